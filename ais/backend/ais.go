@@ -554,7 +554,7 @@ func (m *AISbp) HeadObj(_ context.Context, lom *core.LOM, _ *http.Request) (oa *
 }
 
 // TODO: retry
-func (m *AISbp) GetObj(_ context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (ecode int, err error) {
+func (m *AISbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (ecode int, err error) {
 	var (
 		remAis    *remAis
 		r         io.ReadCloser
@@ -565,7 +565,7 @@ func (m *AISbp) GetObj(_ context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Re
 		return
 	}
 	unsetUUID(&remoteBck)
-	if r, size, err = api.GetObjectReader(remAis.bpL, remoteBck, lom.ObjName, nil /*api.GetArgs*/); err != nil {
+	if r, size, err = api.GetObjectReader(remAis.bpL, remoteBck, lom.ObjName, withReqID(ctx, nil)); err != nil {
 		return extractErrCode(err, remAis.uuid)
 	}
 	params := core.AllocPutParams()
@@ -584,7 +584,7 @@ func (m *AISbp) GetObj(_ context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Re
 	return extractErrCode(err, remAis.uuid)
 }
 
-func (m *AISbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+func (m *AISbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
 	var (
 		remAis    *remAis
 		op        *cmn.ObjectProps
@@ -616,11 +616,27 @@ func (m *AISbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length in
 		res.ExpCksum = oa.Cksum
 		lom.SetCksum(nil)
 	}
-	res.R, res.Size, res.Err = api.GetObjectReader(remAis.bpL, remoteBck, lom.ObjName, args)
+	res.R, res.Size, res.Err = api.GetObjectReader(remAis.bpL, remoteBck, lom.ObjName, withReqID(ctx, args))
 	res.ErrCode, res.Err = extractErrCode(res.Err, remAis.uuid)
 	return
 }
 
+// propagate request ID (if any) to the remote cluster
+func withReqID(ctx context.Context, args *api.GetArgs) *api.GetArgs {
+	rid := ctxReqID(ctx)
+	if rid == "" {
+		return args
+	}
+	if args == nil {
+		args = &api.GetArgs{}
+	}
+	if args.Header == nil {
+		args.Header = make(http.Header, 1)
+	}
+	args.Header.Set(apc.HdrRequestID, rid)
+	return args
+}
+
 // TODO: retry upon 'unreachable' or timeout
 func (m *AISbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode int, err error) {
 	var (
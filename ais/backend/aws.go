@@ -1,4 +1,4 @@
-//go:build aws
+//go:build aws || rgw
 
 // Package backend contains implementation of various backend providers.
 /*
@@ -38,6 +38,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 )
 
 type (
@@ -48,6 +49,7 @@ type (
 	}
 	sessConf struct {
 		bck    *cmn.Bck
+		preset *cmn.S3Preset // S3-compatible provider preset, if any
 		region string
 	}
 )
@@ -116,11 +118,12 @@ func (*s3bp) HeadBucket(_ context.Context, bck *meta.Bck) (bckProps cos.StrKVs,
 
 	// NOTE: return a few assorted fields, specifically to fill-in vendor-specific `cmn.ExtraProps`
 	bckProps = make(cos.StrKVs, 4)
-	bckProps[apc.HdrBackendProvider] = apc.AWS
+	bckProps[apc.HdrBackendProvider] = cloudBck.Provider
 	bckProps[apc.HdrS3Region] = sessConf.region
 	bckProps[apc.HdrS3Endpoint] = ""
 	if bck.Props != nil {
 		bckProps[apc.HdrS3Endpoint] = bck.Props.Extra.AWS.Endpoint
+		bckProps[apc.HdrS3Preset] = bck.Props.Extra.AWS.Preset
 	}
 	versioned, errV := getBucketVersioning(svc, cloudBck)
 	if errV != nil {
@@ -403,19 +406,20 @@ func (*s3bp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode
 // LIST BUCKETS
 //
 
-func (*s3bp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, _ error) {
+func (s3bp *s3bp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, _ error) {
 	var (
-		sessConf sessConf
+		provider = &cmn.Bck{Provider: s3bp.provider}
+		sessConf = sessConf{bck: provider}
 		result   *s3.ListBucketsOutput
 	)
 	svc, err := sessConf.s3client("")
 	if err != nil {
-		ecode, err = awsErrorToAISError(err, &cmn.Bck{Provider: apc.AWS}, "")
+		ecode, err = awsErrorToAISError(err, provider, "")
 		return nil, ecode, err
 	}
 	result, err = svc.ListBuckets(context.Background(), &s3.ListBucketsInput{})
 	if err != nil {
-		ecode, err = awsErrorToAISError(err, &cmn.Bck{Provider: apc.AWS}, "")
+		ecode, err = awsErrorToAISError(err, provider, "")
 		return nil, ecode, err
 	}
 
@@ -426,7 +430,7 @@ func (*s3bp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, _ error) {
 		}
 		bcks[idx] = cmn.Bck{
 			Name:     aws.ToString(bck.Name),
-			Provider: apc.AWS,
+			Provider: s3bp.provider,
 		}
 	}
 	return bcks, 0, nil
@@ -440,6 +444,8 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	const tag = "[head_object]"
 	var (
 		svc        *s3.Client
+		sse        *awsSSE
+		input      *s3.HeadObjectInput
 		headOutput *s3.HeadObjectOutput
 		h          = cmn.BackendHelpers.Amazon
 		cloudBck   = lom.Bck().RemoteBck()
@@ -463,17 +469,23 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	if err != nil {
 		return
 	}
-	headOutput, err = svc.HeadObject(context.Background(), &s3.HeadObjectInput{
+	sse, err = newAwsSSE(cloudBck)
+	if err != nil {
+		return
+	}
+	input = &s3.HeadObjectInput{
 		Bucket: aws.String(cloudBck.Name),
 		Key:    aws.String(lom.ObjName),
-	})
+	}
+	input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = sse.customer()
+	headOutput, err = svc.HeadObject(context.Background(), input)
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 		return
 	}
 	oa = &cmn.ObjAttrs{}
 	oa.CustomMD = make(cos.StrKVs, 6)
-	oa.SetCustomKey(cmn.SourceObjMD, apc.AWS)
+	oa.SetCustomKey(cmn.SourceObjMD, cloudBck.Provider)
 	oa.Size = *headOutput.ContentLength
 	if v, ok := h.EncodeVersion(headOutput.VersionId); ok {
 		lom.SetCustomKey(cmn.VersionObjMD, v)
@@ -482,9 +494,10 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	if v, ok := h.EncodeETag(headOutput.ETag); ok {
 		oa.SetCustomKey(cmn.ETag, v)
 	}
-	if v, ok := h.EncodeCksum(headOutput.ETag); ok {
+	if v, ok := h.EncodeCksum(headOutput.ETag); ok && etagIsMD5(cloudBck) {
 		oa.SetCustomKey(cmn.MD5ObjMD, v)
 	}
+	sseCustom(oa.SetCustomKey, headOutput.ServerSideEncryption, headOutput.SSEKMSKeyId)
 
 	// AIS custom (see also: PutObject, GetObjReader)
 	if cksumType, ok := headOutput.Metadata[cos.S3MetadataChecksumType]; ok {
@@ -494,6 +507,7 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	}
 
 	// unlike other custom attrs, "Content-Type" is not getting stored w/ LOM
+	// (unless the bucket returns it upon GET - see lom.SetContentType)
 	// - only shown via list-objects and HEAD when not present
 	if v := headOutput.ContentType; v != nil {
 		oa.SetCustomKey(cos.HdrContentType, *v)
@@ -568,10 +582,16 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 		res.Err = err
 		return
 	}
+	sse, err := newAwsSSE(cloudBck)
+	if err != nil {
+		res.Err = err
+		return
+	}
+	input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = sse.customer()
 	if length > 0 {
 		rng := cmn.MakeRangeHdr(offset, length)
 		input.Range = aws.String(rng)
-		obj, err = svc.GetObject(ctx, &input)
+		obj, err = svc.GetObject(ctx, &input, s3ReqID(ctx)...)
 		if err != nil {
 			res.ErrCode, res.Err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 			if res.ErrCode == http.StatusRequestedRangeNotSatisfiable {
@@ -580,15 +600,18 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 			return res
 		}
 	} else {
-		obj, err = svc.GetObject(ctx, &input)
+		obj, err = svc.GetObject(ctx, &input, s3ReqID(ctx)...)
 		if err != nil {
 			res.ErrCode, res.Err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 			return res
 		}
 		// custom metadata
-		lom.SetCustomKey(cmn.SourceObjMD, apc.AWS)
+		lom.SetCustomKey(cmn.SourceObjMD, cloudBck.Provider)
 
-		res.ExpCksum = _getCustom(lom, obj)
+		res.ExpCksum = _getCustom(lom, obj, etagIsMD5(cloudBck))
+		if v := obj.ContentType; v != nil {
+			lom.SetContentType(*v)
+		}
 
 		md := obj.Metadata
 		if cksumType, ok := md[cos.S3MetadataChecksumType]; ok {
@@ -605,7 +628,17 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 	return res
 }
 
-func _getCustom(lom *core.LOM, obj *s3.GetObjectOutput) (md5 *cos.Cksum) {
+// pass on request ID (if any) as a custom header
+func s3ReqID(ctx context.Context) (opts []func(*s3.Options)) {
+	if rid := ctxReqID(ctx); rid != "" {
+		opts = append(opts, func(o *s3.Options) {
+			o.APIOptions = append(o.APIOptions, smithyhttp.AddHeaderValue(apc.HdrRequestID, rid))
+		})
+	}
+	return opts
+}
+
+func _getCustom(lom *core.LOM, obj *s3.GetObjectOutput, etagMD5 bool) (md5 *cos.Cksum) {
 	h := cmn.BackendHelpers.Amazon
 	if v, ok := h.EncodeVersion(obj.VersionId); ok {
 		lom.SetVersion(v)
@@ -614,10 +647,11 @@ func _getCustom(lom *core.LOM, obj *s3.GetObjectOutput) (md5 *cos.Cksum) {
 	if v, ok := h.EncodeETag(obj.ETag); ok {
 		lom.SetCustomKey(cmn.ETag, v)
 	}
-	if v, ok := h.EncodeCksum(obj.ETag); ok {
+	if v, ok := h.EncodeCksum(obj.ETag); ok && etagMD5 {
 		md5 = cos.NewCksum(cos.ChecksumMD5, v)
 		lom.SetCustomKey(cmn.MD5ObjMD, v)
 	}
+	sseCustom(lom.SetCustomKey, obj.ServerSideEncryption, obj.SSEKMSKeyId)
 	mtime := *(obj.LastModified)
 	lom.SetCustomKey(cmn.LastModified, fmtTime(mtime))
 	return
@@ -631,6 +665,8 @@ func (*s3bp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode i
 	const tag = "[put_object]"
 	var (
 		svc                   *s3.Client
+		sse                   *awsSSE
+		input                 *s3.PutObjectInput
 		uploader              *s3manager.Uploader
 		uploadOutput          *s3manager.UploadOutput
 		h                     = cmn.BackendHelpers.Amazon
@@ -656,19 +692,29 @@ func (*s3bp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode i
 
 	svc, err = sessConf.s3client(tag)
 	if err != nil {
+		cos.Close(r)
+		return
+	}
+	sse, err = newAwsSSE(cloudBck)
+	if err != nil {
+		cos.Close(r)
 		return
 	}
 
 	md[cos.S3MetadataChecksumType] = cksumType
 	md[cos.S3MetadataChecksumVal] = cksumValue
 
-	uploader = s3manager.NewUploader(svc)
-	uploadOutput, err = uploader.Upload(context.Background(), &s3.PutObjectInput{
+	input = &s3.PutObjectInput{
 		Bucket:   aws.String(cloudBck.Name),
 		Key:      aws.String(lom.ObjName),
 		Body:     r,
 		Metadata: md,
-	})
+	}
+	input.ServerSideEncryption, input.SSEKMSKeyId = sse.server()
+	input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = sse.customer()
+
+	uploader = s3manager.NewUploader(svc) // (propagates SSE to multipart uploads)
+	uploadOutput, err = uploader.Upload(context.Background(), input)
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 		cos.Close(r)
@@ -684,9 +730,10 @@ exit:
 	if v, ok := h.EncodeETag(uploadOutput.ETag); ok {
 		lom.SetCustomKey(cmn.ETag, v)
 	}
-	if v, ok := h.EncodeCksum(uploadOutput.ETag); ok {
+	if v, ok := h.EncodeCksum(uploadOutput.ETag); ok && etagIsMD5(cloudBck) {
 		lom.SetCustomKey(cmn.MD5ObjMD, v)
 	}
+	sseCustom(lom.SetCustomKey, uploadOutput.ServerSideEncryption, uploadOutput.SSEKMSKeyId)
 	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Infoln(tag, lom.String())
 	}
@@ -743,15 +790,30 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 		endpoint = s3Endpoint
 		profile  = awsProfile
 	)
-	if sessConf.bck != nil && sessConf.bck.Props != nil {
+	if sessConf.bck != nil && sessConf.bck.Provider == apc.RGW {
+		// Ceph RGW: all buckets share the configured endpoint, credentials, and (zonegroup) region
+		conf := cmn.GCO.Get().Backend.RGW()
+		endpoint, profile, sessConf.region = conf.Endpoint, conf.Profile, conf.Region
+	} else if sessConf.bck != nil && sessConf.bck.Props != nil {
+		extra := &sessConf.bck.Props.Extra.AWS
 		if sessConf.region == "" {
-			sessConf.region = sessConf.bck.Props.Extra.AWS.CloudRegion
+			sessConf.region = extra.CloudRegion
+		}
+		// preset: default region (no get-bucket-location) and region-specific endpoint
+		preset, err := cmn.GetS3Preset(extra.Preset)
+		if err != nil {
+			return nil, err
 		}
-		if sessConf.bck.Props.Extra.AWS.Endpoint != "" {
-			endpoint = sessConf.bck.Props.Extra.AWS.Endpoint
+		if preset != nil {
+			sessConf.preset = preset
+			sessConf.region = preset.Region(sessConf.region)
+			endpoint = preset.EndpointFor(sessConf.region)
 		}
-		if sessConf.bck.Props.Extra.AWS.Profile != "" {
-			profile = sessConf.bck.Props.Extra.AWS.Profile
+		if extra.Endpoint != "" {
+			endpoint = extra.Endpoint
+		}
+		if extra.Profile != "" {
+			profile = extra.Profile
 		}
 	}
 
@@ -802,6 +864,13 @@ func (sessConf *sessConf) options(options *s3.Options) {
 			options.UsePathStyle = cmn.Rom.Features().IsSet(feat.S3UsePathStyle)
 		}
 	}
+	if bck := sessConf.bck; bck != nil && bck.Provider == apc.RGW {
+		options.UsePathStyle = true // (no virtual-hosted DNS)
+	}
+	if preset := sessConf.preset; preset != nil {
+		options.Region = preset.Signing(sessConf.region)
+		options.UsePathStyle = options.UsePathStyle || preset.PathStyle
+	}
 }
 
 func _cid(profile, region, endpoint string) string {
@@ -0,0 +1,49 @@
+//go:build aws || rgw
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func TestS3ReqID(t *testing.T) {
+	var rid string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rid = r.Header.Get(apc.HdrRequestID)
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+	svc := s3.New(s3.Options{
+		BaseEndpoint: aws.String(srv.URL),
+		Region:       "us-east-1",
+		Credentials:  credentials.NewStaticCredentialsProvider("AKIDTEST", "secret", ""),
+		UsePathStyle: true,
+		HTTPClient:   srv.Client(),
+	})
+
+	for _, expected := range []string{"rid-s3", ""} {
+		ctx := context.Background()
+		if expected != "" {
+			ctx = context.WithValue(ctx, cos.CtxReqID, expected)
+		}
+		input := s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("obj")}
+		obj, err := svc.GetObject(ctx, &input, s3ReqID(ctx)...)
+		tassert.CheckFatal(t, err)
+		obj.Body.Close()
+		tassert.Errorf(t, rid == expected, "expected request ID %q, got %q", expected, rid)
+	}
+}
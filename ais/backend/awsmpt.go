@@ -1,4 +1,4 @@
-//go:build aws
+//go:build aws || rgw
 
 // Package backend contains implementation of various backend providers.
 /*
@@ -60,6 +60,12 @@ func StartMpt(lom *core.LOM, oreq *http.Request, oq url.Values) (id string, ecod
 	if errN != nil && cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Warningln(errN)
 	}
+	sse, err := newAwsSSE(cloudBck)
+	if err != nil {
+		return "", 0, err
+	}
+	input.ServerSideEncryption, input.SSEKMSKeyId = sse.server()
+	input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = sse.customer()
 	out, err := svc.CreateMultipartUpload(context.Background(), &input)
 	if err == nil {
 		id = *out.UploadId
@@ -102,6 +108,11 @@ func PutMptPart(lom *core.LOM, r io.ReadCloser, oreq *http.Request, oq url.Value
 	if errN != nil && cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Warningln(errN)
 	}
+	sse, err := newAwsSSE(cloudBck)
+	if err != nil {
+		return "", 0, err
+	}
+	input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = sse.customer()
 
 	out, err := svc.UploadPart(context.Background(), &input)
 	if err != nil {
@@ -147,6 +158,11 @@ func CompleteMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID stri
 	if errN != nil && cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Warningln(errN)
 	}
+	sse, err := newAwsSSE(cloudBck)
+	if err != nil {
+		return "", 0, err
+	}
+	input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = sse.customer()
 
 	s3parts.Parts = parts.Parts
 	input.MultipartUpload = &s3parts
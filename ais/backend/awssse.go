@@ -0,0 +1,81 @@
+//go:build aws || rgw
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3 server-side encryption as per bucket props (see cmn/s3sse.go)
+// NOTE: never log SSE-C key
+
+type awsSSE struct {
+	mode     types.ServerSideEncryption // SSE-S3 and SSE-KMS
+	kmsKeyID *string
+	key      *string // SSE-C (base64)
+	keyMD5   *string // ditto
+}
+
+var sseCAlgo = aws.String(string(types.ServerSideEncryptionAes256))
+
+// returns nil when not configured
+func newAwsSSE(bck *cmn.Bck) (*awsSSE, error) {
+	if bck.Props == nil || bck.Props.Extra.AWS.SSE == "" {
+		return nil, nil
+	}
+	extra := &bck.Props.Extra.AWS
+	switch extra.SSE {
+	case cmn.S3SSES3:
+		return &awsSSE{mode: types.ServerSideEncryptionAes256}, nil
+	case cmn.S3SSEKMS:
+		sse := &awsSSE{mode: types.ServerSideEncryptionAwsKms}
+		if extra.SSEKMSKeyID != "" {
+			sse.kmsKeyID = aws.String(extra.SSEKMSKeyID)
+		}
+		return sse, nil
+	default:
+		key, keyMD5, err := extra.SSECustomerKey()
+		if err != nil {
+			return nil, err
+		}
+		return &awsSSE{key: aws.String(key), keyMD5: aws.String(keyMD5)}, nil
+	}
+}
+
+// PUT and start-multipart
+func (sse *awsSSE) server() (types.ServerSideEncryption, *string) {
+	if sse == nil {
+		return "", nil
+	}
+	return sse.mode, sse.kmsKeyID
+}
+
+// SSE-C: all requests that read or write object data (including HEAD)
+func (sse *awsSSE) customer() (algo, key, keyMD5 *string) {
+	if sse == nil || sse.key == nil {
+		return nil, nil, nil
+	}
+	return sseCAlgo, sse.key, sse.keyMD5
+}
+
+// record server-side encryption (as reported by S3) in object's custom metadata
+func sseCustom(set func(key, value string), mode types.ServerSideEncryption, kmsKeyID *string) {
+	if mode == "" {
+		return
+	}
+	set(cmn.SSEObjMD, string(mode))
+	if kmsKeyID != nil && *kmsKeyID != "" {
+		set(cmn.SSEKMSKeyObjMD, *kmsKeyID)
+	}
+}
+
+// with SSE-KMS and SSE-C, ETag is not MD5 of the object
+func etagIsMD5(bck *cmn.Bck) bool {
+	return bck.Props == nil || bck.Props.Extra.AWS.ETagIsMD5()
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
@@ -429,6 +430,7 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 		res.ErrCode, res.Err = azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return
 	}
+	ctx = azReqID(ctx)
 
 	// Get checksum
 	respProps, err := client.GetProperties(ctx, nil)
@@ -465,12 +467,23 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 			lom.SetCustomKey(cmn.MD5ObjMD, md5)
 			res.ExpCksum = cos.NewCksum(cos.ChecksumMD5, md5)
 		}
+		if v := respProps.ContentType; v != nil {
+			lom.SetContentType(*v)
+		}
 	}
 
 	res.R = resp.Body
 	return res
 }
 
+// pass on request ID (if any) as a custom header
+func azReqID(ctx context.Context) context.Context {
+	if rid := ctxReqID(ctx); rid != "" {
+		return policy.WithHTTPHeader(ctx, http.Header{apc.HdrRequestID: []string{rid}})
+	}
+	return ctx
+}
+
 //
 // PUT OBJECT
 //
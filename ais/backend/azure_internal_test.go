@@ -0,0 +1,52 @@
+//go:build azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAzureReqID(t *testing.T) {
+	var rid string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rid = r.Header.Get(apc.HdrRequestID)
+		w.Header().Set(cos.HdrContentLength, "5")
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+	creds, err := azblob.NewSharedKeyCredential("account", "c2VjcmV0")
+	tassert.CheckFatal(t, err)
+	opts := &blockblob.ClientOptions{ClientOptions: azcore.ClientOptions{
+		Transport:                       srv.Client(),
+		InsecureAllowCredentialWithHTTP: true,
+		Retry:                           policy.RetryOptions{MaxRetries: -1},
+	}}
+	client, err := blockblob.NewClientWithSharedKeyCredential(srv.URL+"/container/obj", creds, opts)
+	tassert.CheckFatal(t, err)
+
+	for _, expected := range []string{"rid-azure", ""} {
+		ctx := context.Background()
+		if expected != "" {
+			ctx = context.WithValue(ctx, cos.CtxReqID, expected)
+		}
+		resp, err := client.DownloadStream(azReqID(ctx), nil)
+		tassert.CheckFatal(t, err)
+		resp.Body.Close()
+		tassert.Errorf(t, rid == expected, "expected request ID %q, got %q", expected, rid)
+	}
+}
@@ -5,6 +5,7 @@
 package backend
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -232,6 +233,12 @@ func (b *base) ListObjectsInv(*meta.Bck, *apc.LsoMsg, *cmn.LsoRes, *core.LsoInvC
 
 func fmtTime(t time.Time) string { return t.Format(time.RFC3339) }
 
+// request ID (if any) to pass on to the remote backend (see cos.CtxReqID)
+func ctxReqID(ctx context.Context) string {
+	rid, _ := ctx.Value(cos.CtxReqID).(string)
+	return rid
+}
+
 func calcPageSize(pageSize, maxPageSize int64) int64 {
 	debug.Assert(pageSize >= 0, pageSize)
 	if pageSize == 0 {
@@ -0,0 +1,430 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// External backend plugins:
+// - always linked-in (no build tag): each plugin is a separate process (e.g., a sidecar container)
+//   that implements a given object store behind a simple HTTP protocol (below);
+// - plugins are registered in the cluster config (cmn.BackendConfExt) at runtime - no restart required;
+// - plugin name is the bucket's namespace, e.g. "ext://#acme/bucket"
+//
+// Protocol (all paths relative to the plugin URL; non-2xx status with plain-text error in the body):
+// - GET    /v1/buckets                    => [{"name": "..."}, ...]
+// - HEAD   /v1/buckets/BUCKET             => 200 | 404 (optional header: apc.HdrBucketVerEnabled)
+// - GET    /v1/buckets/BUCKET?prefix=&delimiter=&continuation_token=&max_keys= => extLsoRes
+// - HEAD   /v1/objects/BUCKET/OBJECT      => Content-Length, ETag, Last-Modified, apc.HdrObjVersion (all optional)
+// - GET    /v1/objects/BUCKET/OBJECT      => object content; supports Range; same headers as HEAD
+// - PUT    /v1/objects/BUCKET/OBJECT      => ETag, apc.HdrObjVersion (optional)
+// - DELETE /v1/objects/BUCKET/OBJECT
+// See docs/providers.md for details.
+
+const extAPIVersion = "/v1"
+
+type (
+	extbp struct {
+		t      core.TargetPut
+		cliH   *http.Client
+		cliTLS *http.Client
+		base
+	}
+	extLsoRes struct {
+		Entries           []extLsoEnt `json:"entries"`
+		Prefixes          []string    `json:"prefixes,omitempty"` // virtual directories (with delimiter)
+		ContinuationToken string      `json:"continuation_token,omitempty"`
+	}
+	extLsoEnt struct {
+		Name         string `json:"name"`
+		ETag         string `json:"etag,omitempty"`
+		Version      string `json:"version,omitempty"`
+		LastModified string `json:"last_modified,omitempty"` // RFC 3339
+		Size         int64  `json:"size"`
+	}
+	extBck struct {
+		Name string `json:"name"`
+	}
+)
+
+// interface guard
+var _ core.Backend = (*extbp)(nil)
+
+func NewExt(t core.TargetPut, config *cmn.Config, tstats stats.Tracker, startingUp bool) (core.Backend, error) {
+	bp := &extbp{
+		t:    t,
+		base: base{provider: apc.Ext},
+	}
+	// no client timeout: large GETs and PUTs - see ExtPluginConf.Timeout for the rest
+	bp.cliH, bp.cliTLS = cmn.NewDefaultClients(0)
+	bp.init(t.Snode(), tstats, startingUp)
+	return bp, nil
+}
+
+// plugin (base URL and per-request timeout) that serves a given bucket
+func extPlugin(bck *cmn.Bck) (u string, timeout time.Duration, err error) {
+	config := cmn.GCO.Get()
+	pc, ok := config.Backend.Ext()[bck.Ns.Name]
+	if !ok {
+		if bck.Ns.Name == "" {
+			err = fmt.Errorf("%s: missing plugin name (expecting namespace, e.g. \"%s#plugin/%s\")",
+				bck.Cname(""), apc.Ext+apc.BckProviderSeparator, bck.Name)
+		} else {
+			err = &cmn.ErrMissingBackend{Provider: apc.Ext, Msg: "plugin \"" + bck.Ns.Name + "\" is not registered"}
+		}
+		return "", 0, err
+	}
+	timeout = pc.Timeout.D()
+	if timeout == 0 {
+		timeout = config.Client.Timeout.D()
+	}
+	return strings.TrimSuffix(pc.URL, "/"), timeout, nil
+}
+
+func extURL(base, what, bucket, objName string, q url.Values) string {
+	p := extAPIVersion + "/" + what
+	if bucket != "" {
+		p += "/" + bucket
+	}
+	if objName != "" {
+		p += "/" + objName
+	}
+	u := base + (&url.URL{Path: p}).EscapedPath()
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	return u
+}
+
+func (bp *extbp) do(ctx context.Context, method, u string, body io.Reader, hdr http.Header) (*http.Response, int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if hdr != nil {
+		req.Header = hdr
+	}
+	cli := bp.cliH
+	if cos.IsHTTPS(u) {
+		cli = bp.cliTLS
+	}
+	resp, err := cli.Do(req) //nolint:bodyclose // is closed by the caller
+	if err != nil {
+		return nil, http.StatusServiceUnavailable, err
+	}
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp, 0, nil
+	}
+	var msg string
+	if method != http.MethodHead {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		msg = strings.TrimSpace(string(b))
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if msg == "" {
+		msg = http.StatusText(resp.StatusCode)
+	}
+	return nil, resp.StatusCode, fmt.Errorf("%s %s: %s", method, u, msg)
+}
+
+// call with timeout and decode JSON response
+func (bp *extbp) getJSON(u string, timeout time.Duration, v any) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	resp, ecode, err := bp.do(ctx, http.MethodGet, u, http.NoBody, nil)
+	if err != nil {
+		return ecode, err
+	}
+	err = jsoniter.NewDecoder(resp.Body).Decode(v)
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return http.StatusBadGateway, fmt.Errorf("GET %s: invalid response: %v", u, err)
+	}
+	return 0, nil
+}
+
+//
+// buckets
+//
+
+func (bp *extbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
+	cloudBck := bck.RemoteBck()
+	base, timeout, err := extPlugin(cloudBck)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, ecode, err := bp.do(ctx, http.MethodHead, extURL(base, "buckets", cloudBck.Name, "", nil), http.NoBody, nil)
+	if err != nil {
+		if ecode == http.StatusNotFound {
+			err = cmn.NewErrRemoteBckNotFound(cloudBck)
+		}
+		return nil, ecode, err
+	}
+	resp.Body.Close()
+
+	bckProps := make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.Ext
+	if v := resp.Header.Get(apc.HdrBucketVerEnabled); v != "" {
+		bckProps[apc.HdrBucketVerEnabled] = strconv.FormatBool(cos.IsParseBool(v))
+	}
+	return bckProps, 0, nil
+}
+
+func (bp *extbp) ListBuckets(qbck cmn.QueryBcks) (bcks cmn.Bcks, ecode int, err error) {
+	plugins := cmn.GCO.Get().Backend.Ext()
+	for name := range plugins {
+		if qbck.Ns.Name != "" && qbck.Ns.Name != name {
+			continue
+		}
+		var (
+			all []extBck
+			b   = &cmn.Bck{Provider: apc.Ext, Ns: cmn.Ns{Name: name}}
+		)
+		base, timeout, _ := extPlugin(b)
+		if ecode, err = bp.getJSON(extURL(base, "buckets", "", "", nil), timeout, &all); err != nil {
+			if qbck.Ns.Name != "" {
+				return nil, ecode, err
+			}
+			// listing all plugins: skip the one that fails
+			nlog.Warningln("plugin", name+":", err)
+			ecode, err = 0, nil
+			continue
+		}
+		for _, ab := range all {
+			bcks = append(bcks, cmn.Bck{Name: ab.Name, Provider: apc.Ext, Ns: b.Ns})
+		}
+	}
+	return bcks, 0, nil
+}
+
+//
+// list objects
+//
+
+func (bp *extbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	cloudBck := bck.RemoteBck()
+	base, timeout, err := extPlugin(cloudBck)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
+	q := url.Values{"max_keys": []string{strconv.FormatInt(msg.PageSize, 10)}}
+	if msg.Prefix != "" {
+		q.Set("prefix", msg.Prefix)
+	}
+	if msg.IsFlagSet(apc.LsNoRecursion) {
+		q.Set("delimiter", "/")
+	}
+	if msg.ContinuationToken != "" {
+		q.Set("continuation_token", msg.ContinuationToken)
+	}
+
+	var res extLsoRes
+	if ecode, err := bp.getJSON(extURL(base, "buckets", cloudBck.Name, "", q), timeout, &res); err != nil {
+		if ecode == http.StatusNotFound {
+			err = cmn.NewErrRemoteBckNotFound(cloudBck)
+		}
+		return ecode, err
+	}
+
+	var (
+		h          = cmn.BackendHelpers.HTTP
+		wantCustom = msg.WantProp(apc.GetPropsCustom)
+	)
+	lst.Entries = lst.Entries[:0]
+	for i := range res.Entries {
+		obj := &res.Entries[i]
+		en := &cmn.LsoEnt{Name: obj.Name, Size: obj.Size, Version: obj.Version}
+		if wantCustom && !msg.IsFlagSet(apc.LsNameOnly) && !msg.IsFlagSet(apc.LsNameSize) {
+			etag, _ := h.EncodeETag(obj.ETag)
+			en.Custom = cmn.CustomProps2S(cmn.ETag, etag, cmn.LastModified, obj.LastModified)
+		}
+		lst.Entries = append(lst.Entries, en)
+	}
+	if !msg.IsFlagSet(apc.LsNoDirs) {
+		for _, dir := range res.Prefixes {
+			lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: dir, Flags: apc.EntryIsDir})
+		}
+	}
+	lst.ContinuationToken = res.ContinuationToken
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[list_objects]", cloudBck.Cname(""), len(lst.Entries))
+	}
+	return 0, nil
+}
+
+//
+// objects
+//
+
+// source, ETag, and last-modified
+func extObjAttrs(hdr http.Header, setCustom func(k, v string)) {
+	h := cmn.BackendHelpers.HTTP
+	setCustom(cmn.SourceObjMD, apc.Ext)
+	if v, ok := h.EncodeETag(hdr.Get(cos.HdrETag)); ok {
+		setCustom(cmn.ETag, v)
+	}
+	if v := hdr.Get(cos.S3LastModified); v != "" {
+		setCustom(cmn.LastModified, v)
+	}
+}
+
+func (bp *extbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
+	cloudBck := lom.Bck().RemoteBck()
+	base, timeout, err := extPlugin(cloudBck)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, ecode, err := bp.do(ctx, http.MethodHead, extURL(base, "objects", cloudBck.Name, lom.ObjName, nil), http.NoBody, nil)
+	if err != nil {
+		if ecode == http.StatusNotFound {
+			err = cos.NewErrNotFound(bp.t, lom.Cname())
+		}
+		return nil, ecode, err
+	}
+	resp.Body.Close()
+
+	oa := &cmn.ObjAttrs{}
+	oa.CustomMD = make(cos.StrKVs, 4)
+	extObjAttrs(resp.Header, oa.SetCustomKey)
+	if resp.ContentLength >= 0 {
+		oa.Size = resp.ContentLength
+	}
+	if v := resp.Header.Get(apc.HdrObjVersion); v != "" {
+		oa.SetCustomKey(cmn.VersionObjMD, v)
+		oa.SetVersion(v)
+	}
+	return oa, 0, nil
+}
+
+func (bp *extbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (int, error) {
+	res := bp.GetObjReader(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := allocPutParams(res, owt)
+	err := bp.t.PutObject(lom, params)
+	core.FreePutParams(params)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[get_object]", lom.String(), err)
+	}
+	return 0, err
+}
+
+func (bp *extbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+	cloudBck := lom.Bck().RemoteBck()
+	base, _, err := extPlugin(cloudBck)
+	if err != nil {
+		res.Err, res.ErrCode = err, http.StatusNotFound
+		return res
+	}
+	var hdr http.Header
+	if length > 0 {
+		hdr = http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(offset, length)}}
+	}
+	resp, ecode, err := bp.do(ctx, http.MethodGet, extURL(base, "objects", cloudBck.Name, lom.ObjName, nil), http.NoBody, hdr) //nolint:bodyclose // is closed by the caller
+	if err != nil {
+		if ecode == http.StatusNotFound {
+			err = cos.NewErrNotFound(bp.t, lom.Cname())
+		}
+		res.Err, res.ErrCode = err, ecode
+		return res
+	}
+	extObjAttrs(resp.Header, lom.SetCustomKey)
+	if v := resp.Header.Get(apc.HdrObjVersion); v != "" {
+		lom.SetCustomKey(cmn.VersionObjMD, v)
+		lom.SetVersion(v)
+	}
+	res.Size = resp.ContentLength
+	res.R = resp.Body
+	return res
+}
+
+func (bp *extbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	cloudBck := lom.Bck().RemoteBck()
+	base, _, err := extPlugin(cloudBck)
+	if err != nil {
+		cos.Close(r)
+		return http.StatusNotFound, err
+	}
+	req, err := http.NewRequest(http.MethodPut, extURL(base, "objects", cloudBck.Name, lom.ObjName, nil), r)
+	if err != nil {
+		cos.Close(r)
+		return http.StatusInternalServerError, err
+	}
+	req.ContentLength = lom.Lsize(true)
+	if cksumType, cksumValue := lom.Checksum().Get(); cksumType != cos.ChecksumNone {
+		req.Header.Set(apc.HdrObjCksumType, cksumType)
+		req.Header.Set(apc.HdrObjCksumVal, cksumValue)
+	}
+	cli := bp.cliH
+	if cos.IsHTTPS(req.URL.String()) {
+		cli = bp.cliTLS
+	}
+	resp, err := cli.Do(req) // (closes r)
+	if err != nil {
+		return http.StatusServiceUnavailable, err
+	}
+	defer func() {
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("PUT %s: %s", lom.Cname(), strings.TrimSpace(string(b)))
+	}
+	extObjAttrs(resp.Header, lom.SetCustomKey)
+	if v := resp.Header.Get(apc.HdrObjVersion); v != "" {
+		lom.SetCustomKey(cmn.VersionObjMD, v)
+		lom.SetVersion(v)
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[put_object]", lom.String())
+	}
+	return 0, nil
+}
+
+func (bp *extbp) DeleteObj(lom *core.LOM) (int, error) {
+	cloudBck := lom.Bck().RemoteBck()
+	base, timeout, err := extPlugin(cloudBck)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	resp, ecode, err := bp.do(ctx, http.MethodDelete, extURL(base, "objects", cloudBck.Name, lom.ObjName, nil), http.NoBody, nil)
+	if err != nil {
+		if ecode == http.StatusNotFound {
+			err = cos.NewErrNotFound(bp.t, lom.Cname())
+		}
+		return ecode, err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	return 0, nil
+}
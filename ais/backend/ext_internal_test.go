@@ -0,0 +1,394 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	coremock "github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	extTestPlugin  = "acme"
+	extTestBucket  = "bucket"
+	extTestTimeout = 200 * time.Millisecond
+
+	extSlowObj   = "slow"   // plugin takes longer than extTestTimeout
+	extBrokenObj = "broken" // plugin fails with 500
+)
+
+type (
+	// in-memory plugin (sidecar) implementing the protocol documented in ext.go
+	extPluginSrv struct {
+		objs map[string][]byte
+		hdrs http.Header // last PUT request headers
+		mu   sync.Mutex
+	}
+	// captures cold-GET payload
+	extTarget struct {
+		*coremock.TargetMock
+		got []byte
+	}
+)
+
+func (*extPluginSrv) etag(b []byte) string {
+	return strconv.Quote(fmt.Sprintf("%x", md5.Sum(b)))
+}
+
+func (p *extPluginSrv) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	items := strings.SplitN(strings.TrimPrefix(r.URL.Path, extAPIVersion+"/"), "/", 3)
+	switch {
+	case items[0] == "buckets" && len(items) == 1:
+		w.Write([]byte(`[{"name":"` + extTestBucket + `"}]`))
+	case items[0] == "buckets":
+		if items[1] != extTestBucket {
+			http.Error(w, "no such bucket "+items[1], http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodHead {
+			w.Header().Set(apc.HdrBucketVerEnabled, "true")
+			return
+		}
+		p.list(w, r)
+	case items[0] == "objects" && len(items) == 3:
+		if items[1] != extTestBucket {
+			http.Error(w, "no such bucket "+items[1], http.StatusNotFound)
+			return
+		}
+		p.object(w, r, items[2])
+	default:
+		http.Error(w, "invalid path "+r.URL.Path, http.StatusBadRequest)
+	}
+}
+
+func (p *extPluginSrv) object(w http.ResponseWriter, r *http.Request, objName string) {
+	switch objName {
+	case extSlowObj:
+		time.Sleep(3 * extTestTimeout)
+	case extBrokenObj:
+		http.Error(w, "plugin failure", http.StatusInternalServerError)
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if r.Method == http.MethodPut {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		p.objs[objName] = b
+		p.hdrs = r.Header.Clone()
+		w.Header().Set(cos.HdrETag, p.etag(b))
+		w.Header().Set(apc.HdrObjVersion, "v2")
+		return
+	}
+	b, ok := p.objs[objName]
+	if !ok {
+		http.Error(w, "no such object "+objName, http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodDelete:
+		delete(p.objs, objName)
+	case http.MethodHead, http.MethodGet:
+		w.Header().Set(cos.HdrETag, p.etag(b))
+		w.Header().Set(cos.S3LastModified, "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set(apc.HdrObjVersion, "v1")
+		http.ServeContent(w, r, objName, time.Time{}, bytes.NewReader(b))
+	}
+}
+
+// page by page; continuation token is the index of the next entry
+func (p *extPluginSrv) list(w http.ResponseWriter, r *http.Request) {
+	var (
+		q          = r.URL.Query()
+		prefix     = q.Get("prefix")
+		delim      = q.Get("delimiter")
+		maxKeys    = 1000
+		res        extLsoRes
+		dirs       = cos.StrSet{}
+		names      []string
+		start, _   = strconv.Atoi(q.Get("continuation_token"))
+		maxKeysStr = q.Get("max_keys")
+	)
+	if maxKeysStr != "" {
+		maxKeys, _ = strconv.Atoi(maxKeysStr)
+	}
+	p.mu.Lock()
+	for name := range p.objs {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if delim != "" {
+			if i := strings.Index(name[len(prefix):], delim); i >= 0 {
+				dirs.Add(name[:len(prefix)+i+1])
+				continue
+			}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i := start; i < len(names) && len(res.Entries) < maxKeys; i++ {
+		b := p.objs[names[i]]
+		res.Entries = append(res.Entries, extLsoEnt{Name: names[i], Size: int64(len(b)), ETag: p.etag(b)})
+		if len(res.Entries) == maxKeys && i+1 < len(names) {
+			res.ContinuationToken = strconv.Itoa(i + 1)
+		}
+	}
+	p.mu.Unlock()
+	res.Prefixes = dirs.ToSlice()
+	sort.Strings(res.Prefixes)
+	jsoniter.NewEncoder(w).Encode(&res)
+}
+
+func (t *extTarget) PutObject(_ *core.LOM, params *core.PutParams) (err error) {
+	t.got, err = io.ReadAll(params.Reader)
+	params.Reader.Close()
+	return err
+}
+
+func extSetup(t *testing.T, objs map[string][]byte) (*extbp, *extTarget, *extPluginSrv, *meta.Bck) {
+	plugin := &extPluginSrv{objs: objs}
+	srv := httptest.NewServer(plugin)
+	t.Cleanup(srv.Close)
+
+	config := cmn.GCO.BeginUpdate()
+	prev := config.Backend.Conf
+	config.Backend.Conf = map[string]any{
+		apc.Ext: cmn.BackendConfExt{
+			extTestPlugin: {URL: srv.URL + "/", Timeout: cos.Duration(extTestTimeout)},
+			"gone":        {URL: "http://127.0.0.1:1", Timeout: cos.Duration(extTestTimeout)},
+		},
+	}
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Backend.Conf = prev
+		cmn.GCO.CommitUpdate(config)
+	})
+
+	fs.TestNew(nil)
+	t.Cleanup(func() { fs.TestNew(nil) })
+	mpath := filepath.Join(t.TempDir(), "mp")
+	tassert.CheckFatal(t, cos.CreateDir(mpath))
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	bck := meta.NewBck(extTestBucket, apc.Ext, cmn.Ns{Name: extTestPlugin}, &cmn.Bprops{
+		Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash},
+	})
+	tt := &extTarget{TargetMock: coremock.NewTarget(coremock.NewBaseBownerMock(bck))}
+	bp := &extbp{t: tt, base: base{provider: apc.Ext}}
+	bp.cliH, bp.cliTLS = cmn.NewDefaultClients(0)
+	return bp, tt, plugin, bck
+}
+
+func extLOM(t *testing.T, bck *meta.Bck, objName string) *core.LOM {
+	lom := core.AllocLOM(objName)
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	return lom
+}
+
+func TestExtBuckets(t *testing.T) {
+	bp, _, _, bck := extSetup(t, map[string][]byte{})
+
+	props, ecode, err := bp.HeadBucket(context.Background(), bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ecode == 0 && props[apc.HdrBackendProvider] == apc.Ext, "unexpected %d, %v", ecode, props)
+	tassert.Errorf(t, props[apc.HdrBucketVerEnabled] == "true", "expected versioning enabled: %v", props)
+
+	missing := meta.NewBck("missing", apc.Ext, cmn.Ns{Name: extTestPlugin}, &cmn.Bprops{})
+	_, ecode, err = bp.HeadBucket(context.Background(), missing)
+	tassert.Errorf(t, ecode == http.StatusNotFound && cmn.IsErrRemoteBckNotFound(err), "expected not-found, got %d: %v", ecode, err)
+
+	bcks, _, err := bp.ListBuckets(cmn.QueryBcks{Provider: apc.Ext, Ns: cmn.Ns{Name: extTestPlugin}})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(bcks) == 1 && bcks[0].Equal(bck.Bucket()), "expected %s, got %v", bck, bcks)
+
+	// listing all plugins skips the unreachable one
+	bcks, _, err = bp.ListBuckets(cmn.QueryBcks{Provider: apc.Ext})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(bcks) == 1, "expected 1 bucket, got %v", bcks)
+
+	_, ecode, err = bp.ListBuckets(cmn.QueryBcks{Provider: apc.Ext, Ns: cmn.Ns{Name: "gone"}})
+	tassert.Errorf(t, err != nil && ecode == http.StatusServiceUnavailable, "expected unreachable plugin, got %d: %v", ecode, err)
+
+	// unregistered plugin
+	var errMissing *cmn.ErrMissingBackend
+	unknown := meta.NewBck(extTestBucket, apc.Ext, cmn.Ns{Name: "unknown"}, &cmn.Bprops{})
+	_, ecode, err = bp.HeadBucket(context.Background(), unknown)
+	tassert.Errorf(t, ecode == http.StatusNotFound && errors.As(err, &errMissing), "expected missing backend, got %d: %v", ecode, err)
+}
+
+func TestExtListObjects(t *testing.T) {
+	objs := map[string][]byte{
+		"a":       []byte("a"),
+		"b":       []byte("bb"),
+		"c":       []byte("ccc"),
+		"dir/x":   []byte("x"),
+		"dir/y":   []byte("y"),
+		"other/z": []byte("z"),
+	}
+	bp, _, _, bck := extSetup(t, objs)
+
+	// paginate
+	var (
+		names []string
+		msg   = &apc.LsoMsg{PageSize: 4, Props: apc.GetPropsCustom}
+		lst   = &cmn.LsoRes{}
+		pages int
+	)
+	for {
+		_, err := bp.ListObjects(bck, msg, lst)
+		tassert.CheckFatal(t, err)
+		for _, en := range lst.Entries {
+			names = append(names, en.Name)
+			tassert.Errorf(t, en.Size == int64(len(objs[en.Name])), "%s: wrong size %d", en.Name, en.Size)
+			tassert.Errorf(t, en.Custom != "", "%s: expected custom props (ETag)", en.Name)
+		}
+		pages++
+		if lst.ContinuationToken == "" {
+			break
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+	tassert.Errorf(t, pages == 2 && len(names) == len(objs), "expected %d entries in 2 pages, got %v in %d", len(objs), names, pages)
+
+	// prefix, non-recursive
+	msg = &apc.LsoMsg{Prefix: "d"}
+	msg.SetFlag(apc.LsNoRecursion)
+	_, err := bp.ListObjects(bck, msg, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == 1, "expected a single (virtual) directory, got %d", len(lst.Entries))
+	en := lst.Entries[0]
+	tassert.Errorf(t, en.Name == "dir/" && en.IsDir(), "expected \"dir/\", got %+v", en)
+
+	missing := meta.NewBck("missing", apc.Ext, cmn.Ns{Name: extTestPlugin}, &cmn.Bprops{})
+	ecode, err := bp.ListObjects(missing, &apc.LsoMsg{}, lst)
+	tassert.Errorf(t, ecode == http.StatusNotFound && cmn.IsErrRemoteBckNotFound(err), "expected not-found, got %d: %v", ecode, err)
+}
+
+func TestExtGetHead(t *testing.T) {
+	const objName = "dir/obj name?"
+	content := []byte("external backend plugin content")
+	bp, tt, _, bck := extSetup(t, map[string][]byte{objName: content})
+
+	lom := extLOM(t, bck, objName)
+	oa, ecode, err := bp.HeadObj(context.Background(), lom, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ecode == 0 && oa.Size == int64(len(content)), "unexpected %d, size %d", ecode, oa.Size)
+	tassert.Errorf(t, oa.Version() == "v1", "expected version v1, got %q", oa.Version())
+	if v, _ := oa.GetCustomKey(cmn.SourceObjMD); v != apc.Ext {
+		t.Errorf("expected source %q, got %q", apc.Ext, v)
+	}
+	if v, _ := oa.GetCustomKey(cmn.ETag); v == "" {
+		t.Error("expected ETag")
+	}
+
+	// cold GET
+	_, err = bp.GetObj(context.Background(), lom, cmn.OwtGet, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(tt.got, content), "expected %q, got %q", content, tt.got)
+	tassert.Errorf(t, lom.Version() == "v1", "expected version v1, got %q", lom.Version())
+
+	// range read
+	res := bp.GetObjReader(context.Background(), lom, 9, 7)
+	tassert.CheckFatal(t, res.Err)
+	b, err := io.ReadAll(res.R)
+	res.R.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "backend" && res.Size == 7, "expected \"backend\", got %q (size %d)", b, res.Size)
+
+	// not found
+	lom = extLOM(t, bck, "nonexistent")
+	_, ecode, err = bp.HeadObj(context.Background(), lom, nil)
+	tassert.Errorf(t, cos.IsNotExist(err, ecode), "expected not-found, got %d: %v", ecode, err)
+	ecode, err = bp.GetObj(context.Background(), lom, cmn.OwtGet, nil)
+	tassert.Errorf(t, cos.IsNotExist(err, ecode), "expected not-found, got %d: %v", ecode, err)
+}
+
+func TestExtPutDelete(t *testing.T) {
+	const objName = "put/obj"
+	content := []byte("put via plugin")
+	bp, _, plugin, bck := extSetup(t, map[string][]byte{})
+
+	lom := extLOM(t, bck, objName)
+	lom.SetSize(int64(len(content)))
+	lom.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef"))
+	ecode, err := bp.PutObj(io.NopCloser(bytes.NewReader(content)), lom, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ecode == 0, "unexpected %d", ecode)
+
+	plugin.mu.Lock()
+	got, hdrs := plugin.objs[objName], plugin.hdrs
+	plugin.mu.Unlock()
+	tassert.Errorf(t, bytes.Equal(got, content), "expected %q, got %q", content, got)
+	tassert.Errorf(t, hdrs.Get(apc.HdrObjCksumType) == cos.ChecksumXXHash && hdrs.Get(apc.HdrObjCksumVal) == "0123456789abcdef",
+		"expected checksum headers, got %v", hdrs)
+	tassert.Errorf(t, lom.Version() == "v2", "expected version v2, got %q", lom.Version())
+	if v, _ := lom.GetCustomKey(cmn.ETag); v == "" {
+		t.Error("expected ETag")
+	}
+
+	ecode, err = bp.DeleteObj(lom)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ecode == 0, "unexpected %d", ecode)
+	ecode, err = bp.DeleteObj(lom)
+	tassert.Errorf(t, cos.IsNotExist(err, ecode), "expected not-found, got %d: %v", ecode, err)
+}
+
+func TestExtErrors(t *testing.T) {
+	bp, _, _, bck := extSetup(t, map[string][]byte{extSlowObj: []byte("slow")})
+
+	// plugin error: status and (plain-text) message
+	lom := extLOM(t, bck, extBrokenObj)
+	ecode, err := bp.GetObj(context.Background(), lom, cmn.OwtGet, nil)
+	tassert.Errorf(t, ecode == http.StatusInternalServerError && err != nil && strings.Contains(err.Error(), "plugin failure"),
+		"expected plugin failure, got %d: %v", ecode, err)
+	lom.SetSize(1)
+	ecode, err = bp.PutObj(io.NopCloser(bytes.NewReader([]byte{1})), lom, nil)
+	tassert.Errorf(t, ecode == http.StatusInternalServerError && err != nil && strings.Contains(err.Error(), "plugin failure"),
+		"expected plugin failure, got %d: %v", ecode, err)
+
+	// timeout (HEAD and DELETE)
+	lom = extLOM(t, bck, extSlowObj)
+	started := time.Now()
+	_, ecode, err = bp.HeadObj(context.Background(), lom, nil)
+	tassert.Errorf(t, err != nil && ecode == http.StatusServiceUnavailable, "expected timeout, got %d: %v", ecode, err)
+	tassert.Errorf(t, time.Since(started) < 2*extTestTimeout, "expected to time out after %v, took %v", extTestTimeout, time.Since(started))
+	_, err = bp.DeleteObj(lom)
+	tassert.Errorf(t, err != nil, "expected timeout")
+
+	// unreachable plugin
+	gone := meta.NewBck(extTestBucket, apc.Ext, cmn.Ns{Name: "gone"}, &cmn.Bprops{})
+	_, ecode, err = bp.HeadBucket(context.Background(), gone)
+	tassert.Errorf(t, err != nil && ecode == http.StatusServiceUnavailable, "expected unreachable plugin, got %d: %v", ecode, err)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/tracing"
+	"github.com/googleapis/gax-go/v2/callctx"
 	jsoniter "github.com/json-iterator/go"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
@@ -304,6 +305,7 @@ func (*gsbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (oa *c
 
 	oa.SetCustomKey(cmn.LastModified, fmtTime(attrs.Updated))
 	// unlike other custom attrs, "Content-Type" is not getting stored w/ LOM
+	// (unless the bucket returns it upon GET - see lom.SetContentType)
 	// - only shown via list-objects and HEAD when not present
 	oa.SetCustomKey(cos.HdrContentType, attrs.ContentType)
 	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
@@ -337,6 +339,7 @@ func (*gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 		cloudBck = lom.Bck().RemoteBck()
 		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
 	)
+	ctx = gcpReqID(ctx)
 	attrs, res.Err = o.Attrs(ctx)
 	if res.Err != nil {
 		res.ErrCode, res.Err = gcpErrorToAISError(res.Err, cloudBck)
@@ -363,6 +366,7 @@ func (*gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 			}
 		}
 		res.ExpCksum = setCustomGs(lom, attrs)
+		lom.SetContentType(attrs.ContentType)
 	}
 
 	res.Size = rc.Attrs.Size
@@ -370,6 +374,14 @@ func (*gsbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 	return res
 }
 
+// pass on request ID (if any) as a custom header
+func gcpReqID(ctx context.Context) context.Context {
+	if rid := ctxReqID(ctx); rid != "" {
+		return callctx.SetHeaders(ctx, apc.HdrRequestID, rid)
+	}
+	return ctx
+}
+
 func setCustomGs(lom *core.LOM, attrs *storage.ObjectAttrs) (expCksum *cos.Cksum) {
 	h := cmn.BackendHelpers.Google
 	if v, ok := h.EncodeVersion(attrs.Generation); ok {
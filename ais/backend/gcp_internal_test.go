@@ -0,0 +1,44 @@
+//go:build gcp
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"google.golang.org/api/option"
+)
+
+func TestGCPReqID(t *testing.T) {
+	var rid string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rid = r.Header.Get(apc.HdrRequestID)
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+	client, err := storage.NewClient(context.Background(), option.WithEndpoint(srv.URL), option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()))
+	tassert.CheckFatal(t, err)
+	defer client.Close()
+
+	for _, expected := range []string{"rid-gcp", ""} {
+		ctx := context.Background()
+		if expected != "" {
+			ctx = context.WithValue(ctx, cos.CtxReqID, expected)
+		}
+		r, err := client.Bucket("bucket").Object("obj").NewReader(gcpReqID(ctx))
+		tassert.CheckFatal(t, err)
+		r.Close()
+		tassert.Errorf(t, rid == expected, "expected request ID %q, got %q", expected, rid)
+	}
+}
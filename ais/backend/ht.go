@@ -51,6 +51,51 @@ func (htbp *htbp) client(u string) *http.Client {
 	return htbp.cliH
 }
 
+// origin => mirrors (failover), if configured - see cmn.BackendConfHT
+func rewrite(origURL string) []string {
+	var conf cmn.BackendConfHT
+	switch v := cmn.GCO.Get().Backend.Get(apc.HT).(type) {
+	case nil:
+	case cmn.BackendConfHT:
+		conf = v
+	default:
+		if err := cos.MorphMarshal(v, &conf); err != nil {
+			nlog.Errorln("invalid", apc.HT, "backend config:", err)
+		}
+	}
+	return conf.Rewrite(origURL)
+}
+
+// execute HEAD or GET request against the original URL or its configured mirrors, in order,
+// failing over to the next mirror upon connection error or unexpected status
+// (ecode == 0 indicates a connection error)
+func (htbp *htbp) do(method, origURL string, hdr http.Header) (resp *http.Response, ecode int, err error) {
+	urls := rewrite(origURL)
+	for i, u := range urls {
+		var req *http.Request
+		if req, err = http.NewRequest(method, u, http.NoBody); err != nil {
+			ecode = http.StatusInternalServerError
+			return nil, ecode, err
+		}
+		req.Header = hdr
+		resp, err = htbp.client(u).Do(req) //nolint:bodyclose // is closed by the caller
+		if err == nil {
+			if resp.StatusCode == http.StatusOK {
+				return resp, 0, nil
+			}
+			resp.Body.Close()
+			ecode = resp.StatusCode
+			err = fmt.Errorf("%s(%s) failed, status %d", method, u, ecode)
+		} else {
+			ecode = 0
+		}
+		if i < len(urls)-1 {
+			nlog.Warningln(err, "- failing over to", urls[i+1])
+		}
+	}
+	return nil, ecode, err
+}
+
 func (htbp *htbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, ecode int, err error) {
 	// TODO: we should use `bck.RemoteBck()`.
 
@@ -63,18 +108,16 @@ func (htbp *htbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.S
 		nlog.Infof("[head_bucket] original_url: %q", origURL)
 	}
 
-	// Contact the original URL - as long as we can make connection we assume it's good.
-	resp, err := htbp.client(origURL).Head(origURL)
+	// Contact the original URL (or its mirrors) - as long as we can make connection we assume it's good.
+	resp, ecode, err := htbp.do(http.MethodHead, origURL, nil)
 	if err != nil {
-		return nil, http.StatusBadRequest, err
+		if ecode == 0 {
+			ecode = http.StatusBadRequest
+		}
+		return nil, ecode, err
 	}
 	resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("HEAD(%s) failed, status %d", origURL, resp.StatusCode)
-		return nil, resp.StatusCode, err
-	}
-
 	if resp.Header.Get(cos.HdrETag) == "" {
 		// TODO: improve validation
 		nlog.Errorf("Warning: missing header %s (response header: %+v)", cos.HdrETag, resp.Header)
@@ -95,6 +138,17 @@ func (*htbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, err error) {
 	return
 }
 
+// pass on request ID (if any) as a custom header
+func htReqID(ctx context.Context, hdr http.Header) http.Header {
+	if rid := ctxReqID(ctx); rid != "" {
+		if hdr == nil {
+			hdr = make(http.Header, 1)
+		}
+		hdr.Set(apc.HdrRequestID, rid)
+	}
+	return hdr
+}
+
 func getOriginalURL(ctx context.Context, bck *meta.Bck, objName string) (string, error) {
 	origURL, ok := ctx.Value(cos.CtxOriginalURL).(string)
 	if !ok || origURL == "" {
@@ -121,14 +175,14 @@ func (htbp *htbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (
 	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
 		nlog.Infof("[head_object] original_url: %q", origURL)
 	}
-	resp, err := htbp.client(origURL).Head(origURL)
+	resp, ecode, err := htbp.do(http.MethodHead, origURL, nil)
 	if err != nil {
-		return nil, http.StatusBadRequest, err
+		if ecode == 0 {
+			ecode = http.StatusBadRequest
+		}
+		return nil, ecode, err
 	}
 	resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, resp.StatusCode, fmt.Errorf("error occurred: %v", resp.StatusCode)
-	}
 	oa = &cmn.ObjAttrs{}
 	oa.SetCustomKey(cmn.SourceObjMD, apc.HT)
 	if resp.ContentLength >= 0 {
@@ -162,7 +216,7 @@ func (htbp *htbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *htt
 
 func (htbp *htbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
 	var (
-		req  *http.Request
+		hdr  http.Header
 		resp *http.Response
 		h    = cmn.BackendHelpers.HTTP
 		bck  = lom.Bck() // TODO: This should be `cloudBck = lom.Bck().RemoteBck()`
@@ -175,24 +229,15 @@ func (htbp *htbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 		nlog.Infof("[HTTP CLOUD][GET] original_url: %q", origURL)
 	}
 
-	req, res.Err = http.NewRequest(http.MethodGet, origURL, http.NoBody)
-	if err != nil {
-		res.ErrCode = http.StatusInternalServerError
-		return res
-	}
 	if length > 0 {
 		rng := cmn.MakeRangeHdr(offset, length)
-		req.Header = http.Header{cos.HdrRange: []string{rng}}
+		hdr = http.Header{cos.HdrRange: []string{rng}}
 	}
-	resp, res.Err = htbp.client(origURL).Do(req) //nolint:bodyclose // is closed by the caller
+	hdr = htReqID(ctx, hdr)
+	resp, res.ErrCode, res.Err = htbp.do(http.MethodGet, origURL, hdr) //nolint:bodyclose // is closed by the caller
 	if res.Err != nil {
 		return res
 	}
-	if resp.StatusCode != http.StatusOK {
-		res.ErrCode = resp.StatusCode
-		res.Err = fmt.Errorf("error occurred: %v", resp.StatusCode)
-		return res
-	}
 
 	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
 		nlog.Infof("[HTTP CLOUD][GET] success, size: %d", resp.ContentLength)
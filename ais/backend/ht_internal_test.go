@@ -0,0 +1,40 @@
+//go:build ht
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHTReqID(t *testing.T) {
+	var rid string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rid = r.Header.Get(apc.HdrRequestID)
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+	bp := &htbp{base: base{provider: apc.HT}}
+	bp.cliH, bp.cliTLS = srv.Client(), srv.Client()
+
+	for _, expected := range []string{"rid-ht", ""} {
+		ctx := context.Background()
+		if expected != "" {
+			ctx = context.WithValue(ctx, cos.CtxReqID, expected)
+		}
+		resp, _, err := bp.do(http.MethodGet, srv.URL+"/obj", htReqID(ctx, nil))
+		tassert.CheckFatal(t, err)
+		resp.Body.Close()
+		tassert.Errorf(t, rid == expected, "expected request ID %q, got %q", expected, rid)
+	}
+}
@@ -1,4 +1,4 @@
-//go:build !aws
+//go:build !aws && !rgw
 
 // Package backend contains implementation of various backend providers.
 /*
@@ -10,10 +10,9 @@ package backend
 //   1) Need to parse OCI's ~/.oci/config file for non-ENV defaults (for req'd settings)
 //   2) Validate ListObjects() should only return Name & Size in all cases (or improve)
 //   3) Handle non-descending ListObjects() case (including listing of "virtual" directories)
-//   4) Multi-Segment-Upload utilization (for fast/large object PUTs)... if practical
-//   5) Multi-Segment-Download utilization (for fast/large object GETs)... if practical
-//   6) Add support for object versioning
-//   7) Resolve test:long:oci CI Pipeline failure in TestMultiProxy
+//   4) Multi-Segment-Download utilization (for fast/large object GETs)... if practical
+//   5) Add support for object versioning
+//   6) Resolve test:long:oci CI Pipeline failure in TestMultiProxy
 
 import (
 	"context"
@@ -299,8 +298,16 @@ func (bp *ocibp) ListBuckets(_ cmn.QueryBcks) (bcks cmn.Bcks, ecode int, _ error
 	return bcks, 0, nil
 }
 
-// [TODO] Need to implement multi-threaded PUT when "length" exceeds bp.mpuThreshold
+// large objects are uploaded in segments, in parallel (see ocimpu.go)
 func (bp *ocibp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	if size := lom.Lsize(true); size > bp.mpuThreshold {
+		if ra, ok := r.(io.ReaderAt); ok {
+			ecode, err := bp.putObjMPU(ra, size, lom)
+			cos.Close(r)
+			return ecode, err
+		}
+	}
+
 	h := cmn.BackendHelpers.OCI
 	cloudBck := lom.Bck().RemoteBck()
 	req := ocios.PutObjectRequest{
@@ -407,6 +414,14 @@ func (bp *ocibp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *http
 	return 0, err
 }
 
+// pass on request ID (if any) via OCI's own request-tracing header (opc-client-request-id)
+func ociReqID(ctx context.Context) *string {
+	if rid := ctxReqID(ctx); rid != "" {
+		return &rid
+	}
+	return nil
+}
+
 // [TODO]
 //  1. Need to implement multi-threaded GET when "length" exceeds bp.mpdThreshold
 //  2. Consider setting req.IfMatch to lom.GetCustomKey(cmn.ETag) if present
@@ -426,6 +441,7 @@ func (bp *ocibp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length
 		rangeHeader = cmn.MakeRangeHdr(offset, length)
 		req.Range = &rangeHeader
 	}
+	req.OpcClientRequestId = ociReqID(ctx)
 
 	resp, err := bp.client.GetObject(ctx, req)
 	if err != nil {
@@ -0,0 +1,60 @@
+//go:build oci
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+)
+
+func TestOCIReqID(t *testing.T) {
+	var rid string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rid = r.Header.Get("opc-client-request-id")
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	tassert.CheckFatal(t, err)
+	pkey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	provider := common.NewRawConfigurationProvider("ocid1.tenancy.oc1..test", "ocid1.user.oc1..test", "us-ashburn-1",
+		"20:3b:97:13:55:1c:5b:0d:d3:37:d8:50:4e:c5:3a:34", string(pkey), nil)
+	client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(provider)
+	tassert.CheckFatal(t, err)
+	client.Host = srv.URL
+	client.HTTPClient = srv.Client()
+
+	// (with no request ID, the SDK generates its own)
+	for _, expected := range []string{"rid-oci", ""} {
+		ctx := context.Background()
+		if expected != "" {
+			ctx = context.WithValue(ctx, cos.CtxReqID, expected)
+		}
+		req := objectstorage.GetObjectRequest{
+			NamespaceName:      common.String("namespace"),
+			BucketName:         common.String("bucket"),
+			ObjectName:         common.String("obj"),
+			OpcClientRequestId: ociReqID(ctx),
+		}
+		resp, err := client.GetObject(ctx, req)
+		tassert.CheckFatal(t, err)
+		resp.Content.Close()
+		tassert.Errorf(t, (rid == expected) == (expected != ""), "request ID %q: got %q", expected, rid)
+	}
+}
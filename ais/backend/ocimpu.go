@@ -0,0 +1,191 @@
+//go:build oci
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	ocicmn "github.com/oracle/oci-go-sdk/v65/common"
+	ocios "github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"golang.org/x/sync/errgroup"
+)
+
+// Multi-segment (multipart) upload of objects larger than bp.mpuThreshold:
+// - segments of up to bp.mpuSegmentMaxSize bytes are uploaded by up to bp.mpuMaxThreads
+//   goroutines, each reading its own section of the (work) file;
+// - each segment upload is retried (see ociMpuRetries) unless the error is not retriable;
+// - the first failed segment upload stops all others and aborts the entire upload,
+//   so that no orphaned segments are left behind.
+
+const (
+	ociMpuMaxParts = 10000 // OCI limit
+
+	ociMpuRetries = 3
+	ociMpuSleep   = time.Second
+)
+
+type ocimpu struct {
+	bp       *ocibp
+	lom      *core.LOM
+	bucket   string
+	uploadID *string
+	ra       io.ReaderAt
+	size     int64
+	segSize  int64
+}
+
+func (bp *ocibp) putObjMPU(ra io.ReaderAt, size int64, lom *core.LOM) (int, error) {
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		mpu      = &ocimpu{bp: bp, lom: lom, bucket: cloudBck.Name, ra: ra, size: size}
+	)
+	mpu.segSize = max(bp.mpuSegmentMaxSize, cos.DivCeil(size, ociMpuMaxParts))
+
+	req := ocios.CreateMultipartUploadRequest{
+		NamespaceName: &bp.namespace,
+		BucketName:    &mpu.bucket,
+		CreateMultipartUploadDetails: ocios.CreateMultipartUploadDetails{
+			Object: &lom.ObjName,
+		},
+	}
+	resp, err := bp.client.CreateMultipartUpload(context.Background(), req)
+	if err != nil {
+		return ociStatus(resp.RawResponse), err
+	}
+	mpu.uploadID = resp.UploadId
+
+	parts, err := mpu.upload()
+	if err != nil {
+		mpu.abort()
+		return ociErrCode(err), err
+	}
+
+	commitReq := ocios.CommitMultipartUploadRequest{
+		NamespaceName: &bp.namespace,
+		BucketName:    &mpu.bucket,
+		ObjectName:    &lom.ObjName,
+		UploadId:      mpu.uploadID,
+		CommitMultipartUploadDetails: ocios.CommitMultipartUploadDetails{
+			PartsToCommit: parts,
+		},
+	}
+	commitResp, err := bp.client.CommitMultipartUpload(context.Background(), commitReq)
+	if err != nil {
+		mpu.abort()
+		return ociStatus(commitResp.RawResponse), err
+	}
+
+	// NOTE: multipart ETag and opc-multipart-md5 are not MD5 of the object's content
+	lom.SetCustomKey(apc.HdrBackendProvider, apc.OCI)
+	if v, ok := cmn.BackendHelpers.OCI.EncodeETag(commitResp.ETag); ok {
+		lom.SetCustomKey(cmn.ETag, v)
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[put_object_mpu]", lom.String(), "parts:", len(parts))
+	}
+	return 0, nil
+}
+
+func (mpu *ocimpu) upload() ([]ocios.CommitMultipartUploadPartDetails, error) {
+	var (
+		num        = int(cos.DivCeil(mpu.size, mpu.segSize))
+		parts      = make([]ocios.CommitMultipartUploadPartDetails, num) // in partNum order
+		group, ctx = errgroup.WithContext(context.Background())
+	)
+	group.SetLimit(int(mpu.bp.mpuMaxThreads))
+	for i := range num {
+		if ctx.Err() != nil {
+			break // (failed)
+		}
+		partNum := i + 1 // 1-based
+		group.Go(func() error {
+			off := int64(i) * mpu.segSize
+			etag, err := mpu.uploadPart(ctx, partNum, off, min(mpu.segSize, mpu.size-off))
+			if err == nil {
+				parts[i] = ocios.CommitMultipartUploadPartDetails{PartNum: &partNum, Etag: etag}
+			}
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+func (mpu *ocimpu) uploadPart(ctx context.Context, partNum int, off, n int64) (etag *string, _ error) {
+	call := func() (int, error) {
+		req := ocios.UploadPartRequest{
+			NamespaceName:  &mpu.bp.namespace,
+			BucketName:     &mpu.bucket,
+			ObjectName:     &mpu.lom.ObjName,
+			UploadId:       mpu.uploadID,
+			UploadPartNum:  &partNum,
+			ContentLength:  &n,
+			UploadPartBody: io.NopCloser(io.NewSectionReader(mpu.ra, off, n)), // (new reader upon retry)
+		}
+		resp, err := mpu.bp.client.UploadPart(ctx, req)
+		if err != nil {
+			return ociStatus(resp.RawResponse), err
+		}
+		etag = resp.ETag
+		return 0, nil
+	}
+	err := cmn.NetworkCallWithRetry(&cmn.RetryArgs{
+		Call:      call,
+		IsFatal:   func(err error) bool { return ctx.Err() != nil || !ociRetriable(err) },
+		Action:    fmt.Sprintf("upload part %d of %s", partNum, mpu.lom.Cname()),
+		SoftErr:   ociMpuRetries,
+		HardErr:   ociMpuRetries,
+		Sleep:     ociMpuSleep,
+		Verbosity: cmn.RetryLogQuiet,
+		BackOff:   true,
+	})
+	return etag, err
+}
+
+// best-effort
+func (mpu *ocimpu) abort() {
+	req := ocios.AbortMultipartUploadRequest{
+		NamespaceName: &mpu.bp.namespace,
+		BucketName:    &mpu.bucket,
+		ObjectName:    &mpu.lom.ObjName,
+		UploadId:      mpu.uploadID,
+	}
+	if _, err := mpu.bp.client.AbortMultipartUpload(context.Background(), req); err != nil {
+		nlog.Warningln("failed to abort multipart upload", *mpu.uploadID, "of", mpu.lom.Cname()+":", err)
+	}
+}
+
+// client errors (except timeouts and throttling) are not retriable
+func ociRetriable(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if serr, ok := ocicmn.IsServiceError(err); ok {
+		code := serr.GetHTTPStatusCode()
+		return code >= http.StatusInternalServerError || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+	}
+	return true
+}
+
+func ociErrCode(err error) int {
+	if serr, ok := ocicmn.IsServiceError(err); ok {
+		return serr.GetHTTPStatusCode()
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,196 @@
+//go:build rgw
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Ceph RADOS Gateway (RGW):
+// - data path: S3 (see aws.go), with endpoint, credentials, and region from cmn.BackendConfRGW;
+// - in addition, HEAD(bucket) queries RGW admin ops API for bucket quota and usage
+//   (ref: https://docs.ceph.com/en/latest/radosgw/adminops/#get-bucket-info)
+
+type (
+	rgwbp struct {
+		*s3bp
+		cliH   *http.Client
+		cliTLS *http.Client
+		creds  rgwCreds
+	}
+	// admin ops API credentials (cached, reloaded upon profile change)
+	rgwCreds struct {
+		provider aws.CredentialsProvider
+		profile  string
+		mu       sync.Mutex
+	}
+	// GET /admin/bucket?bucket=...&stats=true (subset)
+	rgwBucketInfo struct {
+		Usage map[string]struct { // by category: "rgw.main", "rgw.multimeta", etc.
+			Size       int64 `json:"size"`
+			NumObjects int64 `json:"num_objects"`
+		} `json:"usage"`
+		Quota struct {
+			Enabled    bool  `json:"enabled"`
+			MaxSize    int64 `json:"max_size"`    // bytes; negative: unlimited
+			MaxObjects int64 `json:"max_objects"` // ditto
+		} `json:"bucket_quota"`
+	}
+)
+
+// sha256 of the empty payload
+const rgwEmptySha256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// interface guard
+var _ core.Backend = (*rgwbp)(nil)
+
+func NewRGW(t core.TargetPut, config *cmn.Config, tstats stats.Tracker, startingUp bool) (core.Backend, error) {
+	bp := &rgwbp{
+		s3bp: &s3bp{
+			t:    t,
+			mm:   t.PageMM(),
+			base: base{provider: apc.RGW},
+		},
+	}
+	bp.cliH, bp.cliTLS = cmn.NewDefaultClients(config.Client.Timeout.D())
+	bp.init(t.Snode(), tstats, startingUp)
+	return bp, nil
+}
+
+// S3 inventory is AWS-only
+func (bp *rgwbp) GetBucketInv(bck *meta.Bck, ctx *core.LsoInvCtx) (int, error) {
+	return bp.base.GetBucketInv(bck, ctx)
+}
+
+func (bp *rgwbp) ListObjectsInv(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes, ctx *core.LsoInvCtx) error {
+	return bp.base.ListObjectsInv(bck, msg, lst, ctx)
+}
+
+// S3 HEAD(bucket) + quota and usage via admin ops API;
+// the latter is best-effort (e.g., credentials without "buckets=read" admin caps)
+func (bp *rgwbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, ecode int, err error) {
+	if bckProps, ecode, err = bp.s3bp.HeadBucket(ctx, bck); err != nil {
+		return nil, ecode, err
+	}
+	cloudBck := bck.RemoteBck()
+	info, err := bp.bucketInfo(ctx, cloudBck.Name)
+	if err != nil {
+		nlog.Warningln("failed to get", cloudBck.Cname(""), "quota and usage:", err)
+		return bckProps, 0, nil
+	}
+	info.toProps(bckProps)
+	return bckProps, 0, nil
+}
+
+func (bp *rgwbp) bucketInfo(ctx context.Context, bucket string) (*rgwBucketInfo, error) {
+	var (
+		conf = cmn.GCO.Get().Backend.RGW()
+		q    = url.Values{"bucket": []string{bucket}, "stats": []string{"true"}, "format": []string{"json"}}
+		u    = strings.TrimSuffix(conf.Endpoint, "/") + conf.AdminPath + "/bucket?" + q.Encode()
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if err := bp.creds.sign(ctx, req, &conf); err != nil {
+		return nil, err
+	}
+
+	cli := bp.cliH
+	if cos.IsHTTPS(u) {
+		cli = bp.cliTLS
+	}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("admin ops API: %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	info := &rgwBucketInfo{}
+	if err := jsoniter.NewDecoder(resp.Body).Decode(info); err != nil {
+		return nil, fmt.Errorf("admin ops API: failed to decode bucket info: %v", err)
+	}
+	return info, nil
+}
+
+//////////////
+// rgwCreds //
+//////////////
+
+// admin ops API uses the same (S3) AWS Signature Version 4
+func (rc *rgwCreds) sign(ctx context.Context, req *http.Request, conf *cmn.BackendConfRGW) error {
+	provider, err := rc.get(conf)
+	if err != nil {
+		return err
+	}
+	creds, err := provider.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Amz-Content-Sha256", rgwEmptySha256)
+	return v4.NewSigner().SignHTTP(ctx, creds, req, rgwEmptySha256, "s3", conf.Region, time.Now())
+}
+
+// load shared config (and credentials) once per profile;
+// aws.CredentialsCache retrieves again only when the credentials expire
+func (rc *rgwCreds) get(conf *cmn.BackendConfRGW) (aws.CredentialsProvider, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.provider != nil && rc.profile == conf.Profile {
+		return rc.provider, nil
+	}
+	cfg, err := loadConfig(conf.Endpoint, conf.Profile)
+	if err != nil {
+		return nil, err
+	}
+	rc.provider, rc.profile = aws.NewCredentialsCache(cfg.Credentials), conf.Profile
+	return rc.provider, nil
+}
+
+func (info *rgwBucketInfo) toProps(bckProps cos.StrKVs) {
+	var size, objs int64
+	for _, u := range info.Usage {
+		size += u.Size
+		objs += u.NumObjects
+	}
+	bckProps[apc.HdrRGWUsedSize] = strconv.FormatInt(size, 10)
+	bckProps[apc.HdrRGWUsedObjects] = strconv.FormatInt(objs, 10)
+	if !info.Quota.Enabled {
+		return
+	}
+	if info.Quota.MaxSize > 0 {
+		bckProps[apc.HdrRGWQuotaMaxSize] = strconv.FormatInt(info.Quota.MaxSize, 10)
+	}
+	if info.Quota.MaxObjects > 0 {
+		bckProps[apc.HdrRGWQuotaMaxObjects] = strconv.FormatInt(info.Quota.MaxObjects, 10)
+	}
+}
@@ -0,0 +1,93 @@
+//go:build rgw
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const rgwTestBucket = "rgw-bucket"
+
+func rgwSetup(t *testing.T, h http.HandlerFunc) *rgwbp {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDTEST")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_CONFIG_FILE", "/dev/null")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/dev/null")
+	t.Setenv("AWS_CA_BUNDLE", "")
+	t.Setenv("AWS_PROFILE", "")
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	config := cmn.GCO.BeginUpdate()
+	prev := config.Backend.Conf
+	config.Backend.Conf = map[string]any{
+		apc.RGW: map[string]any{"endpoint": srv.URL},
+	}
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Backend.Conf = prev
+		cmn.GCO.CommitUpdate(config)
+	})
+	return &rgwbp{cliH: srv.Client(), cliTLS: srv.Client()}
+}
+
+func TestRGWBucketInfo(t *testing.T) {
+	bp := rgwSetup(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path != cmn.RGWDefaultAdminPath+"/bucket":
+			http.NotFound(w, r)
+		case !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDTEST/"):
+			http.Error(w, "AccessDenied", http.StatusForbidden)
+		case r.URL.Query().Get("bucket") != rgwTestBucket:
+			http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"bucket":"rgw-bucket",
+				"usage":{"rgw.main":{"size":1000,"num_objects":10},"rgw.multimeta":{"size":24,"num_objects":2}},
+				"bucket_quota":{"enabled":true,"max_size":4096,"max_objects":-1}}`))
+		}
+	})
+
+	info, err := bp.bucketInfo(context.Background(), rgwTestBucket)
+	tassert.CheckFatal(t, err)
+	props := make(cos.StrKVs, 4)
+	info.toProps(props)
+	tassert.Errorf(t, props[apc.HdrRGWUsedSize] == "1024", "used size: %q", props[apc.HdrRGWUsedSize])
+	tassert.Errorf(t, props[apc.HdrRGWUsedObjects] == "12", "used objects: %q", props[apc.HdrRGWUsedObjects])
+	tassert.Errorf(t, props[apc.HdrRGWQuotaMaxSize] == "4096", "quota max size: %q", props[apc.HdrRGWQuotaMaxSize])
+	_, ok := props[apc.HdrRGWQuotaMaxObjects]
+	tassert.Errorf(t, !ok, "unexpected (unlimited) quota max objects: %q", props[apc.HdrRGWQuotaMaxObjects])
+
+	// credentials are loaded once and reused
+	provider := bp.creds.provider
+	_, err = bp.bucketInfo(context.Background(), rgwTestBucket)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bp.creds.provider == provider, "expected cached credentials provider")
+
+	_, err = bp.bucketInfo(context.Background(), "nonexistent")
+	tassert.Fatalf(t, err != nil && strings.Contains(err.Error(), "NoSuchBucket"), "expected not-found error, got %v", err)
+}
+
+func TestRGWQuotaDisabled(t *testing.T) {
+	info := &rgwBucketInfo{}
+	info.Quota.MaxSize, info.Quota.MaxObjects = 100, 100
+	props := make(cos.StrKVs, 4)
+	info.toProps(props)
+	_, ok := props[apc.HdrRGWQuotaMaxSize]
+	tassert.Errorf(t, !ok, "unexpected quota (disabled)")
+	tassert.Errorf(t, props[apc.HdrRGWUsedSize] == "0" && props[apc.HdrRGWUsedObjects] == "0", "expected zero usage: %v", props)
+}
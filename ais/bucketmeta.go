@@ -63,9 +63,11 @@ type (
 		putPersist(bmd *bucketMD, payload msPayload) error
 		persist(clone *bucketMD, payload msPayload) error
 		modify(*bmdModifier) (*bucketMD, error)
+		history() *metaHist
 	}
 	bmdOwnerBase struct {
-		bmd ratomic.Pointer[bucketMD]
+		bmd  ratomic.Pointer[bucketMD]
+		hist metaHist // proxies only
 		sync.Mutex
 	}
 	bmdOwnerPrx struct {
@@ -259,6 +261,8 @@ func (m *bucketMD) _encode() (sgl *memsys.SGL) {
 func (bo *bmdOwnerBase) Get() *meta.BMD       { return &bo.get().BMD }
 func (bo *bmdOwnerBase) get() (bmd *bucketMD) { return bo.bmd.Load() }
 
+func (bo *bmdOwnerBase) history() *metaHist { return &bo.hist }
+
 func (bo *bmdOwnerBase) put(bmd *bucketMD) {
 	bmd.vstr = strconv.FormatInt(bmd.Version, 10)
 	bo.bmd.Store(bmd)
@@ -327,7 +331,9 @@ func (bo *bmdOwnerPrx) _pre(ctx *bmdModifier) (clone *bucketMD, err error) {
 	if err = ctx.pre(ctx, clone); err != nil || ctx.terminate {
 		return
 	}
-	err = bo.putPersist(clone, nil)
+	if err = bo.putPersist(clone, nil); err == nil {
+		bo.hist.addBMD(clone, ctx.msg, "")
+	}
 	return
 }
 
@@ -533,6 +539,7 @@ func mergeRemoteBckProps(props *cmn.Bprops, header http.Header) *cmn.Bprops {
 		props.Extra.AWS.CloudRegion = header.Get(apc.HdrS3Region)
 		props.Extra.AWS.Endpoint = header.Get(apc.HdrS3Endpoint)
 		props.Extra.AWS.Profile = header.Get(apc.HdrS3Profile)
+		props.Extra.AWS.Preset = header.Get(apc.HdrS3Preset)
 	case apc.HT:
 		props.Extra.HTTP.OrigURLBck = header.Get(apc.HdrOrigURLBck)
 	}
@@ -58,6 +58,7 @@ type (
 		smap    ratomic.Pointer[smapX]
 		sls     *sls
 		fpath   string
+		hist    metaHist
 		immSize int64
 		mu      sync.Mutex
 	}
@@ -580,6 +581,7 @@ func (r *smapOwner) synchronize(si *meta.Snode, newSmap *smapX, payload msPayloa
 	}
 	if err == nil {
 		r.put(newSmap)
+		r.hist.addSmap(newSmap, payloadMsg(payload, revsSmapTag), newSmap.Primary.StringEx())
 	}
 	r.mu.Unlock()
 
@@ -639,6 +641,7 @@ func (r *smapOwner) prepost(ctx *smapModifier) (clone *smapX, err error) {
 		clone._free()
 	}
 	r.put(clone)
+	r.hist.addSmap(clone, ctx.msg, "")
 	if ctx.post != nil {
 		ctx.post(ctx, clone)
 	}
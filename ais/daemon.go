@@ -242,6 +242,7 @@ func initDaemon(version, buildTime string) cos.Runner {
 
 		// aux plumbing
 		nlog.SetTitle(title)
+		nlog.SetNode(p.si.Name())
 		cmn.InitErrs(p.si.Name(), nil)
 
 		// init distributed tracing
@@ -262,6 +263,7 @@ func initDaemon(version, buildTime string) cos.Runner {
 
 	// aux plumbing
 	nlog.SetTitle(title)
+	nlog.SetNode(t.si.Name())
 	cmn.InitErrs(t.si.Name(), fs.CleanPathErr)
 
 	// init distributed tracing
@@ -323,7 +325,8 @@ func newProxy(co *configOwner) *proxy {
 }
 
 func newTarget(co *configOwner) *target {
-	t := &target{backend: make(backends, 8)}
+	t := &target{}
+	t.backend.init(8)
 	t.owner.bmd = newBMDOwnerTgt()
 	t.owner.etl = newEtlMDOwnerTgt()
 	t.owner.config = co
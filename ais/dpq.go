@@ -16,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/filter"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 )
 
@@ -30,6 +31,9 @@ type dpq struct {
 	arch struct {
 		path, mime, regx, mmode string // QparamArchpath et al. (plus archmode below)
 	}
+	presign struct {
+		exp, sig, prefix, tid string // QparamPresignExp, QparamPresignSig, QparamPresignPrefix, QparamPresignTid
+	}
 
 	ptime       string // req timestamp at calling/redirecting proxy (QparamUnixTime)
 	uuid        string // xaction
@@ -37,11 +41,13 @@ type dpq struct {
 	owt         string // object write transaction { OwtPut, ... }
 	fltPresence string // QparamFltPresence
 	etlName     string // QparamETLName
+	filter      string // QparamFilter
 	binfo       string // bucket info, with or without requirement to summarize remote obj-s
 
 	skipVC        bool // QparamSkipVC (skip loading existing object's metadata)
 	isGFN         bool // QparamIsGFNRequest
 	dontAddRemote bool // QparamDontAddRemote
+	bckUsage      bool // QparamBckUsage
 	silent        bool // QparamSilent
 	latestVer     bool // QparamLatestVer
 	isS3          bool // special use: frontend S3 API
@@ -49,6 +55,7 @@ type dpq struct {
 
 var _except = map[string]bool{
 	apc.QparamProxyID:        false,
+	apc.QparamReqID:          false, // (see reqIDQuery)
 	apc.QparamDontHeadRemote: false,
 
 	// flows that utilize the following query parameters perform conventional r.URL.Query()
@@ -144,14 +151,32 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.dontAddRemote = cos.IsParseBool(value)
 		case apc.QparamBinfoWithOrWithoutRemote:
 			dpq.binfo = value
+		case apc.QparamBckUsage:
+			dpq.bckUsage = cos.IsParseBool(value)
 
 		case apc.QparamETLName:
 			dpq.etlName = value
+		case apc.QparamFilter:
+			dpq.filter = value
+			if err = filter.Validate(value); err != nil {
+				return err
+			}
 		case apc.QparamSilent:
 			dpq.silent = cos.IsParseBool(value)
 		case apc.QparamLatestVer:
 			dpq.latestVer = cos.IsParseBool(value)
 
+		case apc.QparamPresignExp:
+			dpq.presign.exp = value
+		case apc.QparamPresignSig:
+			dpq.presign.sig = value
+		case apc.QparamPresignTid:
+			dpq.presign.tid = value
+		case apc.QparamPresignPrefix:
+			if dpq.presign.prefix, err = url.QueryUnescape(value); err != nil {
+				return
+			}
+
 		default: // the key must be known or `_except`-ed
 			if strings.HasPrefix(key, s3.HeaderPrefix) {
 				continue
@@ -43,6 +43,8 @@ func (g *fsprungroup) enableMpath(mpath string) (enabledMi *fs.Mountpath, err er
 	if err != nil || enabledMi == nil {
 		return
 	}
+	g.t.fshc.StopProbation(enabledMi.Path)
+	g.t.clrDiskFault()
 	g._postAdd(apc.ActMountpathEnable, enabledMi)
 	return
 }
@@ -89,7 +91,12 @@ func (g *fsprungroup) disableMpath(mpath string, dontResilver bool) (*fs.Mountpa
 // detachMpath removes mountpath and notifies necessary runners about the
 // change if the mountpath was actually removed.
 func (g *fsprungroup) detachMpath(mpath string, dontResilver bool) (*fs.Mountpath, error) {
-	return g.doDD(apc.ActMountpathDetach, fs.FlagBeingDetached, mpath, dontResilver)
+	detachedMi, err := g.doDD(apc.ActMountpathDetach, fs.FlagBeingDetached, mpath, dontResilver)
+	if err == nil && detachedMi != nil {
+		g.t.fshc.StopProbation(detachedMi.Path)
+		g.t.clrDiskFault()
+	}
+	return detachedMi, err
 }
 
 //
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/3rdparty/golang/mux"
+	"github.com/NVIDIA/aistore/ais/webdav"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/certloader"
@@ -218,6 +219,7 @@ type (
 var htverbs = [...]string{
 	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
 	http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace,
+	webdav.MethodPropfind, webdav.MethodMkcol, // (WebDAV gateway)
 }
 
 var (
@@ -238,6 +240,22 @@ func (e *errSmapUUIDDiffer) Error() string   { return e.detail }
 func (e *errNodeNotFound) Error() string {
 	return fmt.Sprintf("%s: %s node %s not present in the %s", e.si, e.msg, e.id, e.smap)
 }
+
+// cluster membership changed: tell clients to refresh their Smap and retry (see api.RetryPolicy)
+func smapChanged(err error) (smap *smapX) {
+	var (
+		errNNF *errNodeNotFound
+		errNP  *errNotPrimary
+	)
+	switch {
+	case errors.As(err, &errNNF):
+		smap = errNNF.smap
+	case errors.As(err, &errNP):
+		smap = errNP.smap
+	}
+	return smap
+}
+
 func (e *errSelfNotFound) Error() string {
 	return fmt.Sprintf("%s: %s failure: not finding self in the %s %s", e.si, e.act, e.tag, e.smap.StringEx())
 }
@@ -397,11 +415,13 @@ type (
 	cresEI struct{} // -> etl.InfoList
 	cresEL struct{} // -> etl.Logs
 	cresEM struct{} // -> etl.CPUMemUsed
+	cresES struct{} // -> etl.Stats
 	cresIC struct{} // -> icBundle
 	cresBM struct{} // -> bucketMD
 
 	cresLso   struct{} // -> cmn.LsoRes
 	cresBsumm struct{} // -> cmn.AllBsummResults
+	cresBU    struct{} // -> cmn.AllBckUsage
 )
 
 var (
@@ -413,9 +433,11 @@ var (
 	_ cresv = cresEI{}
 	_ cresv = cresEL{}
 	_ cresv = cresEM{}
+	_ cresv = cresES{}
 	_ cresv = cresIC{}
 	_ cresv = cresBM{}
 	_ cresv = cresBsumm{}
+	_ cresv = cresBU{}
 )
 
 func (res *callResult) read(body io.Reader, size int64) {
@@ -458,6 +480,9 @@ func (c cresEL) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresEM) newV() any                              { return &etl.CPUMemUsed{} }
 func (c cresEM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresES) newV() any                              { return &etl.Stats{} }
+func (c cresES) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 func (cresIC) newV() any                              { return &icBundle{} }
 func (c cresIC) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
@@ -467,6 +492,9 @@ func (c cresBM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresBsumm) newV() any                              { return &cmn.AllBsummResults{} }
 func (c cresBsumm) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresBU) newV() any                              { return &cmn.AllBckUsage{} }
+func (c cresBU) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 ////////////////
 // nlogWriter //
 ////////////////
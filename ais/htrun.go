@@ -30,6 +30,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/certloader"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/k8s"
 	"github.com/NVIDIA/aistore/cmn/mono"
@@ -81,6 +82,8 @@ type htrun struct {
 		cluster atomic.Int64 // mono.NanoTime() since cluster startup, zero prior to that
 		node    atomic.Int64 // ditto - for this node
 	}
+	elections electionHistory // recent primary elections (see vote.go)
+	idem      idemCache       // idempotency keys (see idem.go)
 }
 
 ///////////
@@ -300,6 +303,7 @@ func (h *htrun) init(config *cmn.Config) {
 	}
 
 	h.owner.smap = newSmapOwner(config)
+	h.owner.smap.hist.init(filepath.Join(config.ConfigDir, fname.SmapHist), h.si.String())
 	h.owner.rmd = newRMDOwner(config)
 	h.owner.rmd.load()
 
@@ -424,6 +428,8 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		PubNet:     pubAddr,
 		ControlNet: ctrlAddr,
 		DataNet:    dataAddr,
+		Zone:       config.FDomain.Zone,
+		Rack:       config.FDomain.Rack,
 	}
 	if l := len(pubExtra); l > 0 {
 		h.si.PubExtra = make([]meta.NetInfo, l)
@@ -1109,6 +1115,10 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		}
 	case apc.WhatSnode:
 		body = h.si
+	case apc.WhatElections:
+		body = h.elections.get()
+	case apc.WhatSmapHist:
+		body = h.owner.smap.hist.get()
 	case apc.WhatLog:
 		if cos.IsParseBool(query.Get(apc.QparamAllLogs)) {
 			tempdir := h.sendAllLogs(w, r, query)
@@ -1130,6 +1140,8 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		body = statsNode
 	case apc.WhatMetricNames:
 		body = h.statsT.GetMetricNames()
+	case apc.WhatNodeAlerts:
+		body = cos.NodeStateFlags(h.statsT.Get(cos.NodeAlerts))
 	case apc.WhatNodeStatsAndStatusV322:
 		ds := h.statsAndStatusV322()
 		daeStats := h.statsT.GetStatsV322()
@@ -1356,6 +1368,9 @@ func logname2Sev(fname, severity string) bool {
 const Silent = 1
 
 func (*htrun) writeErr(w http.ResponseWriter, r *http.Request, err error, ecode ...int) {
+	if smap := smapChanged(err); smap != nil {
+		w.Header().Set(apc.HdrSmapChanged, strconv.FormatInt(smap.Version, 10))
+	}
 	cmn.WriteErr(w, r, err, ecode...) // [ecode[, silent]]
 }
 
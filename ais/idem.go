@@ -0,0 +1,281 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Idempotency keys: a mutating request (PUT, DELETE, POST) that carries apc.HdrIdempotencyKey
+// executes at most once per key. A retry with the same key (e.g., after an ambiguous network
+// failure) receives the original response - status, headers, and body - without re-executing.
+// In particular:
+//   - proxy caches its own responses, including xaction IDs and redirects;
+//   - target caches the results of the (redirected) object PUT and DELETE.
+//
+// Notes:
+//   - keys are scoped by the caller (token) - the same key sent by another user (or without
+//     a token) is a different key;
+//   - before replaying, proxy re-checks access: the retrying caller must (still) have
+//     the permissions that the original request required (see idemAccess);
+//   - the cache is node-local and short-lived (idemTTL); clients are expected to retry
+//     via the same endpoint;
+//   - a key reused for a different request (method, URL path and query, and body) fails with 422;
+//   - a retry that arrives while the original is still executing fails with 409;
+//   - server errors (5xx) are not cached, so that the request can be retried.
+
+const (
+	idemTTL     = 10 * time.Minute
+	idemMaxKeys = 64 * 1024
+	idemMaxBody = 64 * cos.KiB // larger responses are not cached
+	idemMaxLen  = 256          // max key length
+)
+
+type (
+	// access checked by the original request (see checkAccess)
+	idemAccess struct {
+		bck *meta.Bck
+		ace apc.AccessAttrs
+	}
+	idemEntry struct {
+		hdr     http.Header
+		acc     *idemAccess
+		fp      string // request fingerprint (see idemFP)
+		body    []byte
+		created int64 // mono.NanoTime
+		status  int
+		done    bool
+	}
+	idemCache struct {
+		m      map[string]*idemEntry
+		access func(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) error // nil: no checks (target)
+		mu     sync.Mutex
+	}
+	idemCtxKey struct{}
+	// captures the response
+	idemWriter struct {
+		http.ResponseWriter
+		body   bytes.Buffer
+		status int
+		over   bool // exceeded idemMaxBody
+	}
+)
+
+var (
+	errIdemInProgress = errors.New("request with the same idempotency key is in progress")
+	errIdemMismatch   = errors.New("idempotency key was already used with a different request")
+)
+
+func (c *idemCache) init(access func(http.Header, *meta.Bck, apc.AccessAttrs) error) {
+	c.m = make(map[string]*idemEntry, 64)
+	c.access = access
+	hk.Reg("idempotency"+hk.NameSuffix, c.housekeep, idemTTL/2)
+}
+
+// run the handler at most once for a given (client-supplied) key
+func (c *idemCache) do(w http.ResponseWriter, r *http.Request, handler http.HandlerFunc) {
+	key := r.Header.Get(apc.HdrIdempotencyKey)
+	if key == "" || r.Method == http.MethodGet || r.Method == http.MethodHead {
+		handler(w, r)
+		return
+	}
+	if len(key) > idemMaxLen {
+		cmn.WriteErr(w, r, fmt.Errorf("idempotency key is too long (%d > %d)", len(key), idemMaxLen))
+		return
+	}
+	fp, err := idemFP(r)
+	if err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	key = idemScope(r.Header) + key
+
+	c.mu.Lock()
+	if e, ok := c.m[key]; ok {
+		// (the entry is written by the original request - copy under lock)
+		var (
+			res  idemEntry
+			acc  idemAccess
+			same = e.fp == fp
+		)
+		if same && e.done {
+			res.hdr, res.body, res.status = e.hdr, e.body, e.status
+			if e.acc != nil {
+				acc = *e.acc
+			}
+		}
+		done := e.done
+		c.mu.Unlock()
+		switch {
+		case !same:
+			cmn.WriteErr(w, r, errIdemMismatch, http.StatusUnprocessableEntity)
+		case !done:
+			cmn.WriteErr(w, r, errIdemInProgress, http.StatusConflict)
+		default:
+			// (when acc.bck is nil: validate token only)
+			if c.access != nil {
+				if err := c.access(r.Header, acc.bck, acc.ace); err != nil {
+					cmn.WriteErr(w, r, err, aceErrToCode(err))
+					return
+				}
+			}
+			res.replay(w)
+			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+				nlog.Infoln("idempotency key", key, "- replaying", fp, res.status)
+			}
+		}
+		return
+	}
+	if len(c.m) >= idemMaxKeys {
+		c.mu.Unlock()
+		handler(w, r) // (not caching)
+		return
+	}
+	e := &idemEntry{fp: fp, created: mono.NanoTime(), acc: &idemAccess{}}
+	c.m[key] = e
+	c.mu.Unlock()
+
+	var (
+		iw       = &idemWriter{ResponseWriter: w}
+		returned bool
+	)
+	defer func() {
+		c.mu.Lock()
+		if iw.status == 0 && returned {
+			iw.status = http.StatusOK
+		}
+		// not caching: panic, server error, or too large
+		if !returned || iw.status >= http.StatusInternalServerError || iw.over {
+			delete(c.m, key)
+		} else {
+			e.hdr, e.body, e.status, e.done = w.Header().Clone(), iw.body.Bytes(), iw.status, true
+		}
+		c.mu.Unlock()
+	}()
+	handler(iw, r.WithContext(context.WithValue(r.Context(), idemCtxKey{}, e.acc)))
+	returned = true
+}
+
+// request fingerprint: method, URL path, query (excluding parameters added by the proxy
+// upon redirect), and body - small bodies (e.g., control messages) are read and hashed in full;
+// larger (e.g., object PUT) are represented by their content length and checksum, if provided
+func idemFP(r *http.Request) (string, error) {
+	q := r.URL.Query()
+	q.Del(apc.QparamProxyID)
+	q.Del(apc.QparamUnixTime)
+	q.Del(apc.QparamReqID)
+
+	h := sha256.New()
+	h.Write(cos.UnsafeB(q.Encode()))
+	switch {
+	case r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0:
+	case r.ContentLength > 0 && r.ContentLength <= idemMaxBody:
+		b, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(b))
+		h.Write([]byte{0})
+		h.Write(b)
+	default:
+		h.Write([]byte{0})
+		h.Write(cos.UnsafeB(strconv.FormatInt(r.ContentLength, 10)))
+		h.Write(cos.UnsafeB(r.Header.Get(apc.HdrObjCksumType)))
+		h.Write(cos.UnsafeB(r.Header.Get(apc.HdrObjCksumVal)))
+	}
+	sum := h.Sum(nil)
+	return r.Method + " " + r.URL.Path + " " + hex.EncodeToString(sum[:16]), nil
+}
+
+// caller's scope: hash of the token (if any)
+func idemScope(hdr http.Header) string {
+	token, err := tok.ExtractToken(hdr)
+	if err != nil || token == "" {
+		return "/"
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16]) + "/"
+}
+
+// record the access that the original request required (see checkAccess)
+func idemRecordAccess(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) {
+	if acc, ok := r.Context().Value(idemCtxKey{}).(*idemAccess); ok {
+		acc.bck, acc.ace = bck, acc.ace|ace
+	}
+}
+
+func (c *idemCache) housekeep(now int64) time.Duration {
+	c.mu.Lock()
+	for key, e := range c.m {
+		if time.Duration(now-e.created) > idemTTL {
+			delete(c.m, key)
+		}
+	}
+	c.mu.Unlock()
+	return idemTTL / 2
+}
+
+func (e *idemEntry) replay(w http.ResponseWriter) {
+	hdr := w.Header()
+	for k, v := range e.hdr {
+		hdr[k] = v
+	}
+	w.WriteHeader(e.status)
+	if len(e.body) > 0 {
+		w.Write(e.body)
+	}
+}
+
+////////////////
+// idemWriter //
+////////////////
+
+func (iw *idemWriter) WriteHeader(status int) {
+	if iw.status == 0 {
+		iw.status = status
+	}
+	iw.ResponseWriter.WriteHeader(status)
+}
+
+func (iw *idemWriter) Write(b []byte) (int, error) {
+	if iw.status == 0 {
+		iw.status = http.StatusOK
+	}
+	if !iw.over {
+		if iw.body.Len()+len(b) > idemMaxBody {
+			iw.over = true
+			iw.body.Reset()
+		} else {
+			iw.body.Write(b)
+		}
+	}
+	return iw.ResponseWriter.Write(b)
+}
+
+func (iw *idemWriter) Flush() {
+	if f, ok := iw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,203 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	var (
+		c      = idemCache{m: make(map[string]*idemEntry)}
+		cnt    int
+		status = http.StatusOK
+	)
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		cnt++
+		w.Header().Set(apc.HdrXactionID, "xid")
+		w.WriteHeader(status)
+		w.Write([]byte("xid"))
+	}
+	call := func(method, path, key string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, http.NoBody)
+		if key != "" {
+			r.Header.Set(apc.HdrIdempotencyKey, key)
+		}
+		w := httptest.NewRecorder()
+		c.do(w, r, handler)
+		return w
+	}
+
+	// executed once, replayed thereafter
+	for range 3 {
+		w := call(http.MethodPut, "/v1/cluster", "k1")
+		tassert.Errorf(t, w.Code == http.StatusOK && w.Body.String() == "xid", "unexpected response %d %q", w.Code, w.Body.String())
+		tassert.Errorf(t, w.Header().Get(apc.HdrXactionID) == "xid", "expected header to be replayed")
+	}
+	tassert.Errorf(t, cnt == 1, "expected one execution, got %d", cnt)
+
+	// no key, or GET
+	call(http.MethodPut, "/v1/cluster", "")
+	call(http.MethodGet, "/v1/cluster", "k1")
+	tassert.Errorf(t, cnt == 3, "expected three executions, got %d", cnt)
+
+	// same key, different request
+	w := call(http.MethodDelete, "/v1/objects/abc/obj", "k1")
+	tassert.Errorf(t, w.Code == http.StatusUnprocessableEntity, "expected 422, got %d", w.Code)
+
+	// server errors are not cached
+	status = http.StatusInternalServerError
+	call(http.MethodDelete, "/v1/objects/abc/obj", "k2")
+	status = http.StatusOK
+	w = call(http.MethodDelete, "/v1/objects/abc/obj", "k2")
+	tassert.Errorf(t, w.Code == http.StatusOK && cnt == 5, "expected retry to execute, got %d (cnt %d)", w.Code, cnt)
+
+	// in progress
+	fp, _ := idemFP(httptest.NewRequest(http.MethodPost, "/v1/buckets/abc", http.NoBody))
+	c.m["/k3"] = &idemEntry{fp: fp}
+	w = call(http.MethodPost, "/v1/buckets/abc", "k3")
+	tassert.Errorf(t, w.Code == http.StatusConflict, "expected 409, got %d", w.Code)
+
+	// panicking handler: not cached
+	func() {
+		defer func() { recover() }()
+		c.do(httptest.NewRecorder(), newIdemReq(http.MethodPost, "/v1/buckets/abc", "k4", ""), func(http.ResponseWriter, *http.Request) {
+			panic("handler")
+		})
+	}()
+	tassert.Errorf(t, len(c.m) == 3, "expected panicking request not to be cached (%d entries)", len(c.m))
+}
+
+func TestIdempotencyKeysFingerprint(t *testing.T) {
+	var (
+		c   = idemCache{m: make(map[string]*idemEntry)}
+		cnt int
+	)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		tassert.CheckFatal(t, err)
+		cnt++
+		w.Write(b)
+	}
+	call := func(url, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, url, strings.NewReader(body))
+		r.Header.Set(apc.HdrIdempotencyKey, "k1")
+		w := httptest.NewRecorder()
+		c.do(w, r, handler)
+		return w
+	}
+
+	w := call("/v1/buckets/abc?"+apc.QparamProvider+"=ais", `{"action":"copy-bck"}`)
+	tassert.Fatalf(t, w.Code == http.StatusOK && w.Body.String() == `{"action":"copy-bck"}`, "body not passed through: %q", w.Body.String())
+
+	// same request, redirect-added parameters notwithstanding
+	w = call("/v1/buckets/abc?"+apc.QparamProvider+"=ais&"+apc.QparamUnixTime+"=123", `{"action":"copy-bck"}`)
+	tassert.Errorf(t, w.Code == http.StatusOK && cnt == 1, "expected replay, got %d (cnt %d)", w.Code, cnt)
+
+	// different query or body
+	w = call("/v1/buckets/abc?"+apc.QparamProvider+"=gcp", `{"action":"copy-bck"}`)
+	tassert.Errorf(t, w.Code == http.StatusUnprocessableEntity, "expected 422 (query), got %d", w.Code)
+	w = call("/v1/buckets/abc?"+apc.QparamProvider+"=ais", `{"action":"etl-bck"}`)
+	tassert.Errorf(t, w.Code == http.StatusUnprocessableEntity, "expected 422 (body), got %d", w.Code)
+	tassert.Errorf(t, cnt == 1, "expected one execution, got %d", cnt)
+}
+
+// retries racing with the original request (run with -race)
+func TestIdempotencyKeysConcurrent(t *testing.T) {
+	var (
+		c       = idemCache{m: make(map[string]*idemEntry)}
+		bck     = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+		cnt     atomic.Int32
+		wg      sync.WaitGroup
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			cnt.Inc()
+			idemRecordAccess(r, bck, apc.AcePUT)
+			w.Header().Set(apc.HdrXactionID, "xid")
+			w.Write([]byte("xid"))
+		}
+	)
+	c.access = func(http.Header, *meta.Bck, apc.AccessAttrs) error { return nil }
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				w := httptest.NewRecorder()
+				c.do(w, newIdemReq(http.MethodPut, "/v1/objects/abc/obj", "k1", ""), handler)
+				tassert.Errorf(t, w.Code == http.StatusOK || w.Code == http.StatusConflict, "unexpected %d", w.Code)
+			}
+		}()
+	}
+	wg.Wait()
+	tassert.Errorf(t, cnt.Load() == 1, "expected one execution, got %d", cnt.Load())
+}
+
+func newIdemReq(method, path, key, token string) *http.Request {
+	r := httptest.NewRequest(method, path, http.NoBody)
+	r.Header.Set(apc.HdrIdempotencyKey, key)
+	if token != "" {
+		r.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+token)
+	}
+	return r
+}
+
+func TestIdempotencyKeysScope(t *testing.T) {
+	var (
+		bck     = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+		denied  = map[string]bool{}
+		c       = idemCache{m: make(map[string]*idemEntry)}
+		cnt     int
+		checked apc.AccessAttrs
+	)
+	// a (proxy) handler that checks access
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		idemRecordAccess(r, bck, apc.AcePUT)
+		cnt++
+		w.Write([]byte("xid"))
+	}
+	c.access = func(hdr http.Header, b *meta.Bck, ace apc.AccessAttrs) error {
+		checked = ace
+		if b != bck {
+			return errors.New("wrong bucket")
+		}
+		if token, _ := tok.ExtractToken(hdr); denied[token] {
+			return tok.ErrInvalidToken
+		}
+		return nil
+	}
+	call := func(token string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c.do(w, newIdemReq(http.MethodPut, "/v1/objects/abc/obj", "k1", token), handler)
+		return w
+	}
+
+	call("alice")
+	w := call("alice")
+	tassert.Errorf(t, cnt == 1 && w.Body.String() == "xid", "expected replay, got %d executions", cnt)
+	tassert.Errorf(t, checked == apc.AcePUT, "expected access check upon replay, got %v", checked)
+
+	// other users (and token-less clients) never get alice's response
+	call("bob")
+	call("")
+	tassert.Errorf(t, cnt == 3, "expected separate executions per caller, got %d", cnt)
+
+	// access is re-checked before replaying
+	denied["alice"] = true
+	w = call("alice")
+	tassert.Errorf(t, w.Code == http.StatusUnauthorized && cnt == 3, "expected 401 w/o execution, got %d (cnt %d)", w.Code, cnt)
+}
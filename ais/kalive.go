@@ -38,6 +38,7 @@ type (
 	keepaliver interface {
 		sendKalive(*smapX, time.Duration, int64 /*now*/, bool) (string, int, error)
 		heardFrom(sid string) int64
+		lastHeard(sid string) int64
 		do(config *cmn.Config) (stopped bool)
 		timeToPing(sid string) bool
 		ctrl(msg string)
@@ -74,6 +75,7 @@ type (
 	hbTracker interface {
 		HeardFrom(id string, now int64) int64 // callback for 'id' to respond
 		TimedOut(id string) bool              // true if 'id` didn't keepalive or called (via "heard") within the interval (above)
+		LastHeard(id string) int64            // mono-time of the last keepalive (or call) from 'id'; zero if never
 
 		reg(id string)
 		set(interval time.Duration) bool
@@ -159,6 +161,9 @@ func (tkr *talive) do(config *cmn.Config) (stopped bool) {
 		return
 	}
 	if stopped = tkr.keepalive.do(smap, tkr.t.si, config); stopped {
+		if !tkr.t.confirmPrimaryDown(smap, config) {
+			return false
+		}
 		tkr.t.onPrimaryDown(nil /*proxy*/, "")
 	}
 	return
@@ -241,6 +246,9 @@ func (pkr *palive) do(config *cmn.Config) (stopped bool) {
 		return
 	}
 	if stopped = pkr.keepalive.do(smap, pkr.p.si, config); stopped {
+		if !pkr.p.confirmPrimaryDown(smap, config) {
+			return false
+		}
 		pkr.p.onPrimaryDown(pkr.p /*self*/, "")
 	}
 	return
@@ -472,6 +480,8 @@ func (k *keepalive) heardFrom(sid string) int64 {
 	return k.hb.HeardFrom(sid, 0 /*now*/)
 }
 
+func (k *keepalive) lastHeard(sid string) int64 { return k.hb.LastHeard(sid) }
+
 // wait for stats-runner to set startedUp=true
 func (k *keepalive) wait() (stopped bool) {
 	var ticker *time.Ticker
@@ -691,6 +701,14 @@ func (hb *heartBeat) TimedOut(id string) bool {
 	return mono.Since(tim) > hb.interval
 }
 
+func (hb *heartBeat) LastHeard(id string) int64 {
+	v, ok := hb.last.Load(id)
+	if !ok {
+		return 0
+	}
+	return ratomic.LoadInt64(v.(*int64))
+}
+
 func (hb *heartBeat) reg(id string) { hb.last.Store(id, new(int64)) }
 
 func (hb *heartBeat) set(interval time.Duration) (changed bool) {
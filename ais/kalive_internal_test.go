@@ -5,8 +5,11 @@
 package ais
 
 import (
+	"strconv"
 	"testing"
 	"time"
+
+	"github.com/NVIDIA/aistore/core/meta"
 )
 
 func TestHB(t *testing.T) {
@@ -48,4 +51,25 @@ func TestHB(t *testing.T) {
 	if !hb.TimedOut(id1) {
 		t.Fatal("Expecting timeout")
 	}
+
+	if hb.LastHeard("unknown server") != 0 {
+		t.Fatal("Expecting zero last-heard time for unknown server")
+	}
+	if last := hb.HeardFrom(id2, 0 /*now*/); hb.LastHeard(id2) != last {
+		t.Fatalf("Expecting last-heard time %d, got %d", last, hb.LastHeard(id2))
+	}
+}
+
+func TestElectionHistory(t *testing.T) {
+	var eh electionHistory
+	for i := range maxElectionHistory + 5 {
+		eh.add(&meta.Election{Candidate: strconv.Itoa(i)})
+	}
+	recs := eh.get()
+	if len(recs) != maxElectionHistory {
+		t.Fatalf("Expecting %d records, got %d", maxElectionHistory, len(recs))
+	}
+	if first, last := recs[0].Candidate, recs[len(recs)-1].Candidate; first != "5" || last != strconv.Itoa(maxElectionHistory+4) {
+		t.Fatalf("Expecting the most recent records, got [%s ... %s]", first, last)
+	}
 }
@@ -0,0 +1,323 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// bounded persistent history of recent cluster map and BMD versions, to reconstruct
+// what changed (and when) - e.g., in the aftermath of an incident:
+// - Smap: all nodes (apc.WhatSmapHist)
+// - BMD:  proxies (apc.WhatBMDHist)
+// - stored under local config dir (fname.SmapHist, fname.BmdHist)
+// - the oldest record carries all nodes (buckets); each subsequent one - only the nodes (buckets)
+//   that were added, changed, or removed; full versions are reconstructed upon request (see get)
+// - add() is called under Smap (BMD) owner's lock and only queues the new (immutable) version;
+//   computing the changes and persisting happen in the background, one goroutine at a time
+
+const maxMetaHist = 16
+
+type (
+	mhRec struct {
+		meta.MetaHist                        // (Smap or BMD header only - no nodes, no buckets)
+		Nodes         meta.NodeMap           `json:"nodes,omitempty"` // Smap: added or changed nodes
+		Bcks          map[string]*cmn.Bprops `json:"bcks,omitempty"`  // BMD: added or changed buckets (by uname)
+		Gone          []string               `json:"gone,omitempty"`  // removed nodes (IDs) or buckets (unames)
+	}
+	mhState struct {
+		nodes meta.NodeMap
+		bcks  map[string]*cmn.Bprops
+	}
+	metaHist struct {
+		fpath   string
+		self    string          // this node - the actor of locally made changes (when primary)
+		recs    []mhRec         // oldest first
+		pending []meta.MetaHist // queued by add(), not yet folded into recs
+		cur     mhState         // latest version's nodes and buckets
+		mu      sync.Mutex
+		dirty   bool // recs changed since last saved
+		saving  bool // persisting goroutine is running
+	}
+)
+
+func (mh *metaHist) init(fpath, self string) {
+	mh.fpath, mh.self = fpath, self
+	if _, err := jsp.Load(fpath, &mh.recs, jsp.Plain()); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln("failed to load", fpath, "err:", err)
+		}
+		mh.recs = nil
+	}
+	mh.cur = mhState{}
+	for i := range mh.recs {
+		mh.cur.apply(&mh.recs[i])
+	}
+}
+
+func (mh *metaHist) addSmap(smap *smapX, msg *apc.ActMsg, actor string) {
+	mh.add(&meta.MetaHist{Smap: &smap.Smap, Version: smap.Version}, msg, actor)
+}
+
+func (mh *metaHist) addBMD(bmd *bucketMD, msg *apc.ActMsg, actor string) {
+	mh.add(&meta.MetaHist{BMD: &bmd.BMD, Version: bmd.Version}, msg, actor)
+}
+
+func (mh *metaHist) add(rec *meta.MetaHist, msg *apc.ActMsg, actor string) {
+	if mh.fpath == "" {
+		return // not initialized (e.g., unit tests)
+	}
+	rec.Time = time.Now()
+	rec.Actor = actor
+	if actor == "" {
+		rec.Actor = mh.self
+	}
+	if msg != nil {
+		rec.Action, rec.Name = msg.Action, msg.Name
+	}
+
+	mh.mu.Lock()
+	if mh.lastVer() == rec.Version {
+		mh.mu.Unlock()
+		return
+	}
+	if len(mh.pending) >= maxMetaHist {
+		n := copy(mh.pending, mh.pending[len(mh.pending)-maxMetaHist+1:])
+		mh.pending = mh.pending[:n]
+	}
+	mh.pending = append(mh.pending, *rec)
+	if !mh.saving {
+		mh.saving = true
+		go mh.persist()
+	}
+	mh.mu.Unlock()
+}
+
+// under lock
+func (mh *metaHist) lastVer() int64 {
+	if l := len(mh.pending); l > 0 {
+		return mh.pending[l-1].Version
+	}
+	if l := len(mh.recs); l > 0 {
+		return mh.recs[l-1].Version
+	}
+	return 0
+}
+
+func (mh *metaHist) persist() {
+	for {
+		mh.mu.Lock()
+		mh.fold()
+		if !mh.dirty {
+			mh.saving = false
+			mh.mu.Unlock()
+			return
+		}
+		mh.dirty = false
+		recs := slices.Clone(mh.recs) // (records are never modified in place)
+		mh.mu.Unlock()
+
+		if err := jsp.Save(mh.fpath, recs, jsp.Plain(), nil /*wto*/); err != nil {
+			nlog.Errorln("failed to persist", mh.fpath, "err:", err)
+		}
+	}
+}
+
+// under lock: pending versions => records (changes only)
+func (mh *metaHist) fold() {
+	for i := range mh.pending {
+		var (
+			full = &mh.pending[i]
+			rec  = mhRec{MetaHist: *full}
+			nst  = mhState{}
+		)
+		switch {
+		case full.Smap != nil:
+			nst.nodes = make(meta.NodeMap, len(full.Smap.Pmap)+len(full.Smap.Tmap))
+			for _, m := range []meta.NodeMap{full.Smap.Pmap, full.Smap.Tmap} {
+				for id, si := range m {
+					nst.nodes[id] = si
+				}
+			}
+			hdr := *full.Smap
+			hdr.Pmap, hdr.Tmap = nil, nil
+			rec.Smap = &hdr
+		case full.BMD != nil:
+			nst.bcks = make(map[string]*cmn.Bprops, 16)
+			full.BMD.Range(nil, nil, func(bck *meta.Bck) bool {
+				nst.bcks[string(bck.MakeUname(""))] = bck.Props
+				return false
+			})
+			hdr := *full.BMD
+			hdr.Providers = nil
+			rec.BMD = &hdr
+		}
+		rec.Nodes, rec.Bcks, rec.Gone = mh.cur.diff(&nst)
+		mh.cur = nst
+
+		if len(mh.recs) >= maxMetaHist {
+			// squash the two oldest records: the second one becomes full
+			var st mhState
+			st.apply(&mh.recs[0])
+			st.apply(&mh.recs[1])
+			base := mh.recs[1]
+			base.Nodes, base.Bcks, base.Gone = st.nodes, st.bcks, nil
+			n := copy(mh.recs, mh.recs[len(mh.recs)-maxMetaHist+1:])
+			mh.recs = mh.recs[:n]
+			mh.recs[0] = base
+		}
+		mh.recs = append(mh.recs, rec)
+		mh.dirty = true
+	}
+	clear(mh.pending)
+	mh.pending = mh.pending[:0]
+}
+
+// full versions, oldest first
+func (mh *metaHist) get() []meta.MetaHist {
+	mh.mu.Lock()
+	defer mh.mu.Unlock()
+	mh.fold()
+
+	var (
+		st  mhState
+		out = make([]meta.MetaHist, len(mh.recs))
+	)
+	for i := range mh.recs {
+		rec := &mh.recs[i]
+		st.apply(rec)
+		out[i] = rec.MetaHist
+		switch {
+		case rec.Smap != nil:
+			out[i].Smap = st.smap(rec.Smap)
+		case rec.BMD != nil:
+			out[i].BMD = st.bmd(rec.BMD)
+		}
+	}
+	return out
+}
+
+/////////////
+// mhState //
+/////////////
+
+func (st *mhState) apply(rec *mhRec) {
+	if rec.Smap != nil {
+		nodes := make(meta.NodeMap, len(st.nodes)+len(rec.Nodes))
+		for id, si := range st.nodes {
+			nodes[id] = si
+		}
+		for _, id := range rec.Gone {
+			delete(nodes, id)
+		}
+		for id, si := range rec.Nodes {
+			nodes[id] = si
+		}
+		st.nodes = nodes
+	}
+	if rec.BMD != nil {
+		bcks := make(map[string]*cmn.Bprops, len(st.bcks)+len(rec.Bcks))
+		for uname, props := range st.bcks {
+			bcks[uname] = props
+		}
+		for _, uname := range rec.Gone {
+			delete(bcks, uname)
+		}
+		for uname, props := range rec.Bcks {
+			bcks[uname] = props
+		}
+		st.bcks = bcks
+	}
+}
+
+// changes from `st` to `nst`
+func (st *mhState) diff(nst *mhState) (nodes meta.NodeMap, bcks map[string]*cmn.Bprops, gone []string) {
+	for id, si := range nst.nodes {
+		if osi, ok := st.nodes[id]; !ok || !snodeEq(osi, si) {
+			if nodes == nil {
+				nodes = make(meta.NodeMap, 4)
+			}
+			nodes[id] = si
+		}
+	}
+	for uname, props := range nst.bcks {
+		if oprops, ok := st.bcks[uname]; !ok || *oprops != *props {
+			if bcks == nil {
+				bcks = make(map[string]*cmn.Bprops, 4)
+			}
+			bcks[uname] = props
+		}
+	}
+	for id := range st.nodes {
+		if _, ok := nst.nodes[id]; !ok {
+			gone = append(gone, id)
+		}
+	}
+	for uname := range st.bcks {
+		if _, ok := nst.bcks[uname]; !ok {
+			gone = append(gone, uname)
+		}
+	}
+	return nodes, bcks, gone
+}
+
+func (st *mhState) smap(hdr *meta.Smap) *meta.Smap {
+	smap := *hdr
+	smap.Pmap, smap.Tmap = make(meta.NodeMap, 4), make(meta.NodeMap, len(st.nodes))
+	for id, si := range st.nodes {
+		if si.IsProxy() {
+			smap.Pmap[id] = si
+		} else {
+			smap.Tmap[id] = si
+		}
+	}
+	if hdr.Primary != nil {
+		if psi, ok := smap.Pmap[hdr.Primary.ID()]; ok {
+			smap.Primary = psi
+		}
+	}
+	return &smap
+}
+
+func (st *mhState) bmd(hdr *meta.BMD) *meta.BMD {
+	bmd := *hdr
+	bmd.Providers = make(meta.Providers, 2)
+	for uname, props := range st.bcks {
+		b, _ := cmn.ParseUname(uname)
+		bck := meta.CloneBck(&b)
+		bck.Props = props
+		bmd.Add(bck)
+	}
+	return &bmd
+}
+
+func snodeEq(a, b *meta.Snode) bool {
+	return a.DaeType == b.DaeType && a.Flags == b.Flags && a.Zone == b.Zone && a.Rack == b.Rack &&
+		a.PubNet == b.PubNet && a.ControlNet == b.ControlNet && a.DataNet == b.DataNet &&
+		slices.Equal(a.PubExtra, b.PubExtra)
+}
+
+// action message that comes with metasync payload (compare w/ htrun.extractSmap et al.)
+func payloadMsg(payload msPayload, tag string) *apc.ActMsg {
+	msgValue, ok := payload[tag+revsActionTag]
+	if !ok {
+		return nil
+	}
+	msg := &apc.ActMsg{}
+	if err := jsoniter.Unmarshal(msgValue, msg); err != nil {
+		return nil
+	}
+	return msg
+}
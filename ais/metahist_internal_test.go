@@ -0,0 +1,168 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// wait for the background goroutine to persist all records
+func (mh *metaHist) wait(t *testing.T) {
+	for i := 0; i < 500; i++ {
+		mh.mu.Lock()
+		saving := mh.saving
+		mh.mu.Unlock()
+		if !saving {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for metahist to persist")
+}
+
+func TestMetaHist(t *testing.T) {
+	var (
+		mh    metaHist
+		fpath = filepath.Join(t.TempDir(), "smap.hist")
+		self  = meta.Pname("primary")
+		psi   = &meta.Snode{DaeID: "p1", DaeType: apc.Proxy}
+	)
+	mh.init(fpath, self)
+	tassert.Fatalf(t, len(mh.get()) == 0, "expected empty history")
+
+	// each version: one more target
+	const num = maxMetaHist + 5
+	tmap := meta.NodeMap{}
+	for ver := int64(1); ver <= num; ver++ {
+		tid := "t" + strconv.FormatInt(ver, 10)
+		tmap[tid] = &meta.Snode{DaeID: tid, DaeType: apc.Target, PubNet: meta.NetInfo{URL: "http://" + tid}}
+		smap := &smapX{Smap: meta.Smap{Version: ver, UUID: "uuid", Primary: psi, Pmap: meta.NodeMap{"p1": psi}}}
+		smap.Tmap = make(meta.NodeMap, len(tmap))
+		for id, si := range tmap {
+			smap.Tmap[id] = si
+		}
+		mh.addSmap(smap, &apc.ActMsg{Action: apc.ActSelfJoinTarget, Name: tid}, "")
+		mh.addSmap(smap, nil, "p[other]") // same version: ignored
+	}
+
+	recs := mh.get()
+	tassert.Fatalf(t, len(recs) == maxMetaHist, "expected %d records, got %d", maxMetaHist, len(recs))
+	first, last := recs[0], recs[len(recs)-1]
+	tassert.Errorf(t, first.Version == num-maxMetaHist+1, "expected oldest v%d, got v%d", num-maxMetaHist+1, first.Version)
+	tassert.Errorf(t, last.Version == num && last.Smap.Version == num, "expected latest v%d, got v%d", num, last.Version)
+	tassert.Errorf(t, last.Actor == self && last.Action == apc.ActSelfJoinTarget && last.Name == "t"+strconv.Itoa(num),
+		"unexpected record %+v", last)
+	for i := range recs {
+		smap := recs[i].Smap
+		tassert.Errorf(t, len(smap.Tmap) == int(recs[i].Version) && len(smap.Pmap) == 1,
+			"v%d: expected %d targets and 1 proxy, got %d and %d", recs[i].Version, recs[i].Version, len(smap.Tmap), len(smap.Pmap))
+		tassert.Errorf(t, smap.Primary != nil && smap.Primary.ID() == "p1", "v%d: expected primary p1", recs[i].Version)
+	}
+
+	// stored: full oldest version, and then only the added target
+	mh.wait(t)
+	mh.mu.Lock()
+	tassert.Errorf(t, len(mh.recs[0].Nodes) == int(first.Version)+1, "expected full oldest record, got %d nodes", len(mh.recs[0].Nodes))
+	for i := 1; i < len(mh.recs); i++ {
+		rec := &mh.recs[i]
+		tassert.Errorf(t, len(rec.Nodes) == 1 && len(rec.Gone) == 0 && len(rec.Smap.Tmap) == 0,
+			"v%d: expected a single added node, got %d (gone %d)", rec.Version, len(rec.Nodes), len(rec.Gone))
+	}
+	mh.mu.Unlock()
+
+	// reload from disk
+	var mh2 metaHist
+	mh2.init(fpath, self)
+	recs2 := mh2.get()
+	tassert.Fatalf(t, len(recs2) == len(recs), "expected %d persisted records, got %d", len(recs), len(recs2))
+	for i := range recs {
+		tassert.Errorf(t, recs2[i].Version == recs[i].Version && recs2[i].Smap.UUID == "uuid",
+			"record %d: expected v%d, got %+v", i, recs[i].Version, recs2[i])
+		tassert.Errorf(t, len(recs2[i].Smap.Tmap) == len(recs[i].Smap.Tmap), "record %d: targets mismatch", i)
+		tassert.Errorf(t, recs2[i].Time.Equal(recs[i].Time), "record %d: time mismatch", i)
+	}
+
+	// and continue from there: a node leaves
+	smap := &smapX{Smap: meta.Smap{Version: num + 1, UUID: "uuid", Primary: psi, Pmap: meta.NodeMap{"p1": psi}, Tmap: meta.NodeMap{}}}
+	for id, si := range tmap {
+		if id != "t1" {
+			smap.Tmap[id] = si
+		}
+	}
+	mh2.addSmap(smap, &apc.ActMsg{Action: apc.ActDecommissionNode, Name: "t1"}, "")
+	recs2 = mh2.get()
+	last = recs2[len(recs2)-1]
+	_, ok := last.Smap.Tmap["t1"]
+	tassert.Errorf(t, last.Version == num+1 && !ok && len(last.Smap.Tmap) == num-1, "expected t1 removed, got %+v", last.Smap.Tmap)
+	mh2.wait(t)
+	tassert.Errorf(t, len(mh2.recs[len(mh2.recs)-1].Gone) == 1, "expected one removed node")
+}
+
+func TestMetaHistBMD(t *testing.T) {
+	var (
+		mh    metaHist
+		fpath = filepath.Join(t.TempDir(), "bmd.hist")
+		bmd   = newBucketMD()
+		bcks  = []*meta.Bck{
+			meta.NewBck("abc", apc.AIS, cmn.NsGlobal),
+			meta.NewBck("def", apc.AIS, cmn.NsGlobal),
+		}
+	)
+	mh.init(fpath, "p[primary]")
+
+	// add, modify, and remove buckets
+	bmd.UUID = "uuid"
+	for _, bck := range bcks {
+		bmd = bmd.clone()
+		bmd.Version++
+		bmd.add(bck, &cmn.Bprops{BID: uint64(bmd.Version)})
+		mh.addBMD(bmd, &apc.ActMsg{Action: apc.ActCreateBck, Name: bck.Name}, "")
+	}
+	bmd = bmd.clone()
+	bmd.Version++
+	props, _ := bmd.Get(bcks[0])
+	nprops := props.Clone()
+	nprops.Frozen = true
+	bmd.set(bcks[0], nprops)
+	mh.addBMD(bmd, &apc.ActMsg{Action: apc.ActSetBprops, Name: bcks[0].Name}, "")
+
+	bmd = bmd.clone()
+	bmd.Version++
+	bmd.del(bcks[1])
+	mh.addBMD(bmd, &apc.ActMsg{Action: apc.ActDestroyBck, Name: bcks[1].Name}, "")
+	mh.wait(t)
+
+	var mh2 metaHist
+	mh2.init(fpath, "")
+	recs := mh2.get()
+	tassert.Fatalf(t, len(recs) == 4, "expected 4 records, got %d", len(recs))
+	for i, expected := range []int{1, 2, 2, 1} {
+		var n int
+		recs[i].BMD.Range(nil, nil, func(*meta.Bck) bool { n++; return false })
+		tassert.Errorf(t, n == expected, "v%d: expected %d buckets, got %d", recs[i].Version, expected, n)
+	}
+	p2, ok := recs[2].BMD.Get(bcks[0])
+	tassert.Errorf(t, ok && p2.Frozen, "v%d: expected %s frozen", recs[2].Version, bcks[0])
+	p1, ok := recs[1].BMD.Get(bcks[0])
+	tassert.Errorf(t, ok && !p1.Frozen, "v%d: expected %s not frozen", recs[1].Version, bcks[0])
+	_, ok = recs[3].BMD.Get(bcks[1])
+	tassert.Errorf(t, !ok, "v%d: expected %s removed", recs[3].Version, bcks[1])
+
+	mh2.mu.Lock()
+	for i := 1; i < len(mh2.recs); i++ {
+		rec := &mh2.recs[i]
+		tassert.Errorf(t, len(rec.Bcks)+len(rec.Gone) == 1, "v%d: expected a single change, got %d (gone %d)",
+			rec.Version, len(rec.Bcks), len(rec.Gone))
+	}
+	mh2.mu.Unlock()
+}
@@ -133,6 +133,7 @@ func (c *lstcx) do() (string, error) {
 		}
 		lr.ObjNames = append(lr.ObjNames, e.Name)
 	}
+	c.truncate(lr, 0)
 
 	// 5. multi-obj action: transform/copy 1st page
 	c.altmsg.Value = &c.tcomsg
@@ -149,7 +150,7 @@ func (c *lstcx) do() (string, error) {
 	s := fmt.Sprintf("%s[%s] %s => %s", c.altmsg.Action, c.xid, c.bckFrom, c.bckTo)
 
 	// 6. more pages, if any
-	if lst.ContinuationToken != "" {
+	if lst.ContinuationToken != "" && !c.quota(len(lr.ObjNames)) {
 		// Run
 		nlog.Infoln("run", s, "...")
 		c.lsmsg.ContinuationToken = lst.ContinuationToken
@@ -166,7 +167,7 @@ func (c *lstcx) pages(s string, cnt int) {
 
 	// pages 2, 3, ...
 	var err error
-	for !c.stopped.Load() && c.lsmsg.ContinuationToken != "" {
+	for !c.stopped.Load() && c.lsmsg.ContinuationToken != "" && !c.quota(c.cnt) {
 		if cnt, err = c._page(); err != nil {
 			break
 		}
@@ -197,12 +198,27 @@ func (c *lstcx) _page() (int, error) {
 		}
 		lr.ObjNames = append(lr.ObjNames, e.Name)
 	}
+	c.truncate(lr, c.cnt)
 	c.altmsg.Name = c.xid
 	c.altmsg.Value = &c.tcomsg
 	err = c.bcast()
 	return len(lr.ObjNames), err
 }
 
+// apc.JobQuota: no need to list (and send) more than max-objects names
+// (targets further enforce their respective shares - see xs/quota)
+func (c *lstcx) quota(cnt int) bool {
+	maxn := c.tcomsg.MaxObjects
+	return maxn > 0 && int64(cnt) >= maxn
+}
+
+func (c *lstcx) truncate(lr *apc.ListRange, cnt int) {
+	maxn := c.tcomsg.MaxObjects
+	if maxn > 0 && int64(cnt+len(lr.ObjNames)) > maxn {
+		lr.ObjNames = lr.ObjNames[:max(maxn-int64(cnt), 0)]
+	}
+}
+
 // calls t.httpxpost (TODO: slice of names is the only "delta" - optimize)
 func (c *lstcx) bcast() (err error) {
 	body := cos.MustMarshal(c.altmsg)
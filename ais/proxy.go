@@ -61,7 +61,10 @@ type (
 		qm         lsobjMem
 		rproxy     reverseProxy
 		notifs     notifs
+		invs       invSched
 		lstca      lstca
+		adm        admission
+		jq         jobQuota
 		reg        struct {
 			pool nodeRegPool
 			mu   sync.RWMutex
@@ -76,6 +79,7 @@ type (
 			last atomic.Int64 // last active EC via apc.HdrActiveEC (mono time)
 			rust int64        // same as above
 		}
+		rgw               rgwCache    // Ceph RGW bucket quota and usage (see prxrgw.go)
 		settingNewPrimary atomic.Bool // primary executing "set new primary" request (state)
 		readyToFastKalive atomic.Bool // primary can accept fast keepalives
 	}
@@ -187,6 +191,7 @@ func (p *proxy) Run() error {
 	p.owner.etl = newEtlMDOwnerPrx(config)
 
 	p.owner.bmd.init() // initialize owner and load BMD
+	p.owner.bmd.history().init(filepath.Join(config.ConfigDir, fname.BmdHist), p.si.String())
 	p.owner.etl.init() // initialize owner and load EtlMD
 
 	core.Pinit()
@@ -199,6 +204,8 @@ func (p *proxy) Run() error {
 	p.rproxy.init()
 
 	p.notifs.init(p)
+	p.idem.init(p.access)
+	p.invs.init(p)
 	p.ic.init(p)
 	p.qm.init()
 
@@ -230,6 +237,9 @@ func (p *proxy) Run() error {
 		// S3 compatibility
 		{r: "/" + apc.S3, h: p.s3Handler, net: accessNetPublic},
 
+		// WebDAV gateway
+		{r: "/" + apc.WebDAV, h: p.davHandler, net: accessNetPublic},
+
 		// "easy URL"
 		{r: "/" + apc.GSScheme, h: p.easyURLHandler, net: accessNetPublic},
 		{r: "/" + apc.AZScheme, h: p.easyURLHandler, net: accessNetPublic},
@@ -440,10 +450,15 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
+	p.reqID(w, r)
+	p.idem.do(w, r, p._bucketHandler)
+}
+
+func (p *proxy) _bucketHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
 		dpq := dpqAlloc()
-		p.httpbckget(w, r, dpq)
+		p.admitAndRun(w, r, admClassList, func() { p.httpbckget(w, r, dpq) })
 		dpqFree(dpq)
 	case http.MethodDelete:
 		apireq := apiReqAlloc(1, apc.URLPathBuckets.L, false /*dpq*/)
@@ -469,6 +484,11 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/objects/
 func (p *proxy) objectHandler(w http.ResponseWriter, r *http.Request) {
+	p.reqID(w, r)
+	p.admitAndRun(w, r, admClassObj, func() { p.idem.do(w, r, p._objectHandler) })
+}
+
+func (p *proxy) _objectHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
 		p.httpobjget(w, r)
@@ -720,6 +740,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		bckArgs.r = r
 		bckArgs.bck = apireq.bck
 		bckArgs.dpq = apireq.dpq
+		bckArgs.objName = apireq.items[1]
 		bckArgs.perms = apc.AceGET
 		bckArgs.createAIS = false
 	}
@@ -802,7 +823,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		bckArgs.perms = perms
 		bckArgs.createAIS = false
 	}
-	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
+	bckArgs.bck, bckArgs.dpq, bckArgs.objName = apireq.bck, apireq.dpq, apireq.items[1]
 	bck, err := bckArgs.initAndTry()
 	freeBctx(bckArgs)
 	if err != nil {
@@ -901,7 +922,7 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 		return
 	}
 	perms := apc.AceDestroyBucket
-	if msg.Action == apc.ActDeleteObjects || msg.Action == apc.ActEvictObjects {
+	if msg.Action == apc.ActDeleteObjects || msg.Action == apc.ActEvictObjects || msg.Action == apc.ActTierObjects {
 		perms = apc.AceObjDELETE
 	}
 
@@ -966,8 +987,8 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 				p.writeErr(w, r, err)
 			}
 		}
-	case apc.ActDeleteObjects, apc.ActEvictObjects:
-		if msg.Action == apc.ActEvictObjects {
+	case apc.ActDeleteObjects, apc.ActEvictObjects, apc.ActTierObjects:
+		if msg.Action != apc.ActDeleteObjects {
 			if err := cmn.ValidateRemoteBck(apc.ActEvictRemoteBck, bck.Bucket()); err != nil {
 				p.writeErr(w, r, err)
 				return
@@ -1215,6 +1236,17 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 			p.writeErr(w, r, err)
 			return
 		}
+		if err := archMsg.ValidateSplit(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := archMsg.JobQuota.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := p.checkFrozen(w, r, bckTo, msg.Action); err != nil {
+			return
+		}
 		xid, err := p.createArchMultiObj(bckFrom, bckTo, msg)
 		if err == nil {
 			writeXid(w, xid)
@@ -1289,6 +1321,9 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 	//
 	var xid string
 	switch msg.Action {
+	case apc.ActPresign:
+		p.presign(w, r, msg, bck)
+		return
 	case apc.ActMoveBck:
 		bckFrom := bck
 		bckTo, err := newBckFromQuname(query, true /*required*/)
@@ -1350,6 +1385,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, errPrependSync, tcbmsg.Prepend)
 			return
 		}
+		if err := tcbmsg.Init(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		bckTo, err = newBckFromQuname(query, true /*required*/)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1361,6 +1400,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				p.writeErrf(w, r, "cannot %s bucket %q onto itself", msg.Action, bckFrom)
 				return
 			}
+			if tcbmsg.Snapshot {
+				p.writeErrf(w, r, "%s %s: snapshot copy onto itself is not supported", msg.Action, bckFrom)
+				return
+			}
 			nlog.Infoln("proceeding to copy remote", bckFrom.String())
 		}
 
@@ -1376,6 +1419,8 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				return
 			}
 			nlog.Infof(warnDstNotExist, p, bckTo, bckFrom)
+		} else if err := p.checkFrozen(w, r, bckTo, msg.Action); err != nil {
+			return
 		}
 
 		// start x-tcb or x-tco
@@ -1391,6 +1436,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		//         this one does not
 
 		if !apc.IsFltPresent(fltPresence) && (bckFrom.IsCloud() || bckFrom.IsRemoteAIS()) {
+			if tcbmsg.Snapshot {
+				p.writeErrf(w, r, "%s %s: snapshot copy applies only to in-cluster objects", msg.Action, bckFrom)
+				return
+			}
 			lstcx := &lstcx{
 				p:       p,
 				bckFrom: bckFrom,
@@ -1424,6 +1473,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, errPrependSync, tcomsg.Prepend)
 			return
 		}
+		if err := tcomsg.Init(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		tcomsg.Prefix = cos.TrimPrefix(tcomsg.Prefix)
 		bckTo = meta.CloneBck(&tcomsg.ToBck)
 
@@ -1450,6 +1503,14 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				nlog.Infof(warnDstNotExist, p, bckTo, bck)
 			}
 		}
+		if eq {
+			err = p.checkFrozen(w, r, bck, msg.Action)
+		} else {
+			err = p.checkFrozen(w, r, bckTo, msg.Action)
+		}
+		if err != nil {
+			return
+		}
 
 		xid, err = p.tcobjs(bck, bckTo, cmn.GCO.Get(), msg, tcomsg)
 		if err != nil {
@@ -1504,6 +1565,15 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 
 // init existing or create remote
 // not calling `initAndTry` - delegating ais:from// props cloning to the separate method
+// reject new jobs that would write into a frozen bucket (see also: apc.AccessModify)
+func (p *proxy) checkFrozen(w http.ResponseWriter, r *http.Request, bckTo *meta.Bck, action string) error {
+	err := bckTo.CheckFrozen(action)
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusLocked)
+	}
+	return err
+}
+
 func (p *proxy) initBckTo(w http.ResponseWriter, r *http.Request, query url.Values, bckTo *meta.Bck) (*meta.Bck, int, error) {
 	bckToArgs := bctx{p: p, w: w, r: r, bck: bckTo, perms: apc.AcePUT, query: query}
 	bckToArgs.createAIS = true
@@ -2009,6 +2079,9 @@ func (p *proxy) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *apiR
 				info.IsBckPresent = true
 			}
 		}
+		if bck.Provider == apc.RGW {
+			p.addRGWStats(bck)
+		}
 		toHdr(w, bck, info, status, msg.UUID)
 		return
 	}
@@ -2043,6 +2116,9 @@ func (p *proxy) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *apiR
 			info.IsBckPresent = true
 		}
 	}
+	if bck.Provider == apc.RGW {
+		p.addRGWStats(bck)
+	}
 	toHdr(w, bck, info, status, msg.UUID)
 }
 
@@ -2163,14 +2239,22 @@ func (p *proxy) httpobjhead(w http.ResponseWriter, r *http.Request, origURLBck .
 }
 
 // PATCH /v1/objects/bucket-name/object-name
+// (set custom props or, with apc.QparamPatchOffset, ranged in-place write)
 func (p *proxy) httpobjpatch(w http.ResponseWriter, r *http.Request) {
-	started := time.Now()
-	bckArgs := allocBctx()
+	var (
+		started  = time.Now()
+		bckArgs  = allocBctx()
+		perms    = apc.AceObjUpdate
+		netIntra = cmn.NetIntraControl
+	)
+	if r.URL.Query().Has(apc.QparamPatchOffset) {
+		perms, netIntra = apc.AcePUT, cmn.NetIntraData
+	}
 	{
 		bckArgs.p = p
 		bckArgs.w = w
 		bckArgs.r = r
-		bckArgs.perms = apc.AceObjUpdate
+		bckArgs.perms = perms
 		bckArgs.createAIS = false
 	}
 	bck, objName, err := p._parseReqTry(w, r, bckArgs)
@@ -2190,7 +2274,7 @@ func (p *proxy) httpobjpatch(w http.ResponseWriter, r *http.Request) {
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		nlog.Infoln(r.Method, bck.Cname(objName), "=>", si.StringEx())
 	}
-	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	redirectURL := p.redirectURL(r, si, started, netIntra)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 }
 
@@ -2199,20 +2283,18 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		bmd     = p.owner.bmd.get()
 		present bool
 	)
-	if qbck.IsAIS() || qbck.IsHT() {
-		bcks := bmd.Select(qbck)
-		p.writeJSON(w, r, bcks, "list-buckets")
-		return
-	}
-
 	// present-only filtering
 	if dpq.fltPresence != "" {
 		if v, err := strconv.Atoi(dpq.fltPresence); err == nil {
 			present = apc.IsFltPresent(v)
 		}
 	}
-	if present {
+	if qbck.IsAIS() || qbck.IsHT() || present {
 		bcks := bmd.Select(qbck)
+		if dpq.bckUsage {
+			p.listBucketsUsage(w, r, qbck, bmd, bcks)
+			return
+		}
 		p.writeJSON(w, r, bcks, "list-buckets")
 		return
 	}
@@ -2288,6 +2370,9 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIn
 		apc.QparamProxyID:  []string{p.SID()},
 		apc.QparamUnixTime: []string{cos.UnixNano2S(ts.UnixNano())},
 	}
+	if rid := r.Header.Get(apc.HdrRequestID); rid != "" {
+		query.Set(apc.QparamReqID, rid)
+	}
 	redirect += query.Encode()
 	return
 }
@@ -2305,6 +2390,8 @@ func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoRe
 		cacheID   = cacheReqID{bck: bck.Bucket(), prefix: lsmsg.Prefix}
 		token     = lsmsg.ContinuationToken
 		props     = lsmsg.PropsSet()
+		timeout   time.Duration
+		started   int64
 		hasEnough bool
 		flags     uint32
 	)
@@ -2343,18 +2430,23 @@ func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoRe
 		Body:   cos.MustMarshal(actMsgExt),
 	}
 	args.timeout = apc.LongTimeout
+	if limit := cmn.GCO.Get().Timeout.ListObjects.D(); limit > 0 {
+		args.timeout = limit
+	}
 	args.smap = smap
 	args.cresv = cresLso{} // -> cmn.LsoRes
 
 	// Combine the results.
+	started = mono.NanoTime()
 	results = p.bcastGroup(args)
+	timeout = args.timeout
 	freeBcArgs(args)
 	for _, res := range results {
 		if res.err != nil {
 			if res.details == "" || res.details == dfltDetail {
 				res.details = xact.Cname(apc.ActList, lsmsg.UUID)
 			}
-			err = res.toErr()
+			err = res.toLsoErr(bck, started, timeout)
 			freeBcastRes(results)
 			return nil, err
 		}
@@ -2408,6 +2500,8 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 		actMsgExt = p.newAmsgActVal(apc.ActList, &lsmsg)
 		args      = allocBcArgs()
 		timeout   = config.Client.ListObjTimeout.D()
+		started   = mono.NanoTime()
+		inventory bool // first page from bucket inventory
 	)
 	if cos.IsParseBool(hdr.Get(apc.HdrInventory)) {
 		// TODO: extend to other Clouds or, more precisely, other list-objects supporting backends
@@ -2427,8 +2521,12 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 			lsmsg.SID = tsi.ID()
 
 			timeout = config.Client.TimeoutLong.D()
+			inventory = true
 		}
 	}
+	if limit := config.Timeout.ListObjects.D(); limit > 0 && !inventory {
+		timeout = limit
+	}
 	args.req = cmn.HreqArgs{
 		Method: http.MethodGet,
 		Path:   apc.URLPathBuckets.Join(bck.Name),
@@ -2471,7 +2569,7 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 			if res.details == "" || res.details == dfltDetail {
 				res.details = xact.Cname(apc.ActList, lsmsg.UUID)
 			}
-			err := res.toErr()
+			err := res.toLsoErr(bck, started, timeout)
 			freeBcastRes(results)
 			return nil, err
 		}
@@ -2482,6 +2580,15 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 	return cmn.MergeLso(resLists, lsmsg, 0), nil
 }
 
+// (timed-out waiting for a target to produce its list-objects page - see 'timeout.list_objects')
+func (res *callResult) toLsoErr(bck *meta.Bck, started int64, timeout time.Duration) error {
+	if cos.IsClientTimeout(res.err) || cos.IsErrClientURLTimeout(res.err) {
+		phase := "waiting for " + res.si.StringEx()
+		return cmn.NewErrTimeout(lsotag+" "+bck.Cname(""), phase, mono.Since(started), timeout, bck.IsRemote())
+	}
+	return res.toErr()
+}
+
 // http-redirect(with-json-message)
 func (p *proxy) redirectAction(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, msg *apc.ActMsg) {
 	started := time.Now()
@@ -2691,7 +2798,7 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		fallthrough // fallthrough
 	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog,
 		apc.WhatNodeStats, apc.WhatNodeStatsV322, apc.WhatMetricNames,
-		apc.WhatNodeStatsAndStatusV322:
+		apc.WhatNodeStatsAndStatusV322, apc.WhatElections, apc.WhatSmapHist:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 
 	case apc.WhatNodeStatsAndStatus:
@@ -2704,6 +2811,9 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	case apc.WhatSysInfo:
 		p.writeJSON(w, r, apc.GetMemCPU(), what)
 
+	case apc.WhatBMDHist:
+		p.writeJSON(w, r, p.owner.bmd.history().get(), what)
+
 	case apc.WhatSmap:
 		const retries = 16
 		var (
@@ -2979,6 +3089,12 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 			p.writeErr(w, r, err)
 			return
 		}
+		if tk := p.jobToken(r); tk != nil {
+			if p.jobQuota(w, r, tk, apc.ActDsort, nil /*msg*/, body) {
+				return
+			}
+			parsc.SetUser(tk.UserID)
+		}
 		bck := meta.CloneBck(&parsc.InputBck)
 		args := bctx{p: p, w: w, r: r, bck: bck, perms: apc.AceObjLIST | apc.AceGET}
 		if _, err = args.initAndTry(); err != nil {
@@ -3014,7 +3130,11 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 				nlog.Warningf(warnfmt, p, "", bckTo, bck)
+			} else if err := p.checkFrozen(w, r, bckTo, apc.ActDsort); err != nil {
+				return
 			}
+		} else if err := p.checkFrozen(w, r, bck, apc.ActDsort); err != nil {
+			return
 		}
 		dsort.PstartHandler(w, r, parsc)
 	case http.MethodGet:
@@ -3269,6 +3389,9 @@ func (p *proxy) receiveBMD(newBMD *bucketMD, msg *actMsgExt, payload msPayload,
 skip:
 	err = p.owner.bmd.putPersist(newBMD, payload)
 	debug.AssertNoErr(err)
+	if err == nil {
+		p.owner.bmd.history().addBMD(newBMD, &msg.ActMsg, caller)
+	}
 	p.owner.bmd.Unlock()
 	return
 }
@@ -0,0 +1,156 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// API request admission control (proxy):
+// - per endpoint class: a bounded number of in-flight requests and a bounded FIFO wait queue;
+// - a request that finds the queue full, or cannot be admitted within the queue timeout,
+//   is shed with 503 and Retry-After;
+// - configuration is dynamic (see config.Proxy.Admission); zero max-inflight disables admission control
+//   for a given class
+// - when max-inflight decreases at runtime, the excess drains as executing requests complete
+
+const (
+	admClassList = iota // list-objects, bucket summary, and other bucket GETs
+	admClassObj         // object requests
+	admNumClasses
+)
+
+type (
+	admq struct {
+		waiters  []chan struct{}
+		inflight int
+		mu       sync.Mutex
+	}
+	admission struct {
+		queues [admNumClasses]admq
+	}
+	admMetrics struct {
+		inflight, queued, shed string
+	}
+)
+
+var (
+	errAdmShed = errors.New("too many requests")
+
+	admNames = [admNumClasses]admMetrics{
+		{stats.AdmListInflight, stats.AdmListQueued, stats.AdmListShed},
+		{stats.AdmObjInflight, stats.AdmObjQueued, stats.AdmObjShed},
+	}
+)
+
+func admClassConf(config *cmn.Config, class int) *cmn.AdmissionClassConf {
+	if class == admClassList {
+		return &config.Proxy.Admission.List
+	}
+	return &config.Proxy.Admission.Objects
+}
+
+// execute `f` subject to admission control; when not admitted, write 503 with Retry-After
+func (p *proxy) admitAndRun(w http.ResponseWriter, r *http.Request, class int, f func()) {
+	conf := admClassConf(cmn.GCO.Get(), class)
+	if conf.MaxInflight <= 0 {
+		f()
+		return
+	}
+	q := &p.adm.queues[class]
+	err := q.acquire(r.Context(), conf)
+	p.admStats(class)
+	if err != nil {
+		p.statsT.Inc(admNames[class].shed)
+		w.Header().Set(cos.HdrRetryAfter, strconv.Itoa(admRetryAfter(conf)))
+		p.writeErr(w, r, err, http.StatusServiceUnavailable, Silent)
+		return
+	}
+	defer func() {
+		conf := admClassConf(cmn.GCO.Get(), class) // (may have changed)
+		q.release(conf.MaxInflight)
+		p.admStats(class)
+	}()
+	f()
+}
+
+func (p *proxy) admStats(class int) {
+	q := &p.adm.queues[class]
+	q.mu.Lock()
+	inflight, queued := q.inflight, len(q.waiters)
+	q.mu.Unlock()
+	p.statsT.SetGauge(admNames[class].inflight, int64(inflight))
+	p.statsT.SetGauge(admNames[class].queued, int64(queued))
+}
+
+// (seconds)
+func admRetryAfter(conf *cmn.AdmissionClassConf) int {
+	return max(int(math.Ceil(conf.QueueTimeout.D().Seconds())), 1)
+}
+
+//////////
+// admq //
+//////////
+
+func (q *admq) acquire(ctx context.Context, conf *cmn.AdmissionClassConf) error {
+	q.mu.Lock()
+	if q.inflight < conf.MaxInflight {
+		q.inflight++
+		q.mu.Unlock()
+		return nil
+	}
+	if len(q.waiters) >= conf.MaxQueued || conf.QueueTimeout <= 0 {
+		q.mu.Unlock()
+		return errAdmShed
+	}
+	ch := make(chan struct{})
+	q.waiters = append(q.waiters, ch)
+	q.mu.Unlock()
+
+	timer := time.NewTimer(conf.QueueTimeout.D())
+	select {
+	case <-ch:
+		timer.Stop()
+		return nil
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+	}
+
+	// timed out (or client went away) - unless admitted in the meantime
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, c := range q.waiters {
+		if c == ch {
+			q.waiters = append(q.waiters[:i], q.waiters[i+1:]...)
+			return errAdmShed
+		}
+	}
+	return nil // (handed off by release)
+}
+
+// hand off the slot to the next waiter, if any (in which case `inflight` stays the same)
+// (non-positive `maxInflight`: admission control disabled at runtime - admit all waiters)
+func (q *admq) release(maxInflight int) {
+	q.mu.Lock()
+	if len(q.waiters) > 0 && (maxInflight <= 0 || q.inflight <= maxInflight) {
+		ch := q.waiters[0]
+		q.waiters = q.waiters[1:]
+		close(ch)
+	} else {
+		q.inflight--
+	}
+	q.mu.Unlock()
+}
@@ -0,0 +1,66 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAdmissionQueue(t *testing.T) {
+	var (
+		q    admq
+		ctx  = context.Background()
+		conf = &cmn.AdmissionClassConf{MaxInflight: 2, MaxQueued: 1, QueueTimeout: cos.Duration(5 * time.Second)}
+	)
+	tassert.CheckFatal(t, q.acquire(ctx, conf))
+	tassert.CheckFatal(t, q.acquire(ctx, conf))
+
+	// third waits in the queue and gets admitted upon release
+	admitted := make(chan error, 1)
+	go func() { admitted <- q.acquire(ctx, conf) }()
+	for {
+		q.mu.Lock()
+		n := len(q.waiters)
+		q.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// fourth is shed: queue is full
+	err := q.acquire(ctx, conf)
+	tassert.Errorf(t, err == errAdmShed, "expected shedding, got %v", err)
+
+	q.release(conf.MaxInflight)
+	tassert.CheckFatal(t, <-admitted)
+	tassert.Errorf(t, q.inflight == 2 && len(q.waiters) == 0, "expected (2, 0), got (%d, %d)", q.inflight, len(q.waiters))
+
+	// queue timeout
+	conf.QueueTimeout = cos.Duration(10 * time.Millisecond)
+	err = q.acquire(ctx, conf)
+	tassert.Errorf(t, err == errAdmShed, "expected shedding upon timeout, got %v", err)
+	tassert.Errorf(t, len(q.waiters) == 0, "expected empty queue, got %d", len(q.waiters))
+
+	// canceled request leaves the queue
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	conf.QueueTimeout = cos.Duration(time.Minute)
+	err = q.acquire(cctx, conf)
+	tassert.Errorf(t, err == errAdmShed, "expected shedding upon cancel, got %v", err)
+
+	q.release(conf.MaxInflight)
+	q.release(conf.MaxInflight)
+	tassert.Errorf(t, q.inflight == 0, "expected zero in-flight, got %d", q.inflight)
+
+	tassert.Errorf(t, admRetryAfter(&cmn.AdmissionClassConf{QueueTimeout: cos.Duration(1500 * time.Millisecond)}) == 2,
+		"expected Retry-After rounded up to 2s")
+}
@@ -5,9 +5,19 @@
 package ais
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,8 +29,12 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/crypto/hkdf"
 )
 
 type (
@@ -32,14 +46,28 @@ type (
 		// list of invalid tokens(revoked or of deleted users)
 		// Authn sends these tokens to primary for broadcasting
 		revokedTokens map[string]bool
-		version       int64
+		// IDs of the above (see presignTid)
+		revokedTids cos.StrSet
+		version     int64
 		// signing key secret
 		secret string
 		// lock
 		sync.Mutex
 	}
+	// per-user job quota: serializes (limited) job starts on primary
+	jobQuota struct {
+		m  map[string]*jqEntry
+		mu sync.Mutex
+	}
+	jqEntry struct {
+		dloads cos.StrSet // download jobs (see httpdlpost)
+		mu     sync.Mutex // held from counting running jobs through starting a new one
+	}
 )
 
+// request handled (rejected or forwarded) - see jobQuota
+var errJobQuota = errors.New("job quota")
+
 /////////////////
 // authManager //
 /////////////////
@@ -48,6 +76,7 @@ func newAuthManager(config *cmn.Config) *authManager {
 	return &authManager{
 		tkList:        make(tkList),
 		revokedTokens: make(map[string]bool), // TODO: preallocate
+		revokedTids:   make(cos.StrSet),
 		version:       1,
 		secret:        cos.Right(config.Auth.Secret, os.Getenv(env.AuthN.SecretKey)), // environment override
 	}
@@ -71,6 +100,7 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 	// Add new revoked tokens and remove them from the valid token list.
 	for _, token := range newRevoked.Tokens {
 		a.revokedTokens[token] = true
+		a.revokedTids.Add(presignTid(token))
 		delete(a.tkList, token)
 	}
 
@@ -87,6 +117,7 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 		debug.AssertNoErr(err)
 		if tk.Expires.Before(now) {
 			delete(a.revokedTokens, token)
+			a.revokedTids.Delete(presignTid(token))
 		} else {
 			allRevoked.Tokens = append(allRevoked.Tokens, token)
 		}
@@ -241,6 +272,8 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
 	if err = p.access(r.Header, bck, ace); err != nil {
 		p.writeErr(w, r, err, aceErrToCode(err))
+	} else {
+		idemRecordAccess(r, bck, ace)
 	}
 	return
 }
@@ -251,6 +284,9 @@ func aceErrToCode(err error) (status int) {
 	case tok.ErrNoToken, tok.ErrInvalidToken:
 		status = http.StatusUnauthorized
 	default:
+		if cmn.IsErrBucketFrozen(err) {
+			return http.StatusLocked
+		}
 		status = http.StatusForbidden
 	}
 	return status
@@ -299,3 +335,344 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 	}
 	return bck.Allow(ace)
 }
+
+//
+// job submitters and per-user quotas
+//
+
+// (compare w/ htrun.readActionMsg)
+func (p *proxy) readActionMsg(w http.ResponseWriter, r *http.Request) (*apc.ActMsg, error) {
+	msg, err := p.htrun.readActionMsg(w, r)
+	if err != nil {
+		return nil, err
+	}
+	msg.User = ""
+	tk := p.jobToken(r)
+	if tk == nil {
+		return msg, nil // (not authenticated - access checks to follow)
+	}
+	msg.User = tk.UserID
+	if p.jobQuota(w, r, tk, msg.Action, msg, nil) {
+		return nil, errJobQuota
+	}
+	return msg, nil
+}
+
+// the submitter's token, if any
+func (p *proxy) jobToken(r *http.Request) *tok.Token {
+	if !cmn.Rom.AuthEnabled() {
+		return nil
+	}
+	if _, err := tok.ExtractToken(r.Header); err != nil {
+		return nil
+	}
+	tk, err := p.validateToken(r.Header)
+	if err != nil {
+		return nil
+	}
+	return tk
+}
+
+// when the user's roles limit the number of running jobs (see authn.Role.MaxJobs)
+func jobLimited(tk *tok.Token) bool { return tk != nil && !tk.IsAdmin && tk.MaxJobs > 0 }
+
+func startsJob(action string) bool {
+	if action == apc.ActXactStart {
+		return true
+	}
+	_, ok := xact.Table[action]
+	return ok && action != apc.ActList
+}
+
+// Reserve a slot for the (limited) user's new job. Returns true when the request
+// has been handled - either rejected or forwarded to primary.
+// To make counting-and-starting atomic, primary executes all limited job starts
+// while holding the user's lock - until the (job-starting) request returns.
+func (p *proxy) jobQuota(w http.ResponseWriter, r *http.Request, tk *tok.Token, action string, msg *apc.ActMsg,
+	body []byte) bool {
+	if !jobLimited(tk) || !startsJob(action) {
+		return false
+	}
+	if p.forwardCP(w, r, msg, action, body) {
+		return true
+	}
+	p.jq.lock(r.Context(), tk.UserID)
+
+	n, err := p.numRunning(tk.UserID)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return true
+	}
+	n += p.jq.numDloads(tk.UserID, p.dlRunning)
+	if n >= tk.MaxJobs {
+		err := fmt.Errorf("user %q: cannot start %q - too many running jobs (%d, max %d)", tk.UserID, action, n, tk.MaxJobs)
+		p.writeErr(w, r, err, http.StatusTooManyRequests)
+		return true
+	}
+	return false
+}
+
+func (p *proxy) dlRunning(jobID string) bool {
+	nl := p.notifs.entry(jobID)
+	return nl != nil && !nl.Finished()
+}
+
+////////////
+// jobQuota //
+////////////
+
+// (primary only)
+func (jq *jobQuota) lock(ctx context.Context, user string) {
+	jq.mu.Lock()
+	if jq.m == nil {
+		jq.m = make(map[string]*jqEntry, 4)
+	}
+	e, ok := jq.m[user]
+	if !ok {
+		e = &jqEntry{}
+		jq.m[user] = e
+	}
+	jq.mu.Unlock()
+
+	e.mu.Lock()
+	context.AfterFunc(ctx, e.mu.Unlock) // (net/http cancels request context when the handler returns)
+}
+
+// download jobs (all running within a single per-target xaction) are counted by their IDs
+func (jq *jobQuota) addDload(user, jobID string) {
+	jq.mu.Lock()
+	if e, ok := jq.m[user]; ok {
+		if e.dloads == nil {
+			e.dloads = cos.NewStrSet()
+		}
+		e.dloads.Set(jobID)
+	}
+	jq.mu.Unlock()
+}
+
+func (jq *jobQuota) numDloads(user string, running func(string) bool) (n int) {
+	jq.mu.Lock()
+	if e, ok := jq.m[user]; ok {
+		for jobID := range e.dloads {
+			if running(jobID) {
+				n++
+			} else {
+				delete(e.dloads, jobID)
+			}
+		}
+	}
+	jq.mu.Unlock()
+	return n
+}
+
+// number of jobs started by a given user and currently running cluster-wide
+func (p *proxy) numRunning(user string) (int, error) {
+	qmsg := xact.QueryMsg{OnlyRunning: apc.Ptr(true), User: user}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathXactions.S,
+		Body:   cos.MustMarshal(qmsg),
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatQueryXactStats}},
+	}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	xids := cos.NewStrSet()
+	for _, res := range results {
+		if res.status == http.StatusNotFound {
+			continue
+		}
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return 0, err
+		}
+		var snaps []*core.Snap
+		if err := jsoniter.Unmarshal(res.bytes, &snaps); err != nil {
+			freeBcastRes(results)
+			return 0, err
+		}
+		for _, snap := range snaps {
+			xids.Set(snap.ID)
+		}
+	}
+	freeBcastRes(results)
+	return len(xids), nil
+}
+
+//
+// presigned URLs (apc.ActPresign)
+//
+
+var (
+	errPresignSig     = errors.New("invalid presigned URL signature")
+	errPresignExpired = errors.New("presigned URL expired")
+	errPresignRevoked = errors.New("presigned URL revoked (the issuing token is revoked)")
+)
+
+const presignInfo = "presign" // HKDF info (key derivation)
+
+// POST /v1/buckets/bucket-name {apc.ActPresign}
+// (exchange the caller's token for a time-limited URL; the URL grants a subset of the token's permissions)
+func (p *proxy) presign(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck) {
+	var (
+		pmsg apc.PresignMsg
+		perm = apc.AceGET
+		now  = time.Now()
+	)
+	if !cmn.Rom.AuthEnabled() || p.authn.secret == "" {
+		p.writeErrf(w, r, "%s: presigned URLs require AuthN (see config.auth)", p)
+		return
+	}
+	if err := cos.MorphMarshal(msg.Value, &pmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	switch pmsg.Method {
+	case "", http.MethodGet:
+		pmsg.Method = http.MethodGet
+	case http.MethodPut:
+		perm = apc.AcePUT
+	default:
+		p.writeErrf(w, r, "%s: invalid presigned URL method %q (expecting GET or PUT)", p, pmsg.Method)
+		return
+	}
+	if msg.Name == "" && !pmsg.Prefix {
+		p.writeErrf(w, r, "%s: object name required", apc.ActPresign)
+		return
+	}
+	expires := pmsg.Expires.D()
+	switch {
+	case expires == 0:
+		expires = apc.PresignExpiresDflt
+	case expires < 0 || expires > apc.PresignExpiresMax:
+		p.writeErrf(w, r, "%s: invalid expiration %v (expecting (0, %v])", apc.ActPresign, expires, apc.PresignExpiresMax)
+		return
+	}
+
+	// the caller must have the permission that the URL grants
+	if err := p.checkAccess(w, r, bck, perm); err != nil {
+		return
+	}
+	token, err := tok.ExtractToken(r.Header)
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	tk, err := p.authn.validateToken(token)
+	if err != nil {
+		p.writeErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	exp := now.Add(expires)
+	if !tk.Expires.IsZero() && tk.Expires.Before(exp) {
+		exp = tk.Expires // never outlive the token
+	}
+
+	var (
+		q   = bck.AddToQuery(nil)
+		tid = presignTid(token)
+		sig = p.authn.presignSig(pmsg.Method, bck.MakeUname(msg.Name), pmsg.Prefix, tid, exp.Unix())
+	)
+	q.Set(apc.QparamPresignExp, strconv.FormatInt(exp.Unix(), 10))
+	q.Set(apc.QparamPresignTid, tid)
+	q.Set(apc.QparamPresignSig, sig)
+	objName := msg.Name
+	if pmsg.Prefix {
+		q.Set(apc.QparamPresignPrefix, msg.Name)
+		objName = "" // to be appended by the user
+	}
+	res := &apc.PresignRes{
+		URL:     p.si.URL(cmn.NetPublic) + apc.URLPathObjects.Join(bck.Name, objName) + "?" + q.Encode(),
+		Method:  pmsg.Method,
+		Expires: exp,
+	}
+	p.writeJSON(w, r, res, apc.ActPresign)
+}
+
+// the ID of the issuing token: binds presigned URL to the token, so that revoking the latter
+// (or deleting its user) invalidates the former (see checkPresigned)
+func presignTid(token string) string {
+	h := sha256.Sum256(cos.UnsafeB(token))
+	return hex.EncodeToString(h[:16])
+}
+
+// HMAC-SHA256(presign key; method, bucket/object uname, scope, token ID, expiration)
+//   - variable-length fields are length-prefixed, scope is a separate byte, expiration is fixed-size,
+//     so that no two distinct inputs can serialize to the same message
+func (a *authManager) presignSig(method string, uname []byte, prefix bool, tid string, exp int64) string {
+	var (
+		mac   = hmac.New(sha256.New, a.presignKey())
+		b     [8]byte
+		scope byte
+	)
+	for _, field := range [][]byte{[]byte(method), uname} {
+		binary.BigEndian.PutUint64(b[:], uint64(len(field)))
+		mac.Write(b[:])
+		mac.Write(field)
+	}
+	if prefix {
+		scope = 1
+	}
+	mac.Write([]byte{scope})
+	binary.BigEndian.PutUint64(b[:], uint64(len(tid)))
+	mac.Write(b[:])
+	mac.Write([]byte(tid))
+	binary.BigEndian.PutUint64(b[:], uint64(exp))
+	mac.Write(b[:])
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// HKDF(secret, "presign"): never sign presigned URLs with the (JWT) secret itself
+func (a *authManager) presignKey() []byte {
+	key := make([]byte, sha256.Size)
+	_, err := io.ReadFull(hkdf.New(sha256.New, []byte(a.secret), nil, []byte(presignInfo)), key)
+	debug.AssertNoErr(err)
+	return key
+}
+
+// validate presigned GET or PUT request that carries no token (see apc.QparamPresignSig)
+func (a *authManager) checkPresigned(r *http.Request, dpq *dpq, bck *meta.Bck, objName string, perms apc.AccessAttrs) error {
+	var method string
+	switch perms {
+	case apc.AceGET:
+		method = http.MethodGet
+	case apc.AcePUT:
+		method = http.MethodPut
+	default:
+		return errPresignSig
+	}
+	if r.Method != method || a.secret == "" {
+		return errPresignSig
+	}
+	exp, err := strconv.ParseInt(dpq.presign.exp, 10, 64)
+	if err != nil || exp > time.Now().Add(apc.PresignExpiresMax).Unix() {
+		return errPresignSig // (the proxy never issues URLs that expire later than that)
+	}
+	name, prefix := objName, dpq.presign.prefix != ""
+	if prefix {
+		name = dpq.presign.prefix
+		if !strings.HasPrefix(objName, name) {
+			return errPresignSig
+		}
+	}
+	if dpq.presign.tid == "" {
+		return errPresignSig
+	}
+	sig := a.presignSig(method, bck.MakeUname(name), prefix, dpq.presign.tid, exp)
+	if !hmac.Equal([]byte(sig), []byte(dpq.presign.sig)) {
+		return errPresignSig
+	}
+	if time.Now().Unix() > exp {
+		return errPresignExpired
+	}
+	a.Lock()
+	revoked := a.revokedTids.Contains(dpq.presign.tid)
+	a.Unlock()
+	if revoked {
+		return errPresignRevoked
+	}
+	return nil
+}
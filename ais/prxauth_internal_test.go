@@ -0,0 +1,146 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPresignedURL(t *testing.T) {
+	var (
+		a   = newAuthManager(cmn.GCO.Get())
+		bck = meta.NewBck("bucket", apc.AIS, cmn.NsGlobal)
+		exp = time.Now().Add(time.Hour).Unix()
+	)
+	a.secret = "s3cr3t"
+	token, err := tok.AdminJWT(time.Now().Add(time.Hour), "admin", a.secret)
+	tassert.CheckFatal(t, err)
+	tid := presignTid(token)
+
+	check := func(method, objName string, perms apc.AccessAttrs, sig, prefix string, exp int64) error {
+		dpq := &dpq{}
+		dpq.presign.exp, dpq.presign.sig, dpq.presign.prefix = strconv.FormatInt(exp, 10), sig, prefix
+		dpq.presign.tid = tid
+		r := httptest.NewRequest(method, apc.URLPathObjects.Join(bck.Name, objName), http.NoBody)
+		return a.checkPresigned(r, dpq, bck, objName, perms)
+	}
+
+	// single object
+	sig := a.presignSig(http.MethodGet, bck.MakeUname("obj"), false, tid, exp)
+	tassert.CheckFatal(t, check(http.MethodGet, "obj", apc.AceGET, sig, "", exp))
+	err = check(http.MethodGet, "obj2", apc.AceGET, sig, "", exp)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (other object), got %v", err)
+	err = check(http.MethodPut, "obj", apc.AcePUT, sig, "", exp)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (other method), got %v", err)
+	err = check(http.MethodGet, "obj", apc.AceGET, sig, "", exp+1)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (modified expiration), got %v", err)
+
+	// prefix
+	sig = a.presignSig(http.MethodPut, bck.MakeUname("dir/"), true, tid, exp)
+	tassert.CheckFatal(t, check(http.MethodPut, "dir/a/b", apc.AcePUT, sig, "dir/", exp))
+	err = check(http.MethodPut, "other/a", apc.AcePUT, sig, "dir/", exp)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (outside prefix), got %v", err)
+	err = check(http.MethodPut, "dir/", apc.AcePUT, sig, "", exp)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (scope), got %v", err)
+
+	// forgery: shifting bytes between object name and expiration
+	short := time.Now().Add(time.Minute).Unix()
+	sig = a.presignSig(http.MethodGet, bck.MakeUname("x1"), false, tid, short)
+	shifted, _ := strconv.ParseInt("1"+strconv.FormatInt(short, 10), 10, 64)
+	err = check(http.MethodGet, "x", apc.AceGET, sig, "", shifted)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (shifted expiration), got %v", err)
+
+	// forgery: single object named "foo*" used as a prefix grant
+	sig = a.presignSig(http.MethodGet, bck.MakeUname("foo*"), false, tid, exp)
+	tassert.CheckFatal(t, check(http.MethodGet, "foo*", apc.AceGET, sig, "", exp))
+	err = check(http.MethodGet, "foo/bar", apc.AceGET, sig, "foo", exp)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (object as prefix), got %v", err)
+
+	// validly signed but expiring later than the maximum allowed
+	far := time.Now().Add(apc.PresignExpiresMax + time.Hour).Unix()
+	sig = a.presignSig(http.MethodGet, bck.MakeUname("obj"), false, tid, far)
+	err = check(http.MethodGet, "obj", apc.AceGET, sig, "", far)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (expiration too far), got %v", err)
+
+	// signing key is derived (not the secret itself)
+	tassert.Errorf(t, !bytes.Equal(a.presignKey(), []byte(a.secret)), "expected derived key")
+
+	// expired
+	past := time.Now().Add(-time.Minute).Unix()
+	sig = a.presignSig(http.MethodGet, bck.MakeUname("obj"), false, tid, past)
+	err = check(http.MethodGet, "obj", apc.AceGET, sig, "", past)
+	tassert.Errorf(t, err == errPresignExpired, "expected expired, got %v", err)
+
+	// bound to the issuing token: other token ID, no token ID
+	sig = a.presignSig(http.MethodGet, bck.MakeUname("obj"), false, tid, exp)
+	tid = presignTid("other-token")
+	err = check(http.MethodGet, "obj", apc.AceGET, sig, "", exp)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (other token), got %v", err)
+	tid = ""
+	err = check(http.MethodGet, "obj", apc.AceGET, sig, "", exp)
+	tassert.Errorf(t, err == errPresignSig, "expected invalid signature (no token ID), got %v", err)
+
+	// revoked token (or deleted user) invalidates the URL
+	tid = presignTid(token)
+	tassert.CheckFatal(t, check(http.MethodGet, "obj", apc.AceGET, sig, "", exp))
+	a.updateRevokedList(&tokenList{Tokens: []string{token}})
+	err = check(http.MethodGet, "obj", apc.AceGET, sig, "", exp)
+	tassert.Errorf(t, err == errPresignRevoked, "expected revoked, got %v", err)
+}
+
+func TestJobQuotaSerialize(t *testing.T) {
+	var (
+		jq          jobQuota
+		ctx1, done1 = context.WithCancel(context.Background())
+		ctx2, done2 = context.WithCancel(context.Background())
+		acquired    = make(chan struct{})
+	)
+	defer done2()
+	jq.lock(ctx1, "user")
+
+	// other users are not affected
+	ctx3, done3 := context.WithCancel(context.Background())
+	jq.lock(ctx3, "other")
+	done3()
+
+	go func() {
+		jq.lock(ctx2, "user")
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("expected the second job start to wait for the first one")
+	case <-time.After(100 * time.Millisecond):
+	}
+	done1() // first request returns
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the second job start to proceed")
+	}
+
+	// download jobs: counted while running, pruned when finished
+	running := map[string]bool{"dl-1": true, "dl-2": true}
+	jq.addDload("user", "dl-1")
+	jq.addDload("user", "dl-2")
+	isRunning := func(id string) bool { return running[id] }
+	tassert.Errorf(t, jq.numDloads("user", isRunning) == 2, "expected 2 running downloads")
+	running["dl-1"] = false
+	tassert.Errorf(t, jq.numDloads("user", isRunning) == 1, "expected 1 running download")
+	tassert.Errorf(t, len(jq.m["user"].dloads) == 1, "expected finished download to be pruned")
+	tassert.Errorf(t, jq.numDloads("nobody", isRunning) == 0, "expected none")
+}
@@ -34,6 +34,7 @@ type bctx struct {
 	dpq   *dpq
 
 	origURLBck string
+	objName    string // GET and PUT: validating presigned requests (see apc.QparamPresignSig)
 
 	reqBody []byte          // request body of original request
 	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
@@ -176,6 +177,15 @@ rerr:
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (ecode int, err error) {
+	if bctx.dpq != nil && bctx.dpq.presign.sig != "" && cmn.Rom.AuthEnabled() {
+		if err = bctx.p.authn.checkPresigned(bctx.r, bctx.dpq, bck, bctx.objName, bctx.perms); err != nil {
+			return http.StatusUnauthorized, err
+		}
+		if err = bck.Allow(bctx.perms); err != nil {
+			return aceErrToCode(err), err
+		}
+		return 0, nil
+	}
 	err = bctx.p.access(bctx.r.Header, bck, bctx.perms)
 	ecode = aceErrToCode(err)
 	return ecode, err
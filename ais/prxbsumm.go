@@ -167,3 +167,51 @@ func (p *proxy) bsummhead(bck *meta.Bck, msg *apc.BsummCtrlMsg) (info *cmn.Bsumm
 	}
 	return info, status, err
 }
+
+// list present buckets with usage and activity (apc.QparamBckUsage)
+func (p *proxy) listBucketsUsage(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, bmd *bucketMD, bcks cmn.Bcks) {
+	var (
+		q    = make(url.Values, 4)
+		args = allocBcArgs()
+	)
+	qbck.AddToQuery(q)
+	q.Set(apc.QparamWhat, apc.WhatBckUsage)
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: q}
+	args.timeout = cmn.Rom.MaxKeepalive()
+	args.cresv = cresBU{} // -> cmn.AllBckUsage
+
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var all cmn.AllBckUsage
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		for _, u := range *res.v.(*cmn.AllBckUsage) {
+			all = all.Aggregate(u)
+		}
+	}
+	freeBcastRes(results)
+
+	// in BMD order (and including buckets that are empty or unknown to targets)
+	out := make(cmn.AllBckUsage, 0, len(bcks))
+	for i := range bcks {
+		bck := &bcks[i]
+		u := &cmn.BckUsage{Bck: *bck}
+		for _, tu := range all {
+			if tu.Bck.Equal(bck) {
+				u = tu
+				break
+			}
+		}
+		if props, present := bmd.Get(meta.CloneBck(bck)); present {
+			u.Created = props.Created
+		}
+		out = append(out, u)
+	}
+	p.writeJSON(w, r, out, "list-buckets-usage")
+}
@@ -33,6 +33,10 @@ import (
 //
 
 func (p *proxy) clusterHandler(w http.ResponseWriter, r *http.Request) {
+	p.idem.do(w, r, p._clusterHandler)
+}
+
+func (p *proxy) _clusterHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
 		p.httpcluget(w, r)
@@ -79,6 +83,8 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.qcluStats(w, r, what, query)
 	case apc.WhatSysInfo:
 		p.qcluSysinfo(w, r, what, query)
+	case apc.WhatNodeAlerts:
+		p.qcluAlerts(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
 	case apc.WhatBackends:
@@ -231,6 +237,30 @@ func (p *proxy) qcluSysinfo(w http.ResponseWriter, r *http.Request, what string,
 	p.writeJSON(w, r, out, what)
 }
 
+// all nodes, including this one; unresponsive nodes are reported rather than failing the request
+func (p *proxy) qcluAlerts(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: r.Method, Path: apc.URLPathDae.S, Query: query}
+	args.timeout = cmn.Rom.MaxKeepalive()
+	args.to = core.AllNodes
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	out := make(stats.ClusterAlerts, len(results)+1)
+	out[p.SID()] = &stats.Alerts{Snode: p.si, Flags: cos.NodeStateFlags(p.statsT.Get(cos.NodeAlerts))}
+	for _, res := range results {
+		a := &stats.Alerts{Snode: res.si}
+		if res.err != nil {
+			a.Err = res.toErr().Error()
+		} else if err := jsoniter.Unmarshal(res.bytes, &a.Flags); err != nil {
+			a.Err = err.Error()
+		}
+		out[res.si.ID()] = a
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
 func (p *proxy) getRemAisVec(refresh bool) (*meta.RemAisVec, error) {
 	smap := p.owner.smap.get()
 	si, errT := smap.GetRandTarget()
@@ -733,13 +763,14 @@ func (p *proxy) rereg(nsi, osi *meta.Snode) bool {
 	if !p.NodeStarted() {
 		return true
 	}
-	if osi.Eq(nsi) && osi.Flags == nsi.Flags {
+	if osi.Eq(nsi) && osi.Flags == nsi.Flags && osi.FailureDomain() == nsi.FailureDomain() {
 		nlog.Infoln(p.String(), "node", nsi.StringEx(), "is already _in_ - nothing to do")
 		return false
 	}
 
 	// NOTE: also ref0417 (ais/earlystart)
-	nlog.Warningf("%s: renewing %s(flags %s) => %s(flags %s)", p, osi.StringEx(), osi.Fl2S(), nsi.StringEx(), nsi.Fl2S())
+	nlog.Warningf("%s: renewing %s(flags %s, domain %q) => %s(flags %s, domain %q)", p,
+		osi.StringEx(), osi.Fl2S(), osi.FailureDomain(), nsi.StringEx(), nsi.Fl2S(), nsi.FailureDomain())
 	return true
 }
 
@@ -1252,7 +1283,7 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 			return
 		}
 		args._selected(tsi)
-		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs, Name: msg.Name})
+		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs, Name: msg.Name, User: msg.User})
 	case xargs.Kind == apc.ActResilver && xargs.DaemonID != "":
 		args.smap = p.owner.smap.get()
 		tsi := args.smap.GetTarget(xargs.DaemonID)
@@ -1262,12 +1293,13 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 			return
 		}
 		args._selected(tsi)
-		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs})
+		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs, User: msg.User})
 	default:
 		// all targets, one common UUID for all
+		// (msg.Name, if present, carries kind-specific detail, e.g. x-verify-checksum prefix)
 		args.to = core.Targets
 		xargs.ID = cos.GenUUID()
-		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs})
+		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs, Name: msg.Name, User: msg.User})
 	}
 
 	results := p.bcastGroup(args)
@@ -2183,5 +2215,11 @@ func mustRebalance(ctx *smapModifier, cur *smapX) bool {
 			return true
 		}
 	}
+	// failure-domain labels changed (affects EC placement, see cmn.ECConf.SpreadFD)
+	for _, tsi := range cur.Tmap {
+		if psi := prev.GetActiveNode(tsi.ID()); psi != nil && psi.FailureDomain() != tsi.FailureDomain() {
+			return true
+		}
+	}
 	return false
 }
@@ -0,0 +1,46 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestMustRebalanceFailureDomain(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	enabled := config.Rebalance.Enabled
+	config.Rebalance.Enabled = true
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Rebalance.Enabled = enabled
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	newSmapT := func(ver int64, rack string) *smapX {
+		smap := newSmap()
+		smap.Version = ver
+		for _, id := range []string{"t1", "t2"} {
+			tsi := &meta.Snode{}
+			tsi.Init(id, apc.Target)
+			if id == "t2" {
+				tsi.Rack = rack
+			}
+			smap.Tmap[id] = tsi
+		}
+		return smap
+	}
+
+	prev := newSmapT(1, "r1")
+	ctx := &smapModifier{smap: prev}
+	tassert.Errorf(t, !mustRebalance(ctx, newSmapT(2, "r1")), "same labels: expecting no rebalance")
+	tassert.Errorf(t, mustRebalance(ctx, newSmapT(2, "r2")), "relabeled: expecting rebalance")
+	tassert.Errorf(t, mustRebalance(ctx, newSmapT(2, "")), "unlabeled: expecting rebalance")
+}
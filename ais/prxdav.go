@@ -0,0 +1,371 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/webdav"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// WebDAV gateway: buckets are top-level collections, virtual directories (prefixes) are
+// nested collections, and objects are files.
+// - PROPFIND => list-objects (non-recursive)
+// - GET, HEAD, PUT, DELETE => reverse-proxied to the designated target
+// - MKCOL => zero-size marker object `DIR/` + davDirMarker, hidden from PROPFIND
+//   (otherwise, virtual directories exist only as long as there are objects under them)
+// Only buckets with feat.WebDAV (or the cluster-wide feature) are visible.
+// See also: docs/webdav.md
+
+const davDirMarker = ".webdav-dir"
+
+var errDavDisabled = errors.New("WebDAV access is disabled for this bucket (see feature flag \"WebDAV\")")
+
+// [METHOD] /webdav
+func (p *proxy) davHandler(w http.ResponseWriter, r *http.Request) {
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln("davHandler", p.String(), r.Method, r.URL)
+	}
+	apiItems, err := p.parseURL(w, r, apc.URLPathWebDAV.L, 0, true)
+	if err != nil {
+		return
+	}
+	webdav.BasicToBearer(r.Header)
+
+	switch r.Method {
+	case http.MethodOptions:
+		h := w.Header()
+		h.Set(webdav.HdrDAV, webdav.Class)
+		h.Set("Allow", strings.Join(webdav.Allowed, ", "))
+		h.Set("MS-Author-Via", "DAV")
+	case webdav.MethodPropfind:
+		p.davPropfind(w, r, apiItems)
+	case http.MethodGet:
+		p.davObj(w, r, apiItems, apc.AceGET)
+	case http.MethodHead:
+		p.davObj(w, r, apiItems, apc.AceObjHEAD)
+	case http.MethodPut:
+		p.davObj(w, r, apiItems, apc.AcePUT)
+	case http.MethodDelete:
+		p.davObj(w, r, apiItems, apc.AceObjDELETE)
+	case webdav.MethodMkcol:
+		p.davMkcol(w, r, apiItems)
+	default:
+		cmn.WriteErr405(w, r, webdav.Allowed...)
+	}
+}
+
+// PROPFIND /webdav[/<bucket-name>[/<path>]]
+func (p *proxy) davPropfind(w http.ResponseWriter, r *http.Request, items []string) {
+	var (
+		depth = webdav.ParseDepth(r.Header)
+		ms    = webdav.NewMultistatus()
+	)
+	if len(items) == 0 {
+		if err := p.access(r.Header, nil, apc.AceListBuckets); err != nil {
+			p.davErr(w, r, err, aceErrToCode(err))
+			return
+		}
+		ms.AddCollection(webdav.Href(true), "")
+		if depth == webdav.DepthOne {
+			bmd := p.owner.bmd.get()
+			bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+				if davEnabled(bck) {
+					ms.AddCollection(webdav.Href(true, bck.Name), bck.Name)
+				}
+				return false
+			})
+		}
+		p.davWrite(w, ms)
+		return
+	}
+
+	bck := p.davBck(w, r, items[0], apc.AceObjLIST)
+	if bck == nil {
+		return
+	}
+	amsg := &apc.ActMsg{Action: apc.ActList}
+	if p.forwardCP(w, r, amsg, lsotag+" "+bck.String()) {
+		return
+	}
+
+	var (
+		name   = strings.Trim(path.Join(items[1:]...), "/")
+		prefix string
+	)
+	if name != "" {
+		prefix = name + "/"
+	}
+	// (virtual) directory?
+	entries, err := p.davList(bck, prefix, r.Header, depth == webdav.DepthOne)
+	if err != nil {
+		p.davErr(w, r, err, 0)
+		return
+	}
+	if len(entries) > 0 || name == "" {
+		ms.AddCollection(webdav.Href(true, bck.Name, name), path.Base("/"+bck.Name+"/"+name))
+		if depth == webdav.DepthOne {
+			for _, en := range entries {
+				p.davAdd(ms, bck, en)
+			}
+		}
+		p.davWrite(w, ms)
+		return
+	}
+	if cos.IsLastB(r.URL.Path, '/') {
+		p.davErr(w, r, cos.NewErrNotFound(p, bck.Cname(name)), http.StatusNotFound)
+		return
+	}
+
+	// object?
+	en, ecode, err := p.davHead(bck, name)
+	if err != nil {
+		p.davErr(w, r, err, ecode)
+		return
+	}
+	p.davAdd(ms, bck, en)
+	p.davWrite(w, ms)
+}
+
+// non-recursive listing of a given (virtual) directory; !all: existence check
+func (p *proxy) davList(bck *meta.Bck, prefix string, hdr http.Header, all bool) ([]*cmn.LsoEnt, error) {
+	var (
+		amsg  = &apc.ActMsg{Action: apc.ActList}
+		lsmsg = &apc.LsoMsg{TimeFormat: http.TimeFormat, Prefix: prefix}
+	)
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime)
+	lsmsg.SetFlag(apc.LsNoRecursion)
+	amsg.Value = lsmsg
+	if !all {
+		lsmsg.PageSize = 1
+		lst, err := p.lsPage(bck, amsg, lsmsg, hdr, p.owner.smap.get())
+		if err != nil {
+			return nil, err
+		}
+		return lst.Entries, nil
+	}
+	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, hdr)
+	if err != nil {
+		return nil, err
+	}
+	return lst.Entries, nil
+}
+
+// HEAD(object) via designated target
+func (p *proxy) davHead(bck *meta.Bck, objName string) (*cmn.LsoEnt, int, error) {
+	smap := p.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		return nil, 0, err
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodHead,
+			Path:   apc.URLPathObjects.Join(bck.Name, objName),
+			Query:  bck.AddToQuery(nil),
+		}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		err, ecode := res.err, res.status
+		if ecode == http.StatusNotFound {
+			err = cos.NewErrNotFound(p, bck.Cname(objName))
+		}
+		freeCR(res)
+		return nil, ecode, err
+	}
+
+	var oa cmn.ObjAttrs
+	cksum := oa.FromHeader(res.header)
+	freeCR(res)
+	en := &cmn.LsoEnt{Name: objName, Size: oa.Size}
+	if oa.Atime != 0 {
+		en.Atime = time.Unix(0, oa.Atime).UTC().Format(http.TimeFormat)
+	}
+	if !cksum.IsEmpty() {
+		en.Checksum = cksum.Val()
+	}
+	return en, 0, nil
+}
+
+func (*proxy) davAdd(ms *webdav.Multistatus, bck *meta.Bck, en *cmn.LsoEnt) {
+	name := strings.TrimSuffix(en.Name, "/")
+	if name == "" || path.Base(name) == davDirMarker {
+		return
+	}
+	if en.IsDir() {
+		ms.AddCollection(webdav.Href(true, bck.Name, name), path.Base(name))
+		return
+	}
+	ms.AddFile(webdav.Href(false, bck.Name, name), path.Base(name), en.Size, en.Atime, en.Checksum)
+}
+
+// GET, HEAD, PUT, DELETE /webdav/<bucket-name>/<object-name>
+// rewrite as native API request and reverse-proxy it to the designated target
+func (p *proxy) davObj(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs) {
+	if len(items) < 2 {
+		cmn.WriteErr405(w, r, http.MethodOptions, webdav.MethodPropfind, webdav.MethodMkcol)
+		return
+	}
+	bck := p.davBck(w, r, items[0], ace)
+	if bck == nil {
+		return
+	}
+	objName := path.Join(items[1:]...)
+	if err := cmn.ValidOname(objName); err != nil {
+		p.davErr(w, r, err, 0)
+		return
+	}
+	smap := p.owner.smap.get()
+	si, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		p.davErr(w, r, err, 0)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln("webdav", r.Method, bck.Cname(objName), "=>", si.StringEx())
+	}
+
+	q := bck.NewQuery()
+	q.Set(apc.QparamProxyID, p.SID())
+	q.Set(apc.QparamUnixTime, cos.UnixNano2S(time.Now().UnixNano()))
+	r.URL.Path = apc.URLPathObjects.Join(bck.Name, objName)
+	r.URL.RawPath = ""
+	r.URL.RawQuery = q.Encode()
+	p.reverseNodeRequest(w, r, si)
+}
+
+// MKCOL /webdav/<bucket-name>/<path>
+func (p *proxy) davMkcol(w http.ResponseWriter, r *http.Request, items []string) {
+	if len(items) < 2 {
+		// creating buckets is out of scope
+		cmn.WriteErr405(w, r, http.MethodOptions, webdav.MethodPropfind)
+		return
+	}
+	bck := p.davBck(w, r, items[0], apc.AcePUT)
+	if bck == nil {
+		return
+	}
+	if r.ContentLength > 0 {
+		p.davErr(w, r, errors.New("MKCOL with request body is not supported"), http.StatusUnsupportedMediaType)
+		return
+	}
+	name := strings.Trim(path.Join(items[1:]...), "/")
+	objName := name + "/" + davDirMarker
+	if err := cmn.ValidOname(objName); err != nil {
+		p.davErr(w, r, err, 0)
+		return
+	}
+
+	// already exists (as a directory or an object)?
+	entries, err := p.davList(bck, name+"/", r.Header, false)
+	if err != nil {
+		p.davErr(w, r, err, 0)
+		return
+	}
+	if len(entries) == 0 {
+		if _, ecode, err := p.davHead(bck, name); err == nil {
+			entries = append(entries, &cmn.LsoEnt{Name: name})
+		} else if ecode != http.StatusNotFound {
+			p.davErr(w, r, err, ecode)
+			return
+		}
+	}
+	if len(entries) > 0 {
+		cmn.WriteErr405(w, r, http.MethodOptions, webdav.MethodPropfind)
+		return
+	}
+
+	if ecode, err := p.davPut(bck, objName); err != nil {
+		p.davErr(w, r, err, ecode)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// PUT(zero-size object) via designated target
+func (p *proxy) davPut(bck *meta.Bck, objName string) (int, error) {
+	smap := p.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		return 0, err
+	}
+	q := bck.NewQuery()
+	q.Set(apc.QparamProxyID, p.SID())
+	q.Set(apc.QparamUnixTime, cos.UnixNano2S(time.Now().UnixNano()))
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPut,
+			Path:   apc.URLPathObjects.Join(bck.Name, objName),
+			Query:  q,
+		}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	err, ecode := res.err, res.status
+	freeCR(res)
+	return ecode, err
+}
+
+//
+// misc. utils
+//
+
+func davEnabled(bck *meta.Bck) bool {
+	return cmn.Rom.Features().IsSet(feat.WebDAV) || (bck.Props != nil && bck.Props.Features.IsSet(feat.WebDAV))
+}
+
+func (p *proxy) davBck(w http.ResponseWriter, r *http.Request, bucket string, ace apc.AccessAttrs) *meta.Bck {
+	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		p.davErr(w, r, err, ecode)
+		return nil
+	}
+	if !davEnabled(bck) {
+		p.davErr(w, r, errDavDisabled, http.StatusForbidden)
+		return nil
+	}
+	if err := p.access(r.Header, bck, ace); err != nil {
+		p.davErr(w, r, err, aceErrToCode(err))
+		return nil
+	}
+	return bck
+}
+
+func (p *proxy) davErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
+	if ecode == http.StatusUnauthorized || err == tok.ErrNoToken {
+		// prompt for credentials
+		w.Header().Set(webdav.HdrWWWAuth, webdav.Realm)
+		ecode = http.StatusUnauthorized
+	}
+	p.writeErr(w, r, err, ecode)
+}
+
+func (p *proxy) davWrite(w http.ResponseWriter, ms *webdav.Multistatus) {
+	sgl := p.gmm.NewSGL(0)
+	ms.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, webdav.ContentXML)
+	w.WriteHeader(http.StatusMultiStatus)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
@@ -28,18 +28,20 @@ func (p *proxy) dloadHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	switch r.Method {
-	case http.MethodGet, http.MethodDelete:
+	case http.MethodGet, http.MethodDelete, http.MethodPut:
 		p.httpdladm(w, r)
 	case http.MethodPost:
 		p.httpdlpost(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
 	}
 }
 
-// httpDownloadAdmin is meant for aborting, removing and getting status updates for downloads.
+// httpDownloadAdmin is meant for aborting, removing, boosting, pausing/resuming,
+// and getting status updates for downloads.
 // GET /v1/download?id=...
 // DELETE /v1/download/{abort, remove}?id=...
+// PUT /v1/download/{boost, pause, resume}?id=...
 func (p *proxy) httpdladm(w http.ResponseWriter, r *http.Request) {
 	if !p.ClusterStarted() {
 		w.WriteHeader(http.StatusServiceUnavailable)
@@ -49,22 +51,32 @@ func (p *proxy) httpdladm(w http.ResponseWriter, r *http.Request) {
 	if err := cmn.ReadJSON(w, r, &msg); err != nil {
 		return
 	}
-	if err := msg.Validate(r.Method == http.MethodDelete); err != nil {
+	if err := msg.Validate(r.Method != http.MethodGet); err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
 
-	if r.Method == http.MethodDelete {
+	switch r.Method {
+	case http.MethodDelete:
 		items, err := cmn.ParseURL(r.URL.Path, apc.URLPathDownload.L, 1, false)
 		if err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
-
 		if items[0] != apc.Abort && items[0] != apc.Remove {
 			p.writeErrAct(w, r, items[0])
 			return
 		}
+	case http.MethodPut:
+		items, err := cmn.ParseURL(r.URL.Path, apc.URLPathDownload.L, 1, false)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if items[0] != apc.Boost && items[0] != apc.Pause && items[0] != apc.Resume {
+			p.writeErrAct(w, r, items[0])
+			return
+		}
 	}
 	if msg.ID != "" && p.ic.redirectToIC(w, r) {
 		return
@@ -95,6 +107,10 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
+	tk := p.jobToken(r)
+	if p.jobQuota(w, r, tk, apc.ActDownload, nil /*msg*/, body) {
+		return
+	}
 
 	var progressInterval = dload.DownloadProgressInterval
 	if dlBase.ProgressInterval != "" {
@@ -115,6 +131,9 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 	nl := dload.NewDownloadNL(jobID, string(dlb.Type), &smap.Smap, progressInterval)
 	nl.SetOwner(equalIC)
 	p.ic.registerEqual(regIC{nl: nl, smap: smap})
+	if jobLimited(tk) {
+		p.jq.addDload(tk.UserID, jobID)
+	}
 
 	b := cos.MustMarshal(dload.DlPostResp{ID: jobID})
 	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
@@ -124,7 +143,7 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 
 func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, error) {
 	config := cmn.GCO.Get()
-	if msg.ID != "" && method == http.MethodGet && msg.OnlyActive {
+	if msg.ID != "" && method == http.MethodGet && msg.OnlyActive && !msg.Progress {
 		nl := p.notifs.entry(msg.ID)
 		if nl != nil {
 			respBytes := p.dlstatus(nl, config)
@@ -201,17 +220,26 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 			return result, http.StatusOK, nil
 		}
 
+		if msg.Spec {
+			// same spec on all targets
+			return validResponses[0].bytes, http.StatusOK, nil
+		}
 		var stResp *dload.StatusResp
 		for _, resp := range validResponses {
 			status := dload.StatusResp{}
 			if err := jsoniter.Unmarshal(resp.bytes, &status); err != nil {
 				return nil, http.StatusInternalServerError, err
 			}
+			if msg.Progress {
+				// per-target breakdown
+				job := status.Job
+				status.Targets = map[string]*dload.Job{resp.si.ID(): &job}
+			}
 			stResp = stResp.Aggregate(&status)
 		}
 		body := cos.MustMarshal(stResp)
 		return body, http.StatusOK, nil
-	case http.MethodDelete:
+	case http.MethodDelete, http.MethodPut:
 		res := validResponses[0]
 		return res.bytes, res.status, res.err
 	default:
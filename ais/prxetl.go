@@ -78,6 +78,9 @@ func (p *proxy) httpetlget(w http.ResponseWriter, r *http.Request) {
 	case apc.ETLMetrics:
 		// /v1/etl/<etl-name>/metrics
 		p.metricsETL(w, r)
+	case apc.ETLStats:
+		// /v1/etl/<etl-name>/stats
+		p.statsETL(w, r)
 	default:
 		p.writeErrURL(w, r)
 	}
@@ -421,6 +424,28 @@ func (p *proxy) metricsETL(w http.ResponseWriter, r *http.Request) {
 	p.writeJSON(w, r, metrics, "metrics-etl")
 }
 
+// GET /v1/etl/<etl-name>/stats
+func (p *proxy) statsETL(w http.ResponseWriter, r *http.Request) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path}
+	args.timeout = apc.DefaultTimeout
+	args.cresv = cresES{} // -> etl.StatsByTarget
+	results := p.bcastGroup(args)
+	defer freeBcastRes(results)
+	freeBcArgs(args)
+
+	all := make(etl.StatsByTarget, 0, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr(), res.status)
+			return
+		}
+		all = append(all, res.v.(*etl.Stats))
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].TargetID < all[j].TargetID })
+	p.writeJSON(w, r, all, "stats-etl")
+}
+
 // POST /v1/etl/<etl-name>/stop
 func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request) {
 	args := allocBcArgs()
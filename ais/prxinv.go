@@ -0,0 +1,119 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Periodic (scheduled) AIS-native bucket inventories (see cmn.InventoryConf):
+// primary proxy starts x-make-inventory for each bucket with `inventory.enabled`
+// every `inventory.interval`.
+//
+// Notes:
+//   - schedule is tracked in memory: the first scheduled inventory is generated one interval
+//     after the bucket is first observed (enabled) by a given primary, including upon
+//     restart and primary change; use 'ais job start make-inventory' to generate one now;
+//   - retention (`inventory.keep`) is enforced by targets (see xact/xs/inventory.go).
+
+const invSchedIval = time.Minute
+
+type invSched struct {
+	p    *proxy
+	last map[string]int64 // bucket cname => last started (mono time)
+}
+
+func (is *invSched) init(p *proxy) {
+	is.p = p
+	is.last = make(map[string]int64, 4)
+	hk.Reg("inventory"+hk.NameSuffix, is.housekeep, invSchedIval)
+}
+
+func (is *invSched) housekeep(int64) time.Duration {
+	p := is.p
+	if !p.ClusterStarted() || !p.owner.smap.get().isPrimary(p.si) {
+		clear(is.last)
+		return invSchedIval
+	}
+	var (
+		now     = mono.NanoTime()
+		bmd     = p.owner.bmd.get()
+		enabled = make(map[string]struct{}, len(is.last))
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		conf := &bck.Props.Inventory
+		if !conf.Enabled {
+			return false
+		}
+		cname := bck.Cname("")
+		enabled[cname] = struct{}{}
+		last, ok := is.last[cname]
+		switch {
+		case !ok:
+			is.last[cname] = now
+		case time.Duration(now-last) >= conf.Interval.D():
+			is.last[cname] = now
+			go p.schedInventory(bck, cname) // (not to block housekeeper)
+		}
+		return false
+	})
+	// disabled or destroyed
+	for cname := range is.last {
+		if _, ok := enabled[cname]; !ok {
+			delete(is.last, cname)
+		}
+	}
+	return invSchedIval
+}
+
+func (p *proxy) schedInventory(bck *meta.Bck, cname string) {
+	xid, err := p.startInventory(bck)
+	if err != nil {
+		nlog.Errorln(p.String(), "failed to start scheduled inventory of", cname, "err:", err)
+		return
+	}
+	nlog.Infoln(p.String(), "scheduled inventory of", cname, "started:", xid)
+}
+
+// (compare with xstart)
+func (p *proxy) startInventory(bck *meta.Bck) (string, error) {
+	xargs := xact.ArgsMsg{ID: cos.GenUUID(), Kind: apc.ActMakeInventory, Bck: *bck.Bucket()}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPut,
+		Path:   apc.URLPathXactions.S,
+		Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActXactStart, Value: xargs}),
+	}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var err error
+	for _, res := range results {
+		if res.err != nil {
+			err = res.toErr()
+			break
+		}
+	}
+	freeBcastRes(results)
+	if err != nil {
+		return "", err
+	}
+	smap := p.owner.smap.get()
+	nl := xact.NewXactNL(xargs.ID, xargs.Kind, &smap.Smap, nil)
+	p.ic.registerEqual(regIC{smap: smap, nl: nl})
+	return xargs.ID, nil
+}
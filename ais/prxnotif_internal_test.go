@@ -27,6 +27,7 @@ type nopHB struct{}
 
 func (*nopHB) HeardFrom(string, int64) int64 { return 0 }
 func (*nopHB) TimedOut(string) bool          { return false }
+func (*nopHB) LastHeard(string) int64        { return 0 }
 func (*nopHB) reg(string)                    {}
 func (*nopHB) set(time.Duration) bool        { return false }
 
@@ -0,0 +1,118 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"golang.org/x/sync/singleflight"
+)
+
+// Ceph RGW bucket quota and usage (cmn.ExtraPropsRGW):
+// - HEAD(remote bucket) => target => RGW admin ops API (see ais/backend/rgw.go);
+// - never stored in BMD - added to the props returned by HEAD(bucket);
+// - cached for rgwStatsTTL to avoid remote round-trips upon every HEAD;
+// - concurrent cache misses (same bucket) share a single remote HEAD;
+// - upon BMD change, entries of the buckets that are no longer present (and expired entries) are pruned
+
+const rgwStatsTTL = 30 * time.Second
+
+type (
+	rgwStats struct {
+		bck   *meta.Bck
+		extra cmn.ExtraPropsRGW
+		ts    int64 // mono time
+	}
+	rgwCache struct {
+		m      map[string]*rgwStats // by bucket uname
+		group  singleflight.Group   // in-flight remote HEADs
+		bmdVer int64                // BMD version last pruned at
+		mu     sync.Mutex
+	}
+)
+
+func (p *proxy) addRGWStats(bck *meta.Bck) {
+	if bck.Props == nil {
+		return
+	}
+	extra, err := p.rgw.get(bck, p.owner.bmd.get(), func() (cmn.ExtraPropsRGW, error) {
+		hdr, _, err := p.headRemoteBck(bck.Bucket(), nil)
+		if err != nil {
+			return cmn.ExtraPropsRGW{}, err
+		}
+		return rgwStatsFromHdr(hdr), nil
+	})
+	if err != nil {
+		nlog.Warningln(p.String(), "failed to get", bck.Cname(""), "quota and usage:", err)
+		return
+	}
+	props := bck.Props.Clone()
+	props.Extra.RGW = extra
+	bck.Props = props // (not changing BMD)
+}
+
+func rgwStatsFromHdr(hdr http.Header) (extra cmn.ExtraPropsRGW) {
+	extra.QuotaMaxSize, _ = strconv.ParseInt(hdr.Get(apc.HdrRGWQuotaMaxSize), 10, 64)
+	extra.QuotaMaxObjects, _ = strconv.ParseInt(hdr.Get(apc.HdrRGWQuotaMaxObjects), 10, 64)
+	extra.UsedSize, _ = strconv.ParseInt(hdr.Get(apc.HdrRGWUsedSize), 10, 64)
+	extra.UsedObjects, _ = strconv.ParseInt(hdr.Get(apc.HdrRGWUsedObjects), 10, 64)
+	return extra
+}
+
+//////////////
+// rgwCache //
+//////////////
+
+func (c *rgwCache) get(bck *meta.Bck, bmd *bucketMD, fetch func() (cmn.ExtraPropsRGW, error)) (cmn.ExtraPropsRGW, error) {
+	var (
+		uname = string(bck.MakeUname(""))
+		now   = mono.NanoTime()
+	)
+	c.mu.Lock()
+	if bmd.Version != c.bmdVer {
+		c.prune(bmd, now)
+	}
+	v, ok := c.m[uname]
+	c.mu.Unlock()
+	if ok && time.Duration(now-v.ts) < rgwStatsTTL {
+		return v.extra, nil
+	}
+
+	res, err, _ := c.group.Do(uname, func() (any, error) {
+		extra, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		if c.m == nil {
+			c.m = make(map[string]*rgwStats, 4)
+		}
+		c.m[uname] = &rgwStats{bck: meta.CloneBck(bck.Bucket()), extra: extra, ts: mono.NanoTime()}
+		c.mu.Unlock()
+		return extra, nil
+	})
+	if err != nil {
+		return cmn.ExtraPropsRGW{}, err
+	}
+	return res.(cmn.ExtraPropsRGW), nil
+}
+
+// under lock
+func (c *rgwCache) prune(bmd *bucketMD, now int64) {
+	for uname, v := range c.m {
+		if _, present := bmd.Get(v.bck); !present || time.Duration(now-v.ts) >= rgwStatsTTL {
+			delete(c.m, uname)
+		}
+	}
+	c.bmdVer = bmd.Version
+}
@@ -0,0 +1,81 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestRGWStatsCache(t *testing.T) {
+	var (
+		c     rgwCache
+		bmd   = newBucketMD()
+		bck   = meta.NewBck("rgw-bucket", apc.RGW, cmn.NsGlobal)
+		calls atomic.Int32
+		start = make(chan struct{})
+		wg    sync.WaitGroup
+	)
+	bmd.add(bck, &cmn.Bprops{})
+	bmd.Version = 2
+
+	fetch := func() (cmn.ExtraPropsRGW, error) {
+		calls.Inc()
+		<-start
+		return cmn.ExtraPropsRGW{UsedSize: 1024, UsedObjects: 12}, nil
+	}
+
+	// concurrent misses share a single remote call
+	const num = 8
+	for range num {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			extra, err := c.get(bck, bmd, fetch)
+			tassert.CheckError(t, err)
+			tassert.Errorf(t, extra.UsedSize == 1024, "expected used size 1024, got %d", extra.UsedSize)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(start)
+	wg.Wait()
+	tassert.Errorf(t, calls.Load() == 1, "expected a single remote call, got %d", calls.Load())
+
+	// cached
+	_, err := c.get(bck, bmd, fetch)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, calls.Load() == 1, "expected cached stats, got %d remote calls", calls.Load())
+
+	// errors are not cached
+	other := meta.NewBck("other", apc.RGW, cmn.NsGlobal)
+	_, err = c.get(other, bmd, func() (cmn.ExtraPropsRGW, error) { return cmn.ExtraPropsRGW{}, errors.New("fail") })
+	tassert.Fatalf(t, err != nil, "expected error")
+	tassert.Errorf(t, len(c.m) == 1, "expected 1 cached entry, got %d", len(c.m))
+
+	// expired
+	c.m[string(bck.MakeUname(""))].ts = mono.NanoTime() - int64(rgwStatsTTL)
+	_, err = c.get(bck, bmd, fetch)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, calls.Load() == 2, "expected expired stats to be refetched, got %d remote calls", calls.Load())
+
+	// pruned upon BMD change
+	clone := bmd.clone()
+	clone.del(bck)
+	clone.Version++
+	_, err = c.get(other, clone, func() (cmn.ExtraPropsRGW, error) { return cmn.ExtraPropsRGW{}, nil })
+	tassert.CheckFatal(t, err)
+	_, ok := c.m[string(bck.MakeUname(""))]
+	tassert.Errorf(t, !ok, "expected %s to be pruned", bck)
+	tassert.Errorf(t, c.bmdVer == clone.Version, "expected BMD v%d, got v%d", clone.Version, c.bmdVer)
+}
@@ -41,6 +41,7 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 
 	// TODO: Fix the hack, https://github.com/tensorflow/tensorflow/issues/41798
 	cos.ReparseQuery(r)
+	p.reqID(w, r)
 	apiItems, err := p.parseURL(w, r, apc.URLPathS3.L, 0, true)
 	if err != nil {
 		return
@@ -0,0 +1,69 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Request IDs (apc.HdrRequestID) to correlate a given request across nodes:
+//   - proxy reuses the client-provided ID or generates a new one;
+//   - proxy passes it on to targets when redirecting (apc.QparamReqID);
+//   - target passes it on to remote backends upon cold GET (see cos.CtxReqID):
+//     custom header for remote AIS, S3, GCP, Azure, and HTTP; opc-client-request-id for OCI;
+//   - both return it to the client (response header) and include it in error
+//     responses and error logs (see cmn.ErrHTTP).
+// To find all log records of a given request: `ais log show cluster --request-id`.
+
+const maxReqIDLen = 64
+
+// (proxy)
+func (*proxy) reqID(w http.ResponseWriter, r *http.Request) {
+	rid := r.Header.Get(apc.HdrRequestID)
+	if rid == "" || len(rid) > maxReqIDLen || !cos.IsAlphaNice(rid) {
+		rid = cos.GenUUID()
+		r.Header.Set(apc.HdrRequestID, rid)
+	}
+	w.Header().Set(apc.HdrRequestID, rid)
+}
+
+// (target)
+func (*target) reqID(w http.ResponseWriter, r *http.Request) {
+	rid := r.Header.Get(apc.HdrRequestID)
+	if rid == "" {
+		if rid = reqIDQuery(r.URL.RawQuery); rid == "" {
+			return
+		}
+		r.Header.Set(apc.HdrRequestID, rid)
+	}
+	w.Header().Set(apc.HdrRequestID, rid)
+}
+
+// (same as dpq.parse - faster than r.URL.Query())
+func reqIDQuery(rawQuery string) string {
+	for rawQuery != "" {
+		var kv string
+		kv, rawQuery, _ = strings.Cut(rawQuery, "&")
+		if k, v, ok := _dpqKeqV(kv); ok && k == apc.QparamReqID {
+			if len(v) > maxReqIDLen || !cos.IsAlphaNice(v) {
+				return ""
+			}
+			return v
+		}
+	}
+	return ""
+}
+
+func ctxReqID(ctx context.Context, r *http.Request) context.Context {
+	if rid := r.Header.Get(apc.HdrRequestID); rid != "" {
+		return context.WithValue(ctx, cos.CtxReqID, rid)
+	}
+	return ctx
+}
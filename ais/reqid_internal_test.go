@@ -0,0 +1,29 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReqIDQuery(t *testing.T) {
+	tests := []struct {
+		query, rid string
+	}{
+		{"", ""},
+		{"provider=ais&pid=abc&utm=123", ""},
+		{"provider=ais&rid=Xy-12_z&utm=123", "Xy-12_z"},
+		{"rid=abc", "abc"},
+		{"rid=a%20b", ""},    // invalid
+		{"rid=-abc", ""},     // ditto
+		{"xrid=abc&rid", ""}, // no value
+	}
+	for _, test := range tests {
+		rid := reqIDQuery(test.query)
+		tassert.Errorf(t, rid == test.rid, "%q: expected %q, got %q", test.query, test.rid, rid)
+	}
+}
@@ -7,6 +7,7 @@ package ais
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"net/url"
@@ -15,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/ais/backend"
@@ -59,7 +61,12 @@ type (
 		disabled atomic.Bool // true: standing by
 		prevbmd  atomic.Bool // special
 	}
-	backends map[string]core.Backend
+	// remote backends by provider: copy-on-write
+	// (datapath reads never lock; reinitialization, enable/disable replace the entire map)
+	backends struct {
+		m  ratomic.Pointer[map[string]core.Backend]
+		mu sync.Mutex // serialize updates
+	}
 	// main
 	target struct {
 		htrun
@@ -69,7 +76,11 @@ type (
 		reb          *reb.Reb
 		res          *res.Res
 		transactions transactions
+		ra           readAhead
+		secrets      tgtSecrets
 		regstate     regstate
+		busage       bckUsage
+		cusage       cntUsage
 	}
 )
 
@@ -97,6 +108,37 @@ func (*target) interruptedRestarted() (i, r bool) {
 	return i, r
 }
 
+//////////////
+// backends //
+//////////////
+
+func (b *backends) init(size int) {
+	m := make(map[string]core.Backend, size)
+	b.m.Store(&m)
+}
+
+func (b *backends) get(provider string) (bp core.Backend, ok bool) {
+	bp, ok = (*b.m.Load())[provider]
+	return bp, ok
+}
+
+// nil backend: disabled (configured but not enabled, or not linked)
+func (b *backends) set(provider string, bp core.Backend) {
+	b.mu.Lock()
+	m := maps.Clone(*b.m.Load())
+	m[provider] = bp
+	b.m.Store(&m)
+	b.mu.Unlock()
+}
+
+func (b *backends) del(provider string) {
+	b.mu.Lock()
+	m := maps.Clone(*b.m.Load())
+	delete(m, provider)
+	b.m.Store(&m)
+	b.mu.Unlock()
+}
+
 //
 // target
 //
@@ -104,7 +146,7 @@ func (*target) interruptedRestarted() (i, r bool) {
 func (t *target) initBackends(tstats *stats.Trunner) {
 	config := cmn.GCO.Get()
 	aisbp := backend.NewAIS(t, tstats, true)
-	t.backend[apc.AIS] = aisbp // always present
+	t.backend.set(apc.AIS, aisbp) // always present
 
 	if aisConf := config.Backend.Get(apc.AIS); aisConf != nil {
 		if err := aisbp.Apply(aisConf, "init", &config.ClusterConfig); err != nil {
@@ -138,14 +180,18 @@ func (t *target) initBuiltTagged(tstats *stats.Trunner, config *cmn.Config, star
 			add, err = backend.NewAzure(t, tstats, startingUp)
 		case apc.OCI:
 			add, err = backend.NewOCI(t, tstats, startingUp)
+		case apc.RGW:
+			add, err = backend.NewRGW(t, config, tstats, startingUp)
 		case apc.HT:
 			add, err = backend.NewHT(t, config, tstats, startingUp)
+		case apc.Ext:
+			add, err = backend.NewExt(t, config, tstats, startingUp)
 		case apc.AIS:
 			continue
 		default:
 			return fmt.Errorf("unknown backend provider %q", provider)
 		}
-		t.backend[provider] = add
+		t.backend.set(provider, add)
 
 		configured := config.Backend.Get(provider) != nil
 		switch {
@@ -192,7 +238,7 @@ func (t *target) initBuiltTagged(tstats *stats.Trunner, config *cmn.Config, star
 }
 
 func (t *target) aisbp() *backend.AISbp {
-	bendp := t.backend[apc.AIS]
+	bendp, _ := t.backend.get(apc.AIS)
 	return bendp.(*backend.AISbp)
 }
 
@@ -337,6 +383,7 @@ func (t *target) Run() error {
 	// register object type and workfile type
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.ObjMDType, &fs.ObjMDContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -381,7 +428,8 @@ func (t *target) Run() error {
 
 	tstats.RegMetrics(t.si)
 
-	t.initBackends(tstats) // (+ reg backend metrics)
+	t.secrets.init(t, config) // (backend credentials - before backends)
+	t.initBackends(tstats)    // (+ reg backend metrics)
 
 	// end target metrics -----------------------
 
@@ -392,6 +440,9 @@ func (t *target) Run() error {
 	}
 
 	t.transactions.init(t)
+	t.ra.init(t)
+	t.secrets.regHK()
+	t.idem.init(nil)
 
 	t.reb = reb.New(config)
 	t.res = res.New()
@@ -411,6 +462,7 @@ func (t *target) Run() error {
 
 	dsort.Tinit(t.statsT, db, config)
 	dload.Init(t.statsT, db, &config.Client)
+	etl.Tinit(t.statsT)
 
 	err = t.htrun.run(config)
 
@@ -596,6 +648,7 @@ func (t *target) errURL(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/buckets
 func (t *target) bucketHandler(w http.ResponseWriter, r *http.Request) {
+	t.reqID(w, r)
 	switch r.Method {
 	case http.MethodGet:
 		dpq := dpqAlloc()
@@ -620,6 +673,11 @@ func (t *target) bucketHandler(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/objects
 func (t *target) objectHandler(w http.ResponseWriter, r *http.Request) {
+	t.reqID(w, r)
+	t.idem.do(w, r, t._objectHandler)
+}
+
+func (t *target) _objectHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, true /*dpq*/)
@@ -707,8 +765,12 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 
 	// two special flows
 	if dpq.etlName != "" {
-		t.getETL(w, r, dpq.etlName, lom)
-		return lom, nil
+		if !t.getETL(w, r, dpq.etlName, lom) {
+			return lom, nil
+		}
+		// fallback: transformer is unavailable - proceed to GET the original object
+		// (see bucket props: etl.on_error)
+		dpq.etlName = ""
 	}
 	if cos.IsParseBool(r.Header.Get(apc.HdrBlobDownload)) {
 		var msg apc.BlobMsg
@@ -746,7 +808,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.dpq = dpq
 		goi.req = r
 		goi.w = w
-		goi.ctx = context.Background()
+		goi.ctx = ctxReqID(context.Background(), r)
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
 		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
 	}
@@ -795,6 +857,14 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 				t._erris(w, r, err, ecode, !goi.isIOErr /*silent*/)
 			}
 		}
+	} else {
+		if goi.hedged {
+			bck := *goi.lom.Bucket() // (copy: lom gets freed)
+			go t.hedgeRevalidate(&bck, goi.lom.ObjName)
+		}
+		if lom.Bprops().ReadAhead.Enabled {
+			t.ra.onGet(goi.lom)
+		}
 	}
 	lom = goi.lom
 	freeGOI(goi)
@@ -854,6 +924,11 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 	}
 
+	if err := t.checkFrozen(lom.Bck(), cmn.OwtPut); err != nil {
+		t.writeErr(w, r, err, http.StatusLocked)
+		return
+	}
+
 	// load (maybe)
 	skipVC := lom.IsFeatureSet(feat.SkipVC) || apireq.dpq.skipVC
 	if !skipVC {
@@ -945,6 +1020,11 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		core.FreeLOM(lom)
 		return
 	}
+	if err := lom.Bck().CheckFrozen(r.Method); err != nil {
+		t.writeErr(w, r, err, http.StatusLocked)
+		core.FreeLOM(lom)
+		return
+	}
 
 	ecode, err := t.DeleteObject(lom, evict)
 	if err == nil && ecode == 0 {
@@ -976,6 +1056,10 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		t.writeErrf(w, r, "%s: %s-%s(obj) is expected to be redirected", t.si, r.Method, msg.Action)
 		return
 	}
+	if err := apireq.bck.CheckFrozen(msg.Action); err != nil {
+		t.writeErr(w, r, err, http.StatusLocked)
+		return
+	}
 	var lom *core.LOM
 	switch msg.Action {
 	case apc.ActRenameObject:
@@ -1133,10 +1217,10 @@ func (t *target) objHead(r *http.Request, whdr http.Header, q url.Values, bck *m
 		var oa *cmn.ObjAttrs
 		oa, ecode, err = t.HeadCold(lom, r)
 		if err != nil {
-			if ecode != http.StatusNotFound {
+			if ecode != http.StatusNotFound && !cmn.IsErrTimeout(err) {
 				err = cmn.NewErrFailedTo(t, "HEAD", lom.Cname(), err)
-			} else if latest {
-				ecode = http.StatusGone
+			} else if ecode == http.StatusNotFound && latest {
+				ecode = http.StatusGone // (remote deleted)
 			}
 			return
 		}
@@ -1184,10 +1268,15 @@ func (t *target) objHead(r *http.Request, whdr http.Header, q url.Values, bck *m
 // PATCH /v1/objects/<bucket-name>/<object-name>
 // By default, adds or updates existing custom keys. Will remove all existing keys and
 // replace them with the specified ones _iff_ `apc.QparamNewCustom` is set.
+// With `apc.QparamPatchOffset`, writes the request body in place at the given offset instead.
 func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	if err := t.parseReq(w, r, apireq); err != nil {
 		return
 	}
+	if err := apireq.bck.CheckFrozen(r.Method); err != nil {
+		t.writeErr(w, r, err, http.StatusLocked)
+		return
+	}
 	if cmn.Rom.Features().IsSet(feat.EnforceIntraClusterAccess) {
 		if isRedirect(apireq.query) == "" && t.checkIntraCall(r.Header, false) != nil {
 			t.writeErrf(w, r, "%s: %s(obj) is expected to be redirected (remaddr=%s)",
@@ -1195,6 +1284,10 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 			return
 		}
 	}
+	if apireq.query.Has(apc.QparamPatchOffset) {
+		t.patchObjRange(w, r, apireq)
+		return
+	}
 
 	msg, err := t.readActionMsg(w, r)
 	if err != nil {
@@ -1231,6 +1324,33 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 	lom.Persist()
 }
 
+func (t *target) patchObjRange(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
+	offset, err := strconv.ParseInt(apireq.query.Get(apc.QparamPatchOffset), 10, 64)
+	if err != nil || offset < 0 {
+		t.writeErrf(w, r, "%s: invalid %q value %q", t.si, apc.QparamPatchOffset, apireq.query.Get(apc.QparamPatchOffset))
+		return
+	}
+	lom := core.AllocLOM(apireq.items[1] /*objName*/)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(apireq.bck.Bucket()); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if !lom.Bck().IsAIS() {
+		t.writeErr(w, r, cmn.NewErrUnsupp("patch (ranged write)", lom.Cname()+" - not an ais:// bucket"), http.StatusNotImplemented)
+		return
+	}
+	started := time.Now()
+	pti := &patchOI{t: t, lom: lom, r: r.Body, offset: offset}
+	if ecode, err := pti.do(); err != nil {
+		t.writeErr(w, r, err, ecode)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln("PATCH", lom.Cname(), "offset", offset, "size", pti.size, "latency", time.Since(started))
+	}
+}
+
 // called under lock
 func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq *dpq) (int, error) {
 	var (
@@ -1282,6 +1402,18 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	return a.do()
 }
 
+// frozen bucket: reject writes by users and jobs, except rebalance and cold GET;
+// checking the current BMD (bucket props may have changed since lom.InitBck)
+func (t *target) checkFrozen(bck *meta.Bck, owt cmn.OWT) error {
+	if owt >= cmn.OwtRebalance && owt < cmn.OwtCopySameBucket { // rebalance, GET and friends
+		return nil
+	}
+	if props, present := t.owner.bmd.get().Get(bck); present && props.Frozen {
+		return cmn.NewErrBucketFrozen(bck.String(), owt.String())
+	}
+	return nil
+}
+
 func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
@@ -442,6 +442,56 @@ func testArch(t *testing.T, bck *meta.Bck) {
 	}
 }
 
+func TestArchMultiObjSplit(t *testing.T) {
+	var (
+		m = ioContext{
+			t:       t,
+			bck:     cmn.Bck{Name: trand.String(10), Provider: apc.AIS},
+			num:     20,
+			prefix:  "split/",
+			ordered: true,
+		}
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		maxRecs    = 6
+		numShards  = (m.num + maxRecs - 1) / maxRecs
+	)
+	tools.CreateBucket(t, proxyURL, m.bck, nil, true /*cleanup*/)
+	m.init(true /*cleanup*/)
+	m.puts()
+
+	bckTo := cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+	tools.CreateBucket(t, proxyURL, bckTo, nil, true /*cleanup*/)
+
+	msg := cmn.ArchiveBckMsg{
+		ToBck:      bckTo,
+		ArchiveMsg: apc.ArchiveMsg{ArchName: "out" + archive.ExtTar, MaxShardRecs: int64(maxRecs)},
+	}
+	msg.ListRange.Template = m.prefix
+	_, err := api.ArchiveMultiObj(baseParams, m.bck, &msg)
+	tassert.CheckFatal(t, err)
+
+	flt := xact.ArgsMsg{Kind: apc.ActArchive, Bck: m.bck}
+	api.WaitForXactionIdle(baseParams, &flt)
+
+	lsmsg := &apc.LsoMsg{Prefix: "out-"}
+	lsmsg.SetFlag(apc.LsArchDir)
+	lst, err := api.ListObjects(baseParams, bckTo, lsmsg, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+
+	var shards, recs int
+	for _, en := range lst.Entries {
+		if en.IsInsideArch() {
+			recs++
+			continue
+		}
+		tassert.Errorf(t, en.Name == archive.ShardName(msg.ArchName, shards), "unexpected shard name %q", en.Name)
+		shards++
+	}
+	tassert.Errorf(t, shards == numShards, "expected %d shards, have %d", numShards, shards)
+	tassert.Errorf(t, recs == m.num, "expected %d archived objects, have %d", m.num, recs)
+}
+
 // exercises `api.ArchiveMultiObj` followed by api.PutApndArch(local rand-reader)
 func TestAppendToArch(t *testing.T) {
 	var (
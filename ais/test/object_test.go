@@ -306,6 +306,104 @@ func TestAppendObject(t *testing.T) {
 	}
 }
 
+func TestPatchObject(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "test/patched"
+		content    = []byte("0000000000111111111122222222223333333333")
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	_, err := api.PutObject(&api.PutArgs{
+		BaseParams: baseParams,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     readers.NewBytes(content),
+	})
+	tassert.CheckFatal(t, err)
+
+	patches := []struct {
+		data   string
+		offset int64
+	}{
+		{"abc", 0},
+		{"XYZXYZ", 15},
+		{"tail-grows", int64(len(content)) - 4},
+	}
+	expected := content
+	for _, p := range patches {
+		err := api.PatchObject(&api.PatchArgs{
+			BaseParams: baseParams,
+			Bck:        bck,
+			Object:     objName,
+			Offset:     p.offset,
+			Reader:     cos.NewByteHandle([]byte(p.data)),
+			Size:       int64(len(p.data)),
+		})
+		tassert.CheckFatal(t, err)
+		if end := int(p.offset) + len(p.data); end > len(expected) {
+			expected = append(expected, make([]byte, end-len(expected))...)
+		}
+		copy(expected[p.offset:], p.data)
+	}
+
+	// read back and validate (checksum must have been recomputed)
+	writer := bytes.NewBuffer(nil)
+	oah, err := api.GetObjectWithValidation(baseParams, bck, objName, &api.GetArgs{Writer: writer})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, writer.String() == string(expected), "invalid content: %q, expected: %q", writer.String(), expected)
+	tassert.Errorf(t, oah.Size() == int64(len(expected)), "invalid size %d, expected %d", oah.Size(), len(expected))
+
+	// holes are not permitted
+	err = api.PatchObject(&api.PatchArgs{
+		BaseParams: baseParams,
+		Bck:        bck,
+		Object:     objName,
+		Offset:     int64(len(expected)) + 1,
+		Reader:     cos.NewByteHandle([]byte("x")),
+		Size:       1,
+	})
+	tassert.Errorf(t, err != nil, "expected error patching beyond the end of %s", bck.Cname(objName))
+}
+
+func TestGetObjectParallel(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "large/obj"
+		content    = make([]byte, 5*cos.MiB+123)
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	for i := range content {
+		content[i] = byte(rand.IntN(256))
+	}
+	_, err := api.PutObject(&api.PutArgs{
+		BaseParams: baseParams,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     cos.NewByteHandle(content),
+	})
+	tassert.CheckFatal(t, err)
+
+	for _, partSize := range []int64{64 * cos.KiB, cos.MiB + 1, 16 * cos.MiB} {
+		fh, err := os.CreateTemp(t.TempDir(), "getpar")
+		tassert.CheckFatal(t, err)
+
+		size, err := api.GetObjectParallel(baseParams, bck, objName, fh, partSize, 6)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, size == int64(len(content)), "expected size %d, got %d", len(content), size)
+
+		fh.Close()
+		b, err := os.ReadFile(fh.Name())
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(b, content), "part size %d: content mismatch", partSize)
+	}
+}
+
 func TestSameBucketName(t *testing.T) {
 	var (
 		proxyURL   = tools.RandomProxyURL(t)
@@ -392,8 +392,8 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			debug.AssertNoErr(errs[0])
 			t.writeErr(w, r, errs[0]) // only 1 err is possible for 1 bck
 		}
-	case apc.ActDeleteObjects, apc.ActEvictObjects:
-		lrMsg := &apc.ListRange{}
+	case apc.ActDeleteObjects, apc.ActEvictObjects, apc.ActTierObjects:
+		lrMsg := &apc.EvdMsg{}
 		if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
 			return
@@ -411,6 +411,7 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			return
 		}
 		xctn := rns.Entry.Get()
+		xctn.SetUser(msg.User)
 		notif := &xact.NotifXact{
 			Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
 			Xact: xctn,
@@ -449,7 +450,9 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	}
 	if ecode, err := t.runPrefetch(msg.UUID, apireq.bck, prfMsg); err != nil {
 		t.writeErr(w, r, err, ecode)
+		return
 	}
+	setXactUser(msg.UUID, msg.User)
 }
 
 // handle apc.ActPrefetchObjects <-- via api.Prefetch* and api.StartX*
@@ -0,0 +1,174 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// per-bucket usage and activity (apc.WhatBckUsage) to list buckets with sizes,
+// object counts, and last PUT/GET times without running bucket summary:
+// - last PUT/GET: in memory, updated upon successful user GET and PUT
+// - object count and size: walking object directories and counting only the objects
+//   that this target owns - main replicas at their HRW locations (so that cluster-wide
+//   sums don't double-count mirrored copies and objects in transit during rebalance);
+// - computed once (synchronously) and then refreshed in the background when older than `busageTTL`
+
+const busageTTL = time.Minute
+
+type (
+	bckActivity struct {
+		lastPut ratomic.Int64
+		lastGet ratomic.Int64
+	}
+	bckUsageEntry struct {
+		num, size uint64
+		ts        int64 // mono.NanoTime
+		bid       uint64
+	}
+	bckUsage struct {
+		act   sync.Map // uname => *bckActivity
+		cache sync.Map // uname => *bckUsageEntry
+		busy  sync.Map // uname => struct{} (refreshing)
+	}
+)
+
+func (bu *bckUsage) activity(bck *meta.Bck) *bckActivity {
+	uname := string(bck.MakeUname(""))
+	if v, ok := bu.act.Load(uname); ok {
+		return v.(*bckActivity)
+	}
+	v, _ := bu.act.LoadOrStore(uname, &bckActivity{})
+	return v.(*bckActivity)
+}
+
+func (bu *bckUsage) put(bck *meta.Bck) { bu.activity(bck).lastPut.Store(time.Now().UnixNano()) }
+func (bu *bckUsage) get(bck *meta.Bck) { bu.activity(bck).lastGet.Store(time.Now().UnixNano()) }
+
+func (bu *bckUsage) usage(bck *meta.Bck, now int64, keep func(*fs.Mountpath, string) bool) (*cmn.BckUsage, error) {
+	var (
+		uname = string(bck.MakeUname(""))
+		out   = &cmn.BckUsage{Bck: *bck.Bucket()}
+	)
+	if v, ok := bu.act.Load(uname); ok {
+		act := v.(*bckActivity)
+		out.LastPut, out.LastGet = act.lastPut.Load(), act.lastGet.Load()
+	}
+	if v, ok := bu.cache.Load(uname); ok {
+		en := v.(*bckUsageEntry)
+		if en.bid == bck.Props.BID {
+			if time.Duration(now-en.ts) >= busageTTL {
+				if _, loaded := bu.busy.LoadOrStore(uname, struct{}{}); !loaded {
+					go bu.refresh(bck, uname, keep)
+				}
+			}
+			out.ObjCount, out.Size = en.num, en.size
+			return out, nil
+		}
+	}
+	en, err := bu.compute(bck, uname, keep)
+	if err != nil {
+		return nil, err
+	}
+	out.ObjCount, out.Size = en.num, en.size
+	return out, nil
+}
+
+func (bu *bckUsage) refresh(bck *meta.Bck, uname string, keep func(*fs.Mountpath, string) bool) {
+	if _, err := bu.compute(bck, uname, keep); err != nil {
+		nlog.Warningln("failed to refresh", bck.Cname(""), "usage:", err)
+	}
+	bu.busy.Delete(uname)
+}
+
+func (bu *bckUsage) compute(bck *meta.Bck, uname string, keep func(*fs.Mountpath, string) bool) (*bckUsageEntry, error) {
+	num, size, err := fs.ObjFiles(bck.Bucket(), keep)
+	if err != nil {
+		return nil, err
+	}
+	en := &bckUsageEntry{num: num, size: size, ts: mono.NanoTime(), bid: bck.Props.BID}
+	bu.cache.Store(uname, en)
+	return en, nil
+}
+
+// selects objects that this target owns: main replicas at their HRW locations
+func (t *target) busageKeep(bck *meta.Bck) func(*fs.Mountpath, string) bool {
+	smap := t.owner.smap.get()
+	return func(mi *fs.Mountpath, objName string) bool {
+		uname := bck.MakeUname(objName)
+		tsi, err := smap.HrwName2T(uname)
+		if err != nil || tsi.ID() != t.SID() {
+			return false
+		}
+		hmi, _, err := fs.Hrw(uname)
+		return err == nil && hmi == mi
+	}
+}
+
+// forget buckets that no longer exist
+func (bu *bckUsage) prune(bmd *bucketMD) {
+	f := func(k, _ any) bool {
+		b, _ := cmn.ParseUname(k.(string))
+		if _, present := bmd.Get(meta.CloneBck(&b)); !present {
+			bu.act.Delete(k)
+			bu.cache.Delete(k)
+		}
+		return true
+	}
+	bu.act.Range(f)
+	bu.cache.Range(f)
+}
+
+// GET /v1/daemon?what=bck_usage[&provider=...&namespace=...]
+func (t *target) bckUsage(w http.ResponseWriter, r *http.Request, query url.Values) {
+	var (
+		bmd  = t.owner.bmd.get()
+		qbck = cmn.QueryBcks{Provider: query.Get(apc.QparamProvider)}
+		now  = mono.NanoTime()
+		all  = make(cmn.AllBckUsage, 0, 16)
+		cp   *string
+		err  error
+	)
+	if qbck.Provider != "" {
+		cp = &qbck.Provider
+	}
+	if ns := query.Get(apc.QparamNamespace); ns != "" {
+		qbck.Ns = cmn.ParseNsUname(ns)
+	}
+	bmd.Range(cp, nil, func(bck *meta.Bck) bool {
+		if b := bck.Bucket(); !qbck.Equal(b) && !qbck.Contains(b) {
+			return false
+		}
+		var u *cmn.BckUsage
+		if u, err = t.busage.usage(bck, now, t.busageKeep(bck)); err != nil {
+			err = cmn.NewErrFailedTo(t, "compute usage", bck.Cname(""), err)
+			return true
+		}
+		all = append(all, u)
+		return false
+	})
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.busage.prune(bmd)
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String(), "bucket usage:", len(all))
+	}
+	t.writeJSON(w, r, all, "httpdaeget-"+apc.WhatBckUsage)
+}
@@ -0,0 +1,68 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBckUsage(tt *testing.T) {
+	var (
+		bu  bckUsage
+		bck = meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0xb1})
+		mi  = fs.GetAvail()[testMountpath]
+		put = func(name, data string) {
+			fqn := mi.MakePathFQN(bck.Bucket(), fs.ObjectType, name)
+			tassert.CheckFatal(tt, cos.CreateDir(filepath.Dir(fqn)))
+			tassert.CheckFatal(tt, os.WriteFile(fqn, []byte(data), cos.PermRWR))
+			tt.Cleanup(func() { os.Remove(fqn) })
+		}
+		// e.g., objects that belong to other targets
+		keep = func(_ *fs.Mountpath, objName string) bool { return !strings.HasPrefix(objName, "other/") }
+	)
+	tassert.Fatalf(tt, mi != nil, "missing %s", testMountpath)
+	put("busage/a", "aaaa")
+	put("busage/b", "bb")
+	put("other/c", "cccccccc")
+
+	bu.put(bck)
+	u, err := bu.usage(bck, mono.NanoTime(), keep)
+	tassert.CheckFatal(tt, err)
+	tassert.Errorf(tt, u.ObjCount == 2 && u.Size == 6, "expected (2, 6), got (%d, %d)", u.ObjCount, u.Size)
+	tassert.Errorf(tt, u.LastPut != 0, "expected last PUT")
+
+	// cached
+	put("busage/d", "d")
+	u, err = bu.usage(bck, mono.NanoTime(), keep)
+	tassert.CheckFatal(tt, err)
+	tassert.Errorf(tt, u.ObjCount == 2, "expected cached count 2, got %d", u.ObjCount)
+
+	// stale: returns cached values right away, refreshes in the background
+	u, err = bu.usage(bck, mono.NanoTime()+int64(busageTTL), keep)
+	tassert.CheckFatal(tt, err)
+	tassert.Errorf(tt, u.ObjCount == 2, "expected (stale) cached count 2, got %d", u.ObjCount)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		u, err = bu.usage(bck, mono.NanoTime(), keep)
+		tassert.CheckFatal(tt, err)
+		if u.ObjCount == 3 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	tassert.Errorf(tt, u.ObjCount == 3 && u.Size == 7, "expected refreshed (3, 7), got (%d, %d)", u.ObjCount, u.Size)
+}
@@ -183,34 +183,26 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 		errorsOnly := msg.Value.(bool)
 		t.statsT.ResetStats(errorsOnly)
 	case apc.ActReloadBackendCreds:
-		var (
-			tstats   = t.statsT.(*stats.Trunner)
-			provider = msg.Name
-		)
+		provider := msg.Name
+		config := cmn.GCO.Get()
+		if config.Secrets.Provider != "" {
+			// re-resolve from secrets provider, regardless of whether changed
+			if _, err := t.secrets.resolve(&config.Secrets); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
 		if provider == "" { // all
-			if err := t.initBuiltTagged(t.statsT.(*stats.Trunner), cmn.GCO.Get(), false); err != nil {
+			if err := t.initBuiltTagged(t.statsT.(*stats.Trunner), config, false); err != nil {
 				t.writeErr(w, r, err)
 			}
 			return
 		}
-
 		// one
-		var add core.Backend
-		switch provider {
-		case apc.AWS:
-			add, err = backend.NewAWS(t, tstats, false /*starting up*/)
-		case apc.GCP:
-			add, err = backend.NewGCP(t, tstats, false)
-		case apc.Azure:
-			add, err = backend.NewAzure(t, tstats, false)
-		case apc.OCI:
-			add, err = backend.NewOCI(t, tstats, false)
-		}
-		if err != nil {
+		if err := t.reloadBackend(provider); err != nil {
 			t.writeErr(w, r, err)
 			return
 		}
-		t.backend[provider] = add
 	case apc.ActStartMaintenance:
 		if !t.ensureIntraControl(w, r, true /* from primary */) {
 			return
@@ -290,7 +282,7 @@ func (t *target) enableBackend(w http.ResponseWriter, r *http.Request, items []s
 		t.writeErrf(w, r, "backend %q is not configured, cannot enable", provider)
 		return
 	}
-	bp, k := t.backend[provider]
+	bp, k := t.backend.get(provider)
 	debug.Assert(k, provider)
 	if bp != nil {
 		// TODO: return http.StatusNoContent
@@ -308,13 +300,17 @@ func (t *target) enableBackend(w http.ResponseWriter, r *http.Request, items []s
 			bp, err = backend.NewAzure(t, t.statsT, false /*starting up*/)
 		case apc.OCI:
 			bp, err = backend.NewOCI(t, t.statsT, false /*starting up*/)
+		case apc.RGW:
+			bp, err = backend.NewRGW(t, config, t.statsT, false /*starting up*/)
+		case apc.Ext:
+			bp, err = backend.NewExt(t, config, t.statsT, false /*starting up*/)
 		}
 		if err != nil {
 			debug.AssertNoErr(err) // (unlikely)
 			t.writeErr(w, r, err)
 			return
 		}
-		t.backend[provider] = bp
+		t.backend.set(provider, bp)
 	}
 	nlog.Infoln(phase+":", "enable", provider)
 }
@@ -334,7 +330,7 @@ func (t *target) disableBackend(w http.ResponseWriter, r *http.Request, items []
 		t.writeErrf(w, r, "backend %q is not configured, nothing to do", provider)
 		return
 	}
-	bp, k := t.backend[provider]
+	bp, k := t.backend.get(provider)
 	debug.Assert(k, provider)
 	if bp == nil {
 		// TODO: return http.StatusNoContent
@@ -342,7 +338,7 @@ func (t *target) disableBackend(w http.ResponseWriter, r *http.Request, items []
 		return
 	}
 	if phase == apc.ActCommit {
-		t.backend[provider] = nil
+		t.backend.set(provider, nil)
 	}
 	nlog.Infoln(phase+":", "disable", provider)
 }
@@ -398,7 +394,7 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	)
 	switch what {
 	case apc.WhatNodeConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
-		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames:
+		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames, apc.WhatElections, apc.WhatSmapHist:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	case apc.WhatSysInfo:
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
@@ -442,6 +438,10 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		fs.DiskStats(dstats, nil, config, true /*refresh cap*/)
 		mpl := fs.ToMPL()
 		t.writeJSON(w, r, mpl, httpdaeWhat)
+	case apc.WhatContent:
+		t.contentUsage(w, r)
+	case apc.WhatBckUsage:
+		t.bckUsage(w, r, query)
 	case apc.WhatDiskRWUtilCap:
 		var (
 			tcdfExt fs.TcdfExt
@@ -607,17 +607,18 @@ func (t *target) enableMpath(w http.ResponseWriter, r *http.Request, mpath strin
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
+	if err := t.createMissingBckDirs(enabledMi); err != nil {
+		t.writeErr(w, r, err)
+	}
+}
 
-	// create missing buckets dirs
+func (t *target) createMissingBckDirs(mi *fs.Mountpath) (err error) {
 	bmd := t.owner.bmd.get()
 	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
-		err = enabledMi.CreateMissingBckDirs(bck.Bucket())
+		err = mi.CreateMissingBckDirs(bck.Bucket())
 		return err != nil // break on error
 	})
-	if err != nil {
-		t.writeErr(w, r, err)
-		return
-	}
+	return err
 }
 
 func (t *target) attachMpath(w http.ResponseWriter, r *http.Request, mpath string) {
@@ -847,6 +848,9 @@ func (f *delb) do(nbck *meta.Bck) bool {
 		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
 		xreg.DoAbort(flt, errors.New("apply-bmd"))
 	}
+	if !f.obck.Props.Frozen && nbck.Props.Frozen {
+		xreg.AbortModifying(cmn.NewErrBucketFrozen(nbck.String(), "running job"), nbck)
+	}
 	return true // break
 }
 
@@ -1217,7 +1221,7 @@ func (t *target) receiveConfig(newConfig *globalConfig, msg *actMsgExt, payload
 		if aisConf := newConfig.Backend.Get(apc.AIS); aisConf != nil {
 			err = t.attachDetachRemAis(newConfig, msg)
 		} else {
-			t.backend[apc.AIS] = backend.NewAIS(t, t.statsT, false)
+			t.backend.set(apc.AIS, backend.NewAIS(t, t.statsT, false))
 		}
 	}
 	return
@@ -1483,6 +1487,9 @@ func (t *target) Stop(err error) {
 	}()
 
 	xreg.AbortAll(err)
+	if t.fshc != nil {
+		t.fshc.Stop()
+	}
 
 	t.htrun.stop(wg, g.netServ.pub.s != nil && !isErrNoUnregister(err) /*rm from Smap*/)
 }
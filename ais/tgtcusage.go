@@ -0,0 +1,102 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// used space per content type per mountpath (apc.WhatContent):
+// - walks content directories of all buckets on all available mountpaths (see fs.ContentUsage);
+// - computed once (synchronously) and then refreshed in the background when older than `cusageTTL`;
+// - recomputed synchronously when BMD version or the set of available mountpaths changes;
+// - at most one walk at a time
+
+const cusageTTL = time.Minute
+
+type cntUsage struct {
+	usage  apc.ContentUsage
+	ts     int64 // mono.NanoTime
+	bmdVer int64
+	mu     sync.Mutex   // protects the above
+	wmu    sync.Mutex   // serializes walks
+	busy   ratomic.Bool // refreshing in the background
+}
+
+func (cu *cntUsage) get(bmd *bucketMD, now int64) apc.ContentUsage {
+	if usage, ok := cu.cached(bmd, now); ok {
+		return usage
+	}
+	cu.wmu.Lock()
+	defer cu.wmu.Unlock()
+	if usage, ok := cu.cached(bmd, now); ok { // computed in the meantime
+		return usage
+	}
+	return cu.compute(bmd)
+}
+
+func (cu *cntUsage) cached(bmd *bucketMD, now int64) (apc.ContentUsage, bool) {
+	cu.mu.Lock()
+	usage, ts, ver := cu.usage, cu.ts, cu.bmdVer
+	cu.mu.Unlock()
+	if usage == nil || ver != bmd.Version || !cusageAvail(usage) {
+		return nil, false
+	}
+	if time.Duration(now-ts) >= cusageTTL && cu.busy.CompareAndSwap(false, true) {
+		go cu.refresh(bmd)
+	}
+	return usage, true
+}
+
+func (cu *cntUsage) refresh(bmd *bucketMD) {
+	cu.wmu.Lock()
+	cu.compute(bmd)
+	cu.wmu.Unlock()
+	cu.busy.Store(false)
+}
+
+// under wmu
+func (cu *cntUsage) compute(bmd *bucketMD) apc.ContentUsage {
+	bcks := make([]cmn.Bck, 0, 16)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		bcks = append(bcks, *bck.Bucket())
+		return false
+	})
+	usage := fs.ContentUsage(bcks)
+	cu.mu.Lock()
+	cu.usage, cu.ts, cu.bmdVer = usage, mono.NanoTime(), bmd.Version
+	cu.mu.Unlock()
+	return usage
+}
+
+// same mountpaths?
+func cusageAvail(usage apc.ContentUsage) bool {
+	avail := fs.GetAvail()
+	if len(avail) != len(usage) {
+		return false
+	}
+	for mpath := range avail {
+		if _, ok := usage[mpath]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// GET /v1/daemon?what=content
+func (t *target) contentUsage(w http.ResponseWriter, r *http.Request) {
+	usage := t.cusage.get(t.owner.bmd.get(), mono.NanoTime())
+	t.writeJSON(w, r, usage, "httpdaeget-"+apc.WhatContent)
+}
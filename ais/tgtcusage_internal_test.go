@@ -0,0 +1,65 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// (global target, see TestMain)
+func curBMD() *bucketMD { return t.owner.bmd.get() }
+
+func TestContentUsageCache(t *testing.T) {
+	var (
+		cu   cntUsage
+		bmd  = curBMD()
+		now  = mono.NanoTime()
+		objs = func(usage apc.ContentUsage) (size uint64) {
+			for _, cts := range usage {
+				size += cts[fs.ObjectType]
+			}
+			return size
+		}
+		put = func(name, data string) {
+			lom := core.AllocLOM(name)
+			tassert.CheckFatal(t, lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+			patchPut(t, lom, data)
+			t.Cleanup(func() { lom.RemoveMain(); core.FreeLOM(lom) })
+		}
+	)
+	put("cusage-1", "0123456789")
+	before := objs(cu.get(bmd, now))
+	tassert.Fatalf(t, before > 0, "expecting objects")
+
+	// cached (note: size on disk)
+	put("cusage-2", "0123456789")
+	size := objs(cu.get(bmd, now))
+	tassert.Errorf(t, size == before, "expecting cached %d, got %d", before, size)
+
+	// stale: served from cache while refreshing in the background
+	size = objs(cu.get(bmd, mono.NanoTime()+int64(cusageTTL)))
+	tassert.Errorf(t, size == before, "expecting cached %d, got %d", before, size)
+	for i := 0; cu.busy.Load() && i < 100; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	size = objs(cu.get(bmd, now))
+	tassert.Fatalf(t, size > before, "expecting refreshed (greater than %d), got %d", before, size)
+	before = size
+
+	// new BMD version: recomputed right away
+	put("cusage-3", "0123456789")
+	clone := bmd.clone()
+	clone.Version++
+	size = objs(cu.get(clone, now))
+	tassert.Errorf(t, size > before, "expecting recomputed (greater than %d), got %d", before, size)
+}
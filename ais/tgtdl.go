@@ -121,7 +121,9 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		if msg.ID != "" {
+		if msg.Spec {
+			response, statusCode, respErr = dload.JobSpec(msg.ID)
+		} else if msg.ID != "" {
 			xid := r.URL.Query().Get(apc.QparamUUID)
 			debug.Assert(cos.IsValidUUID(xid))
 			xdl, err := renewdl(xid, nil)
@@ -129,7 +131,11 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 				t.writeErr(w, r, err, http.StatusInternalServerError)
 				return
 			}
-			response, statusCode, respErr = xdl.JobStatus(msg.ID, msg.OnlyActive)
+			if msg.Progress {
+				response, statusCode, respErr = xdl.JobProgress(msg.ID)
+			} else {
+				response, statusCode, respErr = xdl.JobStatus(msg.ID, msg.OnlyActive)
+			}
 		} else {
 			var regex *regexp.Regexp
 			if msg.Regex != "" {
@@ -140,7 +146,7 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 				}
 				regex = rgx
 			}
-			response, statusCode, respErr = dload.ListJobs(regex, msg.OnlyActive)
+			response, statusCode, respErr = dload.ListJobs(regex, msg.OnlyActive, msg.Status)
 		}
 
 	case http.MethodDelete:
@@ -176,8 +182,41 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 		} else { // apc.Remove
 			response, statusCode, respErr = xdl.RemoveJob(payload.ID)
 		}
+	case http.MethodPut:
+		items, err := t.parseURL(w, r, apc.URLPathDownload.L, 1, false)
+		if err != nil {
+			return
+		}
+		actput := items[0]
+		if actput != apc.Boost && actput != apc.Pause && actput != apc.Resume {
+			t.writeErrAct(w, r, actput)
+			return
+		}
+		payload := &dload.AdminBody{}
+		if err = cmn.ReadJSON(w, r, payload); err != nil {
+			return
+		}
+		if err = payload.Validate(true /*requireID*/); err != nil {
+			debug.Assert(false)
+			t.writeErr(w, r, err)
+			return
+		}
+		xid := r.URL.Query().Get(apc.QparamUUID)
+		xdl, err := renewdl(xid, nil)
+		if err != nil {
+			t.writeErr(w, r, err, http.StatusInternalServerError)
+			return
+		}
+		switch actput {
+		case apc.Boost:
+			response, statusCode, respErr = xdl.BoostJob(payload.ID)
+		case apc.Pause:
+			response, statusCode, respErr = xdl.PauseJob(payload.ID)
+		default: // apc.Resume
+			response, statusCode, respErr = xdl.ResumeJob(payload.ID)
+		}
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
 		return
 	}
 
@@ -8,19 +8,24 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
 )
 
+const etlRetrySleep = 100 * time.Millisecond // (linear backoff between inline transformation retries)
+
 // [METHOD] /v1/etl
 func (t *target) etlHandler(w http.ResponseWriter, r *http.Request) {
 	if !k8s.IsK8s() {
@@ -109,7 +114,7 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// /v1/etl/<etl-name>/logs or /v1/etl/<etl-name>/health or /v1/etl/<etl-name>/metrics
+	// /v1/etl/<etl-name>/{logs | health | metrics | stats}
 	switch apiItems[1] {
 	case apc.ETLLogs:
 		t.logsETL(w, r, apiItems[0])
@@ -118,6 +123,8 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request) {
 	case apc.ETLMetrics:
 		k8s.InitMetricsClient()
 		t.metricsETL(w, r, apiItems[0])
+	case apc.ETLStats:
+		t.statsETL(w, r, apiItems[0])
 	default:
 		t.writeErrURL(w, r)
 	}
@@ -150,13 +157,20 @@ func (t *target) stopETL(w http.ResponseWriter, r *http.Request, etlName string)
 	}
 }
 
-func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string, lom *core.LOM) {
+// inline transformation, subject to the bucket's ETL policy (timeout, retries, on_error);
+// returns true to fall back to the original (untransformed) object
+func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string, lom *core.LOM) (fallback bool) {
 	var (
-		comm etl.Communicator
-		err  error
+		comm   etl.Communicator
+		policy = &lom.Bprops().ETL
+		err    error
 	)
 	comm, err = etl.GetCommunicator(etlName)
 	if err != nil {
+		if cos.IsErrNotFound(err) && policy.Fallback() {
+			t._etlFallback(etlName, lom, err)
+			return true
+		}
 		if cos.IsErrNotFound(err) {
 			smap := t.owner.smap.Get()
 			errV := fmt.Errorf("%v - try starting new ETL with \"%s/v1/etl/init\" endpoint",
@@ -165,14 +179,36 @@ func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string,
 			return
 		}
 		t.writeErr(w, r, err)
-		return
+		return false
+	}
+	for i := 0; ; i++ {
+		started := mono.NanoTime()
+		err = comm.InlineTransform(w, r, lom, policy.Timeout.D())
+		comm.ObjDone(true /*inline*/, lom.Lsize(true), started, err)
+		if err == nil || !etl.IsErrUnavail(err) || i >= policy.Retries {
+			break
+		}
+		time.Sleep(time.Duration(i+1) * etlRetrySleep)
+	}
+	if err == nil {
+		return false
 	}
-	if err := comm.InlineTransform(w, r, lom); err != nil {
-		errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
-			err.Error())
-		xetl := comm.Xact()
-		xetl.AddErr(errV)
-		t.writeErr(w, r, errV)
+	errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
+		err.Error())
+	xetl := comm.Xact()
+	xetl.AddErr(errV)
+	if etl.IsErrUnavail(err) && policy.Fallback() {
+		t._etlFallback(etlName, lom, err)
+		return true
+	}
+	t.writeErr(w, r, errV)
+	return false
+}
+
+func (t *target) _etlFallback(etlName string, lom *core.LOM, err error) {
+	t.statsT.IncWith(stats.ETLInlineFallbackCount, map[string]string{stats.VarlabETL: etlName})
+	if cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Warningln(t.String(), "ETL", etlName, "- serving original", lom.Cname(), "["+err.Error()+"]")
 	}
 }
 
@@ -211,6 +247,19 @@ func (t *target) metricsETL(w http.ResponseWriter, r *http.Request, etlName stri
 	t.writeJSON(w, r, metricMsg, "metrics-etl")
 }
 
+func (t *target) statsETL(w http.ResponseWriter, r *http.Request, etlName string) {
+	xstats, err := etl.GetStats(etlName)
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			t.writeErr(w, r, err, http.StatusNotFound, Silent)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+	t.writeJSON(w, r, xstats, "stats-etl")
+}
+
 func etlParseObjectReq(_ http.ResponseWriter, r *http.Request) (secret string, bck *meta.Bck, objName string, err error) {
 	var items []string
 	items, err = cmn.ParseURL(r.URL.EscapedPath(), apc.URLPathETLObject.L, 2, false)
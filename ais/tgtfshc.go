@@ -62,10 +62,30 @@ func (t *target) FSHC(err error, mi *fs.Mountpath, fqn string) {
 	t.fshc.OnErr(mi, fqn)
 }
 
-// implements health.disabler interface
+// implements health.mpather interface
 func (t *target) DisableMpath(mi *fs.Mountpath) (err error) {
 	_, err = t.fsprg.disableMpath(mi.Path, true /*dont-resilver*/)
 
 	t.statsT.SetFlag(cos.NodeAlerts, cos.DiskFault)
 	return err
 }
+
+// ditto (re-enable after probation)
+func (t *target) EnableMpath(mi *fs.Mountpath) error {
+	enabledMi, err := t.fsprg.enableMpath(mi.Path)
+	if err != nil || enabledMi == nil {
+		return err
+	}
+	return t.createMissingBckDirs(enabledMi)
+}
+
+// clear node alert when none of the remaining mountpaths is disabled by FSHC
+func (t *target) clrDiskFault() {
+	_, disabled := fs.Get()
+	for _, mi := range disabled {
+		if mi.IsAnySet(fs.FlagDisabledByFSHC) {
+			return
+		}
+	}
+	t.statsT.ClrFlag(cos.NodeAlerts, cos.DiskFault)
+}
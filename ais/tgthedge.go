@@ -0,0 +1,233 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Hedged reads (cold GET): race the bucket's remote backend against an alternative
+// source (`hedge.source`, see cmn.HedgeConf). The request to the alternative source
+// starts after `hedge.delay` or immediately upon primary failure; the first successful
+// response wins, and the other one gets cancelled.
+// When the alternative source wins, the (cold-GET) object is then re-validated against
+// the remote backend (see hedgeRevalidate).
+
+type (
+	hedgeRes struct {
+		res core.GetReaderResult
+		alt bool
+	}
+	// reader that also releases its context (hedged read's winner; cold GET w/ timeout)
+	cancelReader struct {
+		io.ReadCloser
+		cancel context.CancelFunc
+	}
+)
+
+func (r *cancelReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
+
+// returns hedged = true when the alternative source wins
+func (t *target) getObjReader(ctx context.Context, lom *core.LOM) (_ core.GetReaderResult, hedged bool) {
+	var (
+		hedge   = &lom.Bprops().Hedge
+		backend = t.Backend(lom.Bck())
+	)
+	if !hedge.Enabled {
+		return backend.GetObjReader(ctx, lom, 0, 0), false
+	}
+	altLOM, err := hedgeLOM(lom)
+	if err != nil {
+		nlog.Warningln(lom.Cname(), "hedged read disabled:", err)
+		return backend.GetObjReader(ctx, lom, 0, 0), false
+	}
+
+	var (
+		ch            = make(chan hedgeRes, 2)
+		pctx, pcancel = context.WithCancel(ctx)
+		actx, acancel = context.WithCancel(ctx)
+		timer         = time.NewTimer(hedge.Delay.D())
+		pending       = 1
+		started       bool
+		perr          *core.GetReaderResult
+	)
+	defer timer.Stop()
+	go func() {
+		ch <- hedgeRes{res: backend.GetObjReader(pctx, lom, 0, 0)}
+	}()
+	startAlt := func() {
+		started = true
+		pending++
+		t.statsT.IncBck(stats.HedgeCount, lom.Bucket())
+		go func() {
+			ch <- hedgeRes{res: t.Backend(altLOM.Bck()).GetObjReader(actx, altLOM, 0, 0), alt: true}
+		}()
+	}
+
+	for pending > 0 {
+		var r hedgeRes
+		select {
+		case r = <-ch:
+		case <-timer.C:
+			if !started {
+				startAlt()
+			}
+			continue
+		}
+		pending--
+		if r.res.Err != nil {
+			if !r.alt {
+				perr = &r.res
+				if cos.IsNotExist(r.res.Err, r.res.ErrCode) {
+					break // primary's "not found" is authoritative
+				}
+				if !started {
+					startAlt()
+				}
+			}
+			continue
+		}
+		// winner
+		if r.alt {
+			pcancel()
+			if pending > 0 {
+				// wait for the primary to terminate before updating `lom`
+				if p := <-ch; p.res.Err == nil {
+					cos.Close(p.res.R)
+				}
+			}
+			hedgeWon(lom, altLOM)
+			t.statsT.IncBck(stats.HedgeWinCount, lom.Bucket())
+			r.res.R = &cancelReader{r.res.R, acancel}
+		} else {
+			acancel()
+			if pending > 0 {
+				go hedgeDrain(ch, altLOM)
+				altLOM = nil
+			}
+			r.res.R = &cancelReader{r.res.R, pcancel}
+		}
+		if altLOM != nil {
+			core.FreeLOM(altLOM)
+		}
+		return r.res, r.alt
+	}
+
+	// both failed (or primary returned "not found"): cancel everything and report primary error
+	pcancel()
+	acancel()
+	if pending > 0 {
+		go hedgeDrain(ch, altLOM)
+	} else {
+		core.FreeLOM(altLOM)
+	}
+	debug.Assert(perr != nil)
+	return *perr, false
+}
+
+func hedgeLOM(lom *core.LOM) (*core.LOM, error) {
+	bck, err := lom.Bprops().Hedge.Bck()
+	if err != nil {
+		return nil, err
+	}
+	altLOM := core.AllocLOM(lom.ObjName)
+	if err := altLOM.InitBck(&bck); err != nil {
+		core.FreeLOM(altLOM)
+		return nil, err
+	}
+	return altLOM, nil
+}
+
+// alternative source won: content checksums (if any) carry over; source-specific
+// metadata (version, ETag) - only when both sources share the same origin
+// (e.g., remote ais:// bucket that caches the same cloud bucket)
+func hedgeWon(lom, altLOM *core.LOM) {
+	var (
+		md     = make(cos.StrKVs, 4)
+		src, _ = altLOM.GetCustomKey(cmn.SourceObjMD)
+		same   = src == lom.Bck().RemoteBck().Provider
+	)
+	for k, v := range altLOM.GetCustomMD() {
+		switch k {
+		case cmn.MD5ObjMD, cmn.CRC32CObjMD:
+			md[k] = v
+		case cmn.SourceObjMD, cmn.VersionObjMD, cmn.ETag:
+			if same {
+				md[k] = v
+			}
+		}
+	}
+	lom.SetCustomMD(md)
+	if same {
+		lom.CopyVersion(altLOM)
+	}
+	if cksum := altLOM.Checksum(); cksum != nil && cksum.Type() != cos.ChecksumNone {
+		lom.SetCksum(cksum)
+	}
+}
+
+// the object that was cold-GET from the alternative source may be stale:
+// HEAD the remote backend and compare; when equal, adopt remote version (and metadata);
+// otherwise, evict the object so that the next GET goes to the remote backend
+func (t *target) hedgeRevalidate(bck *cmn.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return
+	}
+	oa, ecode, err := t.HeadCold(lom, nil)
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if lom.Load(false /*cache it*/, true /*locked*/) != nil {
+		return // deleted or evicted in the meantime
+	}
+	switch {
+	case err == nil:
+		if err = lom.CheckEq(oa); err == nil {
+			lom.CopyVersion(oa)
+			for k, v := range oa.GetCustomMD() {
+				lom.SetCustomKey(k, v)
+			}
+			if err = lom.Persist(); err != nil {
+				nlog.Errorln(t.String(), "failed to persist re-validated", lom.Cname(), "err:", err)
+			}
+			return
+		}
+	case cos.IsNotExist(err, ecode):
+	default:
+		// keeping the (unversioned) object - subject to 'versioning.validate_warm_get', if enabled
+		nlog.Warningln(t.String(), "failed to re-validate hedged", lom.Cname(), "err:", err)
+		return
+	}
+	nlog.Warningln(t.String(), "evicting hedged", lom.Cname(), "- not matching remote:", err)
+	if err := lom.RemoveObj(); err != nil {
+		nlog.Errorln(t.String(), "failed to evict", lom.Cname(), "err:", err)
+	}
+}
+
+// close the loser's reader
+func hedgeDrain(ch chan hedgeRes, altLOM *core.LOM) {
+	r := <-ch
+	if r.res.Err == nil {
+		cos.Close(r.res.R)
+	}
+	if altLOM != nil {
+		core.FreeLOM(altLOM)
+	}
+}
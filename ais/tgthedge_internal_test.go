@@ -0,0 +1,264 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type (
+	// remote backend that responds (or fails) after a given delay
+	hedgeBackend struct {
+		core.Backend // (not implemented)
+		err          error
+		head         *cmn.ObjAttrs
+		md           cos.StrKVs
+		data         string
+		provider     string
+		delay        time.Duration
+		headDelay    time.Duration
+		calls        atomic.Int32
+		cancelled    atomic.Bool
+	}
+)
+
+const hedgeTestDelay = 20 * time.Millisecond
+
+func (b *hedgeBackend) Provider() string           { return b.provider }
+func (b *hedgeBackend) MetricName(n string) string { return b.provider + "." + n }
+
+func (b *hedgeBackend) GetObjReader(ctx context.Context, lom *core.LOM, _, _ int64) core.GetReaderResult {
+	b.calls.Add(1)
+	select {
+	case <-time.After(b.delay):
+	case <-ctx.Done():
+		b.cancelled.Store(true)
+		return core.GetReaderResult{Err: ctx.Err(), ErrCode: http.StatusInternalServerError}
+	}
+	if b.err != nil {
+		ecode := http.StatusInternalServerError
+		if cos.IsNotExist(b.err, 0) {
+			ecode = http.StatusNotFound
+		}
+		return core.GetReaderResult{Err: b.err, ErrCode: ecode}
+	}
+	lom.SetCustomMD(maps.Clone(b.md))
+	if ver, ok := b.md[cmn.VersionObjMD]; ok {
+		lom.SetVersion(ver)
+	}
+	return core.GetReaderResult{R: io.NopCloser(strings.NewReader(b.data)), Size: int64(len(b.data))}
+}
+
+func (b *hedgeBackend) HeadObj(ctx context.Context, _ *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
+	if b.headDelay > 0 {
+		select {
+		case <-time.After(b.headDelay):
+		case <-ctx.Done():
+			return nil, http.StatusInternalServerError, ctx.Err()
+		}
+	}
+	if b.head == nil {
+		return nil, http.StatusNotFound, cos.NewErrNotFound(nil, "hedge-test")
+	}
+	return b.head, 0, nil
+}
+
+// primary aws://hedge-primary with hedged reads from gcp://hedge-alt
+func hedgeSetup(tb testing.TB, primary, alt *hedgeBackend) *meta.Bck {
+	var (
+		config = cmn.GCO.Get()
+		bck    = meta.NewBck("hedge-primary", apc.AWS, cmn.NsGlobal)
+		altBck = meta.NewBck("hedge-alt", apc.GCP, cmn.NsGlobal)
+	)
+	primary.provider, alt.provider = apc.AWS, apc.GCP
+	if config.Backend.Providers == nil {
+		config.Backend.Providers = make(map[string]cmn.Ns, 2)
+	}
+	for _, b := range []*hedgeBackend{primary, alt} {
+		config.Backend.Providers[b.provider] = cmn.NsGlobal
+		t.backend.set(b.provider, b)
+	}
+	bmd := t.owner.bmd.get().clone()
+	if _, present := bmd.Get(bck); !present {
+		bmd.add(bck, &cmn.Bprops{
+			Cksum: cmn.CksumConf{Type: cos.ChecksumNone},
+			Hedge: cmn.HedgeConf{Source: altBck.Cname(""), Delay: cos.Duration(hedgeTestDelay), Enabled: true},
+		})
+		bmd.add(altBck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+		tassert.CheckFatal(tb, t.owner.bmd.putPersist(bmd, nil))
+		errs := fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+		tassert.Fatalf(tb, len(errs) == 0, "failed to create %s: %v", bck, errs)
+	}
+	tb.Cleanup(func() {
+		for _, b := range []*hedgeBackend{primary, alt} {
+			delete(config.Backend.Providers, b.provider)
+			t.backend.del(b.provider)
+		}
+	})
+	return bck
+}
+
+func hedgeGet(ctx context.Context, tb testing.TB, bck *meta.Bck, objName string) (lom *core.LOM, res core.GetReaderResult, hedged bool, data string) {
+	lom = core.AllocLOM(objName)
+	tassert.CheckFatal(tb, lom.InitBck(bck.Bucket()))
+	res, hedged = t.getObjReader(ctx, lom)
+	if res.Err == nil {
+		b, err := io.ReadAll(res.R)
+		tassert.CheckFatal(tb, err)
+		tassert.CheckFatal(tb, res.R.Close())
+		data = string(b)
+	}
+	return lom, res, hedged, data
+}
+
+// store object as if it was cold-GET from the alternative source
+func hedgePut(tb testing.TB, bck *meta.Bck, objName string, md cos.StrKVs) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(tb, lom.InitBck(bck.Bucket()))
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	lom.SetCustomMD(md)
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       io.NopCloser(strings.NewReader("hedged")),
+		size:    6,
+		workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileColdget),
+		config:  cmn.GCO.Get(),
+		owt:     cmn.OwtGet,
+		coldGET: true,
+	}
+	_, err := poi.putObject()
+	tassert.CheckFatal(tb, err)
+}
+
+func hedgeRevalidate(bck *meta.Bck, objName string) { t.hedgeRevalidate(bck.Bucket(), objName) }
+
+func TestHedgePrimaryWins(t *testing.T) {
+	var (
+		primary = &hedgeBackend{data: "primary", md: cos.StrKVs{cmn.VersionObjMD: "v1"}}
+		alt     = &hedgeBackend{data: "alt", delay: time.Minute}
+		bck     = hedgeSetup(t, primary, alt)
+	)
+	lom, res, hedged, data := hedgeGet(context.Background(), t, bck, "obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, res.Err)
+	tassert.Errorf(t, !hedged && data == "primary", "expected primary to win, got (%t, %q)", hedged, data)
+	tassert.Errorf(t, alt.calls.Load() == 0, "alternative source must not be used")
+	tassert.Errorf(t, lom.Version() == "v1", "expected primary version, got %q", lom.Version())
+}
+
+func TestHedgeAltWins(t *testing.T) {
+	var (
+		primary = &hedgeBackend{data: "primary", delay: time.Minute}
+		alt     = &hedgeBackend{data: "alt", md: cos.StrKVs{
+			cmn.SourceObjMD: apc.GCP, cmn.VersionObjMD: "gcp-generation", cmn.MD5ObjMD: "md5",
+		}}
+		bck = hedgeSetup(t, primary, alt)
+	)
+	lom, res, hedged, data := hedgeGet(context.Background(), t, bck, "obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, res.Err)
+	tassert.Errorf(t, hedged && data == "alt", "expected alternative source to win, got (%t, %q)", hedged, data)
+	tassert.Errorf(t, primary.cancelled.Load(), "primary must be cancelled")
+
+	// different origin: content checksum only
+	md5, _ := lom.GetCustomKey(cmn.MD5ObjMD)
+	_, hasSrc := lom.GetCustomKey(cmn.SourceObjMD)
+	tassert.Errorf(t, md5 == "md5" && !hasSrc && lom.Version() == "", "unexpected metadata %v (version %q)",
+		lom.GetCustomMD(), lom.Version())
+}
+
+func TestHedgePrimaryFails(t *testing.T) {
+	var (
+		primary = &hedgeBackend{err: errors.New("primary failed")}
+		alt     = &hedgeBackend{data: "alt", md: cos.StrKVs{cmn.SourceObjMD: apc.AWS, cmn.VersionObjMD: "v1"}}
+		bck     = hedgeSetup(t, primary, alt)
+		started = time.Now()
+	)
+	// primary fails - the alternative starts right away (not waiting for hedge.delay)
+	lom, res, hedged, data := hedgeGet(context.Background(), t, bck, "obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, res.Err)
+	tassert.Errorf(t, hedged && data == "alt", "expected alternative source to win, got (%t, %q)", hedged, data)
+	tassert.Errorf(t, time.Since(started) < time.Minute, "took too long")
+
+	// same origin: version carries over
+	tassert.Errorf(t, lom.Version() == "v1", "expected version v1, got %q", lom.Version())
+
+	// primary's "not found" is authoritative
+	primary.err = cos.NewErrNotFound(nil, "obj")
+	lom2, res, _, _ := hedgeGet(context.Background(), t, bck, "obj")
+	defer core.FreeLOM(lom2)
+	tassert.Fatalf(t, cos.IsNotExist(res.Err, res.ErrCode), "expected not found, got %v", res.Err)
+	tassert.Errorf(t, alt.calls.Load() == 1, "expected a single request to the alternative source, got %d", alt.calls.Load())
+}
+
+func TestHedgeCancel(t *testing.T) {
+	var (
+		primary     = &hedgeBackend{data: "primary", delay: time.Minute}
+		alt         = &hedgeBackend{data: "alt", delay: time.Minute}
+		bck         = hedgeSetup(t, primary, alt)
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+	time.AfterFunc(5*hedgeTestDelay, cancel)
+	lom, res, hedged, _ := hedgeGet(ctx, t, bck, "obj")
+	defer core.FreeLOM(lom)
+	tassert.Fatalf(t, res.Err != nil && !hedged, "expected cancellation, got (%v, %t)", res.Err, hedged)
+	tassert.Errorf(t, errors.Is(res.Err, context.Canceled), "expected context canceled, got %v", res.Err)
+	tassert.Errorf(t, primary.cancelled.Load() && alt.cancelled.Load(), "both requests must be cancelled")
+}
+
+func TestHedgeRevalidate(t *testing.T) {
+	var (
+		primary = &hedgeBackend{}
+		alt     = &hedgeBackend{}
+		bck     = hedgeSetup(t, primary, alt)
+		objName = "revalidate"
+	)
+	load := func() (*core.LOM, error) {
+		lom := core.AllocLOM(objName)
+		tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+		return lom, lom.Load(false, false)
+	}
+
+	// remote matches: adopt remote version
+	hedgePut(t, bck, objName, cos.StrKVs{cmn.ETag: "etag", cmn.MD5ObjMD: "md5"})
+	primary.head = &cmn.ObjAttrs{Size: 6, CustomMD: cos.StrKVs{
+		cmn.SourceObjMD: apc.AWS, cmn.VersionObjMD: "v2", cmn.ETag: "etag", cmn.MD5ObjMD: "md5",
+	}}
+	primary.head.SetVersion("v2")
+	hedgeRevalidate(bck, objName)
+	lom, err := load()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, lom.Version() == "v2", "expected re-validated version v2, got %q", lom.Version())
+	core.FreeLOM(lom)
+
+	// stale: evict
+	hedgePut(t, bck, objName, cos.StrKVs{cmn.ETag: "etag", cmn.MD5ObjMD: "md5"})
+	primary.head.CustomMD = cos.StrKVs{cmn.SourceObjMD: apc.AWS, cmn.VersionObjMD: "v3", cmn.ETag: "etag3", cmn.MD5ObjMD: "md5-3"}
+	hedgeRevalidate(bck, objName)
+	lom, err = load()
+	tassert.Errorf(t, cos.IsNotExist(err, 0), "expected stale object to be evicted, got %v", err)
+	core.FreeLOM(lom)
+}
@@ -40,7 +40,8 @@ func (t *target) Health(si *meta.Snode, timeout time.Duration, query url.Values)
 
 func (t *target) Backend(bck *meta.Bck) core.Backend {
 	if bck.IsRemoteAIS() {
-		return t.backend[apc.AIS]
+		bp, _ := t.backend.get(apc.AIS)
+		return bp
 	}
 	provider := bck.Provider
 	if bck.Props != nil {
@@ -48,7 +49,7 @@ func (t *target) Backend(bck *meta.Bck) core.Backend {
 	}
 	config := cmn.GCO.Get()
 	if _, ok := config.Backend.Providers[provider]; ok {
-		bp, k := t.backend[provider]
+		bp, k := t.backend.get(provider)
 		debug.Assert(k, provider)
 		if bp != nil {
 			return bp
@@ -204,13 +205,27 @@ func (t *target) GetColdBlob(params *core.BlobParams, oa *cmn.ObjAttrs) (xctn co
 	return xctn, err
 }
 
+// NOTE: client requests (origReq != nil) are subject to 'timeout.head_object', if configured
 func (t *target) HeadCold(lom *core.LOM, origReq *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
 	var (
 		backend = t.Backend(lom.Bck())
 		now     = mono.NanoTime()
 		vlabs   = map[string]string{stats.VarlabBucket: lom.Bck().Cname("")}
+		ctx     = context.Background()
+		limit   time.Duration
 	)
-	oa, ecode, err = backend.HeadObj(context.Background(), lom, origReq)
+	if origReq != nil {
+		if limit = cmn.GCO.Get().Timeout.HeadObject.D(); limit > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, limit)
+			defer cancel()
+		}
+	}
+	oa, ecode, err = backend.HeadObj(ctx, lom, origReq)
+	if err != nil && limit > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		errT := cmn.NewErrTimeout("HEAD "+lom.Cname(), "waiting for remote backend", mono.Since(now), limit, true)
+		err, ecode = errT, errT.Status()
+	}
 	if err != nil {
 		t.statsT.IncWith(stats.ErrHeadCount, vlabs)
 	} else {
@@ -6,14 +6,17 @@ package ais
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
 	"encoding"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"os"
+	"path"
 	"runtime"
 	"strconv"
 	"strings"
@@ -27,6 +30,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/filter"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
@@ -88,6 +92,7 @@ type (
 		verchanged bool       // version changed
 		retry      bool       // once
 		cold       bool       // true if executed backend.Get
+		hedged     bool       // cold GET from the alternative source (see tgthedge)
 		latestVer  bool       // QparamLatestVer || 'versioning.*_warm_get'
 		isIOErr    bool       // to count GET error as a "IO error"; see `Trunner._softErrs()`
 	}
@@ -103,6 +108,7 @@ type (
 		partialCksum *cos.CksumHash
 		nodeID       string
 		workFQN      string
+		offset       int64 // work file size as of the last successful append; -1 when unknown (older clients)
 	}
 	apndOI struct {
 		r       io.ReadCloser // content reader
@@ -139,6 +145,15 @@ type (
 		size     int64         // aka Content-Length
 		put      bool          // overwrite
 	}
+
+	// PATCH(object): ranged in-place write
+	patchOI struct {
+		r      io.ReadCloser // bytes to write
+		t      *target       // this
+		lom    *core.LOM     // existing ais:// object
+		offset int64         // apc.QparamPatchOffset
+		size   int64         // number of written bytes
+	}
 )
 
 //
@@ -155,6 +170,9 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 		poi.cksumToUse = poi.lom.ObjAttrs().FromHeader(r.Header)
 		poi.owt = cmn.OwtPut // default
 	}
+	if !poi.t2t {
+		poi.lom.SetContentType(r.Header.Get(cos.HdrContentType))
+	}
 	if dpq.owt != "" {
 		poi.owt.FromS(dpq.owt)
 	}
@@ -265,6 +283,7 @@ func (poi *putOI) stats() {
 		cos.NamedVal64{Name: stats.PutLatency, Value: delta, VarLabs: vlabs},
 		cos.NamedVal64{Name: stats.PutLatencyTotal, Value: delta, VarLabs: vlabs},
 	)
+	poi.t.busage.put(bck)
 	if poi.rltime > 0 {
 		debug.Assert(bck.IsRemote())
 		backend := poi.t.Backend(bck)
@@ -304,7 +323,12 @@ func (poi *putOI) loghdr() string {
 }
 
 func (poi *putOI) finalize() (ecode int, err error) {
-	if ecode, err = poi.fini(); err != nil {
+	if err = poi.t.checkFrozen(poi.lom.Bck(), poi.owt); err != nil {
+		ecode = http.StatusLocked
+	} else {
+		ecode, err = poi.fini()
+	}
+	if err != nil {
 		if err1 := cos.Stat(poi.workFQN); err1 == nil || !os.IsNotExist(err1) {
 			// cleanup: rm work-fqn
 			if err1 == nil {
@@ -321,7 +345,13 @@ func (poi *putOI) finalize() (ecode int, err error) {
 		return ecode, err
 	}
 	if !poi.skipEC {
-		if ecErr := ec.ECM.EncodeObject(poi.lom, nil); ecErr != nil && ecErr != ec.ErrorECDisabled {
+		var ecErr error
+		if poi.owt == cmn.OwtPut {
+			ecErr = ec.ECM.EncodeOnPut(poi.lom) // (inline, lazy, or async - as per bucket's `ec.encode_mode`)
+		} else {
+			ecErr = ec.ECM.EncodeObject(poi.lom, nil)
+		}
+		if ecErr != nil && ecErr != ec.ErrorECDisabled {
 			err = ecErr
 			if ecode != http.StatusInsufficientStorage && cmn.IsErrCapExceeded(err) {
 				ecode = http.StatusInsufficientStorage
@@ -656,9 +686,8 @@ do:
 	// cold-GET: upgrade rlock => wlock and call t.Backend.GetObjReader
 	if cold {
 		var (
-			res     core.GetReaderResult
-			ckconf  = goi.lom.CksumConf()
-			backend = goi.t.Backend(goi.lom.Bck())
+			res    core.GetReaderResult
+			ckconf = goi.lom.CksumConf()
 		)
 		if cs.IsNil() {
 			cs = fs.Cap()
@@ -688,7 +717,7 @@ do:
 
 		goi.rstarttime = mono.NanoTime()
 		// get remote reader (compare w/ t.GetCold)
-		res = backend.GetObjReader(goi.ctx, goi.lom, 0, 0)
+		res = goi.coldReader() // (hedged reads, if enabled)
 		if res.Err != nil {
 			goi.lom.Unlock(true)
 			goi.unlocked = true
@@ -700,7 +729,7 @@ do:
 		goi.cold = true
 
 		// 3 alternative ways to perform cold GET
-		if goi.dpq.arch.path == "" && goi.dpq.arch.regx == "" &&
+		if goi.dpq.arch.path == "" && goi.dpq.arch.regx == "" && goi.dpq.filter == "" &&
 			(ckconf.Type == cos.ChecksumNone || (!ckconf.ValidateColdGet && !ckconf.EnableReadRange)) {
 			if goi.ranges.Range == "" && goi.lom.IsFeatureSet(feat.StreamingColdGET) {
 				err = goi.coldStream(&res)
@@ -737,6 +766,41 @@ fin:
 	return ecode, err
 }
 
+// get remote reader, subject to 'timeout.cold_get' (if configured) that limits the time
+// for the remote backend to respond - but not the time to transfer the object
+func (goi *getOI) coldReader() (res core.GetReaderResult) {
+	limit := cmn.GCO.Get().Timeout.ColdGet.D()
+	if limit <= 0 {
+		res, goi.hedged = goi.t.getObjReader(goi.ctx, goi.lom)
+		return res
+	}
+	ctx, cancel := context.WithCancelCause(goi.ctx)
+	timer := time.AfterFunc(limit, func() {
+		elapsed := mono.Since(goi.rstarttime)
+		cancel(cmn.NewErrTimeout("cold GET "+goi.lom.Cname(), "waiting for remote backend", elapsed, limit, true))
+	})
+	res, goi.hedged = goi.t.getObjReader(ctx, goi.lom)
+	if timer.Stop() {
+		if res.Err == nil {
+			res.R = &cancelReader{res.R, func() { cancel(nil) }}
+		} else {
+			cancel(nil)
+		}
+		return res
+	}
+
+	// timed out (possibly, right after the backend responded)
+	<-ctx.Done()
+	if res.Err == nil {
+		cos.Close(res.R)
+	}
+	err := context.Cause(ctx)
+	if errT, ok := err.(*cmn.ErrTimeout); ok {
+		return core.GetReaderResult{Err: errT, ErrCode: errT.Status()}
+	}
+	return core.GetReaderResult{Err: err, ErrCode: http.StatusInternalServerError}
+}
+
 func (goi *getOI) _coldPut(res *core.GetReaderResult) (int, error) {
 	var (
 		t, lom = goi.t, goi.lom
@@ -1027,8 +1091,15 @@ func (goi *getOI) txfini() (ecode int, err error) {
 
 	whdr := goi.w.Header()
 
-	// transmit (range, arch, regular)
+	// transmit (filter, range, arch, regular)
 	switch {
+	case dpq.filter != "":
+		if goi.ranges.Range != "" || dpq.isArch() {
+			ecode = http.StatusBadRequest
+			err = cmn.NewErrUnsupp("apply filter ("+dpq.filter+") to range-read or archived file of", goi.lom.Cname())
+			break
+		}
+		ecode, err = goi._txflt(fqn, lmfh, whdr)
 	case goi.ranges.Range != "":
 		debug.Assert(!dpq.isArch())
 		rsize := goi.lom.Lsize()
@@ -1078,6 +1149,9 @@ func (goi *getOI) _txrng(fqn string, lmfh *os.File, whdr http.Header, hrng *htra
 	// set response header
 	whdr.Set(cos.HdrContentType, cos.ContentBinary)
 	cmn.ToHeader(lom.ObjAttrs(), whdr, size, cksum)
+	if conf := &lom.Bprops().RespHdr; conf.IsSet() {
+		setRespHdr(whdr, lom, conf)
+	}
 
 	buf, slab := goi.t.gmm.AllocSize(min(size, memsys.DefaultBuf2Size))
 	err = goi.transmit(r, buf, fqn)
@@ -1099,6 +1173,9 @@ func (goi *getOI) _txreg(fqn string, lmfh *os.File, whdr http.Header) (err error
 	// set response header
 	whdr.Set(cos.HdrContentType, cos.ContentBinary)
 	cmn.ToHeader(lom.ObjAttrs(), whdr, size, cksum)
+	if conf := &lom.Bprops().RespHdr; conf.IsSet() {
+		setRespHdr(whdr, lom, conf)
+	}
 	if dpq.isS3 {
 		// (expecting user to set bucket checksum = md5)
 		s3.SetEtag(whdr, lom)
@@ -1110,6 +1187,62 @@ func (goi *getOI) _txreg(fqn string, lmfh *os.File, whdr http.Header) (err error
 	return err
 }
 
+// per-bucket GET response headers (see cmn.RespHdrConf)
+func setRespHdr(whdr http.Header, lom *core.LOM, conf *cmn.RespHdrConf) {
+	if conf.InferType {
+		if v, ok := lom.GetCustomKey(cos.HdrContentType); ok && v != "" {
+			whdr.Set(cos.HdrContentType, v)
+		} else if v := mime.TypeByExtension(path.Ext(lom.ObjName)); v != "" {
+			whdr.Set(cos.HdrContentType, v)
+		}
+	}
+	if conf.CacheControl != "" {
+		whdr.Set(cos.HdrCacheControl, conf.CacheControl)
+	}
+	if conf.Disposition != "" {
+		v := mime.FormatMediaType(conf.Disposition, map[string]string{"filename": path.Base(lom.ObjName)})
+		if v == "" {
+			v = conf.Disposition // (unlikely)
+		}
+		whdr.Set(cos.HdrContentDisposition, v)
+	}
+}
+
+// built-in filter (see cmn/filter)
+// - size and checksum of the transformed content are unknown (not set)
+// - bad content (e.g., corrupted gzip, oversized image) is the user's error, not I/O;
+// to fail those with 4xx, read ahead prior to sending anything
+func (goi *getOI) _txflt(fqn string, lmfh *os.File, whdr http.Header) (int, error) {
+	r, mime, err := filter.NewReader(goi.dpq.filter, lmfh, cmn.GCO.Get().Memsys.MaxImgPixels)
+	if err != nil {
+		return goi._fltErr(fqn, err)
+	}
+	head, hslab := goi.t.gmm.AllocSize(memsys.DefaultBufSize)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		hslab.Free(head)
+		r.Close()
+		return goi._fltErr(fqn, err)
+	}
+	whdr.Set(cos.HdrContentType, mime)
+	buf, slab := goi.t.gmm.AllocSize(memsys.DefaultBuf2Size)
+	err = goi.transmit(io.MultiReader(bytes.NewReader(head[:n]), r), buf, fqn)
+	slab.Free(buf)
+	hslab.Free(head)
+	r.Close()
+	return 0, err
+}
+
+func (goi *getOI) _fltErr(fqn string, err error) (int, error) {
+	ecode := http.StatusUnsupportedMediaType
+	if !filter.IsErrFilter(err) {
+		goi.isIOErr = true
+		goi.t.FSHC(err, goi.lom.Mountpath(), fqn)
+		ecode = http.StatusInternalServerError
+	}
+	return ecode, cmn.NewErrFailedTo(goi.t, "apply filter "+goi.dpq.filter+" to", goi.lom.Cname(), err, ecode)
+}
+
 // TODO: checksum
 func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 	var (
@@ -1167,6 +1300,10 @@ func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
 	written, err := cos.CopyBuffer(goi.w, r, buf)
 	if err != nil {
+		if filter.IsErrFilter(err) { // (bad content - see _txflt)
+			nlog.Warningln("failed to GET (filter)", goi.lom.Cname(), err)
+			return errSendingResp
+		}
 		if !cos.IsRetriableConnErr(err) || cmn.Rom.FastV(5, cos.SmoduleAIS) {
 			nlog.Warningln("failed to GET (Tx)", goi.lom.Cname(), err)
 			goi.t.FSHC(err, goi.lom.Mountpath(), fqn)
@@ -1209,6 +1346,7 @@ func (goi *getOI) stats(written int64) {
 		cos.NamedVal64{Name: stats.GetLatency, Value: delta, VarLabs: vlabs},      // see also: per-backend *LatencyTotal below
 		cos.NamedVal64{Name: stats.GetLatencyTotal, Value: delta, VarLabs: vlabs}, // ditto
 	)
+	goi.t.busage.get(goi.lom.Bck())
 	if goi.verchanged {
 		goi.t.statsT.AddWith(
 			cos.NamedVal64{Name: stats.VerChangeCount, Value: 1, VarLabs: vlabs},
@@ -1313,7 +1451,7 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, ecode int, err error) {
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			a.hdl.offset, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
 			a.lom.Unlock(false)
 			if err != nil {
 				ecode = http.StatusInternalServerError
@@ -1322,10 +1460,19 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, ecode int, err error) {
 			fh, err = a.lom.AppendWork(workFQN)
 		} else {
 			a.lom.Unlock(false)
+			a.hdl.offset = 0
 			a.hdl.partialCksum = cos.NewCksumHash(a.lom.CksumType())
 			fh, err = a.lom.CreateWork(workFQN)
 		}
 	} else {
+		// the same handle may be used to retry a failed append -
+		// discard whatever the failed attempt may have written
+		if a.hdl.offset >= 0 {
+			if err = a.rollback(workFQN); err != nil {
+				ecode = http.StatusInternalServerError
+				return
+			}
+		}
 		fh, err = a.lom.AppendWork(workFQN)
 		debug.Assert(a.hdl.partialCksum != nil)
 	}
@@ -1334,13 +1481,19 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, ecode int, err error) {
 		return
 	}
 
-	w := cos.NewWriterMulti(fh, a.hdl.partialCksum.H)
-	_, err = cos.CopyBuffer(w, a.r, buf)
+	var (
+		n int64
+		w = cos.NewWriterMulti(fh, a.hdl.partialCksum.H)
+	)
+	n, err = cos.CopyBuffer(w, a.r, buf)
 	cos.Close(fh)
 	if err != nil {
 		ecode = http.StatusInternalServerError
 		return
 	}
+	if a.hdl.offset >= 0 {
+		a.hdl.offset += n
+	}
 
 	packedHdl = a.pack(workFQN)
 
@@ -1357,6 +1510,22 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, ecode int, err error) {
 	return
 }
 
+func (a *apndOI) rollback(workFQN string) error {
+	finfo, err := os.Stat(workFQN)
+	if err != nil {
+		return err
+	}
+	switch size := finfo.Size(); {
+	case size == a.hdl.offset:
+		return nil
+	case size < a.hdl.offset:
+		return fmt.Errorf("append handle (offset %d) does not match %s (size %d)", a.hdl.offset, workFQN, size)
+	default:
+		nlog.Warningln("APPEND", a.lom.String(), "retry: truncating", workFQN, "from", size, "to", a.hdl.offset)
+		return os.Truncate(workFQN, a.hdl.offset)
+	}
+}
+
 func (a *apndOI) flush() (int, error) {
 	if a.hdl.workFQN == "" {
 		return 0, fmt.Errorf("failed to finalize append-file operation: empty source in the %+v handle", a.hdl)
@@ -1403,6 +1572,12 @@ func (a *apndOI) parse(packedHdl string) error {
 
 	a.hdl.nodeID = items[0]
 	a.hdl.workFQN = items[1]
+	a.hdl.offset = -1
+	if len(items) > 4 {
+		if a.hdl.offset, err = strconv.ParseInt(items[4], 10, 64); err != nil {
+			return fmt.Errorf("invalid APPEND handle offset %q: %v", items[4], err)
+		}
+	}
 	return nil
 }
 
@@ -1411,7 +1586,11 @@ func (a *apndOI) pack(workFQN string) string {
 	debug.AssertNoErr(err)
 	cksumTy := a.hdl.partialCksum.Type()
 	cksumBinary := base64.StdEncoding.EncodeToString(buf)
-	return a.t.SID() + appendHandleSepa + workFQN + appendHandleSepa + cksumTy + appendHandleSepa + cksumBinary
+	hdl := a.t.SID() + appendHandleSepa + workFQN + appendHandleSepa + cksumTy + appendHandleSepa + cksumBinary
+	if a.hdl.offset >= 0 {
+		hdl += appendHandleSepa + strconv.FormatInt(a.hdl.offset, 10)
+	}
+	return hdl
 }
 
 //
@@ -1759,43 +1938,19 @@ func (a *putA2I) do() (int, error) {
 		return 0, errors.New("archive path is not defined")
 	}
 	// standard library does not support appending to tgz, zip, and such;
-	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
-		var (
-			err       error
-			fh        *os.File
-			offset    int64
-			size      int64
-			tarFormat tar.Format
-			workFQN   = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppendToArch)
-		)
-		if err = a.lom.RenameMainTo(workFQN); err != nil {
-			return http.StatusInternalServerError, err
-		}
-		fh, tarFormat, offset, err = archive.OpenTarForAppend(a.lom.Cname(), workFQN)
-		if err != nil {
-			if errV := a.lom.RenameToMain(workFQN); errV != nil {
-				return http.StatusInternalServerError, errV
-			}
-			if err == archive.ErrTarIsEmpty {
-				a.put = true
-				goto cpap
-			}
-			return http.StatusInternalServerError, err
-		}
-		// do - fast
-		if size, err = a.fast(fh, tarFormat, offset); err == nil {
-			// TODO: checksum NIY
-			if err = a.finalize(size, cos.NoneCksum, workFQN); err == nil {
-				return http.StatusInternalServerError, nil // ok
-			}
-		} else if errV := a.lom.RenameToMain(workFQN); errV != nil {
-			nlog.Errorf(fmtNested, a.t, err, "append and rename back", workFQN, errV)
+	// for TAR, TAR.LZ4, and ZIP there are optimizing workarounds not requiring a full copy
+	if archive.FastAppend(a.mime) && !a.put /*append*/ && !a.lom.IsChunked() {
+		ecode, err := a.fastAppend()
+		switch err {
+		case archive.ErrTarIsEmpty:
+			a.put = true
+		case archive.ErrNoFastAppend:
+		default:
+			return ecode, err
 		}
-		return http.StatusInternalServerError, err
 	}
 
-cpap: // copy + append
+	// copy + append
 	var (
 		err     error
 		wfh     *os.File
@@ -1845,6 +2000,61 @@ cpap: // copy + append
 	return a.reterr(err)
 }
 
+// append in place; returns archive.ErrTarIsEmpty or archive.ErrNoFastAppend
+// (with the original shard intact) to fall back to copy + append
+func (a *putA2I) fastAppend() (int, error) {
+	var (
+		err     error
+		size    int64
+		cname   = a.lom.Cname()
+		oah     = cos.SimpleOAH{Size: a.size, Atime: a.started}
+		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppendToArch)
+	)
+	if err = a.lom.RenameMainTo(workFQN); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	switch a.mime {
+	case archive.ExtTar:
+		var (
+			fh        *os.File
+			offset    int64
+			tarFormat tar.Format
+		)
+		if fh, tarFormat, offset, err = archive.OpenTarForAppend(cname, workFQN); err == nil {
+			size, err = a.fast(fh, tarFormat, offset)
+		}
+	case archive.ExtTarLz4:
+		var fh *os.File
+		if fh, _, err = archive.OpenTarLz4ForAppend(cname, workFQN); err == nil {
+			size, err = archive.AppendTarLz4(fh, a.filename, oah, a.r)
+			cos.Close(fh)
+		}
+	case archive.ExtZip:
+		var (
+			fh  *os.File
+			zcd *archive.ZipCdir
+		)
+		if fh, zcd, err = archive.OpenZipForAppend(cname, workFQN); err == nil {
+			size, err = zcd.Append(fh, a.filename, oah, a.r)
+			cos.Close(fh)
+		}
+	default:
+		debug.Assert(false, a.mime)
+	}
+	if err == nil {
+		// TODO: checksum NIY
+		if err = a.finalize(size, cos.NoneCksum, workFQN); err == nil {
+			return http.StatusInternalServerError, nil // ok
+		}
+		return http.StatusInternalServerError, err
+	}
+	if errV := a.lom.RenameToMain(workFQN); errV != nil {
+		nlog.Errorf(fmtNested, a.t, err, "append and rename back", workFQN, errV)
+		return http.StatusInternalServerError, errV
+	}
+	return http.StatusInternalServerError, err
+}
+
 // TAR only - fast & direct
 func (a *putA2I) fast(rwfh *os.File, tarFormat tar.Format, offset int64) (size int64, err error) {
 	var (
@@ -1904,6 +2114,136 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 	return nil
 }
 
+//
+// PATCH(object): write request body at a given offset;
+// the resulting object may grow but holes are not permitted (offset <= size)
+// - the patched content goes into a workfile that then atomically replaces the original
+//
+
+func (pti *patchOI) do() (int, error) {
+	lom := pti.lom
+	lom.Lock(true)
+	ecode, err := pti._write()
+	lom.Unlock(true)
+	if err != nil {
+		return ecode, err
+	}
+
+	// old copies and slices are now stale
+	if lom.ECEnabled() {
+		if err := ec.ECM.EncodeObject(lom, nil); err != nil && err != ec.ErrorECDisabled {
+			return http.StatusInternalServerError, err
+		}
+	}
+	pti.t.putMirror(lom)
+	return 0, nil
+}
+
+// (under wlock)
+func (pti *patchOI) _write() (int, error) {
+	lom := pti.lom
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, err
+	}
+	if pti.offset > lom.Lsize() {
+		return http.StatusBadRequest, fmt.Errorf("%s: patch offset %d is beyond the object's size %d",
+			lom.Cname(), pti.offset, lom.Lsize())
+	}
+	workFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePatch)
+	ecode := http.StatusInternalServerError
+	n, cksum, err := pti.patch(workFQN, lom.CksumType())
+	if err == nil && n == 0 {
+		ecode, err = http.StatusBadRequest, fmt.Errorf("%s: nothing to patch (empty body)", lom.Cname())
+	}
+	if err == nil {
+		err = lom.RenameFinalize(workFQN)
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(workFQN); nerr != nil {
+			nlog.Errorln("nested error: PATCH", lom.Cname(), "[", err, "] remove workfile:", nerr)
+		}
+		if cmn.IsErrCapExceeded(err) {
+			ecode = http.StatusInsufficientStorage
+		}
+		return ecode, err
+	}
+
+	// (renamed) - update metadata
+	pti.size = n
+	if end := pti.offset + n; end > lom.Lsize() {
+		lom.SetSize(end)
+	}
+	if cksum == nil {
+		lom.SetCksum(cos.NoneCksum)
+	} else {
+		lom.SetCksum(cksum.Clone())
+	}
+	if lom.VersionConf().Enabled {
+		if err := lom.IncVersion(); err != nil {
+			nlog.Errorln(err) // (unlikely)
+		}
+	}
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	if lom.HasCopies() {
+		if errdc := lom.DelAllCopies(); errdc != nil {
+			nlog.Errorf("PATCH %s: failed to delete old copies [%v], proceeding anyway...", lom.Cname(), errdc)
+		}
+	}
+	if err := lom.PersistMain(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return 0, nil
+}
+
+// workfile = original[0:offset) + body + original[offset+len(body):]
+// - single pass; checksum computed on the fly
+func (pti *patchOI) patch(workFQN, cksumType string) (n int64, cksum *cos.CksumHash, err error) {
+	var (
+		src, wfh *os.File
+		w        io.Writer
+	)
+	if src, err = os.Open(pti.lom.FQN); err != nil {
+		return 0, nil, err
+	}
+	defer cos.Close(src)
+	if wfh, err = cos.CreateFile(workFQN); err != nil {
+		return 0, nil, err
+	}
+	w = wfh
+	if cksumType != cos.ChecksumNone {
+		cksum = cos.NewCksumHash(cksumType)
+		w = io.MultiWriter(wfh, cksum.H)
+	}
+	buf, slab := pti.t.gmm.Alloc()
+	defer slab.Free(buf)
+
+	if _, err = io.CopyBuffer(w, io.LimitReader(src, pti.offset), buf); err != nil {
+		goto rerr
+	}
+	if n, err = io.CopyBuffer(w, pti.r, buf); err != nil || n == 0 {
+		goto rerr
+	}
+	if _, err = src.Seek(pti.offset+n, io.SeekStart); err != nil {
+		goto rerr
+	}
+	if _, err = io.CopyBuffer(w, src, buf); err != nil {
+		goto rerr
+	}
+	if err = cos.FlushClose(wfh); err != nil {
+		return n, nil, err
+	}
+	if cksum != nil {
+		cksum.Finalize()
+	}
+	return n, cksum, nil
+rerr:
+	cos.Close(wfh)
+	return n, nil, err
+}
+
 //
 // put mirorr (main)
 //
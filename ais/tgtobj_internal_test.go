@@ -5,12 +5,17 @@
 package ais
 
 import (
+	"errors"
 	"flag"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -21,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/tools/readers"
+	"github.com/NVIDIA/aistore/tools/tassert"
 )
 
 const (
@@ -77,6 +83,11 @@ func TestMain(m *testing.M) {
 	t.statsT = mock.NewStatsTracker()
 	core.Tinit(t, t.statsT, config, false)
 
+	// single-target cluster map (e.g., append-flush promotes locally)
+	smap := newSmap()
+	smap.Tmap[t.SID()] = t.si
+	t.owner.smap.put(smap)
+
 	bck := meta.NewBck(testBucket, apc.AIS, cmn.NsGlobal)
 	bmd := newBucketMD()
 	bmd.add(bck, &cmn.Bprops{
@@ -265,3 +276,274 @@ func BenchmarkObjGetDiscard(b *testing.B) {
 		})
 	}
 }
+
+func patchPut(tb testing.TB, lom *core.LOM, data string) {
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       io.NopCloser(strings.NewReader(data)),
+		size:    int64(len(data)),
+		workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+		config:  cmn.GCO.Get(),
+		owt:     cmn.OwtPut,
+	}
+	_, err := poi.putObject()
+	tassert.CheckFatal(tb, err)
+}
+
+func patchDo(lom *core.LOM, offset int64, data string) (int, error) {
+	pti := &patchOI{t: t, lom: lom, r: io.NopCloser(strings.NewReader(data)), offset: offset}
+	return pti.do()
+}
+
+func TestObjPatch(t *testing.T) {
+	lom := core.AllocLOM("patch-obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+	patchPut(t, lom, "0123456789")
+	defer lom.RemoveMain()
+
+	check := func(expected string) {
+		t.Helper()
+		b, err := os.ReadFile(lom.FQN)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, string(b) == expected, "expected %q, got %q", expected, b)
+		lom.Uncache()
+		tassert.CheckFatal(t, lom.Load(false, false))
+		tassert.Errorf(t, lom.Lsize() == int64(len(expected)), "expected size %d, got %d", len(expected), lom.Lsize())
+		wdir := lom.Mountpath().MakePathCT(lom.Bucket(), fs.WorkfileType)
+		entries, _ := os.ReadDir(wdir)
+		tassert.Errorf(t, len(entries) == 0, "expecting no workfiles, got %d", len(entries))
+	}
+
+	_, err := patchDo(lom, 3, "abc")
+	tassert.CheckFatal(t, err)
+	check("012abc6789")
+
+	_, err = patchDo(lom, 8, "XYZ") // grow
+	tassert.CheckFatal(t, err)
+	check("012abc67XYZ")
+
+	ecode, err := patchDo(lom, 12, "hole")
+	tassert.Errorf(t, err != nil && ecode == http.StatusBadRequest, "expected bad request, got (%v, %d)", err, ecode)
+	ecode, err = patchDo(lom, 0, "")
+	tassert.Errorf(t, err != nil && ecode == http.StatusBadRequest, "expected bad request, got (%v, %d)", err, ecode)
+	check("012abc67XYZ")
+}
+
+func apndDo(lom *core.LOM, packedHdl, op string, r io.Reader) (string, error) {
+	aoi := &apndOI{started: time.Now().UnixNano(), t: t, config: cmn.GCO.Get(), lom: lom, op: op}
+	if r != nil {
+		aoi.r = io.NopCloser(r)
+	}
+	if err := aoi.parse(packedHdl); err != nil {
+		return "", err
+	}
+	buf := make([]byte, 4*cos.KiB)
+	switch op {
+	case apc.AppendOp:
+		hdl, _, err := aoi.apnd(buf)
+		return hdl, err
+	default:
+		_, err := aoi.flush()
+		return "", err
+	}
+}
+
+// append fails mid-way (e.g., connection reset) and gets retried with the same handle
+func TestObjAppendRollback(t *testing.T) {
+	lom := core.AllocLOM("append-obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(cksumBck().Bucket()))
+	patchPut(t, lom, "orig-")
+	defer lom.RemoveMain()
+
+	read := func(fqn string) string {
+		t.Helper()
+		b, err := os.ReadFile(fqn)
+		tassert.CheckFatal(t, err)
+		return string(b)
+	}
+
+	hdl, err := apndDo(lom, "", apc.AppendOp, strings.NewReader("AAAA"))
+	tassert.CheckFatal(t, err)
+
+	// fail mid-append: partial content in the work file, original object intact
+	failing := io.MultiReader(strings.NewReader("BB"), iotest.ErrReader(errors.New("connection reset by peer")))
+	_, err = apndDo(lom, hdl, apc.AppendOp, failing)
+	tassert.Fatalf(t, err != nil, "expecting append to fail")
+	workFQN := strings.Split(hdl, appendHandleSepa)[1]
+	tassert.Errorf(t, read(workFQN) == "orig-AAAABB", "expecting partial append, got %q", read(workFQN))
+	tassert.Errorf(t, read(lom.FQN) == "orig-", "expecting original object, got %q", read(lom.FQN))
+
+	// retry: partial content rolled back
+	hdl, err = apndDo(lom, hdl, apc.AppendOp, strings.NewReader("BBBB"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, read(workFQN) == "orig-AAAABBBB", "expecting rollback and append, got %q", read(workFQN))
+	tassert.Errorf(t, read(lom.FQN) == "orig-", "expecting original object, got %q", read(lom.FQN))
+
+	// finalize
+	_, err = apndDo(lom, hdl, apc.FlushOp, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, read(lom.FQN) == "orig-AAAABBBB", "expecting appended object, got %q", read(lom.FQN))
+	_, err = os.Stat(workFQN)
+	tassert.Errorf(t, os.IsNotExist(err), "expecting work file to be removed, got %v", err)
+}
+
+// Content-Type provided with PUT is returned upon GET (resp_hdr.infer_type)
+func TestObjPutContentType(t *testing.T) {
+	lom := core.AllocLOM("img/cat.txt")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(respHdrBck().Bucket()))
+	defer lom.RemoveMain()
+
+	get := func(ctype string) string {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodPut, "/", strings.NewReader("meow"))
+		r.Header.Set(cos.HdrContentType, ctype)
+		_, err := putReq(lom, r)
+		tassert.CheckFatal(t, err)
+
+		lom.Uncache()
+		tassert.CheckFatal(t, lom.Load(false, false))
+		whdr := http.Header{}
+		whdr.Set(cos.HdrContentType, cos.ContentBinary)
+		setRespHdr(whdr, lom, &lom.Bprops().RespHdr)
+		return whdr.Get(cos.HdrContentType)
+	}
+
+	ctype := get("image/png")
+	tassert.Errorf(t, ctype == "image/png", "expecting stored content type, got %q", ctype)
+	ctype = get(cos.ContentBinary)
+	tassert.Errorf(t, strings.HasPrefix(ctype, "text/plain"), "expecting content type inferred from extension, got %q", ctype)
+}
+
+func putReq(lom *core.LOM, r *http.Request) (int, error) {
+	poi := &putOI{atime: time.Now().UnixNano(), t: t, lom: lom, config: cmn.GCO.Get()}
+	return poi.do(nil, r, &dpq{})
+}
+
+// add a bucket that returns Content-Type upon GET
+func respHdrBck() *meta.Bck {
+	bck := meta.NewBck("resp-hdr-bck", apc.AIS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	if _, present := bmd.Get(bck); !present {
+		bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, RespHdr: cmn.RespHdrConf{InferType: true}})
+		fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+		t.owner.bmd.putPersist(bmd, nil)
+	}
+	return bck
+}
+
+// add a bucket with checksumming enabled (append maintains partial checksum)
+func cksumBck() *meta.Bck {
+	bck := meta.NewBck("cksum-bck", apc.AIS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	if _, present := bmd.Get(bck); !present {
+		bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+		t.owner.bmd.putPersist(bmd, nil)
+	}
+	return bck
+}
+
+// add (or update) a bucket with the given freeze state
+func frozenBck(frozen bool) *meta.Bck {
+	bck := meta.NewBck("frozen-bck", apc.AIS, cmn.NsGlobal)
+	bmd := t.owner.bmd.get().clone()
+	props, present := bmd.Get(bck)
+	if present {
+		nprops := props.Clone()
+		nprops.Frozen = frozen
+		bmd.set(bck, nprops)
+	} else {
+		bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, Frozen: frozen})
+		fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	}
+	t.owner.bmd.putPersist(bmd, nil)
+	return bck
+}
+
+func putOWT(lom *core.LOM, owt cmn.OWT, data string) (int, error) {
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       io.NopCloser(strings.NewReader(data)),
+		size:    int64(len(data)),
+		workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+		config:  cmn.GCO.Get(),
+		owt:     owt,
+		skipEC:  true,
+	}
+	return poi.putObject()
+}
+
+func TestObjPutFrozen(t *testing.T) {
+	bck := frozenBck(true)
+	defer frozenBck(false)
+
+	lom := core.AllocLOM("frozen-obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+
+	// users and jobs
+	for _, owt := range []cmn.OWT{cmn.OwtPut, cmn.OwtPromote, cmn.OwtArchive, cmn.OwtTransform, cmn.OwtCopy, cmn.OwtNone} {
+		ecode, err := putOWT(lom, owt, "data")
+		tassert.Errorf(t, cmn.IsErrBucketFrozen(err) && ecode == http.StatusLocked, "%s: expecting frozen, got %v(%d)", owt, err, ecode)
+		lom.Uncache()
+		tassert.Errorf(t, lom.Load(false, false) != nil, "%s: %s must not exist", owt, lom.Cname())
+	}
+	wdir := lom.Mountpath().MakePathCT(lom.Bucket(), fs.WorkfileType)
+	entries, _ := os.ReadDir(wdir)
+	tassert.Errorf(t, len(entries) == 0, "expecting no workfiles, got %d", len(entries))
+
+	// rebalance
+	_, err := putOWT(lom, cmn.OwtRebalance, "data")
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, lom.RemoveMain())
+
+	// unfrozen
+	frozenBck(false)
+	_, err = putOWT(lom, cmn.OwtPut, "data")
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, lom.RemoveMain())
+}
+
+// HEAD(latest): remote timeout is not "deleted remotely"
+func TestObjHeadLatest(tt *testing.T) {
+	var (
+		primary = &hedgeBackend{}
+		bck     = hedgeSetup(tt, primary, &hedgeBackend{})
+		config  = cmn.GCO.Get()
+		q       = url.Values{apc.QparamLatestVer: []string{"true"}}
+		r       = httptest.NewRequest(http.MethodHead, apc.URLPathObjects.Join(bck.Name, "obj"), http.NoBody)
+	)
+	saved := config.Timeout.HeadObject
+	tt.Cleanup(func() { config.Timeout.HeadObject = saved })
+	config.Timeout.HeadObject = cos.Duration(10 * time.Millisecond)
+
+	head := func() (int, error) {
+		lom := core.AllocLOM("obj")
+		defer core.FreeLOM(lom)
+		return t.objHead(r, make(http.Header), q, bck, lom)
+	}
+
+	// timeout
+	primary.headDelay = time.Second
+	ecode, err := head()
+	tassert.Fatalf(tt, cmn.IsErrTimeout(err), "expected timeout, got %v", err)
+	tassert.Errorf(tt, ecode == http.StatusGatewayTimeout, "expected %d, got %d", http.StatusGatewayTimeout, ecode)
+
+	// not found
+	primary.headDelay = 0
+	ecode, err = head()
+	tassert.Fatalf(tt, err != nil, "expected error")
+	tassert.Errorf(tt, ecode == http.StatusGone, "expected %d, got %d (%v)", http.StatusGone, ecode, err)
+
+	// found
+	primary.head = &cmn.ObjAttrs{Size: 1}
+	ecode, err = head()
+	tassert.Errorf(tt, err == nil, "expected success, got %v (%d)", err, ecode)
+}
@@ -0,0 +1,215 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Read-ahead (adaptive prefetch; see cmn.ReadAheadConf): detect sequential GETs of
+// numbered objects - names that only differ by their last sequence of digits, e.g.
+// "shard-0001.tar", "shard-0002.tar", ... - and cold-GET the next `read_ahead.window`
+// objects in the background.
+//
+// Given HRW distribution, each target observes only a subset of any sequential stream
+// (with gaps roughly equal to the number of targets). Each target, therefore, tolerates
+// gaps and prefetches only those of the next objects that it owns.
+//
+// Hit rate = read_ahead.hit.n / read_ahead.n, to tune the window.
+
+const (
+	raMaxStreams  = 1024
+	raMaxPending  = 16 * 1024
+	raMaxInflight = 16              // max concurrent cold GETs (when exceeded, read-ahead skips)
+	raMaxDigits   = 18              // (fits int64)
+	raIdle        = 5 * time.Minute // to forget idle streams and never read prefetched objects
+	raHkIval      = time.Minute
+)
+
+type (
+	raStream struct {
+		width int   // number of digits (zero-padded)
+		last  int64 // last observed sequence number
+		hi    int64 // highest sequence number scheduled for read-ahead
+		run   int   // current run of sequential GETs
+		atime int64 // mono.NanoTime
+	}
+	readAhead struct {
+		t       *target
+		streams map[string]*raStream // by bucket, prefix, and suffix
+		pending map[string]int64     // prefetched and not yet read: uname => mono.NanoTime
+		sema    *cos.Semaphore
+		mu      sync.Mutex
+	}
+)
+
+func (ra *readAhead) init(t *target) {
+	ra.t = t
+	ra.streams = make(map[string]*raStream, 16)
+	ra.pending = make(map[string]int64, 64)
+	ra.sema = cos.NewSemaphore(raMaxInflight)
+	hk.Reg("read-ahead"+hk.NameSuffix, ra.housekeep, raHkIval)
+}
+
+// is called upon successful GET
+func (ra *readAhead) onGet(lom *core.LOM) {
+	var (
+		conf     = &lom.Bprops().ReadAhead
+		now      = mono.NanoTime()
+		from, to int64
+	)
+	prefix, suffix, num, width := raSplit(lom.ObjName)
+
+	ra.mu.Lock()
+	if _, ok := ra.pending[lom.Uname()]; ok {
+		delete(ra.pending, lom.Uname())
+		ra.t.statsT.IncBck(stats.ReadAheadHitCount, lom.Bucket())
+	}
+	if width == 0 {
+		ra.mu.Unlock()
+		return
+	}
+	key := string(lom.Bck().MakeUname(prefix)) + "\x00" + suffix
+	s, ok := ra.streams[key]
+	switch {
+	case ok:
+		// tolerate gaps - see above
+		gap := int64(conf.Window + ra.t.owner.smap.get().CountActiveTs())
+		switch d := num - s.last; {
+		case d == 0: // same object (again)
+		case d > 0 && d <= gap:
+			s.run++
+		default:
+			s.run, s.hi = 1, num
+		}
+		s.last = num
+	case len(ra.streams) < raMaxStreams:
+		s = &raStream{last: num, hi: num, run: 1}
+		ra.streams[key] = s
+	default:
+		ra.mu.Unlock()
+		return
+	}
+	s.width, s.atime = width, now
+	if s.run >= conf.MinRun {
+		from, to = max(s.hi, num)+1, num+int64(conf.Window)
+		s.hi = max(s.hi, to)
+	}
+	ra.mu.Unlock()
+
+	if from == 0 || from > to {
+		return
+	}
+	var (
+		smap = ra.t.owner.smap.get()
+		bck  = lom.Bck()
+	)
+	for n := from; n <= to; n++ {
+		objName := prefix + raPad(n, width) + suffix
+		tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+		if err != nil || tsi.ID() != ra.t.SID() {
+			continue // not mine
+		}
+		select {
+		case <-ra.sema.TryAcquire():
+			go ra.prefetch(bck, objName)
+		default:
+			return // busy
+		}
+	}
+}
+
+func (ra *readAhead) prefetch(bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer func() {
+		core.FreeLOM(lom)
+		ra.sema.Release()
+	}()
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil {
+		return // present
+	}
+	ecode, err := ra.t.GetCold(context.Background(), lom, cmn.OwtGetTryLock)
+	if err != nil {
+		// not found is expected at the end of a sequence
+		if err != cmn.ErrSkip && ecode != http.StatusNotFound && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Warningln("read-ahead", lom.Cname(), "[", err, ecode, "]")
+		}
+		return
+	}
+	ra.t.statsT.IncBck(stats.ReadAheadCount, bck.Bucket())
+
+	ra.mu.Lock()
+	if len(ra.pending) < raMaxPending {
+		ra.pending[lom.Uname()] = mono.NanoTime()
+	}
+	ra.mu.Unlock()
+}
+
+func (ra *readAhead) housekeep(now int64) time.Duration {
+	ra.mu.Lock()
+	for key, s := range ra.streams {
+		if time.Duration(now-s.atime) > raIdle {
+			delete(ra.streams, key)
+		}
+	}
+	for uname, started := range ra.pending {
+		if time.Duration(now-started) > raIdle {
+			delete(ra.pending, uname)
+		}
+	}
+	ra.mu.Unlock()
+	return raHkIval
+}
+
+// split object name by its last sequence of digits; width == 0 when there are no digits
+func raSplit(name string) (prefix, suffix string, num int64, width int) {
+	j := len(name) - 1
+	for j >= 0 && !raDigit(name[j]) {
+		j--
+	}
+	if j < 0 {
+		return
+	}
+	i := j
+	for i > 0 && raDigit(name[i-1]) {
+		i--
+	}
+	digits := name[i : j+1]
+	if len(digits) > raMaxDigits {
+		return
+	}
+	n, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return
+	}
+	return name[:i], name[j+1:], n, len(digits)
+}
+
+func raDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+// zero-pad to the given width (the result may be wider, e.g., "shard-9" => "shard-10")
+func raPad(n int64, width int) string {
+	s := strconv.FormatInt(n, 10)
+	for len(s) < width {
+		s = "0" + s
+	}
+	return s
+}
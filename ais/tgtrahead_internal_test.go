@@ -0,0 +1,37 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReadAheadSplit(t *testing.T) {
+	tests := []struct {
+		name, prefix, suffix, next string
+		num                        int64
+		width                      int
+	}{
+		{"shard-0001.tar", "shard-", ".tar", "shard-0002.tar", 1, 4},
+		{"a/b7/shard-0099.tar", "a/b7/shard-", ".tar", "a/b7/shard-0100.tar", 99, 4},
+		{"img-9", "img-", "", "img-10", 9, 1},
+		{"42", "", "", "43", 42, 2},
+		{"no-digits.txt", "", "", "", 0, 0},
+		{"huge-1234567890123456789", "", "", "", 0, 0},
+	}
+	for _, test := range tests {
+		prefix, suffix, num, width := raSplit(test.name)
+		tassert.Errorf(t, width == test.width, "%q: expected width %d, got %d", test.name, test.width, width)
+		if width == 0 {
+			continue
+		}
+		tassert.Errorf(t, prefix == test.prefix && suffix == test.suffix && num == test.num,
+			"%q: got (%q, %q, %d)", test.name, prefix, suffix, num)
+		next := prefix + raPad(num+1, width) + suffix
+		tassert.Errorf(t, next == test.next, "%q: expected next %q, got %q", test.name, test.next, next)
+	}
+}
@@ -32,6 +32,7 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infoln("s3Handler", t.String(), r.Method, r.URL)
 	}
+	t.reqID(w, r)
 	apiItems, err := t.parseURL(w, r, apc.URLPathS3.L, 0, true)
 	if err != nil {
 		return
@@ -0,0 +1,110 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/backend"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/secrets"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// backend credentials via external secrets provider (config.Secrets):
+// - resolved (and exported) prior to initializing backends
+// - periodically re-resolved; upon rotation, the respective backend gets reinitialized
+//   in place - requests in flight complete with the previous client (zero downtime)
+
+const (
+	secretsDir      = "secrets"
+	secretsIdleIval = time.Minute // when refresh is disabled (to pick up config change)
+	secretsTimeout  = time.Minute
+)
+
+type tgtSecrets struct {
+	t     *target
+	store *secrets.Store
+}
+
+func (ts *tgtSecrets) init(t *target, config *cmn.Config) {
+	ts.t = t
+	ts.store = secrets.NewStore(filepath.Join(config.ConfigDir, secretsDir))
+	if config.Secrets.Provider == "" {
+		return
+	}
+	if _, err := ts.resolve(&config.Secrets); err != nil {
+		nlog.Errorln(t.String()+":", err, "- proceeding with environment credentials (if any)")
+	}
+}
+
+// must be called when HK is running
+func (ts *tgtSecrets) regHK() {
+	hk.Reg("secrets"+hk.NameSuffix, ts.housekeep, secretsIdleIval)
+}
+
+func (ts *tgtSecrets) resolve(conf *cmn.SecretsConf) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), secretsTimeout)
+	defer cancel()
+	return ts.store.Resolve(ctx, conf)
+}
+
+func (ts *tgtSecrets) housekeep(int64) time.Duration {
+	config := cmn.GCO.Get()
+	refresh := config.Secrets.Refresh.D()
+	if config.Secrets.Provider != "" && refresh == 0 {
+		return secretsIdleIval
+	}
+	// (when not configured, this merely unsets previously exported credentials, if any)
+	changed, err := ts.resolve(&config.Secrets)
+	if err != nil {
+		nlog.Errorln(ts.t.String()+":", err)
+	}
+	for _, provider := range changed {
+		if config.Backend.Get(provider) == nil {
+			continue // not configured - nothing to reload
+		}
+		if err := ts.t.reloadBackend(provider); err != nil {
+			nlog.Errorln(ts.t.String()+": failed to reload", provider, "backend with rotated credentials:", err)
+		} else {
+			nlog.Infoln(ts.t.String()+": reloaded", provider, "backend with rotated credentials")
+		}
+	}
+	if refresh == 0 {
+		return secretsIdleIval
+	}
+	return refresh
+}
+
+// (re)create backend with the current credentials
+func (t *target) reloadBackend(provider string) (err error) {
+	var (
+		add    core.Backend
+		tstats = t.statsT.(*stats.Trunner)
+	)
+	switch provider {
+	case apc.AWS:
+		add, err = backend.NewAWS(t, tstats, false /*starting up*/)
+	case apc.GCP:
+		add, err = backend.NewGCP(t, tstats, false)
+	case apc.Azure:
+		add, err = backend.NewAzure(t, tstats, false)
+	case apc.OCI:
+		add, err = backend.NewOCI(t, tstats, false)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	t.backend.set(provider, add)
+	return nil
+}
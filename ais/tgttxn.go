@@ -112,6 +112,10 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, c.msg.Value, err)
 			return
 		}
+		if err := tcbmsg.Init(); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		if msg.Action == apc.ActETLBck {
 			var err error
 			if dp, err = etlDP(tcbmsg); err != nil {
@@ -129,6 +133,10 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, c.msg.Value, err)
 			return
 		}
+		if err := tcomsg.Init(); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
 		if msg.Action == apc.ActETLObjects {
 			cs := fs.Cap()
 			if err := cs.Err(); err != nil {
@@ -159,6 +167,7 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 	if err == nil {
 		if xid != "" {
 			w.Header().Set(apc.HdrXactionID, xid)
+			setXactUser(xid, msg.User)
 		}
 		return
 	}
@@ -835,7 +844,11 @@ func (t *target) createArchMultiObj(c *txnSrv) (string /*xaction uuid*/, error)
 		// finalize the message and begin local transaction
 		archMsg.TxnUUID = c.uuid
 		archMsg.FromBckName = bckFrom.Name
-		archlom := core.AllocLOM(archMsg.ArchName)
+		archName := archMsg.ArchName
+		if archMsg.Split() {
+			archName = archive.ShardName(archName, 0)
+		}
+		archlom := core.AllocLOM(archName)
 		if err := xarch.Begin(archMsg, archlom); err != nil {
 			// NOTE: unexpected and unlikely - aborting
 			core.FreeLOM(archlom)
@@ -90,7 +90,8 @@ func (t *target) httpxget(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	xactQuery := xreg.Flt{
-		ID: xactMsg.ID, Kind: xactMsg.Kind, Bck: bck, OnlyRunning: xactMsg.OnlyRunning,
+		ID: xactMsg.ID, Kind: xactMsg.Kind, Bck: bck, OnlyRunning: xactMsg.OnlyRunning, User: xactMsg.User,
+		Timeline: xactMsg.Timeline,
 	}
 	t.xquery(w, r, what, xactQuery)
 }
@@ -141,6 +142,7 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err)
 			return
 		}
+		setXactUser(xid, msg.User)
 		if xid != "" {
 			writeXid(w, xid)
 		}
@@ -191,6 +193,16 @@ func (t *target) xget(w http.ResponseWriter, r *http.Request, what, uuid string)
 	t.writeErr(w, r, err, http.StatusNotFound, Silent)
 }
 
+// record the submitter (see apc.ActMsg.User)
+func setXactUser(xid, user string) {
+	if xid == "" || user == "" {
+		return
+	}
+	if xctn, err := xreg.GetXact(xid); err == nil && xctn != nil {
+		xctn.SetUser(user)
+	}
+}
+
 func (t *target) xquery(w http.ResponseWriter, r *http.Request, what string, xactQuery xreg.Flt) {
 	stats, err := xreg.GetSnap(xactQuery)
 	if err == nil {
@@ -255,9 +267,50 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		}
 		go t.runResilver(res.Args{UUID: args.ID, Notif: notif}, wg)
 		wg.Wait()
+	case apc.ActRebVerify:
+		if bck != nil {
+			nlog.Errorf(erfmb, args.Kind, bck)
+		}
+		if args.ID == "" {
+			args.ID = cos.GenUUID()
+			xid = args.ID
+		}
+		rns := xreg.RenewRebVerify(args.ID, args.Flags)
+		if rns.Err != nil {
+			return xid, rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+			Xact: xctn,
+		})
+		xact.GoRunW(xctn)
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
+	case apc.ActMakeInventory:
+		rns := xreg.RenewMakeInventory(args.ID, bck)
+		if rns.Err != nil {
+			return xid, rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+			Xact: xctn,
+		})
+		xact.GoRunW(xctn)
+	case apc.ActVerifyCksum:
+		cvargs := &xact.CksumVerifyArgs{Prefix: msg.Name, Repair: args.Flags&xact.XcvRepair != 0}
+		rns := xreg.RenewVerifyCksum(args.ID, bck, cvargs)
+		if rns.Err != nil {
+			return xid, rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+			Xact: xctn,
+		})
+		xact.GoRunW(xctn)
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
 		lom := core.AllocLOM(msg.Name)
@@ -276,7 +329,7 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return xid, fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
-	case apc.ActDownload, apc.ActEvictObjects, apc.ActDeleteObjects, apc.ActMakeNCopies, apc.ActECEncode:
+	case apc.ActDownload, apc.ActEvictObjects, apc.ActTierObjects, apc.ActDeleteObjects, apc.ActMakeNCopies, apc.ActECEncode:
 		return xid, fmt.Errorf("initiating %q must be done via a separate documented API", args)
 	// 4. unknown
 	case "":
@@ -428,8 +428,8 @@ func cleanupConfigDir(name string, keepInitialConfig bool) {
 const appendHandleSepa = "|"
 
 func preParse(packedHdl string) (items []string, err error) {
-	items = strings.SplitN(packedHdl, appendHandleSepa, 4)
-	if len(items) != 4 {
+	items = strings.SplitN(packedHdl, appendHandleSepa, 5) // (offset is optional)
+	if len(items) < 4 {
 		err = fmt.Errorf("invalid APPEND handle: %q", packedHdl)
 	}
 	return
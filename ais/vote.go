@@ -10,12 +10,14 @@ import (
 	"net/url"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -28,7 +30,10 @@ const (
 	VoteNo  Vote = "NO"
 )
 
-const maxRetryElectReq = 3
+const (
+	maxRetryElectReq   = 3
+	maxElectionHistory = 32
+)
 
 type (
 	Vote string
@@ -61,6 +66,12 @@ type (
 		daemonID string
 		yes      bool
 	}
+
+	// bounded in-memory history (see apc.WhatElections)
+	electionHistory struct {
+		recs []meta.Election
+		mu   sync.Mutex
+	}
 )
 
 func voteInProgress() (xele core.Xact) {
@@ -91,11 +102,14 @@ func (p *proxy) voteHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	// MethodGet
 	if r.Method == http.MethodGet {
-		if item != apc.Proxy {
+		switch item {
+		case apc.Proxy:
+			p.httpgetvote(w, r)
+		case apc.PreVote:
+			p.httpprevote(w, r)
+		default:
 			p.writeErrURL(w, r)
-			return
 		}
-		p.httpgetvote(w, r)
 		return
 	}
 	// MethodPut
@@ -255,9 +269,11 @@ func (p *proxy) elect(vr *VoteRecord, xele *xs.Election) {
 
 		if err == nil {
 			nlog.Infoln(pnameC, "the current primary", curName, "is up, moving back to idle")
+			p.addElection(vr, meta.ElectionPrimaryAlive, "")
 		} else {
 			errV := fmt.Errorf("%s: current primary(?) %s responds but does not consider itself primary", pname, curName)
 			xele.AddErr(errV, 0)
+			p.addElection(vr, meta.ElectionPrimaryAlive, errV.Error())
 		}
 		return
 	}
@@ -269,6 +285,7 @@ func (p *proxy) elect(vr *VoteRecord, xele *xs.Election) {
 	if !elected {
 		errV := fmt.Errorf("%s: election phase 1 (prepare) failed: primary still %s w/ status unknown", pname, curName)
 		xele.AddErr(errV, 0)
+		p.addElection(vr, meta.ElectionFailed, errV.Error())
 
 		smap = p.owner.smap.get()
 		if smap.version() > vr.Smap.version() {
@@ -308,6 +325,7 @@ func (p *proxy) elect(vr *VoteRecord, xele *xs.Election) {
 
 	// 4. become!
 	nlog.Infoln(pnameC, "becoming primary")
+	p.addElection(vr, meta.ElectionElected, "")
 	p.becomeNewPrimary(vr.Primary /*proxyIDToRemove*/)
 }
 
@@ -426,6 +444,8 @@ func (t *target) voteHandler(w http.ResponseWriter, r *http.Request) {
 	switch {
 	case r.Method == http.MethodGet && apiItems[0] == apc.Proxy:
 		t.httpgetvote(w, r)
+	case r.Method == http.MethodGet && apiItems[0] == apc.PreVote:
+		t.httpprevote(w, r)
 	case r.Method == http.MethodPut && apiItems[0] == apc.Voteres:
 		t.httpsetprimary(w, r)
 	default:
@@ -586,6 +606,7 @@ func (h *htrun) httpsetprimary(w http.ResponseWriter, r *http.Request) {
 	}
 	vr := msg.Result
 	nlog.Infof("%s: received vote result: new primary %s (old %s)", h.si, vr.Candidate, vr.Primary)
+	h.addElection((*VoteRecord)(&vr), meta.ElectionElected, "")
 
 	ctx := &smapModifier{
 		pre: h._votedPrimary,
@@ -681,3 +702,141 @@ func (h *htrun) voteOnProxy(daemonID, currPrimaryID string) (bool, error) {
 	}
 	return vote, nil
 }
+
+//
+// election triggers: grace period and pre-vote
+//
+
+// Called by non-primary nodes upon failing to keepalive the primary (and prior to onPrimaryDown)
+// to make sure that the primary's absence is neither brief nor local; returns false to
+// decline, in which case keepalive continues as usual.
+// See also: cmn.KeepaliveConf (election_grace, pre_vote)
+func (h *htrun) confirmPrimaryDown(smap *smapX, config *cmn.Config) bool {
+	if nlog.Stopping() {
+		return true
+	}
+	var (
+		pid = smap.Primary.ID()
+		vr  = &VoteRecord{Primary: pid, Initiator: h.SID(), StartTime: time.Now()}
+	)
+	if psi, err := smap.HrwProxy(pid); err == nil {
+		vr.Candidate = psi.ID()
+	}
+	if grace := config.Keepalive.ElectionGrace.D(); grace > 0 {
+		if last := h.keepalive.lastHeard(pid); last != 0 {
+			if elapsed := mono.Since(last); elapsed < grace {
+				h.addElection(vr, meta.ElectionDeclined,
+					fmt.Sprintf("election grace: last heard from primary %v ago (grace %v)", elapsed, grace))
+				return false
+			}
+		}
+	}
+	if !config.Keepalive.PreVote {
+		return true
+	}
+	yes, no, peers := h.preVote(smap, config)
+	if peers == 0 || yes > no {
+		nlog.Infoln(h.String(), "pre-vote: primary", smap.Primary.StringEx(), "confirmed down [ yes:", yes, "no:", no, "]")
+		return true
+	}
+	h.addElection(vr, meta.ElectionDeclined, fmt.Sprintf("pre-vote: yes %d, no %d, unreachable %d", yes, no, peers-yes-no))
+	return false
+}
+
+// ask all other nodes whether they, too, consider the primary down
+// (not counting the primary itself - unless it responds, in which case it votes No)
+func (h *htrun) preVote(smap *smapX, config *cmn.Config) (yes, no, peers int) {
+	var (
+		pid  = smap.Primary.ID()
+		args = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathVotePreVote.S,
+		Query:  url.Values{apc.QparamProxyID: []string{pid}},
+	}
+	args.smap = smap
+	args.to = core.AllNodes
+	args.timeout = config.Timeout.CplaneOperation.D()
+	results := h.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		switch {
+		case res.err == nil && Vote(res.bytes) == VoteYes:
+			yes++
+		case res.err == nil:
+			no++
+		}
+		if res.si.ID() != pid {
+			peers++
+		}
+	}
+	freeBcastRes(results)
+	return yes, no, peers
+}
+
+// GET /v1/vote/prevote (see preVote above)
+// vote Yes iff the primary is down from this node's perspective as well
+func (h *htrun) httpprevote(w http.ResponseWriter, r *http.Request) {
+	if _, err := h.parseURL(w, r, apc.URLPathVotePreVote.L, 0, false); err != nil {
+		return
+	}
+	var (
+		pid  = r.URL.Query().Get(apc.QparamProxyID)
+		smap = h.owner.smap.get()
+		vote = VoteNo
+	)
+	switch {
+	case pid == "" || pid == h.SID() || smap.validate() != nil:
+	case smap.Primary.ID() != pid: // (different primary)
+	case !h.keepalive.timeToPing(pid): // (recently heard from)
+	default:
+		// health probe
+		tout := cmn.Rom.CplaneOperation()
+		if _, _, err := h.reqHealth(smap.Primary, tout, nil /*ask primary*/, smap, true /*retry via pub-addr*/); err != nil {
+			vote = VoteYes
+		}
+	}
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(vote)))
+	_, err := w.Write([]byte(vote))
+	debug.AssertNoErr(err)
+}
+
+/////////////////////
+// electionHistory //
+/////////////////////
+
+func (h *htrun) addElection(vr *VoteRecord, outcome, details string) {
+	rec := meta.Election{
+		Time:      time.Now(),
+		Primary:   vr.Primary,
+		Candidate: vr.Candidate,
+		Initiator: vr.Initiator,
+		Outcome:   outcome,
+		Details:   details,
+	}
+	if details == "" {
+		nlog.Infoln(h.String(), "election:", outcome, "[ primary", vr.Primary, "candidate", vr.Candidate, "]")
+	} else {
+		nlog.Infoln(h.String(), "election:", outcome, "[ primary", vr.Primary, "candidate", vr.Candidate, "]:", details)
+	}
+	h.elections.add(&rec)
+}
+
+func (eh *electionHistory) add(rec *meta.Election) {
+	eh.mu.Lock()
+	if len(eh.recs) >= maxElectionHistory {
+		n := copy(eh.recs, eh.recs[len(eh.recs)-maxElectionHistory+1:])
+		eh.recs = eh.recs[:n]
+	}
+	eh.recs = append(eh.recs, *rec)
+	eh.mu.Unlock()
+}
+
+func (eh *electionHistory) get() []meta.Election {
+	eh.mu.Lock()
+	out := make([]meta.Election, len(eh.recs))
+	copy(out, eh.recs)
+	eh.mu.Unlock()
+	return out
+}
@@ -0,0 +1,154 @@
+// Package webdav provides WebDAV (RFC 4918, class 1) compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package webdav
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// WebDAV methods (in addition to GET, HEAD, PUT, DELETE, and OPTIONS)
+const (
+	MethodPropfind = "PROPFIND"
+	MethodMkcol    = "MKCOL"
+)
+
+const (
+	HdrDAV     = "DAV"
+	HdrDepth   = "Depth"
+	HdrWWWAuth = "WWW-Authenticate"
+
+	ContentXML = `application/xml; charset="utf-8"`
+
+	// class 1 only: no LOCK/UNLOCK (macOS Finder, for one, mounts class 1 servers read-only)
+	Class = "1"
+	Realm = `Basic realm="aistore"`
+)
+
+const (
+	DepthZero = 0
+	DepthOne  = 1
+)
+
+var Allowed = []string{
+	http.MethodOptions, MethodPropfind, http.MethodGet, http.MethodHead,
+	http.MethodPut, http.MethodDelete, MethodMkcol,
+}
+
+// NOTE: encoding/xml writes prefixed names verbatim - hence, "D:" everywhere
+// and a single `xmlns:D` declaration at the top
+type (
+	Multistatus struct {
+		XMLName   xml.Name   `xml:"D:multistatus"`
+		XMLNS     string     `xml:"xmlns:D,attr"`
+		Responses []Response `xml:"D:response"`
+	}
+	Response struct {
+		Href     string   `xml:"D:href"`
+		Propstat Propstat `xml:"D:propstat"`
+	}
+	Propstat struct {
+		Prop   Prop   `xml:"D:prop"`
+		Status string `xml:"D:status"`
+	}
+	Prop struct {
+		DisplayName   string       `xml:"D:displayname,omitempty"`
+		ResourceType  ResourceType `xml:"D:resourcetype"`
+		ContentLength *int64       `xml:"D:getcontentlength,omitempty"`
+		ContentType   string       `xml:"D:getcontenttype,omitempty"`
+		LastModified  string       `xml:"D:getlastmodified,omitempty"`
+		ETag          string       `xml:"D:getetag,omitempty"`
+	}
+	ResourceType struct {
+		Collection *struct{} `xml:"D:collection,omitempty"`
+	}
+)
+
+const statusOK = "HTTP/1.1 200 OK"
+
+func NewMultistatus() *Multistatus { return &Multistatus{XMLNS: "DAV:"} }
+
+// directory: root, bucket, or virtual subdirectory (prefix)
+func (ms *Multistatus) AddCollection(href, name string) {
+	ms.Responses = append(ms.Responses, Response{
+		Href: href,
+		Propstat: Propstat{
+			Prop:   Prop{DisplayName: name, ResourceType: ResourceType{Collection: &struct{}{}}},
+			Status: statusOK,
+		},
+	})
+}
+
+// object; mtime is expected to be already formatted (http.TimeFormat)
+func (ms *Multistatus) AddFile(href, name string, size int64, mtime, etag string) {
+	prop := Prop{DisplayName: name, ContentLength: &size, ContentType: cos.ContentBinary, LastModified: mtime}
+	if etag != "" {
+		prop.ETag = `"` + etag + `"`
+	}
+	ms.Responses = append(ms.Responses, Response{Href: href, Propstat: Propstat{Prop: prop, Status: statusOK}})
+}
+
+func (ms *Multistatus) MustMarshal(w io.Writer) {
+	w.Write([]byte(xml.Header))
+	err := xml.NewEncoder(w).Encode(ms)
+	if err != nil {
+		// (unlikely)
+		panic(err)
+	}
+}
+
+// Href returns escaped `/webdav/bucket/dir/obj` path; collections end with '/'
+func Href(collection bool, names ...string) string {
+	var sb strings.Builder
+	sb.WriteString(apc.URLPathWebDAV.S)
+	for _, name := range names {
+		for _, word := range strings.Split(strings.Trim(name, "/"), "/") {
+			if word == "" {
+				continue
+			}
+			sb.WriteByte('/')
+			sb.WriteString(url.PathEscape(word))
+		}
+	}
+	if collection {
+		sb.WriteByte('/')
+	}
+	return sb.String()
+}
+
+// Depth header: "0", "1", or "infinity" (the default);
+// infinite depth is not supported and gets limited to 1
+func ParseDepth(hdr http.Header) int {
+	if hdr.Get(HdrDepth) == "0" {
+		return DepthZero
+	}
+	return DepthOne
+}
+
+// BasicToBearer allows standard WebDAV clients (file managers, davfs2, rclone, etc.)
+// to authenticate with AuthN: username is ignored, password is the AuthN token.
+func BasicToBearer(hdr http.Header) {
+	s := hdr.Get(apc.HdrAuthorization)
+	b64, ok := strings.CutPrefix(s, "Basic ")
+	if !ok {
+		return
+	}
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(b64))
+	if err != nil {
+		return
+	}
+	_, token, ok := strings.Cut(string(b), ":")
+	if !ok || token == "" {
+		return
+	}
+	hdr.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+token)
+}
@@ -0,0 +1,77 @@
+// Package webdav provides WebDAV (RFC 4918, class 1) compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package webdav_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/webdav"
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+func TestHref(t *testing.T) {
+	tests := []struct {
+		collection bool
+		names      []string
+		expected   string
+	}{
+		{true, nil, "/webdav/"},
+		{true, []string{"bck"}, "/webdav/bck/"},
+		{true, []string{"bck", ""}, "/webdav/bck/"},
+		{false, []string{"bck", "dir/obj name"}, "/webdav/bck/dir/obj%20name"},
+		{true, []string{"bck", "a/b/"}, "/webdav/bck/a/b/"},
+	}
+	for _, test := range tests {
+		if href := webdav.Href(test.collection, test.names...); href != test.expected {
+			t.Errorf("Href(%t, %v): expected %q, got %q", test.collection, test.names, test.expected, href)
+		}
+	}
+}
+
+func TestMultistatus(t *testing.T) {
+	ms := webdav.NewMultistatus()
+	ms.AddCollection(webdav.Href(true, "bck"), "bck")
+	ms.AddFile(webdav.Href(false, "bck", "obj"), "obj", 1024, "Mon, 02 Jan 2006 15:04:05 GMT", "abc")
+
+	var buf bytes.Buffer
+	ms.MustMarshal(&buf)
+	out := buf.String()
+	for _, s := range []string{
+		`<D:multistatus xmlns:D="DAV:">`,
+		`<D:href>/webdav/bck/</D:href>`,
+		`<D:resourcetype><D:collection></D:collection></D:resourcetype>`,
+		`<D:getcontentlength>1024</D:getcontentlength>`,
+		`<D:getetag>&#34;abc&#34;</D:getetag>`,
+		`<D:status>HTTP/1.1 200 OK</D:status>`,
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("expected %q in:\n%s", s, out)
+		}
+	}
+}
+
+func TestBasicToBearer(t *testing.T) {
+	hdr := http.Header{}
+	hdr.Set(apc.HdrAuthorization, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:TOKEN")))
+	webdav.BasicToBearer(hdr)
+	if s := hdr.Get(apc.HdrAuthorization); s != "Bearer TOKEN" {
+		t.Errorf("expected bearer token, got %q", s)
+	}
+
+	// not touching bearer
+	webdav.BasicToBearer(hdr)
+	if s := hdr.Get(apc.HdrAuthorization); s != "Bearer TOKEN" {
+		t.Errorf("expected bearer token, got %q", s)
+	}
+
+	if webdav.ParseDepth(http.Header{webdav.HdrDepth: []string{"0"}}) != webdav.DepthZero ||
+		webdav.ParseDepth(http.Header{webdav.HdrDepth: []string{"infinity"}}) != webdav.DepthOne {
+		t.Error("unexpected depth")
+	}
+}
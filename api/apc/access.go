@@ -79,6 +79,10 @@ const (
 	AccessRW             = AccessRO | AcePUT | AceAPPEND | AceObjDELETE | AceObjMOVE
 	AllowReadWriteAccess = "rw"
 
+	// all operations that modify bucket's content (or the bucket itself) - denied when bucket is frozen
+	AccessModify = AcePUT | AceAPPEND | AceObjDELETE | AceObjMOVE | AcePromote | AceObjUpdate |
+		AceDestroyBucket | AceMoveBucket
+
 	AccessNone = AccessAttrs(0)
 )
 
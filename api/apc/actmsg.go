@@ -40,6 +40,7 @@ const (
 	ActPutCopies   = "put-copies"
 
 	ActRebalance = "rebalance"
+	ActRebVerify = "verify-rebalance" // post-rebalance verification
 	ActMoveBck   = "move-bck"
 
 	ActResilver = "resilver"
@@ -53,9 +54,12 @@ const (
 	ActInvalListCache = "inval-listobj-cache"
 	ActList           = "list"
 	ActLoadLomCache   = "load-lom-cache"
+	ActMakeInventory  = "make-inventory" // AIS-native bucket inventory (see also: HdrInventory)
 	ActNewPrimary     = "new-primary"
+	ActPresign        = "presign" // short-lived signed URL (see PresignMsg)
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
+	ActVerifyCksum    = "verify-checksum" // on-demand integrity audit (recompute and compare checksums)
 
 	// cp (reverse)
 	ActResetStats  = "reset-stats"
@@ -73,6 +77,7 @@ const (
 	ActDeleteObjects   = "delete-listrange"
 	ActETLObjects      = "etl-listrange"
 	ActEvictObjects    = "evict-listrange"
+	ActTierObjects     = "tier-listrange" // offload to remote backend, and evict
 	ActPrefetchObjects = "prefetch-listrange"
 	ActArchive         = "archive" // see ArchiveMsg
 
@@ -157,6 +162,9 @@ type (
 		Value  any    `json:"value"`  // action-specific and optional
 		Action string `json:"action"` // ActShutdown, ActRebalance, and many more (see apc/const.go)
 		Name   string `json:"name"`   // action-specific info of any kind (not necessarily "name")
+		// submitter: AuthN user ID as per the request's token;
+		// set by the (receiving) proxy - client-provided value, if any, is ignored
+		User string `json:"user,omitempty"`
 	}
 	ActValRmNode struct {
 		DaemonID          string `json:"sid"`
@@ -187,6 +195,11 @@ type (
 	}
 )
 
+// ContentUsage: used bytes (on disk) per content type - objects, workfiles, EC slices and metadata,
+// dsort intermediate files, etc. - on a per-mountpath basis:
+// mountpath => content type (e.g., "ob", "wk", "ec") => size
+type ContentUsage map[string]map[string]uint64
+
 // sysinfo
 type (
 	CapacityInfo struct {
@@ -36,6 +36,19 @@ type (
 		UsedPct      uint64 `json:"used_pct"`
 		IsBckPresent bool   `json:"is_present"` // in BMD
 	}
+
+	// lightweight alternative to the summary above: list-buckets with QparamBckUsage
+	// - object count and size: objects (main replicas) at their HRW locations - mirrored copies
+	//   and misplaced objects are not counted; computed by targets and refreshed in the background
+	//   when older than a minute
+	// - last PUT and GET: unix nanoseconds; zero when none since targets (re)started
+	BckUsage struct {
+		ObjCount uint64 `json:"obj_count,string"`
+		Size     uint64 `json:"size,string"` // sum(cached object sizes)
+		Created  int64  `json:"created,omitempty"`
+		LastPut  int64  `json:"last_put,omitempty"`
+		LastGet  int64  `json:"last_get,omitempty"`
+	}
 )
 
 func (msg *BsummCtrlMsg) Str(cname string) string {
@@ -0,0 +1,18 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// when to erasure-code (or replicate) a newly written object (see cmn.ECConf.EncodeMode)
+const (
+	ECEncodeAsync  = "async"  // in the background, right after PUT (default)
+	ECEncodeInline = "inline" // PUT returns upon encoding (durability first)
+	ECEncodeLazy   = "lazy"   // in the background, `ec.lazy_delay` after PUT (latency first)
+)
+
+var SupportedECEncode = [...]string{ECEncodeAsync, ECEncodeInline, ECEncodeLazy}
+
+func IsValidECEncode(m string) bool {
+	return m == "" || m == ECEncodeAsync || m == ECEncodeInline || m == ECEncodeLazy
+}
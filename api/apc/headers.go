@@ -43,10 +43,17 @@ const (
 	HdrS3Region   = aisPrefix + "Cloud_region"
 	HdrS3Endpoint = aisPrefix + "Endpoint"
 	HdrS3Profile  = aisPrefix + "Profile"
+	HdrS3Preset   = aisPrefix + "S3-Preset"
 
 	// including BucketProps.Extra.HTTP
 	HdrOrigURLBck = aisPrefix + "Original-Url"
 
+	// BucketProps.Extra.RGW (Ceph RGW bucket quota and usage)
+	HdrRGWQuotaMaxSize    = aisPrefix + "Rgw-Quota-Max-Size"
+	HdrRGWQuotaMaxObjects = aisPrefix + "Rgw-Quota-Max-Objects"
+	HdrRGWUsedSize        = aisPrefix + "Rgw-Used-Size"
+	HdrRGWUsedObjects     = aisPrefix + "Rgw-Used-Objects"
+
 	// remote AIS
 	HdrRemAisUUID  = aisPrefix + "Remote-Ais-Uuid"
 	HdrRemAisAlias = aisPrefix + "Remote-Ais-Alias"
@@ -92,6 +99,16 @@ const (
 	HdrSignedRequestStyle = aisPrefix + "S3-Signed-Request-Style"
 )
 
+// Client-supplied idempotency key (any unique string, e.g. UUID) for mutating requests:
+// PUT, DELETE, POST. A safely retried request (with the same key) does not duplicate work
+// and receives the original response - see ais/idem.go.
+const HdrIdempotencyKey = aisPrefix + "Idempotency-Key"
+
+// Request ID: generated by the proxy (unless provided by the client), returned to the client,
+// propagated to targets and remote AIS backends, and included in error responses and logs.
+// When redirecting, the proxy passes it on via QparamReqID.
+const HdrRequestID = aisPrefix + "Request-Id"
+
 // AuthN consts
 const (
 	HdrAuthorization         = "Authorization" // https://developer.mozilla.org/en-US/docs/Web/HTTP/Hdrs/Authorization
@@ -106,6 +123,9 @@ const (
 	HdrCallerIsPrimary = aisPrefix + "Caller-Is-Primary"
 	HdrCallerSmapVer   = aisPrefix + "Caller-Smap-Ver"
 
+	// request rejected due to cluster membership change (value: Smap version); see api.RetryPolicy
+	HdrSmapChanged = aisPrefix + "Smap-Changed"
+
 	HdrXactionID = aisPrefix + "Xaction-Id"
 
 	// intra-cluster streams
@@ -5,6 +5,7 @@
 package apc
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -19,6 +20,14 @@ type (
 		Template string   `json:"template"`
 		ObjNames []string `json:"objnames"`
 	}
+	// Optional caps on the total number of objects and bytes processed by a multi-object job
+	// (copy, transform, archive) - e.g., to run on a sample. Upon reaching either cap the job stops
+	// taking new objects and finishes with partial success (see core.Snap.Partial).
+	// Each target enforces its (1/N) share, N being the number of active targets.
+	JobQuota struct {
+		MaxObjects int64 `json:"max_objects,omitempty"`
+		MaxBytes   int64 `json:"max_bytes,omitempty"`
+	}
 )
 
 // [NOTE]
@@ -51,6 +60,60 @@ func (lrm *ListRange) Str(sb *strings.Builder, isPrefix bool) {
 	}
 }
 
+//////////////
+// JobQuota //
+//////////////
+
+func (q *JobQuota) IsSet() bool { return q.MaxObjects > 0 || q.MaxBytes > 0 }
+
+func (q *JobQuota) Validate() error {
+	if q.MaxObjects < 0 || q.MaxBytes < 0 {
+		return fmt.Errorf("invalid job quota: max-objects %d, max-bytes %d", q.MaxObjects, q.MaxBytes)
+	}
+	return nil
+}
+
+func (q *JobQuota) Str(sb *strings.Builder) {
+	if q.MaxObjects > 0 {
+		sb.WriteString(", max-objects=")
+		sb.WriteString(strconv.FormatInt(q.MaxObjects, 10))
+	}
+	if q.MaxBytes > 0 {
+		sb.WriteString(", max-bytes=")
+		sb.WriteString(cos.ToSizeIEC(q.MaxBytes, 0))
+	}
+}
+
+// evict (and delete) multiple objects
+// - optionally, select (in-cluster) objects by access time and/or size;
+// - empty `ListRange{}` selects all objects in the bucket (see NOTE above)
+type EvdMsg struct {
+	ListRange
+	OlderThan cos.Duration `json:"older-than,omitempty"` // not accessed during this time (ie., by object atime)
+	MinSize   int64        `json:"min-size,omitempty"`   // at least this size
+	DryRun    bool         `json:"dry-run,omitempty"`    // count (in-cluster) objects and bytes that would be removed - remove nothing
+}
+
+func (msg *EvdMsg) HasFilter() bool { return msg.OlderThan > 0 || msg.MinSize > 0 }
+
+func (msg *EvdMsg) Str(isPrefix bool) string {
+	var sb strings.Builder
+	sb.Grow(80)
+	msg.ListRange.Str(&sb, isPrefix)
+	if msg.OlderThan > 0 {
+		sb.WriteString(", older-than: ")
+		sb.WriteString(msg.OlderThan.String())
+	}
+	if msg.MinSize > 0 {
+		sb.WriteString(", min-size: ")
+		sb.WriteString(cos.ToSizeIEC(msg.MinSize, 0))
+	}
+	if msg.DryRun {
+		sb.WriteString(", dry-run")
+	}
+	return sb.String()
+}
+
 // prefetch
 type PrefetchMsg struct {
 	ListRange
@@ -95,6 +158,26 @@ type ArchiveMsg struct {
 	InclSrcBname    bool `json:"isbn"`   // include source bucket name into the names of archived objects
 	AppendIfExists  bool `json:"aate"`   // adding a list or a range of objects to an existing archive
 	ContinueOnError bool `json:"coer"`   // on err, keep running arc xaction in a any given multi-object transaction
+
+	// Optional: split the output into multiple shards named `<archname>-000.<ext>`, `<archname>-001.<ext>`, etc.
+	// A new shard starts when adding the next object would exceed either of the limits below.
+	// (incompatible with AppendIfExists)
+	MaxShardSize int64 `json:"max_shard_size,omitempty"` // approximate max shard size, in bytes
+	MaxShardRecs int64 `json:"max_shard_recs,omitempty"` // max number of archived objects per shard
+
+	JobQuota
+}
+
+func (msg *ArchiveMsg) Split() bool { return msg.MaxShardSize > 0 || msg.MaxShardRecs > 0 }
+
+func (msg *ArchiveMsg) ValidateSplit() error {
+	if msg.MaxShardSize < 0 || msg.MaxShardRecs < 0 {
+		return fmt.Errorf("invalid shard limits: max-size %d, max-records %d", msg.MaxShardSize, msg.MaxShardRecs)
+	}
+	if msg.Split() && msg.AppendIfExists {
+		return errors.New("splitting into multiple shards is incompatible with appending to an existing shard")
+	}
+	return nil
 }
 
 // multi-object copy & transform
@@ -0,0 +1,32 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+const (
+	PresignExpiresDflt = time.Hour
+	PresignExpiresMax  = 7 * 24 * time.Hour
+)
+
+type (
+	// ActPresign: exchange (AuthN) token for a time-limited URL that grants
+	// GET or PUT access to a single object or, when Prefix is true, all objects with a given prefix
+	// (ActMsg.Name is the object name or prefix, respectively)
+	PresignMsg struct {
+		Method  string       `json:"method"`            // http.MethodGet (default) or http.MethodPut
+		Expires cos.Duration `json:"expires,omitempty"` // valid for (default: PresignExpiresDflt)
+		Prefix  bool         `json:"prefix,omitempty"`  // ActMsg.Name is a prefix
+	}
+	PresignRes struct {
+		URL     string    `json:"url"`
+		Method  string    `json:"method"`
+		Expires time.Time `json:"expires"`
+	}
+)
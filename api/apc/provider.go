@@ -15,9 +15,11 @@ const (
 	Azure = "azure"
 	GCP   = "gcp"
 	OCI   = "oci"
+	RGW   = "rgw" // Ceph RADOS Gateway (S3 data path + admin ops API)
+	Ext   = "ext" // external backend plugins (out-of-process; bucket namespace = plugin name)
 	HT    = "ht"
 
-	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), oci (oc://), ht://" // NOTE: must include all
+	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), oci (oc://), rgw://, ext://, ht://" // NOTE: must include all
 
 	NsUUIDPrefix = '@' // BEWARE: used by on-disk layout
 	NsNamePrefix = '#' // BEWARE: used by on-disk layout
@@ -36,12 +38,12 @@ const (
 
 const RemAIS = "remais" // to differentiate ais vs "remote" ais; also, default (remote ais cluster) alias
 
-var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, OCI, HT)
+var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, OCI, RGW, Ext, HT)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
 func IsCloudProvider(p string) bool {
-	return p == AWS || p == GCP || p == Azure || p == OCI
+	return p == AWS || p == GCP || p == Azure || p == OCI || p == RGW || p == Ext
 }
 
 // NOTE: not to confuse w/ bck.IsRemote() which also includes remote AIS
@@ -96,6 +98,10 @@ func DisplayProvider(p string) string {
 		return "GCP"
 	case OCI, OCIScheme:
 		return "OCI"
+	case RGW:
+		return "Ceph RGW"
+	case Ext:
+		return "External"
 	case HT:
 		return "HTTP(S)"
 	default:
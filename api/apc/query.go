@@ -58,10 +58,16 @@ const (
 	// - ListObjsMsg flags, docs/providers.md (for terminology)
 	QparamFltPresence = "presence"
 
+	// list present buckets along with their (cached) sizes, object counts, and last activity (apc.BckUsage)
+	QparamBckUsage = "bck_usage"
+
 	// APPEND(object) operation - QparamAppendType enum below
 	QparamAppendType   = "append_type"
 	QparamAppendHandle = "append_handle"
 
+	// PATCH(object): in-place write of the request body at the specified offset (ais:// buckets only)
+	QparamPatchOffset = "patch_offset"
+
 	// HTTP bucket support.
 	QparamOrigURL = "original_url"
 
@@ -129,6 +135,10 @@ const (
 	// validate (ie., recompute and check) in-cluster object's checksums
 	QparamValidateCksum = "validate-checksum"
 
+	// GET with a lightweight built-in filter, e.g. "gzip" or "img-thumb" (see cmn/filter)
+	// - an alternative to deploying ETL for trivial transformations
+	QparamFilter = "filter"
+
 	// when true, skip nlog.Error and friends
 	// (to opt-out logging too many messages and/or benign warnings)
 	QparamSilent = "sln"
@@ -136,6 +146,20 @@ const (
 	// (see api.AttachMountpath vs. LocalConfig.FSP)
 	QparamMpathLabel = "mountpath_label"
 
+	// presigned URL (see ActPresign): expiration time (Unix seconds),
+	// signature, (optional) object name prefix the signature grants access to,
+	// and the ID of the issuing token (revoking the token invalidates the URL)
+	QparamPresignExp    = "ais-exp"
+	QparamPresignSig    = "ais-sig"
+	QparamPresignPrefix = "ais-prefix"
+	QparamPresignTid    = "ais-tid"
+
+	// AuthN: get role with all its inherited permissions (see authn.Role.Parents)
+	QparamEffective = "effective"
+
+	// AuthN: get user's active login sessions (see authn.Session)
+	QparamSessions = "sessions"
+
 	// Request to restore an object
 	QparamECObject = "object"
 )
@@ -192,6 +216,7 @@ const (
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return `cos.NodeStateInfo` including cluster metadata versions and state flags
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
+	QparamReqID            = "rid" // request ID (see HdrRequestID)
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
 
@@ -236,10 +261,17 @@ const (
 
 	WhatMetricNames = "metrics"
 
+	WhatNodeAlerts = "alerts" // node state flags (cos.NodeStateFlags); cluster-wide via GET /v1/cluster
+
 	// assorted
 	WhatMountpaths = "mountpaths"
+	WhatContent    = "content"   // used bytes per content type per mountpath (apc.ContentUsage)
+	WhatBckUsage   = "bck_usage" // per-bucket object counts, sizes, and last activity (apc.BckUsage)
 	WhatRemoteAIS  = "remote"
 	WhatSmapVote   = "smapvote"
+	WhatElections  = "elections" // history of primary elections (see meta.Election)
+	WhatSmapHist   = "smap_hist" // recent Smap versions (see meta.MetaHist)
+	WhatBMDHist    = "bmd_hist"  // recent BMD versions (proxies only)
 	WhatSysInfo    = "sysinfo"
 	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
 
@@ -6,6 +6,8 @@ package apc
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -14,12 +16,31 @@ import (
 // copy & (offline) transform bucket to bucket
 type (
 	CopyBckMsg struct {
-		Prepend   string `json:"prepend"`     // destination naming, as in: dest-obj-name = Prepend + source-obj-name
-		Prefix    string `json:"prefix"`      // prefix to select matching _source_ objects or virtual directories
-		DryRun    bool   `json:"dry_run"`     // visit all source objects, don't make any modifications
-		Force     bool   `json:"force"`       // force running in presence of "limited coexistence" type conflicts
-		LatestVer bool   `json:"latest-ver"`  // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
-		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
+		Prepend   string `json:"prepend"`            // destination naming, as in: dest-obj-name = Prepend + source-obj-name
+		Prefix    string `json:"prefix"`             // prefix to select matching _source_ objects or virtual directories
+		DryRun    bool   `json:"dry_run"`            // visit all source objects, don't make any modifications
+		Force     bool   `json:"force"`              // force running in presence of "limited coexistence" type conflicts
+		LatestVer bool   `json:"latest-ver"`         // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
+		Sync      bool   `json:"synchronize"`        // see also: 'versioning.synchronize'
+		Snapshot  bool   `json:"snapshot,omitempty"` // copy only those objects (and versions) that existed when the copy started
+
+		Rename *RenameMsg `json:"rename,omitempty"` // server-side destination naming (see below)
+
+		JobQuota
+	}
+	// Destination naming template, applied (in this exact order) to each source name:
+	// - strip prefix;
+	// - regex replace, e.g. Regex: "\\.jpeg$", Repl: ".jpg" (Repl may reference submatches: $1, ${name});
+	// - zero-pad the last sequence of digits to PadDigits, e.g. "img-7.jpg" => "img-0007.jpg";
+	// followed by TCBMsg.Ext (if any) and Prepend.
+	// Note that different source objects may end up with the same destination name.
+	RenameMsg struct {
+		StripPrefix string `json:"strip_prefix,omitempty"`
+		Regex       string `json:"regex,omitempty"`
+		Repl        string `json:"repl,omitempty"`
+		PadDigits   int    `json:"pad_digits,omitempty"`
+
+		re *regexp.Regexp // compiled Regex (see Init)
 	}
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
@@ -49,8 +70,12 @@ func (msg *TCBMsg) Validate(isEtl bool) (err error) {
 	return
 }
 
-// Replace extension and add suffix if provided.
+// Rename (if requested), replace extension, and prepend - if provided.
+// NOTE: Rename must be initialized (see CopyBckMsg.Init)
 func (msg *TCBMsg) ToName(name string) string {
+	if msg.Rename != nil {
+		name = msg.Rename.apply(name)
+	}
 	if msg.Ext != nil {
 		if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
 			ext := name[idx+1:]
@@ -69,6 +94,27 @@ func (msg *TCBMsg) ToName(name string) string {
 // CopyBckMsg //
 ////////////////
 
+// validate and compile (must be called prior to TCBMsg.ToName)
+func (msg *CopyBckMsg) Init() error {
+	if msg.Snapshot && (msg.Sync || msg.LatestVer) {
+		return errors.New("snapshot copy is incompatible with the request to synchronize buckets or copy the latest version")
+	}
+	if err := msg.JobQuota.Validate(); err != nil {
+		return err
+	}
+	if msg.JobQuota.IsSet() && msg.Sync {
+		// (synchronizing would remove destination objects that were not copied)
+		return errors.New("job quota is incompatible with the request to synchronize buckets")
+	}
+	if msg.Rename == nil {
+		return nil
+	}
+	if msg.Sync {
+		return errors.New("rename option is incompatible with the request to synchronize buckets")
+	}
+	return msg.Rename.init()
+}
+
 func (msg *CopyBckMsg) Str(sb *strings.Builder, fromCname, toCname string) {
 	sb.WriteString(fromCname)
 	sb.WriteString("=>")
@@ -79,4 +125,67 @@ func (msg *CopyBckMsg) Str(sb *strings.Builder, fromCname, toCname string) {
 	if msg.Sync {
 		sb.WriteString(", sync")
 	}
+	if msg.Snapshot {
+		sb.WriteString(", snapshot")
+	}
+	if msg.Rename != nil {
+		sb.WriteString(", rename")
+	}
+	msg.JobQuota.Str(sb)
 }
+
+///////////////
+// RenameMsg //
+///////////////
+
+const maxPadDigits = 32
+
+func (msg *RenameMsg) init() (err error) {
+	if msg.PadDigits < 0 || msg.PadDigits > maxPadDigits {
+		return fmt.Errorf("invalid rename pad-digits %d (expecting [0, %d])", msg.PadDigits, maxPadDigits)
+	}
+	if msg.Regex == "" {
+		if msg.Repl != "" {
+			return fmt.Errorf("rename replacement %q requires regex", msg.Repl)
+		}
+		return nil
+	}
+	if msg.re, err = regexp.Compile(msg.Regex); err != nil {
+		return fmt.Errorf("invalid rename regex %q: %v", msg.Regex, err)
+	}
+	return nil
+}
+
+func (msg *RenameMsg) apply(name string) string {
+	if msg.StripPrefix != "" {
+		name = strings.TrimPrefix(name, msg.StripPrefix)
+	}
+	if msg.re != nil {
+		name = msg.re.ReplaceAllString(name, msg.Repl)
+	}
+	if msg.PadDigits > 0 {
+		name = padLastDigits(name, msg.PadDigits)
+	}
+	return name
+}
+
+// zero-pad the last sequence of digits in the base name
+func padLastDigits(name string, width int) string {
+	var (
+		base = strings.LastIndexByte(name, '/') + 1
+		end  = len(name)
+	)
+	for end > base && !isDigit(name[end-1]) {
+		end--
+	}
+	start := end
+	for start > base && isDigit(name[start-1]) {
+		start--
+	}
+	if n := end - start; n == 0 || n >= width {
+		return name
+	}
+	return name[:start] + strings.Repeat("0", width-(end-start)) + name[start:]
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
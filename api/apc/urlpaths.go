@@ -26,6 +26,7 @@ const (
 	Reverse   = "reverse"
 	Xactions  = "xactions"
 	S3        = "s3"
+	WebDAV    = "webdav"
 	Txn       = "txn"      // 2PC
 	Notifs    = "notifs"   // intra-cluster notifications
 	Users     = "users"    // AuthN
@@ -38,6 +39,7 @@ const (
 	Voteres  = "result"
 	VoteInit = "init"
 	PriStop  = "primary-stopping"
+	PreVote  = "prevote"
 
 	// (see the corresponding action messages above)
 	Keepalive = "keepalive"
@@ -63,6 +65,9 @@ const (
 	FinishedAck = "finished_ack"
 	UList       = "list"
 	Remove      = "remove"
+	Boost       = "boost"
+	Pause       = "pause"
+	Resume      = "resume"
 	Next        = "next"
 	Peek        = "peek"
 	Discard     = "discard"
@@ -80,6 +85,7 @@ const (
 	ETLStart   = Start
 	ETLHealth  = "health"
 	ETLMetrics = "metrics"
+	ETLStats   = "stats"
 )
 
 // RESTful l3, internal use
@@ -97,7 +103,8 @@ func urlpath(words ...string) URLPath {
 }
 
 var (
-	URLPathS3 = urlpath(S3) // URLPath{[]string{S3}, S3}
+	URLPathS3     = urlpath(S3) // URLPath{[]string{S3}, S3}
+	URLPathWebDAV = urlpath(WebDAV)
 
 	URLPathBuckets  = urlpath(Version, Buckets)
 	URLPathObjects  = urlpath(Version, Objects)
@@ -143,6 +150,7 @@ var (
 	URLPathVoteProxy   = urlpath(Version, Vote, Proxy)
 	URLPathVoteVoteres = urlpath(Version, Vote, Voteres)
 	URLPathVotePriStop = urlpath(Version, Vote, PriStop)
+	URLPathVotePreVote = urlpath(Version, Vote, PreVote)
 
 	URLPathdSort        = urlpath(Version, Sort)
 	URLPathdSortInit    = urlpath(Version, Sort, Init)
@@ -158,6 +166,9 @@ var (
 	URLPathDownload       = urlpath(Version, Download)
 	URLPathDownloadAbort  = urlpath(Version, Download, Abort)
 	URLPathDownloadRemove = urlpath(Version, Download, Remove)
+	URLPathDownloadBoost  = urlpath(Version, Download, Boost)
+	URLPathDownloadPause  = urlpath(Version, Download, Pause)
+	URLPathDownloadResume = urlpath(Version, Download, Resume)
 
 	URLPathETL       = urlpath(Version, ETL)
 	URLPathETLObject = urlpath(Version, ETL, ETLObject)
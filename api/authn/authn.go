@@ -7,6 +7,7 @@ package authn
 import (
 	"errors"
 	"net/http"
+	"net/url"
 	"sort"
 	"time"
 
@@ -161,6 +162,26 @@ func GetRole(bp api.BaseParams, roleID string) (*Role, error) {
 	return rInfo, err
 }
 
+// GetEffectiveRole returns the role with its inherited (parent) permissions
+// merged in - the permissions actually granted to the role's users.
+func GetEffectiveRole(bp api.BaseParams, roleID string) (*Role, error) {
+	if roleID == "" {
+		return nil, errors.New("missing role ID")
+	}
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = cos.JoinWords(apc.URLPathRoles.S, roleID)
+		reqParams.Query = url.Values{apc.QparamEffective: []string{"true"}}
+	}
+
+	rInfo := &Role{}
+	_, err := reqParams.DoReqAny(&rInfo)
+	return rInfo, err
+}
+
 func GetAllRoles(bp api.BaseParams) ([]*Role, error) {
 	bp.Method = http.MethodGet
 	path := apc.URLPathRoles.S
@@ -219,6 +240,25 @@ func GetUser(bp api.BaseParams, userID string) (*User, error) {
 	return uInfo, err
 }
 
+// GetUserSessions returns the user's active login sessions (oldest first)
+func GetUserSessions(bp api.BaseParams, userID string) ([]*Session, error) {
+	if userID == "" {
+		return nil, errors.New("missing user ID")
+	}
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = cos.JoinWords(apc.URLPathUsers.S, userID)
+		reqParams.Query = url.Values{apc.QparamSessions: []string{"true"}}
+	}
+
+	sessions := make([]*Session, 0, 4)
+	_, err := reqParams.DoReqAny(&sessions)
+	return sessions, err
+}
+
 func AddRole(bp api.BaseParams, roleSpec *Role) error {
 	msg := cos.MustMarshal(roleSpec)
 	bp.Method = http.MethodPost
@@ -22,6 +22,7 @@ type (
 		Net     NetConf     `json:"net"`
 		Server  ServerConf  `json:"auth"`
 		Timeout TimeoutConf `json:"timeout"`
+		LDAP    LDAPConf    `json:"ldap"`
 		// private
 		mu sync.RWMutex `json:"-"`
 	}
@@ -48,6 +49,23 @@ type (
 	TimeoutConf struct {
 		Default cos.Duration `json:"default_timeout"`
 	}
+	// LDAP/Active Directory connector (empty URL: disabled)
+	LDAPConf struct {
+		URL          string `json:"url"`           // ldap://host:389 or ldaps://host:636
+		BindDN       string `json:"bind_dn"`       // service account to search the directory (empty: anonymous)
+		BindPassword string `json:"bind_password"` // service account password
+		BaseDN       string `json:"base_dn"`       // search base, e.g. "dc=example,dc=com"
+		UserAttr     string `json:"user_attr"`     // login attribute: "uid" (default) or "sAMAccountName" (AD)
+		UserClass    string `json:"user_class"`    // optional objectClass filter, e.g. "person"
+		GroupAttr    string `json:"group_attr"`    // user's group membership attribute (default: "memberOf")
+		// group DN or group CN => AuthN role
+		GroupRoles map[string]string `json:"group_roles"`
+		// periodically re-resolve LDAP users' groups and update their roles (0: at login time only)
+		SyncInterval cos.Duration `json:"sync_interval"`
+		SkipVerify   bool         `json:"skip_verify"` // ldaps and StartTLS: skip server certificate verification
+		// ldap://: do not upgrade the connection via StartTLS (NOT recommended - passwords are sent in cleartext)
+		AllowPlaintext bool `json:"allow_plaintext"`
+	}
 	ConfigToUpdate struct {
 		Server *ServerConfToSet `json:"auth"`
 	}
@@ -114,3 +132,13 @@ func (c *Config) ApplyUpdate(cu *ConfigToUpdate) error {
 	}
 	return nil
 }
+
+//////////////
+// LDAPConf //
+//////////////
+
+func (c *LDAPConf) Enabled() bool { return c.URL != "" }
+
+func (c *LDAPConf) LoginAttr() string { return cos.Left(c.UserAttr, "uid") }
+
+func (c *LDAPConf) MemberAttr() string { return cos.Left(c.GroupAttr, "memberOf") }
@@ -16,11 +16,20 @@ const (
 	AdminRole = "Admin"
 )
 
+// user source (default: local AuthN database)
+const (
+	UserSourceLDAP = "ldap" // LDAP/Active Directory: password is validated via LDAP bind
+)
+
 type (
 	User struct {
 		ID       string  `json:"id"`
 		Password string  `json:"pass,omitempty"`
+		Source   string  `json:"source,omitempty"` // "" (local) or UserSourceLDAP
 		Roles    []*Role `json:"roles"`
+		// LDAP users only: names of the roles (above) derived from directory groups
+		// (as opposed to assigned manually)
+		LDAPRoles []string `json:"ldap_roles,omitempty"`
 	}
 
 	CluACL struct {
@@ -48,12 +57,29 @@ type (
 		Clusters map[string]*CluACL `json:"clusters,omitempty"`
 	}
 
+	// Role may extend one or more existing (parent) roles, in which case it inherits
+	// (transitively) all their permissions; a role with multiple parents is a composite.
+	// Effective permissions: union of the role's own ACLs and those of all its ancestors.
 	Role struct {
 		Name        string    `json:"name"`
 		Description string    `json:"desc"`
 		ClusterACLs []*CluACL `json:"clusters"`
 		BucketACLs  []*BckACL `json:"buckets"`
-		IsAdmin     bool      `json:"admin"`
+		Parents     []string  `json:"parents,omitempty"`
+		// max number of jobs (xactions) the role's users can have running at the same time;
+		// 0 (default): unlimited; with multiple roles (including inherited), the largest wins
+		MaxJobs int `json:"max_jobs,omitempty"`
+		// max number of concurrent login sessions (active tokens) per user; upon login, the oldest
+		// sessions in excess are forcibly logged out (revoked); 0 (default): unlimited; the largest wins
+		MaxSessions int  `json:"max_sessions,omitempty"`
+		IsAdmin     bool `json:"admin"`
+	}
+
+	// user's login session: issued token that is neither expired nor revoked
+	Session struct {
+		ID      string    `json:"id"` // token fingerprint
+		Issued  time.Time `json:"issued"`
+		Expires time.Time `json:"expires"`
 	}
 )
 
@@ -72,6 +98,8 @@ func (u *User) IsAdmin() bool {
 	return false
 }
 
+func (u *User) IsLDAP() bool { return u.Source == UserSourceLDAP }
+
 ////////////
 // CluACL //
 ////////////
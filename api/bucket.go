@@ -22,6 +22,18 @@ func SetBucketProps(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (string,
 	return patchBprops(bp, bck, b)
 }
 
+// FreezeBucket makes the bucket temporarily read-only: all requests to write,
+// delete, rename, or destroy (see apc.AccessModify) are rejected with http.StatusLocked
+// until the bucket is unfrozen. Same as setting bucket property "frozen=true".
+func FreezeBucket(bp BaseParams, bck cmn.Bck) (string, error) {
+	return SetBucketProps(bp, bck, &cmn.BpropsToSet{Frozen: apc.Ptr(true)})
+}
+
+// UnfreezeBucket reverts FreezeBucket.
+func UnfreezeBucket(bp BaseParams, bck cmn.Bck) (string, error) {
+	return SetBucketProps(bp, bck, &cmn.BpropsToSet{Frozen: apc.Ptr(false)})
+}
+
 // ResetBucketProps resets the properties of a bucket to the global configuration.
 func ResetBucketProps(bp BaseParams, bck cmn.Bck) (string, error) {
 	b := cos.MustMarshal(apc.ActMsg{Action: apc.ActResetBprops})
@@ -297,3 +309,22 @@ func ECEncodeBucket(bp BaseParams, bck cmn.Bck, data, parity int, checkAndRecove
 	FreeRp(reqParams)
 	return
 }
+
+// Exchange the caller's (AuthN) token for a time-limited URL that can be used - without token -
+// to GET or PUT a single object or (when msg.Prefix is set) any object with the `name` prefix.
+// Requires AuthN; the URL expires at msg.Expires or the token's expiration time, whichever comes first.
+func PresignObject(bp BaseParams, bck cmn.Bck, name string, msg *apc.PresignMsg) (res *apc.PresignRes, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActPresign, Name: name, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	res = &apc.PresignRes{}
+	_, err = reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	return
+}
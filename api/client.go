@@ -28,11 +28,13 @@ const (
 
 type (
 	BaseParams struct {
-		Client *http.Client
-		URL    string
-		Method string
-		Token  string
-		UA     string
+		Client  *http.Client
+		URL     string
+		Method  string
+		Token   string
+		UA      string
+		IdemKey string       // optional; mutating requests only (see apc.HdrIdempotencyKey)
+		Retry   *RetryPolicy // optional; refresh cluster map and retry when cluster membership changes (see retry.go)
 	}
 
 	// ReqParams is used in constructing client-side API requests to aistore.
@@ -91,6 +93,9 @@ func SetAuxHeaders(r *http.Request, bp *BaseParams) {
 	if bp.UA != "" {
 		r.Header.Set(cos.HdrUserAgent, bp.UA)
 	}
+	if bp.IdemKey != "" && r.Method != http.MethodGet && r.Method != http.MethodHead {
+		r.Header.Set(apc.HdrIdempotencyKey, bp.IdemKey)
+	}
 }
 
 func GetWhatRawQuery(getWhat, getProps string) string {
@@ -186,7 +191,19 @@ func (reqParams *ReqParams) doReader() (io.ReadCloser, int64, error) {
 }
 
 // makes HTTP request, retries on connection-refused and reset errors, and returns the response
-func (reqParams *ReqParams) do() (resp *http.Response, err error) {
+func (reqParams *ReqParams) do() (*http.Response, error) {
+	if rp := reqParams.BaseParams.Retry; rp != nil {
+		return rp.do(reqParams)
+	}
+	req, resp, err := reqParams.send(true /*retry*/)
+	if err != nil {
+		return nil, reqParams.wrapErr(req, resp, err)
+	}
+	return resp, nil
+}
+
+// retry connection-refused and reset errors unless the caller (RetryPolicy) retries on its own
+func (reqParams *ReqParams) send(retry bool) (*http.Request, *http.Response, error) {
 	var reqBody io.Reader
 	if reqParams.Body != nil {
 		reqBody = bytes.NewBuffer(reqParams.Body)
@@ -194,13 +211,17 @@ func (reqParams *ReqParams) do() (resp *http.Response, err error) {
 	urlPath := reqParams.BaseParams.URL + reqParams.Path
 	req, errR := http.NewRequest(reqParams.BaseParams.Method, urlPath, reqBody)
 	if errR != nil {
-		return nil, fmt.Errorf("failed to create http request: %w", errR)
+		return nil, nil, fmt.Errorf("failed to create http request: %w", errR)
 	}
 	reqParams.setRequestOptParams(req)
 	SetAuxHeaders(req, &reqParams.BaseParams)
 
 	rr := reqResp{client: reqParams.BaseParams.Client, req: req}
-	err = cmn.NetworkCallWithRetry(&cmn.RetryArgs{
+	if !retry {
+		_, err := rr.call()
+		return req, rr.resp, err
+	}
+	err := cmn.NetworkCallWithRetry(&cmn.RetryArgs{
 		Call:      rr.call,
 		Verbosity: cmn.RetryLogOff,
 		SoftErr:   httpMaxRetries,
@@ -208,22 +229,25 @@ func (reqParams *ReqParams) do() (resp *http.Response, err error) {
 		BackOff:   true,
 		IsClient:  true,
 	})
-	resp = rr.resp
-	if err == nil {
-		return resp, nil
+	return req, rr.resp, err
+}
+
+func (reqParams *ReqParams) wrapErr(req *http.Request, resp *http.Response, err error) error {
+	if req == nil {
+		return err
 	}
 	if resp != nil {
 		herr := cmn.NewErrHTTP(req, err, resp.StatusCode)
 		herr.Method, herr.URLPath = reqParams.BaseParams.Method, reqParams.Path
-		return nil, herr
+		return herr
 	}
 	if uerr, ok := err.(*url.Error); ok {
 		err = uerr.Unwrap()
 		herr := cmn.NewErrHTTP(req, err, 0)
 		herr.Method, herr.URLPath = reqParams.BaseParams.Method, reqParams.Path
-		return nil, herr
+		return herr
 	}
-	return nil, err
+	return err
 }
 
 // Check, Drain, Close
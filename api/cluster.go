@@ -135,6 +135,14 @@ func GetNodeMeta(bp BaseParams, sid, what string) (out any, err error) {
 		config := cmn.ClusterConfig{}
 		_, err = reqParams.DoReqAny(&config)
 		out = &config
+	case apc.WhatElections:
+		var elections []meta.Election
+		_, err = reqParams.DoReqAny(&elections)
+		out = elections
+	case apc.WhatSmapHist, apc.WhatBMDHist:
+		var hist []meta.MetaHist
+		_, err = reqParams.DoReqAny(&hist)
+		out = hist
 	default:
 		err = fmt.Errorf("unknown or unsupported cluster-level metadata type %q", what)
 		return
@@ -40,6 +40,24 @@ func GetMountpaths(bp BaseParams, node *meta.Snode) (mpl *apc.MountpathList, err
 	return mpl, err
 }
 
+// GetContentUsage returns used bytes per content type (objects, workfiles, EC, etc.)
+// on each mountpath of a given target
+func GetContentUsage(bp BaseParams, node *meta.Snode) (cu apc.ContentUsage, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatContent}}
+		reqParams.Header = http.Header{
+			apc.HdrNodeID: []string{node.ID()},
+		}
+	}
+	_, err = reqParams.DoReqAny(&cu)
+	FreeRp(reqParams)
+	return cu, err
+}
+
 func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, label ...cos.MountpathLabel) error {
 	var q url.Values
 	if len(label) > 0 {
@@ -94,6 +94,46 @@ func DownloadStatus(bp BaseParams, id string, onlyActive bool) (dlStatus *dload.
 	return
 }
 
+// DownloadProgress returns consolidated cluster-wide progress of a given job in a single call:
+// aggregated counters, downloaded (and total, when known) bytes, and per-target breakdown
+// (see `dload.StatusResp`); unlike DownloadStatus, the response contains no per-task details
+func DownloadProgress(bp BaseParams, id string) (dlStatus *dload.StatusResp, err error) {
+	dlBody := dload.AdminBody{ID: id, Progress: true}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	dlStatus = &dload.StatusResp{}
+	_, err = reqParams.DoReqAny(dlStatus)
+	FreeRp(reqParams)
+	return
+}
+
+// returns the original job spec (with secrets, if any, removed) that can be inspected
+// and/or re-submitted via `DownloadWithParam`
+func DownloadDescribe(bp BaseParams, id string) (*dload.Body, error) {
+	dlBody := dload.AdminBody{ID: id, Spec: true}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	spec := &dload.Body{}
+	_, err := reqParams.DoReqAny(spec)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
 func DownloadGetList(bp BaseParams, regex string, onlyActive bool) (dlList dload.JobInfos, err error) {
 	dlBody := dload.AdminBody{Regex: regex, OnlyActive: onlyActive}
 	bp.Method = http.MethodGet
@@ -140,6 +180,37 @@ func RemoveDownload(bp BaseParams, id string) error {
 	return err
 }
 
+// Lift throttling limits (see dload.Limits) of a running download job, e.g.,
+// when the dataset suddenly becomes urgent
+func BoostDownload(bp BaseParams, id string) error {
+	return _putDownload(bp, id, apc.URLPathDownloadBoost.S)
+}
+
+// Pause a running download job: stop dispatching new tasks (while keeping the job's
+// state and progress) until ResumeDownload - e.g., to temporarily yield bandwidth
+func PauseDownload(bp BaseParams, id string) error {
+	return _putDownload(bp, id, apc.URLPathDownloadPause.S)
+}
+
+func ResumeDownload(bp BaseParams, id string) error {
+	return _putDownload(bp, id, apc.URLPathDownloadResume.S)
+}
+
+func _putDownload(bp BaseParams, id, path string) error {
+	dlBody := dload.AdminBody{ID: id}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = path
+		reqParams.Body = cos.MustMarshal(dlBody)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // TODO: simplify `dload.DlPostResp` => string
 func (reqParams *ReqParams) doDlDownloadRequest() (string, error) {
 	var resp dload.DlPostResp
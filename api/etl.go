@@ -101,6 +101,21 @@ func ETLMetrics(params BaseParams, etlName string) (healths etl.CPUMemByTarget,
 	return
 }
 
+// ETLStats returns per-target transformation counters (objects, size, latency, errors)
+// of the named ETL instance
+func ETLStats(params BaseParams, etlName string) (all etl.StatsByTarget, err error) {
+	params.Method = http.MethodGet
+	path := apc.URLPathETL.Join(etlName, apc.ETLStats)
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = params
+		reqParams.Path = path
+	}
+	_, err = reqParams.DoReqAny(&all)
+	FreeRp(reqParams)
+	return
+}
+
 func ETLHealth(params BaseParams, etlName string) (healths etl.HealthByTarget, err error) {
 	params.Method = http.MethodGet
 	path := apc.URLPathETL.Join(etlName, apc.ETLHealth)
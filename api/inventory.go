@@ -0,0 +1,137 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// AIS-native bucket inventory (see cmn/inventory.go)
+// compare with S3 inventory - ListArgs.Header and apc.HdrInventory
+
+type NativeInv struct {
+	ID    string    // inventory ID (same as the ID of x-make-inventory that generated it)
+	Parts []string  // object names of the inventory parts (one per target)
+	Time  time.Time // when generated (latest part's atime)
+}
+
+// MakeInventory starts generating AIS-native inventory of a given bucket;
+// returns x-make-inventory ID (which is also the inventory ID)
+func MakeInventory(bp BaseParams, bck cmn.Bck) (string, error) {
+	return StartXaction(bp, &xact.ArgsMsg{Kind: apc.ActMakeInventory, Bck: bck}, "")
+}
+
+// GetNativeInventories returns all AIS-native inventories of a given bucket,
+// the most recent first
+func GetNativeInventories(bp BaseParams, bck cmn.Bck) ([]*NativeInv, error) {
+	msg := &apc.LsoMsg{
+		Prefix:     cmn.NativeInvPrefix + cos.PathSeparator,
+		Props:      apc.GetPropsName + apc.LsPropsSepa + apc.GetPropsAtime,
+		TimeFormat: time.RFC3339Nano,
+	}
+	msg.SetFlag(apc.LsObjCached) // (remote bucket: parts are written-through but also stay in-cluster)
+	lst, err := ListObjects(bp, bck, msg, ListArgs{})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		invs = make([]*NativeInv, 0, 4)
+		all  = make(map[string]*NativeInv, 4)
+	)
+	for _, en := range lst.Entries {
+		id := cmn.NativeInvID(en.Name)
+		if id == "" {
+			continue
+		}
+		inv, ok := all[id]
+		if !ok {
+			inv = &NativeInv{ID: id}
+			all[id] = inv
+			invs = append(invs, inv)
+		}
+		inv.Parts = append(inv.Parts, en.Name)
+		if atime, err := time.Parse(time.RFC3339Nano, en.Atime); err == nil && atime.After(inv.Time) {
+			inv.Time = atime
+		}
+	}
+	sort.Slice(invs, func(i, j int) bool { return invs[i].Time.After(invs[j].Time) })
+	return invs, nil
+}
+
+// ListObjectsNativeInv lists objects using a given (or, if `id` is empty, the most recent)
+// AIS-native inventory of a given bucket
+// - returns inventoried objects that match `msg.Prefix` sorted by name;
+// - `msg.TimeFormat` (if defined) applies to the returned atimes; other `msg` fields are ignored
+func ListObjectsNativeInv(bp BaseParams, bck cmn.Bck, msg *apc.LsoMsg, id string) (*cmn.LsoRes, *NativeInv, error) {
+	invs, err := GetNativeInventories(bp, bck)
+	if err != nil {
+		return nil, nil, err
+	}
+	var inv *NativeInv
+	for _, i := range invs {
+		if id == "" || i.ID == id {
+			inv = i
+			break
+		}
+	}
+	if inv == nil {
+		what := "inventory"
+		if id != "" {
+			what += " " + id
+		}
+		return nil, nil, cos.NewErrNotFound(&bck, what)
+	}
+
+	lst := &cmn.LsoRes{UUID: inv.ID}
+	for _, part := range inv.Parts {
+		if err := readInvPart(bp, bck, part, msg, lst); err != nil {
+			return nil, inv, err
+		}
+	}
+	sort.Slice(lst.Entries, func(i, j int) bool { return lst.Entries[i].Name < lst.Entries[j].Name })
+	return lst, inv, nil
+}
+
+func readInvPart(bp BaseParams, bck cmn.Bck, part string, msg *apc.LsoMsg, lst *cmn.LsoRes) error {
+	r, _, err := GetObjectReader(bp, bck, part, nil)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	cr.FieldsPerRecord = len(cmn.NativeInvHeader)
+	if _, err := cr.Read(); err != nil { // header
+		return errors.New(part + ": failed to read inventory header: " + err.Error())
+	}
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.New(part + ": " + err.Error())
+		}
+		if msg.Prefix != "" && !strings.HasPrefix(rec[0], msg.Prefix) {
+			continue
+		}
+		en, err := cmn.ParseNativeInvRecord(rec, msg.TimeFormat)
+		if err != nil {
+			return errors.New(part + ": " + err.Error())
+		}
+		lst.Entries = append(lst.Entries, en)
+	}
+}
@@ -73,6 +73,34 @@ func ListBuckets(bp BaseParams, qbck cmn.QueryBcks, fltPresence int) (cmn.Bcks,
 	return bcks, nil
 }
 
+// ListBucketsUsage returns present buckets (in the cluster) along with their (cached) sizes,
+// object counts, creation times, and last PUT/GET times - a lightweight alternative to
+// GetBucketSummary that does not run summary jobs.
+// See also: apc.BckUsage
+func ListBucketsUsage(bp BaseParams, qbck cmn.QueryBcks) (cmn.AllBckUsage, error) {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamFltPresence, strconv.Itoa(apc.FltPresent))
+	q.Set(apc.QparamBckUsage, "true")
+	qbck.AddToQuery(q)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.S
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActList, Name: qbck.Name})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	all := cmn.AllBckUsage{}
+	_, err := reqParams.DoReqAny(&all)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
 // QueryBuckets is a little convenience helper. It returns true if the selection contains
 // at least one bucket that satisfies the (qbck) criteria.
 // - `fltPresence` - as per QparamFltPresence enum (see api/apc/query.go)
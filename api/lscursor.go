@@ -0,0 +1,219 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// LsoCursor is a typed (and serializable) pagination cursor on top of ListObjectsPage
+// that supports both forward and backward iteration.
+//
+// The cursor records the continuation token of every page visited so far, so that
+// going back (ListObjectsPrev) is a single-page request rather than re-listing
+// the bucket from the start.
+//
+// Stability: the cursor does not depend on the server-side list-objects session.
+// When the session is gone - e.g., due to a brief cluster membership change -
+// the page is re-requested with the same continuation token in a new session.
+// This is done once, and only upon 404 (session not found) or 5xx; other errors
+// (e.g., 400 or 403) are returned as is.
+//
+// Reverse (Z to A) order: when `Reverse` is set, entries of each returned page are
+// sorted in descending order, and ListObjectsPrev walks the pages from the end -
+// see also SeekEnd.
+//
+// Cost of reverse order: the cluster lists objects in ascending order only; reverse
+// paging is done on the client. To start from the last page, SeekEnd must first list
+// the entire bucket (or prefix) - names only, one request per page - so that it takes
+// O(number of objects) time and traffic. For very large buckets, prefer a narrower
+// prefix or ascending order.
+//
+// Usage (e.g., UI browser):
+//
+//	cursor := api.NewLsoCursor(&apc.LsoMsg{Prefix: "a/", PageSize: 100})
+//	page, err := api.ListObjectsNext(bp, bck, cursor, api.ListArgs{})
+//	...
+//	token := cursor.String() // pass around, e.g. as an opaque URL query parameter
+//	...
+//	cursor, err = api.ParseLsoCursor(token)
+//	page, err = api.ListObjectsPrev(bp, bck, cursor, api.ListArgs{})
+type LsoCursor struct {
+	Msg     apc.LsoMsg `json:"m"`
+	Tokens  []string   `json:"t,omitempty"` // Tokens[i] is the continuation token to (re)fetch page i
+	Next    string     `json:"n,omitempty"` // continuation token of the page that follows the current one
+	Page    int        `json:"p"`           // current page number (-1 when positioned before the first page)
+	Done    bool       `json:"d,omitempty"` // current page is the last one
+	Reverse bool       `json:"r,omitempty"` // Z to A
+}
+
+var errLsoCursor = errors.New("invalid list-objects cursor")
+
+func NewLsoCursor(lsmsg *apc.LsoMsg) *LsoCursor {
+	c := &LsoCursor{Page: -1}
+	if lsmsg != nil {
+		c.Msg = *lsmsg
+	}
+	c.Msg.UUID, c.Msg.ContinuationToken = "", ""
+	return c
+}
+
+func ParseLsoCursor(s string) (*LsoCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errLsoCursor, err)
+	}
+	c := &LsoCursor{}
+	if err := jsoniter.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("%w: %v", errLsoCursor, err)
+	}
+	if c.Page < -1 || c.Page > len(c.Tokens) {
+		return nil, fmt.Errorf("%w: page %d out of range [-1, %d]", errLsoCursor, c.Page, len(c.Tokens))
+	}
+	return c, nil
+}
+
+// opaque (URL-safe) representation of the cursor
+func (c *LsoCursor) String() string {
+	b, err := jsoniter.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (c *LsoCursor) HasPrev() bool {
+	if c.Reverse {
+		return c.Page < len(c.Tokens)-1 || !c.Done
+	}
+	return c.Page > 0
+}
+
+func (c *LsoCursor) HasNext() bool {
+	if c.Reverse {
+		return c.Page > 0
+	}
+	return c.Page < 0 || !c.Done
+}
+
+// ListObjectsNext returns the next page: A to Z, or Z to A when the cursor is reversed.
+func ListObjectsNext(bp BaseParams, bck cmn.Bck, c *LsoCursor, args ListArgs) (*cmn.LsoRes, error) {
+	if c.Reverse {
+		return c.back(bp, bck, args)
+	}
+	return c.fwd(bp, bck, args)
+}
+
+// ListObjectsPrev returns the previous page; for a reversed cursor, the previous
+// page is the one that is next in the (natural) A to Z order.
+func ListObjectsPrev(bp BaseParams, bck cmn.Bck, c *LsoCursor, args ListArgs) (*cmn.LsoRes, error) {
+	if c.Reverse {
+		return c.fwd(bp, bck, args)
+	}
+	return c.back(bp, bck, args)
+}
+
+// SeekEnd positions the cursor past the last page, so that the subsequent
+// ListObjectsPrev (or ListObjectsNext, if reversed) returns the last page.
+// To locate the end, SeekEnd lists names only and does it once per cursor:
+// all continuation tokens are recorded and later reused.
+// NOTE: this is a full (client-side) scan - see "Cost of reverse order" above.
+func SeekEnd(bp BaseParams, bck cmn.Bck, c *LsoCursor, args ListArgs) error {
+	var (
+		orig  = c.Msg
+		props = c.Msg.Props
+		flags = c.Msg.Flags
+	)
+	c.Msg.Props = apc.GetPropsName
+	c.Msg.Flags = flags | apc.LsNameOnly
+	for c.Page < 0 || !c.Done {
+		if _, err := c.fwd(bp, bck, args); err != nil {
+			c.Msg = orig
+			return err
+		}
+	}
+	c.Msg.Props, c.Msg.Flags = props, flags
+	c.Msg.UUID = ""
+	c.Page = len(c.Tokens) // past the end
+	return nil
+}
+
+func (c *LsoCursor) fwd(bp BaseParams, bck cmn.Bck, args ListArgs) (*cmn.LsoRes, error) {
+	var token string
+	switch {
+	case c.Page < 0:
+	case c.Page >= len(c.Tokens)-1 && c.Done:
+		return nil, cos.NewErrNotFound(&bck, "next page")
+	default:
+		token = c.Next
+	}
+	page, err := c.get(bp, bck, token, args)
+	if err != nil {
+		return nil, err
+	}
+	c.Page++
+	if c.Page < len(c.Tokens) {
+		c.Tokens[c.Page] = token
+	} else {
+		c.Tokens = append(c.Tokens, token)
+	}
+	c.update(page)
+	return page, nil
+}
+
+func (c *LsoCursor) back(bp BaseParams, bck cmn.Bck, args ListArgs) (*cmn.LsoRes, error) {
+	if c.Page <= 0 {
+		return nil, cos.NewErrNotFound(&bck, "previous page")
+	}
+	token := c.Tokens[c.Page-1]
+	c.Msg.UUID = "" // going back: start new session
+	page, err := c.get(bp, bck, token, args)
+	if err != nil {
+		return nil, err
+	}
+	c.Page--
+	c.update(page)
+	return page, nil
+}
+
+func (c *LsoCursor) update(page *cmn.LsoRes) {
+	c.Msg.UUID = page.UUID
+	c.Next = page.ContinuationToken
+	c.Done = page.ContinuationToken == ""
+	if c.Done {
+		c.Tokens = c.Tokens[:c.Page+1]
+	}
+	if c.Reverse {
+		slices.Reverse(page.Entries)
+	}
+}
+
+// get a single page, and retry once in a new session if the current one is gone
+func (c *LsoCursor) get(bp BaseParams, bck cmn.Bck, token string, args ListArgs) (*cmn.LsoRes, error) {
+	lsmsg := c.Msg
+	lsmsg.ContinuationToken = token
+	page, err := ListObjectsPage(bp, bck, &lsmsg, args)
+	if err != nil && c.Msg.UUID != "" && lsoRetriable(err) {
+		lsmsg = c.Msg
+		lsmsg.UUID, lsmsg.ContinuationToken = "", token
+		page, err = ListObjectsPage(bp, bck, &lsmsg, args)
+	}
+	return page, err
+}
+
+// session not found, or server-side failure
+func lsoRetriable(err error) bool {
+	herr := cmn.Err2HTTPErr(err)
+	return herr != nil && (herr.Status == http.StatusNotFound || herr.Status >= http.StatusInternalServerError)
+}
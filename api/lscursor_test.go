@@ -0,0 +1,222 @@
+// Package api_test contains unit tests for the native Go-based API/SDK.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// fake bucket: continuation token is the index of the first entry of the next page;
+// sessions (UUIDs) can be dropped to emulate a cluster membership change
+type lsoServer struct {
+	names    []string
+	sessions map[string]bool
+	reqs     atomic.Int32
+	fail     atomic.Int32 // fail the next request with this status
+	nextID   int
+}
+
+func newLsoServer(t *testing.T, n int) (*lsoServer, api.BaseParams) {
+	s := &lsoServer{sessions: make(map[string]bool)}
+	for i := range n {
+		s.names = append(s.names, fmt.Sprintf("obj-%03d", i))
+	}
+	srv := httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(srv.Close)
+	return s, api.BaseParams{Client: srv.Client(), URL: srv.URL}
+}
+
+func (s *lsoServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.reqs.Add(1)
+	if status := s.fail.Swap(0); status != 0 {
+		http.Error(w, http.StatusText(int(status)), int(status))
+		return
+	}
+	var msg struct {
+		Action string     `json:"action"`
+		Value  apc.LsoMsg `json:"value"`
+	}
+	if err := jsoniter.NewDecoder(r.Body).Decode(&msg); err != nil || msg.Action != apc.ActList {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	lsmsg := &msg.Value
+	if lsmsg.UUID != "" && !s.sessions[lsmsg.UUID] {
+		http.Error(w, "list-objects session "+lsmsg.UUID+" not found", http.StatusNotFound)
+		return
+	}
+	if lsmsg.UUID == "" {
+		s.nextID++
+		lsmsg.UUID = "lso-" + strconv.Itoa(s.nextID)
+		s.sessions[lsmsg.UUID] = true
+	}
+	var start int
+	if lsmsg.ContinuationToken != "" {
+		start, _ = strconv.Atoi(lsmsg.ContinuationToken)
+	}
+	end := min(start+int(lsmsg.PageSize), len(s.names))
+	page := &cmn.LsoRes{UUID: lsmsg.UUID}
+	for _, name := range s.names[start:end] {
+		page.Entries = append(page.Entries, &cmn.LsoEnt{Name: name})
+	}
+	if end < len(s.names) {
+		page.ContinuationToken = strconv.Itoa(end)
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Write(cos.MustMarshal(page))
+}
+
+func pageNames(page *cmn.LsoRes) (names []string) {
+	for _, en := range page.Entries {
+		names = append(names, en.Name)
+	}
+	return names
+}
+
+func checkPage(t *testing.T, page *cmn.LsoRes, err error, first, last string) {
+	t.Helper()
+	tassert.CheckFatal(t, err)
+	names := pageNames(page)
+	tassert.Fatalf(t, len(names) > 0 && names[0] == first && names[len(names)-1] == last,
+		"expected page [%s ... %s], got %v", first, last, names)
+}
+
+var lsoBck = cmn.Bck{Name: "cursor", Provider: apc.AIS}
+
+func TestLsoCursorFwdBack(t *testing.T) {
+	_, bp := newLsoServer(t, 25)
+	c := api.NewLsoCursor(&apc.LsoMsg{PageSize: 10})
+	tassert.Fatalf(t, c.HasNext() && !c.HasPrev(), "new cursor: expecting next only")
+
+	page, err := api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-000", "obj-009")
+	page, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-010", "obj-019")
+
+	// last page
+	page, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-020", "obj-024")
+	tassert.Fatalf(t, c.Done && !c.HasNext() && c.HasPrev(), "last page: expecting (done, prev only)")
+	_, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	tassert.Fatalf(t, cos.IsNotExist(err, 0), "past the last page: expecting not-found, got %v", err)
+
+	// back
+	page, err = api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-010", "obj-019")
+	page, err = api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-000", "obj-009")
+	tassert.Fatalf(t, !c.HasPrev() && c.HasNext(), "first page: expecting next only")
+	_, err = api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	tassert.Fatalf(t, cos.IsNotExist(err, 0), "before the first page: expecting not-found, got %v", err)
+
+	// and forward again, via serialized cursor
+	c, err = api.ParseLsoCursor(c.String())
+	tassert.CheckFatal(t, err)
+	page, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-010", "obj-019")
+}
+
+func TestLsoCursorSessionGone(t *testing.T) {
+	s, bp := newLsoServer(t, 25)
+	c := api.NewLsoCursor(&apc.LsoMsg{PageSize: 10})
+	page, err := api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-000", "obj-009")
+
+	clear(s.sessions)
+	page, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-010", "obj-019")
+}
+
+func TestLsoCursorRetry(t *testing.T) {
+	s, bp := newLsoServer(t, 25)
+	c := api.NewLsoCursor(&apc.LsoMsg{PageSize: 10})
+	page, err := api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-000", "obj-009")
+
+	// not retriable
+	for _, status := range []int32{http.StatusBadRequest, http.StatusForbidden} {
+		reqs := s.reqs.Load()
+		s.fail.Store(status)
+		_, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+		tassert.Fatalf(t, err != nil, "expecting error (status %d)", status)
+		tassert.Errorf(t, s.reqs.Load()-reqs == 1, "status %d: expecting no retries, got %d requests", status, s.reqs.Load()-reqs)
+	}
+
+	// retried in a new session
+	reqs := s.reqs.Load()
+	s.fail.Store(http.StatusServiceUnavailable)
+	page, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-010", "obj-019")
+	tassert.Errorf(t, s.reqs.Load()-reqs == 2, "expecting one retry, got %d requests", s.reqs.Load()-reqs)
+}
+
+func TestLsoCursorSeekEnd(t *testing.T) {
+	s, bp := newLsoServer(t, 25)
+	c := api.NewLsoCursor(&apc.LsoMsg{PageSize: 10, Props: apc.GetPropsSize})
+	tassert.CheckFatal(t, api.SeekEnd(bp, lsoBck, c, api.ListArgs{}))
+	tassert.Fatalf(t, c.Msg.Props == apc.GetPropsSize && c.Msg.Flags&apc.LsNameOnly == 0, "SeekEnd must restore lsmsg")
+	tassert.Fatalf(t, !c.HasNext() && c.HasPrev(), "past the end: expecting prev only")
+
+	// last page, then back to the first - one request per page
+	reqs := s.reqs.Load()
+	page, err := api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-020", "obj-024")
+	page, err = api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-010", "obj-019")
+	page, err = api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-000", "obj-009")
+	tassert.Errorf(t, s.reqs.Load()-reqs == 3, "expecting 3 requests, got %d", s.reqs.Load()-reqs)
+
+	// reversed (Z to A)
+	c = api.NewLsoCursor(&apc.LsoMsg{PageSize: 10})
+	c.Reverse = true
+	tassert.CheckFatal(t, api.SeekEnd(bp, lsoBck, c, api.ListArgs{}))
+	page, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-024", "obj-020")
+	tassert.Fatalf(t, sort.IsSorted(sort.Reverse(sort.StringSlice(pageNames(page)))), "expecting descending order")
+	page, err = api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-019", "obj-010")
+	page, err = api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	checkPage(t, page, err, "obj-024", "obj-020")
+}
+
+func TestLsoCursorEmpty(t *testing.T) {
+	_, bp := newLsoServer(t, 0)
+	c := api.NewLsoCursor(&apc.LsoMsg{PageSize: 10})
+	page, err := api.ListObjectsNext(bp, lsoBck, c, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(page.Entries) == 0, "expecting empty page, got %v", pageNames(page))
+	tassert.Fatalf(t, c.Done && !c.HasNext() && !c.HasPrev(), "empty bucket: expecting neither next nor prev")
+
+	c = api.NewLsoCursor(&apc.LsoMsg{PageSize: 10})
+	tassert.CheckFatal(t, api.SeekEnd(bp, lsoBck, c, api.ListArgs{}))
+	page, err = api.ListObjectsPrev(bp, lsoBck, c, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(page.Entries) == 0, "expecting empty page, got %v", pageNames(page))
+}
+
+func TestLsoCursorParse(t *testing.T) {
+	for _, s := range []string{"", "!", "e30"} { // "e30" is "{}" (page 0 with no tokens: ok)
+		_, err := api.ParseLsoCursor(s)
+		if s == "e30" {
+			tassert.CheckError(t, err)
+		} else {
+			tassert.Errorf(t, err != nil, "%q: expecting error", s)
+		}
+	}
+}
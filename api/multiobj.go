@@ -60,12 +60,25 @@ func DeleteMultiObj(bp BaseParams, bck cmn.Bck, objNames []string, template stri
 }
 
 func EvictMultiObj(bp BaseParams, bck cmn.Bck, objNames []string, template string) (string, error) {
+	return EvictObjects(bp, bck, &apc.EvdMsg{ListRange: apc.ListRange{ObjNames: objNames, Template: template}})
+}
+
+// same as above, with optional selection of the (in-cluster) objects to evict by access time and size;
+// with msg.DryRun, evicts nothing - the resulting job stats (per target) show what would've been evicted
+func EvictObjects(bp BaseParams, bck cmn.Bck, msg *apc.EvdMsg) (string, error) {
 	bp.Method = http.MethodDelete
 	q := bck.NewQuery()
-	msg := apc.ListRange{ObjNames: objNames, Template: template}
 	return dolr(bp, bck, apc.ActEvictObjects, msg, q)
 }
 
+// offload (in-cluster) objects to the bucket's remote backend and evict them;
+// objects missing in the remote bucket get uploaded first; subsequent GET transparently restores
+func TierObjects(bp BaseParams, bck cmn.Bck, msg *apc.EvdMsg) (string, error) {
+	bp.Method = http.MethodDelete
+	q := bck.NewQuery()
+	return dolr(bp, bck, apc.ActTierObjects, msg, q)
+}
+
 func Prefetch(bp BaseParams, bck cmn.Bck, msg apc.PrefetchMsg) (string, error) {
 	bp.Method = http.MethodPost
 	q := bck.NewQuery()
@@ -15,7 +15,9 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -132,6 +134,16 @@ type (
 		Object     string
 		Handle     string
 	}
+
+	// PATCH(object): ranged in-place write
+	PatchArgs struct {
+		Reader     cos.ReadOpenCloser
+		BaseParams BaseParams
+		Bck        cmn.Bck
+		Object     string
+		Offset     int64 // where to write; must not exceed the current object size
+		Size       int64 // (optional) number of bytes to write, aka Content-Length
+	}
 )
 
 // GET(object) =========================================================================================
@@ -252,6 +264,79 @@ func GetObjectReader(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs)
 	return
 }
 
+// GetObjectParallel ======================================================================================
+//
+// Reads a single (large) object via `workers` concurrent range reads, each of up to `partSize` bytes,
+// and writes the parts in place (at their respective offsets) into the provided `io.WriterAt` (e.g., *os.File).
+// - non-positive `partSize` and/or `workers` default to `dfltParallelPartSize` and `dfltParallelWorkers`, respectively;
+// - the object must not change while being read (otherwise, returns error);
+// - returns the object's size.
+
+const (
+	dfltParallelPartSize = 16 * cos.MiB
+	dfltParallelWorkers  = 8
+)
+
+func GetObjectParallel(bp BaseParams, bck cmn.Bck, objName string, w io.WriterAt, partSize int64, workers int) (int64, error) {
+	op, err := HeadObject(bp, bck, objName, HeadArgs{})
+	if err != nil {
+		return 0, err
+	}
+	size := op.Size
+	if size == 0 {
+		return 0, nil
+	}
+	if partSize <= 0 {
+		partSize = dfltParallelPartSize
+	}
+	if workers <= 0 {
+		workers = dfltParallelWorkers
+	}
+	var (
+		numParts = (size + partSize - 1) / partSize
+		version  = op.Version()
+		group    errgroup.Group
+		failed   atomic.Bool
+	)
+	group.SetLimit(int(min(int64(workers), numParts)))
+	for off := int64(0); off < size && !failed.Load(); off += partSize {
+		length := min(partSize, size-off)
+		group.Go(func() error {
+			if failed.Load() {
+				return nil
+			}
+			err := _getPart(bp, bck, objName, io.NewOffsetWriter(w, off), off, length, version)
+			if err != nil {
+				failed.Store(true)
+			}
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+func _getPart(bp BaseParams, bck cmn.Bck, objName string, w io.Writer, off, length int64, version string) error {
+	args := GetArgs{Writer: w, Header: http.Header{}}
+	args.Header.Set(cos.HdrRange, cmn.MakeRangeHdr(off, length))
+	oah, err := GetObject(bp, bck, objName, &args)
+	if err != nil {
+		return err
+	}
+	if oah.n != length {
+		return fmt.Errorf("%s: range [%d, %d) read %d bytes (expected %d)", bck.Cname(objName), off, off+length, oah.n, length)
+	}
+	if version != "" {
+		attrs := oah.Attrs()
+		if v := attrs.Version(); v != "" && v != version {
+			return fmt.Errorf("%s: version changed during read (%q vs %q)", bck.Cname(objName), version, v)
+		}
+	}
+	return nil
+}
+
 // PUT(object) ============================================================================================
 //
 // Uses the specified reader (`args.Reader`) to write a new object (or a new version of the object).
@@ -400,6 +485,46 @@ func SetObjectCustomProps(bp BaseParams, bck cmn.Bck, objName string, custom cos
 	return err
 }
 
+// PatchObject ==========================================================================================
+//
+// Writes `args.Reader` content in place, starting at `args.Offset`, into an existing ais:// object.
+// The object grows if the write goes past its current end; the offset itself must not exceed the size.
+// Upon success, the object's checksum is recomputed (as per bucket's checksum config), version
+// incremented (if versioned), and mirrored copies and/or EC slices re-synced.
+
+func (args *PatchArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
+
+func (args *PatchArgs) _patch(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, newErrCreateHTTPRequest(err)
+	}
+	req.GetBody = args.getBody
+	if args.Size != 0 {
+		req.ContentLength = args.Size
+	}
+	SetAuxHeaders(req, &args.BaseParams)
+	return req, nil
+}
+
+func PatchObject(args *PatchArgs) error {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamPatchOffset, strconv.FormatInt(args.Offset, 10))
+	q = args.Bck.AddToQuery(q)
+
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPatch
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathObjects.Join(args.Bck.Name, args.Object)
+		reqArgs.Query = q
+		reqArgs.BodyR = args.Reader
+	}
+	_, err := DoWithRetry(args.BaseParams.Client, args._patch, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	return err
+}
+
 // DELETE(object) ======================================================================================
 
 func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
@@ -0,0 +1,194 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// RetryPolicy: transparently refresh cluster map (Smap) and retry API calls that fail
+// because cluster membership changed mid-operation, namely:
+// - the proxy at BaseParams.URL is gone (e.g., primary change) - in which case subsequent
+//   calls switch over to another live proxy from the (cached) cluster map;
+// - the node is not present in the (proxy's) cluster map - e.g., target gone or not yet joined;
+// - "not primary" and "node not found" responses (see apc.HdrSmapChanged);
+// - redirect storms ("stopped after N redirects").
+//
+// Requests that may have been executed - connection reset or EOF mid-request, "service
+// unavailable" - are retried only when idempotent: GET and HEAD, or with BaseParams.IdemKey
+// (see apc.HdrIdempotencyKey). Connection refused and DNS failures are always retried.
+//
+// The policy replaces (rather than wraps) the default retrying of connection errors, so that
+// the total number of attempts does not exceed MaxRetries+1.
+//
+// The policy is meant to be shared by all BaseParams of a given cluster (it is safe for
+// concurrent use). Requests that stream payload from the caller (e.g., PutObject) are
+// not retried by this policy (see DoWithRetry instead).
+//
+// Usage:
+//   bp := api.BaseParams{Client: client, URL: proxyURL, Retry: &api.RetryPolicy{MaxRetries: 5}}
+
+const (
+	dfltSmapRetries    = 3
+	dfltSmapRetrySleep = time.Second
+)
+
+type RetryPolicy struct {
+	smap       *meta.Smap    // cached
+	url        string        // live proxy to use instead of BaseParams.URL, if set
+	MaxRetries int           // max number of times to refresh cluster map and retry (default: 3)
+	Sleep      time.Duration // initial sleep between retries, to grow 1.5x each time (default: 1s)
+	mu         sync.Mutex
+	inited     bool
+}
+
+func (rp *RetryPolicy) do(reqParams *ReqParams) (*http.Response, error) {
+	var (
+		maxRetries = cos.NonZero(rp.MaxRetries, dfltSmapRetries)
+		sleep      = cos.NonZero(rp.Sleep, dfltSmapRetrySleep)
+		idempotent = reqParams.idempotent()
+	)
+	rp.init(reqParams.BaseParams)
+	for i := 0; ; i++ {
+		if u := rp.proxyURL(); u != "" {
+			reqParams.BaseParams.URL = u
+		}
+		req, resp, err := reqParams.send(false /*retry: the policy does*/)
+		var proxyGone bool
+		switch {
+		case err != nil:
+			retry := req != nil && (isNotSent(err) || isRedirectStorm(err) || (idempotent && isUnreachable(err)))
+			if !retry || i >= maxRetries {
+				return nil, reqParams.wrapErr(req, resp, err)
+			}
+			// (as opposed to target that we were redirected to)
+			proxyGone = !isRedirectStorm(err) && isFailedURL(err, reqParams.BaseParams.URL)
+		case resp.StatusCode >= http.StatusBadRequest:
+			b, _ := cos.ReadAllN(resp.Body, resp.ContentLength)
+			resp.Body.Close()
+			if !isSmapChange(resp, idempotent) || i >= maxRetries {
+				resp.Body = io.NopCloser(bytes.NewReader(b))
+				return resp, nil
+			}
+		default:
+			return resp, nil
+		}
+
+		time.Sleep(sleep)
+		sleep += sleep / 2
+		rp.refresh(reqParams.BaseParams, proxyGone)
+	}
+}
+
+func (rp *RetryPolicy) proxyURL() (u string) {
+	rp.mu.Lock()
+	u = rp.url
+	rp.mu.Unlock()
+	return u
+}
+
+// best-effort: cache cluster map upfront - the proxy may be gone by the time we need it
+func (rp *RetryPolicy) init(bp BaseParams) {
+	rp.mu.Lock()
+	if rp.inited {
+		rp.mu.Unlock()
+		return
+	}
+	rp.inited = true
+	rp.mu.Unlock()
+
+	bp.Retry = nil
+	if smap, err := GetClusterMap(bp); err == nil {
+		rp.mu.Lock()
+		rp.smap = smap
+		rp.mu.Unlock()
+	}
+}
+
+// refresh cluster map; when the current proxy is unreachable, try other proxies
+// (primary first) and switch over to the first one that responds
+func (rp *RetryPolicy) refresh(bp BaseParams, proxyGone bool) {
+	rp.mu.Lock()
+	smap := rp.smap
+	rp.mu.Unlock()
+
+	urls := make([]string, 0, 4)
+	if proxyGone && smap != nil {
+		if smap.Primary != nil && smap.Primary.PubNet.URL != bp.URL {
+			urls = append(urls, smap.Primary.PubNet.URL)
+		}
+		for _, psi := range smap.Pmap {
+			if u := psi.PubNet.URL; u != bp.URL && (smap.Primary == nil || psi.ID() != smap.Primary.ID()) {
+				urls = append(urls, u)
+			}
+		}
+	}
+	urls = append(urls, bp.URL)
+
+	bp.Retry = nil
+	for _, u := range urls {
+		bp.URL = u
+		newSmap, err := GetClusterMap(bp)
+		if err != nil {
+			continue
+		}
+		rp.mu.Lock()
+		if rp.smap == nil || newSmap.Version >= rp.smap.Version {
+			rp.smap = newSmap
+		}
+		if proxyGone {
+			rp.url = u
+		}
+		rp.mu.Unlock()
+		return
+	}
+}
+
+//
+// error classes
+//
+
+func (reqParams *ReqParams) idempotent() bool {
+	bp := reqParams.BaseParams
+	return bp.Method == http.MethodGet || bp.Method == http.MethodHead || bp.IdemKey != ""
+}
+
+// request never made it to the server
+func isNotSent(err error) bool {
+	return cos.IsErrConnectionRefused(err) || cos.IsErrDNSLookup(err)
+}
+
+func isUnreachable(err error) bool {
+	return isNotSent(err) || cos.IsRetriableConnErr(err) || cos.IsEOF(err)
+}
+
+// see http.Client.CheckRedirect: "stopped after 10 redirects"
+func isRedirectStorm(err error) bool {
+	uerr, ok := err.(*url.Error)
+	return ok && uerr.Err != nil && strings.HasPrefix(uerr.Err.Error(), "stopped after")
+}
+
+func isFailedURL(err error, u string) bool {
+	uerr, ok := err.(*url.Error)
+	return ok && strings.HasPrefix(uerr.URL, u)
+}
+
+// rejected by the cluster (see ais: errNodeNotFound, errNotPrimary), or unavailable
+func isSmapChange(resp *http.Response, idempotent bool) bool {
+	if resp.Header.Get(apc.HdrSmapChanged) != "" {
+		return true
+	}
+	return idempotent && resp.StatusCode == http.StatusServiceUnavailable
+}
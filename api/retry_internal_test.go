@@ -0,0 +1,200 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const retryTestPath = "/v1/test"
+
+// fake proxy: serves cluster map and counts (non-Smap) requests handled by `h`
+type retryServer struct {
+	srv  *httptest.Server
+	smap *meta.Smap
+	h    http.HandlerFunc
+	reqs atomic.Int32
+}
+
+func newRetryServer(t *testing.T, h http.HandlerFunc) *retryServer {
+	s := &retryServer{h: h}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func (s *retryServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == apc.URLPathDae.S && r.URL.Query().Get(apc.QparamWhat) == apc.WhatSmap {
+		w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+		w.Write(cos.MustMarshal(s.smap))
+		return
+	}
+	s.reqs.Add(1)
+	s.h(w, r)
+}
+
+func retryTestSmap(urls ...string) *meta.Smap {
+	smap := &meta.Smap{Pmap: make(meta.NodeMap, len(urls)), Version: 10}
+	for i, u := range urls {
+		psi := &meta.Snode{DaeID: "p" + string(rune('a'+i)), DaeType: apc.Proxy, PubNet: meta.NetInfo{URL: u}}
+		smap.Pmap[psi.DaeID] = psi
+		if i == 0 {
+			smap.Primary = psi
+		}
+	}
+	return smap
+}
+
+func retryTestDo(bp BaseParams, method, idemKey string) (int, error) {
+	bp.Method = method
+	bp.IdemKey = idemKey
+	reqParams := AllocRp()
+	reqParams.BaseParams = bp
+	reqParams.Path = retryTestPath
+	resp, err := reqParams.do()
+	FreeRp(reqParams)
+	if err != nil {
+		return 0, err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func TestRetryErrClasses(t *testing.T) {
+	// connection refused
+	gone := httptest.NewServer(http.NotFoundHandler())
+	goneURL := gone.URL
+	gone.Close()
+	_, err := http.Get(goneURL + retryTestPath)
+	tassert.Fatalf(t, err != nil, "expected connection error")
+	tassert.Errorf(t, isNotSent(err), "expected not-sent: %v", err)
+	tassert.Errorf(t, isUnreachable(err), "expected unreachable: %v", err)
+	tassert.Errorf(t, isFailedURL(err, goneURL), "expected failed URL %q: %v", goneURL, err)
+	tassert.Errorf(t, !isFailedURL(err, "http://127.0.0.1:1"), "unexpected failed URL: %v", err)
+	tassert.Errorf(t, !isRedirectStorm(err), "unexpected redirect storm: %v", err)
+
+	// redirect storm
+	loop := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	defer loop.Close()
+	_, err = loop.Client().Get(loop.URL + retryTestPath)
+	tassert.Fatalf(t, err != nil, "expected redirect error")
+	tassert.Errorf(t, isRedirectStorm(err), "expected redirect storm: %v", err)
+	tassert.Errorf(t, !isNotSent(err), "unexpected not-sent: %v", err)
+	tassert.Errorf(t, !isRedirectStorm(errors.New("stopped after 10 redirects")), "expected url.Error only")
+
+	// cluster map changed
+	tests := []struct {
+		status     int
+		hdr        bool
+		idempotent bool
+		expected   bool
+	}{
+		{http.StatusBadRequest, true, false, true},
+		{http.StatusNotFound, true, true, true},
+		{http.StatusServiceUnavailable, false, true, true},
+		{http.StatusServiceUnavailable, false, false, false},
+		{http.StatusNotFound, false, true, false},
+		{http.StatusInternalServerError, false, true, false},
+	}
+	for _, test := range tests {
+		resp := &http.Response{StatusCode: test.status, Header: http.Header{}}
+		if test.hdr {
+			resp.Header.Set(apc.HdrSmapChanged, "true")
+		}
+		tassert.Errorf(t, isSmapChange(resp, test.idempotent) == test.expected,
+			"%+v: expected %t", test, test.expected)
+	}
+}
+
+func TestRetryPolicyFailover(t *testing.T) {
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	a, b := newRetryServer(t, ok), newRetryServer(t, ok)
+	smap := retryTestSmap(a.srv.URL, b.srv.URL)
+	a.smap, b.smap = smap, smap
+
+	rp := &RetryPolicy{MaxRetries: 2, Sleep: time.Millisecond}
+	bp := BaseParams{Client: &http.Client{}, URL: a.srv.URL, Retry: rp}
+	status, err := retryTestDo(bp, http.MethodGet, "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, status == http.StatusOK && a.reqs.Load() == 1, "expected one request to %s", a.srv.URL)
+
+	// the proxy (primary) is gone: switch over to the other one
+	a.srv.Close()
+	status, err = retryTestDo(bp, http.MethodPost, "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, status == http.StatusOK && b.reqs.Load() == 1, "expected one request to %s", b.srv.URL)
+	tassert.Errorf(t, rp.proxyURL() == b.srv.URL, "expected to switch over to %s, got %q", b.srv.URL, rp.proxyURL())
+
+	// and stay there
+	_, err = retryTestDo(bp, http.MethodGet, "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, b.reqs.Load() == 2, "expected 2 requests to %s, got %d", b.srv.URL, b.reqs.Load())
+}
+
+func TestRetryPolicyAttempts(t *testing.T) {
+	const maxRetries = 3
+	tests := []struct {
+		name     string
+		method   string
+		idemKey  string
+		h        http.HandlerFunc
+		expected int32
+	}{
+		{"unavailable-get", http.MethodGet, "", unavailable, maxRetries + 1},
+		{"unavailable-post", http.MethodPost, "", unavailable, 1},
+		{"unavailable-post-idempotent", http.MethodPost, "key", unavailable, maxRetries + 1},
+		{"smap-changed-post", http.MethodPost, "", smapChanged, maxRetries + 1},
+		{"not-found", http.MethodGet, "", http.NotFound, 1},
+		// no inner retries: the policy alone decides (see ReqParams.send)
+		{"reset-get", http.MethodGet, "", reset, maxRetries + 1},
+		{"reset-post", http.MethodPost, "", reset, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newRetryServer(t, test.h)
+			s.smap = retryTestSmap(s.srv.URL)
+			// (no keep-alive: net/http itself replays idempotent requests that fail on a reused connection)
+			client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+			bp := BaseParams{Client: client, URL: s.srv.URL, Retry: &RetryPolicy{MaxRetries: maxRetries, Sleep: time.Millisecond}}
+			retryTestDo(bp, test.method, test.idemKey)
+			tassert.Errorf(t, s.reqs.Load() == test.expected, "expected %d attempts, got %d", test.expected, s.reqs.Load())
+		})
+	}
+}
+
+func unavailable(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusServiceUnavailable)
+}
+
+func smapChanged(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set(apc.HdrSmapChanged, "true")
+	w.WriteHeader(http.StatusBadRequest)
+}
+
+// abort the connection without responding
+func reset(w http.ResponseWriter, _ *http.Request) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		return
+	}
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		tcp.SetLinger(0)
+	}
+	conn.Close()
+}
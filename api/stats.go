@@ -60,6 +60,20 @@ func GetClusterStats(bp BaseParams) (res stats.Cluster, err error) {
 	return
 }
 
+// cluster-wide node alerts (cos.NodeStateFlags), including unresponsive nodes (see stats.Alerts)
+func GetClusterAlerts(bp BaseParams) (res stats.ClusterAlerts, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatNodeAlerts}}
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return res, err
+}
+
 //
 // node ----------------------
 //
@@ -41,6 +41,17 @@ func StartXaction(bp BaseParams, args *xact.ArgsMsg, extra string /* e.g. blob-d
 	return
 }
 
+// VerifyChecksum starts on-demand integrity audit (x-verify-checksum) of a given bucket[/prefix]:
+// recompute checksums and compare against stored metadata; optionally, repair corrupted objects.
+// Use `QueryXactionSnaps` to retrieve per-target results (see xact.CksumVerifyReport)
+func VerifyChecksum(bp BaseParams, bck cmn.Bck, prefix string, repair bool) (string, error) {
+	args := &xact.ArgsMsg{Kind: apc.ActVerifyCksum, Bck: bck}
+	if repair {
+		args.Flags = xact.XcvRepair
+	}
+	return StartXaction(bp, args, prefix)
+}
+
 // a.k.a. stop
 func AbortXaction(bp BaseParams, args *xact.ArgsMsg) (err error) {
 	msg := apc.ActMsg{Action: apc.ActXactStop, Value: args}
@@ -84,7 +95,7 @@ func GetAllRunningXactions(bp BaseParams, kindOrName string) (out []string, err
 // QueryXactionSnaps gets all xaction snaps based on the specified selection.
 // NOTE: args.Kind can be either xaction kind or name - here and elsewhere
 func QueryXactionSnaps(bp BaseParams, args *xact.ArgsMsg) (xs xact.MultiSnap, err error) {
-	msg := xact.QueryMsg{ID: args.ID, Kind: args.Kind, Bck: args.Bck}
+	msg := xact.QueryMsg{ID: args.ID, Kind: args.Kind, Bck: args.Bck, User: args.User, Timeline: args.Timeline}
 	if args.OnlyRunning {
 		msg.OnlyRunning = apc.Ptr(true)
 	}
@@ -17,6 +17,7 @@ const (
 	rolesCollection    = "role"
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
+	sessionsCollection = "session"
 
 	adminUserID   = "admin"
 	adminUserPass = "admin"
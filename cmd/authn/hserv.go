@@ -268,6 +268,10 @@ func (h *hserv) httpUserGet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamSessions)) {
+		h.userSessions(w, r, items[0])
+		return
+	}
 	uInfo, err := h.mgr.lookupUser(items[0])
 	if err != nil {
 		cmn.WriteErr(w, r, err)
@@ -277,6 +281,32 @@ func (h *hserv) httpUserGet(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, uInfo, "get user")
 }
 
+// Returns user's active login sessions (admin or the user themselves)
+func (h *hserv) userSessions(w http.ResponseWriter, r *http.Request, userID string) {
+	tk, err := getToken(r)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	if !tk.IsAdmin && tk.UserID != userID {
+		cmn.WriteErr(w, r, fmt.Errorf("not authorized: (%s)", tk), http.StatusUnauthorized)
+		return
+	}
+	if _, err := h.mgr.lookupUser(userID); err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	sessions, err := h.mgr.userSessions(userID)
+	if err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	if sessions == nil {
+		sessions = []*authn.Session{}
+	}
+	writeJSON(w, sessions, "get user sessions")
+}
+
 func getToken(r *http.Request) (*tok.Token, error) {
 	tokenStr, err := tok.ExtractToken(r.Header)
 	if err != nil {
@@ -498,6 +528,12 @@ func (h *hserv) httpRoleGet(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErr(w, r, err)
 		return
 	}
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamEffective)) {
+		if role, err = h.mgr.effectiveRole(role); err != nil {
+			cmn.WriteErr(w, r, err)
+			return
+		}
+	}
 	clus, err := h.mgr.clus()
 	if err != nil {
 		cmn.WriteErr(w, r, err)
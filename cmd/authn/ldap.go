@@ -0,0 +1,254 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// LDAP (RFC 4511) via github.com/go-ldap/ldap:
+// - search the directory for a user entry (and the user's group membership)
+// - validate user credentials via simple bind
+// Plain ldap:// connections are upgraded via StartTLS unless explicitly configured
+// otherwise (see LDAPConf.AllowPlaintext) - simple bind sends passwords in cleartext.
+
+const ldapSizeLimit = 2 // expecting exactly one entry
+
+var errLDAPNotFound = errors.New("ldap: user not found")
+
+func ldapDial(conf *authn.LDAPConf, timeout time.Duration) (*ldap.Conn, error) {
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: invalid URL %q: %v", conf.URL, err)
+	}
+	if u.Scheme != "ldap" && u.Scheme != "ldaps" {
+		return nil, fmt.Errorf("ldap: unsupported URL scheme %q (expecting ldap:// or ldaps://)", u.Scheme)
+	}
+	tcfg := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: conf.SkipVerify, //nolint:gosec // explicitly configured
+	}
+	lc, err := ldap.DialURL(conf.URL, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}), ldap.DialWithTLSConfig(tcfg))
+	if err != nil {
+		return nil, err
+	}
+	if timeout > 0 {
+		lc.SetTimeout(timeout)
+	}
+	if u.Scheme == "ldap" && !conf.AllowPlaintext {
+		if err := lc.StartTLS(tcfg); err != nil {
+			lc.Close()
+			return nil, fmt.Errorf("ldap: StartTLS failed (%w); use ldaps:// or, if you must, set 'allow_plaintext'", err)
+		}
+	}
+	return lc, nil
+}
+
+//
+// AuthN <=> LDAP
+//
+
+func ldapConnect(conf *authn.LDAPConf) (*ldap.Conn, error) {
+	lc, err := ldapDial(conf, time.Duration(Conf.Timeout.Default))
+	if err != nil {
+		return nil, err
+	}
+	if conf.BindDN != "" {
+		if err := lc.Bind(conf.BindDN, conf.BindPassword); err != nil {
+			lc.Close()
+			return nil, fmt.Errorf("ldap: failed to bind as %q: %w", conf.BindDN, err)
+		}
+	}
+	return lc, nil
+}
+
+func ldapLookup(lc *ldap.Conn, conf *authn.LDAPConf, uid string) (*ldap.Entry, error) {
+	filter := fmt.Sprintf("(%s=%s)", ldap.EscapeFilter(conf.LoginAttr()), ldap.EscapeFilter(uid))
+	if conf.UserClass != "" {
+		filter = fmt.Sprintf("(&%s(objectClass=%s))", filter, ldap.EscapeFilter(conf.UserClass))
+	}
+	req := ldap.NewSearchRequest(conf.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, ldapSizeLimit,
+		0 /*time limit*/, false /*types only*/, filter, []string{conf.MemberAttr()}, nil)
+	res, err := lc.Search(req)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+			return nil, fmt.Errorf("ldap: %s=%s is ambiguous", conf.LoginAttr(), uid)
+		}
+		return nil, err
+	}
+	switch len(res.Entries) {
+	case 0:
+		return nil, errLDAPNotFound
+	case 1:
+		return res.Entries[0], nil
+	default:
+		return nil, fmt.Errorf("ldap: %s=%s is ambiguous", conf.LoginAttr(), uid)
+	}
+}
+
+// resolve directory user and, if requested, validate the password (via LDAP bind);
+// returns the user's groups
+func ldapUser(conf *authn.LDAPConf, uid, password string, authenticate bool) ([]string, error) {
+	lc, err := ldapConnect(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer lc.Close()
+	entry, err := ldapLookup(lc, conf, uid)
+	if err != nil {
+		return nil, err
+	}
+	if authenticate {
+		// NOTE: empty password is an "unauthenticated bind" that succeeds - never allow it
+		if password == "" {
+			return nil, errInvalidCredentials
+		}
+		if err := lc.Bind(entry.DN, password); err != nil {
+			if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+				return nil, errInvalidCredentials
+			}
+			return nil, err
+		}
+	}
+	return entry.GetEqualFoldAttributeValues(conf.MemberAttr()), nil
+}
+
+// map directory groups to AuthN role names; groups are matched by DN or by CN (case-insensitive)
+func ldapGroupRoles(conf *authn.LDAPConf, groups []string) []string {
+	var roles []string
+	for _, group := range groups {
+		cn := groupCN(group)
+		for key, role := range conf.GroupRoles {
+			if !strings.EqualFold(key, group) && !strings.EqualFold(key, cn) {
+				continue
+			}
+			if !cos.StringInSlice(role, roles) {
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles
+}
+
+// "CN=admins,OU=groups,DC=example,DC=com" => "admins"
+func groupCN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 {
+		return ""
+	}
+	for _, attr := range parsed.RDNs[0].Attributes {
+		if strings.EqualFold(attr.Type, "cn") {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+//
+// LDAP users
+//
+
+// merge user's roles with the ones mapped from (resolved) directory groups:
+//   - manually assigned roles (e.g., with 'ais auth add user') always remain intact,
+//     including those that also happen to be LDAP-mapped
+//   - previously LDAP-derived roles (User.LDAPRoles) that are no longer mapped get revoked
+func (m *mgr) ldapUpdateRoles(uInfo *authn.User, groups []string) (changed bool) {
+	var (
+		names   = ldapGroupRoles(&Conf.LDAP, groups)
+		roles   = make([]*authn.Role, 0, len(uInfo.Roles)+len(names))
+		derived []string
+	)
+	for _, role := range uInfo.Roles {
+		if !slices.Contains(uInfo.LDAPRoles, role.Name) {
+			roles = append(roles, role) // manual
+		}
+	}
+	for _, name := range names {
+		if slices.ContainsFunc(roles, func(r *authn.Role) bool { return r.Name == name }) {
+			continue // already assigned manually
+		}
+		if role, err := m.lookupRole(name); err == nil {
+			roles = append(roles, role)
+			derived = append(derived, name)
+		} else {
+			nlog.Warningf("LDAP group role %q (user %q) does not exist", name, uInfo.ID)
+		}
+	}
+	changed = !slices.EqualFunc(roles, uInfo.Roles, func(a, b *authn.Role) bool { return a.Name == b.Name }) ||
+		!slices.Equal(derived, uInfo.LDAPRoles)
+	uInfo.Roles, uInfo.LDAPRoles = roles, derived
+	return changed
+}
+
+// periodically re-resolve group membership of all LDAP users (until stopped)
+func (m *mgr) ldapSyncLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.ldapSync(); err != nil {
+				nlog.Errorln("LDAP sync:", err)
+			}
+		case <-m.stopCh.Listen():
+			return
+		}
+	}
+}
+
+func (m *mgr) ldapSync() error {
+	users, err := m.userList()
+	if err != nil {
+		return err
+	}
+	var (
+		lc   *ldap.Conn
+		conf = &Conf.LDAP
+	)
+	for _, uInfo := range users {
+		if !uInfo.IsLDAP() {
+			continue
+		}
+		if lc == nil {
+			if lc, err = ldapConnect(conf); err != nil {
+				return err
+			}
+			defer lc.Close()
+		}
+		var groups []string
+		entry, err := ldapLookup(lc, conf, uInfo.ID)
+		switch {
+		case err == nil:
+			groups = entry.GetEqualFoldAttributeValues(conf.MemberAttr())
+		case errors.Is(err, errLDAPNotFound):
+			// removed from the directory: revoke all LDAP-mapped roles (and note that
+			// the user won't be able to log in anyway)
+			nlog.Warningf("LDAP user %q not found in the directory", uInfo.ID)
+		default:
+			return err
+		}
+		if !m.ldapUpdateRoles(uInfo, groups) {
+			continue
+		}
+		if err := m.db.Set(usersCollection, uInfo.ID, uInfo); err != nil {
+			return err
+		}
+		nlog.Infof("LDAP user %q: updated roles", uInfo.ID)
+	}
+	return nil
+}
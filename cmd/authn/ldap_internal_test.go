@@ -0,0 +1,233 @@
+// Package authn
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	ldapTestUserDN = "uid=alice,ou=people,dc=example,dc=com"
+	ldapTestPass   = "secret"
+)
+
+// self-signed
+func ldapTestTLS(t *testing.T) *tls.Config {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(1), NotAfter: time.Now().Add(time.Hour), IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1)}}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	tassert.CheckFatal(t, err)
+	return &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+}
+
+// fake directory with a single user (alice) that is a member of two groups;
+// nil tcfg: StartTLS is not supported
+func ldapTestServer(t *testing.T, tcfg *tls.Config) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go ldapTestServe(conn, tcfg)
+		}
+	}()
+	return "ldap://" + ln.Addr().String()
+}
+
+func ldapTestServe(conn net.Conn, tcfg *tls.Config) {
+	defer func() { conn.Close() }()
+	reply := func(id int64, ops ...*ber.Packet) {
+		for _, op := range ops {
+			msg := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+			msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, ""))
+			msg.AppendChild(op)
+			conn.Write(msg.Bytes())
+		}
+	}
+	result := func(tag ber.Tag, code int) *ber.Packet {
+		op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+		op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, ""))
+		op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+		op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+		return op
+	}
+	for {
+		msg, err := ber.ReadPacket(conn)
+		if err != nil || len(msg.Children) < 2 {
+			return
+		}
+		id, _ := msg.Children[0].Value.(int64)
+		op := msg.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationExtendedRequest:
+			if tcfg == nil {
+				reply(id, result(ldap.ApplicationExtendedResponse, ldap.LDAPResultProtocolError))
+				continue
+			}
+			reply(id, result(ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess))
+			conn = tls.Server(conn, tcfg)
+		case ldap.ApplicationBindRequest:
+			code := ldap.LDAPResultInvalidCredentials
+			if op.Children[1].Value == ldapTestUserDN && op.Children[2].Data.String() == ldapTestPass {
+				code = ldap.LDAPResultSuccess
+			}
+			reply(id, result(ldap.ApplicationBindResponse, code))
+		case ldap.ApplicationSearchRequest:
+			filter, _ := ldap.DecompileFilter(op.Children[6])
+			if !strings.Contains(filter, "=alice)") {
+				reply(id, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+				continue
+			}
+			vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+			for _, group := range []string{"cn=admins,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"} {
+				vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, group, ""))
+			}
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "memberOf", ""))
+			attr.AppendChild(vals)
+			attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+			attrs.AppendChild(attr)
+			entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "")
+			entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, ldapTestUserDN, ""))
+			entry.AppendChild(attrs)
+			reply(id, entry, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+		default: // unbind
+			return
+		}
+	}
+}
+
+func TestLDAPUser(t *testing.T) {
+	Conf.Timeout.Default = 0
+	conf := &authn.LDAPConf{
+		URL:        ldapTestServer(t, ldapTestTLS(t)),
+		SkipVerify: true,
+		BaseDN:     "dc=example,dc=com",
+		GroupRoles: map[string]string{"admins": "ClusterOwner", "cn=staff,ou=groups,dc=example,dc=com": "Guest"},
+	}
+
+	groups, err := ldapUser(conf, "alice", "", false /*authenticate*/)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(groups) == 2, "expected 2 groups, got %v", groups)
+	roles := ldapGroupRoles(conf, groups)
+	tassert.Fatalf(t, len(roles) == 2 && roles[0] == "ClusterOwner" && roles[1] == "Guest", "unexpected roles %v", roles)
+
+	_, err = ldapUser(conf, "alice", ldapTestPass, true)
+	tassert.CheckFatal(t, err)
+
+	_, err = ldapUser(conf, "alice", "wrong", true)
+	tassert.Fatalf(t, err == errInvalidCredentials, "expected invalid credentials, got %v", err)
+	_, err = ldapUser(conf, "alice", "", true)
+	tassert.Fatalf(t, err == errInvalidCredentials, "empty password must be rejected, got %v", err)
+
+	_, err = ldapUser(conf, "bob", "", false)
+	tassert.Fatalf(t, err == errLDAPNotFound, "expected not found, got %v", err)
+}
+
+// plain ldap:// requires StartTLS unless explicitly allowed
+func TestLDAPPlaintext(t *testing.T) {
+	Conf.Timeout.Default = 0
+	conf := &authn.LDAPConf{URL: ldapTestServer(t, nil), BaseDN: "dc=example,dc=com"}
+	_, err := ldapUser(conf, "alice", ldapTestPass, true)
+	tassert.Fatalf(t, err != nil, "expected StartTLS failure")
+
+	conf.AllowPlaintext = true
+	_, err = ldapUser(conf, "alice", ldapTestPass, true)
+	tassert.CheckFatal(t, err)
+
+	// certificate verification
+	conf = &authn.LDAPConf{URL: ldapTestServer(t, ldapTestTLS(t)), BaseDN: "dc=example,dc=com"}
+	_, err = ldapUser(conf, "alice", "", false)
+	tassert.Fatalf(t, err != nil, "expected self-signed certificate to fail verification")
+}
+
+func TestLDAPSyncStop(t *testing.T) {
+	m := &mgr{stopCh: cos.NewStopCh()}
+	done := make(chan struct{})
+	go func() {
+		m.ldapSyncLoop(time.Hour)
+		close(done)
+	}()
+	m.stop()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("LDAP sync loop did not stop")
+	}
+}
+
+// LDAP-derived roles get merged with (and never override) manually assigned ones
+func TestLDAPUpdateRoles(t *testing.T) {
+	mgr, err := newMgr(mock.NewDBDriver())
+	tassert.CheckFatal(t, err)
+	defer mgr.stop()
+	for _, name := range []string{"admins-role", "staff-role", "manual"} {
+		tassert.CheckFatal(t, mgr.addRole(&authn.Role{Name: name}))
+	}
+	Conf.LDAP.GroupRoles = map[string]string{"admins": "admins-role", "staff": "staff-role"}
+	defer func() { Conf.LDAP.GroupRoles = nil }()
+
+	names := func(u *authn.User) (s []string) {
+		for _, r := range u.Roles {
+			s = append(s, r.Name)
+		}
+		return s
+	}
+	manual, err := mgr.lookupRole("manual")
+	tassert.CheckFatal(t, err)
+	staff, err := mgr.lookupRole("staff-role")
+	tassert.CheckFatal(t, err)
+	// note: "staff-role" is both LDAP-mapped and assigned manually
+	uInfo := &authn.User{ID: "alice", Source: authn.UserSourceLDAP, Roles: []*authn.Role{manual, staff}}
+
+	changed := mgr.ldapUpdateRoles(uInfo, []string{"cn=admins,dc=example", "cn=staff,dc=example"})
+	tassert.Errorf(t, changed, "expected changed")
+	tassert.Errorf(t, slices.Equal(names(uInfo), []string{"manual", "staff-role", "admins-role"}), "got %v", names(uInfo))
+	tassert.Errorf(t, slices.Equal(uInfo.LDAPRoles, []string{"admins-role"}), "got %v", uInfo.LDAPRoles)
+
+	changed = mgr.ldapUpdateRoles(uInfo, []string{"cn=admins,dc=example", "cn=staff,dc=example"})
+	tassert.Errorf(t, !changed, "expected no changes")
+
+	// removed from all groups: only the LDAP-derived role gets revoked
+	changed = mgr.ldapUpdateRoles(uInfo, nil)
+	tassert.Errorf(t, changed, "expected changed")
+	tassert.Errorf(t, slices.Equal(names(uInfo), []string{"manual", "staff-role"}), "got %v", names(uInfo))
+	tassert.Errorf(t, len(uInfo.LDAPRoles) == 0, "got %v", uInfo.LDAPRoles)
+}
+
+func TestGroupCN(t *testing.T) {
+	tests := map[string]string{
+		"CN=admins,OU=groups,DC=example,DC=com": "admins",
+		"cn=Domain\\, Admins,dc=example":        "Domain, Admins",
+		"ou=groups,dc=example":                  "",
+		"admins":                                "",
+	}
+	for dn, cn := range tests {
+		tassert.Errorf(t, groupCN(dn) == cn, "%q: expected %q, got %q", dn, cn, groupCN(dn))
+	}
+}
@@ -99,6 +99,7 @@ func main() {
 	err = srv.Run()
 
 	nlog.Flush(nlog.ActExit)
+	mgr.stop()
 	cos.Close(mgr.db)
 	if err != nil {
 		cos.ExitLogf("Server failed: %v", err)
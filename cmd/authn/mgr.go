@@ -5,11 +5,18 @@
 package main
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -29,6 +36,8 @@ type mgr struct {
 	clientH   *http.Client
 	clientTLS *http.Client
 	db        kvdb.Driver
+	stopCh    *cos.StopCh
+	sessMu    sync.Mutex // serializes sessions read-modify-write
 }
 
 var (
@@ -48,23 +57,45 @@ var (
 // If user DB exists, loads the data from the file and decrypts passwords
 func newMgr(driver kvdb.Driver) (m *mgr, err error) {
 	m = &mgr{
-		db: driver,
+		db:     driver,
+		stopCh: cos.NewStopCh(),
 	}
 	m.clientH, m.clientTLS = cmn.NewDefaultClients(time.Duration(Conf.Timeout.Default))
 	err = initializeDB(driver)
+	if err == nil && Conf.LDAP.Enabled() && Conf.LDAP.SyncInterval > 0 {
+		go m.ldapSyncLoop(time.Duration(Conf.LDAP.SyncInterval))
+	}
 	return
 }
 
 func (*mgr) String() string { return svcName }
 
+// stop background routines (LDAP sync) - must be called prior to closing the DB
+func (m *mgr) stop() { m.stopCh.Close() }
+
 //
 // users ============================================================
 //
 
 // Registers a new user. It is info from a user, so the password
 // is not encrypted and a few fields are not filled(e.g, Access).
+// LDAP users have no password: they must exist in the directory, and their
+// roles include the ones mapped from directory groups (see LDAPConf.GroupRoles).
 func (m *mgr) addUser(info *authn.User) error {
-	if info.ID == "" || info.Password == "" {
+	if info.ID == "" {
+		return errInvalidCredentials
+	}
+	switch {
+	case info.IsLDAP():
+		if !Conf.LDAP.Enabled() {
+			return fmt.Errorf("cannot add LDAP user %q: LDAP is not configured", info.ID)
+		}
+		if info.Password != "" {
+			return fmt.Errorf("LDAP user %q: password is managed by the directory", info.ID)
+		}
+	case info.Source != "":
+		return fmt.Errorf("user %q: invalid source %q", info.ID, info.Source)
+	case info.Password == "":
 		return errInvalidCredentials
 	}
 
@@ -72,7 +103,16 @@ func (m *mgr) addUser(info *authn.User) error {
 	if err == nil {
 		return fmt.Errorf("user %q already registered", info.ID)
 	}
-	info.Password = encryptPassword(info.Password)
+	info.LDAPRoles = nil // (not settable by the client)
+	if info.IsLDAP() {
+		groups, err := ldapUser(&Conf.LDAP, info.ID, "", false /*authenticate*/)
+		if err != nil {
+			return fmt.Errorf("LDAP user %q: %w", info.ID, err)
+		}
+		m.ldapUpdateRoles(info, groups)
+	} else {
+		info.Password = encryptPassword(info.Password)
+	}
 	return m.db.Set(usersCollection, info.ID, info)
 }
 
@@ -81,7 +121,13 @@ func (m *mgr) delUser(userID string) error {
 	if userID == adminUserID {
 		return fmt.Errorf("cannot remove built-in %q account", adminUserID)
 	}
-	return m.db.Delete(usersCollection, userID)
+	if err := m.db.Delete(usersCollection, userID); err != nil {
+		return err
+	}
+	m.sessMu.Lock()
+	m.db.Delete(sessionsCollection, userID)
+	m.sessMu.Unlock()
+	return nil
 }
 
 // Updates an existing user. The function invalidates user tokens after
@@ -97,10 +143,15 @@ func (m *mgr) updateUser(userID string, updateReq *authn.User) error {
 	}
 
 	if updateReq.Password != "" {
+		if uInfo.IsLDAP() {
+			return fmt.Errorf("LDAP user %q: password is managed by the directory", userID)
+		}
 		uInfo.Password = encryptPassword(updateReq.Password)
 	}
 	if len(updateReq.Roles) != 0 {
 		uInfo.Roles = updateReq.Roles
+		// explicitly (re)assigned roles are manual, LDAP-derived ones get re-derived upon the next sync
+		uInfo.LDAPRoles = nil
 	}
 	return m.db.Set(usersCollection, userID, uInfo)
 }
@@ -146,6 +197,9 @@ func (m *mgr) addRole(info *authn.Role) error {
 	if err == nil {
 		return fmt.Errorf("role %q already exists", info.Name)
 	}
+	if err := m.validateParents(info); err != nil {
+		return err
+	}
 	return m.db.Set(rolesCollection, info.Name, info)
 }
 
@@ -154,6 +208,15 @@ func (m *mgr) delRole(role string) error {
 	if role == authn.AdminRole {
 		return fmt.Errorf("cannot remove built-in %q role", authn.AdminRole)
 	}
+	roles, err := m.roleList()
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if slices.Contains(r.Parents, role) {
+			return fmt.Errorf("cannot remove role %q: role %q extends it", role, r.Name)
+		}
+	}
 	return m.db.Delete(rolesCollection, role)
 }
 
@@ -173,10 +236,80 @@ func (m *mgr) updateRole(role string, updateReq *authn.Role) error {
 	}
 	rInfo.ClusterACLs = mergeClusterACLs(rInfo.ClusterACLs, updateReq.ClusterACLs, "")
 	rInfo.BucketACLs = mergeBckACLs(rInfo.BucketACLs, updateReq.BucketACLs, "")
+	switch {
+	case updateReq.MaxJobs > 0:
+		rInfo.MaxJobs = updateReq.MaxJobs
+	case updateReq.MaxJobs < 0:
+		rInfo.MaxJobs = 0 // unlimited
+	}
+	switch {
+	case updateReq.MaxSessions > 0:
+		rInfo.MaxSessions = updateReq.MaxSessions
+	case updateReq.MaxSessions < 0:
+		rInfo.MaxSessions = 0 // unlimited
+	}
+	if updateReq.Parents != nil {
+		rInfo.Parents = updateReq.Parents
+		if err := m.validateParents(rInfo); err != nil {
+			return err
+		}
+	}
 
 	return m.db.Set(rolesCollection, role, rInfo)
 }
 
+// parent roles must exist, may not be built-in Admin, and may not form a cycle
+func (m *mgr) validateParents(role *authn.Role) error {
+	for _, name := range role.Parents {
+		if name == authn.AdminRole {
+			return fmt.Errorf("role %q cannot extend built-in %q role", role.Name, authn.AdminRole)
+		}
+	}
+	_, err := m.effectiveRole(role)
+	return err
+}
+
+// Returns a new role that contains the role's own permissions combined with those
+// inherited from all its ancestors. Shared ancestors ("diamonds") are fine, cycles are not.
+func (m *mgr) effectiveRole(role *authn.Role) (*authn.Role, error) {
+	eff := &authn.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Parents:     role.Parents,
+		MaxJobs:     role.MaxJobs,
+		MaxSessions: role.MaxSessions,
+		IsAdmin:     role.IsAdmin,
+	}
+	eff.ClusterACLs = unionClusterACLs(eff.ClusterACLs, role.ClusterACLs)
+	eff.BucketACLs = unionBckACLs(eff.BucketACLs, role.BucketACLs)
+	err := m._inherit(eff, role.Parents, []string{role.Name}, make(cos.StrSet, 4))
+	return eff, err
+}
+
+func (m *mgr) _inherit(eff *authn.Role, parents, path []string, seen cos.StrSet) error {
+	for _, name := range parents {
+		if slices.Contains(path, name) {
+			return fmt.Errorf("role inheritance cycle: %s", strings.Join(append(path, name), " => "))
+		}
+		if seen.Contains(name) {
+			continue
+		}
+		seen.Set(name)
+		parent, err := m.lookupRole(name)
+		if err != nil {
+			return fmt.Errorf("role %q: parent role %q not found", path[len(path)-1], name)
+		}
+		eff.ClusterACLs = unionClusterACLs(eff.ClusterACLs, parent.ClusterACLs)
+		eff.BucketACLs = unionBckACLs(eff.BucketACLs, parent.BucketACLs)
+		eff.MaxJobs = max(eff.MaxJobs, parent.MaxJobs)
+		eff.MaxSessions = max(eff.MaxSessions, parent.MaxSessions)
+		if err := m._inherit(eff, parent.Parents, append(path, name), seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *mgr) lookupRole(roleID string) (*authn.Role, error) {
 	rInfo := &authn.Role{}
 	err := m.db.Get(rolesCollection, roleID, rInfo)
@@ -351,10 +484,12 @@ func (m *mgr) delCluster(cluID string) error {
 // If a new token was generated then it sends the proxy a new valid token list
 func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, err error) {
 	var (
-		uInfo   = &authn.User{}
-		cid     string
-		cluACLs []*authn.CluACL
-		bckACLs []*authn.BckACL
+		uInfo       = &authn.User{}
+		cid         string
+		cluACLs     []*authn.CluACL
+		bckACLs     []*authn.BckACL
+		maxJobs     int
+		maxSessions int
 	)
 	err = m.db.Get(usersCollection, uid, uInfo)
 	if err != nil {
@@ -364,22 +499,58 @@ func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, er
 
 	debug.Assert(uid == uInfo.ID, uid, " vs ", uInfo.ID)
 
-	if !isSamePassword(pwd, uInfo.Password) {
+	if uInfo.IsLDAP() {
+		if !Conf.LDAP.Enabled() {
+			nlog.Errorf("LDAP user %q: LDAP is not configured", uid)
+			return "", errInvalidCredentials
+		}
+		groups, err := ldapUser(&Conf.LDAP, uid, pwd, true /*authenticate*/)
+		if err != nil {
+			if err != errInvalidCredentials {
+				nlog.Errorf("LDAP user %q: %v", uid, err)
+			}
+			return "", errInvalidCredentials
+		}
+		if m.ldapUpdateRoles(uInfo, groups) {
+			if err := m.db.Set(usersCollection, uid, uInfo); err != nil {
+				nlog.Errorln(err)
+			}
+		}
+	} else if !isSamePassword(pwd, uInfo.Password) {
 		return "", errInvalidCredentials
 	}
 
-	// update ACLs with roles' ones
+	// update ACLs with roles' ones (including inherited)
 	for _, role := range uInfo.Roles {
+		if len(role.Parents) > 0 {
+			eff, err := m.effectiveRole(role)
+			if err != nil {
+				nlog.Errorln(err) // (the role's own permissions still apply)
+			}
+			role = eff
+		}
 		cluACLs = mergeClusterACLs(cluACLs, role.ClusterACLs, cid)
 		bckACLs = mergeBckACLs(bckACLs, role.BucketACLs, cid)
+		maxJobs = max(maxJobs, role.MaxJobs)
+		maxSessions = max(maxSessions, role.MaxSessions)
 	}
 
 	// generate token
-	token, err = m._token(msg, uInfo, cluACLs, bckACLs)
-	return token, err
+	var expires time.Time
+	if token, expires, err = m._token(msg, uInfo, cluACLs, bckACLs, maxJobs); err != nil {
+		return "", err
+	}
+	if uInfo.IsAdmin() {
+		maxSessions = 0 // admins are never limited
+	}
+	if err := m.addSession(uid, token, expires, maxSessions); err != nil {
+		return "", fmt.Errorf("user %q: failed to add login session: %w", uid, err)
+	}
+	return token, nil
 }
 
-func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) (token string, err error) {
+func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.CluACL, bckACLs []*authn.BckACL,
+	maxJobs int) (token string, expires time.Time, err error) {
 	expDelta := Conf.Expire()
 	if msg.ExpiresIn != nil {
 		expDelta = *msg.ExpiresIn
@@ -391,15 +562,15 @@ func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.Cl
 	// put all useful info into token: who owns the token, when it was issued,
 	// when it expires and credentials to log in AWS, GCP etc.
 	// If a user is a super user, it is enough to pass only isAdmin marker
-	expires := time.Now().Add(expDelta)
+	expires = time.Now().Add(expDelta)
 	uid := uInfo.ID
 	if uInfo.IsAdmin() {
 		token, err = tok.AdminJWT(expires, uid, Conf.Secret())
 	} else {
 		m.fixClusterIDs(cluACLs)
-		token, err = tok.JWT(expires, uid, bckACLs, cluACLs, Conf.Secret())
+		token, err = tok.JWT(expires, uid, bckACLs, cluACLs, maxJobs, Conf.Secret())
 	}
-	return token, err
+	return token, expires, err
 }
 
 // Before putting a list of cluster permissions to a token, cluster aliases
@@ -435,6 +606,147 @@ func (m *mgr) revokeToken(token string) error {
 	return nil
 }
 
+//
+// sessions ============================================================
+//
+
+// persisted login session: the token itself is stored sealed (AES-GCM with a key derived
+// from the secret) - needed only to revoke sessions in excess of the limit (see addSession)
+type session struct {
+	authn.Session
+	Sealed []byte `json:"sealed"`
+}
+
+// Returns the user's active sessions (oldest first); expired and revoked ones are removed
+// from the database.
+func (m *mgr) userSessions(uid string) ([]*authn.Session, error) {
+	m.sessMu.Lock()
+	sessions, err := m._sessions(uid)
+	m.sessMu.Unlock()
+	if err != nil || len(sessions) == 0 {
+		return nil, err
+	}
+	out := make([]*authn.Session, len(sessions))
+	for i, s := range sessions {
+		out[i] = &s.Session
+	}
+	return out, nil
+}
+
+// (under sessMu)
+func (m *mgr) _sessions(uid string) ([]*session, error) {
+	var sessions []*session
+	if err := m.db.Get(sessionsCollection, uid, &sessions); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var (
+		now    = time.Now()
+		active = sessions[:0]
+	)
+	for _, s := range sessions {
+		if s.Expires.Before(now) {
+			continue
+		}
+		token, err := unsealToken(s.Sealed)
+		if err != nil {
+			continue // e.g., the secret has changed (and the token is no longer valid)
+		}
+		if _, err := m.db.GetString(revokedCollection, token); err == nil {
+			continue
+		}
+		active = append(active, s)
+	}
+	if len(active) < len(sessions) {
+		if err := m.db.Set(sessionsCollection, uid, active); err != nil {
+			return nil, err
+		}
+	}
+	return active, nil
+}
+
+// Adds a new session; given positive `limit`, forcibly logs out (revokes) the oldest
+// sessions in excess.
+func (m *mgr) addSession(uid, token string, expires time.Time, limit int) error {
+	m.sessMu.Lock()
+	defer m.sessMu.Unlock()
+
+	sessions, err := m._sessions(uid)
+	if err != nil {
+		return err
+	}
+	// same token (e.g., same claims issued within the same second) is the same session
+	id := sessionID(token)
+	if !slices.ContainsFunc(sessions, func(s *session) bool { return s.ID == id }) {
+		sealed, err := sealToken(token)
+		if err != nil {
+			return err
+		}
+		sessions = append(sessions, &session{
+			Session: authn.Session{ID: id, Issued: time.Now(), Expires: expires},
+			Sealed:  sealed,
+		})
+	}
+	if limit > 0 && len(sessions) > limit {
+		evict := len(sessions) - limit
+		for _, s := range sessions[:evict] {
+			nlog.Infof("user %q: max sessions (%d) exceeded - logging out session %s (issued %s)",
+				uid, limit, s.ID, s.Issued.Format(time.RFC3339))
+			old, err := unsealToken(s.Sealed)
+			if err != nil {
+				return err // (cannot happen - see _sessions)
+			}
+			if err := m.revokeToken(old); err != nil {
+				return err
+			}
+		}
+		sessions = sessions[evict:]
+	}
+	return m.db.Set(sessionsCollection, uid, sessions)
+}
+
+// short (non-secret) token fingerprint to identify sessions
+func sessionID(token string) string {
+	sum := sha256.Sum256(cos.UnsafeB(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+func sessionAEAD() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte("ais-authn-sessions:" + Conf.Secret()))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func sealToken(token string) ([]byte, error) {
+	aead, err := sessionAEAD()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(token)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, []byte(token), nil), nil
+}
+
+func unsealToken(sealed []byte) (string, error) {
+	aead, err := sessionAEAD()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("invalid sealed session token")
+	}
+	nonce, ct := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	b, err := aead.Open(nil, nonce, ct, nil)
+	return string(b), err
+}
+
 // Create a list of non-expired and valid revoked tokens.
 // Obsolete and invalid tokens are removed from the database.
 func (m *mgr) generateRevokedTokenList() ([]string, error) {
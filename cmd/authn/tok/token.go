@@ -26,6 +26,7 @@ type Token struct {
 	ClusterACLs []*authn.CluACL `json:"clusters"`
 	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
 	IsAdmin     bool            `json:"admin"`
+	MaxJobs     int             `json:"max_jobs,omitempty"` // max number of running jobs (0: unlimited)
 }
 
 var (
@@ -49,13 +50,17 @@ func AdminJWT(expires time.Time, userID, secret string) (string, error) {
 }
 
 func JWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
-	secret string) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	maxJobs int, secret string) (string, error) {
+	claims := jwt.MapClaims{
 		"expires":  expires,
 		"username": userID,
 		"buckets":  bucketACLs,
 		"clusters": clusterACLs,
-	})
+	}
+	if maxJobs > 0 {
+		claims["max_jobs"] = maxJobs
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return t.SignedString([]byte(secret))
 }
 
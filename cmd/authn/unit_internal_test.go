@@ -9,6 +9,8 @@ package main
 // NOTE go:build debug (above) =====================================
 
 import (
+	"bytes"
+	"sync"
 	"testing"
 	"time"
 
@@ -204,6 +206,100 @@ func TestToken(t *testing.T) {
 	}
 }
 
+func TestRoleInheritance(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	var (
+		bck    = cmn.Bck{Name: "bck", Provider: apc.AIS, Ns: cmn.Ns{UUID: "clu"}}
+		reader = &authn.Role{
+			Name:        "reader",
+			ClusterACLs: []*authn.CluACL{{ID: "clu", Access: apc.AccessRO}},
+		}
+		writer = &authn.Role{
+			Name:       "writer",
+			BucketACLs: []*authn.BckACL{{Bck: bck, Access: apc.AcePUT}},
+			Parents:    []string{"reader"},
+		}
+		auditor = &authn.Role{
+			Name:        "auditor",
+			ClusterACLs: []*authn.CluACL{{ID: "clu", Access: apc.AceShowCluster}},
+			Parents:     []string{"reader"},
+		}
+		// composite; "reader" is reachable via both parents
+		lead = &authn.Role{
+			Name:    "lead",
+			Parents: []string{"writer", "auditor"},
+		}
+	)
+	for _, role := range []*authn.Role{reader, writer, auditor, lead} {
+		tassert.CheckFatal(t, mgr.addRole(role))
+	}
+
+	eff, err := mgr.effectiveRole(lead)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(eff.ClusterACLs) == 1, "expected one cluster ACL, got %d", len(eff.ClusterACLs))
+	tassert.Errorf(t, eff.ClusterACLs[0].Access == apc.AccessRO|apc.AceShowCluster,
+		"expected union of permissions, got %s", eff.ClusterACLs[0].Access.Describe(true))
+	tassert.Errorf(t, len(eff.BucketACLs) == 1 && eff.BucketACLs[0].Access == apc.AcePUT,
+		"expected inherited bucket ACL, got %+v", eff.BucketACLs)
+
+	// stored roles must remain unchanged
+	stored, err := mgr.lookupRole("reader")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, stored.ClusterACLs[0].Access == apc.AccessRO, "parent role modified")
+
+	// cycles
+	err = mgr.updateRole("reader", &authn.Role{Parents: []string{"lead"}})
+	tassert.Errorf(t, err != nil, "expected cycle detection error")
+	err = mgr.addRole(&authn.Role{Name: "self", Parents: []string{"self"}})
+	tassert.Errorf(t, err != nil, "expected error extending self")
+
+	// missing parent; built-in Admin
+	err = mgr.addRole(&authn.Role{Name: "orphan", Parents: []string{"nonexistent"}})
+	tassert.Errorf(t, err != nil, "expected error extending nonexistent role")
+	err = mgr.addRole(&authn.Role{Name: "wannabe", Parents: []string{authn.AdminRole}})
+	tassert.Errorf(t, err != nil, "expected error extending %q", authn.AdminRole)
+
+	// parent in use
+	err = mgr.delRole("reader")
+	tassert.Errorf(t, err != nil, "expected error removing parent role")
+	tassert.CheckError(t, mgr.delRole("lead"))
+}
+
+func TestRoleMaxJobs(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	var (
+		base    = &authn.Role{Name: "base", MaxJobs: 2, ClusterACLs: []*authn.CluACL{{ID: "clu", Access: apc.AccessRO}}}
+		derived = &authn.Role{Name: "derived", MaxJobs: 1, Parents: []string{"base"}}
+	)
+	tassert.CheckFatal(t, mgr.addRole(base))
+	tassert.CheckFatal(t, mgr.addRole(derived))
+
+	// the largest limit wins
+	eff, err := mgr.effectiveRole(derived)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, eff.MaxJobs == 2, "expected inherited max-jobs 2, got %d", eff.MaxJobs)
+
+	user := &authn.User{ID: "limited", Password: "pass", Roles: []*authn.Role{derived}}
+	tassert.CheckFatal(t, mgr.addUser(user))
+	token, err := mgr.issueToken(user.ID, "pass", &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(token, Conf.Secret())
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.MaxJobs == 2, "expected token max-jobs 2, got %d", tk.MaxJobs)
+
+	// remove the limit
+	tassert.CheckFatal(t, mgr.updateRole("base", &authn.Role{MaxJobs: -1}))
+	stored, err := mgr.lookupRole("base")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, stored.MaxJobs == 0, "expected unlimited, got %d", stored.MaxJobs)
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
@@ -637,3 +733,108 @@ func TestMergeBckACLS(t *testing.T) {
 		}
 	}
 }
+
+func TestRoleMaxSessions(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	var (
+		base    = &authn.Role{Name: "base", MaxSessions: 2, ClusterACLs: []*authn.CluACL{{ID: "clu", Access: apc.AccessRO}}}
+		derived = &authn.Role{Name: "derived", Parents: []string{"base"}}
+		user    = &authn.User{ID: "limited", Password: "pass", Roles: []*authn.Role{derived}}
+		tokens  = make([]string, 0, 3)
+	)
+	tassert.CheckFatal(t, mgr.addRole(base))
+	tassert.CheckFatal(t, mgr.addRole(derived))
+	tassert.CheckFatal(t, mgr.addUser(user))
+
+	// distinct expiration => distinct tokens
+	for i := range 3 {
+		expires := time.Duration(i+1) * time.Hour
+		token, err := mgr.issueToken(user.ID, "pass", &authn.LoginMsg{ExpiresIn: &expires})
+		tassert.CheckFatal(t, err)
+		tokens = append(tokens, token)
+	}
+
+	// inherited limit: the oldest session is logged out
+	sessions, err := mgr.userSessions(user.ID)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(sessions) == 2, "expected 2 sessions, got %d", len(sessions))
+	tassert.Errorf(t, sessions[0].ID == sessionID(tokens[1]) && sessions[1].ID == sessionID(tokens[2]),
+		"expected the 2 most recent sessions")
+	_, err = driver.GetString(revokedCollection, tokens[0])
+	tassert.Errorf(t, err == nil, "expected the oldest token to be revoked")
+
+	// logout
+	tassert.CheckFatal(t, mgr.revokeToken(tokens[2]))
+	sessions, err = mgr.userSessions(user.ID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(sessions) == 1 && sessions[0].ID == sessionID(tokens[1]), "expected 1 remaining session")
+
+	// remove the limit
+	tassert.CheckFatal(t, mgr.updateRole("base", &authn.Role{MaxSessions: -1}))
+	for i := range 3 {
+		expires := time.Duration(i+10) * time.Hour
+		_, err := mgr.issueToken(user.ID, "pass", &authn.LoginMsg{ExpiresIn: &expires})
+		tassert.CheckFatal(t, err)
+	}
+	sessions, err = mgr.userSessions(user.ID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(sessions) == 4, "expected 4 sessions, got %d", len(sessions))
+
+	tassert.CheckFatal(t, mgr.delUser(user.ID))
+	sessions, err = mgr.userSessions(user.ID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(sessions) == 0, "expected no sessions after removing user, got %d", len(sessions))
+}
+
+func TestSessionsConcurrent(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	const limit, num = 3, 16
+	var (
+		role = &authn.Role{Name: "limited", MaxSessions: limit, ClusterACLs: []*authn.CluACL{{ID: "clu", Access: apc.AccessRO}}}
+		user = &authn.User{ID: "concurrent", Password: "pass", Roles: []*authn.Role{role}}
+		wg   sync.WaitGroup
+	)
+	tassert.CheckFatal(t, mgr.addRole(role))
+	tassert.CheckFatal(t, mgr.addUser(user))
+
+	tokens := make([]string, num)
+	for i := range num {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			expires := time.Duration(i+1) * time.Hour // distinct tokens
+			token, err := mgr.issueToken(user.ID, "pass", &authn.LoginMsg{ExpiresIn: &expires})
+			tassert.CheckError(t, err)
+			tokens[i] = token
+		}(i)
+	}
+	wg.Wait()
+
+	sessions, err := mgr.userSessions(user.ID)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(sessions) == limit, "expected %d sessions, got %d", limit, len(sessions))
+
+	// all other tokens are revoked
+	var revoked int
+	for _, token := range tokens {
+		if _, err := driver.GetString(revokedCollection, token); err == nil {
+			revoked++
+		}
+	}
+	tassert.Errorf(t, revoked == num-limit, "expected %d revoked tokens, got %d", num-limit, revoked)
+
+	// raw tokens are not stored
+	var stored []*session
+	tassert.CheckFatal(t, driver.Get(sessionsCollection, user.ID, &stored))
+	for _, s := range stored {
+		for _, token := range tokens {
+			tassert.Errorf(t, !bytes.Contains(s.Sealed, []byte(token)), "session %s: raw token stored", s.ID)
+		}
+	}
+}
@@ -61,3 +61,37 @@ func mergeClusterACLs(toACLs, fromACLs cluACLList, cluIDFlt string) []*authn.Clu
 	}
 	return toACLs
 }
+
+// unionBckACLs combines bucket ACLs: permissions of the same bucket are ORed
+// (unlike mergeBckACLs, does not modify the source ACLs)
+func unionBckACLs(toACLs, fromACLs []*authn.BckACL) []*authn.BckACL {
+outer:
+	for _, n := range fromACLs {
+		for _, acl := range toACLs {
+			if acl.Bck.Equal(&n.Bck) {
+				acl.Access |= n.Access
+				continue outer
+			}
+		}
+		acl := *n
+		toACLs = append(toACLs, &acl)
+	}
+	return toACLs
+}
+
+// unionClusterACLs combines cluster ACLs: permissions of the same cluster are ORed
+// (unlike mergeClusterACLs, does not modify the source ACLs)
+func unionClusterACLs(toACLs, fromACLs []*authn.CluACL) []*authn.CluACL {
+outer:
+	for _, n := range fromACLs {
+		for _, acl := range toACLs {
+			if acl.ID == n.ID {
+				acl.Access |= n.Access
+				continue outer
+			}
+		}
+		acl := *n
+		toACLs = append(toACLs, &acl)
+	}
+	return toACLs
+}
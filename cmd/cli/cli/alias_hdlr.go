@@ -77,15 +77,42 @@ func (a *acli) getAliasCmd() cli.Command {
 // NOTE: for default alias config, see cmd/cli/config/config.go and `DefaultAliasConfig`
 func (a *acli) initAliases() (aliasCmds []cli.Command) {
 	for alias, orig := range cfg.Aliases {
-		cmd := a.resolveCmd(orig)
-
-		if cmd != nil {
+		tmpl, err := parseAliasTmpl(orig, a.isCmd)
+		if err != nil {
+			continue // (see "ignored" in docs/cli/alias.md)
+		}
+		cmd := a.resolveCmd(tmpl.cmd)
+		switch {
+		case cmd == nil:
+		case len(tmpl.words) == 0:
 			aliasCmds = append(aliasCmds, makeAlias(*cmd, orig, false, alias))
+		default:
+			aliasCmds = append(aliasCmds, a.makeTmplAlias(cmd, tmpl, orig, alias))
 		}
 	}
 	return
 }
 
+func (a *acli) isCmd(command string) bool { return a.resolveCmd(command) != nil }
+
+// parameterized alias: expand and run the aliased command (flags are passed through as is)
+func (a *acli) makeTmplAlias(cmd *cli.Command, tmpl *aliasTmpl, aliasFor, name string) cli.Command {
+	return cli.Command{
+		Name:            name,
+		Usage:           fmt.Sprintf(aliasForPrefix+"%q) %s", aliasFor, cmd.Usage),
+		ArgsUsage:       tmpl.argsUsage(),
+		HideHelp:        true,
+		SkipFlagParsing: true,
+		Action: func(c *cli.Context) error {
+			args, err := tmpl.expand(c.Args())
+			if err != nil {
+				return incorrectUsageMsg(c, "%v", err)
+			}
+			return a.app.Run(append([]string{a.app.Name}, args...))
+		},
+	}
+}
+
 func validateAlias(alias string) (matched bool) {
 	matched, _ = regexp.MatchString(`^[a-zA-Z][a-zA-Z0-9_-]*$`, alias)
 	return
@@ -188,7 +215,15 @@ func rmAliasHandler(c *cli.Context) (err error) {
 }
 
 func (a *acli) setAliasHandler(c *cli.Context) (err error) {
-	alias := c.Args().Get(0)
+	var (
+		alias = c.Args().Get(0)
+		words = c.Args().Tail()
+	)
+	// ALIAS=COMMAND (single argument)
+	if name, value, ok := strings.Cut(alias, "="); ok {
+		alias = name
+		words = append([]string{value}, words...)
+	}
 	if alias == "" {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
@@ -196,19 +231,13 @@ func (a *acli) setAliasHandler(c *cli.Context) (err error) {
 		return errors.New(invalidAlias)
 	}
 
-	if c.NArg() < 2 {
+	if len(words) == 0 || strings.TrimSpace(words[0]) == "" {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
 	oldCmd, ok := cfg.Aliases[alias]
-	newCmd := ""
-	for _, arg := range c.Args().Tail() {
-		if newCmd != "" {
-			newCmd += " "
-		}
-		newCmd += arg
-	}
-	if cmd := a.resolveCmd(newCmd); cmd == nil {
-		return fmt.Errorf("%q is not AIS command", newCmd)
+	newCmd := strings.Join(strings.Fields(strings.Join(words, " ")), " ")
+	if _, err := parseAliasTmpl(newCmd, a.isCmd); err != nil {
+		return err
 	}
 	cfg.Aliases[alias] = newCmd
 	if ok {
@@ -218,3 +247,150 @@ func (a *acli) setAliasHandler(c *cli.Context) (err error) {
 	}
 	return config.Save(cfg)
 }
+
+//
+// parameterized aliases
+//
+
+// Words that follow the aliased command are argument templates that may reference
+// positional parameters - the arguments given to the alias, e.g.:
+//
+//	ais alias set getlogs='log get $1 /tmp/logs/$1'
+//	ais alias set lsn='ls ${1:-ais://nnn} --summary'
+//
+// where:
+//   - $N (N = 1..9) is the N-th argument (required);
+//   - ${N:-default} - same, with a default value;
+//   - remaining arguments and flags (that must follow positional arguments) are appended as is.
+//
+// Templates are validated when defined: parameters must be referenced without gaps,
+// with at most one default value each, and optional parameters cannot precede required ones.
+
+const aliasMaxParams = 9
+
+var (
+	aliasParamRegex    = regexp.MustCompile(`\$(?:(\d)|\{(\d)(?::-([^}]*))?\})`)
+	aliasBadParamRegex = regexp.MustCompile(`\$(?:\d|\{)`)
+)
+
+type aliasTmpl struct {
+	cmd      string   // aliased command, e.g. "log get"
+	words    []string // argument templates
+	dflts    []string // default values by parameter (index 0 <=> $1)
+	optional []bool   // has default
+}
+
+// the longest sequence of leading words that resolves to a command is the aliased command
+func parseAliasTmpl(value string, isCmd func(string) bool) (*aliasTmpl, error) {
+	words := strings.Fields(value)
+	if len(words) == 0 {
+		return nil, errors.New("empty alias command")
+	}
+	tmpl := &aliasTmpl{}
+	for i := len(words); i > 0; i-- {
+		if cmd := strings.Join(words[:i], " "); isCmd(cmd) {
+			tmpl.cmd, tmpl.words = cmd, words[i:]
+			break
+		}
+	}
+	if tmpl.cmd == "" {
+		return nil, fmt.Errorf("%q is not AIS command", value)
+	}
+
+	// validate
+	var (
+		used  [aliasMaxParams + 1]bool
+		dflts [aliasMaxParams + 1]*string
+		n     int
+	)
+	for _, w := range tmpl.words {
+		for _, m := range aliasParamRegex.FindAllStringSubmatch(w, -1) {
+			num, hasDefault := m[1], false
+			if num == "" {
+				num, hasDefault = m[2], strings.Contains(m[0], ":-")
+			}
+			i := int(num[0] - '0')
+			if i == 0 {
+				return nil, fmt.Errorf("invalid parameter %q in %q (expecting $1 through $%d)", m[0], w, aliasMaxParams)
+			}
+			used[i] = true
+			n = max(n, i)
+			if !hasDefault {
+				continue
+			}
+			if dflts[i] != nil && *dflts[i] != m[3] {
+				return nil, fmt.Errorf("parameter $%d has conflicting default values (%q vs %q)", i, *dflts[i], m[3])
+			}
+			dflt := m[3]
+			dflts[i] = &dflt
+		}
+		if rest := aliasParamRegex.ReplaceAllString(w, ""); aliasBadParamRegex.MatchString(rest) {
+			return nil, fmt.Errorf("invalid parameter reference in %q", w)
+		}
+	}
+	tmpl.dflts = make([]string, n)
+	tmpl.optional = make([]bool, n)
+	for i := 1; i <= n; i++ {
+		if !used[i] {
+			return nil, fmt.Errorf("parameter $%d is not referenced (parameters must be numbered without gaps)", i)
+		}
+		if dflts[i] != nil {
+			tmpl.dflts[i-1], tmpl.optional[i-1] = *dflts[i], true
+		} else if i > 1 && tmpl.optional[i-2] {
+			return nil, fmt.Errorf("required parameter $%d cannot follow optional $%d", i, i-1)
+		}
+	}
+	return tmpl, nil
+}
+
+func (tmpl *aliasTmpl) argsUsage() string {
+	var sb strings.Builder
+	for i, opt := range tmpl.optional {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		if opt {
+			fmt.Fprintf(&sb, "[ARG%d]", i+1)
+		} else {
+			fmt.Fprintf(&sb, "ARG%d", i+1)
+		}
+	}
+	return sb.String()
+}
+
+// returns the aliased command with its arguments
+func (tmpl *aliasTmpl) expand(args []string) ([]string, error) {
+	// leading positional arguments
+	var npos int
+	for npos < len(args) && !strings.HasPrefix(args[npos], "-") {
+		npos++
+	}
+	params := make([]string, len(tmpl.dflts))
+	for i := range params {
+		switch {
+		case i < npos:
+			params[i] = args[i]
+		case tmpl.optional[i]:
+			params[i] = tmpl.dflts[i]
+		default:
+			return nil, fmt.Errorf("missing argument $%d (usage: %s)", i+1, tmpl.argsUsage())
+		}
+	}
+	out := strings.Fields(tmpl.cmd)
+	for _, w := range tmpl.words {
+		w = aliasParamRegex.ReplaceAllStringFunc(w, func(m string) string {
+			sm := aliasParamRegex.FindStringSubmatch(m)
+			num := sm[1]
+			if num == "" {
+				num = sm[2]
+			}
+			return params[num[0]-'1']
+		})
+		if w != "" {
+			out = append(out, w)
+		}
+	}
+	// extra positional arguments and flags
+	out = append(out, args[min(len(params), npos):]...)
+	return out, nil
+}
@@ -179,6 +179,9 @@ func Run(version, buildtime string, args []string) error {
 	a.init(version, emptyCmdline)
 
 	teb.Init(os.Stdout, cfg.NoColor)
+	if err := teb.SetDisplay(cfg.Display.Timezone, cfg.Display.TimeFormat, cfg.Display.ThousandsSep, cfg.Display.DecimalSep); err != nil {
+		return fmt.Errorf("CLI config: display: %v", err)
+	}
 
 	// run
 	if err := a.runOnce(args); err != nil {
@@ -310,6 +313,7 @@ func (a *acli) setupCommands(emptyCmdline bool) {
 		tlsCmd,
 		showCmdPeformance,
 		remClusterCmd,
+		healthCmd,
 		a.getAliasCmd(),
 	}
 
@@ -19,15 +19,16 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
 	"github.com/vbauerster/mpb/v4/decor"
-	"golang.org/x/sync/errgroup"
 )
 
 const archBucketUsage = "archive selected or matching objects from " + bucketObjectSrcArgument + " as\n" +
@@ -37,6 +38,12 @@ const archBucketUsage = "archive selected or matching objects from " + bucketObj
 	indent1 + "\t- 'archive bucket \"ais://src/shard-{001..997}\" ais://dst/a.tar.lz4'\t- same as above (notice double quotes)\n" +
 	indent1 + "\t- 'archive bucket \"ais://src/shard-{998..999}\" ais://dst/a.tar.lz4 --append-or-put'\t- append (ie., archive) 2 more objects"
 
+const archCreateUsage = "shard objects from a given source bucket (and prefix) into " + archExts + "-formatted\n" +
+	indent1 + "approximately equal-size shards with WebDataset-compatible naming (no dsort specification required), e.g.:\n" +
+	indent1 + "\t- 'archive create ais://src/train/ ais://dst/train- --shard-size 256MiB' - shard 'train/' into 256MiB (or smaller)\n" +
+	indent1 + "\t   tarballs named 'train-000000.tar', 'train-000001.tar', etc.;\n" +
+	indent1 + "\t- 'archive create gs://src ais://dst --shard-ext .tar.lz4 --dry-run' - show resulting shards (default name: 'shard-')"
+
 const archPutUsage = "archive a file, a directory, or multiple files and/or directories as\n" +
 	indent1 + "\t" + archExts + "-formatted object - aka \"shard\".\n" +
 	indent1 + "\tBoth APPEND (to an existing shard) and PUT (a new version of the shard) are supported.\n" +
@@ -66,7 +73,8 @@ const archGetUsage = "get a shard and extract its content; get an archived file;
 	indent1 + "'ais archive get' multi-selection examples:\n" +
 	indent4 + "\t- ais://abc/trunk-0123.tar 111.tar --archregx=jpeg --archmode=suffix - return 111.tar with all *.jpeg files from a given shard\n" +
 	indent4 + "\t- ais://abc/trunk-0123.tar 222.tar --archregx=file45 --archmode=wdskey - return 222.tar with all file45.* files --/--\n" +
-	indent4 + "\t- ais://abc/trunk-0123.tar 333.tar --archregx=subdir/ --archmode=prefix - 333.tar with all subdir/* files --/--"
+	indent4 + "\t- ais://abc/trunk-0123.tar 333.tar --archregx=subdir/ --archmode=prefix - 333.tar with all subdir/* files --/--\n" +
+	indent4 + "\t- ais://abc/trunk-0123.tar /tmp/out/ --archregx=jpeg --archmode=suffix - extract all *.jpeg files into /tmp/out/ (directory)"
 
 const genShardsUsage = "generate random " + archExts + "-formatted objects (\"shards\"), e.g.:\n" +
 	indent4 + "\t- gen-shards 'ais://bucket1/shard-{001..999}.tar' - write 999 random shards (default sizes) to ais://bucket1\n" +
@@ -79,6 +87,10 @@ var (
 		commandBucket: {
 			archAppendOrPutFlag,
 			continueOnErrorFlag,
+			archMaxShardSizeFlag,
+			archMaxShardRecsFlag,
+			jobMaxObjectsFlag,
+			jobMaxBytesFlag,
 			dontHeadSrcDstBucketsFlag,
 			dryRunFlag,
 			listFlag,
@@ -102,6 +114,14 @@ var (
 			archSrcDirNameFlag,
 			skipVerCksumFlag,
 		),
+		commandCreate: {
+			shardSizeFlag,
+			shardExtFlag,
+			continueOnErrorFlag,
+			dontHeadSrcDstBucketsFlag,
+			dryRunFlag,
+			waitFlag,
+		},
 		cmdGenShards: {
 			cleanupFlag,
 			numGenShardWorkersFlag,
@@ -121,6 +141,16 @@ var (
 		BashComplete: putPromApndCompletions,
 	}
 
+	// archive create
+	archCreateCmd = cli.Command{
+		Name:         commandCreate,
+		Usage:        archCreateUsage,
+		ArgsUsage:    mkShardsArgument,
+		Flags:        archCmdsFlags[commandCreate],
+		Action:       mkShardsHandler,
+		BashComplete: bucketCompletions(bcmplop{multiple: true}),
+	}
+
 	// archive put
 	archPutCmd = cli.Command{
 		Name:         commandPut,
@@ -167,6 +197,7 @@ var (
 		Action: archUsageHandler,
 		Subcommands: []cli.Command{
 			archBucketCmd,
+			archCreateCmd,
 			archPutCmd,
 			archGetCmd,
 			archLsCmd,
@@ -234,6 +265,20 @@ func archMultiObjHandler(c *cli.Context) error {
 		msg.AppendIfExists = a.apndIfExist
 		msg.ListRange = a.rsrc.lr
 	}
+	if flagIsSet(c, archMaxShardSizeFlag) {
+		size, err := parseSizeFlag(c, archMaxShardSizeFlag)
+		if err != nil {
+			return err
+		}
+		msg.MaxShardSize = size
+	}
+	msg.MaxShardRecs = int64(parseIntFlag(c, archMaxShardRecsFlag))
+	if err := msg.ValidateSplit(); err != nil {
+		return err
+	}
+	if err := parseJobQuotaFlags(c, &msg.JobQuota); err != nil {
+		return err
+	}
 	// dry-run
 	if flagIsSet(c, dryRunFlag) {
 		dryRunCptn(c)
@@ -259,6 +304,7 @@ func archMultiObjHandler(c *cli.Context) error {
 	}
 	// check (NOTE: not waiting through idle-ness, not looking at multiple returned xids)
 	var (
+		oname = a.dst.oname
 		total time.Duration
 		sleep = time.Second / 2
 		maxw  = 2 * time.Second
@@ -266,9 +312,12 @@ func archMultiObjHandler(c *cli.Context) error {
 	if flagIsSet(c, waitFlag) {
 		maxw = 8 * time.Second
 	}
+	if msg.Split() {
+		oname = archive.ShardName(oname, 0)
+	}
 	for total < maxw {
 		hargs := api.HeadArgs{FltPresence: apc.FltPresentNoProps, Silent: true}
-		if _, errV := api.HeadObject(apiBP, a.dst.bck, a.dst.oname, hargs); errV == nil {
+		if _, errV := api.HeadObject(apiBP, a.dst.bck, oname, hargs); errV == nil {
 			goto ex
 		}
 		time.Sleep(sleep)
@@ -517,13 +566,11 @@ func genShardsHandler(c *cli.Context) error {
 	}
 
 	var (
-		shardNum      int
-		progress      = mpb.New(mpb.WithWidth(barWidth))
-		concLimit     = parseIntFlag(c, numGenShardWorkersFlag)
-		concSemaphore = make(chan struct{}, concLimit)
-		group, ctx    = errgroup.WithContext(context.Background())
-		text          = "Shards created: "
-		options       = make([]mpb.BarOption, 0, 6)
+		shardNum int
+		progress = mpb.New(mpb.WithWidth(barWidth))
+		wpool    = cos.NewWorkerPool(context.Background(), parseIntFlag(c, numGenShardWorkersFlag), true /*fail fast*/)
+		text     = "Shards created: "
+		options  = make([]mpb.BarOption, 0, 6)
 	)
 	// progress bar
 	options = append(options, mpb.PrependDecorators(
@@ -535,41 +582,33 @@ func genShardsHandler(c *cli.Context) error {
 
 	pt.InitIter()
 
-loop:
 	for shardName, hasNext := pt.Next(); hasNext; shardName, hasNext = pt.Next() {
-		select {
-		case concSemaphore <- struct{}{}:
-		case <-ctx.Done():
-			break loop
-		}
-		group.Go(func(i int, name string) func() error {
-			return func() error {
-				defer func() {
-					bar.Increment()
-					<-concSemaphore
-				}()
-
-				name := fmt.Sprintf("%s%s", name, ext)
-				sgl := mm.NewSGL(fileSize * int64(fileCnt))
-				defer sgl.Free()
-
-				if err := genOne(sgl, ext, i*fileCnt, (i+1)*fileCnt, fileCnt, int(fileSize), fileExts); err != nil {
-					return err
-				}
-				putArgs := api.PutArgs{
-					BaseParams: apiBP,
-					Bck:        bck,
-					ObjName:    name,
-					Reader:     sgl,
-					SkipVC:     true,
-				}
-				_, err := api.PutObject(&putArgs)
-				return V(err)
+		i, name := shardNum, shardName+ext
+		ok := wpool.Go(func(context.Context) error {
+			defer bar.Increment()
+
+			sgl := mm.NewSGL(fileSize * int64(fileCnt))
+			defer sgl.Free()
+
+			if err := genOne(sgl, ext, i*fileCnt, (i+1)*fileCnt, fileCnt, int(fileSize), fileExts); err != nil {
+				return err
+			}
+			putArgs := api.PutArgs{
+				BaseParams: apiBP,
+				Bck:        bck,
+				ObjName:    name,
+				Reader:     sgl,
+				SkipVC:     true,
 			}
-		}(shardNum, shardName))
+			_, err := api.PutObject(&putArgs)
+			return V(err)
+		})
+		if !ok {
+			break
+		}
 		shardNum++
 	}
-	if err := group.Wait(); err != nil {
+	if err := wpool.Wait(); err != nil {
 		bar.Abort(true)
 		return err
 	}
@@ -596,3 +635,171 @@ func genOne(w io.Writer, shardExt string, start, end, fileCnt, fileSize int, fil
 	writer.Fini()
 	return
 }
+
+//
+// archive create: shard a given source prefix into (approximately) fixed-size WebDataset shards
+//
+
+type (
+	// WebDataset record: all files that share the same key (see cos.WdsKey)
+	wdsRecord struct {
+		key   string
+		names []string
+		size  int64
+	}
+	wdsShard struct {
+		name  string
+		names []string
+		size  int64
+	}
+)
+
+func mkShardsHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() == 1 {
+		return missingArgumentsError(c, bucketDstArgument)
+	}
+	srcBck, prefix, err := parseBckObjURI(c, c.Args().Get(0), true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	dstBck, shardPrefix, err := parseBckObjURI(c, c.Args().Get(1), true)
+	if err != nil {
+		return err
+	}
+	shardSize, err := parseSizeFlag(c, shardSizeFlag)
+	if err != nil {
+		return err
+	}
+	if shardSize <= 0 {
+		return fmt.Errorf("invalid %s=%d (expecting positive size)", flprn(shardSizeFlag), shardSize)
+	}
+	ext := parseStrFlag(c, shardExtFlag)
+	if ext != "" && ext[0] != '.' {
+		ext = "." + ext
+	}
+	if _, err := archive.Strict("", "x"+ext); err != nil {
+		return fmt.Errorf("invalid %s=%q: %v", flprn(shardExtFlag), ext, err)
+	}
+	if !flagIsSet(c, dontHeadSrcDstBucketsFlag) {
+		if _, err := headBucket(srcBck, false /* don't add */); err != nil {
+			return err
+		}
+		if _, err := headBucket(dstBck, false /* don't add */); err != nil {
+			return err
+		}
+	}
+
+	// list source objects
+	msg := &apc.LsoMsg{Prefix: prefix, Props: apc.GetPropsNameSize}
+	msg.SetFlag(apc.LsNoDirs)
+	lst, err := api.ListObjects(apiBP, srcBck, msg, api.ListArgs{})
+	if err != nil {
+		return V(err)
+	}
+	if len(lst.Entries) == 0 {
+		return fmt.Errorf("no objects matching %s", srcBck.Cname(prefix))
+	}
+	shardPrefix = cos.Left(shardPrefix, "shard-")
+	shards := makeWdsShards(wdsRecords(lst.Entries), shardSize, shardPrefix, ext)
+
+	// dry-run
+	if flagIsSet(c, dryRunFlag) {
+		dryRunCptn(c)
+		for _, shard := range shards {
+			fmt.Fprintf(c.App.Writer, "%s: %d objects, %s\n", dstBck.Cname(shard.name), len(shard.names),
+				teb.FmtSize(shard.size, "", 2))
+		}
+		return nil
+	}
+
+	// do
+	xids := cos.NewStrSet()
+	for _, shard := range shards {
+		amsg := cmn.ArchiveBckMsg{ToBck: dstBck}
+		{
+			amsg.ArchName = shard.name
+			amsg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
+			amsg.ListRange.ObjNames = shard.names
+		}
+		xid, err := api.ArchiveMultiObj(apiBP, srcBck, &amsg)
+		if err != nil {
+			return V(err)
+		}
+		xids.Add(xid)
+	}
+	what := fmt.Sprintf("%s => %s (%d shard%s)", srcBck.Cname(prefix), dstBck.Cname(shardPrefix+"*"+ext),
+		len(shards), cos.Plural(len(shards)))
+	if !flagIsSet(c, waitFlag) {
+		actionDone(c, "Archiving "+what)
+		return nil
+	}
+	for xid := range xids {
+		if err := waitXact(&xact.ArgsMsg{ID: xid, Kind: apc.ActArchive}); err != nil {
+			return err
+		}
+	}
+	actionDone(c, "Archived "+what)
+	return nil
+}
+
+// group listed objects by WebDataset key (entries are sorted by name)
+func wdsRecords(entries cmn.LsoEntries) []*wdsRecord {
+	records := make([]*wdsRecord, 0, len(entries))
+	idx := make(map[string]*wdsRecord, len(entries))
+	for _, en := range entries {
+		key := cos.WdsKey(en.Name)
+		rec, ok := idx[key]
+		if !ok {
+			rec = &wdsRecord{key: key}
+			idx[key] = rec
+			records = append(records, rec)
+		}
+		rec.names = append(rec.names, en.Name)
+		rec.size += en.Size
+	}
+	return records
+}
+
+// Distribute records between the minimum number of shards such that each shard is
+// (approximately) `shardSize` or smaller - and all shards are approximately equal in size.
+// Shard names are zero-padded sequence numbers: <prefix>000000<ext>, <prefix>000001<ext>, etc.
+func makeWdsShards(records []*wdsRecord, shardSize int64, prefix, ext string) []*wdsShard {
+	var total int64
+	for _, rec := range records {
+		total += rec.size
+	}
+	num := int(max((total+shardSize-1)/shardSize, 1))
+	num = min(num, len(records))
+
+	// assign each record to a shard by the position of its midpoint in the cumulative size
+	var (
+		shards = make([]*wdsShard, 0, num)
+		cum    int64
+		cur    = -1
+	)
+	for i, rec := range records {
+		var idx int
+		if total > 0 {
+			idx = int((cum + rec.size/2) * int64(num) / total)
+		} else {
+			idx = i * num / len(records)
+		}
+		idx = min(idx, num-1)
+		if idx != cur {
+			shards = append(shards, &wdsShard{})
+			cur = idx
+		}
+		shard := shards[len(shards)-1]
+		shard.names = append(shard.names, rec.names...)
+		shard.size += rec.size
+		cum += rec.size
+	}
+	width := max(len(strconv.Itoa(len(shards)-1)), 6)
+	for i, shard := range shards {
+		shard.name = fmt.Sprintf("%s%0*d%s", prefix, width, i, ext)
+	}
+	return shards
+}
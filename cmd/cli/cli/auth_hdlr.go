@@ -34,6 +34,7 @@ const (
 	flagsAuthUserLogin   = "user_login"
 	flagsAuthUserLogout  = "user_logout"
 	flagsAuthUserShow    = "user_show"
+	flagsAuthUserAdd     = "user_add"
 	flagsAuthRoleAddSet  = "role_add_set"
 	flagsAuthRevokeToken = "revoke_token"
 	flagsAuthRoleShow    = "role_show"
@@ -47,10 +48,11 @@ var (
 		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag},
 		flagsAuthUserLogout:  {tokenFileFlag},
 		cmdAuthUser:          {passwordFlag},
-		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag},
+		flagsAuthUserAdd:     {passwordFlag, ldapUserFlag},
+		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag, extendsRoleFlag, maxJobsRoleFlag, maxSessionsRoleFlag},
 		flagsAuthRevokeToken: {tokenFileFlag},
-		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
-		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
+		flagsAuthUserShow:    {nonverboseFlag, verboseFlag, sessionsUserFlag},
+		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag, effectiveRoleFlag},
 		flagsAuthConfShow:    {jsonFlag},
 	}
 
@@ -104,7 +106,7 @@ var (
 						Name:         cmdAuthUser,
 						Usage:        "add a new user",
 						ArgsUsage:    addAuthUserArgument,
-						Flags:        authFlags[cmdAuthUser],
+						Flags:        authFlags[flagsAuthUserAdd],
 						Action:       wrapAuthN(addAuthUserHandler),
 						BashComplete: oneRoleCompletions,
 					},
@@ -318,10 +320,17 @@ func updateAuthUserHandler(c *cli.Context) error {
 }
 
 func addAuthUserHandler(c *cli.Context) error {
-	user, err := userFromArgsOrStdin(c, false)
+	ldap := flagIsSet(c, ldapUserFlag)
+	if ldap && flagIsSet(c, passwordFlag) {
+		return incorrectUsageMsg(c, "%s and %s are mutually exclusive", qflprn(ldapUserFlag), qflprn(passwordFlag))
+	}
+	user, err := userFromArgsOrStdin(c, ldap /*omitEmpty*/)
 	if err != nil {
 		return err
 	}
+	if ldap {
+		user.Source = authn.UserSourceLDAP
+	}
 	existingUsers, err := authn.GetAllUsers(authParams)
 	if err != nil {
 		return err
@@ -481,7 +490,15 @@ func showAuthClusterHandler(c *cli.Context) (err error) {
 }
 
 func showAuthSingleRole(c *cli.Context, roleID string) error {
-	rInfo, err := authn.GetRole(authParams, roleID)
+	var (
+		rInfo *authn.Role
+		err   error
+	)
+	if flagIsSet(c, effectiveRoleFlag) {
+		rInfo, err = authn.GetEffectiveRole(authParams, roleID)
+	} else {
+		rInfo, err = authn.GetRole(authParams, roleID)
+	}
 	if err != nil {
 		return err
 	}
@@ -531,6 +548,20 @@ func showAuthRoleHandler(c *cli.Context) (err error) {
 
 func showAuthUserHandler(c *cli.Context) (err error) {
 	userID := c.Args().Get(0)
+	if flagIsSet(c, sessionsUserFlag) {
+		if userID == "" {
+			return missingArgumentsError(c, "user name")
+		}
+		sessions, err := authn.GetUserSessions(authParams, userID)
+		if err != nil {
+			return err
+		}
+		if len(sessions) == 0 {
+			fmt.Fprintf(c.App.Writer, "User %q has no active sessions\n", userID)
+			return nil
+		}
+		return teb.Print(sessions, teb.AuthNSessionsTmpl)
+	}
 	if userID == "" {
 		list, err := authn.GetAllUsers(authParams)
 		if err != nil {
@@ -626,6 +657,15 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 	roleACL := &authn.Role{
 		Name:        role,
 		Description: parseStrFlag(c, descRoleFlag),
+		MaxJobs:     parseIntFlag(c, maxJobsRoleFlag),
+		MaxSessions: parseIntFlag(c, maxSessionsRoleFlag),
+	}
+	if flagIsSet(c, extendsRoleFlag) {
+		roleACL.Parents = splitCsv(parseStrFlag(c, extendsRoleFlag))
+		if cluster == "" && perms == apc.AccessNone {
+			// composite role: all permissions are inherited
+			return roleACL, nil
+		}
 	}
 	if bucket != "" {
 		bck, err := parseBckURI(c, bucket, false)
@@ -115,10 +115,6 @@ func mvBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 
 // Evict remote bucket
 func evictBucket(c *cli.Context, bck cmn.Bck) error {
-	if flagIsSet(c, dryRunFlag) {
-		fmt.Fprintf(c.App.Writer, "Evict: %q\n", bck.Cname(""))
-		return nil
-	}
 	bmd, err := api.GetBMD(apiBP)
 	if err != nil {
 		return err
@@ -157,6 +153,11 @@ func _evictBck(c *cli.Context, bck cmn.Bck) (err error) {
 	if err = ensureRemoteProvider(bck); err != nil {
 		return err
 	}
+	if hasEvictFilter(c) || flagIsSet(c, evictOffloadFlag) || flagIsSet(c, dryRunFlag) {
+		// select objects to evict (or offload, or estimate); implies keeping bucket metadata
+		lr := &lrCtx{bck: bck}
+		return lr.do(c)
+	}
 	keep := flagIsSet(c, keepMDFlag)
 	if err = api.EvictRemoteBucket(apiBP, bck, keep); err != nil {
 		return V(err)
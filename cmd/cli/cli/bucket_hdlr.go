@@ -43,10 +43,12 @@ const copyBucketUsage = "copy entire bucket or selected objects (to select, use
 	indent1 + "\tsynchronize with out-of-band updates:\n" +
 	indent1 + "\t- 'ais cp s3://abc ais://nnn --latest'\t- copy Cloud bucket; make sure that already present in-cluster copies are updated to the latest versions;\n" +
 	indent1 + "\t- 'ais cp s3://abc ais://nnn --sync'\t- same as above, but in addition delete in-cluster copies that do not exist (any longer) in the remote source.\n" +
-	indent1 + "\twith template, prefix, and progress:\n" +
+	indent1 + "\twith list, template, prefix, and progress:\n" +
 	indent1 + "\t- 'ais cp s3://abc ais://nnn --prepend backup/'\t- copy objects into 'backup/' virtual subdirectory in destination bucket;\n" +
 	indent1 + "\t- 'ais cp ais://nnn/111 ais://mmm'\t- copy all ais://nnn objects that match prefix '111';\n" +
 	indent1 + "\t- 'ais cp gs://webdataset-coco ais:/dst --template d-tokens/shard-{000000..000999}.tar.lz4'\t- copy up to 1000 objects that share the specified prefix;\n" +
+	indent1 + "\t- 'ais cp \"gs://webdataset-coco/d-tokens/shard-{000000..000999}.tar.lz4\" ais:/dst'\t- same as above (notice double quotes);\n" +
+	indent1 + "\t- 'ais cp s3://abc ais://nnn --list \"a.tar, b.tar, c.tar\"'\t- copy the listed objects (remote objects do not need to be present in-cluster);\n" +
 	indent1 + "\t- 'ais cp gs://webdataset-coco ais:/dst --prefix d-tokens/ --progress --all'\t- show progress while copying virtual subdirectory 'd-tokens';\n" +
 	indent1 + "\t- 'ais cp gs://webdataset-coco/d-tokens/ ais:/dst --progress --all'\t- same as above."
 
@@ -124,6 +126,13 @@ var (
 			forceFlag,
 			copyDryRunFlag,
 			copyPrependFlag,
+			copyStripPrefixFlag,
+			copyRenameRegexFlag,
+			copyRenameToFlag,
+			copyPadDigitsFlag,
+			copySnapshotFlag,
+			jobMaxObjectsFlag,
+			jobMaxBytesFlag,
 			progressFlag,
 			refreshFlag,
 			waitFlag,
@@ -142,6 +151,9 @@ var (
 		commandEvict: append(
 			listRangeProgressWaitFlags,
 			keepMDFlag,
+			evictOlderThanFlag,
+			evictMinSizeFlag,
+			evictOffloadFlag,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			dryRunFlag,
 			noRecursFlag, // (embedded prefix dopOLTP)
@@ -160,6 +172,7 @@ var (
 			listObjCachedFlag,
 			nameOnlyFlag,
 			objPropsFlag,
+			viewFlag,
 			regexLsAnyFlag,
 			templateFlag,
 			listObjPrefixFlag,
@@ -187,6 +200,7 @@ var (
 			useInventoryFlag,
 			invNameFlag,
 			invIDFlag,
+			diffInventoryFlag,
 		},
 
 		cmdLRU: {
@@ -213,6 +227,22 @@ var (
 		Action:       lruBucketHandler,
 		BashComplete: bucketCompletions(bcmplop{}),
 	}
+	bucketCmdFreeze = cli.Command{
+		Name: cmdFreeze,
+		Usage: "make bucket temporarily read-only: reject all writes, deletes, and renames (cluster-wide)\n" +
+			indent1 + "until the bucket is unfrozen, e.g.:\n" +
+			indent1 + "\t- 'ais bucket freeze ais://abc'\t- freeze for the duration of dataset audit or migration",
+		ArgsUsage:    bucketArgument,
+		Action:       freezeBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+	bucketCmdUnfreeze = cli.Command{
+		Name:         cmdUnfreeze,
+		Usage:        "unfreeze previously frozen bucket (see 'ais bucket freeze --help')",
+		ArgsUsage:    bucketArgument,
+		Action:       unfreezeBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
 	bucketObjCmdEvict = cli.Command{
 		Name:         commandEvict,
 		Usage:        evictUsage,
@@ -267,6 +297,8 @@ var (
 			},
 			bucketCmdCopy,
 			bucketCmdRename,
+			bucketCmdFreeze,
+			bucketCmdUnfreeze,
 			{
 				Name:      commandRemove,
 				Usage:     "remove ais buckets",
@@ -400,6 +432,44 @@ func toggleLRU(c *cli.Context, bck cmn.Bck, p *cmn.Bprops, toggle bool) (err err
 	return updateBckProps(c, bck, p, toggledProps)
 }
 
+func freezeBucketHandler(c *cli.Context) error   { return freezeBucket(c, true) }
+func unfreezeBucketHandler(c *cli.Context) error { return freezeBucket(c, false) }
+
+func freezeBucket(c *cli.Context, freeze bool) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	p, err := headBucket(bck, true /* don't add */)
+	if err != nil {
+		return err
+	}
+	if p.Frozen == freeze {
+		fmt.Fprintf(c.App.Writer, "Bucket %s is already %s, nothing to do\n", bck.Cname(""), _frozen(freeze))
+		return nil
+	}
+	if freeze {
+		_, err = api.FreezeBucket(apiBP, bck)
+	} else {
+		_, err = api.UnfreezeBucket(apiBP, bck)
+	}
+	if err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Bucket %s is now %s", bck.Cname(""), _frozen(freeze)))
+	return nil
+}
+
+func _frozen(frozen bool) string {
+	if frozen {
+		return "frozen (read-only)"
+	}
+	return "unfrozen"
+}
+
 func setPropsHandler(c *cli.Context) (err error) {
 	var currProps *cmn.Bprops
 	bck, err := parseBckURI(c, c.Args().Get(0), false)
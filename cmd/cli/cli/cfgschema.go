@@ -0,0 +1,154 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file contains implementation of `ais show config cluster --explain KEY`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+// Cluster config schema: description, valid range or enumeration, and default value
+// of (most) cluster config keys. Type is not part of the schema - it's derived from
+// the config itself.
+
+type (
+	cfgSchemaEntry struct {
+		Desc    string   `json:"desc"`
+		Range   string   `json:"range,omitempty"`
+		Default string   `json:"default,omitempty"`
+		Enum    []string `json:"enum,omitempty"`
+	}
+	cfgExplained struct {
+		Key       string          `json:"key"`
+		Type      string          `json:"type"`
+		Value     string          `json:"value"`
+		Schema    *cfgSchemaEntry `json:"schema,omitempty"`
+		Overrides nvpairList      `json:"overrides,omitempty"` // node => value (when differs from cluster)
+	}
+)
+
+var (
+	//go:embed config_schema.json
+	cfgSchemaJSON []byte
+
+	cfgSchema map[string]*cfgSchemaEntry
+)
+
+func loadCfgSchema() (map[string]*cfgSchemaEntry, error) {
+	if cfgSchema != nil {
+		return cfgSchema, nil
+	}
+	schema := make(map[string]*cfgSchemaEntry, 128)
+	if err := jsoniter.Unmarshal(cfgSchemaJSON, &schema); err != nil {
+		return nil, fmt.Errorf("failed to load config schema: %v", err)
+	}
+	cfgSchema = schema
+	return schema, nil
+}
+
+// returns Go type and string value of a given (flattened) config key
+func cfgKeyValue(config any, key string) (typ, val string, found bool) {
+	cmn.IterFields(config, func(tag string, field cmn.IterField) (error, bool) {
+		if tag != key {
+			return nil, false
+		}
+		v := field.Value()
+		typ, val, found = fmt.Sprintf("%T", v), _toStr(v), true
+		return nil, true
+	})
+	return typ, val, found
+}
+
+func explainClusterConfig(c *cli.Context, key string) error {
+	if key == "" {
+		return missingArgumentsError(c, "config key (e.g., 'timeout.max_keepalive')")
+	}
+	if i := strings.IndexByte(key, '='); i > 0 {
+		key = key[:i]
+	}
+	schema, err := loadCfgSchema()
+	if err != nil {
+		return err
+	}
+	cluConfig, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	out := cfgExplained{Key: key, Schema: schema[key]}
+	var found bool
+	if out.Type, out.Value, found = cfgKeyValue(cluConfig, key); !found {
+		return fmt.Errorf("cluster config key %q not found (hint: use 'ais show config cluster' to list all keys)", key)
+	}
+
+	// nodes inherit cluster config but may also override it (see 'ais config node')
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	for _, nodeMap := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+		for _, si := range nodeMap {
+			config, err := api.GetDaemonConfig(apiBP, si)
+			if err != nil {
+				actionWarn(c, fmt.Sprintf("failed to get %s config: %v", si.StringEx(), err))
+				continue
+			}
+			if _, v, ok := cfgKeyValue(&config.ClusterConfig, key); ok && v != out.Value {
+				out.Overrides = append(out.Overrides, nvpair{Name: si.StringEx(), Value: v})
+			}
+		}
+	}
+	sort.Slice(out.Overrides, func(i, j int) bool { return out.Overrides[i].Name < out.Overrides[j].Name })
+
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(out, "", teb.Jopts(true))
+	}
+	printCfgExplained(c, &out)
+	return nil
+}
+
+func printCfgExplained(c *cli.Context, out *cfgExplained) {
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "KEY:\t%s\n", out.Key)
+	if out.Schema != nil {
+		fmt.Fprintf(tw, "DESCRIPTION:\t%s\n", out.Schema.Desc)
+	} else {
+		fmt.Fprintf(tw, "DESCRIPTION:\t%s\n", "(not available)")
+	}
+	fmt.Fprintf(tw, "TYPE:\t%s\n", out.Type)
+	if out.Schema != nil {
+		switch {
+		case len(out.Schema.Enum) > 0:
+			fmt.Fprintf(tw, "VALID VALUES:\t%s\n", strings.Join(out.Schema.Enum, ", "))
+		case out.Schema.Range != "":
+			fmt.Fprintf(tw, "VALID RANGE:\t%s\n", out.Schema.Range)
+		}
+		if out.Schema.Default != "" {
+			fmt.Fprintf(tw, "DEFAULT:\t%s\n", out.Schema.Default)
+		}
+	}
+	fmt.Fprintf(tw, "CLUSTER VALUE:\t%s\n", out.Value)
+	if len(out.Overrides) == 0 {
+		fmt.Fprintf(tw, "NODE OVERRIDES:\t%s\n", "none")
+	} else {
+		fmt.Fprintln(tw, "NODE OVERRIDES:")
+		for _, nv := range out.Overrides {
+			fmt.Fprintf(tw, "  %s\t%s\n", nv.Name, nv.Value)
+		}
+	}
+	tw.Flush()
+}
@@ -0,0 +1,392 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais job chain` - running a DAG of jobs, each starting upon successful completion of its predecessors.
+// The chain is driven (and its status kept) by the CLI process - it is not persisted by the cluster.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const chainSubmitUsage = "run a chain (DAG) of jobs where each job starts only after all its predecessors succeed, e.g.:\n" +
+	indent4 + "\t - 'ais job chain submit chain.yaml'\t- run the chain and show its status upon completion;\n" +
+	indent4 + "\t - 'ais job chain submit chain.yaml --dry-run'\t- validate the chain and show the execution order;\n" +
+	indent4 + "\t notes:\n" +
+	indent4 + "\t - supported job kinds: prefetch, copy, etl, archive (see docs/cli/job.md for the specification format);\n" +
+	indent4 + "\t - the chain is driven by this CLI process and is not persisted by the cluster;\n" +
+	indent4 + "\t - interrupting the command stops the chain but not the jobs already running (resubmit to run again)"
+
+// step kinds
+const (
+	chainPrefetch = "prefetch"
+	chainCopy     = "copy"
+	chainETL      = "etl"
+	chainArchive  = "archive"
+)
+
+// step states
+const (
+	chainPending   = "pending"
+	chainRunning   = "running"
+	chainSucceeded = "succeeded"
+	chainFailed    = "failed"
+	chainSkipped   = "skipped" // (some predecessor failed or was skipped)
+)
+
+type (
+	chainSpec struct {
+		Name  string       `json:"name" yaml:"name"`
+		Steps []*chainStep `json:"steps" yaml:"steps"`
+	}
+	chainStep struct {
+		Name     string   `json:"name" yaml:"name"`
+		Kind     string   `json:"kind" yaml:"kind"`
+		After    []string `json:"after,omitempty" yaml:"after,omitempty"`
+		Bucket   string   `json:"bucket" yaml:"bucket"`                         // source bucket
+		To       string   `json:"to,omitempty" yaml:"to,omitempty"`             // destination bucket (copy, etl) or bucket/archive-name (archive)
+		ETL      string   `json:"etl,omitempty" yaml:"etl,omitempty"`           // ETL name (etl)
+		Prefix   string   `json:"prefix,omitempty" yaml:"prefix,omitempty"`     // source objects: prefix,
+		Template string   `json:"template,omitempty" yaml:"template,omitempty"` // or template,
+		List     []string `json:"list,omitempty" yaml:"list,omitempty"`         // or list
+
+		// runtime
+		bck, toBck cmn.Bck
+		archName   string
+		deps       []*chainStep
+		done       chan struct{}
+		status     chainStepStatus
+	}
+	chainStepStatus struct {
+		Name    string `json:"name"`
+		Kind    string `json:"kind"`
+		JobID   string `json:"job_id,omitempty"`
+		State   string `json:"state"`
+		Err     string `json:"error,omitempty"`
+		Elapsed string `json:"elapsed,omitempty"`
+	}
+	chainStatus struct {
+		Name  string             `json:"name"`
+		State string             `json:"state"`
+		Steps []*chainStepStatus `json:"steps"`
+	}
+)
+
+var (
+	chainCmdFlags = []cli.Flag{
+		dryRunFlag,
+		waitJobXactFinishedFlag,
+		jsonFlag,
+	}
+	jobChainSub = cli.Command{
+		Name:  cmdChain,
+		Usage: "run a chain (DAG) of jobs, e.g. prefetch => transform => archive",
+		Subcommands: []cli.Command{
+			{
+				Name:      cmdChainSubmit,
+				Usage:     chainSubmitUsage,
+				ArgsUsage: chainSpecArgument,
+				Flags:     chainCmdFlags,
+				Action:    chainSubmitHandler,
+			},
+		},
+	}
+)
+
+func chainSubmitHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	b, err := os.ReadFile(c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	var spec chainSpec
+	if errj := jsoniter.Unmarshal(b, &spec); errj != nil {
+		if erry := yaml.Unmarshal(b, &spec); erry != nil {
+			return fmt.Errorf("failed to parse chain specification, errs: (%v, %v)", errj, erry)
+		}
+	}
+	order, err := spec.validate()
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, dryRunFlag) {
+		fmt.Fprintf(c.App.Writer, "Chain %q, execution order:\n", spec.Name)
+		for i, step := range order {
+			after := "-"
+			if len(step.After) > 0 {
+				after = strings.Join(step.After, ", ")
+			}
+			fmt.Fprintf(c.App.Writer, "%s%d. %s (%s %s), after: %s\n", indent1, i+1, step.Name, step.Kind, step.Bucket, after)
+		}
+		return nil
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+		outJSON = flagIsSet(c, jsonFlag)
+	)
+	report := func(step *chainStep, format string, a ...any) {
+		if outJSON {
+			return
+		}
+		mu.Lock()
+		fmt.Fprintf(c.App.Writer, "%s %s: %s\n", time.Now().Format(time.TimeOnly), step.Name, fmt.Sprintf(format, a...))
+		mu.Unlock()
+	}
+	for _, step := range spec.Steps {
+		wg.Add(1)
+		go step.run(&wg, timeout, report)
+	}
+	wg.Wait()
+
+	// chain-level status
+	status := &chainStatus{Name: spec.Name, State: chainSucceeded, Steps: make([]*chainStepStatus, 0, len(order))}
+	for _, step := range order {
+		status.Steps = append(status.Steps, &step.status)
+		if step.status.State != chainSucceeded {
+			status.State = chainFailed
+		}
+	}
+	if outJSON {
+		if err := teb.Print(status, "", teb.Jopts(true)); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintln(c.App.Writer)
+		if err := teb.Print(status, teb.ChainTmpl); err != nil {
+			return err
+		}
+	}
+	if status.State != chainSucceeded {
+		return fmt.Errorf("chain %q failed", spec.Name)
+	}
+	return nil
+}
+
+// validate the spec, resolve buckets and dependencies; return steps in (a) topological order
+func (spec *chainSpec) validate() ([]*chainStep, error) {
+	if len(spec.Steps) == 0 {
+		return nil, errors.New("chain specification contains no steps")
+	}
+	if spec.Name == "" {
+		spec.Name = "chain-" + cos.GenTie()
+	}
+	steps := make(map[string]*chainStep, len(spec.Steps))
+	for _, step := range spec.Steps {
+		if step.Name == "" {
+			return nil, errors.New("chain step with no name")
+		}
+		if _, ok := steps[step.Name]; ok {
+			return nil, fmt.Errorf("duplicate chain step %q", step.Name)
+		}
+		if err := step.init(); err != nil {
+			return nil, fmt.Errorf("chain step %q: %v", step.Name, err)
+		}
+		steps[step.Name] = step
+	}
+	for _, step := range spec.Steps {
+		for _, name := range step.After {
+			dep, ok := steps[name]
+			if !ok {
+				return nil, fmt.Errorf("chain step %q: unknown predecessor %q", step.Name, name)
+			}
+			step.deps = append(step.deps, dep)
+		}
+	}
+
+	// topological sort (DFS), detect cycles
+	var (
+		order   = make([]*chainStep, 0, len(spec.Steps))
+		visited = make(map[*chainStep]int, len(spec.Steps)) // 1: in progress, 2: done
+		visit   func(step *chainStep) error
+	)
+	visit = func(step *chainStep) error {
+		switch visited[step] {
+		case 1:
+			return fmt.Errorf("chain step %q: circular dependency", step.Name)
+		case 2:
+			return nil
+		}
+		visited[step] = 1
+		for _, dep := range step.deps {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visited[step] = 2
+		order = append(order, step)
+		return nil
+	}
+	for _, step := range spec.Steps {
+		if err := visit(step); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+///////////////
+// chainStep //
+///////////////
+
+func (step *chainStep) init() (err error) {
+	step.status = chainStepStatus{Name: step.Name, Kind: step.Kind, State: chainPending}
+	step.done = make(chan struct{})
+	if step.bck, err = parseChainBck(step.Bucket); err != nil {
+		return err
+	}
+	if step.Prefix != "" && (step.Template != "" || len(step.List) > 0) || step.Template != "" && len(step.List) > 0 {
+		return errors.New("prefix, template, and list are mutually exclusive")
+	}
+	switch step.Kind {
+	case chainPrefetch:
+		if !step.bck.IsRemote() {
+			return fmt.Errorf("expecting remote bucket, got %s", step.bck.Cname(""))
+		}
+	case chainCopy, chainETL:
+		if step.To == "" {
+			return errors.New("missing destination bucket ('to')")
+		}
+		if step.toBck, err = parseChainBck(step.To); err != nil {
+			return err
+		}
+		if step.Kind == chainETL && step.ETL == "" {
+			return errors.New("missing ETL name ('etl')")
+		}
+	case chainArchive:
+		var opts cmn.ParseURIOpts
+		if !providerRequired {
+			opts.DefaultProvider = cfg.DefaultProvider
+		}
+		if step.toBck, step.archName, err = cmn.ParseBckObjectURI(step.To, opts); err != nil {
+			return err
+		}
+		if step.archName == "" {
+			return fmt.Errorf("missing archive name in 'to' (%q), expecting e.g. ais://abc/shard.tar", step.To)
+		}
+	default:
+		return fmt.Errorf("invalid kind %q (expecting one of: %s)", step.Kind,
+			strings.Join([]string{chainPrefetch, chainCopy, chainETL, chainArchive}, ", "))
+	}
+	return nil
+}
+
+func parseChainBck(uri string) (cmn.Bck, error) {
+	var opts cmn.ParseURIOpts
+	if !providerRequired {
+		opts.DefaultProvider = cfg.DefaultProvider
+	}
+	bck, objName, err := cmn.ParseBckObjectURI(uri, opts)
+	switch {
+	case err != nil:
+		return bck, err
+	case bck.Name == "":
+		return bck, fmt.Errorf("missing bucket name: %q", uri)
+	case objName != "":
+		return bck, fmt.Errorf("unexpected object name in bucket %q (use 'prefix' to select source objects)", uri)
+	}
+	return bck, bck.Validate()
+}
+
+func (step *chainStep) lr() apc.ListRange {
+	lr := apc.ListRange{ObjNames: step.List, Template: step.Template}
+	if step.Prefix != "" {
+		lr.Template = step.Prefix // (prefix is a template with no ranges)
+	}
+	return lr
+}
+
+func (step *chainStep) run(wg *sync.WaitGroup, timeout time.Duration, report func(*chainStep, string, ...any)) {
+	defer func() {
+		close(step.done)
+		wg.Done()
+	}()
+	for _, dep := range step.deps {
+		<-dep.done
+		if dep.status.State != chainSucceeded {
+			step.status.State = chainSkipped
+			step.status.Err = fmt.Sprintf("predecessor %q %s", dep.Name, dep.status.State)
+			report(step, "%s (%s)", chainSkipped, step.status.Err)
+			return
+		}
+	}
+
+	started := time.Now()
+	kind, xid, err := step.start()
+	if err == nil {
+		step.status.JobID = xid
+		step.status.State = chainRunning
+		report(step, "started %s", xact.Cname(kind, xid))
+		err = waitXact(&xact.ArgsMsg{ID: xid, Kind: kind, Timeout: timeout})
+	}
+	step.status.Elapsed = teb.FormatDuration(time.Since(started))
+	if err != nil {
+		step.status.State = chainFailed
+		step.status.Err = err.Error()
+		report(step, "%s: %v", chainFailed, err)
+		return
+	}
+	step.status.State = chainSucceeded
+	report(step, "%s (%s)", chainSucceeded, step.status.Elapsed)
+}
+
+func (step *chainStep) start() (kind, xid string, err error) {
+	lr := step.lr()
+	switch step.Kind {
+	case chainPrefetch:
+		kind = apc.ActPrefetchObjects
+		xid, err = api.Prefetch(apiBP, step.bck, apc.PrefetchMsg{ListRange: lr})
+	case chainCopy, chainETL:
+		etl := step.Kind == chainETL
+		switch {
+		case len(step.List) > 0 || step.Template != "":
+			msg := &cmn.TCOMsg{ToBck: step.toBck}
+			msg.ListRange = lr
+			if etl {
+				kind = apc.ActETLObjects
+				msg.Transform.Name = step.ETL
+				xid, err = api.ETLMultiObj(apiBP, step.bck, msg)
+			} else {
+				kind = apc.ActCopyObjects
+				xid, err = api.CopyMultiObj(apiBP, step.bck, msg)
+			}
+		case etl:
+			kind = apc.ActETLBck
+			msg := &apc.TCBMsg{Transform: apc.Transform{Name: step.ETL}, CopyBckMsg: apc.CopyBckMsg{Prefix: step.Prefix}}
+			xid, err = api.ETLBucket(apiBP, step.bck, step.toBck, msg)
+		default:
+			kind = apc.ActCopyBck
+			xid, err = api.CopyBucket(apiBP, step.bck, step.toBck, &apc.CopyBckMsg{Prefix: step.Prefix})
+		}
+	case chainArchive:
+		kind = apc.ActArchive
+		msg := &cmn.ArchiveBckMsg{ToBck: step.toBck}
+		msg.ArchName = step.archName
+		msg.ListRange = lr
+		xid, err = api.ArchiveMultiObj(apiBP, step.bck, msg)
+	}
+	if err != nil {
+		err = V(err)
+	}
+	return kind, xid, err
+}
@@ -0,0 +1,180 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles on-demand integrity audit: `ais object checksum` commands.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+const showCksumVerifyHdr = "JOB ID\t NODE\t BUCKET\t CHECKED\t NO CHECKSUM\t CORRUPTED\t REPAIRED\t STATE"
+
+var (
+	objectCmdChecksum = cli.Command{
+		Name:  commandChecksum,
+		Usage: "on-demand integrity audit: recompute object checksums and compare against stored metadata",
+		Subcommands: []cli.Command{
+			{
+				Name: commandVerify,
+				Usage: "verify checksums of all objects in a bucket (or, objects with a given prefix), e.g.:\n" +
+					indent1 + "\t- 'ais object checksum verify ais://abc'\t- verify all objects in the bucket;\n" +
+					indent1 + "\t- 'ais object checksum verify ais://abc/images/ --wait'\t- verify 'images/*' objects and wait for the report;\n" +
+					indent1 + "\t- 'ais object checksum verify s3://abc --repair'\t- same as above, and re-fetch corrupted objects from S3",
+				ArgsUsage: bucketPrefixArgument,
+				Flags: []cli.Flag{
+					cksumRepairFlag,
+					waitFlag,
+					waitJobXactFinishedFlag,
+					verboseFlag,
+					nonverboseFlag,
+				},
+				Action:       verifyCksumHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
+			{
+				Name:         commandShow,
+				Usage:        "show integrity audit report (the latest, if job ID is not specified)",
+				ArgsUsage:    optionalJobIDArgument,
+				Flags:        []cli.Flag{verboseFlag},
+				Action:       showCksumVerifyHandler,
+				BashComplete: runningJobCompletions,
+			},
+		},
+	}
+)
+
+func verifyCksumHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	bck, prefix, err := parseBckObjURI(c, c.Args().Get(0), true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	if _, err := headBucket(bck, false /* don't add */); err != nil {
+		return err
+	}
+	xid, err := api.VerifyChecksum(apiBP, bck, prefix, flagIsSet(c, cksumRepairFlag))
+	if err != nil {
+		return V(err)
+	}
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActVerifyCksum, Bck: bck}
+	actionX(c, &xargs, "")
+
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		if !flagIsSet(c, nonverboseFlag) {
+			fmt.Fprintf(c.App.Writer, "To see the report, run 'ais object %s %s %s'\n", commandChecksum, commandShow, xid)
+		}
+		return nil
+	}
+	if err := waitJob(c, apc.ActVerifyCksum, xid, bck); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer)
+	return showCksumVerify(c, xid)
+}
+
+func showCksumVerifyHandler(c *cli.Context) error {
+	var xid string
+	if c.NArg() > 0 {
+		xid = c.Args().Get(0)
+	}
+	return showCksumVerify(c, xid)
+}
+
+func showCksumVerify(c *cli.Context, xid string) error {
+	const none = "No integrity audit found. To start, run 'ais object %s %s BUCKET[/PREFIX]'.\n"
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActVerifyCksum}
+	snaps, err := api.QueryXactionSnaps(apiBP, &xargs)
+	if err != nil {
+		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
+			fmt.Fprintf(c.App.Writer, none, commandChecksum, commandVerify)
+			return nil
+		}
+		return V(err)
+	}
+
+	// given job or the latest one
+	if xid == "" {
+		var latest time.Time
+		for _, tsnaps := range snaps {
+			for _, snap := range tsnaps {
+				if snap.StartTime.After(latest) {
+					xid, latest = snap.ID, snap.StartTime
+				}
+			}
+		}
+	}
+	allSnaps := make([]*targetRebSnap, 0, 32)
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID == xid {
+				allSnaps = append(allSnaps, &targetRebSnap{tid: tid, snap: snap})
+			}
+		}
+	}
+	if len(allSnaps) == 0 {
+		fmt.Fprintf(c.App.Writer, none, commandChecksum, commandVerify)
+		return nil
+	}
+	sort.Slice(allSnaps, func(i, j int) bool { return allSnaps[i].tid < allSnaps[j].tid })
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, showCksumVerifyHdr)
+	var (
+		checked, corrupted, repaired int64
+		examples                     []string
+	)
+	for _, ts := range allSnaps {
+		rep := &xact.CksumVerifyReport{}
+		if err := cos.MorphMarshal(ts.snap.Ext, rep); err != nil {
+			return fmt.Errorf("%s: failed to parse integrity audit report: %v", ts.tid, err)
+		}
+		bname := ts.snap.Bck.Cname(rep.Prefix)
+		fmt.Fprintf(tw, "%s\t %s\t %s\t %d\t %d\t %d\t %d\t %s\n",
+			ts.snap.ID, ts.tid, bname, rep.Checked, rep.NoCksum, rep.Corrupted, rep.Repaired,
+			teb.FmtXactRunFinAbrt(ts.snap))
+		checked += rep.Checked
+		corrupted += rep.Corrupted
+		repaired += rep.Repaired
+		for _, e := range rep.Examples {
+			examples = append(examples, ts.tid+": "+e)
+		}
+	}
+	tw.Flush()
+
+	fmt.Fprintln(c.App.Writer)
+	if corrupted == 0 {
+		fmt.Fprintf(c.App.Writer, "%s: %d checked, no corrupted objects found\n", fcyan(xid), checked)
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "%s: %d checked, %s, %d repaired\n", fcyan(xid), checked,
+		fred(strconv.FormatInt(corrupted, 10)+" corrupted"), repaired)
+	if flagIsSet(c, verboseFlag) {
+		for _, e := range examples {
+			fmt.Fprintln(c.App.Writer, indent1+e)
+		}
+	} else if len(examples) > 0 {
+		fmt.Fprintf(c.App.Writer, "Use %s to list corrupted objects\n", qflprn(verboseFlag))
+	}
+	return nil
+}
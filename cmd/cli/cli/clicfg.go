@@ -0,0 +1,127 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file validates 'ais config cli set' keys and values.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// CLI config value types (see cliCfgKeys)
+const (
+	cliCfgString   = "string"
+	cliCfgBool     = "bool"
+	cliCfgDuration = "duration"
+	cliCfgProvider = "provider"
+)
+
+const cliCfgMaxDist = 3 // max Damerau-Levenshtein distance to suggest a key
+
+// all settable CLI config keys and their (value) types
+func cliCfgKeys(cfg *config.Config) map[string]string {
+	keys := make(map[string]string, 32)
+	err := cmn.IterFields(cfg, func(tag string, field cmn.IterField) (error, bool) {
+		switch {
+		case strings.HasPrefix(tag, "timeout."):
+			keys[tag] = cliCfgDuration
+		case tag == "default_provider":
+			keys[tag] = cliCfgProvider
+		default:
+			if _, ok := field.Value().(bool); ok {
+				keys[tag] = cliCfgBool
+			} else {
+				keys[tag] = cliCfgString
+			}
+		}
+		return nil, false
+	})
+	debug.AssertNoErr(err)
+	return keys
+}
+
+func validateCliCfgKV(keys map[string]string, key, value string) error {
+	ty, ok := keys[key]
+	if !ok {
+		return unknownCliCfgKey(keys, key)
+	}
+	var err error
+	switch ty {
+	case cliCfgBool:
+		_, err = cos.ParseBool(value)
+	case cliCfgDuration:
+		_, err = time.ParseDuration(value)
+	case cliCfgProvider:
+		if value != "" && !apc.IsProvider(value) {
+			err = fmt.Errorf("expecting one of: %s", apc.AllProviders)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q for %q: %v", ty, value, key, err)
+	}
+	return nil
+}
+
+func unknownCliCfgKey(keys map[string]string, key string) error {
+	msg := fmt.Sprintf("unknown CLI config key %q", key)
+	if similar := similarCliCfgKeys(keys, key); len(similar) > 0 {
+		msg += " (did you mean: " + strings.Join(similar, ", ") + "?)"
+	}
+	return fmt.Errorf("%s\n(run '%s %s %s %s' to list all keys)", msg, cliName, commandConfig, cmdCLI, cmdCLIShow)
+}
+
+func isCliCfgKeyOrSection(keys map[string]string, key string) bool {
+	if _, ok := keys[key]; ok {
+		return true
+	}
+	for k := range keys {
+		if strings.HasPrefix(k, key+cmn.IterFieldNameSepa) {
+			return true
+		}
+	}
+	return false
+}
+
+// section prefix (e.g. "timeout" => all "timeout.*" keys) or closest key(s)
+func similarCliCfgKeys(keys map[string]string, key string) (similar []string) {
+	for k := range keys {
+		if strings.HasPrefix(k, key+cmn.IterFieldNameSepa) {
+			similar = append(similar, k)
+		}
+	}
+	if len(similar) == 0 {
+		minDist := cliCfgMaxDist + 1
+		for k := range keys {
+			dist := DamerauLevenstheinDistance(key, k)
+			switch {
+			case dist < minDist:
+				minDist = dist
+				similar = append(similar[:0], k)
+			case dist == minDist:
+				similar = append(similar, k)
+			}
+		}
+	}
+	sort.Strings(similar)
+	return similar
+}
+
+// whole-config validation, including display settings (timezone, time format, etc.)
+func validateCliCfg(cfg *config.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	d := &cfg.Display
+	return teb.SetDisplay(d.Timezone, d.TimeFormat, d.ThousandsSep, d.DecimalSep)
+}
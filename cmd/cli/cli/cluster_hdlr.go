@@ -12,6 +12,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
@@ -75,8 +76,14 @@ var (
 		},
 		cmdClusterDecommission: {
 			rmUserDataFlag,
+			archiveToFlag,
 			yesFlag,
 		},
+		cmdMembershipPlan: {
+			planAddFlag,
+			planRemoveFlag,
+			jsonFlag,
+		},
 		commandStart: {},
 		commandStop:  {},
 		commandShow: {
@@ -210,6 +217,14 @@ var (
 						Action:       nodeMaintShutDecommHandler,
 						BashComplete: suggestAllNodes,
 					},
+					{
+						Name:         cmdMembershipPlan,
+						Usage:        rebPlanUsage,
+						ArgsUsage:    optionalBucketArgument,
+						Flags:        clusterCmdsFlags[cmdMembershipPlan],
+						Action:       rebPlanHandler,
+						BashComplete: bucketCompletions(bcmplop{}),
+					},
 				},
 			},
 			{
@@ -227,6 +242,21 @@ var (
 				Action:       reloadCredsHandler,
 				BashComplete: suggestProvider,
 			},
+			{
+				Name: cmdAddBackend,
+				Usage: "register external backend plugin (or update existing registration), e.g.:\n" +
+					indent1 + "\t- 'ais cluster add-backend acme http://acme-plugin:9100'\t- then access plugin's buckets as ext://#acme/BUCKET",
+				ArgsUsage: addBackendArgument,
+				Flags:     []cli.Flag{backendPluginTimeoutFlag},
+				Action:    addBackendHandler,
+			},
+			{
+				Name:         cmdRemoveBackend,
+				Usage:        "unregister external backend plugin",
+				ArgsUsage:    removeBackendArgument,
+				Action:       removeBackendHandler,
+				BashComplete: suggestBackendPlugins,
+			},
 		},
 	}
 )
@@ -298,9 +328,13 @@ func clusterDecommissionHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	archiveTo := parseStrFlag(c, archiveToFlag)
 	if !flagIsSet(c, yesFlag) {
 		warn := fmt.Sprintf("about to permanently decommission cluster (UUID=%s, primary=[%s, %s]).",
 			smap.UUID, smap.Primary.ID(), smap.Primary.PubNet.URL)
+		if archiveTo != "" {
+			warn += " All ais:// buckets will be first copied to " + archiveTo + "."
+		}
 		actionWarn(c, warn)
 		if ok := confirm(c, "The operation cannot be undone. Proceed?"); !ok {
 			return nil
@@ -313,6 +347,12 @@ func clusterDecommissionHandler(c *cli.Context) error {
 	}
 	rmUserData := flagIsSet(c, rmUserDataFlag)
 
+	if archiveTo != "" {
+		if err := archiveCluster(c, smap, archiveTo); err != nil {
+			return fmt.Errorf("%v\n(cluster is NOT decommissioned)", err)
+		}
+	}
+
 	// [NOTE] ditto (see above)
 	bp := apiBP
 	bp.URL = smap.Primary.PubNet.URL
@@ -680,6 +720,81 @@ func reloadCredsHandler(c *cli.Context) error {
 	return api.ReloadBackendCreds(apiBP, p)
 }
 
+func addBackendHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 2 {
+		return incorrectUsageMsg(c, "", c.Args()[2:])
+	}
+	var (
+		name = c.Args().Get(0)
+		pc   = &cmn.ExtPluginConf{URL: c.Args().Get(1)}
+	)
+	if flagIsSet(c, backendPluginTimeoutFlag) {
+		pc.Timeout = cos.Duration(parseDurationFlag(c, backendPluginTimeoutFlag))
+	}
+	if err := setBackendPlugin(name, pc); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Backend plugin %q (%s) successfully registered", name, pc.URL))
+	return nil
+}
+
+func removeBackendHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	name := c.Args().Get(0)
+	if err := setBackendPlugin(name, nil); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Backend plugin %q successfully unregistered", name))
+	return nil
+}
+
+func setBackendPlugin(name string, pc *cmn.ExtPluginConf) error {
+	config, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	bconf, err := updBackendPlugins(&config.Backend, name, pc)
+	if err != nil {
+		return err
+	}
+	return api.SetClusterConfigUsingMsg(apiBP, &cmn.ConfigToSet{Backend: bconf}, false /*transient*/)
+}
+
+// add, update, or remove (nil pc) external backend plugin;
+// returns updated copy of the entire backend config (see cmn.ConfigToSet)
+func updBackendPlugins(from *cmn.BackendConf, name string, pc *cmn.ExtPluginConf) (*cmn.BackendConf, error) {
+	var (
+		plugins = from.Ext()
+		ext     = make(cmn.BackendConfExt, len(plugins)+1)
+		bconf   = &cmn.BackendConf{Conf: make(map[string]any, len(from.Conf)+1)}
+	)
+	for k, v := range from.Conf {
+		bconf.Conf[k] = v
+	}
+	for k, v := range plugins {
+		ext[k] = v
+	}
+	if pc == nil {
+		if _, ok := ext[name]; !ok {
+			return nil, fmt.Errorf("backend plugin %q does not exist", name)
+		}
+		delete(ext, name)
+	} else {
+		ext[name] = *pc
+	}
+	if len(ext) == 0 {
+		delete(bconf.Conf, apc.Ext)
+	} else {
+		bconf.Conf[apc.Ext] = ext
+	}
+	return bconf, bconf.Validate()
+}
+
 func downloadAllLogs(c *cli.Context) error {
 	sev, err := parseLogSev(c)
 	if err != nil {
@@ -695,6 +695,10 @@ func rebalanceCompletions(c *cli.Context) {
 //
 
 func downloadIDFinishedCompletions(c *cli.Context) { suggestDownloadID(c, (*dload.Job).JobFinished, 0) }
+func downloadIDRunningCompletions(c *cli.Context)  { suggestDownloadID(c, (*dload.Job).JobRunning, 0) }
+func downloadIDAllCompletions(c *cli.Context) {
+	suggestDownloadID(c, func(*dload.Job) bool { return true }, 0)
+}
 
 func suggestDownloadID(c *cli.Context, filter func(*dload.Job) bool, shift int) {
 	if c.NArg() > shift {
@@ -861,14 +865,45 @@ func suggestRemote(_ *cli.Context) {
 	}
 }
 
+func suggestBackendPlugins(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	config, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return
+	}
+	for name := range config.Backend.Ext() {
+		fmt.Println(name)
+	}
+}
+
 func cliPropCompletions(c *cli.Context) {
-	err := cmn.IterFields(cfg, func(tag string, _ cmn.IterField) (error, bool) {
-		if !cos.AnyHasPrefixInSlice(tag, c.Args()) {
-			fmt.Println(tag)
+	keys := cliCfgKeys(cfg)
+	// values, e.g.: 'ais config cli set no_color <TAB-TAB>'
+	switch keys[argLast(c)] {
+	case cliCfgBool:
+		fmt.Println("true")
+		fmt.Println("false")
+		return
+	case cliCfgProvider:
+		providers := apc.Providers.ToSlice()
+		sort.Strings(providers)
+		for _, p := range providers {
+			fmt.Println(p)
 		}
-		return nil, false
-	})
-	debug.AssertNoErr(err)
+		return
+	}
+	names := make([]string, 0, len(keys))
+	for k := range keys {
+		if !cos.AnyHasPrefixInSlice(k, c.Args()) {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		fmt.Println(k)
+	}
 }
 
 func suggestMpathEnable(c *cli.Context) { _suggestMpath(c, cmdMpathEnable) }
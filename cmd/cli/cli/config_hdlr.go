@@ -453,33 +453,86 @@ func setCfgCLI(c *cli.Context) (err error) {
 		return missingKeyValueError(c)
 	}
 
-	var nvs cos.StrKVs
+	var (
+		nvs   cos.StrKVs
+		keys  = cliCfgKeys(cfg)
+		views bool
+	)
 	if nvs, err = makePairs(c.Args()); err != nil {
 		if _, ok := err.(*errInvalidNVpair); ok {
+			if key := c.Args().Get(0); !isCliCfgKeyOrSection(keys, key) {
+				return unknownCliCfgKey(keys, key)
+			}
 			return showCfgCLI(c)
 		}
 		return err
 	}
 
+	// validate all keys and values prior to making any changes
+	for k, v := range nvs {
+		if strings.HasPrefix(k, viewsPrefix) {
+			views = true
+			continue
+		}
+		if err := validateCliCfgKV(keys, k, v); err != nil {
+			return err
+		}
+	}
+
 	flatOld := flattenJSON(cfg, "")
 	for k, v := range nvs {
+		// named views (map): 'views.NAME=PROPS' to add or update, 'views.NAME=' to remove
+		if name, ok := strings.CutPrefix(k, viewsPrefix); ok {
+			setCfgView(c, name, v)
+			continue
+		}
 		if err := cmn.UpdateFieldValue(cfg, k, v); err != nil {
 			return err
 		}
 	}
+	if err := validateCliCfg(cfg); err != nil {
+		return fmt.Errorf("CLI config not changed: %v", err)
+	}
 
 	flatNew := flattenJSON(cfg, "")
 	diff := diffConfigs(flatNew, flatOld)
+	var changed int
 	for _, val := range diff {
-		if val.Old == "-" {
-			continue
+		if val.Old == "-" || strings.HasPrefix(val.Name+".", viewsPrefix) {
+			continue // (views: reported by setCfgView)
 		}
 		fmt.Fprintf(c.App.Writer, "%q set to: %q (was: %q)\n", val.Name, val.Current, val.Old)
+		changed++
+	}
+	if changed == 0 && !views {
+		fmt.Fprintln(c.App.Writer, "CLI config: nothing to change")
 	}
 
 	return config.Save(cfg)
 }
 
+const viewsPrefix = "views."
+
+func setCfgView(c *cli.Context, name, props string) {
+	if props == "" {
+		if _, ok := cfg.Views[name]; ok {
+			delete(cfg.Views, name)
+			fmt.Fprintf(c.App.Writer, "view %q removed\n", name)
+		}
+		return
+	}
+	if cfg.Views == nil {
+		cfg.Views = make(config.ViewsConfig, 4)
+	}
+	props = strings.Join(splitCsv(props), apc.LsPropsSepa)
+	if old, ok := cfg.Views[name]; ok {
+		fmt.Fprintf(c.App.Writer, "view %q set to: %q (was: %q)\n", name, props, old)
+	} else {
+		fmt.Fprintf(c.App.Writer, "view %q set to: %q\n", name, props)
+	}
+	cfg.Views[name] = props
+}
+
 func resetCfgCLI(c *cli.Context) (err error) {
 	if err = config.Reset(); err == nil {
 		actionDone(c, "CLI config successfully reset to all defaults")
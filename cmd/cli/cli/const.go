@@ -5,10 +5,12 @@
 package cli
 
 import (
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/filter"
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/urfave/cli"
 )
@@ -24,6 +26,7 @@ const (
 	commandBucket   = "bucket"
 	commandCluster  = "cluster"
 	commandConfig   = "config"
+	commandHealth   = "health"
 	commandETL      = apc.ETL
 	commandJob      = "job"
 	commandLog      = "log"
@@ -57,18 +60,21 @@ const advancedUsageOnly = "(caution: advanced usage only)"
 // - 3rd level subcommands
 const (
 	commandCat       = "cat"
+	commandChecksum  = "checksum"
 	commandConcat    = "concat"
 	commandCopy      = "cp"
 	commandCreate    = "create"
 	commandGet       = "get"
 	commandList      = "ls"
 	commandSetCustom = "set-custom"
+	commandPresign   = apc.ActPresign
 	commandPut       = "put"
 	commandRemove    = "rm"
 	commandRename    = "mv"
 	commandSet       = "set"
 	commandStart     = apc.ActXactStart
 	commandStop      = apc.ActXactStop
+	commandVerify    = "verify"
 	commandWait      = "wait"
 
 	cmdSmap   = apc.WhatSmap
@@ -94,9 +100,13 @@ const (
 	cmdDownload     = apc.ActDownload // download
 	cmdDsort        = apc.ActDsort
 	cmdRebalance    = apc.ActRebalance
+	cmdRebVerify    = apc.ActRebVerify
 	cmdLRU          = apc.ActLRU
+	cmdFreeze       = "freeze"
+	cmdUnfreeze     = "unfreeze"
 	cmdStgCleanup   = "cleanup" // display name for apc.ActStoreCleanup
 	cmdScrub        = "validate"
+	cmdECDomains    = "ec-domains"
 	cmdSummary      = "summary" // ditto apc.ActSummaryBck
 
 	cmdCluster    = commandCluster
@@ -113,7 +123,17 @@ const (
 
 	cmdReloadCreds = "reload-backend-creds"
 
+	// external backend plugins
+	cmdAddBackend    = "add-backend"
+	cmdRemoveBackend = "remove-backend"
+
 	cmdDownloadLogs = "download-logs"
+	cmdDescribe     = "describe"
+	cmdBoost        = "boost"
+	cmdPause        = "pause"
+	cmdResume       = "resume"
+	cmdChain        = "chain"
+	cmdChainSubmit  = "submit"
 	cmdViewLogs     = "view-logs" // etl
 
 	// Cluster subcommands
@@ -145,16 +165,20 @@ const (
 	cmdStopMaint           = "stop-maintenance"
 	cmdNodeDecommission    = "decommission"
 	cmdClusterDecommission = "decommission"
+	cmdMembershipPlan      = "plan"
 
 	// Show subcommands (not all)
 	cmdShowRemoteAIS  = "remote-cluster"
+	cmdRemHealth      = "health"
 	cmdShowStats      = "stats"
 	cmdMountpath      = "mountpath"
 	cmdCapacity       = "capacity"
+	cmdContent        = "content"
 	cmdShowDisk       = "disk"
 	cmdShowCounters   = "counters"
 	cmdShowThroughput = "throughput"
 	cmdShowLatency    = "latency"
+	cmdPerfExport     = "export"
 
 	// Bucket properties subcommands
 	cmdSetBprops   = "set"
@@ -238,6 +262,7 @@ const (
 	// Job IDs (download, dsort)
 	jobIDArgument                 = "JOB_ID"
 	optionalJobIDArgument         = "[JOB_ID]"
+	chainSpecArgument             = "CHAIN_SPEC_FILE"
 	optionalJobIDDaemonIDArgument = "[JOB_ID [NODE_ID]]"
 
 	jobAnyArg                = "[NAME] [JOB_ID] [NODE_ID] [BUCKET]"
@@ -264,6 +289,7 @@ const (
 	bucketObjectOrTemplateMultiArg = "BUCKET[/OBJECT_NAME_or_TEMPLATE] [BUCKET[/OBJECT_NAME_or_TEMPLATE] ...]"
 
 	bucketEmbeddedPrefixArg = "[BUCKET[/PREFIX]]"
+	bucketPrefixArgument    = "BUCKET[/PREFIX]"
 
 	bucketSrcArgument       = "SRC_BUCKET"
 	bucketObjectSrcArgument = "SRC_BUCKET[/OBJECT_NAME_or_TEMPLATE]"
@@ -284,6 +310,7 @@ const (
 	promoteObjectArgument  = "FILE|DIRECTORY[/PATTERN] " + optionalPrefixArgument
 
 	shardArgument         = "BUCKET/SHARD_NAME"
+	mkShardsArgument      = "SRC_BUCKET[/PREFIX] DST_BUCKET[/SHARD_PREFIX]"
 	optionalShardArgument = "BUCKET[/SHARD_NAME]"
 	putApndArchArgument   = "[-|FILE|DIRECTORY[/PATTERN]] " + shardArgument
 	getShardArgument      = optionalShardArgument + " [OUT_FILE|OUT_DIR|-]"
@@ -303,6 +330,8 @@ const (
 	showLogArgument = nodeIDArgument
 	getLogArgument  = nodeIDArgument + " [OUT_FILE|OUT_DIR|-]"
 
+	perfExportArgument = "[OUT_FILE|-]"
+
 	// cluster
 	showClusterArgument = "[NODE_ID] | [target [NODE_ID]] | [proxy [NODE_ID]] | \n" +
 		"                     [smap [NODE_ID]] | [bmd [NODE_ID]] | [config [NODE_ID]] | [stats [NODE_ID]]"
@@ -321,6 +350,10 @@ const (
 	startDownloadArgument = "SOURCE DESTINATION"
 	showStatsArgument     = "[NODE_ID]"
 
+	// external backend plugins
+	addBackendArgument    = "PLUGIN_NAME URL"
+	removeBackendArgument = "PLUGIN_NAME"
+
 	// backend enable/disable
 	cloudProviderArg = "CLOUD_PROVIDER"
 
@@ -367,8 +400,23 @@ var (
 	//
 	// scope 'all'
 	//
-	allPropsFlag        = cli.BoolFlag{Name: scopeAll, Usage: "all object properties including custom (user-defined)"}
-	allJobsFlag         = cli.BoolFlag{Name: scopeAll, Usage: "all jobs, including finished and aborted"}
+	allPropsFlag = cli.BoolFlag{Name: scopeAll, Usage: "all object properties including custom (user-defined)"}
+	allJobsFlag  = cli.BoolFlag{Name: scopeAll, Usage: "all jobs, including finished and aborted"}
+
+	// post-rebalance verification
+	rebVerifyFlag = cli.BoolFlag{
+		Name:  "verify",
+		Usage: "show post-rebalance verification report (see 'ais start " + cmdRebVerify + " --help')",
+	}
+	rebVerifySampleFlag = cli.BoolFlag{
+		Name:  "sample",
+		Usage: "verify a (1%) sample of all objects rather than each and every object",
+	}
+	// on-demand integrity audit
+	cksumRepairFlag = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "repair corrupted objects from remote backend, local replicas (n-way mirror), or EC slices",
+	}
 	allRunningJobsFlag  = cli.BoolFlag{Name: scopeAll, Usage: "all running jobs"}
 	allFinishedJobsFlag = cli.BoolFlag{Name: scopeAll, Usage: "all finished jobs"}
 	rmrfFlag            = cli.BoolFlag{Name: scopeAll, Usage: "remove all objects (use it with extreme caution!)"}
@@ -398,6 +446,14 @@ var (
 			indent4 + "\t--props \"ec, copies, custom, location\"",
 	}
 
+	viewFlag = cli.StringFlag{
+		Name: "view",
+		Usage: "named set of columns (object properties) defined in the CLI config, e.g.:\n" +
+			indent4 + "\t'ais config cli set views.ops=name,size,version,cached' - to define the view, and then\n" +
+			indent4 + "\t'--view ops' - to use it (instead of '--props name,size,version,cached');\n" +
+			indent4 + "\tsee also: 'ais config cli show views'",
+	}
+
 	// prefix (to match)
 	listObjPrefixFlag = cli.StringFlag{
 		Name: "prefix",
@@ -441,6 +497,29 @@ var (
 	}
 	longRunFlags = []cli.Flag{refreshFlag, countFlag}
 
+	// 'show job --sort ... --top N'
+	jobSortFlag = cli.StringFlag{
+		Name: "sort",
+		Usage: "show one line per job (all kinds), sorted by:\n" +
+			indent4 + "\t'cpu'   - total running time summed across all targets, longest first (approximates resource usage);\n" +
+			indent4 + "\t'bytes' - bytes processed locally, sent, and received (all targets), heaviest first;\n" +
+			indent4 + "\t'age'   - start time, oldest first (default)",
+	}
+	jobTopFlag = cli.IntFlag{
+		Name:  "top",
+		Usage: "show only the first N jobs (see " + qflprn(jobSortFlag) + ")",
+	}
+	jobUserFlag = cli.StringFlag{
+		Name:  "user",
+		Usage: "show only jobs started by a given user (AuthN user ID)",
+	}
+	jobTimelineFlag = cli.BoolFlag{
+		Name: "timeline",
+		Usage: "show how the job's throughput evolved over time, per target (requires job ID), e.g.:\n" +
+			indent4 + "\t'ais show job tco-xnGb9Ut5k --timeline'\n" +
+			indent4 + "\t(samples are taken every 10s; retained with finished jobs for post-mortem analysis)",
+	}
+
 	//
 	// regex and friends
 	//
@@ -465,10 +544,64 @@ var (
 		Usage: "regular expression to select jobs by name, kind, or description, e.g.: --regex \"ec|mirror|elect\"",
 	}
 
+	// `ais job stop --all` filters
+	jobKindFlag = cli.StringFlag{
+		Name:  "kind",
+		Usage: "select jobs by kind (or name), e.g.: --kind copy-bucket (see 'ais show job --help' for supported kinds)",
+	}
+	jobBucketFlag = cli.StringFlag{
+		Name:  "bucket",
+		Usage: "select jobs that operate on the specified bucket (including source and destination buckets), e.g.: --bucket ais://abc",
+	}
+	jobOlderThanFlag = DurationFlag{
+		Name: "older-than",
+		Usage: "select only those jobs that have been running longer than the specified duration, e.g.:\n" +
+			indent4 + "\t'--older-than 2h' - jobs started more than 2 hours ago;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+
+	// `ais show cluster smap`
+	smapElectionsFlag = cli.BoolFlag{
+		Name:  "elections",
+		Usage: "show recent primary elections and declined election triggers, as seen by the primary (or the specified node)",
+	}
+	metaHistFlag = cli.BoolFlag{
+		Name:  "history",
+		Usage: "show recent versions: when installed, by which action, and by whom - as seen by the primary (or the specified node)",
+	}
+	metaDiffFlag = cli.BoolFlag{
+		Name: "diff",
+		Usage: "show what changed between two recent versions, e.g.:\n" +
+			indent4 + "\t--diff 12 15\t- compare versions 12 and 15 (see '--history' for available versions)",
+	}
+
 	jsonFlag     = cli.BoolFlag{Name: "json,j", Usage: "json input/output"}
 	noHeaderFlag = cli.BoolFlag{Name: "no-headers,H", Usage: "display tables without headers"}
 	noFooterFlag = cli.BoolFlag{Name: "no-footers,F", Usage: "display tables without footers"}
 
+	// `ais cluster add-remove-nodes plan`
+	planAddFlag = cli.StringFlag{
+		Name:  "add",
+		Usage: "comma-separated list of IDs of the targets to be added (joined)",
+	}
+	planRemoveFlag = cli.StringFlag{
+		Name:  "remove",
+		Usage: "comma-separated list of IDs of the targets to be removed (decommissioned, shut down, or put in maintenance)",
+	}
+
+	// `ais health`
+	healthStrictFlag = cli.BoolFlag{
+		Name:  "strict",
+		Usage: "exit with non-zero status on warnings as well (e.g., low capacity, rebalancing, high error rate)",
+	}
+
+	cfgExplainFlag = cli.BoolFlag{
+		Name: "explain",
+		Usage: "describe a given cluster config key: description, type, valid values, default,\n" +
+			indent4 + "\tcurrent cluster value, and per-node overrides, if any; e.g.:\n" +
+			indent4 + "\t'ais show config cluster timeout.max_keepalive --explain'",
+	}
+
 	progressFlag = cli.BoolFlag{Name: "progress", Usage: "show progress bar(s) and progress of execution in real time"}
 	dryRunFlag   = cli.BoolFlag{Name: "dry-run", Usage: "preview the results without really running the action"}
 
@@ -484,6 +617,16 @@ var (
 
 	averageSizeFlag = cli.BoolFlag{Name: "average-size", Usage: "show average GET, PUT, etc. request size"}
 
+	deltaFlag = cli.BoolFlag{
+		Name: "delta",
+		Usage: "show rate of change (per second) computed between consecutive samples instead of cumulative counters;\n" +
+			indent4 + "\tuse together with " + qflprn(refreshFlag) + " to monitor, e.g.: '--delta --refresh 10s'",
+	}
+	cumulativeFlag = cli.BoolFlag{
+		Name:  "cumulative",
+		Usage: "when used with " + qflprn(deltaFlag) + ": show both cumulative counters and their rates of change",
+	}
+
 	ignoreErrorFlag = cli.BoolFlag{
 		Name:  "ignore-error",
 		Usage: "ignore \"soft\" failures such as \"bucket already exists\", etc.",
@@ -516,6 +659,12 @@ var (
 		Name:  "large-size",
 		Usage: "count and report all objects that are larger or equal in size  (e.g.: 4mb, 1MiB, 1048576, 128k; default: 5 GiB)",
 	}
+	scrubFixFlag = cli.BoolFlag{
+		Name: "fix",
+		Usage: "repair problems found: rebalance or resilver misplaced objects, restore missing copies (n-way mirror),\n" +
+			indent4 + "\tand recover erasure-coded buckets; print a summary of repair jobs started;\n" +
+			indent4 + "\tcluster-wide rebalance (if any) runs to completion before resilver; use '--yes' to skip confirmation",
+	}
 
 	// units enum { unitsIEC, unitsSI, unitsRaw }
 	unitsFlag = cli.StringFlag{
@@ -585,10 +734,12 @@ var (
 
 	useInventoryFlag = cli.BoolFlag{
 		Name: "inventory",
-		Usage: "list objects using _bucket inventory_ (docs/s3inventory.md); requires s3:// backend; will provide significant performance\n" +
+		Usage: "list objects using _bucket inventory_ (docs/s3inventory.md); will provide significant performance\n" +
 			indent4 + "\tboost when used with very large s3 buckets; e.g. usage:\n" +
 			indent4 + "\t  1) 'ais ls s3://abc --inventory'\n" +
 			indent4 + "\t  2) 'ais ls s3://abc --inventory --paged --prefix=subdir/'\n" +
+			indent4 + "\tbuckets other than s3:// are listed using AIS-native inventory, e.g.:\n" +
+			indent4 + "\t  3) 'ais job start make-inventory ais://abc --wait; ais ls ais://abc --inventory'\n" +
 			indent4 + "\t(see also: docs/s3inventory.md)",
 	}
 	invNameFlag = cli.StringFlag{
@@ -596,12 +747,43 @@ var (
 		Usage: "bucket inventory name (optional; system default name is '.inventory')",
 	}
 	invIDFlag = cli.StringFlag{
-		Name:  "inv-id", // cpmpare w/ HdrInvID
-		Usage: "bucket inventory ID (optional; by default, we use bucket name as the bucket's inventory ID)",
+		Name: "inv-id", // cpmpare w/ HdrInvID
+		Usage: "bucket inventory ID (optional; by default, we use bucket name as the bucket's inventory ID);\n" +
+			indent4 + "\tAIS-native inventory: x-make-inventory job ID (by default, the most recent inventory)",
+	}
+
+	diffInventoryFlag = cli.BoolFlag{
+		Name: "diff-inventory",
+		Usage: "compare current bucket contents with the most recent (or '--inv-id' specified) bucket inventory\n" +
+			indent4 + "\tand show objects created, deleted, or changed since the inventory was generated, e.g.:\n" +
+			indent4 + "\t  1) 'ais ls s3://abc --diff-inventory'\t- S3 bucket vs its (latest) S3 inventory;\n" +
+			indent4 + "\t  2) 'ais ls ais://abc --diff-inventory --prefix images/'\t- AIS-native inventory;\n" +
+			indent4 + "\t  3) 'ais ls gs://abc --diff-inventory --cached'\t- in-cluster objects vs AIS-native inventory",
 	}
 
 	keepMDFlag = cli.BoolFlag{Name: "keep-md", Usage: "keep bucket metadata"}
 
+	// evict: select (in-cluster) objects by access time and size
+	evictOlderThanFlag = DurationFlag{
+		Name: "older-than",
+		Usage: "evict only those objects that were not accessed during the specified time, e.g.:\n" +
+			indent4 + "\t'--older-than 72h' - not accessed during the last 3 days;\n" +
+			indent4 + "\tvalid time units: " + timeUnits + ";\n" +
+			indent4 + "\tnote that selecting objects (by age or size) implies " + qflprn(keepMDFlag),
+	}
+	evictOffloadFlag = cli.BoolFlag{
+		Name: "offload",
+		Usage: "tier (offload) in-cluster objects to the bucket's remote backend, and evict them;\n" +
+			indent4 + "\tobjects that do not exist remotely get uploaded first (never overwriting remote objects that differ);\n" +
+			indent4 + "\tsubsequent GET transparently restores evicted object; can be combined with '--older-than' and '--min-size'",
+	}
+	evictMinSizeFlag = cli.StringFlag{
+		Name: "min-size",
+		Usage: "evict only those objects that are at least (in size) as large as specified, e.g.:\n" +
+			indent4 + "\t'--min-size 1GiB' (or same: '--min-size 1gb');\n" +
+			indent4 + "\tnote that selecting objects (by age or size) implies " + qflprn(keepMDFlag),
+	}
+
 	copiesFlag = cli.IntFlag{Name: "copies", Usage: "number of object replicas", Value: 1, Required: true}
 
 	dataSlicesFlag   = cli.IntFlag{Name: "data-slices,d", Value: 2, Usage: "number of data slices"}
@@ -625,6 +807,12 @@ var (
 		Usage: "log severity is either 'i' or 'info' (default, can be omitted), or 'error', whereby error logs contain\n" +
 			indent4 + "\tonly errors and warnings, e.g.: '--severity info', '--severity error', '--severity e'",
 	}
+	logReqIDFlag = cli.StringFlag{
+		Name: "request-id",
+		Usage: "show only log records that contain a given request ID (see response header '" + apc.HdrRequestID + "'), e.g.:\n" +
+			indent4 + "\t--request-id Xy3kLpQm\t- search the current log of the specified node;\n" +
+			indent4 + "\t'ais log show cluster --request-id Xy3kLpQm'\t- search the current logs of all nodes in the cluster",
+	}
 	logFlushFlag = DurationFlag{
 		Name:  "log-flush",
 		Usage: "can be used in combination with " + qflprn(refreshFlag) + " to override configured '" + nodeLogFlushName + "'",
@@ -657,6 +845,18 @@ var (
 			indent4 + "\tthe value is parsed in accordance with the '--units' (see '--units' for details);\n" +
 			indent4 + "\tomitting the flag or (same) specifying '--limit-bph 0' means that download won't be throttled",
 	}
+	dloadPriorityFlag = cli.IntFlag{
+		Name: "priority",
+		Usage: "relative share of the cluster-wide download bandwidth ('downloader.max_bandwidth')\n" +
+			indent4 + "\twhen competing with other download jobs, e.g.:\n" +
+			indent4 + "\t'--priority 3' - get 3 times the bandwidth of a (default) priority 1 job;\n" +
+			indent4 + "\tvalid range: [1, 100]; see also: '--limit-bph'",
+	}
+	dloadManifestFlag = cli.BoolFlag{
+		Name: "manifest",
+		Usage: "upon completion, store integrity manifest (name, size, checksum, and source of each downloaded object)\n" +
+			indent4 + "\tin the destination bucket, one part per target: " + dload.ManifestPrefix + "JOB_ID/TARGET_ID.json",
+	}
 	objectsListFlag = cli.StringFlag{
 		Name:  "object-list,from",
 		Usage: "path to file containing JSON array of object names to download",
@@ -672,6 +872,35 @@ var (
 			indent1 + "\t- the latter can be done using 'ais bucket props set BUCKET versioning'\n" +
 			indent1 + "\t- see also: 'ais ls --check-versions', 'ais cp', 'ais prefetch', 'ais get'",
 	}
+	dloadSpecFlag = cli.StringFlag{
+		Name: "from-spec",
+		Usage: "start download job from JSON specification, e.g., previously exported via 'ais job describe download JOB_ID';\n" +
+			indent4 + "\tuse '-' to read the specification from standard input",
+	}
+	// download filters (evaluated prior to creating download tasks)
+	dloadExtFlag = cli.StringFlag{
+		Name:  "ext",
+		Usage: "comma-separated list of file extensions to download, e.g.: '--ext jpg,png,tar.gz'",
+	}
+	dloadRegexFlag = cli.StringFlag{
+		Name:  regexFlag.Name,
+		Usage: "download only those files (destination object names) that match the regular expression",
+	}
+	dloadMinSizeFlag = cli.StringFlag{
+		Name:  "min-size",
+		Usage: "skip files smaller than specified, e.g.: '--min-size 1KiB'",
+	}
+	dloadMaxSizeFlag = cli.StringFlag{
+		Name: "max-size",
+		Usage: "skip files larger than specified, e.g.: '--max-size 10MiB';\n" +
+			indent4 + "\tapplies at listing time (bucket and Hugging Face downloads) or when the download starts (Content-Length)",
+	}
+	hfTokenFlag = cli.StringFlag{
+		Name: "hf-token",
+		Usage: "Hugging Face access token for gated and private repositories, e.g.:\n" +
+			indent1 + "\t'ais download hf://datasets/ORG/NAME ais://nnn --hf-token=hf_xyz'\n" +
+			indent1 + "\t(if omitted, aistore targets will use their respective HF_TOKEN environment)",
+	}
 	syncFlag = cli.BoolFlag{
 		Name: "sync",
 		Usage: "synchronize destination bucket with its remote (e.g., Cloud or remote AIS) source;\n" +
@@ -696,6 +925,12 @@ var (
 	dsortLogFlag  = cli.StringFlag{Name: "log", Usage: "filename to log metrics (statistics)"}
 	dsortSpecFlag = cli.StringFlag{Name: "file,f", Value: "", Usage: "path to JSON or YAML job specification"}
 
+	dsortEstimateFlag = cli.BoolFlag{
+		Name: "estimate",
+		Usage: "extract metadata only, and estimate output shards and per-target memory and disk requirements\n" +
+			indent1 + "\t(creates no shards; when finished, see 'ais show job JOB_ID')",
+	}
+
 	cleanupFlag = cli.BoolFlag{
 		Name:  "cleanup",
 		Usage: "remove old bucket and create it again (warning: removes the entire content of the old bucket)",
@@ -707,6 +942,11 @@ var (
 		Usage: "maximum time to wait for a job to finish; if omitted: wait forever or until Ctrl-C;\n" +
 			indent4 + "\tvalid time units: " + timeUnits,
 	}
+	backendPluginTimeoutFlag = DurationFlag{
+		Name: waitJobXactFinishedFlag.Name,
+		Usage: "plugin request timeout (does not apply to object reads and writes); if omitted: client.client_timeout;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
 	waitPodReadyTimeoutFlag = DurationFlag{
 		Name: waitJobXactFinishedFlag.Name,
 		Usage: "ais target waiting time for POD to become ready;\n" +
@@ -761,6 +1001,18 @@ var (
 		Name:  "length",
 		Usage: "object read length; default formatting: IEC (use '--units' to override)",
 	}
+	catRangeFlag = cli.StringFlag{
+		Name: "range",
+		Usage: "comma-separated list of byte ranges to read and print, in the order specified, e.g.:\n" +
+			indent4 + "\t'--range 0-99,1000-1099' - first 100 bytes followed by 100 bytes at offset 1000;\n" +
+			indent4 + "\t'--range 1000-' - from offset 1000 to the end of the object;\n" +
+			indent4 + "\t'--range -100' - last 100 bytes",
+	}
+	followFlag = cli.BoolFlag{
+		Name: "follow",
+		Usage: "keep reading and printing new bytes appended to the object (compare with 'tail -f');\n" +
+			indent4 + "\tpoll for object size growth at " + qflprn(refreshFlag) + " intervals (default: 5s)",
+	}
 
 	// NOTE:
 	// In many cases, stating that a given object "is present" will sound more appropriate and,
@@ -878,6 +1130,11 @@ var (
 		Value: 10,
 		Usage: "limits the number of shards created concurrently",
 	}
+	numSetCustomWorkersFlag = cli.IntFlag{
+		Name:  numBlobWorkersFlag.Name,
+		Value: 10,
+		Usage: "number of concurrent client-side workers (to update custom properties with " + qflprn(setCustomFromFlag) + ")",
+	}
 	numPutWorkersFlag = cli.IntFlag{
 		Name:  numBlobWorkersFlag.Name,
 		Value: 10,
@@ -900,7 +1157,8 @@ var (
 	putRetriesFlag = cli.IntFlag{
 		Name:  "retries",
 		Value: 1,
-		Usage: "when failing to PUT retry the operation up to so many times (with increasing timeout if timed out)",
+		Usage: "when failing to PUT retry the operation up to so many times (with increasing timeout if timed out);\n" +
+			indent4 + "\twith '--chunk-size' (single file or standard input): retry and retransmit only the failed chunk",
 	}
 
 	appendConcatFlag = cli.BoolFlag{
@@ -914,10 +1172,37 @@ var (
 	}
 
 	// auth
-	descRoleFlag      = cli.StringFlag{Name: "description,desc", Usage: "role description"}
-	clusterRoleFlag   = cli.StringFlag{Name: "cluster", Usage: "associate role with the specified AIS cluster"}
-	clusterTokenFlag  = cli.StringFlag{Name: "cluster", Usage: "issue token for the cluster"}
-	bucketRoleFlag    = cli.StringFlag{Name: "bucket", Usage: "associate a role with the specified bucket"}
+	descRoleFlag     = cli.StringFlag{Name: "description,desc", Usage: "role description"}
+	clusterRoleFlag  = cli.StringFlag{Name: "cluster", Usage: "associate role with the specified AIS cluster"}
+	clusterTokenFlag = cli.StringFlag{Name: "cluster", Usage: "issue token for the cluster"}
+	bucketRoleFlag   = cli.StringFlag{Name: "bucket", Usage: "associate a role with the specified bucket"}
+	extendsRoleFlag  = cli.StringFlag{
+		Name: "extends",
+		Usage: "comma-separated list of parent roles to inherit permissions from, e.g.:\n" +
+			indent4 + "\t--extends reader\t- role extends 'reader';\n" +
+			indent4 + "\t--extends reader,auditor\t- composite role (union of all parents' permissions)",
+	}
+	maxJobsRoleFlag = cli.IntFlag{
+		Name: "max-jobs",
+		Usage: "max number of jobs the role's users can have running at the same time, e.g.:\n" +
+			indent4 + "\t--max-jobs 4\t- at most 4 running jobs (with multiple roles, the largest limit wins);\n" +
+			indent4 + "\t--max-jobs -1\t- remove the limit (default: unlimited)",
+	}
+	maxSessionsRoleFlag = cli.IntFlag{
+		Name: "max-sessions",
+		Usage: "max number of concurrent login sessions (active tokens) per user; upon login, the oldest sessions\n" +
+			indent4 + "\tin excess are forcibly logged out, e.g.:\n" +
+			indent4 + "\t--max-sessions 2\t- at most 2 sessions (with multiple roles, the largest limit wins);\n" +
+			indent4 + "\t--max-sessions -1\t- remove the limit (default: unlimited)",
+	}
+	sessionsUserFlag = cli.BoolFlag{
+		Name:  "sessions",
+		Usage: "show user's active login sessions",
+	}
+	effectiveRoleFlag = cli.BoolFlag{
+		Name:  "effective",
+		Usage: "show effective permissions, including those inherited from parent roles",
+	}
 	clusterFilterFlag = cli.StringFlag{
 		Name:  "cluster",
 		Usage: "comma-separated list of AIS cluster IDs (type ',' for an empty cluster ID)",
@@ -930,11 +1215,30 @@ var (
 		Name:  "archpath",
 		Usage: "filename in an object (\"shard\") formatted as: " + archFormats,
 	}
+	getFilterFlag = cli.StringFlag{ // for apc.QparamFilter
+		Name: "filter",
+		Usage: "apply built-in server-side filter to the object's content, one of: " + strings.Join(filter.All(), ", ") + ";\n" +
+			indent4 + "\te.g.: '--filter gzip' (compress), '--filter img-thumb' (resize image to fit 128x128)",
+	}
 	archpathGetFlag = cli.StringFlag{ // for apc.QparamArchpath; GET from shard
 		Name: archpathFlag.Name,
 		Usage: "extract the specified file from an object (\"shard\") formatted as: " + archFormats + ";\n" +
 			indent4 + "\tsee also: '--archregx'",
 	}
+	archpathListFlag = cli.StringFlag{
+		Name: "archpath-list",
+		Usage: "extract multiple archived files in one shot, in parallel; the specified text file ('-' for standard input)\n" +
+			indent4 + "\tlists one archived file per line, as either 'SHARD ARCHPATH' or 'SHARD/ARCHPATH', e.g.:\n" +
+			indent4 + "\t  shard-001.tar images/001.jpg\n" +
+			indent4 + "\t  shard-002.tar/images/002.jpg\n" +
+			indent4 + "\twith '--archmode' (e.g. '--archmode wdskey'), ARCHPATH is a matching pattern (e.g. WebDataset key);\n" +
+			indent4 + "\tdestination (default: current directory) must be a directory; see also: '--manifest'",
+	}
+	archManifestFlag = cli.StringFlag{
+		Name:  "manifest",
+		Usage: "write JSON manifest of the extracted files (shard, archpath, destination, size) to the specified file ('-' for standard output)",
+	}
+
 	archmimeFlag = cli.StringFlag{ // for apc.QparamArchmime
 		Name: "archmime",
 		Usage: "expected format (mime type) of an object (\"shard\") formatted as: " + archFormats + ";\n" +
@@ -995,18 +1299,64 @@ var (
 		Name:  "cont-on-err",
 		Usage: "keep running archiving xaction (job) in presence of errors in a any given multi-object transaction",
 	}
+
+	// 'ais archive bucket': split output into multiple shards
+	archMaxShardSizeFlag = cli.StringFlag{
+		Name: "max-shard-size",
+		Usage: "split the output into multiple shards of (approximately) up to the specified size, e.g.:\n" +
+			indent4 + "	'ais archive bucket ais://src ais://dst/out.tar --prefix a/ --max-shard-size 1GiB'\n" +
+			indent4 + "	will produce ais://dst/out-000.tar, ais://dst/out-001.tar, etc.",
+	}
+	archMaxShardRecsFlag = cli.IntFlag{
+		Name:  "max-shard-recs",
+		Usage: "split the output into multiple shards containing up to the specified number of archived objects each",
+	}
 	// end archive
 
 	// AuthN
 	tokenFileFlag = cli.StringFlag{Name: "file,f", Value: "", Usage: "path to file"}
 	passwordFlag  = cli.StringFlag{Name: "password,p", Value: "", Usage: "user password"}
-	expireFlag    = DurationFlag{
+	ldapUserFlag  = cli.BoolFlag{
+		Name:  "ldap",
+		Usage: "directory (LDAP/Active Directory) user: password is validated via LDAP bind, roles include the ones mapped from directory groups",
+	}
+	expireFlag = DurationFlag{
 		Name: "expire,e",
 		Usage: "token expiration time, '0' - for never-expiring token;\n" +
 			indent4 + "\tvalid time units: " + timeUnits,
 		Value: 24 * time.Hour,
 	}
 
+	// presigned URL
+	presignExpireFlag = DurationFlag{
+		Name: "expire,e",
+		Usage: "URL expiration time (max 7 days; never exceeds the expiration of the user's token);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: time.Hour,
+	}
+	presignPutFlag = cli.BoolFlag{
+		Name:  "put",
+		Usage: "grant write (PUT) access - default: read-only (GET)",
+	}
+	presignPrefixFlag = cli.BoolFlag{
+		Name: "prefix-scope",
+		Usage: "treat OBJECT_NAME as a prefix: the URL grants access to all objects with this prefix\n" +
+			indent4 + "\t(append object name to the URL path when using it)",
+	}
+
+	// archive create: shard a prefix
+	shardSizeFlag = cli.StringFlag{
+		Name:  "shard-size",
+		Value: "1GiB",
+		Usage: "approximate size of the resulting shards (e.g.: 64MiB, 512mb, 1GiB); records (files that share\n" +
+			indent4 + "\tthe same WebDataset key, e.g. 'a/123.jpg' and 'a/123.cls') are never split between shards",
+	}
+	shardExtFlag = cli.StringFlag{
+		Name:  "shard-ext",
+		Value: ".tar",
+		Usage: "shard format (extension): " + archExts,
+	}
+
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
 		Name:  "dry-run",
@@ -1021,8 +1371,49 @@ var (
 			indent4 + "\t\t- during 'transform', this flag applies to transformed objects",
 	}
 
+	// server-side destination naming (apc.RenameMsg), in addition to (and prior to) '--prepend'
+	copyStripPrefixFlag = cli.StringFlag{
+		Name:  "strip-prefix",
+		Usage: "remove the specified prefix from destination object names, e.g. '--strip-prefix raw/': 'raw/a/b.txt' => 'a/b.txt'",
+	}
+	copyRenameRegexFlag = cli.StringFlag{
+		Name: "rename-regex",
+		Usage: "regular expression to match (parts of) source object names; used together with " + qflprn(copyRenameToFlag) + ", e.g.:\n" +
+			indent4 + "\t--rename-regex '\\.jpeg$' --rename-to '.jpg'\t- change extension;\n" +
+			indent4 + "\t--rename-regex '^(\\w+)-(\\d+)' --rename-to '${2}_$1'\t- reorder name parts (using submatches)",
+	}
+	copyRenameToFlag = cli.StringFlag{
+		Name:  "rename-to",
+		Usage: "replacement for the '--rename-regex' matches (may reference submatches: $1, ${name}, etc.)",
+	}
+	copyPadDigitsFlag = cli.IntFlag{
+		Name:  "pad-digits",
+		Usage: "zero-pad the last sequence of digits in destination object names, e.g. '--pad-digits 4': 'img-7.jpg' => 'img-0007.jpg'",
+	}
+	copySnapshotFlag = cli.BoolFlag{
+		Name: "snapshot",
+		Usage: "snapshot-consistent copy: copy only the objects (and their versions) that existed when the copy started;\n" +
+			indent1 + "\tobjects created or overwritten while copying are skipped (see 'snapshot.skipped.n' in 'ais show job --verbose')",
+	}
+
+	// job quota: copy, transform, and archive (apc.JobQuota)
+	jobMaxObjectsFlag = cli.IntFlag{
+		Name: "max-objects",
+		Usage: "stop after processing (approximately) the specified number of objects - e.g., to run on a sample;\n" +
+			indent1 + "\tthe job then finishes with partial results (see 'ais show job --verbose')",
+	}
+	jobMaxBytesFlag = cli.StringFlag{
+		Name: "max-bytes",
+		Usage: "stop after processing (approximately) the specified total size, e.g. '--max-bytes 10GiB';\n" +
+			indent1 + "\tthe job then finishes with partial results (see 'ais show job --verbose')",
+	}
+
 	// ETL
-	etlExtFlag  = cli.StringFlag{Name: "ext", Usage: "mapping from old to new extensions of transformed objects' names"}
+	etlExtFlag     = cli.StringFlag{Name: "ext", Usage: "mapping from old to new extensions of transformed objects' names"}
+	etlMetricsFlag = cli.BoolFlag{
+		Name:  "metrics",
+		Usage: "show per-target transformation counters: objects, size, average latency, and errors (inline and offline)",
+	}
 	etlNameFlag = cli.StringFlag{
 		Name:     "name",
 		Usage:    "unique ETL name (leaving this field empty will have unique ID auto-generated)",
@@ -1038,6 +1429,12 @@ var (
 		Usage:    "absolute path to the file with the spec/code for ETL",
 		Required: true,
 	}
+	etlHelmValuesFlag = cli.StringFlag{
+		Name: "from-helm-values",
+		Usage: "YAML (or JSON) file with values to render parameterized spec (see '--from-file'), e.g.:\n" +
+			indent4 + "\t--from-helm-values prod.yaml\t- where the spec references '{{ .Values.image }}' and/or '${image}';\n" +
+			indent4 + "\t(names in '${...}' that are not in the values file resolve to environment variables)",
+	}
 	depsFileFlag = cli.StringFlag{
 		Name:  "deps-file",
 		Usage: "absolute path to the file with dependencies that must be installed before running the code",
@@ -1108,6 +1505,13 @@ var (
 		Name:  "rm-user-data",
 		Usage: "remove all user data when decommissioning node from the cluster",
 	}
+	archiveToFlag = cli.StringFlag{
+		Name: "archive-to",
+		Usage: "prior to decommissioning, copy all ais:// buckets to the specified remote bucket, e.g.:\n" +
+			indent4 + "\t--archive-to s3://backup - copy ais://abc to s3://backup/<cluster UUID>/abc/, and so on;\n" +
+			indent4 + "\tthen verify the copies and store verification manifest as s3://backup/<cluster UUID>/manifest.json\n" +
+			indent4 + "\t(decommissioning won't start if any of the above fails)",
+	}
 	keepInitialConfigFlag = cli.BoolFlag{
 		Name: "keep-initial-config",
 		Usage: "keep the original plain-text configuration the node was deployed with\n" +
@@ -1123,6 +1527,13 @@ var (
 		Name:  "set-new-custom",
 		Usage: "remove existing custom keys (if any) and store new custom metadata",
 	}
+	setCustomFromFlag = cli.StringFlag{
+		Name: "from-csv",
+		Usage: "CSV or JSONL (.jsonl extension) file that maps object names to custom properties, e.g.:\n" +
+			indent4 + "\t- CSV: header 'name,key1,key2,...' followed by rows 'obj1,value1,value2,...' (empty values are skipped);\n" +
+			indent4 + "\t- JSONL: one '{\"name\": \"obj1\", \"custom\": {\"key1\": \"value1\"}}' per line;\n" +
+			indent4 + "\tuse '-' to read from standard input (CSV)",
+	}
 
 	cliConfigPathFlag = cli.BoolFlag{
 		Name:  "path",
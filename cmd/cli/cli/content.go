@@ -0,0 +1,138 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais show storage content`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/dsort/ct"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/urfave/cli"
+)
+
+// Used space broken down by content type, on a per-mountpath basis.
+// Content types are grouped into columns as follows:
+//   - objects:   objects and their (out-of-xattr) metadata;
+//   - workfiles: temporary files, e.g., objects that are being written;
+//   - EC:        erasure-coded slices and replicas, and their metadata;
+//   - dsort:     dsort intermediate files (spilled to disk).
+
+const (
+	ctColObj = iota
+	ctColWork
+	ctColEC
+	ctColDsort
+	ctColOther
+	ctColTotal
+	numCtCols
+)
+
+const showContentUsage = "show used space per content type (objects, workfiles, EC slices, dsort files) per mountpath, e.g.:\n" +
+	indent1 + "\t- 'ais show storage content'\t- all targets;\n" +
+	indent1 + "\t- 'ais show storage content t[abc]'\t- a given target.\n" +
+	indent1 + "(note: walks target directories - may take a while)"
+
+var showCmdContent = cli.Command{
+	Name:         cmdContent,
+	Usage:        showContentUsage,
+	ArgsUsage:    optionalTargetIDArgument,
+	Flags:        []cli.Flag{noHeaderFlag, unitsFlag, jsonFlag},
+	Action:       showContentHandler,
+	BashComplete: suggestTargets,
+}
+
+func showContentHandler(c *cli.Context) error {
+	var nodes []*meta.Snode
+	tsi, sname, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	if tsi != nil && tsi.IsProxy() {
+		return fmt.Errorf("node %s is a proxy (expecting target)", sname)
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	if tsi != nil {
+		nodes = []*meta.Snode{tsi}
+	} else {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return err
+		}
+		for _, tgt := range smap.Tmap {
+			nodes = append(nodes, tgt)
+		}
+		if len(nodes) == 0 {
+			return cmn.NewErrNoNodes(apc.Target, 0)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
+
+	all := make(map[string]apc.ContentUsage, len(nodes))
+	for _, node := range nodes {
+		cu, err := api.GetContentUsage(apiBP, node)
+		if err != nil {
+			return V(err)
+		}
+		all[node.ID()] = cu
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TARGET\t MOUNTPATH\t OBJECTS\t WORKFILES\t EC\t DSORT\t OTHER\t TOTAL")
+	}
+	for _, node := range nodes {
+		cu := all[node.ID()]
+		mpaths := make([]string, 0, len(cu))
+		for mpath := range cu {
+			mpaths = append(mpaths, mpath)
+		}
+		sort.Strings(mpaths)
+		for _, mpath := range mpaths {
+			cols := ctCols(cu[mpath])
+			fmt.Fprintf(tw, "%s\t %s", node.StringEx(), mpath)
+			for _, size := range cols {
+				fmt.Fprintf(tw, "\t %s", teb.FmtSize(int64(size), units, 2))
+			}
+			fmt.Fprintln(tw)
+		}
+	}
+	tw.Flush()
+	return nil
+}
+
+// group content types into columns (see above)
+func ctCols(cts map[string]uint64) (cols [numCtCols]uint64) {
+	for typ, size := range cts {
+		switch typ {
+		case fs.ObjectType, fs.ObjMDType:
+			cols[ctColObj] += size
+		case fs.WorkfileType:
+			cols[ctColWork] += size
+		case fs.ECSliceType, fs.ECMetaType:
+			cols[ctColEC] += size
+		case ct.DsortFileType, ct.DsortWorkfileType:
+			cols[ctColDsort] += size
+		default:
+			cols[ctColOther] += size
+		}
+		cols[ctColTotal] += size
+	}
+	return cols
+}
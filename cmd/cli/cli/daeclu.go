@@ -74,6 +74,11 @@ func cluDaeStatus(c *cli.Context, smap *meta.Smap, tstatusMap, pstatusMap teb.St
 	out := tableP.Template(false) + "\n"
 	out += tableT.Template(false) + "\n"
 
+	// active alerts, if any (see also: `ais health`)
+	if !usejs {
+		out += _cluAlerts(body.Stst.Pmap, body.Stst.Tmap)
+	}
+
 	// summary
 	title := fgreen("Summary:")
 	if isRebalancing(body.Stst.Tmap) {
@@ -0,0 +1,192 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles final data offload prior to decommissioning the entire cluster.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+// `ais cluster decommission --archive-to BUCKET`:
+// - copy each ais:// bucket to BUCKET/<cluster UUID>/<bucket name>/ (one bucket at a time);
+// - verify that each source object exists at the destination and has the same size;
+// - store verification manifest as BUCKET/<cluster UUID>/manifest.json;
+// - only then decommission the cluster
+
+const (
+	offloadManifestName = "manifest.json"
+	offloadMaxErrs      = 10 // mismatches to report
+)
+
+type (
+	offloadManifest struct {
+		Cluster string          `json:"cluster"` // UUID
+		Dest    string          `json:"destination"`
+		Created time.Time       `json:"created"`
+		Buckets []offloadBucket `json:"buckets"`
+	}
+	offloadBucket struct {
+		Bucket  string          `json:"bucket"`      // source
+		Prefix  string          `json:"dest_prefix"` // destination prefix
+		Count   int             `json:"count"`
+		Size    int64           `json:"size,string"`
+		Objects []offloadObject `json:"objects"`
+	}
+	offloadObject struct {
+		Name     string `json:"name"`
+		Size     int64  `json:"size,string"`
+		Checksum string `json:"checksum,omitempty"` // source checksum (type, value)
+	}
+)
+
+func offloadPrefix(uuid string, bck *cmn.Bck) string {
+	if bck.Ns.IsGlobal() {
+		return path.Join(uuid, bck.Name) + "/"
+	}
+	return path.Join(uuid, string(apc.NsNamePrefix)+bck.Ns.Name, bck.Name) + "/"
+}
+
+func archiveCluster(c *cli.Context, smap *meta.Smap, uri string) error {
+	dst, err := parseBckURI(c, uri, true /*error only*/)
+	if err != nil {
+		return err
+	}
+	if !dst.IsRemote() {
+		return fmt.Errorf("%s must be a remote bucket (e.g., s3://, gs://, ais://@remais): in-cluster data is about to be wiped out",
+			qflprn(archiveToFlag))
+	}
+	if _, err := headBucket(dst, false /* don't add */); err != nil {
+		return err
+	}
+
+	bcks, err := api.ListBuckets(apiBP, cmn.QueryBcks{Provider: apc.AIS}, apc.FltPresent)
+	if err != nil {
+		return V(err)
+	}
+	manifest := &offloadManifest{Cluster: smap.UUID, Dest: dst.Cname(""), Created: time.Now()}
+	for i := range bcks {
+		bck := bcks[i]
+		if bck.Ns.IsRemote() {
+			continue
+		}
+		ob, err := archiveBucket(c, bck, dst, offloadPrefix(smap.UUID, &bck))
+		if err != nil {
+			return err
+		}
+		manifest.Buckets = append(manifest.Buckets, *ob)
+	}
+
+	b, err := jsoniter.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        dst,
+		ObjName:    path.Join(smap.UUID, offloadManifestName),
+		Reader:     cos.NewByteHandle(b),
+		Size:       uint64(len(b)),
+	}
+	if _, err := api.PutObject(&putArgs); err != nil {
+		return fmt.Errorf("failed to store manifest %s: %v", dst.Cname(putArgs.ObjName), V(err))
+	}
+	fmt.Fprintf(c.App.Writer, "Archived %d bucket%s to %s (manifest: %s)\n", len(manifest.Buckets),
+		cos.Plural(len(manifest.Buckets)), dst.Cname(smap.UUID+"/"), dst.Cname(putArgs.ObjName))
+	return nil
+}
+
+func archiveBucket(c *cli.Context, bck, dst cmn.Bck, prefix string) (*offloadBucket, error) {
+	src, err := offloadList(bck, "", true /*cksum*/)
+	if err != nil {
+		return nil, err
+	}
+	if len(src) > 0 {
+		fmt.Fprintf(c.App.Writer, "Copying %s => %s ...", bck.Cname(""), dst.Cname(prefix))
+		msg := &apc.CopyBckMsg{Prepend: prefix}
+		xid, err := api.CopyBucket(apiBP, bck, dst, msg, apc.FltPresent)
+		if err != nil {
+			return nil, V(err)
+		}
+		if err := waitXact(&xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck}); err != nil {
+			fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, "copy", bck.Cname(""), dst.Cname(prefix))
+			return nil, err
+		}
+		actionDone(c, fmtXactSucceeded)
+	}
+	dstEntries, err := offloadList(dst, prefix, false)
+	if err != nil {
+		return nil, err
+	}
+	ob, err := verifyOffload(src, dstEntries, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify %s => %s: %v", bck.Cname(""), dst.Cname(prefix), err)
+	}
+	ob.Bucket = bck.Cname("")
+	fmt.Fprintf(c.App.Writer, "Verified %s: %d object%s, %s\n", bck.Cname(""), ob.Count, cos.Plural(ob.Count),
+		teb.FmtSize(ob.Size, "", 2))
+	return ob, nil
+}
+
+func offloadList(bck cmn.Bck, prefix string, cksum bool) (cmn.LsoEntries, error) {
+	props := apc.GetPropsNameSize
+	if cksum {
+		props += apc.LsPropsSepa + apc.GetPropsChecksum
+	}
+	msg := &apc.LsoMsg{Prefix: prefix, Props: props}
+	msg.SetFlag(apc.LsNoDirs)
+	lst, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{})
+	if err != nil {
+		return nil, V(err)
+	}
+	return lst.Entries, nil
+}
+
+// each source object must be present at the destination (as prefix + name) and have the same size
+func verifyOffload(src, dst cmn.LsoEntries, prefix string) (*offloadBucket, error) {
+	var (
+		sizes = make(map[string]int64, len(dst))
+		ob    = &offloadBucket{Prefix: prefix, Objects: make([]offloadObject, 0, len(src))}
+		errs  []string
+		nerr  int
+	)
+	for _, en := range dst {
+		sizes[en.Name] = en.Size
+	}
+	for _, en := range src {
+		size, ok := sizes[prefix+en.Name]
+		switch {
+		case !ok:
+			nerr++
+			if len(errs) < offloadMaxErrs {
+				errs = append(errs, fmt.Sprintf("%q: not found", prefix+en.Name))
+			}
+		case size != en.Size:
+			nerr++
+			if len(errs) < offloadMaxErrs {
+				errs = append(errs, fmt.Sprintf("%q: size %d, expected %d", prefix+en.Name, size, en.Size))
+			}
+		}
+		ob.Objects = append(ob.Objects, offloadObject{Name: en.Name, Size: en.Size, Checksum: en.Checksum})
+		ob.Size += en.Size
+	}
+	if nerr > 0 {
+		return nil, fmt.Errorf("%d mismatched object%s: %s", nerr, cos.Plural(nerr), strings.Join(errs, ", "))
+	}
+	ob.Count = len(ob.Objects)
+	return ob, nil
+}
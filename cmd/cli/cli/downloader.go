@@ -418,14 +418,20 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 	}
 
 	if d.JobFinished() {
-		var skipped, errs string
+		var skipped, resumed, errs string
 		if d.SkippedCnt > 0 {
 			skipped = fmt.Sprintf(", skipped: %d", d.SkippedCnt)
 		}
+		if d.FilteredCnt > 0 {
+			skipped += fmt.Sprintf(", filtered out: %d", d.FilteredCnt)
+		}
+		if d.ResumedCnt > 0 {
+			resumed = fmt.Sprintf(", resumed: %d (%s)", d.ResumedCnt, teb.FmtSize(d.ResumedBytes, "", 2))
+		}
 		if d.ErrorCnt > 0 {
 			errs = fmt.Sprintf(", error%s: %d", cos.Plural(d.ErrorCnt), d.ErrorCnt)
 		}
-		fmt.Fprintf(w, "Done: %d file%s downloaded%s%s\n", d.FinishedCnt, cos.Plural(d.FinishedCnt), skipped, errs)
+		fmt.Fprintf(w, "Done: %d file%s downloaded%s%s%s\n", d.FinishedCnt, cos.Plural(d.FinishedCnt), skipped, resumed, errs)
 
 		if len(d.Errs) == 0 {
 			debug.Assert(d.ErrorCnt == 0)
@@ -451,6 +457,9 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 	if totalCnt < minTotalCnt {
 		verbose = true
 	}
+	if d.Paused {
+		fmt.Fprintf(w, "Download %s is paused (to resume, run 'ais job resume download %s')\n", d.ID, d.ID)
+	}
 	if totalCnt == 0 {
 		fmt.Fprintf(w, "Download %s progress: 0/?\n", d.ID)
 	} else {
@@ -461,6 +470,9 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 		}
 		fmt.Fprintln(w, progressMsg)
 	}
+	if d.Bandwidth > 0 {
+		fmt.Fprintf(w, "Allocated bandwidth: %s/s (priority %d)\n", teb.FmtSize(d.Bandwidth, "", 2), max(d.Priority, 1))
+	}
 	if verbose {
 		if len(d.CurrentTasks) > 0 {
 			sort.Slice(d.CurrentTasks, func(i, j int) bool {
@@ -15,6 +15,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -23,6 +24,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/dsort"
 	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
@@ -95,6 +97,7 @@ var dsortStartCmd = cli.Command{
 		indent1 + "E.g. inline YAML spec:\n" +
 		indent4 + "\t  " + dsortExampleY + "\n" +
 		indent1 + "Tip: use '--dry-run' to see the results without making any changes\n" +
+		indent1 + "Tip: use '--estimate' to predict output shards and resource requirements (without running the job)\n" +
 		indent1 + "Tip: use '--verbose' to print the spec (with all its parameters including applied defaults)\n" +
 		indent1 + "See also: docs/dsort.md, docs/cli/dsort.md, and ais/test/scripts/dsort*",
 	ArgsUsage: dsortSpecArgument,
@@ -183,6 +186,10 @@ func startDsortHandler(c *cli.Context) (err error) {
 		spec.OutputBck = dstbck
 	}
 
+	if flagIsSet(c, dsortEstimateFlag) {
+		spec.Estimate = true
+	}
+
 	if flagIsSet(c, verboseFlag) {
 		flat, config := _flattenSpec(&spec)
 		if flagIsSet(c, noHeaderFlag) {
@@ -538,11 +545,15 @@ func printCondensedStats(c *cli.Context, id, units string, errhint bool) error {
 		sortingTime    time.Duration
 		creationTime   time.Duration
 		description    string
+		est            *dsort.Estimate
 		aborted        bool
 		finished       = true
 	)
 	for _, jmetrics := range resp {
 		tm := jmetrics.Metrics
+		if tm.Estimate != nil {
+			est = tm.Estimate
+		}
 		if description != "" {
 			if description != tm.Description {
 				fmt.Fprintf(c.App.ErrWriter, "dsort[%s] has two descriptions? (%q, %q)\n", id, description, tm.Description)
@@ -587,6 +598,9 @@ func printCondensedStats(c *cli.Context, id, units string, errhint bool) error {
 			indent1+"Longest creation:\t%v\n",
 			id, elapsedTime, extractionTime, sortingTime, creationTime,
 		)
+		if est != nil {
+			printDsortEstimate(c, est, units)
+		}
 	default:
 		fmt.Fprintf(c.App.Writer, "dsort[%s] is currently running:\n"+
 			id, indent1+"Extraction:\t%v", extractionTime)
@@ -603,6 +617,33 @@ func printCondensedStats(c *cli.Context, id, units string, errhint bool) error {
 	return nil
 }
 
+func printDsortEstimate(c *cli.Context, est *dsort.Estimate, units string) {
+	fmtSize := func(size int64) string { return teb.FmtSize(size, units, 2) }
+	fmt.Fprintf(c.App.Writer, "Estimate:\n"+
+		indent1+"Records:\t%d (total size %s, metadata to sort %s)\n"+
+		indent1+"Output shards:\t%d (size min %s, avg %s, max %s)\n",
+		est.RecordCnt, fmtSize(est.RecordSize), fmtSize(est.RecordMemSize),
+		est.ShardCnt, fmtSize(est.MinShardSize), fmtSize(est.AvgShardSize), fmtSize(est.MaxShardSize))
+	if len(est.KeySample) > 0 {
+		fmt.Fprintf(c.App.Writer, indent1+"Key sample:\t%s\n", strings.Join(est.KeySample, ", "))
+	}
+
+	tids := make([]string, 0, len(est.Targets))
+	for tid := range est.Targets {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\t EXTRACTED RECORDS\t EXTRACTED SIZE (MEM OR DISK)\t OUTPUT SHARDS\t OUTPUT SIZE (DISK)")
+	for _, tid := range tids {
+		te := est.Targets[tid]
+		fmt.Fprintf(tw, "%s\t %d\t %s\t %d\t %s\n", meta.Tname(tid), te.ExtractedCnt, fmtSize(te.ExtractedSize),
+			te.ShardCnt, fmtSize(te.ShardSize))
+	}
+	tw.Flush()
+}
+
 func dsortJobsList(c *cli.Context, list []*dsort.JobInfo, usejs bool) error {
 	sort.Slice(list, func(i int, j int) bool {
 		if list[i].IsRunning() && !list[j].IsRunning() {
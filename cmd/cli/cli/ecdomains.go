@@ -0,0 +1,208 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais storage ec-domains`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/urfave/cli"
+)
+
+// Validate erasure-coded buckets against target failure domains (zones and racks):
+// for each bucket, compute EC slice placement for a sample of object names (the same
+// way targets do) and report the maximum number of slices that a single zone or rack
+// may hold. A bucket is considered at risk when the latter exceeds the number of parity
+// slices, i.e., when a single rack (or zone) outage may render objects unrecoverable.
+
+const ecDomainsSamples = 1000
+
+const ecDomainsUsage = "validate EC slice placement across target failure domains (zones and racks), e.g.:\n" +
+	indent1 + "\t- 'ais storage ec-domains'\t- show failure domains and validate all erasure-coded buckets;\n" +
+	indent1 + "\t- 'ais storage ec-domains ais://abc'\t- validate a given bucket.\n" +
+	indent1 + "(use local config 'failure_domain.zone' and 'failure_domain.rack' to label targets)"
+
+type ecdRow struct {
+	bck                *meta.Bck
+	err                error
+	maxZone, maxRack   int
+	numZones, numRacks int
+}
+
+var ecDomainsCmd = cli.Command{
+	Name:         cmdECDomains,
+	Usage:        ecDomainsUsage,
+	ArgsUsage:    optionalBucketArgument,
+	Flags:        []cli.Flag{noHeaderFlag},
+	Action:       ecDomainsHandler,
+	BashComplete: bucketCompletions(bcmplop{}),
+}
+
+func ecDomainsHandler(c *cli.Context) error {
+	var bck cmn.Bck
+	if c.NArg() > 0 {
+		var err error
+		if bck, err = parseBckURI(c, c.Args().Get(0), false); err != nil {
+			return err
+		}
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	bmd, err := api.GetBMD(apiBP)
+	if err != nil {
+		return V(err)
+	}
+
+	// 1. failure domains
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "ZONE\t RACK\t TARGETS")
+	}
+	for _, dom := range ecDomains(smap) {
+		zone, rack := dom[0].Zone, dom[0].Rack
+		if zone == "" {
+			zone = teb.NotSetVal
+		}
+		if rack == "" {
+			rack = teb.NotSetVal
+		}
+		names := make([]string, 0, len(dom))
+		for _, tsi := range dom {
+			names = append(names, tsi.StringEx())
+		}
+		fmt.Fprintf(tw, "%s\t %s\t %s\n", zone, rack, strings.Join(names, ", "))
+	}
+	tw.Flush()
+
+	// 2. erasure-coded buckets
+	var rows []*ecdRow
+	bmd.Range(nil, nil, func(b *meta.Bck) bool {
+		if !bck.IsEmpty() && b.Cname("") != bck.Cname("") {
+			return false
+		}
+		if b.Props.EC.Enabled {
+			rows = append(rows, ecDomainsValidate(smap, b, ecDomainsSamples))
+		}
+		return false
+	})
+	fmt.Fprintln(c.App.Writer)
+	if len(rows) == 0 {
+		if !bck.IsEmpty() {
+			return fmt.Errorf("%s does not exist or is not erasure coded", bck.Cname(""))
+		}
+		fmt.Fprintln(c.App.Writer, "No erasure-coded buckets")
+		return nil
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].bck.Cname("") < rows[j].bck.Cname("") })
+
+	var numRisk int
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "BUCKET\t DATA\t PARITY\t MAX SLICES PER ZONE\t MAX SLICES PER RACK\t STATUS")
+	}
+	for _, row := range rows {
+		ecConf := &row.bck.Props.EC
+		if row.err != nil {
+			fmt.Fprintf(tw, "%s\t %d\t %d\t %s\t %s\t %s\n", row.bck.Cname(""), ecConf.DataSlices, ecConf.ParitySlices,
+				teb.NotSetVal, teb.NotSetVal, fred("error: ")+row.err.Error())
+			numRisk++
+			continue
+		}
+		status := "ok"
+		switch {
+		case row.numRacks < 2:
+			status = "n/a (single failure domain)"
+		case !row.ok():
+			status = fred("at risk")
+			numRisk++
+		}
+		fmt.Fprintf(tw, "%s\t %d\t %d\t %d\t %d\t %s\n", row.bck.Cname(""), ecConf.DataSlices, ecConf.ParitySlices,
+			row.maxZone, row.maxRack, status)
+	}
+	tw.Flush()
+
+	if numRisk > 0 {
+		fmt.Fprintln(c.App.Writer)
+		return fmt.Errorf("%d bucket%s may not survive a single rack (or zone) outage (tip: add racks, or label more targets)",
+			numRisk, cos.Plural(numRisk))
+	}
+	return nil
+}
+
+// targets grouped by failure domain, sorted by zone and rack
+func ecDomains(smap *meta.Smap) (doms []meta.Nodes) {
+	index := make(map[string]int, 4)
+	for _, tsi := range smap.Tmap {
+		fd := tsi.FailureDomain()
+		i, ok := index[fd]
+		if !ok {
+			i = len(doms)
+			index[fd] = i
+			doms = append(doms, nil)
+		}
+		doms[i] = append(doms[i], tsi)
+	}
+	for _, dom := range doms {
+		sort.Slice(dom, func(i, j int) bool { return dom[i].ID() < dom[j].ID() })
+	}
+	sort.Slice(doms, func(i, j int) bool { return doms[i][0].FailureDomain() < doms[j][0].FailureDomain() })
+	return doms
+}
+
+// compute placement for sampled object names; count slices (i.e., excluding the main replica)
+func ecDomainsValidate(smap *meta.Smap, bck *meta.Bck, samples int) *ecdRow {
+	var (
+		row   = &ecdRow{bck: bck}
+		n     = bck.Props.EC.DataSlices + bck.Props.EC.ParitySlices + 1
+		zones = make(map[string]struct{}, 4)
+		racks = make(map[string]struct{}, 4)
+	)
+	for _, tsi := range smap.Tmap {
+		zones[tsi.Zone] = struct{}{}
+		racks[tsi.FailureDomain()] = struct{}{}
+	}
+	row.numZones, row.numRacks = len(zones), len(racks)
+	for i := range samples {
+		uname := cos.UnsafeS(bck.MakeUname("ec-domains-" + strconv.Itoa(i)))
+		sis, err := smap.HrwTargetList(&uname, n, bck.Props.EC.SpreadFD)
+		if err != nil {
+			row.err = err
+			return row
+		}
+		perZone, perRack := make(map[string]int, 4), make(map[string]int, 4)
+		for _, tsi := range sis[1:] {
+			perZone[tsi.Zone]++
+			perRack[tsi.FailureDomain()]++
+		}
+		for _, cnt := range perZone {
+			row.maxZone = max(row.maxZone, cnt)
+		}
+		for _, cnt := range perRack {
+			row.maxRack = max(row.maxRack, cnt)
+		}
+	}
+	return row
+}
+
+// a single zone outage is only considered when there are at least two zones
+func (row *ecdRow) ok() bool {
+	parity := row.bck.Props.EC.ParitySlices
+	if row.maxRack > parity {
+		return false
+	}
+	return row.numZones < 2 || row.maxZone <= parity
+}
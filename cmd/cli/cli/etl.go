@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
@@ -19,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/urfave/cli"
 )
@@ -39,6 +41,7 @@ var (
 		},
 		cmdSpec: {
 			fromFileFlag,
+			etlHelmValuesFlag,
 			commTypeFlag,
 			argTypeFlag,
 			waitPodReadyTimeoutFlag,
@@ -59,6 +62,8 @@ var (
 			templateFlag,
 			numListRangeWorkersFlag,
 			verbObjPrefixFlag,
+			jobMaxObjectsFlag,
+			jobMaxBytesFlag,
 			// TODO: progressFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
@@ -76,6 +81,7 @@ var (
 				Usage:     "show ETL details",
 				ArgsUsage: etlNameArgument,
 				Action:    etlShowDetailsHandler,
+				Flags:     []cli.Flag{etlMetricsFlag},
 			},
 		},
 	}
@@ -210,6 +216,11 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, etlHelmValuesFlag) {
+		if spec, err = renderEtlSpec(spec, parseStrFlag(c, etlHelmValuesFlag)); err != nil {
+			return err
+		}
+	}
 
 	msg := &etl.InitSpecMsg{}
 	{
@@ -345,7 +356,51 @@ func etlShowDetailsHandler(c *cli.Context) error {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
 	id := c.Args().Get(0)
-	return etlPrintDetails(c, id)
+	if err := etlPrintDetails(c, id); err != nil {
+		return err
+	}
+	if !flagIsSet(c, etlMetricsFlag) {
+		return nil
+	}
+	fmt.Fprintln(c.App.Writer)
+	return etlPrintStats(c, id)
+}
+
+func etlPrintStats(c *cli.Context, id string) error {
+	all, err := api.ETLStats(apiBP, id)
+	if err != nil {
+		return V(err)
+	}
+	var (
+		total etl.Stats
+		tw    = &tabwriter.Writer{}
+	)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\t INLINE OBJECTS\t SIZE\t AVG LATENCY\t ERRORS\t OFFLINE OBJECTS\t SIZE\t AVG LATENCY\t ERRORS")
+	for _, xs := range all {
+		fmt.Fprintf(tw, "%s\t %s\t %s\n", meta.Tname(xs.TargetID), fmtXformStats(&xs.Inline), fmtXformStats(&xs.Offline))
+		addXformStats(&total.Inline, &xs.Inline)
+		addXformStats(&total.Offline, &xs.Offline)
+	}
+	fmt.Fprintf(tw, "TOTAL\t %s\t %s\n", fmtXformStats(&total.Inline), fmtXformStats(&total.Offline))
+	return tw.Flush()
+}
+
+func addXformStats(to, xs *etl.XformStats) {
+	to.Objs += xs.Objs
+	to.Size += xs.Size
+	to.Errs += xs.Errs
+	to.Latency += xs.Latency
+}
+
+func fmtXformStats(xs *etl.XformStats) string {
+	var lat string
+	if xs.Objs > 0 {
+		lat = teb.FmtDuration(xs.Latency/xs.Objs, "")
+	} else {
+		lat = teb.NotSetVal
+	}
+	return fmt.Sprintf("%d\t %s\t %s\t %d", xs.Objs, teb.FmtSize(xs.Size, "", 2), lat, xs.Errs)
 }
 
 func etlPrintDetails(c *cli.Context, id string) error {
@@ -0,0 +1,133 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais etl init spec --from-helm-values`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"gopkg.in/yaml.v2"
+)
+
+// Parameterized ETL spec: the same transformer YAML can be reused across environments
+// (different images, resources, etc.) given a values file, e.g.:
+//
+//	image: aistorage/transformer_md5:v2
+//	resources:
+//	  memory: 2Gi
+//
+// The spec may then reference the values in one of the two ways (or both):
+//   - Go template (Helm-style): {{ .Values.image }}, {{ .Values.resources.memory | default "1Gi" }};
+//   - simple substitution:      ${image}, ${resources.memory}
+//     (in the latter case, names that are not in the values file resolve to environment variables).
+
+var etlVarRegex = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_.\-]*)\}`)
+
+func renderEtlSpec(spec []byte, valuesFile string) ([]byte, error) {
+	b, err := os.ReadFile(valuesFile)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]any)
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse values file %q: %v", valuesFile, err)
+	}
+	return renderEtlTmpl(spec, etlNormValues(values).(map[string]any))
+}
+
+func renderEtlTmpl(spec []byte, values map[string]any) ([]byte, error) {
+	// 1. Go template
+	funcs := template.FuncMap{
+		"default": func(dflt, v any) any {
+			if v == nil || v == "" {
+				return dflt
+			}
+			return v
+		},
+		"quote": func(v any) string { return strconv.Quote(fmt.Sprint(v)) },
+	}
+	tmpl, err := template.New("spec").Funcs(funcs).Option("missingkey=zero").Parse(string(spec))
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec template: %v", err)
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, map[string]any{"Values": values}); err != nil {
+		return nil, fmt.Errorf("failed to render spec: %v", err)
+	}
+	if bytes.Contains(out.Bytes(), []byte("<no value>")) {
+		return nil, errors.New("spec references undefined value(s) (tip: check the values file, or use '| default')")
+	}
+
+	// 2. ${VAR}
+	var missing []string
+	rendered := etlVarRegex.ReplaceAllFunc(out.Bytes(), func(m []byte) []byte {
+		name := string(m[2 : len(m)-1])
+		if v, ok := etlLookupValue(values, name); ok {
+			return []byte(v)
+		}
+		if v, ok := os.LookupEnv(name); ok {
+			return []byte(v)
+		}
+		missing = append(missing, name)
+		return m
+	})
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("undefined variable%s in spec: %s (not in values file and not set in the environment)",
+			cos.Plural(len(missing)), strings.Join(missing, ", "))
+	}
+	return rendered, nil
+}
+
+// dot-separated path, e.g. "resources.memory"
+func etlLookupValue(values map[string]any, name string) (string, bool) {
+	var v any = values
+	for _, key := range strings.Split(name, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[key]; !ok {
+			return "", false
+		}
+	}
+	switch v.(type) {
+	case map[string]any, []any:
+		return "", false // (not a scalar)
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+// yaml.v2 decodes nested maps as map[any]any
+func etlNormValues(v any) any {
+	switch vv := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(vv))
+		for k, e := range vv {
+			m[fmt.Sprint(k)] = etlNormValues(e)
+		}
+		return m
+	case map[string]any:
+		for k, e := range vv {
+			vv[k] = etlNormValues(e)
+		}
+		return vv
+	case []any:
+		for i, e := range vv {
+			vv[i] = etlNormValues(e)
+		}
+		return vv
+	default:
+		return v
+	}
+}
@@ -144,6 +144,22 @@ func parseDurationFlag(c *cli.Context, flag cli.Flag) time.Duration {
 	return c.Duration(flagName)
 }
 
+// comma-separated list of object properties: '--props' or, alternatively, '--view' (named set of props in CLI config)
+func parsePropsFlag(c *cli.Context) (string, error) {
+	if !flagIsSet(c, viewFlag) {
+		return parseStrFlag(c, objPropsFlag), nil
+	}
+	if flagIsSet(c, objPropsFlag) {
+		return "", fmt.Errorf(errFmtExclusive, qflprn(viewFlag), qflprn(objPropsFlag))
+	}
+	name := parseStrFlag(c, viewFlag)
+	props, ok := cfg.Views[name]
+	if !ok {
+		return "", fmt.Errorf("view %q is not defined in CLI config (defined views: %v)", name, cos.StrKVs(cfg.Views).Keys())
+	}
+	return props, nil
+}
+
 //nolint:gocritic // ignoring hugeParam - following the orig. github.com/urfave style
 func parseUnitsFlag(c *cli.Context, flag cli.StringFlag) (units string, err error) {
 	units = parseStrFlag(c, flag) // enum { unitsSI, ... }
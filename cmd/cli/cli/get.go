@@ -6,6 +6,7 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -15,6 +16,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -23,6 +25,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/filter"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
@@ -51,10 +54,137 @@ func catHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, catRangeFlag) || flagIsSet(c, followFlag) {
+		return catRanges(c, bck, objName)
+	}
 	a := qparamArch{archpath: parseStrFlag(c, archpathGetFlag)}
 	return getObject(c, bck, objName, fileStdIO, a, true /*quiet*/, false /*extract*/)
 }
 
+// 'ais object cat' with '--range' and/or '--follow':
+// - each range is a separate GET request (one range per request), ranges are printed in the order specified;
+// - '--follow' (without '--range') prints the entire object and then keeps printing appended bytes
+func catRanges(c *cli.Context, bck cmn.Bck, objName string) error {
+	for _, f := range []cli.Flag{offsetFlag, lengthFlag, archpathGetFlag, cksumFlag} {
+		if !flagIsSet(c, f) {
+			continue
+		}
+		if flagIsSet(c, catRangeFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(catRangeFlag), qflprn(f))
+		}
+		return fmt.Errorf(errFmtExclusive, qflprn(followFlag), qflprn(f))
+	}
+	size, err := catObjSize(bck, objName)
+	if err != nil {
+		return err
+	}
+	ranges := []catRng{{0, size}}
+	if flagIsSet(c, catRangeFlag) {
+		if ranges, err = parseCatRanges(parseStrFlag(c, catRangeFlag), size); err != nil {
+			return err
+		}
+	}
+	for _, r := range ranges {
+		if err := catRange(bck, objName, r.off, r.length); err != nil {
+			return err
+		}
+	}
+	if !flagIsSet(c, followFlag) {
+		return nil
+	}
+
+	// follow: poll for object size growth and print new bytes (until interrupted)
+	sleep := _refreshRate(c)
+	for offset := size; ; {
+		time.Sleep(sleep)
+		if size, err = catObjSize(bck, objName); err != nil {
+			return err
+		}
+		if size < offset {
+			return fmt.Errorf("%s: size decreased from %d to %d (object overwritten?)", bck.Cname(objName), offset, size)
+		}
+		if err := catRange(bck, objName, offset, size-offset); err != nil {
+			return err
+		}
+		offset = size
+	}
+}
+
+func catObjSize(bck cmn.Bck, objName string) (int64, error) {
+	props, err := api.HeadObject(apiBP, bck, objName, api.HeadArgs{Silent: true})
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = &errDoesNotExist{what: "object", name: bck.Cname(objName)}
+		}
+		return 0, err
+	}
+	return props.Size, nil
+}
+
+func catRange(bck cmn.Bck, objName string, offset, length int64) error {
+	if length == 0 {
+		return nil
+	}
+	hdr := http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(offset, length)}}
+	_, err := api.GetObject(apiBP, bck, objName, &api.GetArgs{Writer: os.Stdout, Header: hdr})
+	return err
+}
+
+type catRng struct {
+	off, length int64
+}
+
+// parse comma-separated byte ranges given object size (compare w/ RFC 7233 "Range" header):
+// "start-end" (inclusive), "start-" (through the end), and "-suffix" (last `suffix` bytes)
+func parseCatRanges(s string, size int64) (ranges []catRng, err error) {
+	for _, ra := range splitCsv(s) {
+		if ra == "" {
+			continue
+		}
+		start, end, ok := strings.Cut(ra, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid range %q: expecting 'start-end', 'start-', or '-suffix'", ra)
+		}
+		var (
+			r      catRng
+			first  int64
+			last   = size - 1
+			errInv = fmt.Errorf("invalid range %q", ra)
+		)
+		start, end = strings.TrimSpace(start), strings.TrimSpace(end)
+		switch {
+		case start == "" && end == "":
+			return nil, errInv
+		case start == "":
+			n, err := strconv.ParseInt(end, 10, 64)
+			if err != nil || n <= 0 {
+				return nil, errInv
+			}
+			first = max(size-n, 0)
+		default:
+			if first, err = strconv.ParseInt(start, 10, 64); err != nil || first < 0 {
+				return nil, errInv
+			}
+			if end != "" {
+				e, err := strconv.ParseInt(end, 10, 64)
+				if err != nil || e < first {
+					return nil, errInv
+				}
+				last = min(e, last)
+			}
+			if first >= size {
+				return nil, fmt.Errorf("range %q is out of bounds (object size %d)", ra, size)
+			}
+		}
+		r.off, r.length = first, last-first+1
+		ranges = append(ranges, r)
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("invalid (empty) range %q", s)
+	}
+	return ranges, nil
+}
+
 func getHandler(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -71,9 +201,20 @@ func getHandler(c *cli.Context) error {
 		}
 	}
 
+	if flagIsSet(c, getFilterFlag) {
+		if err := filter.Validate(parseStrFlag(c, getFilterFlag)); err != nil {
+			return err
+		}
+		for _, f := range []cli.Flag{lengthFlag, archpathGetFlag, archregxFlag, cksumFlag, blobDownloadFlag, extractFlag} {
+			if flagIsSet(c, f) {
+				return fmt.Errorf(errFmtExclusive, qflprn(getFilterFlag), qflprn(f))
+			}
+		}
+	}
+
 	// source
 	uri := c.Args().Get(0)
-	bck, objName, err := parseBckObjURI(c, uri, flagIsSet(c, getObjPrefixFlag))
+	bck, objName, err := parseBckObjURI(c, uri, flagIsSet(c, getObjPrefixFlag) || flagIsSet(c, archpathListFlag))
 	if err != nil {
 		return err
 	}
@@ -110,6 +251,11 @@ func getHandler(c *cli.Context) error {
 	// destination (empty "" implies using source `basename`)
 	outFile := c.Args().Get(1)
 
+	// bulk extraction
+	if flagIsSet(c, archpathListFlag) {
+		return getArchList(c, bck, objName, outFile)
+	}
+
 	// archive
 	var (
 		a       qparamArch
@@ -154,6 +300,11 @@ func getHandler(c *cli.Context) error {
 		return err
 	}
 
+	// extract regex-matching archived files into a local directory
+	if a.archregx != "" && !flagIsSet(c, getObjPrefixFlag) && (extract || isDirDest(outFile)) {
+		return getArchRegx(c, bck, objName, outFile, a)
+	}
+
 	// GET multiple -- currently, only prefix (TODO: list/range)
 	if flagIsSet(c, getObjPrefixFlag) {
 		if objName != "" {
@@ -278,7 +429,7 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bo
 	// context to get in parallel
 	u := &uctx{
 		showProgress: flagIsSet(c, progressFlag),
-		wg:           cos.NewLimitedWaitGroup(4, 0),
+		wpool:        cos.NewWorkerPool(context.Background(), 4, false /*fail fast*/),
 	}
 	if u.showProgress {
 		var (
@@ -334,15 +485,20 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bo
 				continue
 			}
 		}
-		u.wg.Add(1)
-		go u.get(c, bck, en, shardName, outFile, quiet, extract)
+		u.wpool.Go(func(context.Context) error {
+			u.get(c, bck, en, shardName, outFile, quiet, extract)
+			return nil
+		})
 	}
-	u.wg.Wait()
+	errp := u.wpool.Wait() // (non-nil only when recovering from panic)
 
 	if u.showProgress {
 		u.progress.Wait()
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
+	if errp != nil {
+		return errp
+	}
 	if numFailed := u.errCount.Load(); numFailed > 0 {
 		return fmt.Errorf("failed to GET %d object%s", numFailed, cos.Plural(int(numFailed)))
 	}
@@ -383,8 +539,6 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEnt, shardName, ou
 	} else if err != nil {
 		actionWarn(c, err.Error())
 	}
-
-	u.wg.Done()
 }
 
 // get one (main function)
@@ -662,6 +816,10 @@ func (a *qparamArch) getQuery(c *cli.Context, bck *cmn.Bck) (q url.Values) {
 		f()
 		q.Set(apc.QparamLatestVer, "true")
 	}
+	if flagIsSet(c, getFilterFlag) {
+		f()
+		q.Set(apc.QparamFilter, parseStrFlag(c, getFilterFlag))
+	}
 	return q
 }
 
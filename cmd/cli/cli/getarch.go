@@ -0,0 +1,426 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles bulk extraction of archived files ('ais get --archpath-list', 'ais get --archregx OUT_DIR').
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+	"github.com/vbauerster/mpb/v4"
+)
+
+const archListWorkers = 8
+
+type (
+	// one line in the '--archpath-list' file
+	archMember struct {
+		shard  string
+		member string // archpath or, with '--archmode', matching pattern (e.g., WebDataset key)
+	}
+	// one extracted file (or failure)
+	archManifestEntry struct {
+		Shard  string `json:"shard"`
+		Member string `json:"member"`
+		Path   string `json:"path,omitempty"`
+		Size   int64  `json:"size"`
+		Error  string `json:"error,omitempty"`
+	}
+	archBulk struct {
+		c        *cli.Context
+		bck      cmn.Bck
+		dir      string
+		a        qparamArch // archmime and archmode, if specified
+		bar      *mpb.Bar   // optional (GET progress, in bytes)
+		manifest []archManifestEntry
+		mu       sync.Mutex
+	}
+	// (io.Writer) advance progress bar by the number of bytes written
+	barWriter struct {
+		w   io.Writer
+		bar *mpb.Bar
+	}
+	// multi-match GET returns TAR - extract it locally (compare w/ `extractor`)
+	archBulkRCB struct {
+		b     *archBulk
+		mbr   *archMember
+		dir   string
+		added int
+	}
+)
+
+// parse '--archpath-list' content: empty lines and lines starting with '#' are ignored
+func parseArchList(r io.Reader) (members []archMember, _ error) {
+	scanner := bufio.NewScanner(r)
+	for num := 1; scanner.Scan(); num++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		var mbr archMember
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 1:
+			mbr.shard, mbr.member = splitObjnameShardBoundary(fields[0])
+		case 2:
+			mbr.shard, mbr.member = fields[0], fields[1]
+		}
+		if mbr.shard == "" || mbr.member == "" {
+			return nil, fmt.Errorf("line %d: invalid %q (expecting 'SHARD ARCHPATH' or 'SHARD/ARCHPATH')", num, line)
+		}
+		members = append(members, mbr)
+	}
+	return members, scanner.Err()
+}
+
+func getArchList(c *cli.Context, bck cmn.Bck, objName, dir string) error {
+	for _, f := range []cli.Flag{archpathGetFlag, archregxFlag, getObjPrefixFlag, extractFlag, lengthFlag,
+		blobDownloadFlag, headObjPresentFlag, getFilterFlag} {
+		if flagIsSet(c, f) {
+			return fmt.Errorf(errFmtExclusive, qflprn(archpathListFlag), qflprn(f))
+		}
+	}
+	if objName != "" {
+		return fmt.Errorf("%s: expecting bucket (with shard names listed in %s), got %q",
+			qflprn(archpathListFlag), parseStrFlag(c, archpathListFlag), bck.Cname(objName))
+	}
+
+	// members
+	var (
+		fh    = os.Stdin
+		fname = parseStrFlag(c, archpathListFlag)
+	)
+	if fname != fileStdIO {
+		var err error
+		if fh, err = os.Open(fname); err != nil {
+			return err
+		}
+		defer fh.Close()
+	}
+	members, err := parseArchList(fh)
+	if err != nil {
+		return fmt.Errorf("%s: %v", fname, err)
+	}
+	if len(members) == 0 {
+		return fmt.Errorf("%s: no archived files to extract", fname)
+	}
+
+	// destination directory
+	if dir == "" {
+		dir = "."
+	}
+	if dir == fileStdIO || discardOutput(dir) {
+		return fmt.Errorf("%s: destination must be a directory (got %q)", qflprn(archpathListFlag), dir)
+	}
+	if err := cos.CreateDir(dir); err != nil {
+		return err
+	}
+
+	b := &archBulk{c: c, bck: bck, dir: dir, manifest: make([]archManifestEntry, 0, len(members))}
+	if err := b.a.init(c); err != nil {
+		return err
+	}
+
+	wpool := cos.NewWorkerPool(context.Background(), archListWorkers, false /*fail fast*/)
+	for i := range members {
+		mbr := &members[i]
+		wpool.Go(func(context.Context) error {
+			b.do(mbr)
+			return nil
+		})
+	}
+	if err := wpool.Wait(); err != nil {
+		return err
+	}
+	return b.report()
+}
+
+// 'ais get SHARD OUT_DIR --archregx': extract all matching files into OUT_DIR, preserving archived paths;
+// empty OUT_DIR with '--extract' implies shard name sans extension (compare with `doExtract`)
+func getArchRegx(c *cli.Context, bck cmn.Bck, shard, dir string, a qparamArch) error {
+	b := &archBulk{c: c, bck: bck, dir: dir, a: a}
+	if dir == "" {
+		b.dir = "."
+		dir = b.shardDir(filepath.Base(shard))
+	}
+	if dir == fileStdIO || discardOutput(dir) {
+		return fmt.Errorf("%s: destination must be a directory (got %q)", qflprn(archregxFlag), dir)
+	}
+	b.dir = dir
+
+	var progress *mpb.Progress
+	if flagIsSet(c, progressFlag) {
+		// the total is an upper bound: server-side filtered TAR contains only the matching files
+		props, err := api.HeadObject(apiBP, bck, shard, api.HeadArgs{Silent: true})
+		if err != nil {
+			return V(err)
+		}
+		var bars []*mpb.Bar
+		progress, bars = simpleBar(barArgs{barType: sizeArg, barText: shard, total: props.Size})
+		b.bar = bars[0]
+	}
+
+	b.getMatch(&archMember{shard: shard, member: a.archregx}, dir, a)
+
+	if progress != nil {
+		b.bar.SetTotal(b.bar.Current(), true)
+		progress.Wait()
+	}
+	return b.summary(shard)
+}
+
+// existing directory or (not yet existing) path with trailing separator
+func isDirDest(dst string) bool {
+	if dst == "" || dst == fileStdIO || discardOutput(dst) {
+		return false
+	}
+	if cos.IsLastB(dst, filepath.Separator) {
+		return true
+	}
+	finfo, err := os.Stat(dst)
+	return err == nil && finfo.IsDir()
+}
+
+// brief (compare with `report`)
+func (b *archBulk) summary(shard string) error {
+	var (
+		cnt  int
+		size int64
+	)
+	for i := range b.manifest {
+		en := &b.manifest[i]
+		if en.Error != "" {
+			return errors.New(en.Shard + ": " + en.Error)
+		}
+		cnt++
+		size += en.Size
+	}
+	fmt.Fprintf(b.c.App.Writer, "Extracted %d archived file%s matching %q from %s to %s (total size %s)\n",
+		cnt, cos.Plural(cnt), b.a.archregx, b.bck.Cname(shard), b.dir, teb.FmtSize(size, "", 2))
+	return nil
+}
+
+func (b *archBulk) do(mbr *archMember) {
+	var (
+		a     = qparamArch{archmime: b.a.archmime}
+		sdir  = b.shardDir(mbr.shard)
+		match = b.a.archmode != ""
+	)
+	if match {
+		a.archregx, a.archmode = mbr.member, b.a.archmode
+		b.getMatch(mbr, sdir, a)
+		return
+	}
+	a.archpath = mbr.member
+	fqn, ok := joinInDir(sdir, mbr.member)
+	if !ok { // e.g., "../../etc"
+		b.add(mbr, "", 0, fmt.Errorf("invalid archpath %q", mbr.member))
+		return
+	}
+	size, err := b.get(mbr, fqn, a)
+	if err != nil {
+		b.add(mbr, "", 0, err)
+		return
+	}
+	b.add(mbr, fqn, size, nil)
+}
+
+// shard name sans archival extension, e.g. "train/shard-001.tar" => "<dir>/train/shard-001"
+func (b *archBulk) shardDir(shard string) string {
+	if mime, err := archive.Mime(b.a.archmime, shard); err == nil {
+		shard = strings.TrimSuffix(shard, mime)
+	}
+	return filepath.Join(b.dir, shard)
+}
+
+func (b *archBulk) get(mbr *archMember, fqn string, a qparamArch) (int64, error) {
+	wfh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return 0, err
+	}
+	var w io.Writer = wfh
+	if b.bar != nil {
+		w = &barWriter{w: wfh, bar: b.bar}
+	}
+	getArgs := api.GetArgs{Writer: w, Query: a.getQuery(b.c, &b.bck)}
+	oah, err := api.GetObject(apiBP, b.bck, mbr.shard, &getArgs)
+	wfh.Close()
+	if err != nil {
+		os.Remove(fqn)
+		if cmn.IsStatusNotFound(err) {
+			err = &errDoesNotExist{what: "archived file", name: b.bck.Cname(mbr.shard) + "/" + mbr.member}
+		}
+		return 0, err
+	}
+	return oah.Size(), nil
+}
+
+// GET all files that match (server-side) and extract the resulting TAR locally
+func (b *archBulk) getMatch(mbr *archMember, sdir string, a qparamArch) {
+	if err := cos.CreateDir(sdir); err != nil {
+		b.add(mbr, "", 0, err)
+		return
+	}
+	tmp, err := os.CreateTemp(sdir, ".archlist-*"+archive.ExtTar)
+	if err != nil {
+		b.add(mbr, "", 0, err)
+		return
+	}
+	fqn := tmp.Name()
+	tmp.Close()
+	defer os.Remove(fqn)
+
+	size, err := b.get(mbr, fqn, a)
+	if err != nil {
+		b.add(mbr, "", 0, err)
+		return
+	}
+	rfh, err := os.Open(fqn)
+	if err != nil {
+		b.add(mbr, "", 0, err)
+		return
+	}
+	defer rfh.Close()
+	ar, err := archive.NewReader(archive.ExtTar, rfh, size)
+	if err != nil {
+		b.add(mbr, "", 0, err)
+		return
+	}
+	rcb := &archBulkRCB{b: b, mbr: mbr, dir: sdir}
+	if err := ar.ReadUntil(rcb, cos.EmptyMatchAll, ""); err != nil {
+		b.add(mbr, "", 0, err)
+		return
+	}
+	if rcb.added == 0 {
+		b.add(mbr, "", 0, errors.New("no matching archived files"))
+	}
+}
+
+func (rcb *archBulkRCB) Call(filename string, reader cos.ReadCloseSizer, _ any) (bool /*stop*/, error) {
+	defer reader.Close()
+	fqn, ok := joinInDir(rcb.dir, filename)
+	if cos.IsLastB(filename, '/') || !ok {
+		return false, nil // skip
+	}
+	wfh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return true, err
+	}
+	n, err := io.Copy(wfh, reader)
+	wfh.Close()
+	if err != nil {
+		os.Remove(fqn)
+		return true, err
+	}
+	rcb.b.add(&archMember{shard: rcb.mbr.shard, member: filename}, fqn, n, nil)
+	rcb.added++
+	return false, nil
+}
+
+// join and make sure the result is contained in dir (rejecting, e.g., "../shard-sibling/file")
+func joinInDir(dir, name string) (string, bool) {
+	fqn := filepath.Join(dir, name)
+	rel, err := filepath.Rel(dir, fqn)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return fqn, true
+}
+
+func (bw *barWriter) Write(p []byte) (n int, err error) {
+	n, err = bw.w.Write(p)
+	bw.bar.IncrBy(n)
+	return
+}
+
+func (b *archBulk) add(mbr *archMember, fqn string, size int64, err error) {
+	en := archManifestEntry{Shard: mbr.shard, Member: mbr.member, Path: fqn, Size: size}
+	if err != nil {
+		en.Error = err.Error()
+	}
+	b.mu.Lock()
+	b.manifest = append(b.manifest, en)
+	b.mu.Unlock()
+}
+
+func (b *archBulk) report() error {
+	sort.Slice(b.manifest, func(i, j int) bool {
+		mi, mj := &b.manifest[i], &b.manifest[j]
+		if mi.Shard != mj.Shard {
+			return mi.Shard < mj.Shard
+		}
+		return mi.Member < mj.Member
+	})
+	var (
+		numFailed int
+		totalSize int64
+		shards    = cos.NewStrSet()
+	)
+	for i := range b.manifest {
+		en := &b.manifest[i]
+		if en.Error != "" {
+			numFailed++
+			continue
+		}
+		shards.Add(en.Shard)
+		totalSize += en.Size
+	}
+
+	c := b.c
+	if flagIsSet(c, archManifestFlag) {
+		mfile := parseStrFlag(c, archManifestFlag)
+		out, err := jsoniter.MarshalIndent(b.manifest, "", "  ")
+		if err != nil {
+			return err
+		}
+		out = append(out, '\n')
+		if mfile == fileStdIO {
+			_, err = c.App.Writer.Write(out)
+		} else {
+			err = os.WriteFile(mfile, out, cos.PermRWR)
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		tw := tabwriter.NewWriter(c.App.Writer, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(tw, "SHARD\tARCHPATH\tDESTINATION\tSIZE")
+		for i := range b.manifest {
+			en := &b.manifest[i]
+			if en.Error != "" {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", en.Shard, en.Member, "error: "+en.Error, "-")
+				continue
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", en.Shard, en.Member, en.Path, teb.FmtSize(en.Size, "", 2))
+		}
+		tw.Flush()
+	}
+
+	ok := len(b.manifest) - numFailed
+	if !flagIsSet(c, archManifestFlag) || parseStrFlag(c, archManifestFlag) != fileStdIO {
+		fmt.Fprintf(c.App.Writer, "Extracted %d archived file%s from %d shard%s to %s (total size %s)\n",
+			ok, cos.Plural(ok), len(shards), cos.Plural(len(shards)), b.dir, teb.FmtSize(totalSize, "", 2))
+	}
+	if numFailed > 0 {
+		return fmt.Errorf("failed to extract %d archived file%s", numFailed, cos.Plural(numFailed))
+	}
+	return nil
+}
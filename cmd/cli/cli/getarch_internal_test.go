@@ -0,0 +1,70 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestJoinInDir(t *testing.T) {
+	tests := []struct {
+		dir, name string
+		ok        bool
+	}{
+		{"/tmp/out/shard", "a/b.txt", true},
+		{"/tmp/out/shard", "/a/b.txt", true}, // (joined)
+		{"/tmp/out/shard", "a/../b.txt", true},
+		{"/tmp/out/shard", "../shard-evil/b.txt", false}, // (same prefix)
+		{"/tmp/out/shard", "../../etc/passwd", false},
+		{"/tmp/out/shard", "a/../..", false},
+		{"/tmp/out/shard", "", false},
+		{".", "..hidden", true},
+		{".", "../b.txt", false},
+	}
+	for _, test := range tests {
+		_, ok := joinInDir(test.dir, test.name)
+		tassert.Errorf(t, ok == test.ok, "%q, %q: expecting %t", test.dir, test.name, test.ok)
+	}
+}
+
+// extracting server-side filtered TAR (see getArchRegx)
+func TestArchRegxMaliciousMember(t *testing.T) {
+	var (
+		root = t.TempDir()
+		sdir = filepath.Join(root, "shard")
+		buf  bytes.Buffer
+		tw   = tar.NewWriter(&buf)
+	)
+	for _, name := range []string{"ok.txt", "../shard-evil/x.txt", "../../escaped.txt", "dir/../../up.txt"} {
+		tassert.CheckFatal(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: 3, Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte("abc"))
+		tassert.CheckFatal(t, err)
+	}
+	tassert.CheckFatal(t, tw.Close())
+	tassert.CheckFatal(t, cos.CreateDir(sdir))
+
+	ar, err := archive.NewReader(archive.ExtTar, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	tassert.CheckFatal(t, err)
+	rcb := &archBulkRCB{b: &archBulk{}, mbr: &archMember{shard: "shard.tar"}, dir: sdir}
+	tassert.CheckFatal(t, ar.ReadUntil(rcb, cos.EmptyMatchAll, ""))
+
+	tassert.Errorf(t, rcb.added == 1, "expecting 1 extracted, got %d", rcb.added)
+	_, err = os.Stat(filepath.Join(sdir, "ok.txt"))
+	tassert.CheckError(t, err)
+	for _, name := range []string{"shard-evil/x.txt", "escaped.txt", "up.txt"} {
+		_, err = os.Stat(filepath.Join(root, name))
+		tassert.Errorf(t, os.IsNotExist(err), "%s: must not be extracted outside %s", name, sdir)
+	}
+	_, err = os.Stat(filepath.Join(filepath.Dir(root), "escaped.txt"))
+	tassert.Errorf(t, os.IsNotExist(err), "escaped.txt: must not be extracted")
+}
@@ -0,0 +1,110 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais health` and node alerts in general.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/urfave/cli"
+)
+
+const healthUsage = "check cluster health: show active node alerts (out of space, disk faults, high error rate,\n" +
+	indent1 + "\t degraded mountpaths, and more); exit with non-zero status when any node has a red alert\n" +
+	indent1 + "\t or fails to respond, e.g.:\n" +
+	indent1 + "\t - 'ais health'\t- show alerts, if any; non-zero exit status upon red alerts;\n" +
+	indent1 + "\t - 'ais health --strict'\t- same as above, but also fail on warnings"
+
+var (
+	healthCmd = cli.Command{
+		Name:   commandHealth,
+		Usage:  healthUsage,
+		Flags:  []cli.Flag{healthStrictFlag, jsonFlag},
+		Action: healthHandler,
+	}
+)
+
+func healthHandler(c *cli.Context) error {
+	alerts, err := api.GetClusterAlerts(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	lines, nred, nwarn := fmtClusterAlerts(alerts)
+	if flagIsSet(c, jsonFlag) {
+		if err := teb.Print(alerts, "", teb.Jopts(true)); err != nil {
+			return err
+		}
+	} else {
+		if len(lines) == 0 {
+			fmt.Fprintln(c.App.Writer, fgreen("OK:"), "no alerts in the cluster of", len(alerts), "nodes")
+			return nil
+		}
+		fmt.Fprintln(c.App.Writer, strings.Join(lines, "\n"))
+	}
+	switch {
+	case nred > 0:
+		return fmt.Errorf("red alerts: %d node%s", nred, cos.Plural(nred))
+	case nwarn > 0 && flagIsSet(c, healthStrictFlag):
+		return fmt.Errorf("warnings: %d node%s", nwarn, cos.Plural(nwarn))
+	}
+	return nil
+}
+
+// one line per node with active alerts (red first), sorted by node ID
+func fmtClusterAlerts(alerts stats.ClusterAlerts) (lines []string, nred, nwarn int) {
+	var red, warn []string
+	for sid, a := range alerts {
+		sname := sid
+		if a.Snode != nil {
+			sname = a.Snode.StringEx()
+		}
+		switch {
+		case a.Err != "":
+			red = append(red, sname+": "+fred("failed to respond: ")+a.Err)
+		case a.Flags.IsRed():
+			red = append(red, sname+": "+fred(a.Flags.String()))
+		case a.Flags.IsWarn():
+			warn = append(warn, sname+": "+fcyan(a.Flags.String()))
+		}
+	}
+	sort.Strings(red)
+	sort.Strings(warn)
+	nred, nwarn = len(red), len(warn)
+	for _, ln := range red {
+		lines = append(lines, indent1+ln)
+	}
+	for _, ln := range warn {
+		lines = append(lines, indent1+ln)
+	}
+	return lines, nred, nwarn
+}
+
+// `ais show cluster`: alerts section (empty when there are none)
+func _cluAlerts(nodemaps ...teb.StstMap) string {
+	all := make(stats.ClusterAlerts, 8)
+	for _, m := range nodemaps {
+		for sid, ds := range m {
+			if ds.Status != teb.NodeOnline {
+				continue // (shown as such in the tables)
+			}
+			all[sid] = &stats.Alerts{Snode: ds.Snode, Flags: ds.Cluster.Flags}
+		}
+	}
+	lines, nred, _ := fmtClusterAlerts(all)
+	if len(lines) == 0 {
+		return ""
+	}
+	title := fcyan("Alerts:")
+	if nred > 0 {
+		title = fred("Alerts:")
+	}
+	return title + "\n" + strings.Join(lines, "\n") + "\n\n"
+}
@@ -6,17 +6,25 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/ext/dsort"
@@ -72,6 +80,11 @@ var (
 		jobStopSub,
 		jobWaitSub,
 		jobRemoveSub,
+		jobDescribeSub,
+		jobBoostSub,
+		jobPauseSub,
+		jobResumeSub,
+		jobChainSub,
 		makeAlias(showCmdJob, "", true, commandShow), // alias for `ais show`
 	}
 )
@@ -97,11 +110,20 @@ var (
 			waitFlag,
 			waitJobXactFinishedFlag,
 			limitBytesPerHourFlag,
+			dloadPriorityFlag,
+			dloadManifestFlag,
 			syncFlag,
 			unitsFlag,
+			hfTokenFlag,
+			dloadSpecFlag,
+			dloadExtFlag,
+			dloadRegexFlag,
+			dloadMinSizeFlag,
+			dloadMaxSizeFlag,
 		},
 		cmdDsort: {
 			dsortSpecFlag,
+			dsortEstimateFlag,
 			verboseFlag,
 		},
 		commandPrefetch: append(
@@ -140,6 +162,15 @@ var (
 		Flags:     startSpecialFlags[commandRebalance],
 		Action:    startRebHandler,
 	}
+	jobStartRebVerify = cli.Command{
+		Name: cmdRebVerify,
+		Usage: "verify cluster-wide data placement upon global rebalance, e.g.:\n" +
+			indent1 + "\t- '" + cmdRebVerify + "'\t- check HRW location, n-way copies, and EC slices of all objects in the cluster;\n" +
+			indent1 + "\t- '" + cmdRebVerify + " --sample --wait'\t- same as above for a 1% sample, and wait for the job to finish;\n" +
+			indent1 + "\t- 'ais show rebalance --verify'\t- show per-target verification report",
+		Flags:  append(startCommonFlags, rebVerifySampleFlag),
+		Action: startRebVerifyHandler,
+	}
 	jobStartResilver = cli.Command{
 		Name:         commandResilver,
 		Usage:        resilverUsage,
@@ -198,6 +229,7 @@ var (
 			},
 
 			jobStartRebalance,
+			jobStartRebVerify,
 			jobStartResilver,
 
 			cleanupCmd,
@@ -212,6 +244,9 @@ var (
 	stopCmdsFlags = []cli.Flag{
 		allRunningJobsFlag,
 		regexJobsFlag,
+		jobKindFlag,
+		jobBucketFlag,
+		jobOlderThanFlag,
 		forceFlag,
 		yesFlag,
 	}
@@ -272,6 +307,76 @@ var (
 	}
 )
 
+// ais job describe
+var (
+	jobDescribeSub = cli.Command{
+		Name:  cmdDescribe,
+		Usage: "show original job specification (that can be further used to re-submit the job)",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdDownload,
+				Usage: "show download job specification (with secrets, if any, removed), e.g.:\n" +
+					indent1 + "\t- 'ais job describe download dnl-abc > spec.json' - export job spec;\n" +
+					indent1 + "\t- 'ais job start download --from-spec spec.json' - re-submit",
+				ArgsUsage:    jobIDArgument,
+				Action:       describeDownloadHandler,
+				BashComplete: downloadIDAllCompletions,
+			},
+		},
+	}
+)
+
+// ais job boost
+var (
+	jobBoostSub = cli.Command{
+		Name:  cmdBoost,
+		Usage: "lift throttling limits of a running job",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdDownload,
+				Usage: "lift connection and bandwidth limits of a running download job (\"finish ASAP\"), e.g.:\n" +
+					indent1 + "\t- 'ais job boost download dnl-abc'\t- ignore '--limit-connections' and '--limit-bytes-per-hour'\n" +
+					indent1 + "\t  specified at job start, for the remaining lifetime of the job",
+				ArgsUsage:    jobIDArgument,
+				Action:       boostDownloadHandler,
+				BashComplete: downloadIDRunningCompletions,
+			},
+		},
+	}
+)
+
+// ais job pause | resume
+var (
+	jobPauseSub = cli.Command{
+		Name:  cmdPause,
+		Usage: "pause a running job (to resume later with 'ais job resume')",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdDownload,
+				Usage: "stop dispatching new downloads of a running download job, e.g., to temporarily yield bandwidth:\n" +
+					indent1 + "\t- 'ais job pause download dnl-abc'\t- the job keeps its state and progress;\n" +
+					indent1 + "\t  downloads that are already in progress run to completion",
+				ArgsUsage:    jobIDArgument,
+				Action:       pauseDownloadHandler,
+				BashComplete: downloadIDRunningCompletions,
+			},
+		},
+	}
+	jobResumeSub = cli.Command{
+		Name:  cmdResume,
+		Usage: "resume a paused job",
+		Subcommands: []cli.Command{
+			{
+				Name:         cmdDownload,
+				Usage:        "resume a paused download job (see 'ais job pause download --help')",
+				ArgsUsage:    jobIDArgument,
+				Action:       resumeDownloadHandler,
+				BashComplete: downloadIDRunningCompletions,
+			},
+		},
+	}
+)
+
 func appendJobSub(jobcmd *cli.Command) {
 	debug.Assert(jobcmd.Subcommands[0].Name == commandStart)
 
@@ -368,6 +473,14 @@ func startXactionKind(c *cli.Context, xname string) (err error) {
 	return startXaction(c, &xargs, extra)
 }
 
+func startRebVerifyHandler(c *cli.Context) error {
+	xargs := xact.ArgsMsg{Kind: apc.ActRebVerify}
+	if flagIsSet(c, rebVerifySampleFlag) {
+		xargs.Flags |= xact.XrvSample
+	}
+	return startXaction(c, &xargs, "")
+}
+
 func startResilverHandler(c *cli.Context) error {
 	var tid string
 	if c.NArg() > 0 {
@@ -416,6 +529,9 @@ func startXaction(c *cli.Context, xargs *xact.ArgsMsg, extra string) error {
 }
 
 func startDownloadHandler(c *cli.Context) error {
+	if flagIsSet(c, dloadSpecFlag) {
+		return startDownloadSpec(c)
+	}
 	var (
 		description      = parseStrFlag(c, descJobFlag)
 		timeout          = parseStrFlag(c, dloadTimeoutFlag)
@@ -440,8 +556,20 @@ func startDownloadHandler(c *cli.Context) error {
 		}
 	}
 
-	src, dst := c.Args().Get(0), c.Args().Get(1)
-	source, err := parseSource(src)
+	var (
+		source    dlSource
+		hfBody    dload.HFBody
+		tBody     dload.TorrentBody
+		isTorrent bool
+		err       error
+		src, dst  = c.Args().Get(0), c.Args().Get(1)
+		isHF      = strings.HasPrefix(src, dload.HFScheme+apc.BckProviderSeparator)
+	)
+	if isHF {
+		err = dload.ParseHFURI(src, &hfBody)
+	} else if isTorrent, err = parseTorrentSrc(src, &tBody); err == nil && !isTorrent {
+		source, err = parseSource(src)
+	}
 	if err != nil {
 		return err
 	}
@@ -468,6 +596,12 @@ func startDownloadHandler(c *cli.Context) error {
 			Connections:  parseIntFlag(c, limitConnectionsFlag),
 			BytesPerHour: int(limitBPH),
 		},
+		Priority: parseIntFlag(c, dloadPriorityFlag),
+		Manifest: flagIsSet(c, dloadManifestFlag),
+	}
+
+	if basePayload.Filter, err = parseDlFilter(c); err != nil {
+		return err
 	}
 
 	if basePayload.Bck.Props, err = api.HeadBucket(apiBP, basePayload.Bck, true /* don't add */); err != nil {
@@ -481,7 +615,11 @@ func startDownloadHandler(c *cli.Context) error {
 
 	// Heuristics to determine the download type.
 	var dlType dload.Type
-	if objectsListPath != "" {
+	if isHF {
+		dlType = dload.TypeHF
+	} else if isTorrent {
+		dlType = dload.TypeTorrent
+	} else if objectsListPath != "" {
 		dlType = dload.TypeMulti
 	} else if strings.Contains(source.link, "{") && strings.Contains(source.link, "}") {
 		dlType = dload.TypeRange
@@ -566,6 +704,15 @@ func startDownloadHandler(c *cli.Context) error {
 			Prefix: source.backend.prefix,
 		}
 		id, err = api.DownloadWithParam(apiBP, dlType, payload)
+	case dload.TypeHF:
+		hfBody.Base = basePayload
+		hfBody.Subdir = pathSuffix
+		hfBody.Token = parseStrFlag(c, hfTokenFlag)
+		id, err = api.DownloadWithParam(apiBP, dlType, &hfBody)
+	case dload.TypeTorrent:
+		tBody.Base = basePayload
+		tBody.Subdir = pathSuffix
+		id, err = api.DownloadWithParam(apiBP, dlType, &tBody)
 	default:
 		debug.Assert(false)
 	}
@@ -573,7 +720,95 @@ func startDownloadHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	return startedDownload(c, id)
+}
+
+// BitTorrent source: magnet link, .torrent URL, or local .torrent file (sent to the cluster as is)
+func parseTorrentSrc(src string, body *dload.TorrentBody) (bool, error) {
+	if strings.HasPrefix(src, dload.MagnetScheme+":") {
+		body.Torrent = src
+		return true, nil
+	}
+	u, err := url.Parse(src)
+	if err != nil || !strings.HasSuffix(u.Path, dload.TorrentExt) {
+		return false, nil
+	}
+	if u.Scheme == "http" || u.Scheme == "https" {
+		body.Torrent = src
+		return true, nil
+	}
+	if u.Scheme != "" && u.Scheme != "file" {
+		return false, nil
+	}
+	if body.Metainfo, err = os.ReadFile(u.Path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func parseDlFilter(c *cli.Context) (*dload.Filter, error) {
+	if !flagIsSet(c, dloadExtFlag) && !flagIsSet(c, dloadRegexFlag) && !flagIsSet(c, dloadMinSizeFlag) && !flagIsSet(c, dloadMaxSizeFlag) {
+		return nil, nil
+	}
+	var (
+		flt = &dload.Filter{Regex: parseStrFlag(c, dloadRegexFlag)}
+		err error
+	)
+	if flagIsSet(c, dloadExtFlag) {
+		flt.Exts = splitCsv(parseStrFlag(c, dloadExtFlag))
+	}
+	if flt.MinSize, err = parseSizeFlag(c, dloadMinSizeFlag); err != nil {
+		return nil, err
+	}
+	if flt.MaxSize, err = parseSizeFlag(c, dloadMaxSizeFlag); err != nil {
+		return nil, err
+	}
+	return flt, flt.Validate()
+}
+
+// start download job from (exported) JSON spec
+func startDownloadSpec(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return incorrectUsageMsg(c, "flag %s and command line arguments (%v) are mutually exclusive",
+			qflprn(dloadSpecFlag), c.Args())
+	}
+	var (
+		spec dload.Body
+		path = parseStrFlag(c, dloadSpecFlag)
+		b    []byte
+		err  error
+	)
+	if path == fileStdIO {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+	if err := jsoniter.Unmarshal(b, &spec); err != nil {
+		return fmt.Errorf("invalid download job specification %q: %v", path, err)
+	}
+	if !dload.IsType(string(spec.Type)) {
+		return fmt.Errorf("invalid download job specification %q: unknown type %q", path, spec.Type)
+	}
+	// secrets are never exported - re-add if need be
+	if spec.Type == dload.TypeHF && flagIsSet(c, hfTokenFlag) {
+		var m map[string]any
+		if err := jsoniter.Unmarshal(spec.RawMessage, &m); err != nil {
+			return err
+		}
+		m["token"] = parseStrFlag(c, hfTokenFlag)
+		spec.RawMessage = cos.MustMarshal(m)
+	}
+	id, err := api.DownloadWithParam(apiBP, spec.Type, spec.RawMessage)
+	if err != nil {
+		return V(err)
+	}
+	return startedDownload(c, id)
+}
 
+func startedDownload(c *cli.Context, id string) error {
 	fmt.Fprintf(c.App.Writer, "Started download job %s\n", id)
 
 	if flagIsSet(c, progressFlag) {
@@ -736,7 +971,11 @@ func stopJobHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	if name == "" && xid == "" && !flagIsSet(c, allRunningJobsFlag) {
+	if name, bck, err = stopJobFilters(c, name, bck); err != nil {
+		return err
+	}
+	filtered := flagIsSet(c, jobKindFlag) || flagIsSet(c, jobBucketFlag) || flagIsSet(c, jobOlderThanFlag)
+	if name == "" && xid == "" && !flagIsSet(c, allRunningJobsFlag) && !filtered {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
 	if daemonID != "" {
@@ -768,6 +1007,14 @@ func stopJobHandler(c *cli.Context) error {
 			actionWarn(c, "ignoring "+warn+" -"+NIY)
 		}
 	}
+	if filtered {
+		switch {
+		case xid != "":
+			actionWarn(c, fmt.Sprintf("ignoring job selection flags in presence of %s argument ('%s')", jobIDArgument, xid))
+		case name == cmdDownload || name == cmdDsort || name == commandETL || name == commandRebalance:
+			actionWarn(c, fmt.Sprintf("ignoring job selection flags: not supported for '%s'", name))
+		}
+	}
 
 	var (
 		otherID         string
@@ -784,9 +1031,9 @@ func stopJobHandler(c *cli.Context) error {
 		}
 	}
 
-	// confirm unless
-	if xactID == "" && name != "" {
-		if name != commandRebalance && !flagIsSet(c, yesFlag) && !flagIsSet(c, allRunningJobsFlag) {
+	// confirm unless (generic xactions are confirmed below, with a summary of what's to be stopped)
+	if xactID == "" && (name == cmdDownload || name == cmdDsort || name == commandETL) {
+		if !flagIsSet(c, yesFlag) && !flagIsSet(c, allRunningJobsFlag) {
 			prompt := fmt.Sprintf("Stop all '%s' jobs?", name)
 			if ok := confirm(c, prompt); !ok {
 				return nil
@@ -856,29 +1103,140 @@ func stopJobHandler(c *cli.Context) error {
 	return nil
 }
 
+// `--kind` and `--bucket` are alternatives to the positional (job name, bucket) arguments
+func stopJobFilters(c *cli.Context, name string, bck cmn.Bck) (string, cmn.Bck, error) {
+	if flagIsSet(c, jobKindFlag) {
+		kind := parseStrFlag(c, jobKindFlag)
+		xactKind, xactName := xact.GetSimilar(kind)
+		if xactKind == "" {
+			return name, bck, fmt.Errorf("invalid %s: unknown job kind %q", qflprn(jobKindFlag), kind)
+		}
+		if k := cos.Left(xactName, xactKind); name == "" {
+			name = k
+		} else if name != k {
+			return name, bck, incorrectUsageMsg(c, "job name '%s' vs %s %q", name, qflprn(jobKindFlag), kind)
+		}
+	}
+	if flagIsSet(c, jobBucketFlag) {
+		uri := parseStrFlag(c, jobBucketFlag)
+		fbck, err := parseBckURI(c, uri, false)
+		if err != nil {
+			return name, bck, err
+		}
+		if !bck.IsEmpty() && !bck.Equal(&fbck) {
+			return name, bck, incorrectUsageMsg(c, "bucket %s vs %s %q", bck.Cname(""), qflprn(jobBucketFlag), uri)
+		}
+		bck = fbck
+	}
+	return name, bck, nil
+}
+
+// running job (xaction), as seen across all nodes
+type bulkJob struct {
+	started time.Time
+	kind    string
+	xid     string
+	bck     string
+	etl     string // ETL name, if any (stopping ETL stops its inline-transform xaction as well)
+	nodes   int
+}
+
 // NOTE: the '--all' case when both (xactKind == "" && xname == "") - is also handled here
-// TODO: aistore supports `bck` for additional filtering (NIY)
 func stopXactionKindOrAll(c *cli.Context, xactKind, xname string, bck cmn.Bck) error {
-	cnames, err := api.GetAllRunningXactions(apiBP, xactKind)
+	var (
+		olderThan time.Duration
+		xargs     = xact.ArgsMsg{Kind: xactKind, Bck: bck, OnlyRunning: true}
+	)
+	if flagIsSet(c, jobOlderThanFlag) {
+		olderThan = parseDurationFlag(c, jobOlderThanFlag)
+	}
+	xs, _, err := queryXactions(&xargs, false)
 	if err != nil {
-		return V(err)
+		return err
+	}
+
+	// aggregate across nodes
+	jobs := make(map[string]*bulkJob, 8)
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			if !snap.Running() {
+				continue
+			}
+			j, ok := jobs[snap.ID]
+			if !ok {
+				j = &bulkJob{started: snap.StartTime, kind: snap.Kind, xid: snap.ID, bck: fmtJobBck(snap)}
+				jobs[snap.ID] = j
+			} else if snap.StartTime.Before(j.started) {
+				j.started = snap.StartTime
+			}
+			j.nodes++
+		}
+	}
+	if (xactKind == "" || xactKind == apc.ActETLInline) && bck.IsEmpty() {
+		if err := addETLJobs(jobs, olderThan > 0); err != nil {
+			return err
+		}
 	}
-	if len(cnames) == 0 {
+	now := time.Now()
+	selected := make([]*bulkJob, 0, len(jobs))
+	for _, j := range jobs {
+		if olderThan > 0 && now.Sub(j.started) < olderThan {
+			continue
+		}
+		selected = append(selected, j)
+	}
+	if len(selected) == 0 {
 		var what string
 		if xname != "" {
 			what = " '" + xname + "'"
 		}
-		actionDone(c, fmt.Sprintf("No running%s jobs, nothing to do", what))
+		actionDone(c, fmt.Sprintf("No running%s jobs%s, nothing to do", what, fmtJobFilters(bck, olderThan)))
 		return nil
 	}
-	for _, cname := range cnames {
-		xactKind, xactID, err := xact.ParseCname(cname)
-		if err != nil {
-			debug.AssertNoErr(err)
-			continue
+	sort.Slice(selected, func(i, j int) bool { return selected[i].started.Before(selected[j].started) })
+
+	// summary and confirmation
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "JOB ID\t KIND\t BUCKET\t STARTED\t RUNNING\t NODES")
+	for _, j := range selected {
+		var (
+			xid, kind         = cos.Left(j.xid, "-"), j.kind
+			started, duration = teb.NotSetVal, teb.NotSetVal
+		)
+		if j.etl != "" {
+			kind += " (" + commandETL + " " + j.etl + ")"
+		}
+		if !j.started.IsZero() {
+			started, duration = teb.FmtTime(j.started), teb.FormatDuration(now.Sub(j.started))
+		}
+		fmt.Fprintf(tw, "%s\t %s\t %s\t %s\t %s\t %d\n", xid, kind, j.bck, started, duration, j.nodes)
+	}
+	tw.Flush()
+	fmt.Fprintln(c.App.Writer)
+
+	// (`--all` remains non-interactive)
+	n := len(selected)
+	if !flagIsSet(c, yesFlag) && !flagIsSet(c, allRunningJobsFlag) {
+		if ok := confirm(c, fmt.Sprintf("Stop %d job%s?", n, cos.Plural(n))); !ok {
+			return nil
+		}
+	}
+
+	// stop
+	for _, j := range selected {
+		var (
+			err   error
+			cname = xact.Cname(j.kind, j.xid)
+		)
+		if j.etl != "" {
+			cname = "ETL[" + j.etl + "]"
+			err = api.ETLStop(apiBP, j.etl)
+		} else {
+			args := xact.ArgsMsg{ID: j.xid, Kind: j.kind}
+			err = xstop(&args)
 		}
-		args := xact.ArgsMsg{ID: xactID, Kind: xactKind, Bck: bck}
-		if err := xstop(&args); err != nil {
+		if err != nil {
 			actionWarn(c, fmt.Sprintf("failed to stop %s: %v", cname, err))
 		} else {
 			actionDone(c, "Stopped "+cname)
@@ -887,6 +1245,47 @@ func stopXactionKindOrAll(c *cli.Context, xactKind, xname string, bck cmn.Bck) e
 	return nil
 }
 
+// running ETLs: associate with their (inline-transform) xactions, if any;
+// otherwise, add as separate jobs (unless filtering by age, which is unknown)
+func addETLJobs(jobs map[string]*bulkJob, byAge bool) error {
+	etls, err := api.ETLList(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	for _, info := range etls {
+		if j, ok := jobs[info.XactID]; ok && info.XactID != "" {
+			j.etl = info.Name
+			continue
+		}
+		if byAge {
+			continue
+		}
+		jobs[commandETL+"/"+info.Name] = &bulkJob{kind: commandETL, xid: info.XactID, bck: "-", etl: info.Name}
+	}
+	return nil
+}
+
+func fmtJobBck(snap *core.Snap) string {
+	switch {
+	case !snap.SrcBck.IsEmpty() && !snap.DstBck.IsEmpty():
+		return snap.SrcBck.Cname("") + " => " + snap.DstBck.Cname("")
+	case !snap.Bck.IsEmpty():
+		return snap.Bck.Cname("")
+	default:
+		return "-"
+	}
+}
+
+func fmtJobFilters(bck cmn.Bck, olderThan time.Duration) (s string) {
+	if !bck.IsEmpty() {
+		s = " on " + bck.Cname("")
+	}
+	if olderThan > 0 {
+		s += " running longer than " + olderThan.String()
+	}
+	return s
+}
+
 func formatXactMsg(xactID, xactKind string, bck cmn.Bck) string {
 	var sb string
 	if !bck.IsQuery() {
@@ -1152,6 +1551,82 @@ func waitDsortHandler(c *cli.Context, id string) error {
 // job remove
 //
 
+func describeDownloadHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	spec, err := api.DownloadDescribe(apiBP, c.Args().Get(0))
+	if err != nil {
+		return V(err)
+	}
+	b, err := spec.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, b, "", "    "); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer, out.String())
+	return nil
+}
+
+func boostDownloadHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	id := c.Args().Get(0)
+	status, err := api.DownloadStatus(apiBP, id, false /*onlyActive*/)
+	if err != nil {
+		return V(err)
+	}
+	if !status.JobRunning() {
+		return fmt.Errorf("download job %q is not running (tip: 'ais show job %s')", id, id)
+	}
+	if err := api.BoostDownload(apiBP, id); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Boosted download job %q: connection and bandwidth limits lifted", id))
+	return nil
+}
+
+func pauseDownloadHandler(c *cli.Context) error  { return pauseResumeDownload(c, true) }
+func resumeDownloadHandler(c *cli.Context) error { return pauseResumeDownload(c, false) }
+
+func pauseResumeDownload(c *cli.Context, pause bool) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	id := c.Args().Get(0)
+	status, err := api.DownloadStatus(apiBP, id, false /*onlyActive*/)
+	if err != nil {
+		return V(err)
+	}
+	if !status.JobRunning() {
+		return fmt.Errorf("download job %q is not running (tip: 'ais show job %s')", id, id)
+	}
+	if pause {
+		if status.Paused {
+			fmt.Fprintf(c.App.Writer, "Download job %q is already paused\n", id)
+			return nil
+		}
+		if err := api.PauseDownload(apiBP, id); err != nil {
+			return V(err)
+		}
+		actionDone(c, fmt.Sprintf("Paused download job %q (to resume, run 'ais job resume download %s')", id, id))
+		return nil
+	}
+	if !status.Paused {
+		fmt.Fprintf(c.App.Writer, "Download job %q is not paused\n", id)
+		return nil
+	}
+	if err := api.ResumeDownload(apiBP, id); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Resumed download job %q", id))
+	return nil
+}
+
 func removeDownloadHandler(c *cli.Context) error {
 	regex := parseStrFlag(c, regexJobsFlag)
 	if flagIsSet(c, allFinishedJobsFlag) || regex != "" {
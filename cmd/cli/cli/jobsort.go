@@ -0,0 +1,209 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles 'ais show job --sort ... --top N'
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+// sort keys
+const (
+	jobSortCPU   = "cpu"   // total running time summed across targets (jobs don't report CPU usage per se)
+	jobSortBytes = "bytes" // bytes processed locally, sent, and received - summed across targets
+	jobSortAge   = "age"   // oldest first
+)
+
+// one row per job (cluster-wide)
+type jobSummary struct {
+	Start   time.Time `json:"start-time"`
+	End     time.Time `json:"end-time"` // zero when still running on any target
+	Name    string    `json:"name"`
+	ID      string    `json:"id"`
+	Kind    string    `json:"kind"`
+	User    string    `json:"user,omitempty"` // submitter
+	Objs    int64     `json:"objs,string"`
+	Bytes   int64     `json:"bytes,string"`
+	RunTime int64     `json:"run-time-ns,string"`
+	Targets int       `json:"targets"`
+	Running bool      `json:"running"`
+	Aborted bool      `json:"aborted"`
+}
+
+func showJobsSorted(c *cli.Context, name, daemonID string, bck cmn.Bck) (int, error) {
+	sortBy := parseStrFlag(c, jobSortFlag)
+	switch sortBy {
+	case "":
+		sortBy = jobSortAge
+	case jobSortCPU, jobSortBytes, jobSortAge:
+	default:
+		return 0, fmt.Errorf("invalid %s value %q (expecting one of: %s, %s, %s)",
+			qflprn(jobSortFlag), sortBy, jobSortCPU, jobSortBytes, jobSortAge)
+	}
+	top := parseIntFlag(c, jobTopFlag)
+	if top < 0 {
+		return 0, fmt.Errorf("invalid %s value %d (expecting a positive number)", qflprn(jobTopFlag), top)
+	}
+
+	var regex *regexp.Regexp
+	if s := parseStrFlag(c, regexJobsFlag); s != "" {
+		var err error
+		if regex, err = regexp.Compile(s); err != nil {
+			return 0, err
+		}
+	}
+	xargs := xact.ArgsMsg{
+		DaemonID:    daemonID,
+		Bck:         bck,
+		OnlyRunning: !flagIsSet(c, allJobsFlag),
+		User:        parseStrFlag(c, jobUserFlag),
+	}
+	if name != "" {
+		xargs.Kind, _ = xact.GetKindName(name)
+	}
+	xs, _, err := queryXactions(&xargs, false /*summarize*/)
+	if err != nil {
+		return 0, err
+	}
+
+	jobs := summarizeJobs(xs, time.Now(), regex)
+	sortJobs(jobs, sortBy)
+	if top > 0 && len(jobs) > top {
+		jobs = jobs[:top]
+	}
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+	if flagIsSet(c, jsonFlag) {
+		return len(jobs), teb.Print(jobs, "", teb.Jopts(true))
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return 0, err
+	}
+	printJobSummaries(c, jobs, units)
+	return len(jobs), nil
+}
+
+func summarizeJobs(xs xact.MultiSnap, now time.Time, regex *regexp.Regexp) []*jobSummary {
+	byID := make(map[string]*jobSummary, 16)
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			if !snap.Started() {
+				continue
+			}
+			js, ok := byID[snap.ID]
+			if !ok {
+				_, dname := xact.GetKindName(snap.Kind)
+				js = &jobSummary{Name: cos.Left(dname, snap.Kind), ID: snap.ID, Kind: snap.Kind, Start: snap.StartTime}
+				byID[snap.ID] = js
+			}
+			js.add(snap, now)
+		}
+	}
+	jobs := make([]*jobSummary, 0, len(byID))
+	for _, js := range byID {
+		if regex != nil && !regex.MatchString(js.Name) && !regex.MatchString(js.Kind) {
+			continue
+		}
+		if js.Running {
+			js.End = time.Time{}
+		}
+		jobs = append(jobs, js)
+	}
+	return jobs
+}
+
+func (js *jobSummary) add(snap *core.Snap, now time.Time) {
+	js.Targets++
+	if js.User == "" {
+		js.User = snap.User
+	}
+	js.Objs += snap.Stats.Objs
+	js.Bytes += snap.Stats.Bytes + snap.Stats.OutBytes + snap.Stats.InBytes
+	if snap.StartTime.Before(js.Start) {
+		js.Start = snap.StartTime
+	}
+	end := snap.EndTime
+	if snap.Running() {
+		js.Running = true
+		end = now
+	} else if end.After(js.End) {
+		js.End = end
+	}
+	if !end.IsZero() {
+		js.RunTime += int64(end.Sub(snap.StartTime))
+	}
+	js.Aborted = js.Aborted || snap.IsAborted()
+}
+
+func sortJobs(jobs []*jobSummary, sortBy string) {
+	sort.SliceStable(jobs, func(i, j int) bool {
+		a, b := jobs[i], jobs[j]
+		switch sortBy {
+		case jobSortCPU:
+			if a.RunTime != b.RunTime {
+				return a.RunTime > b.RunTime
+			}
+		case jobSortBytes:
+			if a.Bytes != b.Bytes {
+				return a.Bytes > b.Bytes
+			}
+		}
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		return a.ID < b.ID
+	})
+}
+
+func printJobSummaries(c *cli.Context, jobs []*jobSummary, units string) {
+	var (
+		now     = time.Now()
+		tw      = &tabwriter.Writer{}
+		hasUser bool
+	)
+	for _, js := range jobs {
+		hasUser = hasUser || js.User != ""
+	}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		hdr := "JOB\t TARGETS\t STATE\t STARTED\t AGE\t RUN-TIME (ALL TARGETS)\t OBJECTS\t BYTES"
+		if hasUser {
+			hdr += "\t USER"
+		}
+		fmt.Fprintln(tw, hdr)
+	}
+	for _, js := range jobs {
+		state := "finished"
+		switch {
+		case js.Aborted:
+			state = "aborted"
+		case js.Running:
+			state = "running"
+		}
+		fmt.Fprintf(tw, "%s\t %d\t %s\t %s\t %s\t %s\t %s\t %s",
+			_jname(js.Name, js.ID), js.Targets, state, teb.FmtTime(js.Start),
+			teb.FmtDuration(int64(now.Sub(js.Start)), units), teb.FmtDuration(js.RunTime, units),
+			strconv.FormatInt(js.Objs, 10), teb.FmtSize(js.Bytes, units, 2))
+		if hasUser {
+			fmt.Fprintf(tw, "\t %s", cos.Left(js.User, "-"))
+		}
+		fmt.Fprintln(tw)
+	}
+	tw.Flush()
+}
@@ -41,6 +41,7 @@ var (
 			longRunFlags,
 			logSevFlag,
 			logFlushFlag,
+			logReqIDFlag,
 		),
 		commandGet: append(
 			longRunFlags,
@@ -84,9 +85,75 @@ var (
 )
 
 func showNodeLogHandler(c *cli.Context) error {
+	if flagIsSet(c, logReqIDFlag) {
+		return showReqIDLog(c)
+	}
 	return _currentLog(c)
 }
 
+// grep current log(s) for a given request ID
+func showReqIDLog(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if flagIsSet(c, refreshFlag) {
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(logReqIDFlag), qflprn(refreshFlag))
+	}
+	sev, err := parseLogSev(c)
+	if err != nil {
+		return err
+	}
+	var (
+		nodes []*meta.Snode
+		rid   = parseStrFlag(c, logReqIDFlag)
+	)
+	if c.Args().Get(0) == clusterCompletion {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return err
+		}
+		nodes = append(smap.Pmap.ActiveNodes(), smap.Tmap.ActiveNodes()...)
+	} else {
+		node, _, err := getNode(c, c.Args().Get(0))
+		if err != nil {
+			return err
+		}
+		nodes = []*meta.Snode{node}
+	}
+
+	var found int
+	for _, node := range nodes {
+		var sb strings.Builder
+		if _, err := api.GetDaemonLog(apiBP, node, api.GetLogInput{Writer: &sb, Severity: sev}); err != nil {
+			actionWarn(c, node.StringEx()+" returned error: "+V(err).Error())
+			continue
+		}
+		for _, line := range grepLog(sb.String(), rid) {
+			if len(nodes) > 1 {
+				fmt.Fprint(c.App.Writer, node.StringEx(), ": ")
+			}
+			fmt.Fprintln(c.App.Writer, line)
+			found++
+		}
+	}
+	if found == 0 {
+		return fmt.Errorf("request %q not found in the current log%s (tip: older records may be in rotated logs, see 'ais log get --help')",
+			rid, cos.Plural(len(nodes)))
+	}
+	return nil
+}
+
+func grepLog(log, s string) (lines []string) {
+	for log != "" {
+		var line string
+		line, log, _ = strings.Cut(log, "\n")
+		if strings.Contains(line, s) {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
 func getLogHandler(c *cli.Context) error {
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
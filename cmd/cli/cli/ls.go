@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -51,6 +52,13 @@ func listBckTable(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks, lsb lsbCtx)
 			DontAddRemote: flagIsSet(c, dontAddRemoteFlag),
 		}
 		prefix := cos.Left(parseStrFlag(c, listObjPrefixFlag), lsb.prefix /*as in: bucket/[prefix]*/)
+		// present buckets, entire content: no need to run summary jobs
+		if apc.IsFltPresent(lsb.fltPresence) && !lsb.countRemoteObjs && prefix == "" {
+			var ok bool
+			if cnt, ok = listBckTableUsage(c, qbck, bcks); ok {
+				return cnt
+			}
+		}
 		cnt = listBckTableWithSummary(c, qbck, bcks, args, prefix)
 	} else {
 		cnt = listBckTableNoSummary(c, qbck, bcks, lsb.fltPresence)
@@ -245,6 +253,50 @@ func listBckTableWithSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks,
 	return footer.nb
 }
 
+// (fast path) sizes and object counts cached by targets, plus creation and last-access times;
+// returns false when not supported by the cluster
+func listBckTableUsage(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks) (int, bool) {
+	all, err := api.ListBucketsUsage(apiBP, qbck)
+	if err != nil {
+		return 0, false
+	}
+	var (
+		size, nobj uint64
+		data       = make(cmn.AllBckUsage, 0, len(all))
+	)
+	for _, u := range all {
+		if !qbck.Contains(&u.Bck) || !bcks.Contains(&u.Bck) {
+			continue
+		}
+		data = append(data, u)
+		nobj += u.ObjCount
+		size += u.Size
+	}
+	if len(data) == 0 {
+		return 0, true
+	}
+	units, errU := parseUnitsFlag(c, unitsFlag)
+	if errU != nil {
+		actionWarn(c, errU.Error())
+	}
+	opts := teb.Opts{AltMap: teb.FuncMapUnits(units, false /*incl. calendar date*/)}
+	if flagIsSet(c, noHeaderFlag) {
+		teb.Print(data, teb.ListBucketsUsageBody, opts)
+	} else {
+		teb.Print(data, teb.ListBucketsUsageTmpl, opts)
+	}
+	if flagIsSet(c, noFooterFlag) || len(data) <= 1 {
+		return len(data), true
+	}
+	p := apc.DisplayProvider(qbck.Provider)
+	if qbck.IsRemoteAIS() {
+		p = "Remote " + p
+	}
+	foot := fmt.Sprintf("Total: [%s buckets: %d, objects %d, size %s] ========", p, len(data), nobj, teb.FmtSize(int64(size), units, 2))
+	fmt.Fprintln(c.App.Writer, fcyan(foot))
+	return len(data), true
+}
+
 func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpty bool) error {
 	// prefix and filter
 	lstFilter, prefixFromTemplate, err := newLstFilter(c)
@@ -275,6 +327,9 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 		addCachedCol = true
 		msg.SetFlag(apc.LsBckPresent) // default
 	}
+	if teb.Localized() {
+		msg.TimeFormat = teb.TimeLayout // to convert atimes as per CLI display config
+	}
 	if flagIsSet(c, verChangedFlag) {
 		if bck.IsAIS() {
 			return fmt.Errorf("flag %s requires remote bucket (have: %s)", qflprn(verChangedFlag), bck)
@@ -319,10 +374,13 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	}
 
 	var (
-		props    []string
-		propsStr = parseStrFlag(c, objPropsFlag)
-		catOnly  = flagIsSet(c, countAndTimeFlag)
+		props   []string
+		catOnly = flagIsSet(c, countAndTimeFlag)
 	)
+	propsStr, err := parsePropsFlag(c)
+	if err != nil {
+		return err
+	}
 	if propsStr != "" {
 		debug.Assert(apc.LsPropsSepa == ",", "',' is documented in 'objPropsFlag' usage and elsewhere")
 		props = splitCsv(propsStr) // split apc.LsPropsSepa
@@ -384,9 +442,19 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	}
 	msg.PageSize = pageSize
 
+	if flagIsSet(c, diffInventoryFlag) {
+		if flagIsSet(c, useInventoryFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(diffInventoryFlag), qflprn(useInventoryFlag))
+		}
+		return lsDiffInv(c, bck, msg)
+	}
+
 	// finally, setup lsargs
 	lsargs := api.ListArgs{Limit: limit}
 	if flagIsSet(c, useInventoryFlag) {
+		if bck.Provider != apc.AWS {
+			return listNativeInv(c, bck, msg, lstFilter, propsStr, limit, addCachedCol)
+		}
 		lsargs.Header = http.Header{
 			apc.HdrInventory: []string{"true"},
 			apc.HdrInvName:   []string{parseStrFlag(c, invNameFlag)},
@@ -471,6 +539,154 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 		addCachedCol, bck.IsRemote(), msg.IsFlagSet(apc.LsVerChanged))
 }
 
+// list objects using AIS-native bucket inventory (see 'ais job start make-inventory')
+func listNativeInv(c *cli.Context, bck cmn.Bck, msg *apc.LsoMsg, lstFilter *lstFilter, props string, limit int64,
+	addCachedCol bool) error {
+	if flagIsSet(c, invNameFlag) {
+		return fmt.Errorf("flag %s requires s3:// bucket (have: %s)", qflprn(invNameFlag), bck.Cname(""))
+	}
+	lst, inv, err := api.ListObjectsNativeInv(apiBP, bck, msg, parseStrFlag(c, invIDFlag))
+	if err != nil {
+		return V(err)
+	}
+	entries := lst.Entries
+	if limit > 0 && int(limit) < len(entries) {
+		entries = entries[:limit]
+	}
+	if err := printLso(c, entries, lstFilter, props, nil /*_listed*/, 0, addCachedCol, bck.IsRemote(), false); err != nil {
+		return err
+	}
+	if !flagIsSet(c, noFooterFlag) {
+		fmt.Fprintf(c.App.Writer, "(inventory %s, generated %s)\n", inv.ID, teb.FmtTimestamp(inv.Time, ""))
+	}
+	return nil
+}
+
+// compare live listing with bucket inventory (S3 or AIS-native)
+// NOTE: AIS-native inventory includes only in-cluster objects - hence '--cached' for remote buckets
+
+const (
+	invDiffCreated = "created"
+	invDiffDeleted = "deleted"
+	invDiffChanged = "changed"
+)
+
+type invDiffEnt struct {
+	Name    string
+	Status  string
+	Details string
+	Size    int64
+}
+
+func lsDiffInv(c *cli.Context, bck cmn.Bck, msg *apc.LsoMsg) error {
+	var (
+		invLst *cmn.LsoRes
+		what   string
+		err    error
+		invMsg = &apc.LsoMsg{Prefix: msg.Prefix}
+	)
+	msg.Props = strings.Join([]string{apc.GetPropsName, apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsVersion}, apc.LsPropsSepa)
+	msg.ClearFlag(apc.LsNameOnly | apc.LsNameSize)
+
+	// 1. inventory
+	if bck.Provider == apc.AWS {
+		invMsg.Props = msg.Props
+		lsargs := api.ListArgs{Header: http.Header{
+			apc.HdrInventory: []string{"true"},
+			apc.HdrInvName:   []string{parseStrFlag(c, invNameFlag)},
+			apc.HdrInvID:     []string{parseStrFlag(c, invIDFlag)},
+		}}
+		invLst, err = api.ListObjects(apiBP, bck, invMsg, lsargs)
+		what = "S3 inventory"
+	} else {
+		if flagIsSet(c, invNameFlag) {
+			return fmt.Errorf("flag %s requires s3:// bucket (have: %s)", qflprn(invNameFlag), bck.Cname(""))
+		}
+		var inv *api.NativeInv
+		invLst, inv, err = api.ListObjectsNativeInv(apiBP, bck, invMsg, parseStrFlag(c, invIDFlag))
+		if inv != nil {
+			what = fmt.Sprintf("inventory %s, generated %s", inv.ID, teb.FmtTimestamp(inv.Time, ""))
+		}
+	}
+	if err != nil {
+		return V(err)
+	}
+
+	// 2. live
+	lst, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{})
+	if err != nil {
+		return lsoErr(msg, err)
+	}
+
+	// 3. diff and show
+	diff := diffInv(lst.Entries, invLst.Entries, bck.IsAIS() /*compare checksums*/)
+	if len(diff) > 0 {
+		if err := teb.Print(diff, teb.InvDiffTmpl); err != nil {
+			return err
+		}
+	}
+	if !flagIsSet(c, noFooterFlag) {
+		var created, deleted, changed int
+		for _, en := range diff {
+			switch en.Status {
+			case invDiffCreated:
+				created++
+			case invDiffDeleted:
+				deleted++
+			default:
+				changed++
+			}
+		}
+		fmt.Fprintf(c.App.Writer, "Created: %d, deleted: %d, changed: %d (%s)\n", created, deleted, changed, what)
+	}
+	return nil
+}
+
+// merge-compare two listings by name; object size and version (and, optionally, checksum)
+// are compared only when both sides have them
+func diffInv(live, inv cmn.LsoEntries, cmpCksum bool) (diff []*invDiffEnt) {
+	less := func(entries cmn.LsoEntries) func(i, j int) bool {
+		return func(i, j int) bool { return entries[i].Name < entries[j].Name }
+	}
+	sort.Slice(live, less(live))
+	sort.Slice(inv, less(inv))
+
+	var i, j int
+	for i < len(live) || j < len(inv) {
+		switch {
+		case i < len(live) && cmn.IsNativeInvObj(live[i].Name):
+			i++
+		case j == len(inv) || (i < len(live) && live[i].Name < inv[j].Name):
+			diff = append(diff, &invDiffEnt{Name: live[i].Name, Status: invDiffCreated, Size: live[i].Size})
+			i++
+		case i == len(live) || inv[j].Name < live[i].Name:
+			diff = append(diff, &invDiffEnt{Name: inv[j].Name, Status: invDiffDeleted, Size: inv[j].Size})
+			j++
+		default:
+			if details := _diffEnt(live[i], inv[j], cmpCksum); details != "" {
+				diff = append(diff, &invDiffEnt{Name: live[i].Name, Status: invDiffChanged, Size: live[i].Size, Details: details})
+			}
+			i++
+			j++
+		}
+	}
+	return diff
+}
+
+func _diffEnt(en, inv *cmn.LsoEnt, cmpCksum bool) string {
+	var details []string
+	if en.Size != inv.Size {
+		details = append(details, fmt.Sprintf("size %s => %s", cos.ToSizeIEC(inv.Size, 2), cos.ToSizeIEC(en.Size, 2)))
+	}
+	if en.Version != "" && inv.Version != "" && en.Version != inv.Version {
+		details = append(details, fmt.Sprintf("version %s => %s", inv.Version, en.Version))
+	}
+	if cmpCksum && en.Checksum != "" && inv.Checksum != "" && en.Checksum != inv.Checksum {
+		details = append(details, fmt.Sprintf("checksum %s => %s", cos.SHead(inv.Checksum), cos.SHead(en.Checksum)))
+	}
+	return strings.Join(details, ", ")
+}
+
 func lsoErr(msg *apc.LsoMsg, err error) error {
 	if herr, ok := err.(*cmn.ErrHTTP); ok && msg.IsFlagSet(apc.LsBckPresent) {
 		if herr.TypeCode == "ErrRemoteBckNotFound" {
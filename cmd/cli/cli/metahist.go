@@ -0,0 +1,305 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles 'ais show cluster (smap | bmd) --history | --diff'.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/urfave/cli"
+)
+
+func metaHistTag(what string) string {
+	if what == apc.WhatBMDHist {
+		return "BMD"
+	}
+	return "Cluster map"
+}
+
+// primary, unless specified
+func metaHistNode(c *cli.Context, node *meta.Snode, sname string) (*meta.Snode, string, error) {
+	if node != nil {
+		return node, sname, nil
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return nil, "", err
+	}
+	return smap.Primary, smap.Primary.StringEx(), nil
+}
+
+func getMetaHist(node *meta.Snode, what string) ([]meta.MetaHist, error) {
+	out, err := api.GetNodeMeta(apiBP, node.ID(), what)
+	if err != nil {
+		return nil, V(err)
+	}
+	return out.([]meta.MetaHist), nil
+}
+
+// `ais show cluster (smap | bmd) --history [NODE]`
+func showMetaHist(c *cli.Context, node *meta.Snode, sname, what string) error {
+	node, sname, err := metaHistNode(c, node, sname)
+	if err != nil {
+		return err
+	}
+	hist, err := getMetaHist(node, what)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(hist, "", teb.Jopts(true))
+	}
+	tag := metaHistTag(what)
+	if len(hist) == 0 {
+		fmt.Fprintln(c.App.Writer, sname+": no "+tag+" history")
+		return nil
+	}
+	actionCptn(c, tag+" history as seen by:", sname)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "VERSION\t TIME\t ACTION\t ACTOR\t SUMMARY")
+	}
+	for i := range hist {
+		rec := &hist[i]
+		action := teb.NotSetVal
+		if rec.Action != "" {
+			action = rec.Action
+			if rec.Name != "" {
+				action += "[" + rec.Name + "]"
+			}
+		}
+		fmt.Fprintf(tw, "%d\t %s\t %s\t %s\t %s\n", rec.Version, teb.FmtDateTime(rec.Time), action,
+			rec.Actor, metaHistSummary(rec))
+	}
+	tw.Flush()
+	return nil
+}
+
+func metaHistSummary(rec *meta.MetaHist) string {
+	switch {
+	case rec.Smap != nil:
+		smap := rec.Smap
+		var primary string
+		if smap.Primary != nil {
+			primary = smap.Primary.StringEx()
+		}
+		return fmt.Sprintf("proxies: %d, targets: %d, primary: %s", len(smap.Pmap), len(smap.Tmap), primary)
+	case rec.BMD != nil:
+		var n int
+		rec.BMD.Range(nil, nil, func(*meta.Bck) bool { n++; return false })
+		return fmt.Sprintf("buckets: %d", n)
+	default:
+		return teb.NotSetVal
+	}
+}
+
+// `ais show cluster (smap | bmd) --diff V1 V2 [NODE]`
+func showMetaDiff(c *cli.Context, what string) error {
+	v1, v2, nodeArg, err := parseMetaDiffArgs(c)
+	if err != nil {
+		return err
+	}
+	var (
+		node  *meta.Snode
+		sname string
+	)
+	if nodeArg != "" {
+		if node, sname, err = getNode(c, nodeArg); err != nil {
+			return err
+		}
+	}
+	if node, sname, err = metaHistNode(c, node, sname); err != nil {
+		return err
+	}
+	hist, err := getMetaHist(node, what)
+	if err != nil {
+		return err
+	}
+	tag := metaHistTag(what)
+	recs := make([]*meta.MetaHist, 0, 2)
+	for _, v := range []int64{v1, v2} {
+		rec := findMetaHist(hist, v)
+		if rec == nil {
+			err := fmt.Errorf("%s version %d not found in the history as seen by %s", tag, v, sname)
+			if len(hist) > 0 {
+				err = fmt.Errorf("%v (available: %d through %d)", err, hist[0].Version, hist[len(hist)-1].Version)
+			}
+			return err
+		}
+		recs = append(recs, rec)
+	}
+	r1, r2 := recs[0], recs[1]
+
+	var changes []string
+	if what == apc.WhatBMDHist {
+		changes = diffBMD(r1.BMD, r2.BMD)
+	} else {
+		changes = diffSmap(r1.Smap, r2.Smap)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(changes, "", teb.Jopts(true))
+	}
+	actionCptn(c, fmt.Sprintf("%s v%d => v%d, as seen by:", tag, v1, v2), sname)
+	if len(changes) == 0 {
+		fmt.Fprintln(c.App.Writer, "no changes")
+		return nil
+	}
+	for _, s := range changes {
+		fmt.Fprintln(c.App.Writer, s)
+	}
+	return nil
+}
+
+// two versions and, optionally, node - in any order
+func parseMetaDiffArgs(c *cli.Context) (v1, v2 int64, nodeArg string, err error) {
+	var vers []int64
+	for _, arg := range c.Args() {
+		if v, errV := strconv.ParseInt(arg, 10, 64); errV == nil {
+			vers = append(vers, v)
+			continue
+		}
+		if nodeArg != "" {
+			return 0, 0, "", incorrectUsageMsg(c, "too many arguments: %q, %q", nodeArg, arg)
+		}
+		nodeArg = arg
+	}
+	if len(vers) != 2 {
+		err = fmt.Errorf("expecting two versions to compare, e.g.: '--%s 12 15' (see '--%s' for available versions)",
+			metaDiffFlag.Name, metaHistFlag.Name)
+		return 0, 0, "", err
+	}
+	return vers[0], vers[1], nodeArg, nil
+}
+
+func findMetaHist(hist []meta.MetaHist, ver int64) *meta.MetaHist {
+	for i := range hist {
+		if hist[i].Version == ver {
+			return &hist[i]
+		}
+	}
+	return nil
+}
+
+//
+// diff
+//
+
+func diffSmap(a, b *meta.Smap) (changes []string) {
+	if a == nil || b == nil {
+		return []string{"missing cluster map"}
+	}
+	if a.UUID != b.UUID {
+		changes = append(changes, fmt.Sprintf("~ cluster UUID: %s => %s", a.UUID, b.UUID))
+	}
+	if pa, pb := a.Primary, b.Primary; pa != nil && pb != nil && pa.ID() != pb.ID() {
+		changes = append(changes, fmt.Sprintf("~ primary: %s => %s", pa.StringEx(), pb.StringEx()))
+	}
+	for _, maps := range [][2]meta.NodeMap{{a.Pmap, b.Pmap}, {a.Tmap, b.Tmap}} {
+		ma, mb := maps[0], maps[1]
+		for _, id := range unionKeys(ma, mb) {
+			na, nb := ma[id], mb[id]
+			switch {
+			case na == nil:
+				changes = append(changes, fmt.Sprintf("+ %s %s", nb.StringEx(), nb.PubNet.URL))
+			case nb == nil:
+				changes = append(changes, fmt.Sprintf("- %s %s", na.StringEx(), na.PubNet.URL))
+			default:
+				changes = append(changes, diffSnode(na, nb)...)
+			}
+		}
+	}
+	return changes
+}
+
+func diffSnode(a, b *meta.Snode) (changes []string) {
+	sname := b.StringEx()
+	if a.Flags != b.Flags {
+		changes = append(changes, fmt.Sprintf("~ %s flags: %s => %s", sname, a.Fl2S(), b.Fl2S()))
+	}
+	for _, net := range []struct {
+		name   string
+		na, nb meta.NetInfo
+	}{
+		{cmn.NetPublic, a.PubNet, b.PubNet},
+		{cmn.NetIntraControl, a.ControlNet, b.ControlNet},
+		{cmn.NetIntraData, a.DataNet, b.DataNet},
+	} {
+		if net.na.URL != net.nb.URL {
+			changes = append(changes, fmt.Sprintf("~ %s %s URL: %s => %s", sname, net.name, net.na.URL, net.nb.URL))
+		}
+	}
+	return changes
+}
+
+func diffBMD(a, b *meta.BMD) (changes []string) {
+	if a == nil || b == nil {
+		return []string{"missing BMD"}
+	}
+	if a.UUID != b.UUID {
+		changes = append(changes, fmt.Sprintf("~ BMD UUID: %s => %s", a.UUID, b.UUID))
+	}
+	ma, mb := bmdProps(a), bmdProps(b)
+	for _, cname := range unionKeys(ma, mb) {
+		pa, pb := ma[cname], mb[cname]
+		switch {
+		case pa == nil:
+			changes = append(changes, "+ "+cname)
+		case pb == nil:
+			changes = append(changes, "- "+cname)
+		default:
+			fa, fb := propsFlat(pa), propsFlat(pb)
+			for _, name := range unionKeys(fa, fb) {
+				if fa[name] != fb[name] {
+					changes = append(changes, fmt.Sprintf("~ %s %s: %s => %s", cname, name, fa[name], fb[name]))
+				}
+			}
+		}
+	}
+	return changes
+}
+
+func bmdProps(bmd *meta.BMD) map[string]*cmn.Bprops {
+	m := make(map[string]*cmn.Bprops, 16)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		m[bck.Cname("")] = bck.Props
+		return false
+	})
+	return m
+}
+
+func propsFlat(props *cmn.Bprops) map[string]string {
+	m := make(map[string]string, 64)
+	err := cmn.IterFields(props, func(tag string, field cmn.IterField) (error, bool) {
+		m[tag] = fmt.Sprintf("%v", field.Value())
+		return nil, false
+	})
+	if err != nil {
+		m["(error)"] = err.Error()
+	}
+	return m
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, max(len(a), len(b)))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -9,14 +9,18 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
@@ -77,6 +81,19 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 			msg.NumWorkers = parseIntFlag(c, numListRangeWorkersFlag)
 		}
 	}
+	if rename, err := parseRenameFlags(c); err != nil {
+		return err
+	} else if rename != nil {
+		msg.Rename = rename
+	}
+	if err := parseJobQuotaFlags(c, &msg.JobQuota); err != nil {
+		return err
+	}
+	if msg.Rename != nil || msg.JobQuota.IsSet() {
+		if err := msg.Init(); err != nil {
+			return err
+		}
+	}
 	// 3. start copying/transforming
 	var (
 		xid   string
@@ -186,7 +203,10 @@ func _evictOne(c *cli.Context, shift int) error {
 		return lrCtx.do(c)
 	case oltp.objName == "": // 2. entire bucket
 		return evictBucket(c, bck)
-	default: // 3. one(?) obj to evict
+	case hasEvictFilter(c) || flagIsSet(c, evictOffloadFlag) || flagIsSet(c, dryRunFlag): // 3. one obj to select, offload, or estimate
+		lrCtx := &lrCtx{listObjs: oltp.objName, bck: bck}
+		return lrCtx.do(c)
+	default: // 4. one(?) obj to evict
 		err := api.EvictObject(apiBP, bck, oltp.objName)
 		if err == nil {
 			if !flagIsSet(c, nonverboseFlag) {
@@ -207,6 +227,26 @@ func _evictOne(c *cli.Context, shift int) error {
 	}
 }
 
+func hasEvictFilter(c *cli.Context) bool {
+	return flagIsSet(c, evictOlderThanFlag) || flagIsSet(c, evictMinSizeFlag)
+}
+
+func parseEvictFilter(c *cli.Context) (olderThan cos.Duration, minSize int64, err error) {
+	if flagIsSet(c, evictOlderThanFlag) {
+		d := parseDurationFlag(c, evictOlderThanFlag)
+		if d <= 0 {
+			return 0, 0, fmt.Errorf("invalid %s=%v (expecting positive duration)", flprn(evictOlderThanFlag), d)
+		}
+		olderThan = cos.Duration(d)
+	}
+	if flagIsSet(c, evictMinSizeFlag) {
+		if minSize, err = parseSizeFlag(c, evictMinSizeFlag); err != nil {
+			return 0, 0, err
+		}
+	}
+	return olderThan, minSize, nil
+}
+
 func rmHandler(c *cli.Context) error {
 	if flagIsSet(c, verboseFlag) && flagIsSet(c, nonverboseFlag) {
 		return incorrectUsageMsg(c, errFmtExclusive, qflprn(verboseFlag), qflprn(nonverboseFlag))
@@ -365,14 +405,17 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 
 	// 2. [DRY-RUN]
 	if flagIsSet(c, dryRunFlag) {
+		if verb := c.Command.Name; verb == commandEvict || (isAlias(c) && lastAliasedWord(c) == commandEvict) {
+			return lr.dryEvict(c, fileList)
+		}
 		lr.dry(c, fileList, &pt)
 		return
 	}
 
 	// 3. do
-	xid, kind, action, errV := lr._do(c, fileList)
+	xid, kind, action, err := lr._do(c, fileList)
 	if err != nil {
-		return V(errV)
+		return V(err)
 	}
 
 	// 4. format
@@ -455,6 +498,61 @@ func (lr *lrCtx) dry(c *cli.Context, fileList []string, pt *cos.ParsedTemplate)
 	}
 }
 
+// [DRY-RUN] evict: have targets count (in-cluster) objects and bytes that'd be evicted, and show the numbers
+func (lr *lrCtx) dryEvict(c *cli.Context, fileList []string) error {
+	if err := ensureRemoteProvider(lr.bck); err != nil {
+		return err
+	}
+	var (
+		err error
+		msg = &apc.EvdMsg{ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs}, DryRun: true}
+	)
+	if msg.OlderThan, msg.MinSize, err = parseEvictFilter(c); err != nil {
+		return err
+	}
+	xid, err := api.EvictObjects(apiBP, lr.bck, msg)
+	if err != nil {
+		return V(err)
+	}
+	return showDryRun(c, &xact.ArgsMsg{ID: xid, Kind: apc.ActEvictObjects})
+}
+
+// [DRY-RUN] wait for the job to finish and show per-target objects and bytes (that'd be removed)
+func showDryRun(c *cli.Context, xargs *xact.ArgsMsg) error {
+	if err := waitXact(xargs); err != nil {
+		return err
+	}
+	snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return V(err)
+	}
+
+	var (
+		objs, size int64
+		tids       = make([]string, 0, len(snaps))
+		tw         = &tabwriter.Writer{}
+	)
+	for tid := range snaps {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\t OBJECTS\t SIZE\t BYTES")
+	for _, tid := range tids {
+		for _, snap := range snaps[tid] {
+			if snap.ID != xargs.ID {
+				continue
+			}
+			objs += snap.Stats.Objs
+			size += snap.Stats.Bytes
+			fmt.Fprintf(tw, "%s\t %d\t %s\t %d\n", meta.Tname(tid), snap.Stats.Objs,
+				teb.FmtSize(snap.Stats.Bytes, "", 2), snap.Stats.Bytes)
+		}
+	}
+	fmt.Fprintf(tw, "TOTAL\t %d\t %s\t %d\n", objs, teb.FmtSize(size, "", 2), size)
+	return tw.Flush()
+}
+
 func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action string, err error) {
 	verb := c.Command.Name
 	if isAlias(c) {
@@ -491,9 +589,19 @@ func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action strin
 		if err = ensureRemoteProvider(lr.bck); err != nil {
 			return
 		}
-		xid, err = api.EvictMultiObj(apiBP, lr.bck, fileList, lr.tmplObjs)
-		kind = apc.ActEvictObjects
-		action = "evict"
+		msg := &apc.EvdMsg{ListRange: apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs}}
+		if msg.OlderThan, msg.MinSize, err = parseEvictFilter(c); err != nil {
+			return
+		}
+		if flagIsSet(c, evictOffloadFlag) {
+			xid, err = api.TierObjects(apiBP, lr.bck, msg)
+			kind = apc.ActTierObjects
+			action = "offload"
+		} else {
+			xid, err = api.EvictObjects(apiBP, lr.bck, msg)
+			kind = apc.ActEvictObjects
+			action = "evict"
+		}
 	default:
 		debug.Assert(false, "invalid subcommand: ", verb)
 	}
@@ -296,8 +296,11 @@ func showObjProps(c *cli.Context, bck cmn.Bck, objName string, silent bool) (not
 
 	if flagIsSet(c, allPropsFlag) {
 		propsFlag = apc.GetPropsAll
-	} else if flagIsSet(c, objPropsFlag) {
-		s := parseStrFlag(c, objPropsFlag)
+	} else if flagIsSet(c, objPropsFlag) || flagIsSet(c, viewFlag) {
+		s, err := parsePropsFlag(c)
+		if err != nil {
+			return false, err
+		}
 		propsFlag = splitCsv(s)
 	}
 
@@ -358,7 +361,7 @@ func propVal(op *cmn.ObjectProps, name string) (v string, err error) {
 	case apc.GetPropsChecksum:
 		v = op.Cksum.String()
 	case apc.GetPropsAtime:
-		v = cos.FormatNanoTime(op.Atime, "")
+		v = teb.FmtTimestamp(time.Unix(0, op.Atime), "")
 	case apc.GetPropsVersion:
 		v = op.Version()
 	case apc.GetPropsCached:
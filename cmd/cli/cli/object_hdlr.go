@@ -6,11 +6,14 @@ package cli
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
@@ -27,6 +30,7 @@ const objGetUsage = "get an object, a shard, an archived file, or a range of byt
 	indent4 + "\tassorted options further include:\n" +
 	indent4 + "\t- '--prefix' to get multiple objects in one shot (empty prefix for the entire bucket);\n" +
 	indent4 + "\t- '--extract' or '--archpath' to extract archived content;\n" +
+	indent4 + "\t- '--archpath-list' to extract many archived files (from many shards) in one shot;\n" +
 	indent4 + "\t- '--progress' and '--refresh' to watch progress bar;\n" +
 	indent4 + "\t- '-v' to produce verbose output when getting multiple objects."
 
@@ -71,7 +75,8 @@ const concatUsage = "append a file, a directory, or multiple files and/or direct
 	indent1 + "$ ais object concat docs ais://nnn/all-docs ### concatenate all files from docs/ directory."
 
 const setCustomArgument = objectArgument + " " + jsonKeyValueArgument + " | " + keyValuePairsArgument + ", e.g.:\n" +
-	indent1 + "mykey1=value1 mykey2=value2 OR (same) '{\"mykey1\":\"value1\", \"mykey2\":\"value2\"}'"
+	indent1 + "mykey1=value1 mykey2=value2 OR (same) '{\"mykey1\":\"value1\", \"mykey2\":\"value2\"}'\n" +
+	indent1 + "OR, to update multiple objects: BUCKET --from-csv FILE"
 
 var (
 	objectCmdsFlags = map[string][]cli.Flag{
@@ -104,8 +109,12 @@ var (
 			archmimeFlag,
 			archregxFlag,
 			archmodeFlag,
+			archpathListFlag,
+			archManifestFlag,
 			// archive, client side
 			extractFlag,
+			// built-in server-side filter
+			getFilterFlag,
 			// bucket inventory
 			useInventoryFlag,
 			invNameFlag,
@@ -143,6 +152,9 @@ var (
 		),
 		commandSetCustom: {
 			setNewCustomMDFlag,
+			setCustomFromFlag,
+			numSetCustomWorkersFlag,
+			progressFlag,
 		},
 		commandPromote: {
 			recursFlag,
@@ -158,9 +170,18 @@ var (
 			unitsFlag,
 			progressFlag,
 		},
+		commandPresign: {
+			presignExpireFlag,
+			presignPutFlag,
+			presignPrefixFlag,
+			jsonFlag,
+		},
 		commandCat: {
 			offsetFlag,
 			lengthFlag,
+			catRangeFlag,
+			followFlag,
+			refreshFlag,
 			archpathGetFlag,
 			cksumFlag,
 			forceFlag,
@@ -209,6 +230,18 @@ var (
 		Action:    setCustomPropsHandler,
 	}
 
+	objectCmdPresign = cli.Command{
+		Name: commandPresign,
+		Usage: "exchange user's (AuthN) token for a time-limited URL to GET or PUT the object (or objects with a given prefix)\n" +
+			indent1 + "without credentials, e.g.:\n" +
+			indent1 + "\t- 'ais object presign ais://abc/images/cat.jpg --expire 10m'\t- URL to read one object;\n" +
+			indent1 + "\t- 'ais object presign ais://abc/uploads/ --put --prefix-scope'\t- URL prefix to write any 'uploads/*' object",
+		ArgsUsage:    objectArgument,
+		Flags:        objectCmdsFlags[commandPresign],
+		Action:       presignHandler,
+		BashComplete: bucketCompletions(bcmplop{separator: true}),
+	}
+
 	objectCmdPrefetch = cli.Command{
 		Name:         commandPrefetch,
 		Usage:        prefetchUsage,
@@ -240,6 +273,8 @@ var (
 			objectCmdSetCustom,
 			objectCmdRemove,
 			objectCmdPrefetch,
+			objectCmdPresign,
+			objectCmdChecksum,
 			bucketObjCmdEvict,
 			makeAlias(showCmdObject, "", true, commandShow), // alias for `ais show`
 			{
@@ -431,7 +466,7 @@ func putStdin(c *cli.Context, a *putargs) error {
 	if err != nil {
 		return err
 	}
-	if err := putAppendChunks(c, a.dst.bck, a.dst.oname, os.Stdin, cksum.Type(), chunkSize); err != nil {
+	if err := putAppendChunks(c, a.dst.bck, a.dst.oname, os.Stdin, cksum.Type(), chunkSize, -1 /*size*/); err != nil {
 		return err
 	}
 	actionDone(c, fmt.Sprintf("PUT (standard input) => %s\n", a.dst.bck.Cname(a.dst.oname)))
@@ -505,5 +540,39 @@ func setCustomPropsHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, setCustomFromFlag) {
+		if objName != "" {
+			return incorrectUsageMsg(c, "expecting bucket (not object) with %s", qflprn(setCustomFromFlag))
+		}
+		return setCustomBulk(c, bck, parseStrFlag(c, setCustomFromFlag))
+	}
 	return setCustomProps(c, bck, objName)
 }
+
+func presignHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), flagIsSet(c, presignPrefixFlag) /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	msg := &apc.PresignMsg{
+		Method:  http.MethodGet,
+		Expires: cos.Duration(parseDurationFlag(c, presignExpireFlag)),
+		Prefix:  flagIsSet(c, presignPrefixFlag),
+	}
+	if flagIsSet(c, presignPutFlag) {
+		msg.Method = http.MethodPut
+	}
+	res, err := api.PresignObject(apiBP, bck, objName, msg)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(res, "", teb.Jopts(true))
+	}
+	fmt.Fprintln(c.App.Writer, res.URL)
+	fmt.Fprintf(c.App.ErrWriter, "(%s, expires %s)\n", res.Method, teb.FmtTimestamp(res.Expires, time.RFC3339))
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/urfave/cli"
 )
 
@@ -286,3 +287,17 @@ func dopOLTP(c *cli.Context, bck cmn.Bck, objNameOrTmpl string) (oltp oltp, err
 
 	return oltp, err
 }
+
+// explicit selection: list of names or range template (i.e., not a prefix)
+// that can be resolved without listing the source bucket
+// (e.g., to copy or prefetch remote objects that are not present in-cluster)
+func (oltp *oltp) explicit() bool {
+	if oltp.list != "" || oltp.objName != "" {
+		return true
+	}
+	if oltp.tmpl == "" {
+		return false
+	}
+	pt, err := cos.NewParsedTemplate(oltp.tmpl)
+	return err == nil && len(pt.Ranges) > 0
+}
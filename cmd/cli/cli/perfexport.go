@@ -0,0 +1,422 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais performance export`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+// `ais performance export`: sample all nodes' performance counters over a given duration
+// and write a self-contained bundle - either Prometheus text exposition (with timestamps)
+// or OTLP/JSON (`ExportMetricsServiceRequest`) - for offline analysis
+
+const (
+	perfFormatProm = "prom"
+	perfFormatOtel = "otel"
+
+	perfExportPrefix = "ais_" // Prometheus metric name prefix
+)
+
+var (
+	perfExportFlags = []cli.Flag{
+		perfFormatFlag,
+		perfDurationFlag,
+		perfIntervalFlag,
+		regexFlag,
+	}
+	perfFormatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "output format: '" + perfFormatProm + "' (Prometheus text) or '" + perfFormatOtel + "' (OTLP JSON)",
+		Value: perfFormatProm,
+	}
+	perfDurationFlag = DurationFlag{
+		Name:  "duration",
+		Usage: "total sampling duration; valid time units: " + timeUnits,
+		Value: 10 * time.Minute,
+	}
+	perfIntervalFlag = DurationFlag{
+		Name:  "interval",
+		Usage: "sampling interval (min 1s); valid time units: " + timeUnits,
+		Value: 10 * time.Second,
+	}
+
+	perfExportCmd = cli.Command{
+		Name: cmdPerfExport,
+		Usage: "sample cluster performance counters for a given duration and write a self-contained bundle, e.g.:\n" +
+			indent1 + "\t- 'ais performance export --duration 10m'\t- Prometheus text, to a generated file name;\n" +
+			indent1 + "\t- 'ais performance export --format otel --duration 1m --interval 5s perf.json';\n" +
+			indent1 + "\t- 'ais performance export --duration 30s -'\t- write to standard output",
+		ArgsUsage: perfExportArgument,
+		Flags:     perfExportFlags,
+		Action:    perfExportHandler,
+	}
+)
+
+type (
+	perfPoint struct {
+		ts    time.Time
+		value int64
+	}
+	perfSeries struct {
+		node   *meta.Snode
+		points []perfPoint
+	}
+	// metric name => node ID => series
+	perfBundle struct {
+		smap    *meta.Smap
+		kinds   cos.StrKVs
+		metrics map[string]map[string]*perfSeries
+		begin   time.Time
+		end     time.Time
+	}
+)
+
+func perfExportHandler(c *cli.Context) error {
+	var (
+		format   = parseStrFlag(c, perfFormatFlag)
+		duration = parseDurationFlag(c, perfDurationFlag)
+		interval = parseDurationFlag(c, perfIntervalFlag)
+		outFile  = c.Args().Get(0)
+	)
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "too many arguments: %v", c.Args())
+	}
+	switch format {
+	case perfFormatProm, perfFormatOtel:
+	default:
+		return fmt.Errorf("invalid %s %q (expecting %q or %q)", qflprn(perfFormatFlag), format, perfFormatProm, perfFormatOtel)
+	}
+	if interval < time.Second {
+		return fmt.Errorf("invalid %s %v (expecting 1s or greater)", qflprn(perfIntervalFlag), interval)
+	}
+	if duration < interval {
+		return fmt.Errorf("%s %v is smaller than %s %v", qflprn(perfDurationFlag), duration, qflprn(perfIntervalFlag), interval)
+	}
+	var regex string
+	if flagIsSet(c, regexFlag) {
+		regex = parseStrFlag(c, regexFlag)
+	}
+	if outFile == "" {
+		ext := ".prom"
+		if format == perfFormatOtel {
+			ext = ".json"
+		}
+		outFile = "ais-perf-" + time.Now().Format("20060102-150405") + ext
+	}
+
+	bundle, err := newPerfBundle(c, regex)
+	if err != nil {
+		return err
+	}
+	if outFile != fileStdIO {
+		actionNote(c, fmt.Sprintf("sampling %d metrics every %v for %v => %s", len(bundle.kinds), interval, duration, outFile))
+	}
+	if err := bundle.sample(c, duration, interval); err != nil {
+		return err
+	}
+
+	var w io.Writer = c.App.Writer
+	if outFile != fileStdIO {
+		fh, err := cos.CreateFile(outFile)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		w = fh
+	}
+	bw := bufio.NewWriter(w)
+	if format == perfFormatOtel {
+		err = bundle.writeOtel(bw)
+	} else {
+		err = bundle.writeProm(bw)
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		if outFile != fileStdIO {
+			os.Remove(outFile)
+		}
+		return err
+	}
+	if outFile != fileStdIO {
+		actionDone(c, fmt.Sprintf("Exported %d metrics from %d nodes to %s", len(bundle.metrics), bundle.numNodes(), outFile))
+	}
+	return nil
+}
+
+////////////////
+// perfBundle //
+////////////////
+
+func newPerfBundle(c *cli.Context, regex string) (*perfBundle, error) {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return nil, err
+	}
+	// metric names and kinds: union of (any) target and primary
+	kinds, err := getMetricNames(c)
+	if err != nil {
+		return nil, err
+	}
+	if kinds == nil {
+		kinds = make(cos.StrKVs)
+	}
+	pkinds, err := api.GetMetricNames(apiBP, smap.Primary)
+	if err != nil {
+		return nil, err
+	}
+	for name, kind := range pkinds {
+		if _, ok := kinds[name]; !ok {
+			kinds[name] = kind
+		}
+	}
+	if regex != "" {
+		re, err := regexp.Compile(regex)
+		if err != nil {
+			return nil, err
+		}
+		for name := range kinds {
+			if !re.MatchString(name) {
+				delete(kinds, name)
+			}
+		}
+		if len(kinds) == 0 {
+			return nil, fmt.Errorf("no metrics matching %s %q", qflprn(regexFlag), regex)
+		}
+	}
+	return &perfBundle{smap: smap, kinds: kinds, metrics: make(map[string]map[string]*perfSeries, len(kinds))}, nil
+}
+
+func (b *perfBundle) sample(c *cli.Context, duration, interval time.Duration) error {
+	b.begin = time.Now()
+	deadline := b.begin.Add(duration)
+	for {
+		_, tstatusMap, pstatusMap, err := fillNodeStatusMap(c, "" /*all nodes*/)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		b.add(tstatusMap, now)
+		b.add(pstatusMap, now)
+		b.end = now
+		if now.Add(interval).After(deadline) {
+			return nil
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (b *perfBundle) add(stmap teb.StstMap, ts time.Time) {
+	for sid, ds := range stmap {
+		for name, v := range ds.Tracker {
+			if _, ok := b.kinds[name]; !ok {
+				continue
+			}
+			bynode, ok := b.metrics[name]
+			if !ok {
+				bynode = make(map[string]*perfSeries, 4)
+				b.metrics[name] = bynode
+			}
+			series, ok := bynode[sid]
+			if !ok {
+				series = &perfSeries{node: ds.Snode}
+				bynode[sid] = series
+			}
+			series.points = append(series.points, perfPoint{ts: ts, value: v.Value})
+		}
+	}
+}
+
+func (b *perfBundle) numNodes() int {
+	nodes := make(cos.StrSet, b.smap.Count())
+	for _, bynode := range b.metrics {
+		for sid := range bynode {
+			nodes.Add(sid)
+		}
+	}
+	return len(nodes)
+}
+
+func (b *perfBundle) names() []string {
+	names := make([]string, 0, len(b.metrics))
+	for name := range b.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (*perfBundle) sids(bynode map[string]*perfSeries) []string {
+	sids := make([]string, 0, len(bynode))
+	for sid := range bynode {
+		sids = append(sids, sid)
+	}
+	sort.Strings(sids)
+	return sids
+}
+
+// monotonically increasing (cumulative) vs. point-in-time
+func perfIsCumulative(kind string) bool {
+	return kind == stats.KindCounter || kind == stats.KindSize || kind == stats.KindTotal
+}
+
+// e.g. "get.ns.total" => "ais_get_ns_total"
+func perfPromName(name string) string {
+	var sb strings.Builder
+	sb.Grow(len(perfExportPrefix) + len(name))
+	sb.WriteString(perfExportPrefix)
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
+
+// Prometheus text exposition format, with (millisecond) timestamps
+func (b *perfBundle) writeProm(w io.Writer) error {
+	fmt.Fprintf(w, "# AIStore performance export: cluster %s, version %s\n", b.smap.UUID, cmn.VersionAIStore)
+	fmt.Fprintf(w, "# begin %s, end %s\n", b.begin.Format(time.RFC3339), b.end.Format(time.RFC3339))
+	for _, name := range b.names() {
+		var (
+			kind   = b.kinds[name]
+			pname  = perfPromName(name)
+			ptype  = "gauge"
+			bynode = b.metrics[name]
+		)
+		if perfIsCumulative(kind) {
+			ptype = "counter"
+		}
+		if _, err := fmt.Fprintf(w, "# HELP %s %s (%s)\n# TYPE %s %s\n", pname, name, kind, pname, ptype); err != nil {
+			return err
+		}
+		for _, sid := range b.sids(bynode) {
+			series := bynode[sid]
+			for _, p := range series.points {
+				fmt.Fprintf(w, "%s{node_id=%q,node_type=%q} %d %d\n", pname, sid, series.node.Type(), p.value, p.ts.UnixMilli())
+			}
+		}
+	}
+	return nil
+}
+
+// OTLP/JSON (ref: opentelemetry-proto, metrics/v1)
+type (
+	otelKV struct {
+		Key   string            `json:"key"`
+		Value map[string]string `json:"value"`
+	}
+	otelPoint struct {
+		Attributes        []otelKV `json:"attributes"`
+		StartTimeUnixNano string   `json:"startTimeUnixNano,omitempty"`
+		TimeUnixNano      string   `json:"timeUnixNano"`
+		AsInt             string   `json:"asInt"`
+	}
+	otelSum struct {
+		DataPoints             []otelPoint `json:"dataPoints"`
+		AggregationTemporality int         `json:"aggregationTemporality"`
+		IsMonotonic            bool        `json:"isMonotonic"`
+	}
+	otelGauge struct {
+		DataPoints []otelPoint `json:"dataPoints"`
+	}
+	otelMetric struct {
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		Sum         *otelSum   `json:"sum,omitempty"`
+		Gauge       *otelGauge `json:"gauge,omitempty"`
+	}
+	otelScope struct {
+		Scope   map[string]string `json:"scope"`
+		Metrics []otelMetric      `json:"metrics"`
+	}
+	otelResource struct {
+		Resource     map[string][]otelKV `json:"resource"`
+		ScopeMetrics []otelScope         `json:"scopeMetrics"`
+	}
+	otelRequest struct {
+		ResourceMetrics []otelResource `json:"resourceMetrics"`
+	}
+)
+
+const otelCumulative = 2 // AGGREGATION_TEMPORALITY_CUMULATIVE
+
+func otelStr(key, value string) otelKV {
+	return otelKV{Key: key, Value: map[string]string{"stringValue": value}}
+}
+
+func (b *perfBundle) writeOtel(w io.Writer) error {
+	var (
+		metrics = make([]otelMetric, 0, len(b.metrics))
+		start   = strconv.FormatInt(b.begin.UnixNano(), 10)
+	)
+	for _, name := range b.names() {
+		var (
+			kind   = b.kinds[name]
+			bynode = b.metrics[name]
+			points = make([]otelPoint, 0, len(bynode))
+			cumult = perfIsCumulative(kind)
+		)
+		for _, sid := range b.sids(bynode) {
+			series := bynode[sid]
+			attrs := []otelKV{otelStr("node_id", sid), otelStr("node_type", series.node.Type())}
+			for _, p := range series.points {
+				pt := otelPoint{
+					Attributes:   attrs,
+					TimeUnixNano: strconv.FormatInt(p.ts.UnixNano(), 10),
+					AsInt:        strconv.FormatInt(p.value, 10),
+				}
+				if cumult {
+					pt.StartTimeUnixNano = start
+				}
+				points = append(points, pt)
+			}
+		}
+		m := otelMetric{Name: "ais." + name, Description: kind}
+		if cumult {
+			m.Sum = &otelSum{DataPoints: points, AggregationTemporality: otelCumulative, IsMonotonic: true}
+		} else {
+			m.Gauge = &otelGauge{DataPoints: points}
+		}
+		metrics = append(metrics, m)
+	}
+	req := otelRequest{
+		ResourceMetrics: []otelResource{{
+			Resource: map[string][]otelKV{"attributes": {
+				otelStr("service.name", "aistore"),
+				otelStr("service.version", cmn.VersionAIStore),
+				otelStr("ais.cluster.uuid", b.smap.UUID),
+			}},
+			ScopeMetrics: []otelScope{{
+				Scope:   map[string]string{"name": "ais-cli/performance-export"},
+				Metrics: metrics,
+			}},
+		}},
+	}
+	enc := jsoniter.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(&req)
+}
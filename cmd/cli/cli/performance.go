@@ -65,6 +65,7 @@ var (
 			showThroughput,
 			showLatency,
 			showCmdMpathCapacity,
+			perfExportCmd,
 			makeAlias(showCmdDisk, "", true /*silent*/, cmdShowDisk),
 		},
 	}
@@ -75,7 +76,7 @@ var (
 			indent2 + "\t- (GET, PUT, etc.) cumulative and average sizes;\n" +
 			indent2 + "\t- associated error counters, if any, and more.",
 		ArgsUsage:    optionalTargetIDArgument,
-		Flags:        showPerfFlags,
+		Flags:        append([]cli.Flag{deltaFlag, cumulativeFlag}, showPerfFlags...),
 		Action:       showCountersHandler,
 		BashComplete: suggestTargets,
 	}
@@ -169,9 +170,60 @@ func showCountersHandler(c *cli.Context) error {
 			selected[name] = kind
 		}
 	}
+	if flagIsSet(c, deltaFlag) {
+		return showPerfTab(c, selected, _latest, cmdShowCounters+" (delta)", nil, false)
+	}
+	if flagIsSet(c, cumulativeFlag) {
+		return fmt.Errorf("option %s requires %s", qflprn(cumulativeFlag), qflprn(deltaFlag))
+	}
 	return showPerfTab(c, selected, nil, cmdShowCounters, nil, false)
 }
 
+// update mapBegin <= mapEnd (the latest cumulative values); rates of change are computed separately (see _rates)
+func _latest(c *cli.Context, metrics cos.StrKVs, mapBegin, mapEnd teb.StstMap, _ time.Duration) (idle bool) {
+	idle = true
+	for tid, begin := range mapBegin {
+		end := mapEnd[tid]
+		if end == nil {
+			warn := fmt.Sprintf("missing %s in the get-stats-and-status results\n", meta.Tname(tid))
+			actionWarn(c, warn)
+			continue
+		}
+		for name := range metrics {
+			if begin.Tracker[name].Value != end.Tracker[name].Value {
+				idle = false
+				break
+			}
+		}
+		mapBegin[tid] = end
+	}
+	return idle
+}
+
+// per target, per metric: (end - begin) / elapsed
+// counter that went down (e.g., node restart) has no rate
+func _rates(metrics cos.StrKVs, mapBegin, mapEnd teb.StstMap, elapsed time.Duration) map[string]map[string]float64 {
+	var (
+		seconds = max(elapsed.Seconds(), 1)
+		rates   = make(map[string]map[string]float64, len(mapBegin))
+	)
+	for tid, begin := range mapBegin {
+		end := mapEnd[tid]
+		if end == nil || begin.Tracker == nil || end.Tracker == nil {
+			continue
+		}
+		r := make(map[string]float64, len(metrics))
+		for name := range metrics {
+			vbeg, vend := begin.Tracker[name].Value, end.Tracker[name].Value // (missing == zero)
+			if vend >= vbeg {
+				r[name] = float64(vend-vbeg) / seconds
+			}
+		}
+		rates[tid] = r
+	}
+	return rates
+}
+
 func showThroughputHandler(c *cli.Context) error {
 	var (
 		totals       = make(map[string]int64, 4) // throughput metrics ("columns") to tally up
@@ -492,6 +544,10 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 			cntRun.mapBegin = mapEnd
 		}
 
+		var rates map[string]map[string]float64
+		if flagIsSet(c, deltaFlag) {
+			rates = _rates(metrics, mapBegin, mapEnd, sleep) // before `cb` updates mapBegin
+		}
 		idle := cb(c, metrics, mapBegin, mapEnd, sleep) // call back to recompute
 		perfCptn(c, tag)
 
@@ -509,7 +565,8 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 		}
 
 		ctx := teb.PerfTabCtx{Smap: smap, Sid: tid, Metrics: metrics, Regex: regex, Units: units,
-			Totals: totals, TotalsHdr: totalsHdr, AvgSize: avgSize, Idle: idle, NoColor: cfg.NoColor}
+			Totals: totals, TotalsHdr: totalsHdr, AvgSize: avgSize, Idle: idle, NoColor: cfg.NoColor,
+			Rates: rates, Cumulative: flagIsSet(c, cumulativeFlag)}
 		table, _, err := ctx.MakeTab(mapBegin)
 		if err != nil {
 			return err
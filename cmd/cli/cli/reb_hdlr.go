@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -17,13 +18,15 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
 
 const (
-	showRebHdr = "REB ID\t NODE\t OBJECTS RECV\t SIZE RECV\t OBJECTS SENT\t SIZE SENT\t START\t END\t STATE"
+	showRebHdr       = "REB ID\t NODE\t OBJECTS RECV\t SIZE RECV\t OBJECTS SENT\t SIZE SENT\t START\t END\t STATE"
+	showRebVerifyHdr = "JOB ID\t NODE\t CHECKED\t MISPLACED\t MISPLACED MPATH\t BAD COPIES\t BAD EC\t STATE"
 )
 
 type targetRebSnap struct {
@@ -32,7 +35,7 @@ type targetRebSnap struct {
 }
 
 var (
-	showRebFlags = append(longRunFlags, allJobsFlag, noHeaderFlag, unitsFlag, dateTimeFlag)
+	showRebFlags = append(longRunFlags, allJobsFlag, noHeaderFlag, unitsFlag, dateTimeFlag, rebVerifyFlag, verboseFlag)
 
 	showCmdRebalance = cli.Command{
 		Name:      cmdRebalance,
@@ -61,6 +64,10 @@ func showRebalanceHandler(c *cli.Context) error {
 	}
 	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
 
+	if flagIsSet(c, rebVerifyFlag) {
+		return showRebVerify(c, tw, hideHeader)
+	}
+
 	// [REB_ID] [NODE_ID]
 	if c.NArg() > 0 {
 		arg := c.Args().Get(0)
@@ -204,3 +211,82 @@ func displayRebStats(tw *tabwriter.Writer, st *targetRebSnap, units string, date
 		startTime, endTime, teb.FmtXactRunFinAbrt(st.snap),
 	)
 }
+
+// show the latest post-rebalance verification (x-verify-rebalance)
+func showRebVerify(c *cli.Context, tw *tabwriter.Writer, hideHeader bool) error {
+	xargs := xact.ArgsMsg{Kind: apc.ActRebVerify}
+	snaps, err := api.QueryXactionSnaps(apiBP, &xargs)
+	if err != nil {
+		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
+			fmt.Fprintf(c.App.Writer, "No rebalance verification found. To start, run 'ais start %s'.\n", cmdRebVerify)
+			return nil
+		}
+		return V(err)
+	}
+	// latest only
+	var (
+		xid      string
+		latest   time.Time
+		allSnaps = make([]*targetRebSnap, 0, 32)
+	)
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.StartTime.After(latest) {
+				xid, latest = snap.ID, snap.StartTime
+			}
+		}
+	}
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID == xid {
+				allSnaps = append(allSnaps, &targetRebSnap{tid: tid, snap: snap})
+			}
+		}
+	}
+	if len(allSnaps) == 0 {
+		fmt.Fprintf(c.App.Writer, "No rebalance verification found. To start, run 'ais start %s'.\n", cmdRebVerify)
+		return nil
+	}
+	sort.Slice(allSnaps, func(i, j int) bool { return allSnaps[i].tid < allSnaps[j].tid })
+
+	if !hideHeader {
+		fmt.Fprintln(tw, showRebVerifyHdr)
+	}
+	var (
+		failed, checked int64
+		examples        []string
+	)
+	for _, ts := range allSnaps {
+		rep := &xact.RebVerifyReport{}
+		if err := cos.MorphMarshal(ts.snap.Ext, rep); err != nil {
+			return fmt.Errorf("%s: failed to parse verification report: %v", ts.tid, err)
+		}
+		if rep.Tid != ts.tid || (rep.ID != "" && rep.ID != ts.snap.ID) {
+			return fmt.Errorf("%s: verification report belongs to %s[%s]", ts.tid, rep.Tid, rep.ID)
+		}
+		fmt.Fprintf(tw, "%s\t %s\t %d\t %d\t %d\t %d\t %d\t %s\n",
+			ts.snap.ID, ts.tid, rep.Checked, rep.Misplaced, rep.MisplacedMi, rep.BadCopies, rep.BadEC,
+			teb.FmtXactRunFinAbrt(ts.snap))
+		checked += rep.Checked
+		failed += rep.Failed()
+		for _, e := range rep.Examples {
+			examples = append(examples, ts.tid+": "+e)
+		}
+	}
+	tw.Flush()
+
+	fmt.Fprintln(c.App.Writer)
+	if failed == 0 {
+		fmt.Fprintf(c.App.Writer, "%s: %d checked, no issues found\n", fcyan(xid), checked)
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "%s: %d checked, %s\n", fcyan(xid), checked, fred(strconv.FormatInt(failed, 10)+" issue(s) found"))
+	if flagIsSet(c, verboseFlag) {
+		for _, e := range examples {
+			fmt.Fprintln(c.App.Writer, indent1+e)
+		}
+	} else if len(examples) > 0 {
+		fmt.Fprintf(c.App.Writer, "Use %s to list offending objects\n", qflprn(verboseFlag))
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais cluster add-remove-nodes plan` - rebalance impact preview.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/urfave/cli"
+)
+
+const rebPlanUsage = "preview rebalance impact of adding and/or removing storage targets, e.g.:\n" +
+	indent4 + "\t - 'plan --add t[xyz]'\t- expected data movement (objects and sizes, per target) upon joining t[xyz];\n" +
+	indent4 + "\t - 'plan --remove t[abc] ais://nnn'\t- same for removing t[abc], in a given bucket only;\n" +
+	indent4 + "\t notes:\n" +
+	indent4 + "\t - the computation is exact (HRW over the current and the resulting cluster maps) but only includes\n" +
+	indent4 + "\t   in-cluster objects, i.e., main replicas (not copies, not EC slices) of the objects that are present;\n" +
+	indent4 + "\t - listing all objects in the cluster may take time"
+
+type (
+	rebPlanTarget struct {
+		Sname   string `json:"target"`
+		ObjsOut int64  `json:"objs_out"`
+		SizeOut int64  `json:"size_out"`
+		ObjsIn  int64  `json:"objs_in"`
+		SizeIn  int64  `json:"size_in"`
+	}
+	rebPlan struct {
+		Targets   []*rebPlanTarget `json:"targets"`
+		TotalObjs int64            `json:"total_objs"`
+		TotalSize int64            `json:"total_size"`
+		MoveObjs  int64            `json:"move_objs"`
+		MoveSize  int64            `json:"move_size"`
+	}
+)
+
+func rebPlanHandler(c *cli.Context) error {
+	if !flagIsSet(c, planAddFlag) && !flagIsSet(c, planRemoveFlag) {
+		return missingArgumentsError(c, qflprn(planAddFlag)+" and/or "+qflprn(planRemoveFlag))
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	next, err := _planSmap(c, smap)
+	if err != nil {
+		return err
+	}
+
+	// buckets
+	var bcks cmn.Bcks
+	if c.NArg() > 0 {
+		bck, err := parseBckURI(c, c.Args().Get(0), false /*error only*/)
+		if err != nil {
+			return err
+		}
+		bcks = cmn.Bcks{bck}
+	} else if bcks, err = api.ListBuckets(apiBP, cmn.QueryBcks{}, apc.FltPresent); err != nil {
+		return V(err)
+	}
+
+	// compute
+	var (
+		plan    = &rebPlan{}
+		targets = make(map[string]*rebPlanTarget, len(next.Tmap)+len(smap.Tmap))
+	)
+	_target := func(si *meta.Snode) *rebPlanTarget {
+		pt, ok := targets[si.ID()]
+		if !ok {
+			pt = &rebPlanTarget{Sname: si.StringEx()}
+			targets[si.ID()] = pt
+		}
+		return pt
+	}
+	for _, si := range smap.Tmap {
+		_target(si)
+	}
+	for _, si := range next.Tmap {
+		_target(si)
+	}
+	for i := range bcks {
+		bck := bcks[i]
+		lsmsg := &apc.LsoMsg{Flags: apc.LsObjCached | apc.LsNameSize}
+		lst, err := api.ListObjects(apiBP, bck, lsmsg, api.ListArgs{})
+		if err != nil {
+			return V(err)
+		}
+		for _, en := range lst.Entries {
+			uname := bck.MakeUname(en.Name)
+			from, err := smap.HrwName2T(uname)
+			if err != nil {
+				return err
+			}
+			to, err := next.HrwName2T(uname)
+			if err != nil {
+				return err
+			}
+			plan.TotalObjs++
+			plan.TotalSize += en.Size
+			if from.ID() == to.ID() {
+				continue
+			}
+			plan.MoveObjs++
+			plan.MoveSize += en.Size
+			src, dst := _target(from), _target(to)
+			src.ObjsOut++
+			src.SizeOut += en.Size
+			dst.ObjsIn++
+			dst.SizeIn += en.Size
+		}
+	}
+	plan.Targets = make([]*rebPlanTarget, 0, len(targets))
+	for _, pt := range targets {
+		plan.Targets = append(plan.Targets, pt)
+	}
+	sort.Slice(plan.Targets, func(i, j int) bool { return plan.Targets[i].Sname < plan.Targets[j].Sname })
+
+	// show
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(plan, "", teb.Jopts(true))
+	}
+	if err := teb.Print(plan, teb.RebPlanTmpl); err != nil {
+		return err
+	}
+	var pct float64
+	if plan.TotalSize > 0 {
+		pct = float64(plan.MoveSize) * 100 / float64(plan.TotalSize)
+	}
+	fmt.Fprintf(c.App.Writer, "\nExpected to move: %d objects (%s) out of %d (%s), or %.1f%% of the in-cluster data\n",
+		plan.MoveObjs, teb.FmtSize(plan.MoveSize, cos.UnitsIEC, 2), plan.TotalObjs,
+		teb.FmtSize(plan.TotalSize, cos.UnitsIEC, 2), pct)
+	return nil
+}
+
+// resulting cluster map (targets only)
+func _planSmap(c *cli.Context, smap *meta.Smap) (*meta.Smap, error) {
+	smap.InitDigests()
+	next := &meta.Smap{Tmap: make(meta.NodeMap, len(smap.Tmap))}
+	for tid, si := range smap.Tmap {
+		next.Tmap[tid] = si
+	}
+	if flagIsSet(c, planRemoveFlag) {
+		for _, name := range splitCsv(parseStrFlag(c, planRemoveFlag)) {
+			tid := meta.N2ID(name)
+			if smap.GetTarget(tid) == nil {
+				return nil, fmt.Errorf("cannot remove %s: not a target in the %s", name, smap.StringEx())
+			}
+			delete(next.Tmap, tid)
+		}
+	}
+	if flagIsSet(c, planAddFlag) {
+		for _, name := range splitCsv(parseStrFlag(c, planAddFlag)) {
+			tid := meta.N2ID(name)
+			if smap.GetNode(tid) != nil {
+				return nil, fmt.Errorf("cannot add %s: already a member of the %s", name, smap.StringEx())
+			}
+			si := &meta.Snode{}
+			si.Init(tid, apc.Target)
+			next.Tmap[tid] = si
+		}
+	}
+	if next.CountActiveTs() == 0 {
+		return nil, errors.New("cannot remove all (active) targets")
+	}
+	return next, nil
+}
@@ -1,16 +1,278 @@
 // Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
 // This file handles commands that interact with the cluster.
 /*
- * Copyright (c) 2021, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2021-2024, NVIDIA CORPORATION. All rights reserved.
  */
 package cli
 
-import "github.com/urfave/cli"
+import (
+	cryptorand "crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/urfave/cli"
+)
+
+const remHealthUsage = "probe attached remote AIS clusters (and, optionally, cloud backends), e.g.:\n" +
+	indent1 + "\t- 'ais remote-cluster health'\t- round-trip time, authentication, and list-buckets latency for all attached clusters;\n" +
+	indent1 + "\t- 'ais remote-cluster health remais --bucket abc'\t- in addition, sample PUT and GET bandwidth via 'ais://@remais/abc';\n" +
+	indent1 + "\t- 'ais remote-cluster health --cloud'\t- also probe all configured cloud backends (authentication and list-buckets latency)"
+
+var (
+	remHealthBckFlag = cli.StringFlag{
+		Name: "bucket",
+		Usage: "remote bucket to sample PUT and GET bandwidth (via this cluster), e.g.:\n" +
+			indent4 + "\t--bucket abc\t- for a remote cluster aliased 'remais', write and read back a temporary object in 'ais://@remais/abc'",
+	}
+	remHealthSizeFlag = cli.StringFlag{
+		Name:  "size",
+		Value: "1MiB",
+		Usage: "size of the temporary object to sample PUT and GET bandwidth (see '--bucket')",
+	}
+	remHealthCloudFlag = cli.BoolFlag{Name: "cloud", Usage: "also probe all configured cloud backends"}
+
+	remHealthCmd = cli.Command{
+		Name:      cmdRemHealth,
+		Usage:     remHealthUsage,
+		ArgsUsage: "[ALIAS or UUID]",
+		Flags: []cli.Flag{
+			remHealthBckFlag,
+			remHealthSizeFlag,
+			remHealthCloudFlag,
+			noHeaderFlag,
+		},
+		Action: remHealthHandler,
+	}
+)
 
 var remClusterCmd = cli.Command{
 	Name:  cmdShowRemoteAIS,
 	Usage: "show attached AIS clusters",
 	Subcommands: []cli.Command{
 		makeAlias(showCmdRemoteAIS, "", true, commandShow), // alias for `ais show`
+		remHealthCmd,
 	},
 }
+
+type remProbe struct {
+	name, endpoint string
+	rtt, lsb       time.Duration
+	put, get       string
+	auth           string
+	errs           []string
+}
+
+func remHealthHandler(c *cli.Context) error {
+	var (
+		probes []*remProbe
+		size   int64
+		which  = c.Args().Get(0)
+		bname  = parseStrFlag(c, remHealthBckFlag)
+	)
+	if bname != "" {
+		var err error
+		if size, err = parseSizeFlag(c, remHealthSizeFlag); err != nil {
+			return err
+		}
+		if size <= 0 {
+			return fmt.Errorf("invalid %s value %q (expecting positive size)", qflprn(remHealthSizeFlag), parseStrFlag(c, remHealthSizeFlag))
+		}
+	}
+	all, err := api.GetRemoteAIS(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	for _, ra := range all.A {
+		if which != "" && which != ra.Alias && which != ra.UUID {
+			continue
+		}
+		probes = append(probes, probeRemAIS(ra.Alias, ra.UUID, ra.URL, bname, size))
+	}
+	if which != "" && len(probes) == 0 {
+		return fmt.Errorf("remote cluster %q is not attached (see 'ais show %s')", which, cmdShowRemoteAIS)
+	}
+	if flagIsSet(c, remHealthCloudFlag) {
+		config, err := api.GetClusterConfig(apiBP)
+		if err != nil {
+			return V(err)
+		}
+		providers := make([]string, 0, len(config.Backend.Conf))
+		for provider := range config.Backend.Conf {
+			if apc.IsCloudProvider(provider) {
+				providers = append(providers, provider)
+			}
+		}
+		sort.Strings(providers)
+		for _, provider := range providers {
+			probes = append(probes, probeCloud(provider))
+		}
+	}
+	if len(probes) == 0 {
+		fmt.Fprintln(c.App.Writer, "No remote clusters attached (tip: use '--cloud' to probe cloud backends)")
+		return nil
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "REMOTE\t ENDPOINT\t RTT\t AUTH\t LIST BUCKETS\t PUT\t GET")
+	}
+	var errs []string
+	for _, p := range probes {
+		fmt.Fprintf(tw, "%s\t %s\t %s\t %s\t %s\t %s\t %s\n",
+			p.name, p.endpoint, fmtProbeDur(p.rtt), p.auth, fmtProbeDur(p.lsb), p.put, p.get)
+		for _, e := range p.errs {
+			errs = append(errs, p.name+": "+e)
+		}
+	}
+	tw.Flush()
+
+	if len(errs) > 0 {
+		fmt.Fprintln(c.App.Writer)
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// directly, via remote cluster's public endpoint: health (RTT) and cluster map (auth);
+// via this cluster: list buckets and, optionally, PUT and GET
+func probeRemAIS(alias, uuid, url, bname string, size int64) *remProbe {
+	p := &remProbe{
+		name:     "@" + alias,
+		endpoint: url,
+		auth:     teb.UnknownStatusVal,
+		put:      teb.NotSetVal,
+		get:      teb.NotSetVal,
+	}
+	if alias == "" || alias == uuid {
+		p.name = "@" + uuid
+	}
+	bp := api.BaseParams{URL: url, Token: loggedUserToken, UA: ua, Client: clientH}
+	if cos.IsHTTPS(url) {
+		bp.Client = clientTLS
+	}
+
+	// 1. RTT
+	started := mono.NanoTime()
+	if err := api.Health(bp); err != nil {
+		p.errs = append(p.errs, "health: "+err.Error())
+		return p // unreachable - nothing else to do
+	}
+	p.rtt = mono.Since(started)
+
+	// 2. auth
+	if _, err := api.GetClusterMap(bp); err != nil {
+		p.auth = probeAuthErr(err)
+		p.errs = append(p.errs, "cluster map: "+err.Error())
+	} else {
+		p.auth = "ok"
+	}
+
+	// 3. list buckets
+	qbck := cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.Ns{UUID: alias}}
+	if alias == "" {
+		qbck.Ns.UUID = uuid
+	}
+	started = mono.NanoTime()
+	if _, err := api.ListBuckets(apiBP, qbck, apc.FltExists); err != nil {
+		p.errs = append(p.errs, "list buckets: "+err.Error())
+	} else {
+		p.lsb = mono.Since(started)
+	}
+
+	// 4. PUT and GET
+	if bname != "" {
+		bck := cmn.Bck{Name: bname, Provider: apc.AIS, Ns: qbck.Ns}
+		p.put, p.get = probePutGet(bck, size, &p.errs)
+	}
+	return p
+}
+
+func probeCloud(provider string) *remProbe {
+	p := &remProbe{
+		name:     apc.ToScheme(provider) + "://",
+		endpoint: teb.NotSetVal,
+		auth:     teb.UnknownStatusVal,
+		put:      teb.NotSetVal,
+		get:      teb.NotSetVal,
+	}
+	started := mono.NanoTime()
+	if _, err := api.ListBuckets(apiBP, cmn.QueryBcks{Provider: provider}, apc.FltExists); err != nil {
+		p.auth = probeAuthErr(err)
+		p.errs = append(p.errs, "list buckets: "+err.Error())
+	} else {
+		p.lsb = mono.Since(started)
+		p.auth = "ok"
+	}
+	return p
+}
+
+func probePutGet(bck cmn.Bck, size int64, errs *[]string) (put, get string) {
+	put, get = teb.NotSetVal, teb.NotSetVal
+	var (
+		objName = ".ais-health-probe-" + cos.GenTie()
+		buf     = make([]byte, size)
+	)
+	cryptorand.Read(buf)
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     cos.NewByteHandle(buf),
+		Size:       uint64(size),
+		SkipVC:     true,
+	}
+	started := mono.NanoTime()
+	if _, err := api.PutObject(&putArgs); err != nil {
+		*errs = append(*errs, "PUT: "+err.Error())
+		return
+	}
+	put = fmtBandwidth(size, mono.Since(started))
+
+	// evict the in-cluster copy first, to make sure GET is served by the remote
+	if err := api.EvictObject(apiBP, bck, objName); err != nil {
+		*errs = append(*errs, "evict: "+err.Error())
+	}
+	started = mono.NanoTime()
+	if _, err := api.GetObject(apiBP, bck, objName, nil); err != nil {
+		*errs = append(*errs, "GET: "+err.Error())
+	} else {
+		get = fmtBandwidth(size, mono.Since(started))
+	}
+	if err := api.DeleteObject(apiBP, bck, objName); err != nil {
+		*errs = append(*errs, "failed to cleanup "+bck.Cname(objName)+": "+err.Error())
+	}
+	return
+}
+
+func probeAuthErr(err error) string {
+	if herr, ok := err.(*cmn.ErrHTTP); ok && (herr.Status == http.StatusUnauthorized || herr.Status == http.StatusForbidden) {
+		return fred("denied")
+	}
+	return teb.UnknownStatusVal
+}
+
+func fmtProbeDur(d time.Duration) string {
+	if d == 0 {
+		return teb.NotSetVal
+	}
+	return teb.FormatDuration(d.Round(10 * time.Microsecond))
+}
+
+func fmtBandwidth(size int64, d time.Duration) string {
+	if d <= 0 {
+		return teb.NotSetVal
+	}
+	return cos.ToSizeIEC(int64(float64(size)/d.Seconds()), 1) + "/s"
+}
@@ -67,6 +67,11 @@ type (
 			small     _log
 			large     _log
 		}
+		// misplaced objects: wrong target vs. wrong mountpath (see '--fix')
+		misplaced struct {
+			node  atomic.Int64
+			mpath atomic.Int64
+		}
 		_many bool
 	}
 )
@@ -224,7 +229,13 @@ func (ctx *scrubCtx) prnt() error {
 	all := teb.ScrubHelper{All: out}
 	tab := all.MakeTab(ctx.units)
 
-	return teb.Print(out, tab.Template(flagIsSet(ctx.c, noHeaderFlag)))
+	if err := teb.Print(out, tab.Template(flagIsSet(ctx.c, noHeaderFlag))); err != nil {
+		return err
+	}
+	if flagIsSet(ctx.c, scrubFixFlag) {
+		return ctx.fix()
+	}
+	return nil
 }
 
 func (ctx *scrubCtx) gols(bck cmn.Bck, wg cos.WG, mu *sync.Mutex) {
@@ -348,6 +359,11 @@ func (ctx *scrubCtx) ls(bck cmn.Bck) (*scrubOne, error) {
 func (scr *scrubOne) upd(parent *scrubCtx, en *cmn.LsoEnt, bprops *cmn.Bprops) {
 	scr.Listed++
 	if !en.IsStatusOK() {
+		if en.Status() == apc.LocMisplacedNode {
+			parent.misplaced.node.Inc()
+		} else {
+			parent.misplaced.mpath.Inc()
+		}
 		scr.Stats.Misplaced++
 		scr.log(&parent.log.misplaced, scr.Bck.Cname(en.Name), parent._many)
 		return
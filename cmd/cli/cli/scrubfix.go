@@ -0,0 +1,143 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles 'ais storage validate --fix'.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+// Problems found by scrub translate into targeted repair jobs:
+// - objects misplaced across targets => global rebalance
+// - objects misplaced across mountpaths => resilver (all targets)
+// - missing copies (n-way mirror) => make-n-copies with the configured number of copies
+// - erasure-coded bucket with misplaced objects => ec-encode with check-and-recover
+//
+// Cluster-wide repairs run one at a time: rebalance first and, upon its completion, resilver.
+// Unless '--yes' is given, the user is asked to confirm the plan.
+
+type scrubRepair struct {
+	problem string
+	kind    string  // job kind
+	bck     cmn.Bck // empty for cluster-wide repairs
+	num     uint64  // number of objects
+	xid     string  // started job
+	err     error
+}
+
+func scrubPlan(scrubs []*scrubOne, misplacedNode, misplacedMpath int64) (plan []*scrubRepair) {
+	if misplacedNode > 0 {
+		plan = append(plan, &scrubRepair{problem: "misplaced (target)", kind: apc.ActRebalance, num: uint64(misplacedNode)})
+	}
+	if misplacedMpath > 0 {
+		plan = append(plan, &scrubRepair{problem: "misplaced (mountpath)", kind: apc.ActResilver, num: uint64(misplacedMpath)})
+	}
+	sorted := make([]*scrubOne, len(scrubs))
+	copy(sorted, scrubs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Bck.Cname("") < sorted[j].Bck.Cname("") })
+
+	for _, scr := range sorted {
+		props := scr.Bck.Props
+		if props == nil {
+			continue
+		}
+		if scr.Stats.MissingCp > 0 && props.Mirror.Enabled {
+			plan = append(plan, &scrubRepair{problem: "missing copies", kind: apc.ActMakeNCopies, bck: scr.Bck, num: scr.Stats.MissingCp})
+		}
+		if scr.Stats.Misplaced > 0 && props.EC.Enabled {
+			plan = append(plan, &scrubRepair{problem: "misplaced (EC)", kind: apc.ActECEncode, bck: scr.Bck, num: scr.Stats.Misplaced})
+		}
+	}
+	return plan
+}
+
+func (ctx *scrubCtx) fix() error {
+	c := ctx.c
+	plan := scrubPlan(ctx.scrubs, ctx.misplaced.node.Load(), ctx.misplaced.mpath.Load())
+	if len(plan) == 0 {
+		actionDone(c, "\nNothing to repair")
+		return nil
+	}
+
+	if !flagIsSet(c, yesFlag) {
+		fmt.Fprintln(c.App.Writer)
+		for _, rep := range plan {
+			fmt.Fprintf(c.App.Writer, "%s: %s\n", rep.problem, rep.what())
+		}
+		if ok := confirm(c, fmt.Sprintf("Proceed to start %d repair job%s?", len(plan), cos.Plural(len(plan)))); !ok {
+			return nil
+		}
+	}
+
+	var numFailed int
+	for _, rep := range plan {
+		rep.xid, rep.err = rep.start(c)
+		if rep.err == nil && rep.kind == apc.ActRebalance {
+			// resilver (next) must not run concurrently with rebalance
+			fmt.Fprintf(c.App.Writer, "Started global rebalance %s, waiting for it to finish...\n", rep.xid)
+			rep.err = waitXact(&xact.ArgsMsg{ID: rep.xid, Kind: rep.kind})
+		}
+		if rep.err != nil {
+			numFailed++
+		}
+	}
+
+	// summary
+	const title = "Repairs"
+	fmt.Fprintln(c.App.Writer)
+	fmt.Fprintln(c.App.Writer, fcyan(title))
+	fmt.Fprintln(c.App.Writer, "-------")
+	tw := tabwriter.NewWriter(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROBLEM\tBUCKET\tOBJECTS\tREPAIR\tJOB")
+	for _, rep := range plan {
+		bname, job := "-", rep.xid
+		if !rep.bck.IsEmpty() {
+			bname = rep.bck.Cname("")
+		}
+		if rep.err != nil {
+			job = "error: " + rep.err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", rep.problem, bname, strconv.FormatUint(rep.num, 10), rep.kind, job)
+	}
+	tw.Flush()
+
+	if n := len(plan) - numFailed; n > 0 {
+		fmt.Fprintf(c.App.Writer, "Started %d repair job%s. To monitor progress, run '%s %s %s --all'\n",
+			n, cos.Plural(n), cliName, commandShow, commandJob)
+	}
+	if numFailed > 0 {
+		return fmt.Errorf("%d repair job%s failed", numFailed, cos.Plural(numFailed))
+	}
+	return nil
+}
+
+func (rep *scrubRepair) what() string {
+	if rep.bck.IsEmpty() {
+		return rep.kind + " (cluster-wide)"
+	}
+	return rep.kind + " " + rep.bck.Cname("")
+}
+
+func (rep *scrubRepair) start(c *cli.Context) (string, error) {
+	switch rep.kind {
+	case apc.ActMakeNCopies:
+		return api.MakeNCopies(apiBP, rep.bck, int(rep.bck.Props.Mirror.Copies))
+	case apc.ActECEncode:
+		ec := &rep.bck.Props.EC
+		return api.ECEncodeBucket(apiBP, rep.bck, ec.DataSlices, ec.ParitySlices, true /*check and recover*/)
+	default:
+		return xstart(c, &xact.ArgsMsg{Kind: rep.kind}, "")
+	}
+}
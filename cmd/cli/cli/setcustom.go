@@ -0,0 +1,206 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais object set-custom --from-csv`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+	"github.com/vbauerster/mpb/v4"
+)
+
+// Bulk custom metadata: a CSV or JSONL file that maps object names to custom key=value sets.
+//   - CSV: header "name,key1,key2,..." followed by rows "obj1,value1,value2,..."
+//     (empty values are skipped);
+//   - JSONL: one {"name": "obj1", "custom": {"key1": "value1", ...}} per line.
+// The entire file is parsed upfront; updates run concurrently, and the failed rows
+// (if any) get reported at the end.
+
+type (
+	customRow struct {
+		props cos.StrKVs
+		name  string
+		line  int
+	}
+	customJSONL struct {
+		Custom cos.StrKVs `json:"custom"`
+		Name   string     `json:"name"`
+	}
+)
+
+func setCustomBulk(c *cli.Context, bck cmn.Bck, fname string) error {
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "cannot specify key-value pairs with %s (see --help)", qflprn(setCustomFromFlag))
+	}
+	if _, err := headBucket(bck, false /*don't add*/); err != nil {
+		return err
+	}
+	rows, err := readCustomFile(fname)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%s: no objects to update", fname)
+	}
+
+	var (
+		mu           sync.Mutex
+		failed       []string
+		progress     *mpb.Progress
+		bar          *mpb.Bar
+		setNewCustom = flagIsSet(c, setNewCustomMDFlag)
+		numWorkers   = max(parseIntFlag(c, numSetCustomWorkersFlag), 1)
+		wpool        = cos.NewWorkerPool(context.Background(), numWorkers, false /*fail fast*/)
+	)
+	if flagIsSet(c, progressFlag) {
+		var bars []*mpb.Bar
+		progress, bars = simpleBar(barArgs{total: int64(len(rows)), barText: "Objects: ", barType: unitsArg})
+		bar = bars[0]
+	}
+	for _, row := range rows {
+		wpool.Go(func(context.Context) error {
+			if err := api.SetObjectCustomProps(apiBP, bck, row.name, row.props, setNewCustom); err != nil {
+				mu.Lock()
+				failed = append(failed, fmt.Sprintf("line %d: %s: %v", row.line, bck.Cname(row.name), err))
+				mu.Unlock()
+			}
+			if bar != nil {
+				bar.Increment()
+			}
+			return nil
+		})
+	}
+	errp := wpool.Wait() // (non-nil only when recovering from panic)
+	if progress != nil {
+		progress.Wait()
+	}
+	if errp != nil {
+		return errp
+	}
+
+	if n := len(failed); n > 0 {
+		for _, s := range failed {
+			fmt.Fprintln(c.App.ErrWriter, s)
+		}
+		return fmt.Errorf("failed to update %d (out of %d) object%s", n, len(rows), cos.Plural(len(rows)))
+	}
+	actionDone(c, fmt.Sprintf("Updated custom props of %d object%s in %s", len(rows), cos.Plural(len(rows)), bck.Cname("")))
+	return nil
+}
+
+// CSV unless the extension is .jsonl or .ndjson; "-" for standard input
+func readCustomFile(fname string) ([]*customRow, error) {
+	var r io.Reader = os.Stdin
+	if fname != fileStdIO {
+		fh, err := os.Open(fname)
+		if err != nil {
+			return nil, err
+		}
+		defer fh.Close()
+		r = fh
+	}
+	switch strings.ToLower(filepath.Ext(fname)) {
+	case ".jsonl", ".ndjson":
+		return parseCustomJSONL(r)
+	default:
+		return parseCustomCSV(r)
+	}
+}
+
+func parseCustomCSV(r io.Reader) ([]*customRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 0 // (all rows must have the same number of fields as the header)
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+
+	hdr, err := cr.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(hdr) < 2 {
+		return nil, errors.New("invalid CSV header: expecting 'name,key1[,key2...]'")
+	}
+	keys := hdr[1:]
+	for i, key := range keys {
+		if keys[i] = strings.TrimSpace(key); keys[i] == "" {
+			return nil, fmt.Errorf("invalid CSV header: empty key in column %d", i+2)
+		}
+	}
+	var rows []*customRow
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		row := &customRow{name: strings.TrimSpace(rec[0]), line: line, props: make(cos.StrKVs, len(keys))}
+		if err := validCustomName(row.name); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		for i, key := range keys {
+			if v := strings.TrimSpace(rec[i+1]); v != "" {
+				row.props[key] = v
+			}
+		}
+		if len(row.props) > 0 {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func parseCustomJSONL(r io.Reader) ([]*customRow, error) {
+	var (
+		rows    []*customRow
+		scanner = bufio.NewScanner(r)
+	)
+	for line := 1; scanner.Scan(); line++ {
+		b := scanner.Bytes()
+		if len(strings.TrimSpace(string(b))) == 0 {
+			continue
+		}
+		var v customJSONL
+		if err := jsoniter.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		if err := validCustomName(v.Name); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		if len(v.Custom) > 0 {
+			rows = append(rows, &customRow{name: v.Name, props: v.Custom, line: line})
+		}
+	}
+	return rows, scanner.Err()
+}
+
+func validCustomName(name string) error {
+	if name == "" {
+		return errors.New("missing object name")
+	}
+	if err := cmn.ValidOname(name); err != nil {
+		return err
+	}
+	return nil
+}
@@ -40,7 +40,9 @@ const showJobUsage = "show running and/or finished jobs\n" +
 	indent1 + "\t- 'show job prefetch-listrange'\t- show all running prefetch jobs;\n" +
 	indent1 + "\t- 'show job prefetch'\t- same as above;\n" +
 	indent1 + "\t- 'show job prefetch --refresh 1m'\t- show all running prefetch jobs at 1 minute intervals (until Ctrl-C);\n" +
-	indent1 + "\t- 'show job --all'\t- show absolutely all jobs, running and already finished\n" +
+	indent1 + "\t- 'show job --all'\t- show absolutely all jobs, running and already finished;\n" +
+	indent1 + "\t- 'show job --all --sort age --top 10'\t- show ten oldest jobs, one line per job;\n" +
+	indent1 + "\t- 'show job --sort bytes --top 5'\t- show five running jobs that have processed (or transferred) the most bytes\n" +
 	indent1 + tabHelpOpt + "."
 
 type (
@@ -70,9 +72,15 @@ var (
 			// download and dsort only
 			progressFlag,
 			dsortLogFlag,
+			// all kinds, one row per job
+			jobSortFlag,
+			jobTopFlag,
+			jobUserFlag,
+			jobTimelineFlag,
 		),
 		cmdObject: {
 			objPropsFlag, // --props [list]
+			viewFlag,     // ditto, via named view (CLI config)
 			allPropsFlag,
 			objNotCachedPropsFlag,
 			noHeaderFlag,
@@ -90,11 +98,16 @@ var (
 			longRunFlags,
 			jsonFlag,
 			noHeaderFlag,
+			smapElectionsFlag,
+			metaHistFlag,
+			metaDiffFlag,
 		),
 		cmdBMD: append(
 			longRunFlags,
 			jsonFlag,
 			noHeaderFlag,
+			metaHistFlag,
+			metaDiffFlag,
 		),
 		cmdBucket: {
 			jsonFlag,
@@ -105,6 +118,7 @@ var (
 		cmdConfig: {
 			jsonFlag,
 			noHeaderFlag,
+			cfgExplainFlag,
 		},
 		cmdShowRemoteAIS: {
 			noHeaderFlag,
@@ -143,6 +157,7 @@ var (
 			showCmdMpath,
 			showCmdMpathCapacity,
 			showCmdStgSummary,
+			showCmdContent,
 		},
 	}
 	showCmdObject = cli.Command{
@@ -232,6 +247,9 @@ func showJobsHandler(c *cli.Context) error {
 	if name == cmdRebalance {
 		return showRebalanceHandler(c)
 	}
+	if flagIsSet(c, jobTimelineFlag) && xid == "" {
+		return fmt.Errorf("%s requires job ID", qflprn(jobTimelineFlag))
+	}
 
 	setLongRunParams(c, 72)
 
@@ -255,6 +273,9 @@ func showJobsDo(c *cli.Context, name, xid, daemonID string, bck cmn.Bck) (int, e
 	if name == "" && xid != "" {
 		name, _ = xid2Name(xid)
 	}
+	if xid == "" && (flagIsSet(c, jobSortFlag) || flagIsSet(c, jobTopFlag)) {
+		return showJobsSorted(c, name, daemonID, bck)
+	}
 	if name != "" || xid != "" {
 		return _showJobs(c, name, xid, daemonID, bck, xid == "" /*caption*/)
 	}
@@ -326,6 +347,9 @@ func jobCptn(c *cli.Context, name, xid, ctlmsg string, onlyActive, byTarget bool
 }
 
 func _showJobs(c *cli.Context, name, xid, daemonID string, bck cmn.Bck, caption bool) (int, error) {
+	if flagIsSet(c, jobUserFlag) && (name == cmdDownload || name == commandETL || name == cmdDsort) {
+		return 0, nil // (submitter not recorded)
+	}
 	switch name {
 	case cmdDownload:
 		return showDownloads(c, xid, caption)
@@ -346,6 +370,8 @@ func _showJobs(c *cli.Context, name, xid, daemonID string, bck cmn.Bck, caption
 				DaemonID:    daemonID,
 				Bck:         bck,
 				OnlyRunning: onlyActive,
+				User:        parseStrFlag(c, jobUserFlag),
+				Timeline:    flagIsSet(c, jobTimelineFlag),
 			}
 		)
 		if regexStr != "" {
@@ -578,6 +604,9 @@ func xlistByKindID(c *cli.Context, xargs *xact.ArgsMsg, caption bool, xs xact.Mu
 			}
 		}
 	}
+	if err == nil && !usejs && flagIsSet(c, jobTimelineFlag) {
+		showTimeline(c, dts, units)
+	}
 	if err != nil || !flagIsSet(c, verboseJobFlag) {
 		return l, err
 	}
@@ -628,6 +657,9 @@ func showBckPropsHandler(c *cli.Context) error {
 }
 
 func showSmapHandler(c *cli.Context) error {
+	if flagIsSet(c, metaDiffFlag) {
+		return showMetaDiff(c, apc.WhatSmapHist)
+	}
 	var (
 		sid              string
 		node, sname, err = arg0Node(c)
@@ -637,6 +669,13 @@ func showSmapHandler(c *cli.Context) error {
 		return err
 	}
 
+	if flagIsSet(c, smapElectionsFlag) {
+		return showElections(c, node, sname)
+	}
+	if flagIsSet(c, metaHistFlag) {
+		return showMetaHist(c, node, sname, apc.WhatSmapHist)
+	}
+
 	setLongRunParams(c)
 
 	if node != nil {
@@ -657,6 +696,9 @@ func showSmapHandler(c *cli.Context) error {
 }
 
 func showBMDHandler(c *cli.Context) error {
+	if flagIsSet(c, metaDiffFlag) {
+		return showMetaDiff(c, apc.WhatBMDHist)
+	}
 	var (
 		bmd              *meta.BMD
 		sid              string
@@ -665,6 +707,9 @@ func showBMDHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, metaHistFlag) {
+		return showMetaHist(c, node, sname, apc.WhatBMDHist)
+	}
 
 	setLongRunParams(c)
 
@@ -718,7 +763,7 @@ func showBMDHandler(c *cli.Context) error {
 				}
 				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 					provider, ns, bucket, props.BackendBck, copies, ec,
-					cos.FormatNanoTime(props.Created, ""))
+					teb.FmtTimestamp(time.Unix(0, props.Created), ""))
 			}
 		}
 	}
@@ -749,10 +794,14 @@ func showAnyConfigHandler(c *cli.Context) error {
 // TODO: prune config.ClusterConfig - hide deprecated "non_electable"
 func showClusterConfig(c *cli.Context, section string) error {
 	var (
-		usejs          = flagIsSet(c, jsonFlag)
-		cluConfig, err = api.GetClusterConfig(apiBP)
+		usejs     = flagIsSet(c, jsonFlag)
+		cluConfig *cmn.ClusterConfig
+		err       error
 	)
-	if err != nil {
+	if flagIsSet(c, cfgExplainFlag) {
+		return explainClusterConfig(c, section)
+	}
+	if cluConfig, err = api.GetClusterConfig(apiBP); err != nil {
 		return err
 	}
 
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -109,3 +111,47 @@ func smapFromNode(c *cli.Context, primarySmap *meta.Smap, sid string, usejs bool
 	}
 	return teb.Print(body, teb.SmapTmpl, teb.Jopts(usejs))
 }
+
+// `ais show cluster smap --elections [NODE]`
+func showElections(c *cli.Context, node *meta.Snode, sname string) error {
+	if node == nil {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return err
+		}
+		node, sname = smap.Primary, smap.Primary.StringEx()
+	}
+	out, err := api.GetNodeMeta(apiBP, node.ID(), apc.WhatElections)
+	if err != nil {
+		return V(err)
+	}
+	elections := out.([]meta.Election)
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(elections, "", teb.Jopts(true))
+	}
+	if len(elections) == 0 {
+		fmt.Fprintln(c.App.Writer, sname+": no primary elections")
+		return nil
+	}
+	actionCptn(c, "Primary elections as seen by:", sname)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TIME\t OUTCOME\t PRIMARY\t CANDIDATE\t INITIATOR\t DETAILS")
+	}
+	for i := range elections {
+		e := &elections[i]
+		details := e.Details
+		if details == "" {
+			details = teb.NotSetVal
+		}
+		candidate := teb.NotSetVal
+		if e.Candidate != "" {
+			candidate = meta.Pname(e.Candidate)
+		}
+		fmt.Fprintf(tw, "%s\t %s\t %s\t %s\t %s\t %s\n", teb.FmtDateTime(e.Time), e.Outcome,
+			meta.Pname(e.Primary), candidate, e.Initiator, details)
+	}
+	tw.Flush()
+	return nil
+}
@@ -47,7 +47,8 @@ var scrubUsage = "check in-cluster content for misplaced objects, objects that h
 	indent1 + "\t* ais scrub s3 \t- all s3 buckets present in the cluster;\n" +
 	indent1 + "\t* ais scrub s3 --refresh 10\t- same as above while refreshing runtime counter(s) every 10s;\n" +
 	indent1 + "\t* ais scrub gs://abc/images/\t- validate part of the gcp bucket under 'images/`;\n" +
-	indent1 + "\t* ais scrub gs://abc --prefix images/\t- same as above."
+	indent1 + "\t* ais scrub gs://abc --prefix images/\t- same as above;\n" +
+	indent1 + "\t* ais scrub ais://abc --fix\t- validate and start jobs to repair misplaced objects and missing copies."
 
 var (
 	mpathCmdsFlags = map[string][]cli.Flag{
@@ -123,12 +124,14 @@ var (
 	cleanupFlags = []cli.Flag{
 		forceClnFlag,
 		rmZeroSizeFlag,
+		dryRunFlag,
 		waitFlag,
 		waitJobXactFinishedFlag,
 	}
 	cleanupCmd = cli.Command{
-		Name:         cmdStgCleanup,
-		Usage:        "remove deleted objects and old/obsolete workfiles; remove misplaced objects; optionally, remove zero size objects",
+		Name: cmdStgCleanup,
+		Usage: "remove deleted objects and old/obsolete workfiles; remove misplaced objects; optionally, remove zero size objects;\n" +
+			indent1 + "with '--dry-run': count (and log) what would be removed, and show per-target numbers",
 		ArgsUsage:    lsAnyCommandArgument,
 		Flags:        cleanupFlags,
 		Action:       cleanupStorageHandler,
@@ -172,6 +175,8 @@ var (
 			noRecursFlag,
 			smallSizeFlag,
 			largeSizeFlag,
+			scrubFixFlag,
+			yesFlag,
 		),
 	}
 
@@ -221,6 +226,7 @@ var (
 			mpathCmd,
 			showCmdDisk,
 			cleanupCmd,
+			ecDomainsCmd,
 		},
 	}
 )
@@ -252,12 +258,20 @@ func cleanupStorageHandler(c *cli.Context) error {
 	if flagIsSet(c, rmZeroSizeFlag) {
 		xargs.Flags = xact.XrmZeroSize
 	}
+	if flagIsSet(c, dryRunFlag) {
+		xargs.Flags |= xact.XclnDryRun
+	}
 
 	// do
 	xid, err := xstart(c, &xargs, "")
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, dryRunFlag) {
+		dryRunCptn(c)
+		xargs.ID = xid
+		return showDryRun(c, &xargs)
+	}
 
 	xargs.ID = xid
 	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
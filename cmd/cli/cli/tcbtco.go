@@ -83,24 +83,28 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 		return err
 	}
 
+	oltp, err := dopOLTP(c, bckFrom, objNameOrTmpl)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: similar to prefetch, explicitly listed (or range-selected) remote objects
+	// do not need to be present in-cluster - skip checking
 	allIncludingRemote := flagIsSet(c, copyAllObjsFlag)
-	empty, err := isBucketEmpty(bckFrom, !bckFrom.IsRemote() || !allIncludingRemote /*cached*/)
-	debug.AssertNoErr(err)
-	if empty {
-		if bckFrom.IsRemote() && !allIncludingRemote {
-			hint := "(tip: use option %s to " + text1 + " remote objects from the backend store)\n"
-			note := fmt.Sprintf("source %s appears to be empty "+hint, bckFrom, qflprn(copyAllObjsFlag))
+	if !bckFrom.IsRemote() || !oltp.explicit() {
+		empty, err := isBucketEmpty(bckFrom, !bckFrom.IsRemote() || !allIncludingRemote /*cached*/)
+		debug.AssertNoErr(err)
+		if empty {
+			if bckFrom.IsRemote() && !allIncludingRemote {
+				hint := "(tip: use option %s to " + text1 + " remote objects from the backend store)\n"
+				note := fmt.Sprintf("source %s appears to be empty "+hint, bckFrom, qflprn(copyAllObjsFlag))
+				actionNote(c, note)
+				return nil
+			}
+			note := fmt.Sprintf("source %s is empty, nothing to do\n", bckFrom)
 			actionNote(c, note)
 			return nil
 		}
-		note := fmt.Sprintf("source %s is empty, nothing to do\n", bckFrom)
-		actionNote(c, note)
-		return nil
-	}
-
-	oltp, err := dopOLTP(c, bckFrom, objNameOrTmpl)
-	if err != nil {
-		return err
 	}
 
 	// bck-to exists?
@@ -137,6 +141,10 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 	//
 	// or (2) multi-object x-tco
 	//
+	if flagIsSet(c, copySnapshotFlag) {
+		return incorrectUsageMsg(c, "%s applies to copying entire buckets (or prefixes) - not to multi-object selections",
+			qflprn(copySnapshotFlag))
+	}
 	if oltp.list == "" && oltp.tmpl == "" {
 		oltp.list = oltp.objName // (compare with `_prefetchOne`)
 	}
@@ -161,12 +169,46 @@ func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
 		msg.Force = flagIsSet(c, forceFlag)
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
+		msg.Snapshot = flagIsSet(c, copySnapshotFlag)
 	}
 	if msg.Sync && msg.Prepend != "" {
-		err = fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
+		return fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
 			msg.Prepend, qflprn(progressFlag))
 	}
-	return err
+	if msg.Rename, err = parseRenameFlags(c); err != nil {
+		return err
+	}
+	if err = parseJobQuotaFlags(c, &msg.JobQuota); err != nil {
+		return err
+	}
+	return msg.Init()
+}
+
+func parseJobQuotaFlags(c *cli.Context, quota *apc.JobQuota) (err error) {
+	quota.MaxObjects = int64(parseIntFlag(c, jobMaxObjectsFlag))
+	if flagIsSet(c, jobMaxBytesFlag) {
+		if quota.MaxBytes, err = parseSizeFlag(c, jobMaxBytesFlag); err != nil {
+			return err
+		}
+	}
+	return quota.Validate()
+}
+
+// server-side destination naming, if requested
+func parseRenameFlags(c *cli.Context) (*apc.RenameMsg, error) {
+	if !flagIsSet(c, copyStripPrefixFlag) && !flagIsSet(c, copyRenameRegexFlag) && !flagIsSet(c, copyRenameToFlag) &&
+		!flagIsSet(c, copyPadDigitsFlag) {
+		return nil, nil
+	}
+	if flagIsSet(c, copyRenameToFlag) && !flagIsSet(c, copyRenameRegexFlag) {
+		return nil, fmt.Errorf("%s requires %s", qflprn(copyRenameToFlag), qflprn(copyRenameRegexFlag))
+	}
+	return &apc.RenameMsg{
+		StripPrefix: parseStrFlag(c, copyStripPrefixFlag),
+		Regex:       parseStrFlag(c, copyRenameRegexFlag),
+		Repl:        parseStrFlag(c, copyRenameToFlag),
+		PadDigits:   parseIntFlag(c, copyPadDigitsFlag),
+	}, nil
 }
 
 func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
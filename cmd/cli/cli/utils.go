@@ -568,6 +568,9 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 				propList = append(propList, nvpair{Name: "original-url", Value: origURL})
 			}
 		}
+		if props.Provider == apc.RGW {
+			propList = append(propList, nvpair{Name: "rgw-usage", Value: fmtRGWUsage(&props.Extra.RGW)})
+		}
 	} else {
 		err := cmn.IterFields(props, func(tag string, field cmn.IterField) (error, bool) {
 			var value string
@@ -576,6 +579,8 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 				value = fmtBucketCreatedTime(props.Created)
 			case cmn.PropBucketAccessAttrs:
 				value = props.Access.Describe(true /*incl. all*/)
+			case "extra.rgw.quota_max_size", "extra.rgw.used_size":
+				value = teb.FmtSize(field.Value().(int64), cos.UnitsIEC, 2)
 			default:
 				v := field.Value()
 				value = _toStr(v)
@@ -599,11 +604,29 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 	return
 }
 
+// Ceph RGW quota consumption, e.g.: "1.50GiB of 10.00GiB (15%), 1000 of 5000 objects (20%)"
+func fmtRGWUsage(rgw *cmn.ExtraPropsRGW) string {
+	size := teb.FmtSize(rgw.UsedSize, cos.UnitsIEC, 2)
+	if rgw.QuotaMaxSize > 0 {
+		size += " of " + teb.FmtSize(rgw.QuotaMaxSize, cos.UnitsIEC, 2) + fmt.Sprintf(" (%d%%)", rgw.UsedSize*100/rgw.QuotaMaxSize)
+	}
+	objs := strconv.FormatInt(rgw.UsedObjects, 10)
+	if rgw.QuotaMaxObjects > 0 {
+		objs += " of " + strconv.FormatInt(rgw.QuotaMaxObjects, 10) + fmt.Sprintf(" objects (%d%%)", rgw.UsedObjects*100/rgw.QuotaMaxObjects)
+	} else {
+		objs += " objects"
+	}
+	if rgw.QuotaMaxSize <= 0 && rgw.QuotaMaxObjects <= 0 {
+		return size + ", " + objs + " (no quota)"
+	}
+	return size + ", " + objs
+}
+
 func fmtBucketCreatedTime(created int64) string {
 	if created == 0 {
 		return teb.NotSetVal
 	}
-	return time.Unix(0, created).Format(time.RFC3339)
+	return teb.FmtTimestamp(time.Unix(0, created), time.RFC3339)
 }
 
 // compare with teb.isUnsetTime() and fmtBucketCreatedTime() above
@@ -715,6 +738,10 @@ func flattenBackends(backends []string) (flat nvpairList) {
 			nv.Value = "Azure Blob Storage"
 		case apc.OCI:
 			nv.Value = "Oracle Cloud Infrastructure (OCI) Object Storage"
+		case apc.RGW:
+			nv.Value = "Ceph RADOS Gateway (RGW)"
+		case apc.Ext:
+			nv.Value = "External backend plugins"
 		}
 		flat = append(flat, nv)
 	}
@@ -5,14 +5,29 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
 )
 
 func TestParseSourceValidURIs(t *testing.T) {
@@ -461,3 +476,738 @@ func TestParseBckObjectURI(t *testing.T) {
 		tassert.Errorf(t, err != nil, "expected error on %s (bck: %q, obj_name: %q)", test.uri, bck, objName)
 	}
 }
+
+func TestMakeWdsShards(t *testing.T) {
+	var entries cmn.LsoEntries
+	for i := range 100 {
+		name := fmt.Sprintf("train/%03d", i)
+		entries = append(entries, &cmn.LsoEnt{Name: name + ".jpg", Size: 900}, &cmn.LsoEnt{Name: name + ".cls", Size: 100})
+	}
+	records := wdsRecords(entries)
+	tassert.Fatalf(t, len(records) == 100, "expected 100 records, got %d", len(records))
+
+	shards := makeWdsShards(records, 30*1000, "train-", ".tar")
+	tassert.Fatalf(t, len(shards) == 4, "expected 4 shards, got %d", len(shards))
+	var total int
+	for i, shard := range shards {
+		tassert.Errorf(t, shard.name == fmt.Sprintf("train-%06d.tar", i), "unexpected shard name %q", shard.name)
+		tassert.Errorf(t, shard.size == 25*1000, "shard %q: expected even size, got %d", shard.name, shard.size)
+		tassert.Errorf(t, len(shard.names)%2 == 0, "shard %q: split record", shard.name)
+		total += len(shard.names)
+	}
+	tassert.Errorf(t, total == len(entries), "expected %d objects, got %d", len(entries), total)
+
+	// fewer records than shards
+	shards = makeWdsShards(records[:2], 1, "s-", ".tgz")
+	tassert.Errorf(t, len(shards) == 2, "expected 2 shards, got %d", len(shards))
+}
+
+func TestCfgSchema(t *testing.T) {
+	schema, err := loadCfgSchema()
+	tassert.CheckFatal(t, err)
+	config := &cmn.ClusterConfig{}
+	for key, entry := range schema {
+		_, _, found := cfgKeyValue(config, key)
+		tassert.Errorf(t, found, "schema key %q is not a cluster config key", key)
+		tassert.Errorf(t, entry.Desc != "", "schema key %q: missing description", key)
+		if len(entry.Enum) > 0 && entry.Default != "" {
+			tassert.Errorf(t, cos.StringInSlice(entry.Default, entry.Enum),
+				"schema key %q: default %q not in %v", key, entry.Default, entry.Enum)
+		}
+	}
+
+	// new config keys must be described (the keys below are either internal or not described yet)
+	undescribed := cos.NewStrSet(
+		"uuid", "config_version", "lastupdate_time", "ext", "auth.secret", "log.stats_time",
+		"proxy.primary_url", "proxy.original_url", "proxy.discovery_url", "proxy.non_electable",
+		"keepalivetracker.proxy.name", "keepalivetracker.proxy.factor",
+		"keepalivetracker.target.name", "keepalivetracker.target.factor",
+		"net.l4.proto", "net.l4.sndrcv_buf_size", "net.http.server_crt", "net.http.server_key",
+		"net.http.domain_tls", "net.http.client_ca_tls", "net.http.client_auth_tls",
+		"net.http.write_buffer_size", "net.http.read_buffer_size", "net.http.chunked_transfer",
+		"distributed_sort.ekm_malformed_line", "distributed_sort.ekm_missing_key",
+		"distributed_sort.dsorter_mem_threshold", "distributed_sort.call_timeout", "distributed_sort.bundle_multiplier",
+		"memsys.min_pct_total", "memsys.min_pct_free", "transport.lz4_frame_checksum",
+		"tracing.enabled", "tracing.exporter_endpoint", "tracing.exporter_auth.token_header",
+		"tracing.exporter_auth.token_file", "tracing.service_name_prefix", "tracing.attributes",
+		"tracing.sampler_probability", "tracing.skip_verify",
+	)
+	cmn.IterFields(config, func(tag string, _ cmn.IterField) (error, bool) {
+		_, ok := schema[tag]
+		tassert.Errorf(t, ok || undescribed.Contains(tag), "cluster config key %q is missing in config_schema.json", tag)
+		return nil, false
+	})
+}
+
+func TestParseCatRanges(t *testing.T) {
+	const size = 1000
+	tests := []struct {
+		s        string
+		expected []catRng
+	}{
+		{"0-99", []catRng{{0, 100}}},
+		{"0-99, 500-599", []catRng{{0, 100}, {500, 100}}},
+		{"900-", []catRng{{900, 100}}},
+		{"900-5000", []catRng{{900, 100}}},
+		{"-100", []catRng{{900, 100}}},
+		{"-5000", []catRng{{0, size}}},
+		{"500-599,0-0", []catRng{{500, 100}, {0, 1}}},
+	}
+	for _, test := range tests {
+		ranges, err := parseCatRanges(test.s, size)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, reflect.DeepEqual(ranges, test.expected), "%q: expected %v, got %v", test.s, test.expected, ranges)
+	}
+	for _, s := range []string{"", "-", "100", "a-b", "100-50", "1000-", "-0", "-1-2"} {
+		_, err := parseCatRanges(s, size)
+		tassert.Errorf(t, err != nil, "expected error parsing %q", s)
+	}
+}
+
+func TestChainSpecValidate(t *testing.T) {
+	const valid = `
+name: nightly
+steps:
+  - name: pack
+    kind: archive
+    after: [transform]
+    bucket: ais://dst
+    to: ais://out/train.tar
+  - name: fetch
+    kind: prefetch
+    bucket: s3://src
+    prefix: train/
+  - name: transform
+    kind: etl
+    etl: md5
+    after: [fetch]
+    bucket: s3://src
+    to: ais://dst
+`
+	var spec chainSpec
+	tassert.CheckFatal(t, yaml.Unmarshal([]byte(valid), &spec))
+	order, err := spec.validate()
+	tassert.CheckFatal(t, err)
+	names := make([]string, 0, len(order))
+	for _, step := range order {
+		names = append(names, step.Name)
+	}
+	tassert.Errorf(t, reflect.DeepEqual(names, []string{"fetch", "transform", "pack"}), "unexpected order %v", names)
+	tassert.Errorf(t, order[2].archName == "train.tar", "unexpected archive name %q", order[2].archName)
+
+	invalid := []string{
+		// circular
+		"steps: [{name: a, kind: copy, bucket: 'ais://x', to: 'ais://y', after: [b]}, {name: b, kind: copy, bucket: 'ais://y', to: 'ais://z', after: [a]}]",
+		// unknown predecessor
+		"steps: [{name: a, kind: copy, bucket: 'ais://x', to: 'ais://y', after: [c]}]",
+		// prefetch from ais://
+		"steps: [{name: a, kind: prefetch, bucket: 'ais://x'}]",
+		// missing etl name
+		"steps: [{name: a, kind: etl, bucket: 'ais://x', to: 'ais://y'}]",
+		// missing archive name
+		"steps: [{name: a, kind: archive, bucket: 'ais://x', to: 'ais://y'}]",
+		// duplicate
+		"steps: [{name: a, kind: copy, bucket: 'ais://x', to: 'ais://y'}, {name: a, kind: copy, bucket: 'ais://x', to: 'ais://z'}]",
+	}
+	for _, s := range invalid {
+		var spec chainSpec
+		tassert.CheckFatal(t, yaml.Unmarshal([]byte(s), &spec))
+		_, err := spec.validate()
+		tassert.Errorf(t, err != nil, "expecting error validating %q", s)
+	}
+}
+
+func TestDiffInv(t *testing.T) {
+	live := cmn.LsoEntries{
+		{Name: "d", Size: 4, Version: "2"},
+		{Name: "a", Size: 1, Checksum: "x"},
+		{Name: cmn.NativeInvPartName("inv", 0), Size: 100},
+		{Name: "b", Size: 2, Checksum: "y"},
+		{Name: "e", Size: 5},
+	}
+	inv := cmn.LsoEntries{
+		{Name: "a", Size: 1, Checksum: "x"},
+		{Name: "b", Size: 2, Checksum: "z"},
+		{Name: "c", Size: 3},
+		{Name: "d", Size: 3, Version: "1"},
+	}
+	diff := diffInv(live, inv, false /*cmpCksum*/)
+	exp := []*invDiffEnt{
+		{Name: "c", Status: invDiffDeleted, Size: 3},
+		{Name: "d", Status: invDiffChanged, Size: 4, Details: "size 3B => 4B, version 1 => 2"},
+		{Name: "e", Status: invDiffCreated, Size: 5},
+	}
+	tassert.Fatalf(t, reflect.DeepEqual(diff, exp), "expected %+v, got %+v", exp, diff)
+
+	diff = diffInv(live, inv, true /*cmpCksum*/)
+	tassert.Fatalf(t, len(diff) == 4 && diff[0].Name == "b" && diff[0].Status == invDiffChanged,
+		"expected checksum change in 'b', got %+v", diff)
+}
+
+func TestDisplayConfig(t *testing.T) {
+	defer teb.SetDisplay("", "", "", "")
+
+	ts := time.Date(2024, 6, 10, 20, 30, 0, 0, time.FixedZone("PDT", -7*3600))
+	tests := []struct {
+		tz, tfmt, thousands, decimal string
+		time, size, raw, count       string
+	}{
+		{"", "", "", "", "10 Jun 24 20:30 PDT", "1.50GiB", "1610612736", "1234567"},
+		{"UTC", "datetime", ",", "", "2024-06-11 03:30:00", "1.50GiB", "1,610,612,736", "1,234,567"},
+		{"Asia/Tokyo", "2006-01-02T15:04", ".", ",", "2024-06-11T12:30", "1,50GiB", "1.610.612.736", "1.234.567"},
+	}
+	for _, test := range tests {
+		err := teb.SetDisplay(test.tz, test.tfmt, test.thousands, test.decimal)
+		tassert.CheckFatal(t, err)
+		if s := teb.FmtTimestamp(ts, ""); s != test.time {
+			t.Errorf("%+v: expected time %q, got %q", test, test.time, s)
+		}
+		if s := teb.FmtSize(3*cos.GiB/2, cos.UnitsIEC, 2); s != test.size {
+			t.Errorf("%+v: expected size %q, got %q", test, test.size, s)
+		}
+		if s := teb.FmtSize(3*cos.GiB/2, cos.UnitsRaw, 0); s != test.raw {
+			t.Errorf("%+v: expected raw size %q, got %q", test, test.raw, s)
+		}
+		if s := teb.FmtInt(1234567); s != test.count {
+			t.Errorf("%+v: expected count %q, got %q", test, test.count, s)
+		}
+	}
+
+	// invalid
+	tassert.Errorf(t, teb.SetDisplay("Mars/Olympus", "", "", "") != nil, "expected invalid timezone")
+	tassert.Errorf(t, teb.SetDisplay("", "yyyy-mm-dd", "", "") != nil, "expected invalid time format")
+	tassert.Errorf(t, teb.SetDisplay("", "", ",", ",") != nil, "expected invalid separators")
+}
+
+func TestPerfExport(t *testing.T) {
+	var (
+		stmap teb.StstMap
+		ts    = time.Unix(1700000000, 0)
+		raw   = `{"t1": {"snode": {"daemon_id": "t1", "daemon_type": "target"}, "tracker": {"get.n": 5, "get.ns": 7, "ignored": 1}}}`
+	)
+	tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(raw), &stmap))
+
+	b := &perfBundle{
+		smap:    &meta.Smap{UUID: "uuid"},
+		kinds:   cos.StrKVs{"get.n": stats.KindCounter, "get.ns": stats.KindLatency},
+		metrics: make(map[string]map[string]*perfSeries),
+		begin:   ts,
+		end:     ts.Add(time.Second),
+	}
+	b.add(stmap, ts)
+	b.add(stmap, ts.Add(time.Second))
+	tassert.Fatalf(t, len(b.metrics) == 2, "expected 2 metrics, got %d", len(b.metrics))
+
+	var sb strings.Builder
+	tassert.CheckFatal(t, b.writeProm(&sb))
+	prom := sb.String()
+	for _, s := range []string{
+		"# TYPE ais_get_n counter\n",
+		"# TYPE ais_get_ns gauge\n",
+		`ais_get_n{node_id="t1",node_type="target"} 5 1700000001000` + "\n",
+	} {
+		tassert.Errorf(t, strings.Contains(prom, s), "expected %q in:\n%s", s, prom)
+	}
+	tassert.Errorf(t, !strings.Contains(prom, "ignored"), "unexpected metric in:\n%s", prom)
+
+	sb.Reset()
+	tassert.CheckFatal(t, b.writeOtel(&sb))
+	var req otelRequest
+	tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(sb.String()), &req))
+	metrics := req.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	tassert.Fatalf(t, len(metrics) == 2, "expected 2 OTLP metrics, got %d", len(metrics))
+	tassert.Errorf(t, metrics[0].Name == "ais.get.n" && metrics[0].Sum != nil && len(metrics[0].Sum.DataPoints) == 2,
+		"unexpected OTLP sum: %+v", metrics[0])
+	tassert.Errorf(t, metrics[1].Name == "ais.get.ns" && metrics[1].Gauge != nil, "unexpected OTLP gauge: %+v", metrics[1])
+}
+
+func TestPerfRates(t *testing.T) {
+	var (
+		begin, end teb.StstMap
+		metrics    = cos.StrKVs{"get.n": stats.KindCounter, "err.get.n": stats.KindCounter, "put.n": stats.KindCounter}
+	)
+	tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(`{"t1": {"tracker": {"get.n": 100, "put.n": 50}}}`), &begin))
+	tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(`{"t1": {"tracker": {"get.n": 120, "err.get.n": 5, "put.n": 10}}}`), &end))
+
+	rates := _rates(metrics, begin, end, 10*time.Second)["t1"]
+	tassert.Errorf(t, rates["get.n"] == 2, "get.n: expected 2/s, got %v", rates["get.n"])
+	tassert.Errorf(t, rates["err.get.n"] == 0.5, "err.get.n: expected 0.5/s, got %v", rates["err.get.n"])
+	_, ok := rates["put.n"]
+	tassert.Errorf(t, !ok, "put.n: expected no rate for a counter that went down")
+
+	for rate, s := range map[float64]string{0: "0", 0.5: "0.5/s", 2: "2.0/s", 123.4: "123/s"} {
+		tassert.Errorf(t, teb.FmtRate(rate) == s, "expected %q, got %q", s, teb.FmtRate(rate))
+	}
+}
+
+func TestFmtRGWUsage(t *testing.T) {
+	tests := []struct {
+		rgw      cmn.ExtraPropsRGW
+		expected string
+	}{
+		{cmn.ExtraPropsRGW{UsedSize: 1024, UsedObjects: 3}, "1.00KiB, 3 objects (no quota)"},
+		{cmn.ExtraPropsRGW{UsedSize: 256 * cos.MiB, QuotaMaxSize: cos.GiB, UsedObjects: 10}, "256.00MiB of 1.00GiB (25%), 10 objects"},
+		{cmn.ExtraPropsRGW{UsedObjects: 50, QuotaMaxObjects: 100}, "0B, 50 of 100 objects (50%)"},
+	}
+	for _, test := range tests {
+		got := fmtRGWUsage(&test.rgw)
+		tassert.Errorf(t, got == test.expected, "expected %q, got %q", test.expected, got)
+	}
+}
+
+func TestOltpExplicit(t *testing.T) {
+	tests := []struct {
+		oltp     oltp
+		explicit bool
+	}{
+		{oltp{}, false},
+		{oltp{tmpl: "dir/subdir/"}, false},
+		{oltp{tmpl: "shard-{0010..0019}.tar"}, true},
+		{oltp{list: "a.tar,b.tar"}, true},
+		{oltp{objName: "a.tar"}, true},
+	}
+	for _, test := range tests {
+		tassert.Errorf(t, test.oltp.explicit() == test.explicit, "%+v: expected explicit=%t", test.oltp, test.explicit)
+	}
+}
+
+func TestECDomainsValidate(t *testing.T) {
+	newSmap := func(numRacks int) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, 8)}
+		for i := range 8 {
+			si := &meta.Snode{DaeID: fmt.Sprintf("t%d", i), DaeType: apc.Target, Zone: "z1", Rack: fmt.Sprintf("r%d", i%numRacks)}
+			smap.Tmap[si.DaeID] = si
+		}
+		smap.InitDigests()
+		return smap
+	}
+	props := &cmn.Bprops{EC: cmn.ECConf{Enabled: true, DataSlices: 4, ParitySlices: 2}}
+	bck := meta.NewBck("ec", apc.AIS, cmn.NsGlobal, props)
+
+	// 7 targets (main + 6 slices) over 4 racks
+	row := ecDomainsValidate(newSmap(4), bck, 100)
+	tassert.CheckFatal(t, row.err)
+	tassert.Errorf(t, row.numRacks == 4 && row.numZones == 1, "unexpected domains: %+v", row)
+	tassert.Errorf(t, row.maxRack == 2 && row.ok(), "expecting at most 2 slices per rack: %+v", row)
+
+	// 2 racks
+	row = ecDomainsValidate(newSmap(2), bck, 100)
+	tassert.CheckFatal(t, row.err)
+	tassert.Errorf(t, row.maxRack > 2 && !row.ok(), "expecting the bucket at risk: %+v", row)
+
+	// not enough targets
+	bck.Props.EC.ParitySlices = 4
+	row = ecDomainsValidate(newSmap(4), bck, 1)
+	tassert.Errorf(t, row.err != nil, "expecting not-enough-targets error")
+}
+
+func TestFmtBandwidth(t *testing.T) {
+	tests := []struct {
+		size     int64
+		d        time.Duration
+		expected string
+	}{
+		{cos.MiB, time.Second, "1.0MiB/s"},
+		{cos.MiB, 10 * time.Millisecond, "100.0MiB/s"},
+		{cos.KiB, 0, teb.NotSetVal},
+	}
+	for _, test := range tests {
+		got := fmtBandwidth(test.size, test.d)
+		tassert.Errorf(t, got == test.expected, "size %d, duration %v: expected %q, got %q", test.size, test.d, test.expected, got)
+	}
+}
+
+func TestParseCustomFile(t *testing.T) {
+	csvIn := "name, color, size\n" +
+		"# comment\n" +
+		"a.jpg, red, large\n" +
+		"b.jpg, , small\n" +
+		"c.jpg, ,\n" +
+		"\"d, e.jpg\", blue, \n"
+	rows, err := parseCustomCSV(strings.NewReader(csvIn))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(rows) == 3, "expected 3 rows, got %d", len(rows))
+	tassert.Errorf(t, reflect.DeepEqual(rows[0].props, cos.StrKVs{"color": "red", "size": "large"}), "row 1: %v", rows[0].props)
+	tassert.Errorf(t, reflect.DeepEqual(rows[1].props, cos.StrKVs{"size": "small"}), "row 2: %v", rows[1].props)
+	tassert.Errorf(t, rows[2].name == "d, e.jpg" && rows[2].line == 6, "row 3: %q, line %d", rows[2].name, rows[2].line)
+
+	_, err = parseCustomCSV(strings.NewReader("name\na.jpg\n"))
+	tassert.Errorf(t, err != nil, "expected invalid header error")
+	_, err = parseCustomCSV(strings.NewReader("name,color\na.jpg,red,extra\n"))
+	tassert.Errorf(t, err != nil, "expected wrong number of fields error")
+
+	jsonlIn := `{"name": "a.jpg", "custom": {"color": "red"}}` + "\n\n" + `{"name": "b.jpg", "custom": {}}` + "\n"
+	rows, err = parseCustomJSONL(strings.NewReader(jsonlIn))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(rows) == 1 && rows[0].name == "a.jpg" && rows[0].props["color"] == "red", "unexpected %+v", rows)
+	_, err = parseCustomJSONL(strings.NewReader(`{"custom": {"color": "red"}}`))
+	tassert.Errorf(t, err != nil, "expected missing name error")
+}
+
+func TestContentTypeCols(t *testing.T) {
+	cols := ctCols(map[string]uint64{"ob": 100, "md": 1, "wk": 20, "ec": 300, "mt": 3, "ds": 40, "dw": 2, "zz": 7})
+	expected := [numCtCols]uint64{101, 20, 303, 42, 7, 473}
+	tassert.Errorf(t, cols == expected, "expected %v, got %v", expected, cols)
+}
+
+func TestRenderEtlSpec(t *testing.T) {
+	values := etlNormValues(map[string]any{
+		"image":     "aistorage/md5:v2",
+		"resources": map[any]any{"memory": "2Gi", "cpu": 2},
+	}).(map[string]any)
+	t.Setenv("AIS_TEST_ETL_NS", "prod")
+
+	spec := "image: {{ .Values.image }}\n" +
+		"memory: ${resources.memory}\n" +
+		"cpu: {{ .Values.resources.cpu | quote }}\n" +
+		"limit: {{ .Values.resources.limit | default \"4Gi\" }}\n" +
+		"namespace: ${AIS_TEST_ETL_NS}\n"
+	out, err := renderEtlTmpl([]byte(spec), values)
+	tassert.CheckFatal(t, err)
+	expected := "image: aistorage/md5:v2\nmemory: 2Gi\ncpu: \"2\"\nlimit: 4Gi\nnamespace: prod\n"
+	tassert.Errorf(t, string(out) == expected, "expected:\n%s\ngot:\n%s", expected, out)
+
+	_, err = renderEtlTmpl([]byte("image: ${no.such.value}\n"), values)
+	tassert.Errorf(t, err != nil, "expected undefined variable error")
+	_, err = renderEtlTmpl([]byte("image: {{ .Values.tag }}\n"), values)
+	tassert.Errorf(t, err != nil, "expected undefined value error")
+}
+
+func TestGrepLog(t *testing.T) {
+	log := "I 10:00:00.000001 a.go:1 GET ok\n" +
+		"E 10:00:00.000002 b.go:2 not found (request Xy3kLpQm) (p[abc]: ...)\n" +
+		"I 10:00:00.000003 c.go:3 done\n" +
+		"W 10:00:00.000004 d.go:4 retry (request Xy3kLpQm)"
+	lines := grepLog(log, "Xy3kLpQm")
+	tassert.Fatalf(t, len(lines) == 2, "expected 2 lines, got %d: %v", len(lines), lines)
+	tassert.Errorf(t, strings.HasPrefix(lines[0], "E ") && strings.HasPrefix(lines[1], "W "), "unexpected %v", lines)
+	tassert.Errorf(t, len(grepLog(log, "none")) == 0, "expected no lines")
+}
+
+func TestAliasTmpl(t *testing.T) {
+	isCmd := func(cmd string) bool { return cmd == "log" || cmd == "log get" || cmd == "ls" }
+
+	tmpl, err := parseAliasTmpl("log get $1 /tmp/logs/$1", isCmd)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tmpl.cmd == "log get" && tmpl.argsUsage() == "ARG1", "unexpected %+v", tmpl)
+	args, err := tmpl.expand([]string{"t1", "--all"})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, strings.Join(args, " ") == "log get t1 /tmp/logs/t1 --all", "unexpected %v", args)
+	_, err = tmpl.expand(nil)
+	tassert.Errorf(t, err != nil, "expected missing argument error")
+
+	tmpl, err = parseAliasTmpl("ls ${1:-ais://nnn} --summary", isCmd)
+	tassert.CheckFatal(t, err)
+	args, err = tmpl.expand(nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, strings.Join(args, " ") == "ls ais://nnn --summary", "unexpected %v", args)
+	args, err = tmpl.expand([]string{"ais://abc", "--all"})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, strings.Join(args, " ") == "ls ais://abc --summary --all", "unexpected %v", args)
+
+	// plain alias
+	tmpl, err = parseAliasTmpl("log get", isCmd)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(tmpl.words) == 0, "expected plain alias, got %+v", tmpl)
+
+	for _, bad := range []string{
+		"get $1",             // not a command
+		"ls $0",              // invalid parameter
+		"ls $2",              // gap
+		"ls ${1:-a} ${1:-b}", // conflicting defaults
+		"ls ${1:-a} $2",      // required after optional
+		"ls ${1",             // malformed
+	} {
+		_, err := parseAliasTmpl(bad, isCmd)
+		tassert.Errorf(t, err != nil, "expected error for %q", bad)
+	}
+}
+
+func TestSortJobs(t *testing.T) {
+	var (
+		now = time.Now()
+		xs  = xact.MultiSnap{
+			"t1": {
+				{ID: "a", Kind: apc.ActCopyBck, StartTime: now.Add(-time.Hour), EndTime: now.Add(-50 * time.Minute),
+					Stats: core.Stats{Bytes: 10}},
+				{ID: "b", Kind: apc.ActPrefetchObjects, StartTime: now.Add(-2 * time.Minute), Stats: core.Stats{Bytes: 100}},
+				{ID: "c", Kind: apc.ActLRU, StartTime: now.Add(-3 * time.Hour), EndTime: now.Add(-3*time.Hour + time.Second)},
+			},
+			"t2": {
+				{ID: "a", Kind: apc.ActCopyBck, StartTime: now.Add(-time.Hour), EndTime: now.Add(-40 * time.Minute),
+					Stats: core.Stats{OutBytes: 5, InBytes: 5}},
+			},
+		}
+	)
+	jobs := summarizeJobs(xs, now, nil)
+	tassert.Fatalf(t, len(jobs) == 3, "expected 3 jobs, got %d", len(jobs))
+
+	ids := func() (s string) {
+		for _, js := range jobs {
+			s += js.ID
+		}
+		return s
+	}
+	sortJobs(jobs, jobSortAge)
+	tassert.Errorf(t, ids() == "cab", "age: got %q", ids())
+	sortJobs(jobs, jobSortCPU)
+	tassert.Errorf(t, ids() == "abc", "cpu: got %q", ids())
+	sortJobs(jobs, jobSortBytes)
+	tassert.Errorf(t, ids() == "bac", "bytes: got %q", ids())
+
+	a := jobs[1]
+	tassert.Errorf(t, a.Targets == 2 && a.Bytes == 20 && !a.Running && a.RunTime == int64(30*time.Minute), "unexpected %+v", a)
+	tassert.Errorf(t, jobs[0].Running && jobs[0].End.IsZero(), "expected running %+v", jobs[0])
+
+	jobs = summarizeJobs(xs, now, regexp.MustCompile("lru"))
+	tassert.Errorf(t, len(jobs) == 1 && jobs[0].ID == "c", "regex: unexpected %v", jobs)
+}
+
+func TestParseArchList(t *testing.T) {
+	const list = `
+# shard and archpath
+shard-001.tar   images/001.jpg
+shard-002.tar.gz/images/002.jpg
+
+dir/shard-003.zip	sample-003
+`
+	members, err := parseArchList(strings.NewReader(list))
+	tassert.CheckFatal(t, err)
+	expected := []archMember{
+		{"shard-001.tar", "images/001.jpg"},
+		{"shard-002.tar.gz", "images/002.jpg"},
+		{"dir/shard-003.zip", "sample-003"},
+	}
+	tassert.Fatalf(t, reflect.DeepEqual(members, expected), "expected %v, got %v", expected, members)
+
+	for _, bad := range []string{"shard-001.tar", "no-shard-boundary/file.jpg", "a b c"} {
+		_, err := parseArchList(strings.NewReader(bad))
+		tassert.Errorf(t, err != nil, "expected error parsing %q", bad)
+	}
+}
+
+func TestIsDirDest(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		dst      string
+		expected bool
+	}{
+		{"", false},
+		{fileStdIO, false},
+		{"/dev/null", false},
+		{dir, true},
+		{filepath.Join(dir, "new") + "/", true},
+		{filepath.Join(dir, "new"), false},
+		{filepath.Join(dir, "shard.tar"), false},
+	}
+	for _, test := range tests {
+		tassert.Errorf(t, isDirDest(test.dst) == test.expected, "%q: expected %t", test.dst, test.expected)
+	}
+}
+
+func TestScrubPlan(t *testing.T) {
+	var (
+		mirror = &scrubOne{Bck: cmn.Bck{Name: "mirror", Provider: apc.AIS, Props: &cmn.Bprops{}}}
+		ec     = &scrubOne{Bck: cmn.Bck{Name: "ec", Provider: apc.AIS, Props: &cmn.Bprops{}}}
+		ok     = &scrubOne{Bck: cmn.Bck{Name: "ok", Provider: apc.AIS, Props: &cmn.Bprops{}}}
+	)
+	mirror.Bck.Props.Mirror.Enabled = true
+	mirror.Stats.MissingCp = 3
+	ec.Bck.Props.EC.Enabled = true
+	ec.Stats.Misplaced = 2
+
+	plan := scrubPlan([]*scrubOne{ok, mirror, ec}, 1, 0)
+	kinds := make([]string, 0, len(plan))
+	for _, rep := range plan {
+		kinds = append(kinds, rep.kind)
+	}
+	expected := []string{apc.ActRebalance, apc.ActECEncode, apc.ActMakeNCopies} // (sorted by bucket)
+	tassert.Fatalf(t, reflect.DeepEqual(kinds, expected), "expected %v, got %v", expected, kinds)
+	tassert.Errorf(t, plan[2].bck.Name == "mirror" && plan[2].num == 3, "unexpected %+v", plan[2])
+
+	plan = scrubPlan([]*scrubOne{ok}, 0, 0)
+	tassert.Errorf(t, len(plan) == 0, "expected nothing to repair, got %d", len(plan))
+}
+
+func TestCliCfgValidate(t *testing.T) {
+	keys := cliCfgKeys(&config.Config{})
+	tassert.Fatalf(t, keys["no_color"] == cliCfgBool, "expected bool, got %q", keys["no_color"])
+	tassert.Fatalf(t, keys["timeout.tcp_timeout"] == cliCfgDuration, "expected duration, got %q", keys["timeout.tcp_timeout"])
+
+	tests := []struct {
+		key, value string
+		ok         bool
+	}{
+		{"no_color", "true", true},
+		{"no_color", "maybe", false},
+		{"timeout.http_timeout", "30s", true},
+		{"timeout.http_timeout", "30", false},
+		{"default_provider", "aws", true},
+		{"default_provider", "dropbox", false},
+		{"display.timezone", "UTC", true},
+		{"no_colr", "true", false},
+	}
+	for _, test := range tests {
+		err := validateCliCfgKV(keys, test.key, test.value)
+		tassert.Errorf(t, (err == nil) == test.ok, "%s=%s: unexpected %v", test.key, test.value, err)
+	}
+
+	similar := similarCliCfgKeys(keys, "no_colr")
+	tassert.Errorf(t, reflect.DeepEqual(similar, []string{"no_color"}), "unexpected %v", similar)
+	similar = similarCliCfgKeys(keys, "timeout")
+	tassert.Errorf(t, reflect.DeepEqual(similar, []string{"timeout.http_timeout", "timeout.tcp_timeout"}), "unexpected %v", similar)
+	similar = similarCliCfgKeys(keys, "something_else_entirely")
+	tassert.Errorf(t, len(similar) == 0, "unexpected %v", similar)
+}
+
+func TestMetaDiff(t *testing.T) {
+	node := func(id, daeType, url string, flags cos.BitFlags) *meta.Snode {
+		si := &meta.Snode{DaeID: id, DaeType: daeType, Flags: flags}
+		si.PubNet.URL, si.ControlNet.URL, si.DataNet.URL = url, url, url
+		return si
+	}
+	var (
+		p1 = node("p1", apc.Proxy, "http://p1", 0)
+		p2 = node("p2", apc.Proxy, "http://p2", 0)
+		a  = &meta.Smap{
+			Primary: p1,
+			Pmap:    meta.NodeMap{"p1": p1, "p2": p2},
+			Tmap:    meta.NodeMap{"t1": node("t1", apc.Target, "http://t1", 0), "t2": node("t2", apc.Target, "http://t2", 0)},
+		}
+		b = &meta.Smap{
+			Primary: p2,
+			Pmap:    meta.NodeMap{"p1": p1, "p2": p2},
+			Tmap: meta.NodeMap{
+				"t1": node("t1", apc.Target, "http://t1", meta.SnodeMaint),
+				"t3": node("t3", apc.Target, "http://t3", 0),
+			},
+		}
+	)
+	changes := diffSmap(a, b)
+	expected := []string{
+		"~ primary: p[p1] => p[p2]",
+		"~ t[t1] flags: none => maintenance-mode",
+		"- t[t2] http://t2",
+		"+ t[t3] http://t3",
+	}
+	tassert.Errorf(t, reflect.DeepEqual(changes, expected), "smap diff: expected %q, got %q", expected, changes)
+	tassert.Errorf(t, len(diffSmap(a, a)) == 0, "expected no changes")
+
+	bmd := func(bcks map[string]*cmn.Bprops) *meta.BMD {
+		return &meta.BMD{Providers: meta.Providers{apc.AIS: meta.Namespaces{cmn.NsGlobal.Uname(): bcks}}}
+	}
+	var (
+		ba = bmd(map[string]*cmn.Bprops{"abc": {Provider: apc.AIS}, "old": {Provider: apc.AIS}})
+		bb = bmd(map[string]*cmn.Bprops{
+			"abc": {Provider: apc.AIS, Mirror: cmn.MirrorConf{Enabled: true}},
+			"new": {Provider: apc.AIS},
+		})
+	)
+	changes = diffBMD(ba, bb)
+	expected = []string{
+		"~ ais://abc mirror.enabled: false => true",
+		"+ ais://new",
+		"- ais://old",
+	}
+	tassert.Errorf(t, reflect.DeepEqual(changes, expected), "BMD diff: expected %q, got %q", expected, changes)
+}
+
+func TestUpdBackendPlugins(t *testing.T) {
+	from := &cmn.BackendConf{Conf: map[string]any{apc.GCP: map[string]any{}}}
+
+	// add
+	bconf, err := updBackendPlugins(from, "acme", &cmn.ExtPluginConf{URL: "http://localhost:9100"})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(bconf.Ext()) == 1 && bconf.Ext()["acme"].URL == "http://localhost:9100", "expecting acme, got %+v", bconf.Ext())
+	tassert.Fatalf(t, bconf.Get(apc.GCP) != nil, "expecting %q backend to remain configured", apc.GCP)
+	tassert.Fatalf(t, from.Get(apc.Ext) == nil, "source config must not change")
+
+	// update and add another
+	bconf, err = updBackendPlugins(bconf, "acme", &cmn.ExtPluginConf{URL: "https://acme:9100", Timeout: cos.Duration(time.Minute)})
+	tassert.CheckFatal(t, err)
+	bconf, err = updBackendPlugins(bconf, "other", &cmn.ExtPluginConf{URL: "http://other:9200"})
+	tassert.CheckFatal(t, err)
+	ext := bconf.Ext()
+	tassert.Fatalf(t, len(ext) == 2 && ext["acme"].Timeout.D() == time.Minute, "unexpected %+v", ext)
+
+	// invalid
+	_, err = updBackendPlugins(bconf, "bad", &cmn.ExtPluginConf{URL: "localhost:9300"})
+	tassert.Fatalf(t, err != nil, "expecting error on invalid URL")
+	_, err = updBackendPlugins(bconf, "bad name", &cmn.ExtPluginConf{URL: "http://localhost:9300"})
+	tassert.Fatalf(t, err != nil, "expecting error on invalid plugin name")
+
+	// remove
+	_, err = updBackendPlugins(bconf, "nonexistent", nil)
+	tassert.Fatalf(t, err != nil, "expecting error removing nonexistent plugin")
+	bconf, err = updBackendPlugins(bconf, "acme", nil)
+	tassert.CheckFatal(t, err)
+	bconf, err = updBackendPlugins(bconf, "other", nil)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bconf.Get(apc.Ext) == nil, "expecting %q backend to be removed", apc.Ext)
+}
+
+func TestVerifyOffload(t *testing.T) {
+	const uuid = "ClU5tEr"
+	tassert.Errorf(t, offloadPrefix(uuid, &cmn.Bck{Name: "abc", Provider: apc.AIS}) == "ClU5tEr/abc/",
+		"unexpected prefix %q", offloadPrefix(uuid, &cmn.Bck{Name: "abc", Provider: apc.AIS}))
+	nsbck := &cmn.Bck{Name: "abc", Provider: apc.AIS, Ns: cmn.Ns{Name: "ns"}}
+	tassert.Errorf(t, offloadPrefix(uuid, nsbck) == "ClU5tEr/#ns/abc/", "unexpected prefix %q", offloadPrefix(uuid, nsbck))
+
+	var (
+		prefix = "ClU5tEr/abc/"
+		src    = cmn.LsoEntries{{Name: "a", Size: 10, Checksum: "xxhash,1"}, {Name: "d/b", Size: 20}}
+		dst    = cmn.LsoEntries{{Name: prefix + "a", Size: 10}, {Name: prefix + "d/b", Size: 20}, {Name: prefix + "extra", Size: 1}}
+	)
+	ob, err := verifyOffload(src, dst, prefix)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ob.Count == 2 && ob.Size == 30 && ob.Objects[0].Checksum == "xxhash,1", "unexpected %+v", ob)
+
+	// missing and size mismatch
+	dst = cmn.LsoEntries{{Name: prefix + "a", Size: 9}}
+	_, err = verifyOffload(src, dst, prefix)
+	tassert.Fatalf(t, err != nil && strings.Contains(err.Error(), "2 mismatched objects"), "unexpected error %v", err)
+}
+
+func TestParseTorrentSrc(t *testing.T) {
+	local := filepath.Join(t.TempDir(), "data.torrent")
+	tassert.CheckFatal(t, os.WriteFile(local, []byte("d4:infodee"), cos.PermRWR))
+
+	tests := []struct {
+		src      string
+		torrent  string
+		metainfo string
+		ok       bool
+	}{
+		{src: "magnet:?xt=urn:btih:abc&xs=http://host/x.torrent", torrent: "magnet:?xt=urn:btih:abc&xs=http://host/x.torrent", ok: true},
+		{src: "https://host/path/x.torrent?a=b", torrent: "https://host/path/x.torrent?a=b", ok: true},
+		{src: local, metainfo: "d4:infodee", ok: true},
+		{src: "file://" + local, metainfo: "d4:infodee", ok: true},
+		{src: "gs://bucket/x.torrent"},
+		{src: "https://host/path/x.tar"},
+		{src: "ais://bucket"},
+	}
+	for _, test := range tests {
+		var body dload.TorrentBody
+		ok, err := parseTorrentSrc(test.src, &body)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, ok == test.ok, "%q: expected %t, got %t", test.src, test.ok, ok)
+		tassert.Errorf(t, body.Torrent == test.torrent && string(body.Metainfo) == test.metainfo,
+			"%q: unexpected (%q, %q)", test.src, body.Torrent, body.Metainfo)
+	}
+	_, err := parseTorrentSrc("/nonexistent/x.torrent", &dload.TorrentBody{})
+	tassert.Errorf(t, err != nil, "expected error")
+}
+
+func TestTimelineRows(t *testing.T) {
+	start := time.Now().UnixNano()
+	samples := []core.Sample{
+		{Time: start},
+		{Time: start + int64(10*time.Second), Objs: 100, Bytes: 100 * cos.MiB},
+		{Time: start + int64(20*time.Second), Objs: 105, Bytes: 105 * cos.MiB}, // slowdown
+	}
+	rows := timelineRows(samples, "")
+	tassert.Fatalf(t, len(rows) == 2, "expected 2 rows, got %d", len(rows))
+	tassert.Errorf(t, rows[0] == [5]string{"10s", "100", "100.00MiB", "10/s", "10.00MiB/s"}, "unexpected %v", rows[0])
+	tassert.Errorf(t, rows[1] == [5]string{"20s", "105", "105.00MiB", "0.5/s", "512.00KiB/s"}, "unexpected %v", rows[1])
+	tassert.Errorf(t, timelineRows(samples[:1], "") == nil, "expected no rows")
+}
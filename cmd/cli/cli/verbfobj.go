@@ -7,6 +7,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -42,7 +43,7 @@ type (
 		dryRun     bool
 	}
 	uctx struct {
-		wg            cos.WG
+		wpool         *cos.WorkerPool
 		errCh         chan string
 		errCount      atomic.Int32 // uploads failed so far
 		processedCnt  atomic.Int32 // files processed so far
@@ -146,7 +147,7 @@ func (p *uparams) do(c *cli.Context) error {
 	u := &uctx{
 		verbose:      flagIsSet(c, verboseFlag),
 		showProgress: flagIsSet(c, progressFlag),
-		wg:           cos.NewLimitedWaitGroup(p.numWorkers, 0),
+		wpool:        cos.NewWorkerPool(context.Background(), p.numWorkers, false /*fail fast*/),
 		lastReport:   time.Now(),
 		reportEvery:  p.refresh,
 	}
@@ -173,10 +174,12 @@ func (p *uparams) do(c *cli.Context) error {
 
 	u.errCh = make(chan string, len(p.fobjs))
 	for _, fobj := range p.fobjs {
-		u.wg.Add(1) // cos.NewLimitedWaitGroup
-		go u.run(c, p, fobj)
+		u.wpool.Go(func(context.Context) error {
+			u.run(c, p, fobj)
+			return nil
+		})
 	}
-	u.wg.Wait()
+	errp := u.wpool.Wait() // (non-nil only when recovering from panic)
 
 	close(u.errCh)
 
@@ -184,6 +187,9 @@ func (p *uparams) do(c *cli.Context) error {
 		u.progress.Wait()
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
+	if errp != nil {
+		return errp
+	}
 	if numFailed := u.errCount.Load(); numFailed > 0 {
 		fn := fmt.Sprintf(".ais-%s-failures.%d.log", strings.ToLower(p.wop.verb()), os.Getpid())
 		fn = filepath.Join(os.TempDir(), fn)
@@ -387,13 +393,10 @@ func (u *uctx) fini(c *cli.Context, p *uparams, f fobj) {
 	if u.showProgress {
 		u.barObjs.Increment()
 	}
-	u.wg.Done()
 	if u.reportEvery == 0 {
 		return
 	}
 
-	// lock after releasing semaphore, so the next file can start
-	// uploading even if we are stuck on mutex for a while
 	u.mx.Lock()
 	if !u.showProgress && time.Since(u.lastReport) > u.reportEvery {
 		fmt.Fprintf(
@@ -424,6 +427,21 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 	if err != nil {
 		return err
 	}
+
+	// resumable: PUT the first chunk and APPEND the rest, retrying (only) failed chunks
+	if flagIsSet(c, chunkSizeFlag) {
+		chunkSize, err := parseSizeFlag(c, chunkSizeFlag)
+		if err != nil {
+			fh.Close()
+			return err
+		}
+		if chunkSize > 0 && finfo.Size() > chunkSize {
+			err = putAppendChunks(c, bck, objName, fh, cksum.Type(), chunkSize, finfo.Size())
+			fh.Close()
+			return err
+		}
+	}
+
 	reader = fh
 	if flagIsSet(c, progressFlag) {
 		// setup progress bar
@@ -472,16 +490,21 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 }
 
 // PUT and then APPEND fixed-sized chunks using `api.PutObject`, `api.AppendObject` and `api.FlushObject`
-// - currently, is only used to PUT from standard input when we do expect to overwrite existing destination object
-// - APPEND and flush will only be executed with there's a second chunk
-func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, cksumType string, chunkSize int64) error {
+//   - used to PUT from standard input, and to PUT a single file with '--chunk-size' (`putRegular`);
+//     either way, we do expect to overwrite existing destination object
+//   - APPEND and flush will only be executed with there's a second chunk
+//   - failed chunk gets retried up to '--retries' times; only the chunk in question is retransmitted
+//     (the target rolls back a partially appended chunk - see `apndOI.rollback`)
+//   - `size` is the total size, if known (otherwise, -1)
+func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, cksumType string, chunkSize, size int64) error {
 	var (
-		handle string
-		cksum  = cos.NewCksumHash(cksumType)
-		pi     = newProgIndicator(objName)
+		handle  string
+		cksum   = cos.NewCksumHash(cksumType)
+		retries = parseRetriesFlag(c, putRetriesFlag, true /*warn*/)
+		prog    *chunkProgress
 	)
 	if flagIsSet(c, progressFlag) {
-		pi.start()
+		prog = newChunkProgress(objName, chunkSize, size)
 	}
 	for i := 0; ; i++ {
 		var (
@@ -496,58 +519,62 @@ func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, c
 			n, err = io.CopyN(b, r, chunkSize)
 		}
 		if err != nil && err != io.EOF {
+			prog.abort()
 			return err
 		}
 		if n == 0 {
 			break
 		}
 		reader = cos.NewByteHandle(b.Bytes())
-		if flagIsSet(c, progressFlag) {
-			actualChunkOffset := atomic.NewInt64(0)
-			reader = cos.NewCallbackReadOpenCloser(reader, func(n int, _ error) {
-				if n == 0 {
-					return
+		if prog != nil {
+			reader = prog.wrap(reader, i)
+		}
+		for j := 0; ; j++ {
+			if i == 0 {
+				// overwrite, if exists
+				// NOTE: when followed by APPEND (below) will increment resulting ais object's version one extra time
+				putArgs := api.PutArgs{
+					BaseParams: apiBP,
+					Bck:        bck,
+					ObjName:    objName,
+					Reader:     reader,
+					Size:       uint64(n),
 				}
-				newChunkOffset := actualChunkOffset.Add(int64(n))
-				// `actualChunkOffset` is needed to not count the bytes read more than
-				// once upon redirection
-				if newChunkOffset > chunkSize {
-					// This part of the file was already read, so don't read it again
-					pi.printProgress(chunkSize - newChunkOffset + int64(n))
-					return
+				_, err = api.PutObject(&putArgs)
+			} else {
+				var hdl string
+				hdl, err = api.AppendObject(&api.AppendArgs{
+					BaseParams: apiBP,
+					Bck:        bck,
+					Object:     objName,
+					Handle:     handle, // (when retrying: the handle returned by the last successful append)
+					Reader:     reader,
+					Size:       n,
+				})
+				if err == nil {
+					handle = hdl
 				}
-				pi.printProgress(int64(n))
-			})
-		}
-		if i == 0 {
-			// overwrite, if exists
-			// NOTE: when followed by APPEND (below) will increment resulting ais object's version one extra time
-			putArgs := api.PutArgs{
-				BaseParams: apiBP,
-				Bck:        bck,
-				ObjName:    objName,
-				Reader:     reader,
-				Size:       uint64(n),
 			}
-			_, err = api.PutObject(&putArgs)
-		} else {
-			handle, err = api.AppendObject(&api.AppendArgs{
-				BaseParams: apiBP,
-				Bck:        bck,
-				Object:     objName,
-				Handle:     handle,
-				Reader:     reader,
-				Size:       n,
-			})
-		}
-		if err != nil {
-			return err
+			if err == nil {
+				if j > 0 {
+					fmt.Fprintf(c.App.ErrWriter, "[#%d] %s, chunk #%d - done.\n", j+1, objName, i+1)
+				}
+				break
+			}
+			if j >= retries {
+				prog.abort()
+				return err
+			}
+			fmt.Fprintf(c.App.ErrWriter, "[#%d] %s, chunk #%d: %v - retrying...\n", j+1, objName, i+1, stripErr(err))
+			briefPause(1)
+			if reader, err = reader.Open(); err != nil {
+				prog.abort()
+				return err
+			}
 		}
 	}
 
-	if flagIsSet(c, progressFlag) {
-		pi.stop()
-	}
+	prog.stop()
 	if cksumType != cos.ChecksumNone {
 		cksum.Finalize()
 	}
@@ -563,6 +590,77 @@ func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, c
 	})
 }
 
+// putAppendChunks progress: a bar showing the current chunk when the total size is known;
+// otherwise (standard input), progIndicator
+type chunkProgress struct {
+	pi       *progIndicator
+	progress *mpb.Progress
+	bar      *mpb.Bar
+	chunk    atomic.Int64
+	size     int64
+	nchunks  int64
+}
+
+func newChunkProgress(objName string, chunkSize, size int64) *chunkProgress {
+	prog := &chunkProgress{size: size}
+	if size < 0 {
+		prog.pi = newProgIndicator(objName)
+		prog.pi.start()
+		return prog
+	}
+	prog.nchunks = max(cos.DivCeil(size, chunkSize), 1)
+	chunkDecor := decor.Any(func(*decor.Statistics) string {
+		return fmt.Sprintf("chunk %d/%d", prog.chunk.Load(), prog.nchunks)
+	}, decor.WCSyncWidth)
+	args := barArgs{
+		barType: sizeArg,
+		barText: objName,
+		total:   size,
+		options: []mpb.BarOption{mpb.AppendDecorators(chunkDecor)},
+	}
+	var bars []*mpb.Bar
+	prog.progress, bars = simpleBar(args)
+	prog.bar = bars[0]
+	return prog
+}
+
+// report each byte only once, across redirects and retries
+func (prog *chunkProgress) wrap(reader cos.ReadOpenCloser, i int) cos.ReadOpenCloser {
+	prog.chunk.Store(int64(i + 1))
+	return cos.NewCallbackReadOpenCloser(reader, func(n int, _ error) {
+		if n == 0 {
+			return
+		}
+		if prog.pi != nil {
+			prog.pi.printProgress(int64(n))
+		} else {
+			prog.bar.IncrBy(n)
+		}
+	})
+}
+
+func (prog *chunkProgress) stop() {
+	switch {
+	case prog == nil:
+	case prog.pi != nil:
+		prog.pi.stop()
+	default:
+		prog.bar.SetTotal(prog.size, true)
+		prog.progress.Wait()
+	}
+}
+
+func (prog *chunkProgress) abort() {
+	switch {
+	case prog == nil:
+	case prog.pi != nil:
+		prog.pi.stop()
+	default:
+		prog.bar.Abort(false)
+		prog.progress.Wait()
+	}
+}
+
 //
 // PUT checksum
 //
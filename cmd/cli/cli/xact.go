@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -21,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
@@ -385,3 +387,49 @@ func xstop(xargs *xact.ArgsMsg) (err error) {
 	}
 	return nil
 }
+
+//
+// throughput timeline (`show job ID --timeline`)
+//
+
+func showTimeline(c *cli.Context, dts []nodeSnaps, units string) {
+	for _, di := range dts {
+		for _, snap := range di.XactSnaps {
+			_, name := xact.GetKindName(snap.Kind)
+			actionCptn(c, meta.Tname(di.DaemonID)+":", fmt.Sprintf("%s[%s] throughput timeline", name, snap.ID))
+			if len(snap.Timeline) < 2 {
+				fmt.Fprintf(c.App.Writer, "No samples (the job finished or was running for less than %v)\n", xact.TimelineIval)
+				continue
+			}
+			tw := tabwriter.NewWriter(c.App.Writer, 0, 8, 2, ' ', 0)
+			fmt.Fprintln(tw, "ELAPSED\tOBJECTS\tSIZE\tOBJECTS/S\tTHROUGHPUT")
+			for _, row := range timelineRows(snap.Timeline, units) {
+				fmt.Fprintln(tw, strings.Join(row[:], "\t"))
+			}
+			tw.Flush()
+		}
+	}
+}
+
+// one row per interval between two consecutive samples
+func timelineRows(samples []core.Sample, units string) [][5]string {
+	if len(samples) < 2 {
+		return nil
+	}
+	var (
+		rows  = make([][5]string, 0, len(samples)-1)
+		start = samples[0].Time
+	)
+	for i := 1; i < len(samples); i++ {
+		prev, cur := &samples[i-1], &samples[i]
+		bps, ops := xact.Throughput(prev, cur)
+		rows = append(rows, [5]string{
+			teb.FmtDuration(cur.Time-start, units),
+			strconv.FormatInt(cur.Objs, 10),
+			teb.FmtSize(cur.Bytes, units, 2),
+			teb.FmtRate(ops),
+			teb.FmtSize(int64(bps), units, 2) + "/s",
+		})
+	}
+	return rows
+}
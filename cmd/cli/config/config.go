@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -50,14 +51,32 @@ type (
 	AuthConfig struct {
 		URL string `json:"url"`
 	}
+	// output localization (applies to all tables)
+	DisplayConfig struct {
+		// "" - as is (default), "local", "UTC", or IANA time zone name (e.g., "Europe/Berlin")
+		Timezone string `json:"timezone"`
+		// Go layout (e.g., "2006-01-02 15:04:05") or one of: rfc822, rfc3339, rfc1123, datetime, stamp
+		// ("" - per table default)
+		TimeFormat string `json:"time_format"`
+		// single-character number separators, e.g. ",", ".", " ", "_" ("" - none and '.', respectively)
+		ThousandsSep string `json:"thousands_separator"`
+		DecimalSep   string `json:"decimal_separator"`
+	}
+
 	AliasConfig cos.StrKVs // (see DefaultAliasConfig below)
 
+	// named column layouts ("views"): view name => comma-separated list of object properties
+	// (e.g., "ops" => "name,size,version,cached"); usage: `ais ls --view ops`
+	ViewsConfig cos.StrKVs
+
 	// all of the above
 	Config struct {
 		Cluster         ClusterConfig `json:"cluster"`
 		Timeout         TimeoutConfig `json:"timeout"`
 		Auth            AuthConfig    `json:"auth"`
 		Aliases         AliasConfig   `json:"aliases"`
+		Views           ViewsConfig   `json:"views,omitempty"`
+		Display         DisplayConfig `json:"display"`
 		DefaultProvider string        `json:"default_provider,omitempty"` // NOTE: not supported yet (see app.go)
 		NoColor         bool          `json:"no_color"`
 		Verbose         bool          `json:"verbose"` // more warnings, errors with backtraces and details
@@ -159,7 +178,7 @@ func (a AliasConfig) Str(indent string) (s string) {
 // Config //
 ////////////
 
-func (c *Config) validate() (err error) {
+func (c *Config) Validate() (err error) {
 	if c.Timeout.TCPTimeout, err = time.ParseDuration(c.Timeout.TCPTimeoutStr); err != nil {
 		return fmt.Errorf("invalid timeout.tcp_timeout format %q: %v", c.Timeout.TCPTimeoutStr, err)
 	}
@@ -173,6 +192,20 @@ func (c *Config) validate() (err error) {
 	if c.Aliases == nil {
 		c.Aliases = DefaultAliasConfig
 	}
+	if tz := c.Display.Timezone; tz != "" && tz != "local" {
+		if _, err := time.LoadLocation(tz); err != nil {
+			return fmt.Errorf("invalid display.timezone %q: %v", tz, err)
+		}
+	}
+	if len(c.Display.ThousandsSep) > 1 || len(c.Display.DecimalSep) > 1 {
+		return fmt.Errorf("invalid display separators (%q, %q): expecting single characters",
+			c.Display.ThousandsSep, c.Display.DecimalSep)
+	}
+	for name, props := range c.Views {
+		if name == "" || strings.TrimSpace(props) == "" {
+			return fmt.Errorf("invalid views: view %q is empty or unnamed", name)
+		}
+	}
 	return nil
 }
 
@@ -217,7 +250,7 @@ func Load(args []string, reset string) (*Config, error) {
 		return cfg, err
 	}
 
-	if err := cfg.validate(); err != nil {
+	if err := cfg.Validate(); err != nil {
 		path := filepath.Join(ConfigDir, fname.CliConfig)
 		if cos.StringInSlice(reset, args) {
 			fmt.Fprintf(os.Stderr, "CLI config at %s: %v\n", path, err)
@@ -29,6 +29,7 @@ const (
 const (
 	xfinished     = "Finished"
 	xfinishedErrs = "Finished with errors"
+	xpartial      = "Finished (partial)" // see apc.JobQuota
 	xrunning      = "Running"
 	xidle         = "Idle"
 	xaborted      = "Aborted"
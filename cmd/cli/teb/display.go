@@ -0,0 +1,161 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Output localization: display timezone, time format, and number separators
+// (see config.DisplayConfig and SetDisplay)
+
+const (
+	TimezoneLocal = "local"
+	TimezoneUTC   = "UTC"
+)
+
+// named time formats (in addition to Go layouts, e.g. "2006-01-02 15:04:05")
+var timeFormats = map[string]string{
+	"rfc822":   time.RFC822,
+	"rfc3339":  time.RFC3339,
+	"rfc1123":  time.RFC1123,
+	"datetime": time.DateTime,
+	"stamp":    time.Stamp,
+}
+
+var display struct {
+	loc       *time.Location // nil: as is
+	timeFmt   string         // "": per table default
+	thousands string         // thousands separator ("": none)
+	decimal   string         // decimal separator ("": '.')
+}
+
+func SetDisplay(tz, timeFmt, thousands, decimal string) error {
+	switch tz {
+	case "":
+		display.loc = nil // as is (e.g., node-local time)
+	case TimezoneLocal:
+		display.loc = time.Local
+	case TimezoneUTC, "utc":
+		display.loc = time.UTC
+	default:
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return fmt.Errorf("invalid timezone %q: %v", tz, err)
+		}
+		display.loc = loc
+	}
+	if f, ok := timeFormats[strings.ToLower(timeFmt)]; ok {
+		timeFmt = f
+	} else if timeFmt != "" && !strings.Contains(timeFmt, "2006") && !strings.Contains(timeFmt, "15") {
+		return fmt.Errorf("invalid time format %q (expecting Go layout or one of: rfc822, rfc3339, rfc1123, datetime, stamp)", timeFmt)
+	}
+	display.timeFmt = timeFmt
+
+	if len(thousands) > 1 || len(decimal) > 1 {
+		return errors.New("number separators must be single characters")
+	}
+	if thousands != "" && thousands == decimal {
+		return fmt.Errorf("thousands and decimal separators must differ (%q)", thousands)
+	}
+	display.thousands, display.decimal = thousands, decimal
+	return nil
+}
+
+// format timestamp as per display config; `dflt` is the default (per table) format
+func FmtTimestamp(t time.Time, dflt string) string {
+	if display.loc != nil {
+		t = t.In(display.loc)
+	}
+	if display.timeFmt != "" {
+		dflt = display.timeFmt
+	}
+	return cos.FormatTime(t, dflt)
+}
+
+// whether timestamps are to be converted (see also: TimeLayout)
+func Localized() bool { return display.loc != nil || display.timeFmt != "" }
+
+// layout of the timestamps formatted elsewhere (e.g., list-objects atime) and
+// to be reformatted via FormatAtime
+const TimeLayout = time.RFC3339Nano
+
+func fmtTimestampStr(s string) string {
+	if s == "" || !Localized() {
+		return s
+	}
+	layout := TimeLayout
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return s
+	}
+	return FmtTimestamp(t, "")
+}
+
+// FmtInt formats integer with thousands separator, if configured
+func FmtInt(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	if display.thousands == "" {
+		return s
+	}
+	return groupDigits(s)
+}
+
+// apply configured separators to a formatted number, e.g. "1234.5GiB"
+func fmtNumber(s string) string {
+	if display.thousands == "" && display.decimal == "" {
+		return s
+	}
+	// split: [sign]digits[.fraction][suffix]
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+	j := i
+	for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+		j++
+	}
+	if j == i {
+		return s
+	}
+	out := s[:i] + groupDigits(s[i:j])
+	rest := s[j:]
+	if display.decimal != "" && rest != "" && rest[0] == '.' {
+		rest = display.decimal + rest[1:]
+	}
+	return out + rest
+}
+
+func groupDigits(digits string) string {
+	if display.thousands == "" || len(digits) <= 3 {
+		return digits
+	}
+	neg := digits[0] == '-'
+	if neg {
+		digits = digits[1:]
+	}
+	var sb strings.Builder
+	sb.Grow(len(digits) + len(digits)/3 + 1)
+	if neg {
+		sb.WriteByte('-')
+	}
+	head := len(digits) % 3
+	if head > 0 {
+		sb.WriteString(digits[:head])
+	}
+	for k := head; k < len(digits); k += 3 {
+		if k > 0 {
+			sb.WriteString(display.thousands)
+		}
+		sb.WriteString(digits[k : k+3])
+	}
+	return sb.String()
+}
@@ -18,7 +18,7 @@ var (
 		apc.GetPropsName:     "{{FormatNameDirArch $obj.Name $obj.Flags}}",
 		apc.GetPropsSize:     "{{FormatBytesSig2 $obj.Size 2 $obj.Flags}}",
 		apc.GetPropsChecksum: "{{$obj.Checksum}}",
-		apc.GetPropsAtime:    "{{$obj.Atime}}",
+		apc.GetPropsAtime:    "{{FormatAtime $obj.Atime}}",
 		apc.GetPropsVersion:  "{{$obj.Version}}",
 		apc.GetPropsLocation: "{{$obj.Location}}",
 		apc.GetPropsCustom:   "{{FormatObjCustom $obj.Custom}}",
@@ -6,6 +6,7 @@ package teb
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"sort"
 	"strings"
@@ -30,6 +31,10 @@ type PerfTabCtx struct {
 	AvgSize   bool // compute average size on the fly (and show it), e.g.: `get.size/get.n`
 	Idle      bool // currently idle
 	NoColor   bool
+	// rate of change (per second) between consecutive samples: target ID => (metric name => rate);
+	// when non-nil, shown instead of (or, if Cumulative, next to) cumulative values
+	Rates      map[string]map[string]float64
+	Cumulative bool
 }
 
 // return numNZ (non-zero) metrics OR bad status
@@ -183,6 +188,15 @@ func (c *PerfTabCtx) MakeTab(st StstMap) (*Table, int, error) {
 				}
 			}
 
+			// rate of change
+			if c.Rates != nil {
+				rate, ok := c.Rates[tid][h.name]
+				if stats.IsErrMetric(h.name) {
+					haveErrs = rate != 0 // growing (not cumulative) errors
+				}
+				printedValue = c._fmtRate(h.name, kind, rate, ok, printedValue)
+			}
+
 			if haveErrs && i == last {
 				printedValue += eorErrIndication // <<<
 			}
@@ -234,6 +248,22 @@ func (c *PerfTabCtx) MakeTab(st StstMap) (*Table, int, error) {
 // utils/helpers
 //
 
+func (c *PerfTabCtx) _fmtRate(name, kind string, rate float64, ok bool, cumulative string) string {
+	var s string
+	switch {
+	case !ok:
+		s = unknownVal
+	case kind == stats.KindSize:
+		s = FmtStatValue(name, stats.KindThroughput, int64(math.Round(rate)), c.Units)
+	default:
+		s = FmtRate(rate)
+	}
+	if c.Cumulative {
+		return cumulative + " (" + s + ")"
+	}
+	return s
+}
+
 // remove all-zeros columns
 func (st StstMap) _zerout(cols []*header) []*header {
 	for i := 0; i < len(cols); i++ {
@@ -65,12 +65,14 @@ const (
 	// special xactions & dsort
 	//
 
-	downloadListHdr  = "JOB ID\t XACTION\t STATUS\t ERRORS\t DESCRIPTION\n"
+	downloadListHdr  = "JOB ID\t XACTION\t STATUS\t ERRORS\t BANDWIDTH\t DESCRIPTION\n"
 	downloadListBody = "{{$value.ID}}\t " +
 		"{{$value.XactID}}\t " +
 		"{{if $value.Aborted}}Aborted" +
-		"{{else}}{{if $value.JobFinished}}Finished{{else}}{{$value.PendingCnt}} pending{{end}}" +
-		"{{end}}\t {{$value.ErrorCnt}}\t {{$value.Description}}\n"
+		"{{else}}{{if $value.JobFinished}}Finished{{else}}{{$value.PendingCnt}} pending{{if $value.Paused}} (paused){{end}}{{end}}" +
+		"{{end}}\t {{$value.ErrorCnt}}\t " +
+		"{{if $value.Bandwidth}}{{FormatBytesSig $value.Bandwidth 2}}/s{{else}}-{{end}}\t " +
+		"{{$value.Description}}\n"
 	DownloadListNoHdrTmpl = "{{ range $key, $value := . }}" + downloadListBody + "{{end}}"
 	DownloadListTmpl      = downloadListHdr + DownloadListNoHdrTmpl
 
@@ -178,7 +180,7 @@ const (
 	XactECPutTmpl      = xactECPutStatsHdr + XactECPutNoHdrTmpl
 	XactECPutNoHdrTmpl = "{{range $nodeSnaps := . }}" + xactECPutBody + "{{end}}"
 
-	xactECPutStatsHdr  = "NODE\t ID\t BUCKET\t OBJECTS\t BYTES\t ERRORS\t QUEUE\t AVG TIME\t ENC TIME\t START\t END\t STATE\n"
+	xactECPutStatsHdr  = "NODE\t ID\t BUCKET\t OBJECTS\t BYTES\t ERRORS\t QUEUE\t LAZY\t AVG TIME\t ENC TIME\t START\t END\t STATE\n"
 	xactECPutBody      = "{{range $key, $xctn := $nodeSnaps.XactSnaps}}" + xactECPutStatsBody + "{{end}}"
 	xactECPutStatsBody = "{{ $nodeSnaps.DaemonID }}\t " +
 		"{{if $xctn.ID}}{{$xctn.ID}}{{else}}-{{end}}\t " +
@@ -189,6 +191,7 @@ const (
 		"{{ $ext := ExtECPutStats $xctn }}" +
 		"{{if (eq $ext.EncodeErrCount 0) }}-{{else}}{{$ext.EncodeErrCount}}{{end}}\t " +
 		"{{if (eq $ext.AvgQueueLen 0.0) }}-{{else}}{{ FormatFloat $ext.AvgQueueLen}}{{end}}\t " +
+		"{{if (eq $ext.LazyPending 0) }}-{{else}}{{$ext.LazyPending}}{{end}}\t " +
 		"{{if (eq $ext.AvgObjTime 0) }}-{{else}}{{FormatMilli $ext.AvgObjTime}}{{end}}\t " +
 		"{{if (eq $ext.AvgEncodeTime 0) }}-{{else}}{{FormatMilli $ext.AvgEncodeTime}}{{end}}\t " +
 
@@ -209,6 +212,14 @@ const (
 		"{{end}}"
 	ListBucketsSummTmpl = listBucketsSummHdr + ListBucketsSummBody
 
+	// list-buckets with usage (apc.QparamBckUsage)
+	listBucketsUsageHdr  = "NAME\t OBJECTS\t SIZE\t CREATED\t LAST PUT\t LAST GET\n"
+	ListBucketsUsageBody = "{{range $k, $v := . }}" +
+		"{{FormatBckName $v.Bck}}\t {{$v.ObjCount}}\t {{FormatBytesUns $v.Size 2}}\t " +
+		"{{FormatUnixNano $v.Created}}\t {{FormatUnixNano $v.LastPut}}\t {{FormatUnixNano $v.LastGet}}\n" +
+		"{{end}}"
+	ListBucketsUsageTmpl = listBucketsUsageHdr + ListBucketsUsageBody
+
 	ListBucketsHdrNoSummary  = "NAME\t PRESENT\n"
 	ListBucketsBodyNoSummary = "{{range $k, $v := . }}" +
 		"{{FormatBckName $v.Bck}}\t {{FormatBool $v.Info.IsBckPresent}}\n" +
@@ -224,6 +235,24 @@ const (
 		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
 		"{{end}}"
 
+	// `ais cluster add-remove-nodes plan`
+	RebPlanTmpl = "TARGET\t OBJECTS OUT\t SIZE OUT\t OBJECTS IN\t SIZE IN\n" +
+		"{{range $v := .Targets}}" +
+		"{{$v.Sname}}\t {{$v.ObjsOut}}\t {{FormatBytesSig $v.SizeOut 2}}\t {{$v.ObjsIn}}\t {{FormatBytesSig $v.SizeIn 2}}\n" +
+		"{{end}}"
+
+	ChainTmpl = "CHAIN {{.Name}}: {{.State}}\n" +
+		"STEP\t KIND\t JOB ID\t STATE\t ELAPSED\t ERROR\n" +
+		"{{range $v := .Steps}}" +
+		"{{$v.Name}}\t {{$v.Kind}}\t {{if $v.JobID}}{{$v.JobID}}{{else}}-{{end}}\t {{$v.State}}\t {{if $v.Elapsed}}{{$v.Elapsed}}{{else}}-{{end}}\t {{if $v.Err}}{{$v.Err}}{{else}}-{{end}}\n" +
+		"{{end}}"
+
+	// `ais ls --diff-inventory`
+	InvDiffTmpl = "STATUS\t NAME\t SIZE\t DETAILS\n" +
+		"{{range $v := .}}" +
+		"{{$v.Status}}\t {{$v.Name}}\t {{FormatBytesSig $v.Size 2}}\t {{if $v.Details}}{{$v.Details}}{{else}}-{{end}}\n" +
+		"{{end}}"
+
 	// For `object put` mass uploader. A caller adds to the template
 	// total count and size. That is why the template ends with \t
 	MultiPutTmpl = "Files to upload:\nEXTENSION\t COUNT\t SIZE\n" +
@@ -286,8 +315,16 @@ See '--help' and docs/cli for details.`
 		"{{end}}{{end}}" +
 		"{{ end }}"
 
+	AuthNSessionsTmpl = "SESSION\tISSUED\tEXPIRES\n" +
+		"{{ range $s := . }}" +
+		"{{ $s.ID }}\t{{ FormatTimestamp $s.Issued }}\t{{ FormatTimestamp $s.Expires }}\n" +
+		"{{end}}"
+
 	AuthNRoleVerboseTmpl = "Role\t{{ .Name }}\n" +
 		"Description\t{{ .Description }}\n" +
+		"{{ if .Parents }}Extends\t{{ JoinList .Parents }}\n{{end}}" +
+		"{{ if .MaxJobs }}Max running jobs\t{{ .MaxJobs }}\n{{end}}" +
+		"{{ if .MaxSessions }}Max sessions\t{{ .MaxSessions }}\n{{end}}" +
 		"{{ if ne (len .ClusterACLs) 0 }}" +
 		"CLUSTER ID\tALIAS\tPERMISSIONS\n" +
 		"{{ range $clu := .ClusterACLs }}" +
@@ -75,11 +75,11 @@ func ValidateUnits(units string) error {
 func FmtSize(size int64, units string, digits int) string {
 	switch units {
 	case "", cos.UnitsIEC:
-		return cos.ToSizeIEC(size, digits)
+		return fmtNumber(cos.ToSizeIEC(size, digits))
 	case cos.UnitsSI:
-		return toSizeSI(size, digits)
+		return fmtNumber(toSizeSI(size, digits))
 	case cos.UnitsRaw:
-		return strconv.FormatInt(size, 10)
+		return FmtInt(size)
 	default:
 		debug.Assert(false, units)
 		return ""
@@ -113,6 +113,22 @@ func FmtStatValue(name, kind string, value int64, units string) string {
 	if value == 0 {
 		return "0"
 	}
+	return fmtNumber(_statValue(name, kind, value, units))
+}
+
+// counter's rate of change, e.g. "0.5/s", "120/s"
+func FmtRate(rate float64) string {
+	switch {
+	case rate == 0:
+		return "0"
+	case rate < 10 && rate > -10:
+		return strconv.FormatFloat(rate, 'f', 1, 64) + "/s"
+	default:
+		return strconv.FormatFloat(rate, 'f', 0, 64) + "/s"
+	}
+}
+
+func _statValue(name, kind string, value int64, units string) string {
 	// uptime
 	if strings.HasSuffix(name, ".time") || kind == stats.KindLatency || kind == stats.KindTotal {
 		return FmtDuration(value, units)
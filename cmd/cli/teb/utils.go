@@ -91,13 +91,17 @@ var (
 		"FormatTargetsSumm":    fmtTargetsSumm,
 		"FormatCapPctMAM":      fmtCapPctMAM,
 		"FormatCDFDisks":       fmtCDFDisks,
-		"FormatFloat":          func(f float64) string { return fmt.Sprintf("%.2f", f) },
+		"FormatFloat":          func(f float64) string { return fmtNumber(fmt.Sprintf("%.2f", f)) },
+		"FormatInt":            FmtInt,
+		"FormatAtime":          fmtTimestampStr,
+		"FormatUnixNano":       fmtUnixNano,
 		"FormatBool":           FmtBool,
 		"FormatBckName":        fmtBckName,
 		"FormatACL":            fmtACL,
 		"FormatNameDirArch":    fmtNameDirArch,
 		"FormatXactRunFinAbrt": FmtXactRunFinAbrt,
 		"FormatCtlMsg":         fmtCtlMsg,
+		"FormatTimestamp":      func(t time.Time) string { return FmtTimestamp(t, "") },
 		//  misc. helpers
 		"IsUnsetTime":   isUnsetTime,
 		"IsEqS":         func(a, b string) bool { return a == b },
@@ -527,6 +531,10 @@ func FmtXactRunFinAbrt(snap *core.Snap) (s string) {
 		}
 		return fmt.Sprintf("%s: %q", xaborted, snap.AbortErr)
 	case !snap.EndTime.IsZero():
+		if snap.Partial != "" {
+			s = xpartial + ": " + snap.Partial
+			break
+		}
 		if snap.Err == "" {
 			return xfinished
 		}
@@ -579,7 +587,14 @@ func FmtTime(t time.Time) (s string) {
 	if t.IsZero() {
 		return
 	}
-	return cos.FormatTime(t, cos.StampSec)
+	return FmtTimestamp(t, cos.StampSec)
+}
+
+func fmtUnixNano(ns int64) string {
+	if ns == 0 {
+		return NotSetVal
+	}
+	return FmtDateTime(time.Unix(0, ns))
 }
 
 func FmtDateTime(t time.Time) (s string) {
@@ -587,5 +602,5 @@ func FmtDateTime(t time.Time) (s string) {
 	if t.IsZero() {
 		return
 	}
-	return cos.FormatTime(t, time.Stamp)
+	return FmtTimestamp(t, time.Stamp)
 }
@@ -8,6 +8,7 @@ package cmn_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -108,3 +109,48 @@ func TestActMsgRawUnmarshal(t *testing.T) {
 		testRawUnmarshal(t, test)
 	}
 }
+
+func TestTCBMsgRename(t *testing.T) {
+	tests := []struct {
+		rename   apc.RenameMsg
+		ext      cos.StrKVs
+		prepend  string
+		src, dst string
+	}{
+		{apc.RenameMsg{StripPrefix: "raw/"}, nil, "", "raw/a/b.txt", "a/b.txt"},
+		{apc.RenameMsg{StripPrefix: "raw/"}, nil, "cooked/", "raw/a.txt", "cooked/a.txt"},
+		{apc.RenameMsg{Regex: `\.jpeg$`, Repl: ".jpg"}, nil, "", "img/cat.jpeg", "img/cat.jpg"},
+		{apc.RenameMsg{Regex: `^(\w+)-(\d+)`, Repl: "${2}_$1"}, nil, "", "shard-12.tar", "12_shard.tar"},
+		{apc.RenameMsg{PadDigits: 4}, nil, "", "dir7/img-7.jpg", "dir7/img-0007.jpg"},
+		{apc.RenameMsg{PadDigits: 2}, nil, "", "dir7/img-123.jpg", "dir7/img-123.jpg"},
+		{apc.RenameMsg{PadDigits: 3}, nil, "", "dir7/img.jpg", "dir7/img.jpg"},
+		{apc.RenameMsg{StripPrefix: "a/", PadDigits: 3}, cos.StrKVs{"jpeg": "jpg"}, "b/", "a/x9.jpeg", "b/x009.jpg"},
+	}
+	for _, test := range tests {
+		rename := test.rename
+		msg := &apc.TCBMsg{Ext: test.ext, CopyBckMsg: apc.CopyBckMsg{Prepend: test.prepend, Rename: &rename}}
+		tassert.CheckFatal(t, msg.Init())
+		if dst := msg.ToName(test.src); dst != test.dst {
+			t.Errorf("%+v: %q => %q, expected %q", test.rename, test.src, dst, test.dst)
+		}
+	}
+
+	// invalid
+	for _, rename := range []apc.RenameMsg{{Regex: "("}, {Repl: "x"}, {PadDigits: -1}} {
+		msg := &apc.CopyBckMsg{Rename: &rename}
+		tassert.Errorf(t, msg.Init() != nil, "expected %+v to fail", rename)
+	}
+	msg := &apc.CopyBckMsg{Sync: true, Rename: &apc.RenameMsg{StripPrefix: "a/"}}
+	tassert.Errorf(t, msg.Init() != nil, "expected rename with sync to fail")
+}
+
+func TestCopyBckMsgSnapshot(t *testing.T) {
+	msg := &apc.CopyBckMsg{Snapshot: true}
+	tassert.CheckFatal(t, msg.Init())
+	for _, msg := range []*apc.CopyBckMsg{{Snapshot: true, Sync: true}, {Snapshot: true, LatestVer: true}} {
+		tassert.Errorf(t, msg.Init() != nil, "expected snapshot with %+v to fail", msg)
+	}
+	var sb strings.Builder
+	msg.Str(&sb, "ais://src", "ais://dst")
+	tassert.Errorf(t, strings.HasSuffix(sb.String(), ", snapshot"), "unexpected ctlmsg %q", sb.String())
+}
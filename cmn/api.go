@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -57,12 +58,96 @@ type (
 		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
 		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		Hedge       HedgeConf       `json:"hedge"`                          // hedged reads (cold GET)
+		RespHdr     RespHdrConf     `json:"resp_hdr"`                       // GET response headers
+		ReadAhead   ReadAheadConf   `json:"read_ahead"`                     // adaptive prefetch (sequential access)
+		ETL         ETLPolicyConf   `json:"etl"`                            // inline transformation: timeout, retries, fallback
+		Inventory   InventoryConf   `json:"inventory"`                      // periodic AIS-native inventory (see cmn/inventory.go)
+		Frozen      bool            `json:"frozen"`                         // temporarily read-only (see apc.AccessModify)
+	}
+
+	// Hedged reads: when the bucket's remote backend hasn't responded within `Delay`,
+	// race the same cold GET against an alternative source - a remote AIS cluster or
+	// a mirrored cloud bucket that contains the same objects - and use the first byte-stream
+	// to respond, cancelling the other.
+	HedgeConf struct {
+		Source  string       `json:"source"` // alternative bucket, e.g.: "ais://@remais/abc", "gs://mirror"
+		Delay   cos.Duration `json:"delay"`  // when to start the hedged request (0: both at the same time)
+		Enabled bool         `json:"enabled"`
+	}
+	HedgeConfToSet struct {
+		Source  *string       `json:"source,omitempty"`
+		Delay   *cos.Duration `json:"delay,omitempty"`
+		Enabled *bool         `json:"enabled,omitempty"`
+	}
+
+	// GET response headers - for browsers and CDNs in front of AIS:
+	// - InferType: Content-Type from the object's stored custom metadata (e.g., as
+	//   provided by S3 or GCP backend), or - if not stored - by object name extension;
+	// - CacheControl: Cache-Control value, e.g. "public, max-age=86400";
+	// - Disposition: Content-Disposition type ("inline" or "attachment"), with the
+	//   object's base name as the filename.
+	RespHdrConf struct {
+		CacheControl string `json:"cache_control"`
+		Disposition  string `json:"disposition"`
+		InferType    bool   `json:"infer_type"`
+	}
+	RespHdrConfToSet struct {
+		CacheControl *string `json:"cache_control,omitempty"`
+		Disposition  *string `json:"disposition,omitempty"`
+		InferType    *bool   `json:"infer_type,omitempty"`
+	}
+
+	// Adaptive prefetch: when a target observes sequential GETs of numbered objects
+	// within a given virtual directory (e.g., shard-0001.tar, shard-0002.tar, ...), it
+	// prefetches the next `Window` objects from the remote backend, provided a) the run
+	// of sequential GETs is at least `MinRun` long, and b) the object maps to this target.
+	ReadAheadConf struct {
+		Window  int  `json:"window"`  // number of (next) objects to prefetch
+		MinRun  int  `json:"min_run"` // number of sequential GETs to trigger read-ahead
+		Enabled bool `json:"enabled"`
+	}
+	ReadAheadConfToSet struct {
+		Window  *int  `json:"window,omitempty"`
+		MinRun  *int  `json:"min_run,omitempty"`
+		Enabled *bool `json:"enabled,omitempty"`
+	}
+
+	// Inline (GET) transformation policy - when the transformer doesn't respond
+	// (e.g., its pod has crashed or is being restarted):
+	// - Timeout: per-request timeout (0: none);
+	// - Retries: number of times to retry the transformer before giving up;
+	// - OnError: "fail" the GET (default), or serve the "original" (untransformed) object.
+	ETLPolicyConf struct {
+		OnError string       `json:"on_error"`
+		Timeout cos.Duration `json:"timeout"`
+		Retries int          `json:"retries"`
+	}
+	ETLPolicyConfToSet struct {
+		OnError *string       `json:"on_error,omitempty"`
+		Timeout *cos.Duration `json:"timeout,omitempty"`
+		Retries *int          `json:"retries,omitempty"`
+	}
+
+	// Periodic (scheduled) AIS-native bucket inventory: every `Interval` the primary
+	// proxy starts x-make-inventory, and each target keeps only the `Keep` most recent
+	// inventory parts that it stores (0: keep all).
+	InventoryConf struct {
+		Interval cos.Duration `json:"interval"`
+		Keep     int          `json:"keep"`
+		Enabled  bool         `json:"enabled"`
+	}
+	InventoryConfToSet struct {
+		Interval *cos.Duration `json:"interval,omitempty"`
+		Keep     *int          `json:"keep,omitempty"`
+		Enabled  *bool         `json:"enabled,omitempty"`
 	}
 
 	ExtraProps struct {
 		AWS  ExtraPropsAWS  `json:"aws,omitempty" list:"omitempty"`
 		HTTP ExtraPropsHTTP `json:"http,omitempty" list:"omitempty"`
 		HDFS ExtraPropsHDFS `json:"hdfs,omitempty" list:"omitempty"` // NOTE: obsolete; rm with meta-version
+		RGW  ExtraPropsRGW  `json:"rgw,omitempty" list:"omitempty"`
 	}
 	ExtraToSet struct { // ref. bpropsFilterExtra
 		AWS  *ExtraPropsAWSToSet  `json:"aws"`
@@ -89,12 +174,25 @@ type (
 		// vs OpenStack Swift: 10,000
 		// - https://docs.openstack.org/swift/latest/api/pagination.html
 		MaxPageSize int64 `json:"max_pagesize,omitempty"`
+
+		// S3-compatible provider preset, e.g. "do-spaces" or "b2" (see cmn/s3preset.go)
+		Preset string `json:"preset,omitempty"`
+
+		// server-side encryption: "sse-s3", "sse-kms", "sse-c", or empty (bucket default) - see cmn/s3sse.go
+		SSE         string `json:"sse,omitempty"`
+		SSEKMSKeyID string `json:"sse_kms_key_id,omitempty"` // KMS key ID, ARN, or alias
+		// name of the environment variable that holds base64-encoded SSE-C key (the key itself is never stored)
+		SSECustomerKeyEnv string `json:"sse_customer_key_env,omitempty"`
 	}
 	ExtraPropsAWSToSet struct {
-		CloudRegion *string `json:"cloud_region"`
-		Endpoint    *string `json:"endpoint"`
-		Profile     *string `json:"profile"`
-		MaxPageSize *int64  `json:"max_pagesize"`
+		CloudRegion       *string `json:"cloud_region"`
+		Endpoint          *string `json:"endpoint"`
+		Profile           *string `json:"profile"`
+		MaxPageSize       *int64  `json:"max_pagesize"`
+		Preset            *string `json:"preset"`
+		SSE               *string `json:"sse"`
+		SSEKMSKeyID       *string `json:"sse_kms_key_id"`
+		SSECustomerKeyEnv *string `json:"sse_customer_key_env"`
 	}
 
 	ExtraPropsHTTP struct {
@@ -105,6 +203,15 @@ type (
 		OrigURLBck *string `json:"original_url"`
 	}
 
+	// Ceph RGW bucket quota and usage, as reported by the RGW admin ops API upon HEAD(bucket);
+	// not stored in BMD; zero quota means no quota (unlimited)
+	ExtraPropsRGW struct {
+		QuotaMaxSize    int64 `json:"quota_max_size,omitempty" list:"readonly"`
+		QuotaMaxObjects int64 `json:"quota_max_objects,omitempty" list:"readonly"`
+		UsedSize        int64 `json:"used_size,omitempty" list:"readonly"`
+		UsedObjects     int64 `json:"used_objects,omitempty" list:"readonly"`
+	}
+
 	ExtraPropsHDFS struct {
 		// Reference directory.
 		RefDirectory string `json:"ref_directory,omitempty"`
@@ -127,6 +234,12 @@ type (
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
+		Hedge       *HedgeConfToSet       `json:"hedge,omitempty"`
+		RespHdr     *RespHdrConfToSet     `json:"resp_hdr,omitempty"`
+		ReadAhead   *ReadAheadConfToSet   `json:"read_ahead,omitempty"`
+		ETL         *ETLPolicyConfToSet   `json:"etl,omitempty"`
+		Inventory   *InventoryConfToSet   `json:"inventory,omitempty"`
+		Frozen      *bool                 `json:"frozen,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
@@ -177,6 +290,9 @@ func (bck *Bck) DefaultProps(c *ClusterConfig) *Bprops {
 		EC:          c.EC,
 		WritePolicy: wp,
 		Features:    c.Features,
+		ReadAhead:   ReadAheadConf{Window: DefaultReadAheadWindow, MinRun: DefaultReadAheadMinRun},
+		ETL:         ETLPolicyConf{OnError: ETLOnErrorFail},
+		Inventory:   InventoryConf{Interval: cos.Duration(DefaultInventoryInterval), Keep: DefaultInventoryKeep},
 	}
 }
 
@@ -220,13 +336,17 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.Hedge, &bp.RespHdr, &bp.ReadAhead, &bp.ETL, &bp.Inventory} {
 		var err error
 		switch {
 		case pv == &bp.EC:
 			err = bp.EC.ValidateAsProps(targetCnt)
 		case pv == &bp.Extra:
 			err = bp.Extra.ValidateAsProps(bp.Provider)
+		case pv == &bp.Hedge:
+			err = bp.Hedge.ValidateAsProps(bp)
+		case pv == &bp.ReadAhead:
+			err = bp.ReadAhead.ValidateAsProps(bp)
 		default:
 			err = pv.ValidateAsProps()
 		}
@@ -256,6 +376,141 @@ func (bp *Bprops) Apply(propsToSet *BpropsToSet) {
 	debug.AssertNoErr(err)
 }
 
+///////////////
+// HedgeConf //
+///////////////
+
+func (c *HedgeConf) ValidateAsProps(args ...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	bp, ok := args[0].(*Bprops)
+	debug.Assert(ok)
+	if bp.Provider == apc.AIS && bp.BackendBck.IsEmpty() {
+		return errors.New("hedged reads require remote bucket (or ais:// bucket with remote backend)")
+	}
+	if c.Delay < 0 {
+		return fmt.Errorf("invalid hedge.delay %v (expecting non-negative)", c.Delay)
+	}
+	if _, err := c.Bck(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parse and validate hedge.source
+func (c *HedgeConf) Bck() (bck Bck, err error) {
+	var objName string
+	bck, objName, err = ParseBckObjectURI(c.Source, ParseURIOpts{})
+	if err != nil {
+		return bck, fmt.Errorf("invalid hedge.source %q: %v", c.Source, err)
+	}
+	if objName != "" || bck.Name == "" || !bck.IsRemote() {
+		return bck, fmt.Errorf("invalid hedge.source %q: expecting remote bucket", c.Source)
+	}
+	return bck, nil
+}
+
+///////////////////
+// ReadAheadConf //
+///////////////////
+
+const (
+	DefaultReadAheadWindow = 8
+	DefaultReadAheadMinRun = 2
+	MaxReadAheadWindow     = 256
+)
+
+func (c *ReadAheadConf) ValidateAsProps(args ...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	bp, ok := args[0].(*Bprops)
+	debug.Assert(ok)
+	if bp.Provider == apc.AIS && bp.BackendBck.IsEmpty() {
+		return errors.New("read-ahead requires remote bucket (or ais:// bucket with remote backend)")
+	}
+	if c.Window < 1 || c.Window > MaxReadAheadWindow {
+		return fmt.Errorf("invalid read_ahead.window %d (expecting 1 to %d)", c.Window, MaxReadAheadWindow)
+	}
+	if c.MinRun < 1 {
+		return fmt.Errorf("invalid read_ahead.min_run %d (expecting positive)", c.MinRun)
+	}
+	return nil
+}
+
+///////////////////
+// InventoryConf //
+///////////////////
+
+const (
+	DefaultInventoryInterval = 24 * time.Hour
+	DefaultInventoryKeep     = 2
+	MinInventoryInterval     = time.Minute
+)
+
+func (c *InventoryConf) ValidateAsProps(...any) error {
+	if c.Keep < 0 {
+		return fmt.Errorf("invalid inventory.keep %d (expecting non-negative)", c.Keep)
+	}
+	if c.Enabled && c.Interval.D() < MinInventoryInterval {
+		return fmt.Errorf("invalid inventory.interval %v (expecting at least %v)", c.Interval, MinInventoryInterval)
+	}
+	return nil
+}
+
+/////////////////
+// RespHdrConf //
+/////////////////
+
+const (
+	DispositionInline     = "inline"
+	DispositionAttachment = "attachment"
+)
+
+func (c *RespHdrConf) ValidateAsProps(...any) error {
+	switch c.Disposition {
+	case "", DispositionInline, DispositionAttachment:
+	default:
+		return fmt.Errorf("invalid resp_hdr.disposition %q (expecting %q, %q, or none)",
+			c.Disposition, DispositionInline, DispositionAttachment)
+	}
+	if strings.ContainsAny(c.CacheControl, "\r\n") {
+		return fmt.Errorf("invalid resp_hdr.cache_control %q", c.CacheControl)
+	}
+	return nil
+}
+
+func (c *RespHdrConf) IsSet() bool { return c.InferType || c.CacheControl != "" || c.Disposition != "" }
+
+///////////////////
+// ETLPolicyConf //
+///////////////////
+
+const (
+	ETLOnErrorFail     = "fail"
+	ETLOnErrorOriginal = "original"
+
+	MaxETLRetries = 10
+)
+
+func (c *ETLPolicyConf) ValidateAsProps(...any) error {
+	switch c.OnError {
+	case "", ETLOnErrorFail, ETLOnErrorOriginal:
+	default:
+		return fmt.Errorf("invalid etl.on_error %q (expecting %q or %q)", c.OnError, ETLOnErrorFail, ETLOnErrorOriginal)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("invalid etl.timeout %v (expecting non-negative)", c.Timeout)
+	}
+	if c.Retries < 0 || c.Retries > MaxETLRetries {
+		return fmt.Errorf("invalid etl.retries %d (expecting 0 to %d)", c.Retries, MaxETLRetries)
+	}
+	return nil
+}
+
+func (c *ETLPolicyConf) Fallback() bool { return c.OnError == ETLOnErrorOriginal }
+
 //
 // BpropsToSet
 //
@@ -285,6 +540,12 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	if provider == apc.HT && c.HTTP.OrigURLBck == "" {
 		return errors.New("original bucket URL must be set for a bucket with HTTP provider")
 	}
+	if provider == apc.AWS {
+		if _, err := GetS3Preset(c.AWS.Preset); err != nil {
+			return err
+		}
+		return c.AWS.validateSSE()
+	}
 	return nil
 }
 
@@ -298,6 +559,12 @@ type (
 		apc.BsummResult
 	}
 	AllBsummResults []*BsummResult
+
+	BckUsage struct {
+		Bck
+		apc.BckUsage
+	}
+	AllBckUsage []*BckUsage
 )
 
 // interface guard
@@ -351,6 +618,20 @@ func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
 	}
 }
 
+// across targets
+func (s AllBckUsage) Aggregate(from *BckUsage) AllBckUsage {
+	for _, to := range s {
+		if to.Bck.Equal(&from.Bck) {
+			to.ObjCount += from.ObjCount
+			to.Size += from.Size
+			to.LastPut = max(to.LastPut, from.LastPut)
+			to.LastGet = max(to.LastGet, from.LastGet)
+			return s
+		}
+	}
+	return append(s, from)
+}
+
 //
 // Multi-object (list|range) operations source bucket => dest. bucket ---------------------------------------
 //
@@ -26,7 +26,10 @@ type (
 	}
 )
 
-var ErrTarIsEmpty = errors.New("tar is empty")
+var (
+	ErrTarIsEmpty   = errors.New("tar is empty")
+	ErrNoFastAppend = errors.New("cannot append in place") // fall back to copy + append
+)
 
 func NewErrUnknownMime(d string) *ErrUnknownMime { return &ErrUnknownMime{d} }
 func (e *ErrUnknownMime) Error() string          { return "unknown mime type \"" + e.detail + "\"" }
@@ -7,29 +7,52 @@ package archive
 
 import (
 	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/pierrec/lz4/v3"
 )
 
 // Fast Append -------------------------------------------------------
 // Standard library does not support appending to tgz, zip, and such;
-// for TAR there is an optimizing workaround not requiring a full copy.
+// for TAR, TAR.LZ4, and ZIP there are optimizing workarounds not requiring
+// a full copy. For TGZ (and whenever the workaround does not apply - see
+// ErrNoFastAppend) the caller must copy the original and append.
 //
-// Execution:
-// OpensTAR and use its reader's Next() to skip to the position
+// TAR:
+// Open TAR and use its reader's Next() to skip to the position
 // right _after_ the last file in the TAR (padding bytes including).
-//
-// Background:
 // TAR file is padded with one or more 512-byte blocks of zero bytes.
 // The blocks must be overwritten, otherwise newly added files won't be
 // accessible. Different TAR formats (such as `ustar`, `pax` and `GNU`)
 // write different number of zero blocks.
+//
+// TAR.LZ4:
+// LZ4 stream is a sequence of (independently decodable) frames; lz4Writer
+// writes the TAR trailer as a separate last frame. To append, walk the frame
+// headers (no decompression), truncate the trailer frame, and write two new
+// frames: the appended file and the trailer.
+//
+// ZIP:
+// Write the new file in place of the central directory, and then write the
+// central directory back - with one more entry - followed by the updated
+// end-of-central-directory record. ZIP64 is not supported.
 // --------------------------------------------------------------------
 
+func FastAppend(mime string) bool {
+	return mime == ExtTar || mime == ExtTarLz4 || mime == ExtZip
+}
+
 func OpenTarForAppend(cname, workFQN string) (rwfh *os.File, tarFormat tar.Format, offset int64, err error) {
 	if rwfh, err = os.OpenFile(workFQN, os.O_RDWR, cos.PermRWR); err != nil {
 		return
@@ -86,3 +109,385 @@ func _seekTarEnd(cname string, fh *os.File) (tarFormat tar.Format, offset int64,
 
 	return tarFormat, offset, err
 }
+
+//
+// TAR.LZ4
+//
+
+const (
+	lz4FrameMagic     = 0x184d2204
+	lz4SkippableMagic = 0x184d2a50 // 0x184d2a5X
+	lz4MaxTrailer     = 20 * TarBlockSize
+)
+
+type lz4scan struct {
+	br  *bufio.Reader
+	pos int64
+	buf [4]byte
+}
+
+// returns file positioned at (and the offset of) the last LZ4 frame that contains TAR trailer
+func OpenTarLz4ForAppend(cname, workFQN string) (rwfh *os.File, offset int64, err error) {
+	if rwfh, err = os.OpenFile(workFQN, os.O_RDWR, cos.PermRWR); err != nil {
+		return
+	}
+	if offset, err = _seekLz4Trailer(cname, rwfh); err != nil {
+		rwfh.Close()
+	}
+	return
+}
+
+func _seekLz4Trailer(cname string, fh *os.File) (int64, error) {
+	finfo, err := fh.Stat()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		scan = &lz4scan{br: bufio.NewReader(fh)}
+		last = int64(-1)
+	)
+	for scan.pos < finfo.Size() {
+		start := scan.pos
+		skipped, err := scan.frame()
+		if err != nil {
+			return 0, fmt.Errorf("%s: invalid LZ4 frame at offset %d: %w", cname, start, err)
+		}
+		if !skipped {
+			last = start
+		}
+	}
+	if last < 0 {
+		return 0, fmt.Errorf("%s: no LZ4 frames", cname)
+	}
+
+	// the last frame must contain nothing but TAR trailer (see lz4Writer.Fini)
+	if _, err := fh.Seek(last, io.SeekStart); err != nil {
+		return 0, err
+	}
+	b, err := io.ReadAll(io.LimitReader(lz4.NewReader(fh), lz4MaxTrailer+1))
+	if err != nil {
+		return 0, err
+	}
+	if len(b) < 2*TarBlockSize || len(b) > lz4MaxTrailer || len(b)%TarBlockSize != 0 {
+		return 0, ErrNoFastAppend
+	}
+	for _, c := range b {
+		if c != 0 {
+			return 0, ErrNoFastAppend
+		}
+	}
+	return fh.Seek(last, io.SeekStart)
+}
+
+// skip one frame; return true for skippable frames
+func (scan *lz4scan) frame() (bool, error) {
+	magic, err := scan.u32()
+	if err != nil {
+		return false, err
+	}
+	if magic&0xfffffff0 == lz4SkippableMagic {
+		size, err := scan.u32()
+		if err != nil {
+			return false, err
+		}
+		return true, scan.skip(int64(size))
+	}
+	if magic != lz4FrameMagic {
+		return false, fmt.Errorf("unexpected magic %#x", magic)
+	}
+	if _, err := io.ReadFull(scan.br, scan.buf[:2]); err != nil { // FLG, BD
+		return false, err
+	}
+	scan.pos += 2
+	var (
+		flg       = scan.buf[0]
+		hdrExtra  = int64(1) // header checksum
+		blkCksum  = flg&(1<<4) != 0
+		contCksum = flg&(1<<2) != 0
+	)
+	if flg&(1<<3) != 0 {
+		hdrExtra += 8 // content size
+	}
+	if flg&1 != 0 {
+		hdrExtra += 4 // dictionary ID
+	}
+	if err := scan.skip(hdrExtra); err != nil {
+		return false, err
+	}
+	for {
+		bsize, err := scan.u32()
+		if err != nil {
+			return false, err
+		}
+		if bsize == 0 { // end mark
+			break
+		}
+		size := int64(bsize & 0x7fffffff) // highest bit: uncompressed
+		if blkCksum {
+			size += 4
+		}
+		if err := scan.skip(size); err != nil {
+			return false, err
+		}
+	}
+	if contCksum {
+		return false, scan.skip(4)
+	}
+	return false, nil
+}
+
+func (scan *lz4scan) u32() (uint32, error) {
+	if _, err := io.ReadFull(scan.br, scan.buf[:]); err != nil {
+		return 0, err
+	}
+	scan.pos += 4
+	return binary.LittleEndian.Uint32(scan.buf[:]), nil
+}
+
+func (scan *lz4scan) skip(n int64) error {
+	m, err := scan.br.Discard(int(n))
+	scan.pos += int64(m)
+	return err
+}
+
+// write two frames at the current position (see OpenTarLz4ForAppend):
+// the appended file and TAR trailer; return the resulting size
+// upon failure, restore the original trailer frame (and size)
+func AppendTarLz4(rwfh *os.File, nameInArch string, oah cos.OAH, reader io.Reader) (size int64, err error) {
+	offset, err := rwfh.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	finfo, err := rwfh.Stat()
+	if err != nil {
+		return 0, err
+	}
+	trailer := make([]byte, finfo.Size()-offset)
+	if _, err := rwfh.ReadAt(trailer, offset); err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			err = restoreTail(rwfh, offset, trailer, err)
+		}
+	}()
+
+	var (
+		buf, slab = memsys.PageMM().Alloc()
+		lzw       = newLz4Writer(rwfh)
+		tw        = tar.NewWriter(lzw)
+		hdr       = tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     nameInArch,
+			Size:     oah.Lsize(),
+			ModTime:  time.Unix(0, oah.AtimeUnix()),
+			Mode:     int64(cos.PermRWRR),
+		}
+	)
+	if err = tw.WriteHeader(&hdr); err == nil {
+		if _, err = io.CopyBuffer(tw, reader, buf); err == nil {
+			err = finiTarLz4(tw, lzw, rwfh)
+		}
+	}
+	slab.Free(buf)
+	if err != nil {
+		return 0, err
+	}
+	if size, err = rwfh.Seek(0, io.SeekCurrent); err == nil {
+		err = rwfh.Truncate(size)
+	}
+	return size, err
+}
+
+//
+// ZIP
+//
+
+const (
+	zipEndSig      = 0x06054b50
+	zip64LocSig    = 0x07064b50
+	zipEndLen      = 22
+	zip64LocLen    = 20
+	zipMaxEndScan  = zipEndLen + math.MaxUint16 // end-of-central-directory + comment
+	zipMaxEntries  = math.MaxUint16 - 1
+	zipMaxOffset   = math.MaxUint32 - 1
+	zipHeadroomLen = 64 * cos.KiB // local header, data descriptor, and new central directory entry
+)
+
+type (
+	// end of central directory record (subset)
+	zipEnd struct {
+		comment []byte
+		offset  int64 // of the central directory
+		size    int64 // ditto
+		pos     int64 // of the record itself
+		count   int
+	}
+	// existing central directory to be written back after the appended file
+	ZipCdir struct {
+		raw []byte // central directory
+		rec []byte // end record (including comment)
+		end zipEnd
+	}
+)
+
+// returns file positioned at the start of the central directory
+func OpenZipForAppend(cname, workFQN string) (rwfh *os.File, zcd *ZipCdir, err error) {
+	if rwfh, err = os.OpenFile(workFQN, os.O_RDWR, cos.PermRWR); err != nil {
+		return
+	}
+	if zcd, err = _readZipCdir(cname, rwfh); err != nil {
+		rwfh.Close()
+	}
+	return
+}
+
+func _readZipCdir(cname string, fh *os.File) (*ZipCdir, error) {
+	finfo, err := fh.Stat()
+	if err != nil {
+		return nil, err
+	}
+	end, err := readZipEnd(fh, finfo.Size())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", cname, err)
+	}
+	// no ZIP64, no data prior to (or gaps after) the central directory
+	if end.count >= zipMaxEntries || end.offset >= zipMaxOffset || end.size >= zipMaxOffset ||
+		end.offset+end.size != end.pos {
+		return nil, ErrNoFastAppend
+	}
+	if end.pos >= zip64LocLen {
+		var sig [4]byte
+		if _, err := fh.ReadAt(sig[:], end.pos-zip64LocLen); err != nil {
+			return nil, err
+		}
+		if binary.LittleEndian.Uint32(sig[:]) == zip64LocSig {
+			return nil, ErrNoFastAppend
+		}
+	}
+	zcd := &ZipCdir{raw: make([]byte, end.size), rec: make([]byte, finfo.Size()-end.pos), end: *end}
+	if _, err := fh.ReadAt(zcd.raw, end.offset); err != nil {
+		return nil, err
+	}
+	if _, err := fh.ReadAt(zcd.rec, end.pos); err != nil {
+		return nil, err
+	}
+	if _, err := fh.Seek(end.offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return zcd, nil
+}
+
+func readZipEnd(fh *os.File, size int64) (*zipEnd, error) {
+	if size < zipEndLen {
+		return nil, fmt.Errorf(fmtErrTooShort, ExtZip, zipEndLen)
+	}
+	n := min(size, zipMaxEndScan)
+	b := make([]byte, n)
+	if _, err := fh.ReadAt(b, size-n); err != nil {
+		return nil, err
+	}
+	for i := len(b) - zipEndLen; i >= 0; i-- {
+		rec := b[i:]
+		if binary.LittleEndian.Uint32(rec) != zipEndSig {
+			continue
+		}
+		clen := int(binary.LittleEndian.Uint16(rec[20:]))
+		if zipEndLen+clen != len(rec) {
+			continue
+		}
+		if binary.LittleEndian.Uint16(rec[4:]) != 0 || binary.LittleEndian.Uint16(rec[6:]) != 0 {
+			return nil, errors.New("multi-disk zip is not supported")
+		}
+		return &zipEnd{
+			comment: rec[zipEndLen:],
+			count:   int(binary.LittleEndian.Uint16(rec[10:])),
+			size:    int64(binary.LittleEndian.Uint32(rec[12:])),
+			offset:  int64(binary.LittleEndian.Uint32(rec[16:])),
+			pos:     size - int64(len(rec)),
+		}, nil
+	}
+	return nil, errors.New("zip: end of central directory not found")
+}
+
+// write the file at the current position (see OpenZipForAppend) followed by
+// the updated central directory; return the resulting size
+// upon failure, restore the original central directory and end record (and size)
+func (zcd *ZipCdir) Append(rwfh *os.File, nameInArch string, oah cos.OAH, reader io.Reader) (size int64, err error) {
+	if zcd.end.count+1 >= zipMaxEntries ||
+		zcd.end.offset+oah.Lsize()+zcd.end.size+zipHeadroomLen >= zipMaxOffset {
+		return 0, ErrNoFastAppend // would require ZIP64
+	}
+	defer func() {
+		if err != nil {
+			tail := make([]byte, 0, len(zcd.raw)+len(zcd.rec))
+			tail = append(tail, zcd.raw...)
+			err = restoreTail(rwfh, zcd.end.offset, append(tail, zcd.rec...), err)
+		}
+	}()
+	var (
+		buf, slab = memsys.PageMM().Alloc()
+		zw        = zip.NewWriter(rwfh)
+	)
+	zw.SetOffset(zcd.end.offset)
+	zipw, err := zw.CreateHeader(newZipHeader(nameInArch, oah))
+	if err == nil {
+		_, err = io.CopyBuffer(zipw, reader, buf)
+	}
+	slab.Free(buf)
+	if err != nil {
+		return 0, err
+	}
+	// writes central directory that only has the new entry
+	if err := zw.Close(); err != nil {
+		return 0, err
+	}
+	if size, err = rwfh.Seek(0, io.SeekCurrent); err != nil {
+		return 0, err
+	}
+	nend, err := readZipEnd(rwfh, size)
+	if err != nil {
+		return 0, err
+	}
+	debug.Assert(nend.count == 1, nend.count)
+	ncd := make([]byte, nend.size)
+	if _, err := rwfh.ReadAt(ncd, nend.offset); err != nil {
+		return 0, err
+	}
+
+	// prepend the original central directory and rewrite the end record
+	var (
+		cdir = make([]byte, 0, len(zcd.raw)+len(ncd))
+		rec  = make([]byte, zipEndLen, zipEndLen+len(zcd.end.comment))
+	)
+	cdir = append(cdir, zcd.raw...)
+	cdir = append(cdir, ncd...)
+	binary.LittleEndian.PutUint32(rec, zipEndSig)
+	binary.LittleEndian.PutUint16(rec[8:], uint16(zcd.end.count+1))
+	binary.LittleEndian.PutUint16(rec[10:], uint16(zcd.end.count+1))
+	binary.LittleEndian.PutUint32(rec[12:], uint32(len(cdir)))
+	binary.LittleEndian.PutUint32(rec[16:], uint32(nend.offset))
+	binary.LittleEndian.PutUint16(rec[20:], uint16(len(zcd.end.comment)))
+	rec = append(rec, zcd.end.comment...)
+
+	if _, err := rwfh.WriteAt(cdir, nend.offset); err != nil {
+		return 0, err
+	}
+	if _, err := rwfh.WriteAt(rec, nend.offset+int64(len(cdir))); err != nil {
+		return 0, err
+	}
+	size = nend.offset + int64(len(cdir)) + int64(len(rec))
+	err = rwfh.Truncate(size)
+	return size, err
+}
+
+// write back the original tail of the archive (that fast append has overwritten)
+func restoreTail(rwfh *os.File, offset int64, tail []byte, err error) error {
+	if _, errV := rwfh.WriteAt(tail, offset); errV != nil {
+		return fmt.Errorf("%w (failed to restore archive: %v)", err, errV)
+	}
+	if errV := rwfh.Truncate(offset + int64(len(tail))); errV != nil {
+		return fmt.Errorf("%w (failed to restore archive: %v)", err, errV)
+	}
+	return err
+}
@@ -111,6 +111,15 @@ func byExt(filename string) (string, error) {
 	return "", NewErrUnknownFileExt(filename, "")
 }
 
+// multi-shard naming, e.g.: ("a/b.tar.gz", 7) => "a/b-007.tar.gz"
+func ShardName(archname string, idx int) string {
+	base, ext := archname, ""
+	if e, err := byExt(archname); err == nil {
+		base, ext = archname[:len(archname)-len(e)], e
+	}
+	return fmt.Sprintf("%s-%03d%s", base, idx, ext)
+}
+
 // NOTE convention: caller may pass nil `smm` _not_ to spend time (usage: listing and reading)
 func MimeFile(file cos.LomReader, smm *memsys.MMSA, mime, archname string) (m string, err error) {
 	m, err = Mime(mime, archname)
@@ -211,16 +211,20 @@ func (zw *zipWriter) Fini() {
 	zw.zw.Close()
 }
 
-func (zw *zipWriter) Write(fullname string, oah cos.OAH, reader io.Reader) error {
-	ziphdr := zip.FileHeader{
+func newZipHeader(fullname string, oah cos.OAH) *zip.FileHeader {
+	return &zip.FileHeader{
 		Name:               fullname,
 		Comment:            fullname,
 		UncompressedSize64: uint64(oah.Lsize()),
 		Modified:           time.Unix(0, oah.AtimeUnix()),
 	}
-	zw.cb(&ziphdr)
+}
+
+func (zw *zipWriter) Write(fullname string, oah cos.OAH, reader io.Reader) error {
+	ziphdr := newZipHeader(fullname, oah)
+	zw.cb(ziphdr)
 	zw.lck.Lock()
-	zipw, err := zw.zw.CreateHeader(&ziphdr)
+	zipw, err := zw.zw.CreateHeader(ziphdr)
 	if err == nil {
 		_, err = io.CopyBuffer(zipw, reader, zw.buf)
 	}
@@ -238,21 +242,40 @@ func (zw *zipWriter) Copy(src io.Reader, size ...int64) error {
 
 func (lzw *lz4Writer) init(w io.Writer, cksum *cos.CksumHashSize, opts *Opts) {
 	lzw.tw.baseW.init(w, cksum, opts)
-	lzw.lzw = lz4.NewWriter(lzw.tw.wmul)
+	lzw.lzw = newLz4Writer(lzw.tw.wmul)
+	lzw.tw.tw = tar.NewWriter(lzw.lzw)
+}
 
-	lzw.lzw.Header.BlockChecksum = false
-	lzw.lzw.Header.NoChecksum = !cmn.Rom.Features().IsSet(feat.LZ4FrameChecksum)
-	lzw.lzw.Header.BlockMaxSize = 256 * cos.KiB
+func newLz4Writer(w io.Writer) *lz4.Writer {
+	lzw := lz4.NewWriter(w)
+	lzw.Header.BlockChecksum = false
+	lzw.Header.NoChecksum = !cmn.Rom.Features().IsSet(feat.LZ4FrameChecksum)
+	lzw.Header.BlockMaxSize = 256 * cos.KiB
 	if cmn.Rom.Features().IsSet(feat.LZ4Block1MB) {
-		lzw.lzw.Header.BlockMaxSize = cos.MiB
+		lzw.Header.BlockMaxSize = cos.MiB
 	}
-
-	lzw.tw.tw = tar.NewWriter(lzw.lzw)
+	return lzw
 }
 
 func (lzw *lz4Writer) Fini() {
-	lzw.tw.Fini()
-	lzw.lzw.Close()
+	lzw.tw.slab.Free(lzw.tw.buf)
+	finiTarLz4(lzw.tw.tw, lzw.lzw, lzw.tw.wmul)
+}
+
+// write TAR trailer (end-of-archive marker) as a separate LZ4 frame
+// to subsequently allow appending in place (see OpenTarLz4ForAppend)
+func finiTarLz4(tw *tar.Writer, lzw *lz4.Writer, w io.Writer) error {
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if err := lzw.Close(); err != nil {
+		return err
+	}
+	lzw.Reset(w) // next frame
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return lzw.Close()
 }
 
 func (lzw *lz4Writer) Write(fullname string, oah cos.OAH, reader io.Reader) error {
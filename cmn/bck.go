@@ -585,19 +585,21 @@ func (bcks Bcks) Select(query QueryBcks) (filtered Bcks) {
 	return filtered
 }
 
+func (bcks Bcks) Contains(bck *Bck) bool {
+	for i := range bcks {
+		if bcks[i].Equal(bck) {
+			return true
+		}
+	}
+	return false
+}
+
 func (bcks Bcks) Equal(other Bcks) bool {
 	if len(bcks) != len(other) {
 		return false
 	}
 	for i := range bcks {
-		var found bool
-		for j := range other {
-			if bcks[i].Equal(&other[j]) {
-				found = true
-				break
-			}
-		}
-		if !found {
+		if !other.Contains(&bcks[i]) {
 			return false
 		}
 	}
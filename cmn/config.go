@@ -57,6 +57,14 @@ type (
 		LogDir    string         `json:"log_dir"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
 		HostNet   LocalNetConfig `json:"host_net"`
+		FDomain   FDomainConf    `json:"failure_domain"`
+	}
+
+	// ais node: (optional) failure-domain labels; targets that share the same
+	// zone and rack are assumed to fail together (used by EC slice placement)
+	FDomainConf struct {
+		Zone string `json:"zone,omitempty"`
+		Rack string `json:"rack,omitempty"`
 	}
 
 	// ais node: (local) network config
@@ -118,6 +126,7 @@ type (
 		Periodic    PeriodConf      `json:"periodic"`
 		Mirror      MirrorConf      `json:"mirror" allow:"cluster"`
 		Downloader  DownloaderConf  `json:"downloader"`
+		Secrets     SecretsConf     `json:"secrets"` // backend credentials via external secrets provider
 
 		// standalone enumerated features that can be configured
 		// to flip assorted global defaults (see cmn/feat/feat.go)
@@ -155,6 +164,7 @@ type (
 		TCB         *TCBConfToSet         `json:"tcb,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Proxy       *ProxyConfToSet       `json:"proxy,omitempty"`
+		Secrets     *SecretsConfToSet     `json:"secrets,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 
 		// LocalConfig
@@ -166,6 +176,19 @@ type (
 		Providers map[string]Ns  `json:"-"` // conditional (build tag) providers set during validation (BackendConf.Validate)
 	}
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
+	BackendConfHT  map[string][]string // HTTP(S) origin (base URL) -> [mirror base URLs...], in order of preference
+	// external backend plugins: plugin name (bucket namespace) -> plugin (sidecar) endpoint
+	BackendConfExt map[string]ExtPluginConf
+	ExtPluginConf  struct {
+		URL     string       `json:"url"`               // e.g. "http://localhost:9100"
+		Timeout cos.Duration `json:"timeout,omitempty"` // per request (except GET and PUT data transfers); default: client.client_timeout
+	}
+	BackendConfRGW struct {
+		Endpoint  string `json:"endpoint"`             // RGW base URL for both S3 data path and admin ops, e.g. "http://rgw:7480"
+		Profile   string `json:"profile,omitempty"`    // credentials profile (~/.aws/credentials); quota and usage require "buckets=read" caps
+		Region    string `json:"region,omitempty"`     // zonegroup's API name (default: "us-east-1")
+		AdminPath string `json:"admin_path,omitempty"` // admin ops API entry point (default: "/admin")
+	}
 
 	MirrorConf struct {
 		Copies  int64 `json:"copies"`       // num copies
@@ -206,19 +229,33 @@ type (
 		// storage nodes (a.k.a. targets).
 		ParitySlices int `json:"parity_slices"`
 
+		// When to erasure-code (or replicate) a newly written object - one of apc.SupportedECEncode:
+		// - async (default): in the background, right after PUT;
+		// - inline: PUT returns after the object is encoded and its slices (or replicas) are dispatched;
+		// - lazy: in the background, LazyDelay after PUT
+		EncodeMode string       `json:"encode_mode"`
+		LazyDelay  cos.Duration `json:"lazy_delay"`
+
 		SbundleMult int `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
 
 		Enabled  bool `json:"enabled"`   // EC is enabled
 		DiskOnly bool `json:"disk_only"` // if true, EC does not use SGL - data goes directly to drives
+
+		// spread slices across failure domains (targets labeled with zone and/or rack - see FDomainConf);
+		// false (default): plain HRW placement, regardless of labels
+		SpreadFD bool `json:"spread_failure_domains"`
 	}
 	ECConfToSet struct {
-		ObjSizeLimit *int64  `json:"objsize_limit,omitempty"`
-		Compression  *string `json:"compression,omitempty"`
-		SbundleMult  *int    `json:"bundle_multiplier,omitempty"`
-		DataSlices   *int    `json:"data_slices,omitempty"`
-		ParitySlices *int    `json:"parity_slices,omitempty"`
-		Enabled      *bool   `json:"enabled,omitempty"`
-		DiskOnly     *bool   `json:"disk_only,omitempty"`
+		ObjSizeLimit *int64        `json:"objsize_limit,omitempty"`
+		Compression  *string       `json:"compression,omitempty"`
+		SbundleMult  *int          `json:"bundle_multiplier,omitempty"`
+		DataSlices   *int          `json:"data_slices,omitempty"`
+		ParitySlices *int          `json:"parity_slices,omitempty"`
+		EncodeMode   *string       `json:"encode_mode,omitempty"`
+		LazyDelay    *cos.Duration `json:"lazy_delay,omitempty"`
+		Enabled      *bool         `json:"enabled,omitempty"`
+		DiskOnly     *bool         `json:"disk_only,omitempty"`
+		SpreadFD     *bool         `json:"spread_failure_domains,omitempty"`
 	}
 
 	LogConf struct {
@@ -228,6 +265,7 @@ type (
 		FlushTime cos.Duration `json:"flush_time"` // log flush interval
 		StatsTime cos.Duration `json:"stats_time"` // (not used)
 		ToStderr  bool         `json:"to_stderr"`  // Log only to stderr instead of files.
+		Format    string       `json:"format"`     // LogFormatText (default) or LogFormatJSON
 	}
 	LogConfToSet struct {
 		Level     *cos.LogLevel `json:"level,omitempty"`
@@ -236,6 +274,7 @@ type (
 		MaxTotal  *cos.SizeIEC  `json:"max_total,omitempty"`
 		FlushTime *cos.Duration `json:"flush_time,omitempty"`
 		StatsTime *cos.Duration `json:"stats_time,omitempty"`
+		Format    *string       `json:"format,omitempty"`
 	}
 
 	// TracingConf defines the configuration used for the OpenTelemetry (OTEL) trace exporter.
@@ -268,6 +307,62 @@ type (
 		SkipVerify            *bool                       `json:"skip_verify,omitempty"` // allow insecure exporter gRPC connection
 	}
 
+	// backend credentials resolved via external secrets provider (see cmn/secrets):
+	// each secret is a set of environment variables (e.g., AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY)
+	// exported upon backend initialization and (re)exported upon rotation
+	SecretsConf struct {
+		Provider string `json:"provider"` // enum { "", SecretsVault, SecretsASM, SecretsK8s }; "" - none (environment and files)
+
+		// secret IDs - Vault path, AWS Secrets Manager secret name (or ARN), or K8s secret name;
+		// empty - not managed
+		AWS   string `json:"aws"`
+		GCP   string `json:"gcp"`
+		Azure string `json:"azure"`
+		OCI   string `json:"oci"`
+
+		Vault SecretsVaultConf `json:"vault"`
+		ASM   SecretsASMConf   `json:"asm"`
+		K8s   SecretsK8sConf   `json:"k8s"`
+
+		// re-resolve all secrets at this interval to pick up rotated credentials; 0 - only at backend init
+		Refresh cos.Duration `json:"refresh_time"`
+	}
+	SecretsVaultConf struct {
+		Addr      string `json:"addr"`       // e.g. "https://vault.example.com:8200"
+		Mount     string `json:"mount"`      // KV version 2 secrets engine (default "secret")
+		Namespace string `json:"namespace"`  // (Vault Enterprise)
+		TokenFile string `json:"token_file"` // file that contains Vault token; $VAULT_TOKEN otherwise
+	}
+	SecretsASMConf struct {
+		Region string `json:"region"` // default: $AWS_REGION
+	}
+	SecretsK8sConf struct {
+		Dir string `json:"dir"` // mounted secret volumes, one subdirectory per secret (default "/var/run/secrets/ais")
+	}
+	SecretsConfToSet struct {
+		Provider *string                `json:"provider,omitempty"`
+		AWS      *string                `json:"aws,omitempty"`
+		GCP      *string                `json:"gcp,omitempty"`
+		Azure    *string                `json:"azure,omitempty"`
+		OCI      *string                `json:"oci,omitempty"`
+		Vault    *SecretsVaultConfToSet `json:"vault,omitempty"`
+		ASM      *SecretsASMConfToSet   `json:"asm,omitempty"`
+		K8s      *SecretsK8sConfToSet   `json:"k8s,omitempty"`
+		Refresh  *cos.Duration          `json:"refresh_time,omitempty"`
+	}
+	SecretsVaultConfToSet struct {
+		Addr      *string `json:"addr,omitempty"`
+		Mount     *string `json:"mount,omitempty"`
+		Namespace *string `json:"namespace,omitempty"`
+		TokenFile *string `json:"token_file,omitempty"`
+	}
+	SecretsASMConfToSet struct {
+		Region *string `json:"region,omitempty"`
+	}
+	SecretsK8sConfToSet struct {
+		Dir *string `json:"dir,omitempty"`
+	}
+
 	TraceExporterAuthConf struct {
 		TokenHeader string `json:"token_header"` // header used to pass exporter auth token
 		TokenFile   string `json:"token_file"`   // filepath from where auth token can be obtained
@@ -283,11 +378,20 @@ type (
 		StatsTime     cos.Duration `json:"stats_time"`      // collect and publish stats; other house-keeping
 		RetrySyncTime cos.Duration `json:"retry_sync_time"` // metasync retry
 		NotifTime     cos.Duration `json:"notif_time"`      // (IC notifications)
+		// error-rate alert (cos.HighErrorRate): percentage of failed requests during the last stats_time interval
+		// (default: ErrRateHighDflt), provided the number of errors is at least err_rate_min_errs (ErrRateMinErrsDflt);
+		// the alert is cleared when the rate remains normal for err_rate_clear_time (ErrRateClearTimeDflt)
+		ErrRateHigh      int          `json:"err_rate_high,omitempty"`
+		ErrRateMinErrs   int64        `json:"err_rate_min_errs,omitempty"`
+		ErrRateClearTime cos.Duration `json:"err_rate_clear_time,omitempty"`
 	}
 	PeriodConfToSet struct {
-		StatsTime     *cos.Duration `json:"stats_time,omitempty"`
-		RetrySyncTime *cos.Duration `json:"retry_sync_time,omitempty"`
-		NotifTime     *cos.Duration `json:"notif_time,omitempty"`
+		StatsTime        *cos.Duration `json:"stats_time,omitempty"`
+		RetrySyncTime    *cos.Duration `json:"retry_sync_time,omitempty"`
+		NotifTime        *cos.Duration `json:"notif_time,omitempty"`
+		ErrRateHigh      *int          `json:"err_rate_high,omitempty"`
+		ErrRateMinErrs   *int64        `json:"err_rate_min_errs,omitempty"`
+		ErrRateClearTime *cos.Duration `json:"err_rate_clear_time,omitempty"`
 	}
 
 	// maximum intra-cluster latencies (in the increasing order)
@@ -302,6 +406,11 @@ type (
 		EcStreams cos.Duration `json:"ec_streams_time,omitempty"`
 		// object metadata timeout; for training apps an approx. duration of 2 (two) epochs
 		ObjectMD cos.Duration `json:"object_md"`
+		// maximum durations of the respective client requests, whereby exceeding any of them
+		// results in cmn.ErrTimeout (status 504); zero means no limit (default)
+		ListObjects cos.Duration `json:"list_objects,omitempty"` // one page
+		HeadObject  cos.Duration `json:"head_object,omitempty"`  // HEAD(remote object)
+		ColdGet     cos.Duration `json:"cold_get,omitempty"`     // time for remote backend to respond (time to first byte)
 	}
 	TimeoutConfToSet struct {
 		CplaneOperation *cos.Duration `json:"cplane_operation,omitempty"`
@@ -312,6 +421,9 @@ type (
 		SendFile        *cos.Duration `json:"send_file_time,omitempty"`
 		EcStreams       *cos.Duration `json:"ec_streams_time,omitempty"`
 		ObjectMD        *cos.Duration `json:"object_md"`
+		ListObjects     *cos.Duration `json:"list_objects,omitempty"`
+		HeadObject      *cos.Duration `json:"head_object,omitempty"`
+		ColdGet         *cos.Duration `json:"cold_get,omitempty"`
 	}
 
 	ClientConf struct {
@@ -330,11 +442,35 @@ type (
 		OriginalURL  string `json:"original_url"`
 		DiscoveryURL string `json:"discovery_url"`
 		NonElectable bool   `json:"non_electable"` // NOTE: deprecated, not used
+		// API request admission control (zero values: unlimited - no admission control)
+		Admission AdmissionConf `json:"admission"`
 	}
 	ProxyConfToSet struct {
-		PrimaryURL   *string `json:"primary_url,omitempty"`
-		OriginalURL  *string `json:"original_url,omitempty"`
-		DiscoveryURL *string `json:"discovery_url,omitempty"`
+		PrimaryURL   *string             `json:"primary_url,omitempty"`
+		OriginalURL  *string             `json:"original_url,omitempty"`
+		DiscoveryURL *string             `json:"discovery_url,omitempty"`
+		Admission    *AdmissionConfToSet `json:"admission,omitempty"`
+	}
+
+	// proxy: bounded per-class admission queues; requests that cannot be admitted
+	// within the queue timeout (or when the queue is full) are shed with 503 and Retry-After
+	AdmissionConf struct {
+		List    AdmissionClassConf `json:"list"`    // list-objects and other bucket GET requests
+		Objects AdmissionClassConf `json:"objects"` // object requests (GET, PUT, HEAD, etc.)
+	}
+	AdmissionConfToSet struct {
+		List    *AdmissionClassConfToSet `json:"list,omitempty"`
+		Objects *AdmissionClassConfToSet `json:"objects,omitempty"`
+	}
+	AdmissionClassConf struct {
+		MaxInflight  int          `json:"max_inflight"`  // max requests executing concurrently (0: unlimited)
+		MaxQueued    int          `json:"max_queued"`    // max requests waiting to be admitted (0: shed immediately)
+		QueueTimeout cos.Duration `json:"queue_timeout"` // max time to wait in the queue
+	}
+	AdmissionClassConfToSet struct {
+		MaxInflight  *int          `json:"max_inflight,omitempty"`
+		MaxQueued    *int          `json:"max_queued,omitempty"`
+		QueueTimeout *cos.Duration `json:"queue_timeout,omitempty"`
 	}
 
 	SpaceConf struct {
@@ -385,13 +521,20 @@ type (
 		DiskUtilMaxWM   int64        `json:"disk_util_max_wm"`
 		IostatTimeLong  cos.Duration `json:"iostat_time_long"`
 		IostatTimeShort cos.Duration `json:"iostat_time_short"`
+		// number of goroutines to traverse a given bucket (on a given mountpath) in parallel,
+		// one top-level directory subtree at a time; 0 or 1: single-threaded walk (default)
+		WalkParallel int `json:"walk_parallel,omitempty"`
+		// per mountpath label overrides, e.g. "nvme=16,hdd=1" (see cos.MountpathLabel)
+		WalkParallelLabels string `json:"walk_parallel_labels,omitempty"`
 	}
 	DiskConfToSet struct {
-		DiskUtilLowWM   *int64        `json:"disk_util_low_wm,omitempty"`
-		DiskUtilHighWM  *int64        `json:"disk_util_high_wm,omitempty"`
-		DiskUtilMaxWM   *int64        `json:"disk_util_max_wm,omitempty"`
-		IostatTimeLong  *cos.Duration `json:"iostat_time_long,omitempty"`
-		IostatTimeShort *cos.Duration `json:"iostat_time_short,omitempty"`
+		DiskUtilLowWM      *int64        `json:"disk_util_low_wm,omitempty"`
+		DiskUtilHighWM     *int64        `json:"disk_util_high_wm,omitempty"`
+		DiskUtilMaxWM      *int64        `json:"disk_util_max_wm,omitempty"`
+		IostatTimeLong     *cos.Duration `json:"iostat_time_long,omitempty"`
+		IostatTimeShort    *cos.Duration `json:"iostat_time_short,omitempty"`
+		WalkParallel       *int          `json:"walk_parallel,omitempty"`
+		WalkParallelLabels *string       `json:"walk_parallel_labels,omitempty"`
 	}
 
 	RebalanceConf struct {
@@ -537,6 +680,15 @@ type (
 		// the total number by the end of the interval must not exceed `IOErrs` (above)
 		IOErrTime cos.Duration `json:"io_err_time,omitempty"`
 
+		// size of each temporary file written when testing a mountpath (default: 1MiB)
+		TestFileSize cos.SizeIEC `json:"test_file_size,omitempty"`
+
+		// probation: periodically (every `ProbeInterval`) re-test mountpaths disabled by FSHC
+		// and re-enable those that pass `ProbeCount` consecutive clean probes;
+		// ProbeCount: 0 - default (ProbeCountDflt); (-1) - opt-out (never re-enable automatically)
+		ProbeCount    int          `json:"probe_count,omitempty"`
+		ProbeInterval cos.Duration `json:"probe_interval,omitempty"`
+
 		// whether FSHC is enabled (note: disabling FSHC is _not_ recommended)
 		Enabled bool `json:"enabled"`
 	}
@@ -545,6 +697,9 @@ type (
 		HardErrs      *int          `json:"error_limit,omitempty"`
 		IOErrs        *int          `json:"io_err_limit,omitempty"`
 		IOErrTime     *cos.Duration `json:"io_err_time,omitempty"`
+		TestFileSize  *cos.SizeIEC  `json:"test_file_size,omitempty"`
+		ProbeCount    *int          `json:"probe_count,omitempty"`
+		ProbeInterval *cos.Duration `json:"probe_interval,omitempty"`
 		Enabled       *bool         `json:"enabled,omitempty"`
 	}
 	// [backward compatibility] TODO: remove (ref v324)
@@ -565,12 +720,19 @@ type (
 		Target      KeepaliveTrackerConf `json:"target"`      // how target tracks primary proxies keepalives
 		NumRetries  int                  `json:"num_retries"` // default: `kaNumRetries`
 		RetryFactor uint8                `json:"retry_factor"`
+		// primary election triggers (non-primary nodes, upon failing to keepalive the primary):
+		// - ElectionGrace: minimum time since the last successful keepalive (zero - no grace)
+		// - PreVote: ask all other nodes (health probe) and proceed only if most of them confirm primary down
+		ElectionGrace cos.Duration `json:"election_grace,omitempty"`
+		PreVote       bool         `json:"pre_vote,omitempty"`
 	}
 	KeepaliveConfToSet struct {
-		Proxy       *KeepaliveTrackerConfToSet `json:"proxy,omitempty"`
-		Target      *KeepaliveTrackerConfToSet `json:"target,omitempty"`
-		NumRetries  *int                       `json:"num_retries,omitempty"`
-		RetryFactor *uint8                     `json:"retry_factor,omitempty"`
+		Proxy         *KeepaliveTrackerConfToSet `json:"proxy,omitempty"`
+		Target        *KeepaliveTrackerConfToSet `json:"target,omitempty"`
+		NumRetries    *int                       `json:"num_retries,omitempty"`
+		RetryFactor   *uint8                     `json:"retry_factor,omitempty"`
+		ElectionGrace *cos.Duration              `json:"election_grace,omitempty"`
+		PreVote       *bool                      `json:"pre_vote,omitempty"`
 	}
 	KeepaliveTrackerConf struct {
 		Name     string       `json:"name"`     // "heartbeat"
@@ -585,9 +747,21 @@ type (
 
 	DownloaderConf struct {
 		Timeout cos.Duration `json:"timeout"`
+		// download job store: "memory" (default) or "buntdb" (persistent and indexed; see ext/dload/jobstore.go)
+		// takes effect upon target restart
+		JobStore string `json:"job_store,omitempty"`
+		// capacity of the per-mountpath (jogger) task queue; 0 (omitted) - default;
+		// takes effect immediately, including the running downloader
+		QueueSize int `json:"queue_size,omitempty"`
+		// cluster-wide download bandwidth (bytes per second), split evenly between targets and shared
+		// by concurrent jobs in proportion to their priorities (see ext/dload/bwsched.go); 0 - unlimited
+		MaxBandwidth cos.SizeIEC `json:"max_bandwidth,omitempty"`
 	}
 	DownloaderConfToSet struct {
-		Timeout *cos.Duration `json:"timeout,omitempty"`
+		Timeout      *cos.Duration `json:"timeout,omitempty"`
+		JobStore     *string       `json:"job_store,omitempty"`
+		QueueSize    *int          `json:"queue_size,omitempty"`
+		MaxBandwidth *cos.SizeIEC  `json:"max_bandwidth,omitempty"`
 	}
 
 	DsortConf struct {
@@ -626,14 +800,40 @@ type (
 		// fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
 		LZ4BlockMaxSize  cos.SizeIEC `json:"lz4_block"`
 		LZ4FrameChecksum bool        `json:"lz4_frame_checksum"`
+		// data movers pack objects of this size or smaller - many per transport object;
+		// zero disables small-object batching (default)
+		BatchSmall cos.SizeIEC `json:"batch_small"`
+		// weighted sharing of intra-cluster send bandwidth by competing data movers
+		QoS TransportQoSConf `json:"qos"`
 	}
 	TransportConfToSet struct {
-		MaxHeaderSize    *int          `json:"max_header,omitempty"`
-		Burst            *int          `json:"burst_buffer,omitempty"`
-		IdleTeardown     *cos.Duration `json:"idle_teardown,omitempty"`
-		QuiesceTime      *cos.Duration `json:"quiescent,omitempty"`
-		LZ4BlockMaxSize  *cos.SizeIEC  `json:"lz4_block,omitempty"`
-		LZ4FrameChecksum *bool         `json:"lz4_frame_checksum,omitempty"`
+		MaxHeaderSize    *int                   `json:"max_header,omitempty"`
+		Burst            *int                   `json:"burst_buffer,omitempty"`
+		IdleTeardown     *cos.Duration          `json:"idle_teardown,omitempty"`
+		QuiesceTime      *cos.Duration          `json:"quiescent,omitempty"`
+		LZ4BlockMaxSize  *cos.SizeIEC           `json:"lz4_block,omitempty"`
+		LZ4FrameChecksum *bool                  `json:"lz4_frame_checksum,omitempty"`
+		BatchSmall       *cos.SizeIEC           `json:"batch_small,omitempty"`
+		QoS              *TransportQoSConfToSet `json:"qos,omitempty"`
+	}
+	// QoS classes (see transport.QoS* enum):
+	// - "rebalance": global rebalance
+	// - "ec":        erasure coding (encoding, restoring, and responding to other targets)
+	// - "user":      all other data movers, including copy and transform (ETL) bucket, dsort, and more
+	// when two or more classes compete, each active class is limited to its weighted share
+	// of the configured bandwidth (e.g., rebalance=30, user=70: rebalance gets at most 30%);
+	// a class that runs alone is not throttled
+	TransportQoSConf struct {
+		Bandwidth cos.SizeIEC `json:"bandwidth"` // total per-target send bandwidth (bytes/s) to share; zero disables QoS
+		Rebalance int         `json:"rebalance"` // weight; zero defaults to DfltQoSWeight (same below)
+		EC        int         `json:"ec"`
+		User      int         `json:"user"`
+	}
+	TransportQoSConfToSet struct {
+		Bandwidth *cos.SizeIEC `json:"bandwidth,omitempty"`
+		Rebalance *int         `json:"rebalance,omitempty"`
+		EC        *int         `json:"ec,omitempty"`
+		User      *int         `json:"user,omitempty"`
 	}
 
 	MemsysConf struct {
@@ -643,6 +843,14 @@ type (
 		HousekeepTime  cos.Duration `json:"hk_time"`
 		MinPctTotal    int          `json:"min_pct_total"`
 		MinPctFree     int          `json:"min_pct_free"`
+
+		// on multi-socket targets: pin per-mountpath workers (and joggers) to the NUMA node
+		// local to the mountpath's disks, and allocate their slab buffers from that node
+		NUMA bool `json:"numa,omitempty"`
+
+		// GET with image filter (see cmn/filter): max width * height of the source image;
+		// zero: filter.DfltMaxImgPixels
+		MaxImgPixels int64 `json:"max_img_pixels,omitempty"`
 	}
 	MemsysConfToSet struct {
 		MinFree        *cos.SizeIEC  `json:"min_free,omitempty"`
@@ -651,6 +859,8 @@ type (
 		HousekeepTime  *cos.Duration `json:"hk_time,omitempty"`
 		MinPctTotal    *int          `json:"min_pct_total,omitempty"`
 		MinPctFree     *int          `json:"min_pct_free,omitempty"`
+		NUMA           *bool         `json:"numa,omitempty"`
+		MaxImgPixels   *int64        `json:"max_img_pixels,omitempty"`
 	}
 
 	TCBConf struct {
@@ -707,6 +917,7 @@ var (
 	_ Validator = (*ECConf)(nil)
 	_ Validator = (*VersionConf)(nil)
 	_ Validator = (*KeepaliveConf)(nil)
+	_ Validator = (*AdmissionConf)(nil)
 	_ Validator = (*PeriodConf)(nil)
 	_ Validator = (*TimeoutConf)(nil)
 	_ Validator = (*ClientConf)(nil)
@@ -722,6 +933,7 @@ var (
 	_ Validator = (*TCBConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
 	_ Validator = (*TracingConf)(nil)
+	_ Validator = (*SecretsConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
@@ -834,6 +1046,13 @@ func (c *LocalConfig) DelPath(mpath string) {
 // PeriodConf //
 ////////////////
 
+// error-rate alert defaults (see PeriodConf)
+const (
+	ErrRateHighDflt      = 5 // percent
+	ErrRateMinErrsDflt   = 100
+	ErrRateClearTimeDflt = 5 * time.Minute
+)
+
 func (c *PeriodConf) Validate() error {
 	if c.StatsTime.D() < time.Second || c.StatsTime.D() > time.Minute {
 		return fmt.Errorf("invalid periodic.stats_time=%s (expected range [1s, 1m])",
@@ -847,6 +1066,15 @@ func (c *PeriodConf) Validate() error {
 		return fmt.Errorf("invalid periodic.notif_time=%s (expected range [1s, 1m])",
 			c.StatsTime)
 	}
+	if c.ErrRateHigh < 0 || c.ErrRateHigh > 100 {
+		return fmt.Errorf("invalid periodic.err_rate_high=%d (expected range [0, 100] percent)", c.ErrRateHigh)
+	}
+	if c.ErrRateMinErrs < 0 {
+		return fmt.Errorf("invalid periodic.err_rate_min_errs=%d (expected non-negative)", c.ErrRateMinErrs)
+	}
+	if c.ErrRateClearTime < 0 {
+		return fmt.Errorf("invalid periodic.err_rate_clear_time=%s (expected non-negative)", c.ErrRateClearTime)
+	}
 	return nil
 }
 
@@ -854,6 +1082,12 @@ func (c *PeriodConf) Validate() error {
 // LogConf //
 /////////////
 
+// log.format
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json" // one JSON object per line (see nlog for the schema)
+)
+
 func (c *LogConf) Validate() error {
 	if err := c.Level.Validate(); err != nil {
 		return err
@@ -873,6 +1107,11 @@ func (c *LogConf) Validate() error {
 	if c.StatsTime.D() > 10*time.Minute {
 		return fmt.Errorf("invalid log.stats_time=%s (expected range [periodic.stats_time, 10m])", c.StatsTime)
 	}
+	switch c.Format {
+	case "", LogFormatText, LogFormatJSON:
+	default:
+		return fmt.Errorf("invalid log.format=%q (expecting %q or %q)", c.Format, LogFormatText, LogFormatJSON)
+	}
 	return nil
 }
 
@@ -937,6 +1176,36 @@ func (c *BackendConf) Validate() (err error) {
 				}
 			}
 			c.Conf[provider] = aisConf
+		case apc.HT:
+			var htConf BackendConfHT
+			if err := jsoniter.Unmarshal(b, &htConf); err != nil {
+				return fmt.Errorf("invalid %q backend specification: %v", apc.HT, err)
+			}
+			if err := htConf.validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = htConf
+			c.setProvider(provider)
+		case apc.Ext:
+			var extConf BackendConfExt
+			if err := jsoniter.Unmarshal(b, &extConf); err != nil {
+				return fmt.Errorf("invalid %q backend specification: %v", apc.Ext, err)
+			}
+			if err := extConf.validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = extConf
+			c.setProvider(provider)
+		case apc.RGW:
+			var rgwConf BackendConfRGW
+			if err := jsoniter.Unmarshal(b, &rgwConf); err != nil {
+				return fmt.Errorf("invalid %q backend specification: %v", apc.RGW, err)
+			}
+			if err := rgwConf.validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = rgwConf
+			c.setProvider(provider)
 		case "":
 			continue
 		default:
@@ -949,7 +1218,7 @@ func (c *BackendConf) Validate() (err error) {
 func (c *BackendConf) setProvider(provider string) {
 	var ns Ns
 	switch provider {
-	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HT:
+	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.RGW, apc.Ext, apc.HT:
 		ns = NsGlobal
 	default:
 		debug.Assert(false, "unknown backend provider "+provider)
@@ -1009,10 +1278,141 @@ func (c BackendConfAIS) String() (s string) {
 	return
 }
 
+///////////////////
+// BackendConfHT //
+///////////////////
+
+func (c BackendConfHT) validate() error {
+	for origin, mirrors := range c {
+		if !_isHTTP(origin) {
+			return fmt.Errorf("invalid HTTP(S) origin %q: expecting http:// or https:// base URL", origin)
+		}
+		if len(mirrors) == 0 {
+			return fmt.Errorf("no mirror URL(s) for HTTP(S) origin %q", origin)
+		}
+		for _, u := range mirrors {
+			if !_isHTTP(u) {
+				return fmt.Errorf("invalid mirror URL %q (origin %q): expecting http:// or https:// base URL", u, origin)
+			}
+		}
+	}
+	return nil
+}
+
+////////////////////
+// BackendConfExt //
+////////////////////
+
+func (c BackendConfExt) validate() error {
+	for name, pc := range c {
+		if err := cos.CheckAlphaPlus(name, "plugin name"); err != nil {
+			return fmt.Errorf("invalid %q backend plugin: %v", apc.Ext, err)
+		}
+		if !_isHTTP(pc.URL) {
+			return fmt.Errorf("invalid %q backend plugin %q URL %q: expecting http:// or https:// base URL", apc.Ext, name, pc.URL)
+		}
+		if pc.Timeout < 0 {
+			return fmt.Errorf("invalid %q backend plugin %q timeout %v", apc.Ext, name, pc.Timeout)
+		}
+	}
+	return nil
+}
+
+// Ext returns registered external backend plugins, if any
+func (c *BackendConf) Ext() (conf BackendConfExt) {
+	switch v := c.Get(apc.Ext).(type) {
+	case nil:
+	case BackendConfExt:
+		conf = v
+	default:
+		if err := cos.MorphMarshal(v, &conf); err != nil {
+			nlog.Errorln("invalid", apc.Ext, "backend config:", err)
+		}
+	}
+	return conf
+}
+
+////////////////////
+// BackendConfRGW //
+////////////////////
+
+const (
+	RGWDefaultRegion    = "us-east-1"
+	RGWDefaultAdminPath = "/admin"
+)
+
+// RGW returns validated Ceph RGW backend config, if configured (zero value otherwise)
+func (c *BackendConf) RGW() (conf BackendConfRGW) {
+	switch v := c.Get(apc.RGW).(type) {
+	case nil:
+	case BackendConfRGW:
+		conf = v
+	default:
+		if err := cos.MorphMarshal(v, &conf); err != nil {
+			nlog.Errorln("invalid", apc.RGW, "backend config:", err)
+		}
+		conf.validate() //nolint:errcheck // defaults
+	}
+	return conf
+}
+
+func (c *BackendConfRGW) validate() error {
+	if !_isHTTP(c.Endpoint) {
+		return fmt.Errorf("invalid %q backend endpoint %q: expecting http:// or https:// base URL", apc.RGW, c.Endpoint)
+	}
+	if c.AdminPath != "" && c.AdminPath[0] != '/' {
+		return fmt.Errorf("invalid %q backend admin_path %q: expecting absolute path, e.g. %q", apc.RGW, c.AdminPath, RGWDefaultAdminPath)
+	}
+	if c.Region == "" {
+		c.Region = RGWDefaultRegion
+	}
+	if c.AdminPath == "" {
+		c.AdminPath = RGWDefaultAdminPath
+	}
+	return nil
+}
+
+func _isHTTP(u string) bool {
+	pu, err := url.Parse(u)
+	return err == nil && (pu.Scheme == "http" || pu.Scheme == "https") && pu.Host != ""
+}
+
+// Rewrite returns the list of URLs to try, in order, when reading the given original URL:
+// the mirrors of the longest matching origin or, if there's no match, the original URL itself.
+// Origins match on the path boundary: "https://a/b" matches "https://a/b/c" but not "https://a/bc".
+func (c BackendConfHT) Rewrite(origURL string) []string {
+	var (
+		match   string
+		mirrors []string
+	)
+	for origin, mm := range c {
+		o := strings.TrimSuffix(origin, "/")
+		if len(o) <= len(match) {
+			continue
+		}
+		if origURL == o || strings.HasPrefix(origURL, o+"/") {
+			match, mirrors = o, mm
+		}
+	}
+	if mirrors == nil {
+		return []string{origURL}
+	}
+	var (
+		suffix = origURL[len(match):]
+		urls   = make([]string, 0, len(mirrors))
+	)
+	for _, m := range mirrors {
+		urls = append(urls, strings.TrimSuffix(m, "/")+suffix)
+	}
+	return urls
+}
+
 //////////////
 // DiskConf //
 //////////////
 
+const MaxWalkParallel = 64 // max number of (parallel) bucket walkers per mountpath
+
 func (c *DiskConf) Validate() (err error) {
 	lwm, hwm, maxwm := c.DiskUtilLowWM, c.DiskUtilHighWM, c.DiskUtilMaxWM
 	if lwm <= 0 || hwm <= lwm || maxwm <= hwm || maxwm > 100 {
@@ -1028,7 +1428,49 @@ func (c *DiskConf) Validate() (err error) {
 		return fmt.Errorf("disk.iostat_time_long %v shorter than disk.iostat_time_short %v",
 			c.IostatTimeLong, c.IostatTimeShort)
 	}
-	return nil
+	if c.WalkParallel < 0 || c.WalkParallel > MaxWalkParallel {
+		return fmt.Errorf("invalid disk.walk_parallel %d (expecting [0, %d] range)", c.WalkParallel, MaxWalkParallel)
+	}
+	_, err = c.walkParallelLabels()
+	return err
+}
+
+// returns the number of parallel walkers for a mountpath with a given label
+func (c *DiskConf) WalkParallelism(label cos.MountpathLabel) int {
+	n := c.WalkParallel
+	if c.WalkParallelLabels != "" && label != "" {
+		if labels, err := c.walkParallelLabels(); err == nil {
+			if v, ok := labels[string(label)]; ok {
+				n = v
+			}
+		}
+	}
+	return max(n, 1)
+}
+
+func (c *DiskConf) walkParallelLabels() (map[string]int, error) {
+	if c.WalkParallelLabels == "" {
+		return nil, nil
+	}
+	var (
+		pairs  = strings.Split(c.WalkParallelLabels, ",")
+		labels = make(map[string]int, len(pairs))
+	)
+	for _, pair := range pairs {
+		label, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		label = strings.TrimSpace(label)
+		if !ok || label == "" {
+			return nil, fmt.Errorf("invalid disk.walk_parallel_labels %q (expecting comma-separated label=N pairs)",
+				c.WalkParallelLabels)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil || n < 0 || n > MaxWalkParallel {
+			return nil, fmt.Errorf("invalid disk.walk_parallel_labels %q: %q (expecting [0, %d] range)",
+				c.WalkParallelLabels, pair, MaxWalkParallel)
+		}
+		labels[label] = n
+	}
+	return labels, nil
 }
 
 ///////////////
@@ -1188,9 +1630,20 @@ func (c *ECConf) Validate() error {
 	if !apc.IsValidCompression(c.Compression) {
 		return fmt.Errorf("invalid ec.compression: %q (expecting one of: %v)", c.Compression, apc.SupportedCompression)
 	}
+	if !apc.IsValidECEncode(c.EncodeMode) {
+		return fmt.Errorf("invalid ec.encode_mode: %q (expecting one of: %v)", c.EncodeMode, apc.SupportedECEncode)
+	}
+	if d := c.LazyDelay.D(); d < 0 || d > 24*time.Hour {
+		return fmt.Errorf("invalid ec.lazy_delay: %v (expected range [0, 24h])", d)
+	}
+	if c.EncodeMode == apc.ECEncodeLazy && c.LazyDelay == 0 {
+		c.LazyDelay = cos.Duration(dfltECLazyDelay)
+	}
 	return nil
 }
 
+const dfltECLazyDelay = time.Minute
+
 func (c *ECConf) ValidateAsProps(arg ...any) (err error) {
 	if !c.Enabled {
 		return
@@ -1261,6 +1714,8 @@ func (c *WritePolicyConf) ValidateAsProps(...any) error { return c.Validate() }
 // see palive.retry in re "total number of failures prior to removing"
 const kaNumRetries = 3
 
+const maxElectionGrace = 10 * time.Minute
+
 func (c *KeepaliveConf) Validate() error {
 	if c.Proxy.Name != "heartbeat" {
 		return fmt.Errorf("invalid keepalivetracker.proxy.name %s", c.Proxy.Name)
@@ -1277,6 +1732,32 @@ func (c *KeepaliveConf) Validate() error {
 	if c.NumRetries < 1 || c.NumRetries > 10 {
 		return fmt.Errorf("invalid keepalivetracker.num_retries %d (expecting range [1, 10])", c.NumRetries)
 	}
+	if g := c.ElectionGrace.D(); g < 0 || g > maxElectionGrace {
+		return fmt.Errorf("invalid keepalivetracker.election_grace %s (expecting range [0, %s])", g, maxElectionGrace)
+	}
+	return nil
+}
+
+///////////////////
+// AdmissionConf //
+///////////////////
+
+const dfltAdmQueueTimeout = 10 * time.Second
+
+func (c *AdmissionConf) Validate() error {
+	if err := c.List.validate("list"); err != nil {
+		return err
+	}
+	return c.Objects.validate("objects")
+}
+
+func (c *AdmissionClassConf) validate(class string) error {
+	if c.MaxInflight < 0 || c.MaxQueued < 0 || c.QueueTimeout < 0 {
+		return fmt.Errorf("invalid proxy.admission.%s %+v (negative values are not permitted)", class, *c)
+	}
+	if c.MaxInflight > 0 && c.MaxQueued > 0 && c.QueueTimeout == 0 {
+		c.QueueTimeout = cos.Duration(dfltAdmQueueTimeout)
+	}
 	return nil
 }
 
@@ -1337,6 +1818,10 @@ func (c *HTTPConf) ToTLS() TLSArgs {
 const (
 	IOErrTimeDflt = 10 * time.Second
 	IOErrsLimit   = 10
+
+	TestFileSizeDflt  = cos.MiB
+	ProbeCountDflt    = 3
+	ProbeIntervalDflt = 10 * time.Minute
 )
 
 // [backward compatibility] TODO: remove (ref v324)
@@ -1351,6 +1836,9 @@ func (c *FSHCConf) UnmarshalJSON(data []byte) (err error) {
 	c.HardErrs = 2
 	c.IOErrs = IOErrsLimit
 	c.IOErrTime = cos.Duration(IOErrTimeDflt)
+	c.TestFileSize = TestFileSizeDflt
+	c.ProbeCount = ProbeCountDflt
+	c.ProbeInterval = cos.Duration(ProbeIntervalDflt)
 	c.Enabled = true
 
 	cos.Errorln("Warning: setting fshc to all defaults")
@@ -1389,6 +1877,41 @@ func (c *FSHCConf) Validate() error {
 	if c.IOErrTime > cos.Duration(60*time.Second) {
 		return fmt.Errorf("invalid fshc.io_err_time %d (expecting <= %v)", c.IOErrTime, 60*time.Second)
 	}
+
+	if c.TestFileSize == 0 {
+		c.TestFileSize = TestFileSizeDflt
+	}
+	if c.TestFileSize < 4*cos.KiB || c.TestFileSize > 64*cos.MiB {
+		return fmt.Errorf("invalid fshc.test_file_size %s (expecting [4KiB, 64MiB] range)", c.TestFileSize)
+	}
+	if c.ProbeCount == 0 {
+		c.ProbeCount = ProbeCountDflt
+	}
+	if c.ProbeCount < -1 {
+		return fmt.Errorf("invalid fshc.probe_count %d (expecting positive number or -1 to disable probation)", c.ProbeCount)
+	}
+	if c.ProbeInterval == 0 {
+		c.ProbeInterval = cos.Duration(ProbeIntervalDflt)
+	}
+	if c.ProbeInterval < cos.Duration(time.Minute) {
+		return fmt.Errorf("invalid fshc.probe_interval %v (expecting >= %v)", c.ProbeInterval, time.Minute)
+	}
+	return nil
+}
+
+/////////////////
+// FDomainConf //
+/////////////////
+
+func (c *FDomainConf) Validate() error {
+	if c.Zone != "" {
+		if err := cos.CheckAlphaPlus(c.Zone, "failure_domain.zone"); err != nil {
+			return err
+		}
+	}
+	if c.Rack != "" {
+		return cos.CheckAlphaPlus(c.Rack, "failure_domain.rack")
+	}
 	return nil
 }
 
@@ -1675,6 +2198,9 @@ func (c *MemsysConf) Validate() (err error) {
 	if c.MinPctFree < 0 || c.MinPctFree > 95 {
 		return fmt.Errorf("invalid memsys.min_pct_free %d%%", c.MinPctFree)
 	}
+	if c.MaxImgPixels < 0 {
+		return fmt.Errorf("invalid memsys.max_img_pixels %d (expecting non-negative)", c.MaxImgPixels)
+	}
 	return nil
 }
 
@@ -1688,6 +2214,11 @@ const (
 
 	DfltTransportBurst = 256
 	MaxTransportBurst  = 4096
+
+	DfltQoSWeight = 50
+	MaxQoSWeight  = 100
+
+	MaxTransportBatchSmall = 128 * cos.KiB
 )
 
 // NOTE: uncompressed block sizes - the enum currently supported by the github.com/pierrec/lz4
@@ -1713,6 +2244,26 @@ func (c *TransportConf) Validate() (err error) {
 	if c.QuiesceTime.D() < 8*time.Second {
 		return fmt.Errorf("invalid transport.quiescent: %v (expecting >= 8s)", c.QuiesceTime)
 	}
+	if c.BatchSmall < 0 || c.BatchSmall > MaxTransportBatchSmall {
+		return fmt.Errorf("invalid transport.batch_small: %s, expecting [0, 128KiB] range (where 0 disables batching)",
+			cos.ToSizeIEC(int64(c.BatchSmall), 0))
+	}
+	return c.QoS.Validate()
+}
+
+func (c *TransportQoSConf) Validate() error {
+	if c.Bandwidth < 0 {
+		return fmt.Errorf("invalid transport.qos.bandwidth: %d (expecting non-negative)", c.Bandwidth)
+	}
+	for _, w := range []*int{&c.Rebalance, &c.EC, &c.User} {
+		if *w < 0 || *w > MaxQoSWeight {
+			return fmt.Errorf("invalid transport.qos weight: %d (expecting [0, %d] range, where 0 means default)",
+				*w, MaxQoSWeight)
+		}
+		if *w == 0 {
+			*w = DfltQoSWeight
+		}
+	}
 	return nil
 }
 
@@ -1773,6 +2324,15 @@ func (c *TimeoutConf) Validate() error {
 		return fmt.Errorf("invalid timeout.object_md=%s (expecting 0 (zero) for system default or a value greater or equal 20m)",
 			c.ObjectMD)
 	}
+	for _, v := range []struct {
+		name string
+		d    cos.Duration
+	}{{"list_objects", c.ListObjects}, {"head_object", c.HeadObject}, {"cold_get", c.ColdGet}} {
+		if v.d != 0 && v.d.D() < time.Second {
+			return fmt.Errorf("invalid timeout.%s=%s (expecting 0 (zero) for no limit or a value greater or equal 1s)",
+				v.name, v.d)
+		}
+	}
 	return nil
 }
 
@@ -1780,10 +2340,27 @@ func (c *TimeoutConf) Validate() error {
 // DownloaderConf //
 ////////////////////
 
+const (
+	minDloadQueueSize = 16
+	maxDloadQueueSize = 16 * 1024
+)
+
 func (c *DownloaderConf) Validate() error {
 	if j := c.Timeout.D(); j < time.Second || j > time.Hour {
 		return fmt.Errorf("invalid downloader.timeout=%s (expected range [1s, 1h])", j)
 	}
+	switch c.JobStore {
+	case "", "memory", "buntdb":
+	default:
+		return fmt.Errorf("invalid downloader.job_store %q (expecting \"memory\" or \"buntdb\")", c.JobStore)
+	}
+	if c.QueueSize != 0 && (c.QueueSize < minDloadQueueSize || c.QueueSize > maxDloadQueueSize) {
+		return fmt.Errorf("invalid downloader.queue_size=%d (expected range [%d, %d] or 0 for default)",
+			c.QueueSize, minDloadQueueSize, maxDloadQueueSize)
+	}
+	if c.MaxBandwidth < 0 {
+		return fmt.Errorf("invalid downloader.max_bandwidth=%d (expected non-negative)", c.MaxBandwidth)
+	}
 	return nil
 }
 
@@ -1846,6 +2423,54 @@ func (c *TracingConf) Validate() error {
 	return nil
 }
 
+/////////////////
+// SecretsConf //
+/////////////////
+
+const (
+	SecretsVault = "vault" // HashiCorp Vault, KV version 2
+	SecretsASM   = "asm"   // AWS Secrets Manager
+	SecretsK8s   = "k8s"   // Kubernetes secrets mounted as volumes
+)
+
+const secretsMinRefresh = 10 * time.Second
+
+func (c *SecretsConf) Validate() error {
+	switch c.Provider {
+	case "":
+		return nil
+	case SecretsVault:
+		if c.Vault.Addr == "" {
+			return errors.New("secrets.vault.addr must be defined when using Vault secrets provider")
+		}
+	case SecretsASM, SecretsK8s:
+	default:
+		return fmt.Errorf("invalid secrets.provider %q (expecting one of: %q, %q, %q)", c.Provider, SecretsVault, SecretsASM, SecretsK8s)
+	}
+	if d := c.Refresh.D(); d != 0 && d < secretsMinRefresh {
+		return fmt.Errorf("invalid secrets.refresh_time %v (expecting 0 (disabled) or >= %v)", c.Refresh, secretsMinRefresh)
+	}
+	return nil
+}
+
+// secret ID for a given backend provider, if any
+func (c *SecretsConf) SecretID(provider string) string {
+	if c.Provider == "" {
+		return ""
+	}
+	switch provider {
+	case apc.AWS:
+		return c.AWS
+	case apc.GCP:
+		return c.GCP
+	case apc.Azure:
+		return c.Azure
+	case apc.OCI:
+		return c.OCI
+	}
+	return ""
+}
+
 func (tac TraceExporterAuthConf) IsEnabled() bool {
 	return tac.TokenFile != "" && tac.TokenHeader != ""
 }
@@ -1982,6 +2607,7 @@ func LoadConfig(globalConfPath, localConfPath, daeRole string, config *Config) e
 	}
 
 	nlog.SetPost(config.Log.ToStderr, int64(config.Log.MaxSize))
+	nlog.SetJSON(config.Log.Format == LogFormatJSON)
 
 	// initialize atomic part of the config including most often used timeouts and features
 	Rom.Set(&config.ClusterConfig)
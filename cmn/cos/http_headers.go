@@ -20,6 +20,11 @@ const (
 
 	// not currently used
 	ContentZip = "application/zip"
+
+	// GET with built-in filters (see cmn/filter)
+	ContentGzip = "application/gzip"
+	ContentJPEG = "image/jpeg"
+	ContentPNG  = "image/png"
 )
 
 // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers
@@ -27,6 +32,7 @@ const (
 	// range to read:
 	HdrRange          = "Range" // Ref: https://www.rfc-editor.org/rfc/rfc7233#section-2.1
 	HdrRangeValPrefix = "bytes="
+	HdrIfRange        = "If-Range" // Ref: https://www.rfc-editor.org/rfc/rfc7233#section-3.2
 	// range read response:
 	HdrContentRange          = "Content-Range"
 	HdrContentRangeValPrefix = "bytes " // Ref: https://tools.ietf.org/html/rfc7233#section-4.2
@@ -37,12 +43,18 @@ const (
 	HdrContentTypeOptions = "X-Content-Type-Options"
 	HdrContentLength      = "Content-Length"
 
+	// caching & presentation
+	HdrCacheControl       = "Cache-Control"
+	HdrLastModified       = "Last-Modified"
+	HdrContentDisposition = "Content-Disposition"
+
 	// misc. gen
-	HdrUserAgent = "User-Agent"
-	HdrAccept    = "Accept"
-	HdrLocation  = "Location"
-	HdrServer    = "Server"
-	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrUserAgent  = "User-Agent"
+	HdrAccept     = "Accept"
+	HdrLocation   = "Location"
+	HdrServer     = "Server"
+	HdrETag       = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+	HdrRetryAfter = "Retry-After"
 
 	HdrHSTS = "Strict-Transport-Security"
 )
@@ -30,7 +30,7 @@ const (
 	LowCapacity                                      // (used > high); warning: OOS possible soon..
 	LowMemory                                        // ditto OOM
 	DiskFault                                        // red
-	NoMountpaths                                     // red: (reserved, not used)
+	NoMountpaths                                     // red: no available mountpaths
 	NumGoroutines                                    // red
 	CertWillSoonExpire                               // warning X.509
 	CertificateExpired                               // red --/--
@@ -38,6 +38,8 @@ const (
 	KeepAliveErrors                                  // warning (new keep-alive errors during the last 5m)
 	OOCPU                                            // out of CPU; red
 	LowCPU                                           // warning
+	HighErrorRate                                    // warning: error-rate spike (percentage of failed requests)
+	DegradedMountpath                                // warning: one or more mountpaths disabled (by FSHC or administratively)
 )
 
 func (f NodeStateFlags) IsOK() bool { return f == NodeStarted|ClusterStarted }
@@ -48,7 +50,7 @@ func (f NodeStateFlags) IsRed() bool {
 
 func (f NodeStateFlags) IsWarn() bool {
 	return f.IsAnySet(Rebalancing | RebalanceInterrupted | Resilvering | ResilverInterrupted | NodeRestarted | MaintenanceMode |
-		LowCapacity | LowMemory | LowCPU | CertWillSoonExpire)
+		LowCapacity | LowMemory | LowCPU | CertWillSoonExpire | HighErrorRate | DegradedMountpath)
 }
 
 func (f NodeStateFlags) IsSet(flag NodeStateFlags) bool { return BitFlags(f).IsSet(BitFlags(flag)) }
@@ -141,6 +143,12 @@ func (f NodeStateFlags) String() string {
 	if f&LowCPU == LowCPU {
 		sb = append(sb, "low-cpu")
 	}
+	if f&HighErrorRate == HighErrorRate {
+		sb = append(sb, "high-error-rate")
+	}
+	if f&DegradedMountpath == DegradedMountpath {
+		sb = append(sb, "degraded-mountpath")
+	}
 
 	l := len(sb)
 	switch l {
@@ -193,6 +193,7 @@ func (s *DynSemaphore) SetSize(n int) {
 	debug.Assert(n >= 1, n)
 	s.mu.Lock()
 	s.size = n
+	s.c.Broadcast() // when growing
 	s.mu.Unlock()
 }
 
@@ -0,0 +1,104 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// WorkerPool runs submitted tasks with bounded (and resizable) concurrency, and provides:
+//   - panic recovery: a panicking task fails with an error (rather than crashing the process);
+//   - error aggregation: up to maxErrs distinct errors (see Errs);
+//   - context cancellation: when `failFast` is set, the first error cancels the pool's context
+//     (compare with errgroup.WithContext); all tasks are expected to observe the context.
+type (
+	WorkerTask func(ctx context.Context) error
+
+	WorkerPool struct {
+		parent   context.Context
+		ctx      context.Context
+		cancel   context.CancelFunc
+		sema     *DynSemaphore
+		errs     Errs
+		wg       sync.WaitGroup
+		failFast bool
+	}
+)
+
+func NewWorkerPool(ctx context.Context, size int, failFast bool) *WorkerPool {
+	debug.Assert(size > 0, size)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	p := &WorkerPool{parent: ctx, sema: NewDynSemaphore(size), failFast: failFast}
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	return p
+}
+
+func (p *WorkerPool) Ctx() context.Context { return p.ctx }
+func (p *WorkerPool) Size() int            { return p.sema.Size() }
+
+// Resize takes effect immediately when growing, and as running tasks complete when shrinking.
+func (p *WorkerPool) Resize(n int) { p.sema.SetSize(max(n, 1)) }
+
+// Cancel the pool's context; submitted tasks are expected to terminate soon thereafter.
+func (p *WorkerPool) Cancel() { p.cancel() }
+
+// Go blocks until a worker slot becomes available, and then runs the task.
+// Returns false when the pool is canceled (in which case the task is not executed).
+func (p *WorkerPool) Go(task WorkerTask) bool {
+	if p.ctx.Err() != nil {
+		return false
+	}
+	p.sema.Acquire()
+	if p.ctx.Err() != nil {
+		p.sema.Release()
+		return false
+	}
+	p.wg.Add(1)
+	go p.run(task)
+	return true
+}
+
+func (p *WorkerPool) run(task WorkerTask) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.fail(fmt.Errorf("worker panic: %v", r))
+		}
+		p.sema.Release()
+		p.wg.Done()
+	}()
+	if err := task(p.ctx); err != nil {
+		p.fail(err)
+	}
+}
+
+func (p *WorkerPool) fail(err error) {
+	// the consequence rather than the cause
+	if errors.Is(err, context.Canceled) && p.ctx.Err() != nil && p.errs.Cnt() > 0 {
+		return
+	}
+	p.errs.Add(err)
+	if p.failFast {
+		p.cancel()
+	}
+}
+
+// Wait for all submitted tasks to complete and return aggregated error, if any.
+// Otherwise, return the parent context's error (if canceled).
+// The pool cannot be reused after Wait.
+func (p *WorkerPool) Wait() (err error) {
+	p.wg.Wait()
+	if _, err = p.errs.JoinErr(); err == nil {
+		err = p.parent.Err()
+	}
+	p.cancel()
+	return err
+}
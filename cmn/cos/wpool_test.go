@@ -0,0 +1,89 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestWorkerPoolBounded(t *testing.T) {
+	var (
+		running, peak atomic.Int32
+		wpool         = cos.NewWorkerPool(context.Background(), 3, false)
+	)
+	for range 30 {
+		wpool.Go(func(context.Context) error {
+			n := running.Inc()
+			for {
+				p := peak.Load()
+				if n <= p || peak.CAS(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Dec()
+			return nil
+		})
+		if peak.Load() == 3 && wpool.Size() == 3 {
+			wpool.Resize(5)
+		}
+	}
+	tassert.CheckFatal(t, wpool.Wait())
+	tassert.Errorf(t, peak.Load() <= 5, "expected at most 5 concurrent tasks, got %d", peak.Load())
+}
+
+func TestWorkerPoolErrors(t *testing.T) {
+	// panic recovery and error aggregation
+	wpool := cos.NewWorkerPool(context.Background(), 2, false)
+	for i := range 4 {
+		wpool.Go(func(context.Context) error {
+			switch i {
+			case 0:
+				panic("boom")
+			case 1:
+				return errors.New("failed")
+			}
+			return nil
+		})
+	}
+	err := wpool.Wait()
+	tassert.Fatalf(t, err != nil, "expected error")
+	tassert.Errorf(t, strings.Contains(err.Error(), "boom") && strings.Contains(err.Error(), "failed"),
+		"expected both errors, got %v", err)
+
+	// fail fast: first error cancels the context
+	var executed atomic.Int32
+	wpool = cos.NewWorkerPool(context.Background(), 1, true)
+	for i := range 10 {
+		if !wpool.Go(func(ctx context.Context) error {
+			executed.Inc()
+			if i == 0 {
+				return errors.New("first")
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		}) {
+			break
+		}
+	}
+	err = wpool.Wait()
+	tassert.Errorf(t, err != nil && err.Error() == "first", "expected the first error only, got %v", err)
+	tassert.Errorf(t, executed.Load() == 1, "expected one task to execute, got %d", executed.Load())
+
+	// parent context
+	ctx, cancel := context.WithCancel(context.Background())
+	wpool = cos.NewWorkerPool(ctx, 1, false)
+	cancel()
+	tassert.Errorf(t, !wpool.Go(func(context.Context) error { return nil }), "expected canceled pool")
+	tassert.Errorf(t, errors.Is(wpool.Wait(), context.Canceled), "expected context canceled")
+}
@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -57,6 +58,7 @@ type (
 		RemoteAddr string `json:"remote_addr"`
 		Caller     string `json:"caller"`
 		Node       string `json:"node"`
+		RequestID  string `json:"request_id,omitempty"`
 		trace      []byte
 		Status     int `json:"status"`
 	}
@@ -113,6 +115,11 @@ type (
 		err error
 	}
 
+	ErrBucketFrozen struct {
+		bucket    string
+		operation string
+	}
+
 	ErrBucketAccessDenied struct{ errAccessDenied }
 	ErrObjectAccessDenied struct{ errAccessDenied }
 	errAccessDenied       struct {
@@ -241,6 +248,13 @@ type (
 		ranges []string // RFC 7233
 		size   int64    // [0, size)
 	}
+	ErrTimeout struct {
+		what    string // e.g., "HEAD s3://abc/xyz"
+		phase   string // the one that timed out, e.g. "waiting for remote backend"
+		elapsed time.Duration
+		limit   time.Duration // (see TimeoutConf)
+		remote  bool          // true: remote backend (status 504), otherwise 503
+	}
 )
 
 var (
@@ -445,6 +459,22 @@ func (e *ErrBusy) Error() string {
 	return fmt.Sprintf("%s %q is currently busy%s, please try again", e.whereOrType, e.what, s)
 }
 
+// ErrBucketFrozen
+
+func NewErrBucketFrozen(bucket, oper string) *ErrBucketFrozen {
+	return &ErrBucketFrozen{bucket, oper}
+}
+
+func (e *ErrBucketFrozen) Error() string {
+	return fmt.Sprintf("bucket %s is frozen (read-only): %s is not permitted until the bucket is unfrozen",
+		e.bucket, e.operation)
+}
+
+func IsErrBucketFrozen(err error) bool {
+	_, ok := err.(*ErrBucketFrozen)
+	return ok
+}
+
 // errAccessDenied & ErrBucketAccessDenied
 
 func (e *errAccessDenied) String() string {
@@ -977,6 +1007,33 @@ func IsErrRangeNotSatisfiable(err error) bool {
 	return ok
 }
 
+// ErrTimeout
+// http.StatusGatewayTimeout = 504 when waiting for remote backend; otherwise, http.StatusServiceUnavailable = 503
+
+func NewErrTimeout(what, phase string, elapsed, limit time.Duration, remote bool) *ErrTimeout {
+	return &ErrTimeout{what, phase, elapsed, limit, remote}
+}
+
+func (e *ErrTimeout) Error() string {
+	return fmt.Sprintf("%s timed out after %v (limit %v) while %s", e.what, e.elapsed.Round(time.Millisecond), e.limit, e.phase)
+}
+
+func (e *ErrTimeout) Status() int {
+	if e.remote {
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusServiceUnavailable
+}
+
+// (including ErrTimeout received by API clients)
+func IsErrTimeout(err error) bool {
+	if _, ok := err.(*ErrTimeout); ok {
+		return true
+	}
+	herr, ok := err.(*ErrHTTP)
+	return ok && herr.TypeCode == "ErrTimeout"
+}
+
 //
 // more is-error helpers
 //
@@ -1078,6 +1135,7 @@ func (e *ErrHTTP) init(r *http.Request, err error, ecode int) {
 		e.Method, e.URLPath = r.Method, r.URL.Path
 		e.RemoteAddr = r.RemoteAddr
 		e.Caller = r.Header.Get(apc.HdrCallerName)
+		e.RequestID = r.Header.Get(apc.HdrRequestID)
 	}
 	e.Node = thisNodeName
 }
@@ -1118,6 +1176,9 @@ func (e *ErrHTTP) StringEx() (s string) {
 	if e.Caller != "" {
 		s += " (called by " + e.Caller + ")"
 	}
+	if e.RequestID != "" {
+		s += " (request " + e.RequestID + ")"
+	}
 	if len(e.trace) == 0 {
 		e._trace()
 	}
@@ -1219,6 +1280,9 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 		if len(opts) > 0 && opts[0] > http.StatusBadRequest {
 			herr.Status = opts[0]
 		}
+		if herr.RequestID == "" {
+			herr.RequestID = r.Header.Get(apc.HdrRequestID)
+		}
 		herr.write(w, r, len(opts) > 1 /*silent*/)
 		if allocated {
 			FreeHterr(herr)
@@ -1239,6 +1303,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 		status = opts[0]
 	} else if errf, ok := err.(*ErrFailedTo); ok {
 		status = errf.status
+	} else if errt, ok := err.(*ErrTimeout); ok {
+		status = errt.Status()
 	} else {
 		switch {
 		case isErrNotFoundExtended(err, status):
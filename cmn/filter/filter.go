@@ -0,0 +1,177 @@
+// Package filter provides lightweight built-in transformations that can be applied
+// to object content on the fly (GET) without deploying ETL
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package filter
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// built-in filters (apc.QparamFilter values)
+const (
+	Gzip   = "gzip"   // compress
+	Gunzip = "gunzip" // decompress
+
+	// image presets: resize to fit (preserving aspect ratio, never upscaling)
+	ImgThumb  = "img-thumb"  // fit 128x128
+	ImgSmall  = "img-small"  // fit 320x320
+	ImgMedium = "img-medium" // fit 640x640
+
+	// image preset: center crop to square
+	ImgSquare = "img-square"
+)
+
+// default max number of pixels (width * height) in the source image - see NewReader
+// (decoded, that's 256MiB of RGBA)
+const DfltMaxImgPixels = 64 * 1024 * 1024
+
+type (
+	ErrUnknown struct {
+		name string
+	}
+	// bad (e.g., corrupted or oversized) content - the user's error, not I/O
+	ErrFilter struct {
+		err  error
+		name string
+	}
+)
+
+type (
+	// source reader: remembers (source) I/O errors
+	srcReader struct {
+		r   io.Reader
+		err error
+	}
+	// tags all other read errors as ErrFilter
+	reader struct {
+		io.ReadCloser
+		src  *srcReader
+		name string
+	}
+)
+
+// (name => max width and height; zero: crop only)
+var imgPresets = map[string]int{
+	ImgThumb:  128,
+	ImgSmall:  320,
+	ImgMedium: 640,
+	ImgSquare: 0,
+}
+
+func All() []string {
+	return []string{Gzip, Gunzip, ImgThumb, ImgSmall, ImgMedium, ImgSquare}
+}
+
+func Validate(name string) error {
+	if name == Gzip || name == Gunzip {
+		return nil
+	}
+	if _, ok := imgPresets[name]; ok {
+		return nil
+	}
+	return &ErrUnknown{name}
+}
+
+func IsErrUnknown(err error) bool {
+	_, ok := err.(*ErrUnknown)
+	return ok
+}
+
+func (e *ErrUnknown) Error() string {
+	return fmt.Sprintf("unknown filter %q (expecting one of: %s)", e.name, strings.Join(All(), ", "))
+}
+
+func IsErrFilter(err error) bool {
+	var e *ErrFilter
+	return errors.As(err, &e)
+}
+
+func (e *ErrFilter) Error() string { return fmt.Sprintf("filter %q: %v", e.name, e.err) }
+func (e *ErrFilter) Unwrap() error { return e.err }
+
+// NewReader returns filtered content and its (resulting) content type.
+// Gzip and image filters run in a separate goroutine - the caller must always close the reader.
+// Errors caused by the content itself (e.g., corrupted gzip, image exceeding maxPixels)
+// are ErrFilter, both here and when reading - see IsErrFilter.
+// maxPixels == 0: DfltMaxImgPixels.
+func NewReader(name string, r io.Reader, maxPixels int64) (io.ReadCloser, string, error) {
+	var (
+		rc   io.ReadCloser
+		mime string
+		err  error
+		src  = &srcReader{r: r}
+	)
+	switch name {
+	case Gzip:
+		pr, pw := io.Pipe()
+		go func() {
+			zw := gzip.NewWriter(pw)
+			_, err := io.Copy(zw, src)
+			if err == nil {
+				err = zw.Close()
+			}
+			pw.CloseWithError(err)
+		}()
+		rc, mime = pr, cos.ContentGzip
+	case Gunzip:
+		rc, err = gzip.NewReader(src)
+		mime = cos.ContentBinary
+	default:
+		side, ok := imgPresets[name]
+		if !ok {
+			return nil, "", &ErrUnknown{name}
+		}
+		if maxPixels <= 0 {
+			maxPixels = DfltMaxImgPixels
+		}
+		rc, mime, err = newImgReader(src, side, maxPixels)
+	}
+	if err != nil {
+		return nil, "", src.tag(err, name)
+	}
+	return &reader{ReadCloser: rc, src: src, name: name}, mime, nil
+}
+
+///////////////
+// srcReader //
+///////////////
+
+func (src *srcReader) Read(p []byte) (n int, err error) {
+	n, err = src.r.Read(p)
+	if err != nil && err != io.EOF {
+		src.err = err
+	}
+	return n, err
+}
+
+// failing to read the source is an I/O error, even when the decoder says otherwise
+func (src *srcReader) tag(err error, name string) error {
+	switch {
+	case src.err == nil:
+		return &ErrFilter{err: err, name: name}
+	case errors.Is(err, src.err):
+		return err
+	default:
+		return fmt.Errorf("%w (filter %q: %v)", src.err, name, err)
+	}
+}
+
+////////////
+// reader //
+////////////
+
+func (r *reader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = r.src.tag(err, r.name)
+	}
+	return n, err
+}
@@ -0,0 +1,143 @@
+// Package filter provides lightweight built-in transformations that can be applied
+// to object content on the fly (GET) without deploying ETL
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package filter_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/filter"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestGzipRoundTrip(t *testing.T) {
+	orig := bytes.Repeat([]byte("built-in filter "), 4096)
+
+	zr, mime, err := filter.NewReader(filter.Gzip, bytes.NewReader(orig), 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, mime == cos.ContentGzip, "unexpected content type %q", mime)
+	compressed, err := io.ReadAll(zr)
+	tassert.CheckFatal(t, err)
+	zr.Close()
+	tassert.Errorf(t, len(compressed) < len(orig), "expected compression: %d vs %d", len(compressed), len(orig))
+
+	ur, _, err := filter.NewReader(filter.Gunzip, bytes.NewReader(compressed), 0)
+	tassert.CheckFatal(t, err)
+	out, err := io.ReadAll(ur)
+	tassert.CheckFatal(t, err)
+	ur.Close()
+	tassert.Fatalf(t, bytes.Equal(out, orig), "gzip => gunzip round trip mismatch")
+
+	_, _, err = filter.NewReader(filter.Gunzip, bytes.NewReader(orig), 0)
+	tassert.Errorf(t, filter.IsErrFilter(err), "expected gunzip to fail on non-gzip content, got %v", err)
+}
+
+// bad content vs source I/O errors
+func TestErrFilter(t *testing.T) {
+	var zbuf bytes.Buffer
+	zw := gzip.NewWriter(&zbuf)
+	zw.Write(bytes.Repeat([]byte("corrupted "), 4096))
+	zw.Close()
+	corrupted := zbuf.Bytes()
+	corrupted[len(corrupted)/2] ^= 0xff
+
+	ur, _, err := filter.NewReader(filter.Gunzip, bytes.NewReader(corrupted), 0)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(ur)
+	ur.Close()
+	tassert.Errorf(t, filter.IsErrFilter(err), "corrupted gzip: expected filter error, got %v", err)
+
+	errIO := errors.New("disk failure")
+	zr, _, err := filter.NewReader(filter.Gzip, &errReader{errIO}, 0)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(zr)
+	zr.Close()
+	tassert.Errorf(t, errors.Is(err, errIO) && !filter.IsErrFilter(err), "expected I/O error, got %v", err)
+
+	_, _, err = filter.NewReader(filter.ImgThumb, &errReader{errIO}, 0)
+	tassert.Errorf(t, errors.Is(err, errIO) && !filter.IsErrFilter(err), "expected I/O error, got %v", err)
+}
+
+func TestImgTooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	tassert.CheckFatal(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 200, 100))))
+	data := buf.Bytes()
+
+	_, _, err := filter.NewReader(filter.ImgThumb, bytes.NewReader(data), 200*100-1)
+	tassert.Errorf(t, filter.IsErrFilter(err), "expected too-large image to be rejected, got %v", err)
+
+	r, _, err := filter.NewReader(filter.ImgThumb, bytes.NewReader(data), 200*100)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(r)
+	r.Close()
+	tassert.CheckFatal(t, err)
+}
+
+type errReader struct{ err error }
+
+func (r *errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestImgPresets(t *testing.T) {
+	tests := []struct {
+		name   string
+		enc    func(io.Writer, image.Image) error
+		preset string
+		mime   string
+		w, h   int
+		ew, eh int
+	}{
+		{"png-thumb", png.Encode, filter.ImgThumb, cos.ContentPNG, 1000, 500, 128, 64},
+		{"jpeg-small", encJPEG, filter.ImgSmall, cos.ContentJPEG, 480, 960, 160, 320},
+		{"png-no-upscale", png.Encode, filter.ImgMedium, cos.ContentPNG, 100, 50, 100, 50},
+		{"png-square", png.Encode, filter.ImgSquare, cos.ContentPNG, 300, 200, 200, 200},
+		{"jpeg-square", encJPEG, filter.ImgSquare, cos.ContentJPEG, 90, 120, 90, 90},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				buf bytes.Buffer
+				src = image.NewRGBA(image.Rect(0, 0, test.w, test.h))
+			)
+			for y := range test.h {
+				for x := range test.w {
+					src.SetRGBA(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+				}
+			}
+			tassert.CheckFatal(t, test.enc(&buf, src))
+
+			r, mime, err := filter.NewReader(test.preset, &buf, 0)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, mime == test.mime, "expected %q, got %q", test.mime, mime)
+			dst, _, err := image.Decode(r)
+			tassert.CheckFatal(t, err)
+			r.Close()
+			b := dst.Bounds()
+			tassert.Errorf(t, b.Dx() == test.ew && b.Dy() == test.eh,
+				"expected %dx%d, got %dx%d", test.ew, test.eh, b.Dx(), b.Dy())
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, name := range filter.All() {
+		tassert.CheckError(t, filter.Validate(name))
+	}
+	err := filter.Validate("img-huge")
+	tassert.Fatalf(t, filter.IsErrUnknown(err), "expected unknown filter error, got %v", err)
+
+	_, _, err = filter.NewReader(filter.ImgThumb, bytes.NewReader([]byte("not an image")), 0)
+	tassert.Errorf(t, filter.IsErrFilter(err), "expected image decoding to fail, got %v", err)
+}
+
+func encJPEG(w io.Writer, img image.Image) error { return jpeg.Encode(w, img, nil) }
@@ -0,0 +1,117 @@
+// Package filter provides lightweight built-in transformations that can be applied
+// to object content on the fly (GET) without deploying ETL
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package filter
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // (decode only)
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+const jpegQuality = 85
+
+// decode (synchronously) and transform the image, and then encode it
+// in the source format (JPEG or PNG; other formats => PNG)
+// - reject images larger than maxPixels (width * height) prior to decoding
+func newImgReader(r io.Reader, side int, maxPixels int64) (io.ReadCloser, string, error) {
+	var hdr bytes.Buffer
+	cfg, _, err := image.DecodeConfig(io.TeeReader(r, &hdr))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image config: %w", err)
+	}
+	if n := int64(cfg.Width) * int64(cfg.Height); n > maxPixels {
+		return nil, "", fmt.Errorf("image %dx%d is too large (%d pixels, max %d)", cfg.Width, cfg.Height, n, maxPixels)
+	}
+	src, format, err := image.Decode(io.MultiReader(&hdr, r))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image: %w", err)
+	}
+	var dst image.Image
+	if side == 0 {
+		dst = cropSquare(src)
+	} else {
+		dst = fit(src, side, side)
+	}
+
+	mime := cos.ContentPNG
+	if format == "jpeg" {
+		mime = cos.ContentJPEG
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		var err error
+		if format == "jpeg" {
+			err = jpeg.Encode(pw, dst, &jpeg.Options{Quality: jpegQuality})
+		} else {
+			err = png.Encode(pw, dst)
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr, mime, nil
+}
+
+func cropSquare(src image.Image) image.Image {
+	b := src.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0, y0 := b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2
+	rect := image.Rect(x0, y0, x0+side, y0+side)
+	if sub, ok := src.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return sub.SubImage(rect)
+	}
+	return resample(src, rect, side, side)
+}
+
+// resize to fit maxW x maxH, preserving aspect ratio; never upscale
+func fit(src image.Image, maxW, maxH int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxW && h <= maxH {
+		return src
+	}
+	scale := min(float64(maxW)/float64(w), float64(maxH)/float64(h))
+	dw, dh := max(int(float64(w)*scale), 1), max(int(float64(h)*scale), 1)
+	return resample(src, b, dw, dh)
+}
+
+// box filter: each destination pixel is the average of the source pixels it covers
+func resample(src image.Image, rect image.Rectangle, dw, dh int) *image.RGBA {
+	var (
+		dst  = image.NewRGBA(image.Rect(0, 0, dw, dh))
+		w, h = rect.Dx(), rect.Dy()
+	)
+	for y := range dh {
+		y0 := rect.Min.Y + y*h/dh
+		y1 := max(rect.Min.Y+(y+1)*h/dh, y0+1)
+		for x := range dw {
+			x0 := rect.Min.X + x*w/dw
+			x1 := max(rect.Min.X+(x+1)*w/dw, x0+1)
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return dst
+}
@@ -33,6 +33,13 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// latest post-rebalance verification report (target)
+	RebVerify = ".ais.rebverify"
+
+	// history of recent versions (see meta.MetaHist)
+	SmapHist = Smap + ".hist"
+	BmdHist  = Bmd + ".hist"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
@@ -6,11 +6,15 @@
 package cmn
 
 import (
+	"reflect"
+	"strings"
 	"sync"
 	ratomic "sync/atomic"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 )
 
 // GCO (Global Config Owner) is responsible for updating and notifying
@@ -23,6 +27,20 @@ type (
 		oc       ratomic.Pointer[ConfigToSet] // for a node to override inherited (global) configuration
 		confPath ratomic.Pointer[string]      // initial (plain-text) global config path
 		mtx      sync.Mutex                   // [BeginUpdate -- CommitUpdate]
+		subs     struct {
+			m   map[int64]*confSub
+			mu  sync.RWMutex
+			cnt int64
+		}
+	}
+
+	// config change callback (see Subscribe)
+	ConfigCB func(oldConf, newConf *Config)
+
+	confSub struct {
+		cb      ConfigCB
+		section string
+		idx     []int // section's field index in Config (nil: any change)
 	}
 )
 
@@ -42,9 +60,10 @@ func init() {
 func (gco *gco) Get() *Config { return gco.c.Load() }
 
 func (gco *gco) Put(config *Config) {
-	gco.c.Store(config)
+	oconfig := gco.c.Swap(config)
 	// update assorted read-mostly knobs
 	Rom.Set(&config.ClusterConfig)
+	gco.notify(oconfig, config)
 }
 
 func (gco *gco) GetOverride() *ConfigToSet       { return gco.oc.Load() }
@@ -92,8 +111,9 @@ func (gco *gco) BeginUpdate() *Config {
 // CommitUpdate finalizes config update and notifies listeners.
 // NOTE: `ais` package must use config-owner to modify config.
 func (gco *gco) CommitUpdate(config *Config) {
-	gco.c.Store(config)
+	oconfig := gco.c.Swap(config)
 	gco.mtx.Unlock()
+	gco.notify(oconfig, config)
 }
 
 // DiscardUpdate discards commit updates.
@@ -120,3 +140,93 @@ func (gco *gco) Update(cluConfig *ClusterConfig) (err error) {
 	gco.Put(config)
 	return
 }
+
+//
+// config change subscriptions
+//
+
+// Subscribe registers a callback to be invoked upon any runtime change of a given config
+// section, where section is the section's JSON name, e.g. "lru", "space", "downloader"
+// (empty section: any change).
+//   - callbacks execute synchronously, in the context of the config update - must not block
+//     and must not update config;
+//   - returns function to unsubscribe.
+func (gco *gco) Subscribe(section string, cb ConfigCB) (unsubscribe func()) {
+	sub := &confSub{cb: cb, section: section}
+	if section != "" {
+		if sub.idx = configSectionIdx(section); sub.idx == nil {
+			debug.Assertf(false, "invalid config section %q", section)
+			nlog.Errorf("cannot subscribe to config changes: invalid section %q", section)
+			return func() {}
+		}
+	}
+	gco.subs.mu.Lock()
+	if gco.subs.m == nil {
+		gco.subs.m = make(map[int64]*confSub, 4)
+	}
+	gco.subs.cnt++
+	id := gco.subs.cnt
+	gco.subs.m[id] = sub
+	gco.subs.mu.Unlock()
+
+	return func() {
+		gco.subs.mu.Lock()
+		delete(gco.subs.m, id)
+		gco.subs.mu.Unlock()
+	}
+}
+
+func (gco *gco) notify(oconfig, nconfig *Config) {
+	gco.subs.mu.RLock()
+	if len(gco.subs.m) == 0 {
+		gco.subs.mu.RUnlock()
+		return
+	}
+	subs := make([]*confSub, 0, len(gco.subs.m))
+	for _, sub := range gco.subs.m {
+		subs = append(subs, sub)
+	}
+	gco.subs.mu.RUnlock()
+
+	var (
+		ov = reflect.ValueOf(oconfig).Elem()
+		nv = reflect.ValueOf(nconfig).Elem()
+	)
+	for _, sub := range subs {
+		if sub.idx == nil {
+			if oconfig != nconfig {
+				sub.cb(oconfig, nconfig)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(ov.FieldByIndex(sub.idx).Interface(), nv.FieldByIndex(sub.idx).Interface()) {
+			sub.cb(oconfig, nconfig)
+		}
+	}
+}
+
+// resolve section name (JSON tag) into Config's field index, including inline-embedded
+// LocalConfig and ClusterConfig
+func configSectionIdx(section string) []int {
+	return _sectionIdx(reflect.TypeOf(Config{}), section)
+}
+
+func _sectionIdx(t reflect.Type, section string) []int {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Anonymous && name == "" && strings.Contains(opts, "inline") {
+			if idx := _sectionIdx(f.Type, section); idx != nil {
+				return append([]int{i}, idx...)
+			}
+			continue
+		}
+		if name == section {
+			return []int{i}
+		}
+	}
+	return nil
+}
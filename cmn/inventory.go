@@ -0,0 +1,93 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// AIS-native bucket inventory (compare with S3 inventory in ais/s3/inventory.go):
+// - generated by x-make-inventory (xact/xs/inventory.go) for any bucket;
+// - each target writes a single CSV part that lists all objects it stores;
+// - all parts are stored in the same bucket as "<NativeInvPrefix>/<inventory ID>/part-<N>.csv"
+// - inventory ID is the ID of the (cluster-wide) x-make-inventory that generated it
+
+const (
+	NativeInvPrefix = ".ais-inventory"
+	NativeInvExt    = ".csv"
+
+	nativeInvPart = "part-"
+)
+
+// CSV columns
+var NativeInvHeader = []string{"name", "size", "checksum_type", "checksum", "version", "atime"}
+
+func NativeInvDir(id string) string {
+	return NativeInvPrefix + cos.PathSeparator + id + cos.PathSeparator
+}
+
+func NativeInvPartName(id string, n int) string {
+	return NativeInvDir(id) + nativeInvPart + strconv.Itoa(n) + NativeInvExt
+}
+
+// returns inventory ID given a part name, or "" if `objName` is not an inventory part
+func NativeInvID(objName string) string {
+	s, ok := strings.CutPrefix(objName, NativeInvPrefix+cos.PathSeparator)
+	if !ok {
+		return ""
+	}
+	id, part, ok := strings.Cut(s, cos.PathSeparator)
+	if !ok || !strings.HasPrefix(part, nativeInvPart) || !strings.HasSuffix(part, NativeInvExt) {
+		return ""
+	}
+	return id
+}
+
+func IsNativeInvObj(objName string) bool {
+	return strings.HasPrefix(objName, NativeInvPrefix+cos.PathSeparator)
+}
+
+func NativeInvRecord(name string, size int64, cksum *cos.Cksum, version string, atime int64) []string {
+	var ty, val string
+	if cksum != nil && !cksum.IsEmpty() {
+		ty, val = cksum.Get()
+	}
+	var at string
+	if atime != 0 {
+		at = time.Unix(0, atime).UTC().Format(time.RFC3339Nano)
+	}
+	return []string{name, strconv.FormatInt(size, 10), ty, val, version, at}
+}
+
+// parse CSV record into list-objects entry; atime is formatted as per `timeFormat` (see LsoMsg.TimeFormat)
+func ParseNativeInvRecord(rec []string, timeFormat string) (*LsoEnt, error) {
+	if len(rec) != len(NativeInvHeader) {
+		return nil, fmt.Errorf("invalid inventory record %q: expecting %d fields", rec, len(NativeInvHeader))
+	}
+	if rec[0] == "" {
+		return nil, errors.New("invalid inventory record: empty object name")
+	}
+	size, err := strconv.ParseInt(rec[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inventory record %q: %v", rec, err)
+	}
+	en := &LsoEnt{Name: rec[0], Size: size, Checksum: rec[3], Version: rec[4], Flags: apc.EntryIsCached}
+	if rec[5] != "" {
+		atime, err := time.Parse(time.RFC3339Nano, rec[5])
+		if err != nil {
+			return nil, fmt.Errorf("invalid inventory record %q: %v", rec, err)
+		}
+		en.Atime = cos.FormatNanoTime(atime.UnixNano(), timeFormat)
+	}
+	return en, nil
+}
@@ -45,6 +45,10 @@ func SetPost(logToStderr bool, maxSize int64) {
 
 func SetTitle(s string) { title = s }
 
+// structured logging: one JSON object per line (see json.go)
+func SetJSON(v bool)       { jsonFmt = v }
+func SetNode(sname string) { node = sname }
+
 // see also: `logtypes` in stats/common
 func InfoLogName() string { return sname() + ".INFO" }
 func ErrLogName() string  { return sname() + ".ERROR" }
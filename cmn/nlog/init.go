@@ -36,6 +36,9 @@ var (
 	arg0    string
 	aisrole string
 	title   string
+	node    string // (JSON only)
+
+	jsonFmt bool // config.log.format == "json"
 
 	pid int
 
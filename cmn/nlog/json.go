@@ -0,0 +1,103 @@
+// Package nlog - aistore logger, provides buffering, timestamping, writing, and
+// flushing/syncing/rotating
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nlog
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Structured (JSON) logging - enabled via config.log.format = "json".
+// Each log record is a single line, e.g.:
+//
+// {"ts":"2024-05-01T10:20:30.123456-07:00","level":"info","module":"tgtobj","msg":"...","fields":{"line":123,"role":"target","node":"t[xyz]"}}
+//
+// where:
+//   - ts:     RFC 3339 timestamp with microseconds;
+//   - level:  "info" | "warning" | "error";
+//   - module: Go source file that logged the record (without ".go"); omitted for redacted files;
+//   - msg:    the message itself (a record that does not fit maxLineSize gets truncated);
+//   - fields: source line, node role and (once known) node name.
+
+const (
+	jsonStamp   = "2006-01-02T15:04:05.000000Z07:00"
+	jsonReserve = 256 // to close a possibly truncated record
+)
+
+var sevJSON = []string{sevInfo: "info", sevWarn: "warning", sevErr: "error"}
+
+func (fb *fixed) writeJSON(sev severity, fn string, ln int, msg string) {
+	fb.writeString(`{"ts":"`)
+	fb.writeString(time.Now().Format(jsonStamp))
+	fb.writeString(`","level":"`)
+	fb.writeString(sevJSON[sev])
+	if fn != "" {
+		fb.writeString(`","module":"`)
+		fb.writeString(fn)
+	}
+	fb.writeString(`","msg":"`)
+	fb.writeEscaped(strings.TrimRight(msg, "\n"))
+	fb.writeString(`","fields":{`)
+	sepa := ""
+	if ln > 0 {
+		fb.writeString(`"line":`)
+		fb.writeString(strconv.Itoa(ln))
+		sepa = ","
+	}
+	if aisrole != "" {
+		fb.writeString(sepa + `"role":"`)
+		fb.writeEscaped(aisrole)
+		fb.writeByte('"')
+		sepa = ","
+	}
+	if node != "" {
+		fb.writeString(sepa + `"node":"`)
+		fb.writeEscaped(node)
+		fb.writeByte('"')
+	}
+	fb.writeString("}}\n")
+}
+
+// JSON string escaping; invalid UTF-8 is replaced with U+FFFD; truncates when running out of space
+func (fb *fixed) writeEscaped(s string) {
+	const hex = "0123456789abcdef"
+	for i := 0; i < len(s); i++ {
+		if fb.avail() < jsonReserve {
+			fb.writeString("...")
+			return
+		}
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				fb.writeString(`\ufffd`)
+			} else {
+				fb.writeString(s[i : i+size])
+			}
+			i += size - 1
+			continue
+		}
+		switch {
+		case c == '"' || c == '\\':
+			fb.writeByte('\\')
+			fb.writeByte(c)
+		case c == '\n':
+			fb.writeString(`\n`)
+		case c == '\t':
+			fb.writeString(`\t`)
+		case c == '\r':
+			fb.writeString(`\r`)
+		case c < 0x20:
+			fb.writeString(`\u00`)
+			fb.writeByte(hex[c>>4])
+			fb.writeByte(hex[c&0xf])
+		default:
+			fb.writeByte(c)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Package nlog - aistore logger, provides buffering, timestamping, writing, and
+// flushing/syncing/rotating
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package nlog
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type jsonRecord struct {
+	Ts     string `json:"ts"`
+	Level  string `json:"level"`
+	Module string `json:"module"`
+	Msg    string `json:"msg"`
+	Fields struct {
+		Line int    `json:"line"`
+		Role string `json:"role"`
+		Node string `json:"node"`
+	} `json:"fields"`
+}
+
+func parseRecord(t *testing.T, fb *fixed) *jsonRecord {
+	t.Helper()
+	b := fb.buf[:fb.woff]
+	tassert.Fatalf(t, len(b) > 0 && b[len(b)-1] == '\n' && strings.Count(string(b), "\n") == 1,
+		"expecting single line, got %q", b)
+	tassert.Fatalf(t, utf8.Valid(b), "invalid UTF-8: %q", b)
+	rec := &jsonRecord{}
+	tassert.CheckFatal(t, json.Unmarshal(b, rec))
+	return rec
+}
+
+func TestWriteJSON(t *testing.T) {
+	savedRole, savedNode := aisrole, node
+	aisrole, node = "target", `t["x"]`
+	defer func() { aisrole, node = savedRole, savedNode }()
+
+	tests := []struct {
+		msg, expected string
+	}{
+		{"plain message\n", "plain message"},
+		{`quotes "and" back\slash`, `quotes "and" back\slash`},
+		{"tab\tnewline\nreturn\r", "tab\tnewline\nreturn\r"},
+		{"ctrl \x00\x01\x1f\x7f", "ctrl \x00\x01\x1f\x7f"},
+		{"utf-8: héllo, 世界", "utf-8: héllo, 世界"},
+		{"invalid: \xff\xfe end", "invalid: �� end"},
+		{"truncated rune: \xe4\xb8", "truncated rune: ��"},
+	}
+	for _, test := range tests {
+		fb := &fixed{buf: make([]byte, maxLineSize)}
+		fb.writeJSON(sevWarn, "json_test", 42, test.msg)
+		rec := parseRecord(t, fb)
+		tassert.Errorf(t, rec.Msg == test.expected, "expected msg %q, got %q", test.expected, rec.Msg)
+		tassert.Errorf(t, rec.Level == "warning" && rec.Module == "json_test" && rec.Fields.Line == 42,
+			"wrong record %+v", rec)
+		tassert.Errorf(t, rec.Fields.Role == aisrole && rec.Fields.Node == node, "wrong fields %+v", rec.Fields)
+	}
+}
+
+func TestWriteJSONTruncate(t *testing.T) {
+	fb := &fixed{buf: make([]byte, maxLineSize)}
+	msg := strings.Repeat(`"\`, maxLineSize) // worst case: every byte escaped
+	fb.writeJSON(sevErr, "", 0, msg)
+	rec := parseRecord(t, fb)
+	tassert.Errorf(t, strings.HasSuffix(rec.Msg, "..."), "expecting truncated message")
+	tassert.Errorf(t, strings.HasPrefix(msg, strings.TrimSuffix(rec.Msg, "...")), "expecting message prefix")
+	tassert.Errorf(t, rec.Level == "error" && rec.Module == "" && rec.Fields.Line == 0, "wrong record %+v", rec)
+}
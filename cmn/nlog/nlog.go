@@ -232,6 +232,17 @@ func (nlog *nlog) rotate(now time.Time) (err error) {
 
 	nlog.written.Store(0)
 	nlog.erred.Store(false)
+	if jsonFmt {
+		line1 = "Started up at " + snow + ", " + s
+		if title != "" {
+			line1 = "Rotated at " + snow + ", " + s + title
+		}
+		fb := alloc()
+		fb.writeJSON(sevInfo, "", 0, line1)
+		_, err = fb.flush(nlog.file)
+		free(fb)
+		return
+	}
 	if title == "" {
 		line1 = "Started up at " + snow + ", " + s
 		_, err = nlog.file.WriteString(line1)
@@ -262,9 +273,9 @@ func logfname(tag string, t time.Time) (name, link string) {
 	return name, s + "." + tag
 }
 
-func formatHdr(s severity, depth int, fb *fixed) {
-	const char = "IWE"
-	_, fn, ln, ok := runtime.Caller(3 + depth)
+// returns the caller's source file (sans ".go") and line number
+func caller(depth int) (fn string, ln int, ok bool) {
+	_, fn, ln, ok = runtime.Caller(3 + depth)
 	if !ok {
 		return
 	}
@@ -275,13 +286,21 @@ func formatHdr(s severity, depth int, fb *fixed) {
 	if l := len(fn); l > 3 {
 		fn = fn[:l-3]
 	}
+	if _, redact := redactFnames[fn]; redact {
+		fn, ln = "", 0
+	}
+	return
+}
+
+func formatHdr(s severity, fn string, ln int, fb *fixed) {
+	const char = "IWE"
 	fb.writeByte(char[s])
 	fb.writeByte(' ')
 
 	fb.writeStamp()
 
 	fb.writeByte(' ')
-	if _, redact := redactFnames[fn]; redact {
+	if fn == "" {
 		return
 	}
 	fb.writeString(fn)
@@ -291,7 +310,20 @@ func formatHdr(s severity, depth int, fb *fixed) {
 }
 
 func sprintf(sev severity, depth int, format string, fb *fixed, args ...any) {
-	formatHdr(sev, depth+1, fb)
+	fn, ln, ok := caller(depth + 1)
+	if jsonFmt {
+		var msg string
+		if format == "" {
+			msg = fmt.Sprintln(args...)
+		} else {
+			msg = fmt.Sprintf(format, args...)
+		}
+		fb.writeJSON(sev, fn, ln, msg)
+		return
+	}
+	if ok {
+		formatHdr(sev, fn, ln, fb)
+	}
 	if format == "" {
 		fmt.Fprintln(fb, args...)
 	} else {
@@ -38,6 +38,10 @@ const (
 
 	// additional backend
 	LastModified = "LastModified"
+
+	// S3 server-side encryption: algorithm ("AES256", "aws:kms") and KMS key ID, if any
+	SSEObjMD       = "sse"
+	SSEKMSKeyObjMD = "sse_kms_key_id"
 )
 
 // object properties
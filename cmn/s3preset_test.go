@@ -0,0 +1,47 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestS3Presets(t *testing.T) {
+	tests := []struct {
+		preset, region      string
+		endpoint, signing   string
+		pathStyle           bool
+		expectedMaxPageSize int64
+	}{
+		{cmn.S3PresetSpaces, "", "https://nyc3.digitaloceanspaces.com", "us-east-1", false, 1000},
+		{cmn.S3PresetSpaces, "fra1", "https://fra1.digitaloceanspaces.com", "us-east-1", false, 1000},
+		{cmn.S3PresetB2, "", "https://s3.us-west-004.backblazeb2.com", "us-west-004", true, 1000},
+		{cmn.S3PresetB2, "eu-central-003", "https://s3.eu-central-003.backblazeb2.com", "eu-central-003", true, 1000},
+	}
+	for _, test := range tests {
+		p, err := cmn.GetS3Preset(test.preset)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, p.EndpointFor(test.region) == test.endpoint,
+			"%s[%s]: expected endpoint %q, got %q", test.preset, test.region, test.endpoint, p.EndpointFor(test.region))
+		tassert.Errorf(t, p.Signing(test.region) == test.signing,
+			"%s[%s]: expected signing region %q, got %q", test.preset, test.region, test.signing, p.Signing(test.region))
+		tassert.Errorf(t, p.PathStyle == test.pathStyle, "%s: unexpected path style %t", test.preset, p.PathStyle)
+		tassert.Errorf(t, p.MaxPageSize == test.expectedMaxPageSize, "%s: unexpected max page size %d", test.preset, p.MaxPageSize)
+	}
+
+	p, err := cmn.GetS3Preset("")
+	tassert.Errorf(t, p == nil && err == nil, "expecting no preset and no error")
+
+	extra := cmn.ExtraProps{AWS: cmn.ExtraPropsAWS{Preset: "wasabi"}}
+	tassert.Errorf(t, extra.ValidateAsProps(apc.AWS) != nil, "expecting invalid preset error")
+	extra.AWS.Preset = cmn.S3PresetB2
+	tassert.CheckError(t, extra.ValidateAsProps(apc.AWS))
+}
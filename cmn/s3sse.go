@@ -0,0 +1,77 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// S3 server-side encryption (bucket props: extra.aws.sse*), applied to all
+// backend requests that read or write object data:
+// - SSE-S3 and SSE-KMS: PUT and multipart upload
+// - SSE-C: PUT, multipart upload, GET, and HEAD (the same key is required to read)
+//
+// SSE-C key material is never stored in the BMD - bucket props only contain the name
+// of the environment variable (on each target) that holds the base64-encoded key
+// (e.g., exported by the secrets provider - see config.Secrets).
+
+const (
+	S3SSES3  = "sse-s3"  // Amazon S3 managed keys ("AES256")
+	S3SSEKMS = "sse-kms" // AWS KMS key ("aws:kms"); default (AWS managed) key unless extra.aws.sse_kms_key_id
+	S3SSEC   = "sse-c"   // customer-provided key ("AES256")
+
+	s3SSECKeySize = 32 // AES256
+)
+
+var envNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (c *ExtraPropsAWS) validateSSE() error {
+	switch c.SSE {
+	case "", S3SSES3, S3SSEKMS, S3SSEC:
+	default:
+		return fmt.Errorf("invalid extra.aws.sse %q (expecting one of: %q, %q, %q, or empty)", c.SSE, S3SSES3, S3SSEKMS, S3SSEC)
+	}
+	if c.SSEKMSKeyID != "" && c.SSE != S3SSEKMS {
+		return fmt.Errorf("extra.aws.sse_kms_key_id requires extra.aws.sse=%q", S3SSEKMS)
+	}
+	if c.SSE == S3SSEC {
+		if c.SSECustomerKeyEnv == "" {
+			return fmt.Errorf("extra.aws.sse=%q requires extra.aws.sse_customer_key_env (name of the environment variable with base64-encoded key)",
+				S3SSEC)
+		}
+		if !envNameRegex.MatchString(c.SSECustomerKeyEnv) {
+			return fmt.Errorf("invalid extra.aws.sse_customer_key_env %q (expecting environment variable name)", c.SSECustomerKeyEnv)
+		}
+	} else if c.SSECustomerKeyEnv != "" {
+		return fmt.Errorf("extra.aws.sse_customer_key_env requires extra.aws.sse=%q", S3SSEC)
+	}
+	return nil
+}
+
+// with SSE-KMS and SSE-C, ETag is not MD5 of the object data
+func (c *ExtraPropsAWS) ETagIsMD5() bool { return c.SSE != S3SSEKMS && c.SSE != S3SSEC }
+
+// SSE-C: base64-encoded key and its base64-encoded MD5 (as per x-amz-server-side-encryption-customer-key*)
+// NOTE: errors must not include the key
+func (c *ExtraPropsAWS) SSECustomerKey() (key, keyMD5 string, _ error) {
+	b64 := os.Getenv(c.SSECustomerKeyEnv)
+	if b64 == "" {
+		return "", "", fmt.Errorf("SSE-C key not found: environment variable %q is not set", c.SSECustomerKeyEnv)
+	}
+	raw, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return "", "", fmt.Errorf("SSE-C key in %q: not a valid base64 encoding", c.SSECustomerKeyEnv)
+	}
+	if len(raw) != s3SSECKeySize {
+		return "", "", fmt.Errorf("SSE-C key in %q: expecting 256-bit key", c.SSECustomerKeyEnv)
+	}
+	sum := md5.Sum(raw)
+	return b64, base64.StdEncoding.EncodeToString(sum[:]), nil
+}
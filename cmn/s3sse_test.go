@@ -0,0 +1,67 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestS3SSE(t *testing.T) {
+	tests := []struct {
+		props cmn.ExtraPropsAWS
+		valid bool
+	}{
+		{cmn.ExtraPropsAWS{}, true},
+		{cmn.ExtraPropsAWS{SSE: cmn.S3SSES3}, true},
+		{cmn.ExtraPropsAWS{SSE: cmn.S3SSEKMS}, true},
+		{cmn.ExtraPropsAWS{SSE: cmn.S3SSEKMS, SSEKMSKeyID: "alias/ais"}, true},
+		{cmn.ExtraPropsAWS{SSE: cmn.S3SSEC, SSECustomerKeyEnv: "AIS_SSEC_KEY"}, true},
+		{cmn.ExtraPropsAWS{SSE: "aes"}, false},
+		{cmn.ExtraPropsAWS{SSE: cmn.S3SSES3, SSEKMSKeyID: "alias/ais"}, false},
+		{cmn.ExtraPropsAWS{SSE: cmn.S3SSEC}, false},
+		{cmn.ExtraPropsAWS{SSE: cmn.S3SSEC, SSECustomerKeyEnv: "AIS-KEY"}, false},
+		{cmn.ExtraPropsAWS{SSECustomerKeyEnv: "AIS_SSEC_KEY"}, false},
+	}
+	for _, test := range tests {
+		extra := cmn.ExtraProps{AWS: test.props}
+		err := extra.ValidateAsProps(apc.AWS)
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: expected valid=%t, got %v", test.props, test.valid, err)
+	}
+
+	// SSE-C key via environment
+	const env = "AIS_TEST_SSEC_KEY"
+	var (
+		raw   = []byte(strings.Repeat("k", 32))
+		b64   = base64.StdEncoding.EncodeToString(raw)
+		sum   = md5.Sum(raw)
+		props = cmn.ExtraPropsAWS{SSE: cmn.S3SSEC, SSECustomerKeyEnv: env}
+	)
+	_, _, err := props.SSECustomerKey()
+	tassert.Errorf(t, err != nil, "expecting error when %q is not set", env)
+
+	t.Setenv(env, base64.StdEncoding.EncodeToString(raw[:16]))
+	_, _, err = props.SSECustomerKey()
+	tassert.Errorf(t, err != nil, "expecting invalid key size error")
+	tassert.Errorf(t, err == nil || !strings.Contains(err.Error(), base64.StdEncoding.EncodeToString(raw[:16])),
+		"error must not contain the key: %v", err)
+
+	t.Setenv(env, b64)
+	key, keyMD5, err := props.SSECustomerKey()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, key == b64, "unexpected key")
+	tassert.Errorf(t, keyMD5 == base64.StdEncoding.EncodeToString(sum[:]), "unexpected key MD5 %q", keyMD5)
+
+	tassert.Errorf(t, !props.ETagIsMD5(), "SSE-C: ETag is not MD5")
+	tassert.Errorf(t, (&cmn.ExtraPropsAWS{SSE: cmn.S3SSES3}).ETagIsMD5(), "SSE-S3: ETag is MD5")
+}
@@ -0,0 +1,18 @@
+//go:build !aws
+
+// Package secrets resolves backend credentials via external secrets providers:
+// HashiCorp Vault, AWS Secrets Manager, and Kubernetes secrets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package secrets
+
+import (
+	"errors"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func newASM(*cmn.SecretsASMConf) (Provider, error) {
+	return nil, errors.New("asm: AWS Secrets Manager requires building with 'aws' tag")
+}
@@ -0,0 +1,208 @@
+// Package secrets resolves backend credentials via external secrets providers:
+// HashiCorp Vault, AWS Secrets Manager, and Kubernetes secrets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Each secret is a set of environment variables that, once exported,
+// get picked up by the respective backend SDK upon (re)initialization, e.g.:
+// - aws:   AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN
+// - gcp:   GOOGLE_APPLICATION_CREDENTIALS (file path or, alternatively, JSON content)
+// - azure: AZURE_STORAGE_ACCOUNT, AZURE_STORAGE_KEY
+// - oci:   OCI_TENANCY_OCID, OCI_USER_OCID, OCI_FINGERPRINT, OCI_PRIVATE_KEY, ...
+// Only the keys listed in `allowedEnv` are exported; keys that disappear upon rotation
+// (or removal of the secret ID) get unset.
+
+type (
+	Secret map[string]string
+
+	Provider interface {
+		Name() string
+		Get(ctx context.Context, id string) (Secret, error)
+	}
+
+	// Store caches the last resolved secrets to detect rotation
+	Store struct {
+		prov    Provider
+		conf    cmn.SecretsConf
+		secrets map[string]Secret // by backend provider
+		dir     string            // where to write file-based credentials
+		mu      sync.Mutex
+	}
+)
+
+const (
+	envGCPCreds = "GOOGLE_APPLICATION_CREDENTIALS"
+
+	reqTimeout = 30 * time.Second
+)
+
+var backends = []string{apc.AWS, apc.GCP, apc.Azure, apc.OCI}
+
+// environment variables that a given secret is permitted to set;
+// any other key (think PATH, LD_PRELOAD, HTTP_PROXY) is rejected
+var allowedEnv = map[string][]string{
+	apc.AWS:   {"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_REGION", "AWS_PROFILE", "S3_ENDPOINT"},
+	apc.GCP:   {envGCPCreds, "GOOGLE_CLOUD_PROJECT"},
+	apc.Azure: {"AZURE_STORAGE_ACCOUNT", "AZURE_STORAGE_KEY", "AIS_AZURE_URL", "AIS_AZURE_PROTO"},
+	apc.OCI: {"OCI_TENANCY_OCID", "OCI_COMPARTMENT_OCID", "OCI_USER_OCID", "OCI_REGION", "OCI_FINGERPRINT",
+		"OCI_PRIVATE_KEY"},
+}
+
+func New(conf *cmn.SecretsConf) (Provider, error) {
+	switch conf.Provider {
+	case cmn.SecretsVault:
+		return newVault(&conf.Vault)
+	case cmn.SecretsASM:
+		return newASM(&conf.ASM)
+	case cmn.SecretsK8s:
+		return newK8s(&conf.K8s), nil
+	case "":
+		return nil, errors.New("secrets provider not configured")
+	}
+	return nil, fmt.Errorf("unknown secrets provider %q", conf.Provider)
+}
+
+///////////
+// Store //
+///////////
+
+// dir: local (non-shared) directory to store file-based credentials
+func NewStore(dir string) *Store {
+	return &Store{dir: dir, secrets: make(map[string]Secret, len(backends))}
+}
+
+// Resolve (re)reads all configured secrets and exports the ones that have changed;
+// returns the list of backend providers that need to be reinitialized.
+// A failure to resolve one secret does not prevent resolving the others -
+// the respective backend simply keeps using its current credentials.
+func (s *Store) Resolve(ctx context.Context, conf *cmn.SecretsConf) (changed []string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if conf.Provider == "" {
+		s.prov = nil
+		for provider, prev := range s.secrets {
+			unexport(prev, nil)
+			changed = append(changed, provider)
+		}
+		clear(s.secrets)
+		sort.Strings(changed)
+		return changed, nil
+	}
+	if s.prov == nil || s.conf.Provider != conf.Provider || s.conf.Vault != conf.Vault ||
+		s.conf.ASM != conf.ASM || s.conf.K8s != conf.K8s {
+		if s.prov, err = New(conf); err != nil {
+			return nil, err
+		}
+		s.conf = *conf
+	}
+
+	var errs []error
+	for _, provider := range backends {
+		id := conf.SecretID(provider)
+		if id == "" {
+			if prev, ok := s.secrets[provider]; ok {
+				unexport(prev, nil)
+				delete(s.secrets, provider)
+				changed = append(changed, provider)
+			}
+			continue
+		}
+		secret, errV := s.prov.Get(ctx, id)
+		if errV != nil {
+			errs = append(errs, fmt.Errorf("%s: failed to get %q secret for %q: %w", s.prov.Name(), id, provider, errV))
+			continue
+		}
+		prev, ok := s.secrets[provider]
+		if ok && maps.Equal(prev, secret) {
+			continue
+		}
+		if errV := export(provider, secret, s.dir); errV != nil {
+			errs = append(errs, errV)
+			continue
+		}
+		unexport(prev, secret) // keys that are no longer present
+		s.secrets[provider] = secret
+		changed = append(changed, provider)
+	}
+	return changed, errors.Join(errs...)
+}
+
+// export secret's key-value pairs into the process environment
+// (all-or-nothing with respect to the allowlist)
+func export(provider string, secret Secret, dir string) error {
+	keys := make([]string, 0, len(secret))
+	for k := range secret {
+		if !slices.Contains(allowedEnv[provider], k) {
+			return fmt.Errorf("%s: secret key %q is not permitted (expecting one of %v)", provider, k, allowedEnv[provider])
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := secret[k]
+		if k == envGCPCreds && strings.HasPrefix(strings.TrimSpace(v), "{") {
+			// the SDK expects a file - write JSON content out
+			fqn, err := writeCreds(dir, provider, []byte(v))
+			if err != nil {
+				return err
+			}
+			v = fqn
+		}
+		if err := os.Setenv(k, v); err != nil {
+			return fmt.Errorf("%s: failed to export %q: %w", provider, k, err)
+		}
+	}
+	return nil
+}
+
+// unset previously exported keys that are not present in the current secret (nil: all)
+func unexport(prev, cur Secret) {
+	for k := range prev {
+		if _, ok := cur[k]; !ok {
+			os.Unsetenv(k)
+		}
+	}
+}
+
+// write atomically (via temp + rename) so that concurrent readers never see partial content
+func writeCreds(dir, provider string, b []byte) (string, error) {
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	if err := cos.CreateDir(dir); err != nil {
+		return "", err
+	}
+	var (
+		fqn = filepath.Join(dir, provider+"-creds.json")
+		tmp = fqn + ".tmp"
+	)
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, fqn); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return fqn, nil
+}
@@ -0,0 +1,190 @@
+// Package secrets resolves backend credentials via external secrets providers:
+// HashiCorp Vault, AWS Secrets Manager, and Kubernetes secrets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package secrets_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/secrets"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// save and restore (at cleanup time) the environment variables the test may modify
+func saveEnv(t *testing.T, names ...string) {
+	for _, name := range names {
+		orig, had := os.LookupEnv(name)
+		t.Cleanup(func() {
+			if had {
+				os.Setenv(name, orig)
+			} else {
+				os.Unsetenv(name)
+			}
+		})
+	}
+}
+
+func TestVault(t *testing.T) {
+	saveEnv(t, "AWS_ACCESS_KEY_ID")
+	key := "v1"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "tok" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		if r.URL.Path != "/v1/kv/data/ais/aws" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"data":{"AWS_ACCESS_KEY_ID":"` + key + `"},"metadata":{"version":1}}}`))
+	}))
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	tassert.CheckFatal(t, os.WriteFile(tokenFile, []byte("tok\n"), 0o600))
+
+	conf := &cmn.SecretsConf{
+		Provider: cmn.SecretsVault,
+		AWS:      "ais/aws",
+		Vault:    cmn.SecretsVaultConf{Addr: srv.URL + "/", Mount: "kv", TokenFile: tokenFile},
+	}
+	tassert.CheckFatal(t, conf.Validate())
+
+	store := secrets.NewStore(t.TempDir())
+	changed, err := store.Resolve(context.Background(), conf)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(changed) == 1 && changed[0] == apc.AWS, "expected [aws], got %v", changed)
+	tassert.Errorf(t, os.Getenv("AWS_ACCESS_KEY_ID") == "v1", "expected exported value")
+
+	// unchanged
+	changed, err = store.Resolve(context.Background(), conf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(changed) == 0, "expected no changes, got %v", changed)
+
+	// rotated
+	key = "v2"
+	changed, err = store.Resolve(context.Background(), conf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(changed) == 1, "expected rotation, got %v", changed)
+	tassert.Errorf(t, os.Getenv("AWS_ACCESS_KEY_ID") == "v2", "expected rotated value")
+
+	// not found
+	conf.AWS = "ais/none"
+	_, err = store.Resolve(context.Background(), conf)
+	tassert.Errorf(t, err != nil, "expected error")
+}
+
+func TestExportAllowlist(t *testing.T) {
+	saveEnv(t, "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "LD_PRELOAD")
+	os.Unsetenv("AWS_SESSION_TOKEN")
+
+	dir := t.TempDir()
+	write := func(kvs map[string]string) {
+		sdir := filepath.Join(dir, "aws-creds")
+		tassert.CheckFatal(t, os.RemoveAll(sdir))
+		tassert.CheckFatal(t, os.MkdirAll(sdir, 0o755))
+		for k, v := range kvs {
+			tassert.CheckFatal(t, os.WriteFile(filepath.Join(sdir, k), []byte(v), 0o600))
+		}
+	}
+	var (
+		conf  = &cmn.SecretsConf{Provider: cmn.SecretsK8s, AWS: "aws-creds", K8s: cmn.SecretsK8sConf{Dir: dir}}
+		store = secrets.NewStore(t.TempDir())
+	)
+
+	// not permitted: nothing gets exported
+	write(map[string]string{"AWS_ACCESS_KEY_ID": "id0", "LD_PRELOAD": "/tmp/evil.so"})
+	os.Unsetenv("LD_PRELOAD")
+	changed, err := store.Resolve(context.Background(), conf)
+	tassert.Errorf(t, err != nil, "expected error")
+	tassert.Errorf(t, len(changed) == 0, "expected no changes, got %v", changed)
+	_, had := os.LookupEnv("LD_PRELOAD")
+	tassert.Errorf(t, !had, "LD_PRELOAD must not be exported")
+
+	write(map[string]string{"AWS_ACCESS_KEY_ID": "id1", "AWS_SECRET_ACCESS_KEY": "key1", "AWS_SESSION_TOKEN": "tok1"})
+	changed, err = store.Resolve(context.Background(), conf)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(changed) == 1, "expected [aws], got %v", changed)
+	tassert.Errorf(t, os.Getenv("AWS_SESSION_TOKEN") == "tok1", "expected exported session token")
+
+	// rotated without session token: the stale one gets unset
+	write(map[string]string{"AWS_ACCESS_KEY_ID": "id2", "AWS_SECRET_ACCESS_KEY": "key2"})
+	changed, err = store.Resolve(context.Background(), conf)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(changed) == 1, "expected [aws], got %v", changed)
+	tassert.Errorf(t, os.Getenv("AWS_ACCESS_KEY_ID") == "id2", "expected rotated value")
+	_, had = os.LookupEnv("AWS_SESSION_TOKEN")
+	tassert.Errorf(t, !had, "stale AWS_SESSION_TOKEN must be unset")
+
+	// secret ID removed: all previously exported keys get unset
+	conf.AWS = ""
+	changed, err = store.Resolve(context.Background(), conf)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(changed) == 1 && changed[0] == apc.AWS, "expected [aws], got %v", changed)
+	for _, k := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"} {
+		_, had = os.LookupEnv(k)
+		tassert.Errorf(t, !had, "%s must be unset", k)
+	}
+}
+
+func TestK8s(t *testing.T) {
+	dir := t.TempDir()
+	sdir := filepath.Join(dir, "gcp-creds")
+	tassert.CheckFatal(t, os.MkdirAll(filepath.Join(sdir, "..data"), 0o755))
+	creds := `{"type": "service_account"}`
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(sdir, "GOOGLE_APPLICATION_CREDENTIALS"), []byte(creds), 0o600))
+
+	conf := &cmn.SecretsConf{Provider: cmn.SecretsK8s, GCP: "gcp-creds", K8s: cmn.SecretsK8sConf{Dir: dir}}
+	prov, err := secrets.New(conf)
+	tassert.CheckFatal(t, err)
+	secret, err := prov.Get(context.Background(), "gcp-creds")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(secret) == 1, "expected one key, got %v", secret)
+
+	// JSON credentials get written out to a file
+	orig, had := os.LookupEnv("GOOGLE_APPLICATION_CREDENTIALS")
+	defer func() {
+		if had {
+			os.Setenv("GOOGLE_APPLICATION_CREDENTIALS", orig)
+		} else {
+			os.Unsetenv("GOOGLE_APPLICATION_CREDENTIALS")
+		}
+	}()
+	credsDir := t.TempDir()
+	changed, err := secrets.NewStore(credsDir).Resolve(context.Background(), conf)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(changed) == 1 && changed[0] == apc.GCP, "expected [gcp], got %v", changed)
+	fqn := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	tassert.Fatalf(t, filepath.Dir(fqn) == credsDir, "expected creds file in %q, got %q", credsDir, fqn)
+	b, err := os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == creds, "expected %q, got %q", creds, b)
+}
+
+func TestSecretsConfValidate(t *testing.T) {
+	tests := []struct {
+		conf  cmn.SecretsConf
+		valid bool
+	}{
+		{cmn.SecretsConf{}, true},
+		{cmn.SecretsConf{Provider: cmn.SecretsK8s}, true},
+		{cmn.SecretsConf{Provider: cmn.SecretsVault}, false},
+		{cmn.SecretsConf{Provider: "unknown"}, false},
+		{cmn.SecretsConf{Provider: cmn.SecretsASM, Refresh: 1}, false},
+	}
+	for _, test := range tests {
+		err := test.conf.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "%+v: valid=%t, err=%v", test.conf, test.valid, err)
+	}
+}
@@ -0,0 +1,114 @@
+// Package secrets resolves backend credentials via external secrets providers:
+// HashiCorp Vault, AWS Secrets Manager, and Kubernetes secrets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// HashiCorp Vault, KV version 2 secrets engine:
+// GET {addr}/v1/{mount}/data/{path}
+
+const (
+	vaultDfltMount = "secret"
+	envVaultToken  = "VAULT_TOKEN"
+)
+
+type (
+	vault struct {
+		client *http.Client
+		conf   cmn.SecretsVaultConf
+	}
+	vaultResp struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+		Errors []string `json:"errors"`
+	}
+)
+
+// interface guard
+var _ Provider = (*vault)(nil)
+
+func newVault(conf *cmn.SecretsVaultConf) (*vault, error) {
+	if conf.Addr == "" {
+		return nil, errors.New("vault: address not configured")
+	}
+	v := &vault{conf: *conf, client: cmn.NewClient(cmn.TransportArgs{Timeout: reqTimeout, UseHTTPProxyEnv: true})}
+	v.conf.Addr = strings.TrimSuffix(v.conf.Addr, "/")
+	if v.conf.Mount == "" {
+		v.conf.Mount = vaultDfltMount
+	}
+	return v, nil
+}
+
+func (*vault) Name() string { return cmn.SecretsVault }
+
+// (re)read the token every time - it may have been renewed by Vault Agent
+func (v *vault) token() (string, error) {
+	if v.conf.TokenFile == "" {
+		if token := os.Getenv(envVaultToken); token != "" {
+			return token, nil
+		}
+		return "", fmt.Errorf("vault: token not found (neither token_file nor $%s)", envVaultToken)
+	}
+	b, err := os.ReadFile(v.conf.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("vault: failed to read token: %w", err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func (v *vault) Get(ctx context.Context, id string) (Secret, error) {
+	token, err := v.token()
+	if err != nil {
+		return nil, err
+	}
+	url := v.conf.Addr + "/v1/" + strings.Trim(v.conf.Mount, "/") + "/data/" + strings.TrimPrefix(id, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if v.conf.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.conf.Namespace)
+	}
+	resp, err := v.client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return nil, err
+	}
+	defer cos.DrainReader(resp.Body)
+
+	var vresp vaultResp
+	if err := jsoniter.NewDecoder(resp.Body).Decode(&vresp); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("vault: failed to decode response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault: %s (%s)", resp.Status, strings.Join(vresp.Errors, "; "))
+	}
+	return toSecret(vresp.Data.Data), nil
+}
+
+func toSecret(data map[string]any) Secret {
+	secret := make(Secret, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			secret[k] = s
+		} else {
+			secret[k] = fmt.Sprint(v)
+		}
+	}
+	return secret
+}
@@ -5,8 +5,11 @@
 package tests_test
 
 import (
+	"time"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -184,4 +187,110 @@ var _ = Describe("API", func() {
 			),
 		)
 	})
+
+	Describe("HedgeConf", func() {
+		DescribeTable("should validate hedged reads",
+			func(bp cmn.Bprops, valid bool) {
+				err := bp.Hedge.ValidateAsProps(&bp)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("disabled", cmn.Bprops{Provider: apc.AIS, Hedge: cmn.HedgeConf{Source: "bogus"}}, true),
+			Entry("remote AIS source",
+				cmn.Bprops{Provider: apc.AWS, Hedge: cmn.HedgeConf{Enabled: true, Source: "ais://@remais/abc"}}, true),
+			Entry("cloud source",
+				cmn.Bprops{Provider: apc.AWS, Hedge: cmn.HedgeConf{Enabled: true, Source: "gs://mirror"}}, true),
+			Entry("ais bucket w/o backend",
+				cmn.Bprops{Provider: apc.AIS, Hedge: cmn.HedgeConf{Enabled: true, Source: "gs://mirror"}}, false),
+			Entry("local source",
+				cmn.Bprops{Provider: apc.AWS, Hedge: cmn.HedgeConf{Enabled: true, Source: "ais://local"}}, false),
+			Entry("object name",
+				cmn.Bprops{Provider: apc.AWS, Hedge: cmn.HedgeConf{Enabled: true, Source: "gs://mirror/obj"}}, false),
+		)
+	})
+
+	Describe("ReadAheadConf", func() {
+		DescribeTable("should validate read-ahead",
+			func(bp cmn.Bprops, valid bool) {
+				err := bp.ReadAhead.ValidateAsProps(&bp)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("disabled", cmn.Bprops{Provider: apc.AIS}, true),
+			Entry("remote bucket",
+				cmn.Bprops{Provider: apc.GCP, ReadAhead: cmn.ReadAheadConf{Enabled: true, Window: 8, MinRun: 2}}, true),
+			Entry("ais bucket w/ backend",
+				cmn.Bprops{Provider: apc.AIS, BackendBck: cmn.Bck{Name: "abc", Provider: apc.AWS},
+					ReadAhead: cmn.ReadAheadConf{Enabled: true, Window: 4, MinRun: 1}}, true),
+			Entry("ais bucket w/o backend",
+				cmn.Bprops{Provider: apc.AIS, ReadAhead: cmn.ReadAheadConf{Enabled: true, Window: 8, MinRun: 2}}, false),
+			Entry("zero window",
+				cmn.Bprops{Provider: apc.GCP, ReadAhead: cmn.ReadAheadConf{Enabled: true, MinRun: 2}}, false),
+			Entry("window too large",
+				cmn.Bprops{Provider: apc.GCP, ReadAhead: cmn.ReadAheadConf{Enabled: true, Window: 1000, MinRun: 2}}, false),
+			Entry("zero min run",
+				cmn.Bprops{Provider: apc.GCP, ReadAhead: cmn.ReadAheadConf{Enabled: true, Window: 8}}, false),
+		)
+	})
+
+	Describe("ETLPolicyConf", func() {
+		DescribeTable("should validate ETL policy",
+			func(c cmn.ETLPolicyConf, valid bool) {
+				err := c.ValidateAsProps()
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("none", cmn.ETLPolicyConf{}, true),
+			Entry("fail", cmn.ETLPolicyConf{OnError: cmn.ETLOnErrorFail, Retries: 2}, true),
+			Entry("original", cmn.ETLPolicyConf{OnError: cmn.ETLOnErrorOriginal, Timeout: cos.Duration(time.Second), Retries: 1}, true),
+			Entry("invalid on_error", cmn.ETLPolicyConf{OnError: "skip"}, false),
+			Entry("negative timeout", cmn.ETLPolicyConf{Timeout: -1}, false),
+			Entry("too many retries", cmn.ETLPolicyConf{Retries: cmn.MaxETLRetries + 1}, false),
+		)
+	})
+
+	Describe("InventoryConf", func() {
+		DescribeTable("should validate inventory schedule",
+			func(c cmn.InventoryConf, valid bool) {
+				err := c.ValidateAsProps()
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("disabled", cmn.InventoryConf{}, true),
+			Entry("daily", cmn.InventoryConf{Enabled: true, Interval: cos.Duration(24 * time.Hour), Keep: 2}, true),
+			Entry("keep all", cmn.InventoryConf{Enabled: true, Interval: cos.Duration(time.Hour)}, true),
+			Entry("interval too short", cmn.InventoryConf{Enabled: true, Interval: cos.Duration(time.Second)}, false),
+			Entry("negative keep", cmn.InventoryConf{Keep: -1}, false),
+		)
+	})
+
+	Describe("RespHdrConf", func() {
+		DescribeTable("should validate GET response headers",
+			func(c cmn.RespHdrConf, valid bool) {
+				err := c.ValidateAsProps()
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("none", cmn.RespHdrConf{}, true),
+			Entry("all", cmn.RespHdrConf{InferType: true, CacheControl: "public, max-age=3600", Disposition: "inline"}, true),
+			Entry("attachment", cmn.RespHdrConf{Disposition: cmn.DispositionAttachment}, true),
+			Entry("invalid disposition", cmn.RespHdrConf{Disposition: "download"}, false),
+			Entry("header injection", cmn.RespHdrConf{CacheControl: "no-cache\r\nX-Evil: 1"}, false),
+		)
+	})
 })
@@ -0,0 +1,189 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/pierrec/lz4/v3"
+)
+
+func TestArchFastAppend(t *testing.T) {
+	for _, mime := range []string{archive.ExtTarLz4, archive.ExtZip} {
+		t.Run(mime, func(t *testing.T) { testArchFastAppend(t, mime) })
+	}
+}
+
+func testArchFastAppend(t *testing.T, mime string) {
+	const numOrig, numAppend = 3, 4
+	var (
+		fqn      = filepath.Join(t.TempDir(), "shard"+mime)
+		expected = make(map[string][]byte, numOrig+numAppend)
+		now      = time.Now().UnixNano()
+	)
+	payload := func(i int) []byte { return bytes.Repeat([]byte{byte('a' + i)}, 1000+i*777) }
+
+	// create
+	fh, err := os.Create(fqn)
+	tassert.CheckFatal(t, err)
+	aw := archive.NewWriter(mime, fh, nil, nil)
+	for i := range numOrig {
+		name, data := fmt.Sprintf("orig/%d.txt", i), payload(i)
+		err := aw.Write(name, &cos.SimpleOAH{Size: int64(len(data)), Atime: now}, bytes.NewReader(data))
+		tassert.CheckFatal(t, err)
+		expected[name] = data
+	}
+	aw.Fini()
+	tassert.CheckFatal(t, fh.Close())
+
+	// append in place
+	for i := range numAppend {
+		var (
+			size int64
+			name = fmt.Sprintf("appended/%d.bin", i)
+			data = payload(numOrig + i)
+			oah  = &cos.SimpleOAH{Size: int64(len(data)), Atime: now}
+		)
+		switch mime {
+		case archive.ExtTarLz4:
+			rwfh, _, err := archive.OpenTarLz4ForAppend(fqn, fqn)
+			tassert.CheckFatal(t, err)
+			size, err = archive.AppendTarLz4(rwfh, name, oah, bytes.NewReader(data))
+			tassert.CheckFatal(t, err)
+			rwfh.Close()
+		case archive.ExtZip:
+			rwfh, zcd, err := archive.OpenZipForAppend(fqn, fqn)
+			tassert.CheckFatal(t, err)
+			size, err = zcd.Append(rwfh, name, oah, bytes.NewReader(data))
+			tassert.CheckFatal(t, err)
+			rwfh.Close()
+		}
+		finfo, err := os.Stat(fqn)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, finfo.Size() == size, "expected size %d, got %d", size, finfo.Size())
+		expected[name] = data
+	}
+
+	// validate
+	lst, err := archive.List(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst) == len(expected), "expected %d archived files, got %d", len(expected), len(lst))
+	for _, en := range lst {
+		data, ok := expected[en.Name]
+		tassert.Fatalf(t, ok, "unexpected archived file %q", en.Name)
+		tassert.Errorf(t, en.Size == int64(len(data)), "%s: expected size %d, got %d", en.Name, len(data), en.Size)
+
+		rfh, err := os.Open(fqn)
+		tassert.CheckFatal(t, err)
+		finfo, err := rfh.Stat()
+		tassert.CheckFatal(t, err)
+		ar, err := archive.NewReader(mime, rfh, finfo.Size())
+		tassert.CheckFatal(t, err)
+		r, err := ar.ReadOne(en.Name)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, r != nil, "%s: not found", en.Name)
+		b, err := io.ReadAll(r)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(b, data), "%s: content mismatch", en.Name)
+		r.Close()
+		rfh.Close()
+	}
+}
+
+// single-frame TAR.LZ4 (as in: `tar cf - ... | lz4`) with the trailer inside
+func TestArchFastAppendFallback(t *testing.T) {
+	var (
+		fqn    = filepath.Join(t.TempDir(), "shard"+archive.ExtTarLz4)
+		data   = []byte("hello")
+		tarbuf bytes.Buffer
+		lz4buf bytes.Buffer
+	)
+	aw := archive.NewWriter(archive.ExtTar, &tarbuf, nil, nil)
+	err := aw.Write("a.txt", &cos.SimpleOAH{Size: int64(len(data)), Atime: time.Now().UnixNano()}, bytes.NewReader(data))
+	tassert.CheckFatal(t, err)
+	aw.Fini()
+
+	lzw := lz4.NewWriter(&lz4buf)
+	_, err = lzw.Write(tarbuf.Bytes())
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, lzw.Close())
+	tassert.CheckFatal(t, os.WriteFile(fqn, lz4buf.Bytes(), cos.PermRWR))
+
+	_, _, err = archive.OpenTarLz4ForAppend(fqn, fqn)
+	tassert.Fatalf(t, err == archive.ErrNoFastAppend, "expected %v, got %v", archive.ErrNoFastAppend, err)
+}
+
+// fails mid-stream (after some data has already been written)
+type failingReader struct{ n int }
+
+func (r *failingReader) Read(b []byte) (int, error) {
+	if r.n <= 0 {
+		return 0, errors.New("client disconnected")
+	}
+	n := min(len(b), r.n)
+	clear(b[:n])
+	r.n -= n
+	return n, nil
+}
+
+// failed fast append must leave the original archive intact
+func TestArchFastAppendAbort(t *testing.T) {
+	for _, mime := range []string{archive.ExtTarLz4, archive.ExtZip} {
+		t.Run(mime, func(t *testing.T) {
+			var (
+				fqn  = filepath.Join(t.TempDir(), "shard"+mime)
+				data = bytes.Repeat([]byte("x"), 3000)
+				oah  = &cos.SimpleOAH{Size: int64(len(data)), Atime: time.Now().UnixNano()}
+			)
+			fh, err := os.Create(fqn)
+			tassert.CheckFatal(t, err)
+			aw := archive.NewWriter(mime, fh, nil, nil)
+			for i := range 3 {
+				tassert.CheckFatal(t, aw.Write(fmt.Sprintf("orig/%d", i), oah, bytes.NewReader(data)))
+			}
+			aw.Fini()
+			tassert.CheckFatal(t, fh.Close())
+			orig, err := os.ReadFile(fqn)
+			tassert.CheckFatal(t, err)
+
+			// 1MiB declared, 256KiB delivered
+			var (
+				r    = &failingReader{n: 256 * cos.KiB}
+				boah = &cos.SimpleOAH{Size: cos.MiB, Atime: oah.Atime}
+			)
+			switch mime {
+			case archive.ExtTarLz4:
+				rwfh, _, err := archive.OpenTarLz4ForAppend(fqn, fqn)
+				tassert.CheckFatal(t, err)
+				_, err = archive.AppendTarLz4(rwfh, "appended", boah, r)
+				tassert.Errorf(t, err != nil, "expected error")
+				rwfh.Close()
+			case archive.ExtZip:
+				rwfh, zcd, err := archive.OpenZipForAppend(fqn, fqn)
+				tassert.CheckFatal(t, err)
+				_, err = zcd.Append(rwfh, "appended", boah, r)
+				tassert.Errorf(t, err != nil, "expected error")
+				rwfh.Close()
+			}
+
+			after, err := os.ReadFile(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, bytes.Equal(orig, after), "archive modified: size %d vs %d", len(orig), len(after))
+			lst, err := archive.List(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, len(lst) == 3, "expected 3 archived files, got %d", len(lst))
+		})
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -72,3 +74,127 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestBackendConfHTRewrite(t *testing.T) {
+	conf := cmn.BackendConfHT{
+		"https://datasets.org/imagenet":        {"https://m1.org/imagenet/", "http://m2.org/mirrors/imagenet"},
+		"https://datasets.org/imagenet/train/": {"https://m3.org/train"},
+	}
+	tests := []struct {
+		in  string
+		out []string
+	}{
+		{"https://datasets.org/imagenet/val/a.tar", []string{"https://m1.org/imagenet/val/a.tar", "http://m2.org/mirrors/imagenet/val/a.tar"}},
+		{"https://datasets.org/imagenet/train/b.tar", []string{"https://m3.org/train/b.tar"}}, // longest match
+		{"https://datasets.org/imagenet2/c.tar", []string{"https://datasets.org/imagenet2/c.tar"}},
+		{"https://other.org/d.tar", []string{"https://other.org/d.tar"}},
+	}
+	for _, test := range tests {
+		out := conf.Rewrite(test.in)
+		tassert.Errorf(t, len(out) == len(test.out), "%q: expected %v, got %v", test.in, test.out, out)
+		for i := 0; i < len(out) && i < len(test.out); i++ {
+			tassert.Errorf(t, out[i] == test.out[i], "%q: expected %v, got %v", test.in, test.out, out)
+		}
+	}
+
+	bc := cmn.BackendConf{Conf: map[string]any{apc.HT: map[string]any{"https://a.org": []string{"ftp://b.org"}}}}
+	tassert.Errorf(t, bc.Validate() != nil, "expecting invalid mirror URL error")
+	bc = cmn.BackendConf{Conf: map[string]any{apc.HT: map[string]any{}}}
+	tassert.CheckError(t, bc.Validate())
+}
+
+func TestBackendConfRGW(t *testing.T) {
+	bc := cmn.BackendConf{Conf: map[string]any{apc.RGW: map[string]any{}}}
+	tassert.Errorf(t, bc.Validate() != nil, "expecting missing endpoint error")
+	bc = cmn.BackendConf{Conf: map[string]any{apc.RGW: map[string]any{"endpoint": "http://rgw:7480", "admin_path": "admin"}}}
+	tassert.Errorf(t, bc.Validate() != nil, "expecting invalid admin path error")
+
+	bc = cmn.BackendConf{Conf: map[string]any{apc.RGW: map[string]any{"endpoint": "http://rgw:7480"}}}
+	tassert.CheckFatal(t, bc.Validate())
+	conf := bc.RGW()
+	tassert.Errorf(t, conf.Endpoint == "http://rgw:7480", "unexpected endpoint %q", conf.Endpoint)
+	tassert.Errorf(t, conf.Region == cmn.RGWDefaultRegion && conf.AdminPath == cmn.RGWDefaultAdminPath,
+		"expecting defaults, got %+v", conf)
+	_, ok := bc.Providers[apc.RGW]
+	tassert.Errorf(t, ok, "expecting %q provider", apc.RGW)
+}
+
+func TestBackendConfExt(t *testing.T) {
+	for _, pc := range []map[string]any{
+		{"p1": map[string]any{"url": "localhost:9100"}},
+		{"p 1": map[string]any{"url": "http://localhost:9100"}},
+		{"p1": map[string]any{"url": "http://localhost:9100", "timeout": "-1s"}},
+	} {
+		bc := cmn.BackendConf{Conf: map[string]any{apc.Ext: pc}}
+		tassert.Errorf(t, bc.Validate() != nil, "%v: expecting validation error", pc)
+	}
+	bc := cmn.BackendConf{Conf: map[string]any{apc.Ext: map[string]any{
+		"p1": map[string]any{"url": "http://localhost:9100", "timeout": "10s"},
+		"p2": map[string]any{"url": "https://p2:9200"},
+	}}}
+	tassert.CheckFatal(t, bc.Validate())
+	ext := bc.Ext()
+	tassert.Fatalf(t, len(ext) == 2, "expecting 2 plugins, got %+v", ext)
+	tassert.Errorf(t, ext["p1"].Timeout.D() == 10*time.Second && ext["p2"].Timeout == 0, "unexpected %+v", ext)
+}
+
+func TestDiskConfWalkParallelism(t *testing.T) {
+	conf := cmn.DiskConf{
+		DiskUtilLowWM: 20, DiskUtilHighWM: 80, DiskUtilMaxWM: 95,
+		IostatTimeLong: cos.Duration(2 * time.Second), IostatTimeShort: cos.Duration(100 * time.Millisecond),
+		WalkParallel:       4,
+		WalkParallelLabels: "nvme=16, hdd=1",
+	}
+	tassert.CheckFatal(t, conf.Validate())
+	tests := []struct {
+		label string
+		n     int
+	}{
+		{"", 4}, {"ssd", 4}, {"nvme", 16}, {"hdd", 1},
+	}
+	for _, test := range tests {
+		n := conf.WalkParallelism(cos.MountpathLabel(test.label))
+		tassert.Errorf(t, n == test.n, "label %q: expected %d, got %d", test.label, test.n, n)
+	}
+
+	for _, labels := range []string{"nvme", "=2", "nvme=x", "nvme=-1", "nvme=1000"} {
+		conf.WalkParallelLabels = labels
+		tassert.Errorf(t, conf.Validate() != nil, "%q: expecting validation error", labels)
+	}
+	conf.WalkParallelLabels, conf.WalkParallel = "", cmn.MaxWalkParallel+1
+	tassert.Errorf(t, conf.Validate() != nil, "expecting out-of-range error")
+}
+
+func TestConfigSubscribe(t *testing.T) {
+	oldConfig := cmn.GCO.Get()
+	defer cmn.GCO.Put(oldConfig)
+
+	var lru, dl, all int
+	unsubLRU := cmn.GCO.Subscribe("lru", func(oc, nc *cmn.Config) {
+		tassert.Errorf(t, oc.LRU.Enabled != nc.LRU.Enabled, "expected LRU change")
+		lru++
+	})
+	unsubDl := cmn.GCO.Subscribe("downloader", func(_, _ *cmn.Config) { dl++ })
+	unsubAny := cmn.GCO.Subscribe("", func(_, _ *cmn.Config) { all++ })
+	defer unsubDl()
+	defer unsubAny()
+
+	// (inline) cluster config section
+	config := cmn.GCO.BeginUpdate()
+	config.LRU.Enabled = !config.LRU.Enabled
+	cmn.GCO.CommitUpdate(config)
+	tassert.Errorf(t, lru == 1 && dl == 0 && all == 1, "expected (1, 0, 1), got (%d, %d, %d)", lru, dl, all)
+
+	// (inline) local config section
+	config = cmn.GCO.Clone()
+	config.LogDir += "/subscribe"
+	cmn.GCO.Put(config)
+	tassert.Errorf(t, lru == 1 && dl == 0 && all == 2, "expected (1, 0, 2), got (%d, %d, %d)", lru, dl, all)
+
+	// unsubscribed
+	unsubLRU()
+	config = cmn.GCO.Clone()
+	config.LRU.Enabled = !config.LRU.Enabled
+	cmn.GCO.Put(config)
+	tassert.Errorf(t, lru == 1 && all == 3, "expected (1, 3), got (%d, %d)", lru, all)
+}
@@ -5,8 +5,12 @@
 package tests_test
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/tools/tassert"
@@ -22,3 +26,21 @@ func TestAbortedErrorAs(t *testing.T) {
 	mockError := fmt.Errorf("wrapping aborted error %w", abortedError)
 	tassert.Fatalf(t, cmn.IsErrAborted(mockError), "expected errors.As to return true on a wrapped error")
 }
+
+func TestErrTimeout(t *testing.T) {
+	err := cmn.NewErrTimeout("HEAD s3://abc/xyz", "waiting for remote backend", 1500*time.Millisecond, time.Second, true)
+	tassert.Fatalf(t, cmn.IsErrTimeout(err), "expected timeout error")
+	tassert.Errorf(t, err.Status() == http.StatusGatewayTimeout, "expected 504, got %d", err.Status())
+	msg := err.Error()
+	for _, s := range []string{"HEAD s3://abc/xyz", "1.5s", "limit 1s", "waiting for remote backend"} {
+		tassert.Errorf(t, strings.Contains(msg, s), "expected %q in %q", s, msg)
+	}
+
+	err = cmn.NewErrTimeout("list-objects ais://abc", "waiting for t[xyz]", time.Minute, time.Minute, false)
+	tassert.Errorf(t, err.Status() == http.StatusServiceUnavailable, "expected 503, got %d", err.Status())
+
+	// as received by API clients
+	herr := cmn.NewErrHTTP(nil, err, err.Status())
+	tassert.Errorf(t, cmn.IsErrTimeout(herr), "expected timeout error, got %+v", herr)
+	tassert.Errorf(t, !cmn.IsErrTimeout(cmn.NewErrHTTP(nil, errors.New("timed out"), 0)), "unexpected timeout error")
+}
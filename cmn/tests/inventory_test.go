@@ -0,0 +1,49 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestNativeInvNames(t *testing.T) {
+	part := cmn.NativeInvPartName("xid123", 7)
+	tassert.Errorf(t, part == ".ais-inventory/xid123/part-7.csv", "unexpected part name %q", part)
+	tassert.Errorf(t, cmn.NativeInvID(part) == "xid123", "failed to parse inventory ID from %q", part)
+	tassert.Errorf(t, cmn.IsNativeInvObj(part), "expected %q to be an inventory object", part)
+
+	for _, name := range []string{"a/b/c", ".ais-inventory/xid123", ".ais-inventory/xid123/readme.txt", ".inventory/b/x.csv"} {
+		tassert.Errorf(t, cmn.NativeInvID(name) == "", "%q is not an inventory part", name)
+	}
+}
+
+func TestNativeInvRecord(t *testing.T) {
+	var (
+		atime = time.Now()
+		cksum = cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef")
+		rec   = cmn.NativeInvRecord("dir/obj,with,commas", 1024, cksum, "3", atime.UnixNano())
+	)
+	tassert.Fatalf(t, len(rec) == len(cmn.NativeInvHeader), "expecting %d fields, got %d", len(cmn.NativeInvHeader), len(rec))
+	en, err := cmn.ParseNativeInvRecord(rec, time.RFC3339Nano)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, en.Name == "dir/obj,with,commas" && en.Size == 1024 && en.Version == "3", "unexpected entry %+v", en)
+	tassert.Errorf(t, en.Checksum == "0123456789abcdef", "unexpected checksum %q", en.Checksum)
+	parsed, err := time.Parse(time.RFC3339Nano, en.Atime)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, parsed.Equal(atime), "atime: expected %v, got %v", atime, parsed)
+
+	// no checksum, no atime
+	en, err = cmn.ParseNativeInvRecord(cmn.NativeInvRecord("x", 0, nil, "", 0), "")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, en.Atime == "" && en.Checksum == "", "unexpected entry %+v", en)
+
+	_, err = cmn.ParseNativeInvRecord([]string{"x", "not-a-number", "", "", "", ""}, "")
+	tassert.Errorf(t, err != nil, "expected error parsing invalid size")
+}
@@ -76,6 +76,8 @@ var _ = Describe("IterFields", func() {
 					"ec.compression":       "",
 					"ec.bundle_multiplier": 0,
 					"ec.disk_only":         false,
+					"ec.encode_mode":       "",
+					"ec.lazy_delay":        cos.Duration(0),
 
 					"versioning.enabled":           false,
 					"versioning.validate_warm_get": false,
@@ -91,17 +93,42 @@ var _ = Describe("IterFields", func() {
 					"lru.dont_evict_time":   cos.Duration(0),
 					"lru.capacity_upd_time": cos.Duration(0),
 
-					"extra.aws.cloud_region": "us-central",
-					"extra.aws.endpoint":     "",
-					"extra.aws.profile":      "",
-					"extra.aws.max_pagesize": int64(0),
+					"extra.aws.cloud_region":         "us-central",
+					"extra.aws.endpoint":             "",
+					"extra.aws.profile":              "",
+					"extra.aws.max_pagesize":         int64(0),
+					"extra.aws.preset":               "",
+					"extra.aws.sse":                  "",
+					"extra.aws.sse_kms_key_id":       "",
+					"extra.aws.sse_customer_key_env": "",
 
 					"access":   apc.AccessAttrs(0),
 					"features": feat.Flags(0),
 					"created":  int64(0),
+					"frozen":   false,
 
 					"write_policy.data": apc.WritePolicy(""),
 					"write_policy.md":   apc.WritePolicy(""),
+
+					"hedge.source":  "",
+					"hedge.delay":   cos.Duration(0),
+					"hedge.enabled": false,
+
+					"resp_hdr.cache_control": "",
+					"resp_hdr.disposition":   "",
+					"resp_hdr.infer_type":    false,
+
+					"read_ahead.window":  0,
+					"read_ahead.min_run": 0,
+					"read_ahead.enabled": false,
+
+					"etl.on_error": "",
+					"etl.timeout":  cos.Duration(0),
+					"etl.retries":  0,
+
+					"inventory.interval": cos.Duration(0),
+					"inventory.keep":     0,
+					"inventory.enabled":  false,
 				},
 			),
 			Entry("list BpropsToSet fields",
@@ -135,6 +162,8 @@ var _ = Describe("IterFields", func() {
 					"ec.compression":       (*string)(nil),
 					"ec.bundle_multiplier": (*int)(nil),
 					"ec.disk_only":         (*bool)(nil),
+					"ec.encode_mode":       (*string)(nil),
+					"ec.lazy_delay":        (*cos.Duration)(nil),
 
 					"versioning.enabled":           (*bool)(nil),
 					"versioning.validate_warm_get": (*bool)(nil),
@@ -152,16 +181,41 @@ var _ = Describe("IterFields", func() {
 
 					"access":   apc.Ptr[apc.AccessAttrs](1024),
 					"features": apc.Ptr[feat.Flags](1024),
+					"frozen":   (*bool)(nil),
 
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   apc.Ptr(apc.WriteDelayed),
 
-					"extra.hdfs.ref_directory": (*string)(nil),
-					"extra.aws.cloud_region":   (*string)(nil),
-					"extra.aws.endpoint":       (*string)(nil),
-					"extra.aws.profile":        (*string)(nil),
-					"extra.aws.max_pagesize":   (*int64)(nil),
-					"extra.http.original_url":  (*string)(nil),
+					"hedge.source":  (*string)(nil),
+					"hedge.delay":   (*cos.Duration)(nil),
+					"hedge.enabled": (*bool)(nil),
+
+					"resp_hdr.cache_control": (*string)(nil),
+					"resp_hdr.disposition":   (*string)(nil),
+					"resp_hdr.infer_type":    (*bool)(nil),
+
+					"read_ahead.window":  (*int)(nil),
+					"read_ahead.min_run": (*int)(nil),
+					"read_ahead.enabled": (*bool)(nil),
+
+					"etl.on_error": (*string)(nil),
+					"etl.timeout":  (*cos.Duration)(nil),
+					"etl.retries":  (*int)(nil),
+
+					"inventory.interval": (*cos.Duration)(nil),
+					"inventory.keep":     (*int)(nil),
+					"inventory.enabled":  (*bool)(nil),
+
+					"extra.hdfs.ref_directory":       (*string)(nil),
+					"extra.aws.cloud_region":         (*string)(nil),
+					"extra.aws.endpoint":             (*string)(nil),
+					"extra.aws.profile":              (*string)(nil),
+					"extra.aws.max_pagesize":         (*int64)(nil),
+					"extra.aws.preset":               (*string)(nil),
+					"extra.aws.sse":                  (*string)(nil),
+					"extra.aws.sse_kms_key_id":       (*string)(nil),
+					"extra.aws.sse_customer_key_env": (*string)(nil),
+					"extra.http.original_url":        (*string)(nil),
 				},
 			),
 			Entry("check for omit tag",
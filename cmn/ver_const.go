@@ -39,6 +39,8 @@ const (
 	MetaverVMD   = 2 // Volume MD (jsp)
 	MetaverEtlMD = 1 // ETL MD (jsp)
 
+	MetaverRebVerify = 1 // post-rebalance verification report (jsp)
+
 	MetaverLOM   = 1 // LOM
 	MetaverChunk = 2 // LOM chunk
 
@@ -5,6 +5,7 @@
 package core
 
 import (
+	"errors"
 	"runtime"
 	"sync"
 	"time"
@@ -409,7 +410,17 @@ func _flushAtime(md *lmeta, atime time.Time, mdTime int64) {
 	md.atimefs = uint64(mdTime)
 	lom.md = *md
 
-	buf := lom.pack()
+	buf, err := lom._pack(false /*sidecar I/O*/)
+	if err != nil {
+		g.tstats.Inc(LcacheErrCount)
+		if errors.Is(err, errSidecarIO) {
+			nlog.Errorln(err) // (unexpected: see delayPersist)
+		} else {
+			T.FSHC(err, lom.Mountpath(), lom.FQN)
+		}
+		FreeLOM(lom)
+		return
+	}
 	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
 		T.FSHC(err, lom.Mountpath(), lom.FQN)
 	} else {
@@ -65,8 +65,10 @@ func (lom *LOM) delCopyMd(copyFQN string) {
 	}
 }
 
-// NOTE: used only in tests
 func (lom *LOM) AddCopy(copyFQN string, mpi *fs.Mountpath) error {
+	if err := lom.copySidecar(mpi, lom.Bucket(), lom.ObjName); err != nil {
+		return err
+	}
 	if lom.md.copies == nil {
 		lom.md.copies = make(fs.MPI, 2)
 	}
@@ -76,12 +78,17 @@ func (lom *LOM) AddCopy(copyFQN string, mpi *fs.Mountpath) error {
 }
 
 func (lom *LOM) DelCopies(copiesFQN ...string) (err error) {
-	numCopies := lom.NumCopies()
+	var (
+		numCopies = lom.NumCopies()
+		mis       = make([]*fs.Mountpath, 0, len(copiesFQN))
+	)
 	// 1. Delete all copies from the metadata
 	for _, copyFQN := range copiesFQN {
-		if _, ok := lom.md.copies[copyFQN]; !ok {
+		mi, ok := lom.md.copies[copyFQN]
+		if !ok {
 			return fmt.Errorf("lom %s(num: %d): copy %s does not exist", lom, numCopies, copyFQN)
 		}
+		mis = append(mis, mi)
 		lom.delCopyMd(copyFQN)
 	}
 
@@ -91,16 +98,34 @@ func (lom *LOM) DelCopies(copiesFQN ...string) (err error) {
 		return err
 	}
 
-	// 3. Remove the copies
-	for _, copyFQN := range copiesFQN {
+	// 3. Remove the copies (and their sidecars, if any)
+	for i, copyFQN := range copiesFQN {
 		if err1 := cos.RemoveFile(copyFQN); err1 != nil {
 			nlog.Errorln(err1) // TODO: LRU should take care of that later.
 			continue
 		}
+		if lom.md.sidecar != 0 && !lom.usesMpath(mis[i]) {
+			if err1 := cos.RemoveFile(sidecarFQN(mis[i], lom.Bucket(), lom.ObjName)); err1 != nil {
+				nlog.Errorln(err1)
+			}
+		}
 	}
 	return
 }
 
+// whether the main replica or any of the remaining copies resides on `mi`
+func (lom *LOM) usesMpath(mi *fs.Mountpath) bool {
+	if mi == nil || mi == lom.mi {
+		return true
+	}
+	for _, mpi := range lom.md.copies {
+		if mpi == mi {
+			return true
+		}
+	}
+	return false
+}
+
 func (lom *LOM) DelAllCopies() (err error) {
 	copiesFQN := make([]string, 0, len(lom.md.copies))
 	for copyFQN := range lom.md.copies {
@@ -253,7 +278,14 @@ func (lom *LOM) Copy(mi *fs.Mountpath, buf []byte) (err error) {
 	}
 add:
 	// add md and persist
-	lom.AddCopy(copyFQN, mi)
+	if err = lom.AddCopy(copyFQN, mi); err != nil {
+		// remove orphaned copy
+		lom.delCopyMd(copyFQN)
+		if errRemove := cos.RemoveFile(copyFQN); errRemove != nil && !os.IsNotExist(errRemove) {
+			nlog.Errorln("nested err:", errRemove)
+		}
+		return err
+	}
 	err = lom.Persist()
 	if err != nil {
 		lom.delCopyMd(copyFQN)
@@ -305,6 +337,12 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 	if err != nil {
 		return
 	}
+	if err = lom.copySidecar(dst.mi, dst.Bucket(), dst.ObjName); err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil && !os.IsNotExist(errRemove) {
+			nlog.Errorln("nested err:", errRemove)
+		}
+		return
+	}
 
 	if err = cos.Rename(workFQN, dstFQN); err != nil {
 		if errRemove := cos.RemoveFile(workFQN); errRemove != nil && !os.IsNotExist(errRemove) {
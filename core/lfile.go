@@ -119,6 +119,9 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 	})
 	lom.Uncache()
 	err = lom.RemoveMain()
+	if lom.md.sidecar != 0 {
+		lom.rmSidecars()
+	}
 	for copyFQN := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !os.IsNotExist(erc) && err == nil {
 			err = erc
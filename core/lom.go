@@ -45,15 +45,22 @@ const (
 	LcacheEvictedCount   = "lcache.evicted.n"
 	LcacheErrCount       = "err.lcache.n" // errPrefix + "lcache.n"
 	LcacheFlushColdCount = "lcache.flush.cold.n"
+
+	// per-mountpath workers and joggers on NUMA hosts (see cmn.MemsysConf.NUMA)
+	NumaPinnedCount          = "numa.pinned.n"
+	NumaPinnedLatencyTotal   = "numa.pinned.ns.total"
+	NumaUnpinnedCount        = "numa.unpinned.n"
+	NumaUnpinnedLatencyTotal = "numa.unpinned.ns.total"
 )
 
 type (
-	lmeta struct { // sizeof = 72
+	lmeta struct { // sizeof = 80
 		copies fs.MPI
 		uname  *string
 		cmn.ObjAttrs
 		atimefs uint64 // (high bit `lomDirtyMask` | int64: atime)
 		lid     lomBID
+		sidecar uint64 // non-zero: custom md overflow (sidecar checksum)
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -190,6 +197,19 @@ func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
 func (lom *LOM) GetCustomKey(key string) (string, bool) { return lom.md.GetCustomKey(key) }
 func (lom *LOM) SetCustomKey(key, value string)         { lom.md.SetCustomKey(key, value) }
 
+// store the object's Content-Type (as provided by remote backend or PUT request) - only
+// when the bucket returns it upon GET (see cmn.RespHdrConf); otherwise, not stored
+func (lom *LOM) SetContentType(v string) {
+	if !lom.Bprops().RespHdr.InferType {
+		return
+	}
+	if v == "" || v == cos.ContentBinary {
+		delete(lom.md.CustomMD, cos.HdrContentType) // (infer from object name extension)
+		return
+	}
+	lom.md.SetCustomKey(cos.HdrContentType, v)
+}
+
 // subj to resilvering
 func (lom *LOM) IsHRW() bool {
 	p := &lom.FQN
@@ -14,6 +14,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -75,6 +76,7 @@ const (
 	packedCustom
 	packedNum
 	packedChunk
+	packedSidecar // custom md overflow (see lsidecar.go)
 )
 
 // packing format: separators
@@ -155,6 +157,9 @@ func (lom *LOM) lmfs(populate bool) (md *lmeta, err error) {
 	}
 	md, err = lom.unpack(b, mdSize, populate)
 	slab.Free(buf)
+	if err == nil && md.sidecar != 0 {
+		err = lom.loadSidecar(md)
+	}
 	return md, err
 }
 
@@ -180,30 +185,59 @@ func (lom *LOM) unpack(b []byte, mdSize int64, populate bool) (md *lmeta, err er
 func (lom *LOM) PersistMain() (err error) {
 	atime := lom.AtimeUnix()
 	debug.Assert(cos.IsValidAtime(atime))
-	if atime < 0 /*prefetch*/ || !lom.WritePolicy().IsImmediate() /*write-never, write-delayed*/ {
+	if lom.delayPersist(atime) /*prefetch, write-never, write-delayed*/ {
 		lom.md.makeDirty()
 		lom.Recache()
 		return
 	}
 	// write-immediate (default)
-	buf := lom.pack()
-	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	var buf []byte
+	if buf, err = lom.pack(); err == nil {
+		err = fs.SetXattr(lom.FQN, XattrLOM, buf)
+		g.smm.Free(buf)
+	}
+	if err != nil {
 		lom.Uncache()
 		T.FSHC(err, lom.Mountpath(), lom.FQN)
 	} else {
 		lom.md.clearDirty()
 		lom.Recache()
 	}
-	g.smm.Free(buf)
 	return
 }
 
+// custom metadata that changes its sidecar is always persisted immediately -
+// sidecar I/O is never deferred to the atime flush (see _flushAtime)
+func (lom *LOM) delayPersist(atime int64) bool {
+	wp := lom.WritePolicy()
+	switch {
+	case wp == apc.WriteNever:
+		return true
+	case atime >= 0 && wp.IsImmediate():
+		return false
+	default:
+		return !lom.sidecarChanged()
+	}
+}
+
+func (lom *LOM) sidecarChanged() bool {
+	prev := lom.md.sidecar
+	if prev == 0 && len(lom.md.GetCustomMD()) == 0 {
+		return false
+	}
+	buf, _ := lom.md.pack(g.maxLmeta.Load())
+	g.smm.Free(buf)
+	changed := lom.md.sidecar != prev
+	lom.md.sidecar = prev
+	return changed
+}
+
 // (caller must set atime; compare with the above)
 func (lom *LOM) Persist() (err error) {
 	atime := lom.AtimeUnix()
 	debug.Assert(cos.IsValidAtime(atime), atime)
 
-	if atime < 0 || !lom.WritePolicy().IsImmediate() {
+	if lom.delayPersist(atime) {
 		lom.md.makeDirty()
 		if lom.Bprops() != nil {
 			if !lom.IsCopy() {
@@ -214,8 +248,12 @@ func (lom *LOM) Persist() (err error) {
 		return
 	}
 
-	buf := lom.pack()
-	if err = fs.SetXattr(lom.FQN, XattrLOM, buf); err != nil {
+	var buf []byte
+	if buf, err = lom.pack(); err == nil {
+		err = fs.SetXattr(lom.FQN, XattrLOM, buf)
+		g.smm.Free(buf)
+	}
+	if err != nil {
 		lom.Uncache()
 		T.FSHC(err, lom.Mountpath(), lom.FQN)
 	} else {
@@ -227,12 +265,14 @@ func (lom *LOM) Persist() (err error) {
 			lom.setbid(lom.Bprops().BID)
 		}
 	}
-	g.smm.Free(buf)
 	return
 }
 
 func (lom *LOM) persistMdOnCopies() (copyFQN string, err error) {
-	buf := lom.pack()
+	var buf []byte
+	if buf, err = lom.pack(); err != nil {
+		return lom.FQN, err
+	}
 	// replicate across copies
 	for copyFQN = range lom.md.copies {
 		if copyFQN == lom.FQN {
@@ -254,13 +294,39 @@ func (lom *LOM) flushAtime(atime time.Time) error {
 	return os.Chtimes(lom.FQN, atime, mtime)
 }
 
-func (lom *LOM) pack() (buf []byte) {
-	lmsize := g.maxLmeta.Load()
-	buf = lom.md.pack(lmsize)
+// packs metadata for the xattr; custom metadata that does not fit
+// goes to the sidecar (see lsidecar.go)
+func (lom *LOM) pack() ([]byte, error) { return lom._pack(true) }
+
+// - unchanged sidecar (same checksum) is never rewritten
+// - with no `sidecarIO` (atime flush), fails instead of writing or removing sidecars
+func (lom *LOM) _pack(sidecarIO bool) (buf []byte, err error) {
+	var (
+		sidecar []byte
+		lmsize  = g.maxLmeta.Load()
+		prev    = lom.md.sidecar
+	)
+	buf, sidecar = lom.md.pack(lmsize)
+	switch {
+	case lom.md.sidecar == prev:
+		// nothing to do
+	case !sidecarIO:
+		g.smm.Free(buf)
+		lom.md.sidecar = prev
+		return nil, fmt.Errorf("%s: %w", lom.Cname(), errSidecarIO)
+	case sidecar != nil:
+		if err = lom.writeSidecar(sidecar); err != nil {
+			g.smm.Free(buf)
+			lom.md.sidecar = prev
+			return nil, err
+		}
+	default:
+		lom.rmSidecars() // custom metadata fits (again) or is gone
+	}
 	size := int64(len(buf))
 	debug.Assert(size <= xattrMaxSize)
 	_mdsize(size, lmsize)
-	return
+	return buf, nil
 }
 
 func _mdsize(size, mdSize int64) {
@@ -298,7 +364,7 @@ func (md *lmeta) unpack(buf []byte) error {
 		cksumType, cksumValue             string
 		haveSize, haveVersion, haveCopies bool
 		haveCksumType, haveCksumValue     bool
-		last                              bool
+		haveCustom, last                  bool
 	)
 	if len(buf) < prefLen {
 		return fmt.Errorf("%s: too short (%d)", badLmeta, len(buf))
@@ -309,6 +375,7 @@ func (md *lmeta) unpack(buf []byte) error {
 	if buf[1] != mdCksumTyXXHash {
 		return fmt.Errorf("%s: unknown checksum %d", badLmeta, buf[1])
 	}
+	md.sidecar = 0
 	payload = buf[prefLen:]
 	actualCksum = xxhash.Checksum64S(buf[prefLen:], cos.MLCG32)
 	expectedCksum = binary.BigEndian.Uint64(buf[2:])
@@ -382,13 +449,17 @@ func (md *lmeta) unpack(buf []byte) error {
 				md.copies[copyFQN] = mpathInfo
 			}
 		case packedCustom:
-			val := string(record[cos.SizeofI16:])
-			entries := strings.Split(val, customSepa)
-			custom := make(cos.StrKVs, len(entries)/2)
-			for i := 0; i < len(entries); i += 2 {
-				custom[entries[i]] = entries[i+1]
+			if haveCustom {
+				return errors.New(badLmeta + " #5.2")
+			}
+			md.SetCustomMD(_unpackCustom(string(record[cos.SizeofI16:])))
+			haveCustom = true
+		case packedSidecar:
+			if haveCustom || len(record) != cos.SizeofI16+cos.SizeofI64 {
+				return errors.New(badLmeta + " #5.3")
 			}
-			md.SetCustomMD(custom)
+			md.sidecar = binary.BigEndian.Uint64(record[cos.SizeofI16:])
+			haveCustom = true
 		default:
 			return errors.New(badLmeta + " #6")
 		}
@@ -403,7 +474,9 @@ func (md *lmeta) unpack(buf []byte) error {
 	return nil
 }
 
-func (md *lmeta) pack(mdSize int64) (buf []byte) {
+// returns packed xattr and, separately, custom metadata sidecar when the latter
+// does not fit `xattrMaxSize`
+func (md *lmeta) pack(mdSize int64) (buf, sidecar []byte) {
 	buf, _ = g.smm.AllocSize(mdSize)
 	buf = buf[:prefLen] // hold it for md-xattr checksum (below)
 
@@ -429,11 +502,18 @@ func (md *lmeta) pack(mdSize int64) (buf []byte) {
 		buf = _packCopies(buf, md.copies)
 	}
 
-	// custom md
+	// custom md: inline or sidecar
+	md.sidecar = 0
 	if custom := md.GetCustomMD(); len(custom) > 0 {
 		buf = g.smm.Append(buf, recordSepa)
-		buf = _packRecord(buf, packedCustom, "", false)
-		buf = _packCustom(buf, custom)
+		if len(buf)+cos.SizeofI16+_customSize(custom) <= xattrMaxSize {
+			buf = _packRecord(buf, packedCustom, "", false)
+			buf = _packCustom(buf, custom)
+		} else {
+			sidecar = packSidecar(custom)
+			md.sidecar = binary.BigEndian.Uint64(sidecar[2:])
+			buf = _packRecord(buf, packedSidecar, cos.UnsafeS(sidecar[2:prefLen]), false)
+		}
 	}
 
 	// checksum, prepend, and return
@@ -489,6 +569,15 @@ func _packCustom(buf []byte, md cos.StrKVs) []byte {
 	return buf
 }
 
+func _unpackCustom(val string) cos.StrKVs {
+	entries := strings.Split(val, customSepa)
+	custom := make(cos.StrKVs, len(entries)/2)
+	for i := 0; i+1 < len(entries); i += 2 {
+		custom[entries[i]] = entries[i+1]
+	}
+	return custom
+}
+
 // copy atime _iff_ valid and more recent
 func (md *lmeta) cpAtime(from *lmeta) {
 	if !cos.IsValidAtime(from.Atime) {
@@ -5,7 +5,10 @@
 package core_test
 
 import (
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -14,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -33,6 +37,7 @@ var _ = Describe("LOM Xattributes", func() {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjMDType, &fs.ObjMDContentResolver{}, true)
 
 	var (
 		copyMpathInfo *fs.Mountpath
@@ -279,6 +284,122 @@ var _ = Describe("LOM Xattributes", func() {
 				Expect(lom1.GetCopies()).To(BeEquivalentTo(lom2.GetCopies()))
 			})
 
+			It("should overflow large custom metadata to sidecar and back", func() {
+				var (
+					sidecarFQN = mix.MakePathFQN(&localBck, fs.ObjMDType, testObjectName+".qqq")
+					custom     = bigCustomMD()
+				)
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				lom1.SetCustomMD(custom)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				b, err := fs.GetXattr(localFQN, core.XattrLOM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(b)).To(BeNumerically("<", memsys.MaxSmallSlabSize))
+				Expect(cos.Stat(sidecarFQN)).NotTo(HaveOccurred())
+
+				lom2 := NewBasicLom(localFQN)
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.GetCustomMD()).To(Equal(custom))
+				Expect(lom2.Checksum()).To(BeEquivalentTo(lom1.Checksum()))
+
+				// stale sidecar
+				lom1.SetCustomMD(cos.StrKVs{"key-000": "v"})
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				Expect(os.IsNotExist(cos.Stat(sidecarFQN))).To(BeTrue())
+
+				lom3 := NewBasicLom(localFQN)
+				Expect(lom3.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom3.GetCustomMD()).To(Equal(cos.StrKVs{"key-000": "v"}))
+			})
+
+			It("should keep unchanged sidecar and maintain sidecars of copies", func() {
+				var (
+					sidecarFQN  = mix.MakePathFQN(&localBck, fs.ObjMDType, testObjectName+".qqq")
+					copySidecar = copyMpathInfo.MakePathFQN(&localBck, fs.ObjMDType, testObjectName+".qqq")
+					past        = time.Now().Add(-time.Hour)
+				)
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				lom1.SetCustomMD(bigCustomMD())
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				// copy gets its own sidecar
+				Expect(lom1.AddCopy(fqns[0], copyMpathInfo)).NotTo(HaveOccurred())
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				Expect(cos.Stat(copySidecar)).NotTo(HaveOccurred())
+
+				// unchanged sidecar is not rewritten
+				Expect(os.Chtimes(sidecarFQN, past, past)).NotTo(HaveOccurred())
+				lom1.SetVersion("v2")
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+				fi, err := os.Stat(sidecarFQN)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fi.ModTime().Unix()).To(Equal(past.Unix()))
+
+				// deleted copy takes its sidecar along
+				Expect(lom1.DelCopies(fqns[0])).NotTo(HaveOccurred())
+				Expect(os.IsNotExist(cos.Stat(copySidecar))).To(BeTrue())
+				Expect(cos.Stat(sidecarFQN)).NotTo(HaveOccurred())
+
+				lom2 := NewBasicLom(localFQN)
+				Expect(lom2.LoadMetaFromFS()).NotTo(HaveOccurred())
+				Expect(lom2.GetCustomMD()).To(Equal(bigCustomMD()))
+			})
+
+			It("should not leave orphaned copy when failing to copy sidecar", func() {
+				var (
+					sidecarFQN = mix.MakePathFQN(&localBck, fs.ObjMDType, testObjectName+".qqq")
+					copyFQN    = copyMpathInfo.MakePathFQN(&localBck, fs.ObjectType, testObjectName)
+				)
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				lom1.SetCustomMD(bigCustomMD())
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				Expect(os.Remove(sidecarFQN)).NotTo(HaveOccurred())
+				Expect(lom1.Copy(copyMpathInfo, make([]byte, cos.KiB))).To(HaveOccurred())
+				Expect(os.IsNotExist(cos.Stat(copyFQN))).To(BeTrue())
+				Expect(lom1.NumCopies()).To(Equal(1))
+			})
+
+			It("should fail when custom metadata sidecar is corrupted", func() {
+				var (
+					sidecarFQN = mix.MakePathFQN(&localBck, fs.ObjMDType, testObjectName+".qqq")
+					custom     = bigCustomMD()
+				)
+				createTestFile(localFQN, testFileSize)
+				lom1 := NewBasicLom(localFQN)
+				lom1.Lock(true)
+				defer lom1.Unlock(true)
+				lom1.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "test_checksum"))
+				lom1.SetCustomMD(custom)
+				Expect(persist(lom1)).NotTo(HaveOccurred())
+
+				b, err := os.ReadFile(sidecarFQN)
+				Expect(err).NotTo(HaveOccurred())
+				b[len(b)-1]++
+				Expect(os.WriteFile(sidecarFQN, b, cos.PermRWR)).NotTo(HaveOccurred())
+
+				lom2 := NewBasicLom(localFQN)
+				err = lom2.LoadMetaFromFS()
+				Expect(cmn.IsErrLmetaCorrupted(err)).To(BeTrue())
+
+				Expect(os.Remove(sidecarFQN)).NotTo(HaveOccurred())
+				err = lom2.LoadMetaFromFS()
+				Expect(cmn.IsErrLmetaCorrupted(err)).To(BeTrue())
+			})
+
 			Describe("error cases", func() {
 				var lom *core.LOM
 
@@ -362,3 +483,12 @@ var _ = Describe("LOM Xattributes", func() {
 		})
 	})
 })
+
+// custom metadata that does not fit xattr (8KiB+)
+func bigCustomMD() cos.StrKVs {
+	custom := make(cos.StrKVs, 64)
+	for i := range 64 {
+		custom[fmt.Sprintf("key-%03d", i)] = strings.Repeat("v", 128)
+	}
+	return custom
+}
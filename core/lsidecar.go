@@ -0,0 +1,168 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Custom metadata overflow (sidecar)
+//
+// Object metadata is stored in a single xattr that cannot exceed `xattrMaxSize`.
+// When custom metadata does not fit, it is stored - in its entirety - in a
+// sidecar file (content type `fs.ObjMDType`) while the xattr carries a
+// `packedSidecar` record instead: the checksum of the sidecar's payload.
+//
+// Custom metadata is never split between the two: it's either inline or in the
+// sidecar, so reading and merging semantics remain exactly the same.
+// A sidecar that does not match the xattr's checksum is treated as corrupted metadata.
+//
+// Sidecar layout mirrors the xattr's:
+//
+// | --- 1 --- | ----- 1 ----- | ---- 8 ---- | ------------------- |
+// |  version  | checksum-type |   xxhash    | packed custom md    |
+
+const sidecarTmp = ".tmp"
+
+var errSidecarIO = errors.New("custom metadata sidecar must be written (or removed) first")
+
+func sidecarFQN(mi *fs.Mountpath, bck *cmn.Bck, objName string) string {
+	return mi.MakePathFQN(bck, fs.ObjMDType, objName)
+}
+
+// (compare with _packCustom)
+// NOTE: sorted keys - same custom metadata, same checksum (see pack())
+func packSidecar(custom cos.StrKVs) (b []byte) {
+	size := prefLen + _customSize(custom)
+	b = make([]byte, prefLen, size)
+	keys := custom.Keys()
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i > 0 {
+			b = append(b, customSepa...)
+		}
+		b = append(b, k...)
+		b = append(b, customSepa...)
+		b = append(b, custom[k]...)
+	}
+	b[0] = cmn.MetaverLOM
+	b[1] = mdCksumTyXXHash
+	binary.BigEndian.PutUint64(b[2:], xxhash.Checksum64S(b[prefLen:], cos.MLCG32))
+	return b
+}
+
+// packed size of custom metadata (not including the record's key)
+func _customSize(custom cos.StrKVs) (size int) {
+	for k, v := range custom {
+		size += len(k) + len(v) + 2*len(customSepa)
+	}
+	return size
+}
+
+// write sidecar at the main location; if successful, replicate
+// to copies (if any) on a best-effort basis
+func (lom *LOM) writeSidecar(b []byte) error {
+	fqn := sidecarFQN(lom.mi, lom.Bucket(), lom.ObjName)
+	if err := _writeSidecar(fqn, b); err != nil {
+		return err
+	}
+	for copyFQN, mi := range lom.md.copies {
+		if copyFQN == lom.FQN || mi == nil {
+			continue
+		}
+		if err := _writeSidecar(sidecarFQN(mi, lom.Bucket(), lom.ObjName), b); err != nil {
+			nlog.Errorln("failed to write sidecar for", copyFQN, "err:", err)
+		}
+	}
+	return nil
+}
+
+// replicate existing sidecar to a new location: mirror copy or copy
+// in another bucket (unchanged sidecars are not rewritten - see pack())
+func (lom *LOM) copySidecar(mi *fs.Mountpath, bck *cmn.Bck, objName string) error {
+	if lom.md.sidecar == 0 {
+		return nil
+	}
+	b, err := os.ReadFile(sidecarFQN(lom.mi, lom.Bucket(), lom.ObjName))
+	if err != nil {
+		return err
+	}
+	return _writeSidecar(sidecarFQN(mi, bck, objName), b)
+}
+
+func _writeSidecar(fqn string, b []byte) error {
+	tmp := fqn + sidecarTmp
+	fh, err := cos.CreateFile(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err = fh.Write(b); err != nil {
+		cos.Close(fh)
+		goto rm
+	}
+	if err = cos.FlushClose(fh); err != nil {
+		goto rm
+	}
+	if err = os.Rename(tmp, fqn); err == nil {
+		return nil
+	}
+rm:
+	if nested := cos.RemoveFile(tmp); nested != nil {
+		nlog.Errorln("nested err:", nested)
+	}
+	return err
+}
+
+// load custom metadata from the sidecar referenced by `md.sidecar`
+func (lom *LOM) loadSidecar(md *lmeta) error {
+	debug.Assert(md.sidecar != 0)
+	fqn := sidecarFQN(lom.mi, lom.Bucket(), lom.ObjName)
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return cmn.NewErrLmetaCorrupted(fmt.Errorf("%s: failed to read custom metadata sidecar: %w", lom.Cname(), err))
+	}
+	if len(b) < prefLen || b[0] != cmn.MetaverLOM || b[1] != mdCksumTyXXHash {
+		return cmn.NewErrLmetaCorrupted(errors.New(badLmeta + ": invalid sidecar " + fqn))
+	}
+	var (
+		expected = binary.BigEndian.Uint64(b[2:])
+		actual   = xxhash.Checksum64S(b[prefLen:], cos.MLCG32)
+	)
+	if expected != actual {
+		return cmn.NewErrLmetaCorrupted(cos.NewErrMetaCksum(expected, actual, fqn))
+	}
+	if expected != md.sidecar {
+		// stale (or foreign) sidecar
+		return cmn.NewErrLmetaCorrupted(cos.NewErrMetaCksum(md.sidecar, expected, fqn))
+	}
+	md.SetCustomMD(_unpackCustom(string(b[prefLen:])))
+	return nil
+}
+
+// remove sidecars at all locations (no longer needed or object removed)
+func (lom *LOM) rmSidecars() {
+	if err := cos.RemoveFile(sidecarFQN(lom.mi, lom.Bucket(), lom.ObjName)); err != nil {
+		nlog.Errorln("failed to remove sidecar of", lom.Cname(), "err:", err)
+	}
+	for copyFQN, mi := range lom.md.copies {
+		if copyFQN == lom.FQN || mi == nil {
+			continue
+		}
+		if err := cos.RemoveFile(sidecarFQN(mi, lom.Bucket(), lom.ObjName)); err != nil {
+			nlog.Errorln("failed to remove sidecar of", copyFQN, "err:", err)
+		}
+	}
+}
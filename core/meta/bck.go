@@ -226,7 +226,20 @@ func (b *Bck) VersionConf() cmn.VersionConf {
 
 func (b *Bck) Allow(bit apc.AccessAttrs) error { return b.checkAccess(bit) }
 
+// frozen (temporarily read-only) bucket: no modifications by users and jobs alike
+func (b *Bck) CheckFrozen(oper string) error {
+	if b.Props != nil && b.Props.Frozen {
+		return cmn.NewErrBucketFrozen(b.String(), oper)
+	}
+	return nil
+}
+
 func (b *Bck) checkAccess(bit apc.AccessAttrs) (err error) {
+	if bit&apc.AccessModify != 0 {
+		if err := b.CheckFrozen((bit & apc.AccessModify).Describe(true /*all*/)); err != nil {
+			return err
+		}
+	}
 	if b.Props.Access.Has(bit) {
 		return
 	}
@@ -243,10 +256,16 @@ func (b *Bck) MaxPageSize() int64 {
 		// ref:
 		// - https://docs.aws.amazon.com/cli/latest/userguide/cli-usage-pagination.html#cli-usage-pagination-serverside
 		// - https://docs.openstack.org/swift/latest/api/pagination.html
-		if b == nil || b.Props == nil || b.Props.Extra.AWS.MaxPageSize == 0 {
+		if b == nil || b.Props == nil {
 			return apc.MaxPageSizeAWS
 		}
-		return b.Props.Extra.AWS.MaxPageSize
+		if b.Props.Extra.AWS.MaxPageSize != 0 {
+			return b.Props.Extra.AWS.MaxPageSize
+		}
+		if p, _ := cmn.GetS3Preset(b.Props.Extra.AWS.Preset); p != nil {
+			return p.MaxPageSize
+		}
+		return apc.MaxPageSizeAWS
 	case apc.GCP:
 		// ref: https://cloud.google.com/storage/docs/json_api/v1/objects/list#parameters
 		return apc.MaxPageSizeGCP
@@ -256,6 +275,9 @@ func (b *Bck) MaxPageSize() int64 {
 	case apc.OCI:
 		// ref: https://docs.oracle.com/en-us/iaas/api/#/en/objectstorage/20160918/Object/ListObjects
 		return apc.MaxPageSizeOCI
+	case apc.RGW:
+		// ref: https://docs.ceph.com/en/latest/radosgw/s3/bucketops/#get-bucket
+		return apc.MaxPageSizeAWS
 	default:
 		return 1000
 	}
@@ -121,4 +121,21 @@ var _ = Describe("Bck", func() {
 			),
 		)
 	})
+
+	Describe("Allow", func() {
+		It("should deny modifications of a frozen bucket", func() {
+			bck := meta.NewBck("a", apc.AIS, cmn.NsGlobal)
+			bck.Props = &cmn.Bprops{Access: apc.AccessAll, Frozen: true}
+			Expect(bck.Allow(apc.AceGET)).NotTo(HaveOccurred())
+			Expect(bck.Allow(apc.AceObjLIST)).NotTo(HaveOccurred())
+			Expect(bck.Allow(apc.AcePATCH)).NotTo(HaveOccurred()) // (to unfreeze)
+			for _, ace := range []apc.AccessAttrs{apc.AcePUT, apc.AceObjDELETE, apc.AceObjMOVE, apc.AceDestroyBucket} {
+				err := bck.Allow(ace)
+				Expect(cmn.IsErrBucketFrozen(err)).To(BeTrue())
+			}
+
+			bck.Props.Frozen = false
+			Expect(bck.Allow(apc.AcePUT)).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -153,8 +153,15 @@ type hrwList struct {
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+//
+// When requested (`spread`, see cmn.ECConf.SpreadFD) and targets are labeled with failure
+// domains (see Snode.Zone and Snode.Rack), the selection is spread across zones and, within each
+// zone, across racks - in a round-robin fashion that preserves the HRW order inside each domain.
+// In particular:
+// - the first target in the list is always the one returned by HrwName2T;
+// - a shorter list is always a prefix of a longer one (same as in the unlabeled case).
 
-func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error) {
+func (smap *Smap) HrwTargetList(uname *string, count int, spread bool) (sis Nodes, err error) {
 	const fmterr = "%v: required %d, available %d, %s"
 	cnt := smap.CountTargets()
 	if cnt < count {
@@ -163,7 +170,12 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	}
 	b := cos.UnsafeBptr(uname)
 	digest := xxhash.Checksum64S(*b, cos.MLCG32)
-	hlist := newHrwList(count)
+
+	n := count
+	if spread && smap.hasFailureDomains() {
+		n = cnt // sort them all
+	}
+	hlist := newHrwList(n)
 
 	for _, tsi := range smap.Tmap {
 		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
@@ -173,6 +185,9 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 		hlist.add(cs, tsi)
 	}
 	sis = hlist.get()
+	if n != count {
+		sis = spreadFD(sis, count)
+	}
 	if count != cnt && len(sis) < count {
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, len(sis), smap)
 		return nil, err
@@ -180,6 +195,60 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	return sis, nil
 }
 
+func (smap *Smap) hasFailureDomains() bool {
+	for _, tsi := range smap.Tmap {
+		if tsi.Zone != "" || tsi.Rack != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// given HRW-sorted targets, select `count` of them round-robin across zones
+// and, within each zone, across racks
+func spreadFD(sorted Nodes, count int) Nodes {
+	zones := groupBy(sorted, func(si *Snode) string { return si.Zone })
+	for i, zone := range zones {
+		racks := groupBy(zone, func(si *Snode) string { return si.Rack })
+		zones[i] = roundRobin(racks, len(zone))
+	}
+	return roundRobin(zones, min(count, len(sorted)))
+}
+
+// group nodes by a given key; groups are ordered by their respective first (ie., highest-weight) nodes
+func groupBy(sorted Nodes, key func(*Snode) string) []Nodes {
+	var (
+		groups = make([]Nodes, 0, 4)
+		index  = make(map[string]int, 4)
+	)
+	for _, si := range sorted {
+		k := key(si)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], si)
+	}
+	return groups
+}
+
+func roundRobin(groups []Nodes, count int) Nodes {
+	sis := make(Nodes, 0, count)
+	for r := 0; len(sis) < count; r++ {
+		for _, g := range groups {
+			if r < len(g) {
+				sis = append(sis, g[r])
+				if len(sis) == count {
+					break
+				}
+			}
+		}
+	}
+	return sis
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
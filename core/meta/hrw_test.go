@@ -0,0 +1,82 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HRW", func() {
+	// 3 racks x 3 targets, plus 2 unlabeled
+	newSmap := func(labeled bool) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, 11)}
+		for i := range 11 {
+			si := &meta.Snode{DaeID: "t" + strconv.Itoa(i), DaeType: apc.Target}
+			if labeled && i < 9 {
+				si.Zone, si.Rack = "z1", "r"+strconv.Itoa(i/3)
+			}
+			smap.Tmap[si.DaeID] = si
+		}
+		smap.InitDigests()
+		return smap
+	}
+
+	It("should spread targets across failure domains", func() {
+		smap := newSmap(true)
+		for i := range 1000 {
+			uname := "ais/@#/bck/obj-" + strconv.Itoa(i)
+			sis, err := smap.HrwTargetList(&uname, 8, true /*spread*/)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(8))
+
+			main, err := smap.HrwName2T([]byte(uname))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0].ID()).To(Equal(main.ID()))
+
+			short, err := smap.HrwTargetList(&uname, 5, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(short).To(Equal(sis[:5]))
+
+			// 4 domains (3 racks and unlabeled), 8 targets: at most 2 per domain
+			cnt := make(map[string]int, 4)
+			for _, si := range sis {
+				cnt[si.FailureDomain()]++
+				Expect(cnt[si.FailureDomain()]).To(BeNumerically("<=", 2))
+			}
+		}
+	})
+
+	It("should not change placement when targets are not labeled", func() {
+		smap := newSmap(false)
+		for i := range 100 {
+			uname := "ais/@#/bck/obj-" + strconv.Itoa(i)
+			sis, err := smap.HrwTargetList(&uname, 11, true)
+			Expect(err).NotTo(HaveOccurred())
+			short, err := smap.HrwTargetList(&uname, 4, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(short).To(Equal(sis[:4]))
+		}
+	})
+
+	It("should ignore labels unless spreading is enabled", func() {
+		labeled, plain := newSmap(true), newSmap(false)
+		for i := range 100 {
+			uname := "ais/@#/bck/obj-" + strconv.Itoa(i)
+			sis, err := labeled.HrwTargetList(&uname, 8, false)
+			Expect(err).NotTo(HaveOccurred())
+			expected, err := plain.HrwTargetList(&uname, 8, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(sis)).To(Equal(len(expected)))
+			for j := range sis {
+				Expect(sis[j].ID()).To(Equal(expected[j].ID()))
+			}
+		}
+	})
+})
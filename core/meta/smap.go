@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -77,7 +78,9 @@ type (
 		DaeID      string     `json:"daemon_id"`
 		name       string
 		PubExtra   []NetInfo    `json:"pub_extra,omitempty"`
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Flags      cos.BitFlags `json:"flags"`          // enum { SnodeNonElectable, SnodeIC, ... }
+		Zone       string       `json:"zone,omitempty"` // failure domain (optional; see cmn.FDomainConf)
+		Rack       string       `json:"rack,omitempty"` // ditto
 		idDigest   uint64
 	}
 
@@ -96,6 +99,37 @@ type (
 	}
 )
 
+// enum Election.Outcome
+const (
+	ElectionElected      = "elected"       // new primary elected
+	ElectionPrimaryAlive = "primary-alive" // election canceled: current primary responds
+	ElectionDeclined     = "declined"      // not triggered: election grace period or pre-vote (see cmn.KeepaliveConf)
+	ElectionFailed       = "failed"        // election phase 1 (prepare) failed
+)
+
+// Election is a record in the node's (in-memory, bounded) history of primary elections
+// and election triggers; see apc.WhatElections
+type Election struct {
+	Time      time.Time `json:"time"`
+	Primary   string    `json:"primary"`   // primary that was (suspected to be) down
+	Candidate string    `json:"candidate"` // next primary in line
+	Initiator string    `json:"initiator"`
+	Outcome   string    `json:"outcome"`
+	Details   string    `json:"details,omitempty"`
+}
+
+// MetaHist is a record in the node's (persistent, bounded) history of cluster map
+// and BMD versions; see apc.WhatSmapHist and apc.WhatBMDHist
+type MetaHist struct {
+	Smap    *Smap     `json:"smap,omitempty"`
+	BMD     *BMD      `json:"bmd,omitempty"`
+	Time    time.Time `json:"time"`             // when this node installed the version
+	Action  string    `json:"action,omitempty"` // action that caused the change (apc.Act*), if known
+	Name    string    `json:"name,omitempty"`   // action's subject: node ID, bucket, etc.
+	Actor   string    `json:"actor"`            // node that made the change (primary)
+	Version int64     `json:"version,string"`
+}
+
 ///////////
 // Snode //
 ///////////
@@ -152,6 +186,9 @@ func (d *Snode) StringEx() string {
 	return Tname(d.DaeID)
 }
 
+// failure domain: "zone/rack" (either or both may be empty)
+func (d *Snode) FailureDomain() string { return d.Zone + "/" + d.Rack }
+
 func (d *Snode) StrURLs() string {
 	if d.PubNet.URL != d.ControlNet.URL ||
 		d.PubNet.URL != d.DataNet.URL {
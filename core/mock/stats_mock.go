@@ -27,6 +27,7 @@ func NewStatsTracker() stats.Tracker {
 func (*StatsTracker) StartedUp() bool                                           { return true }
 func (*StatsTracker) Get(string) int64                                          { return 0 }
 func (*StatsTracker) Inc(string)                                                {}
+func (*StatsTracker) SetGauge(string, int64)                                    {}
 func (*StatsTracker) IncWith(string, map[string]string)                         {}
 func (*StatsTracker) IncBck(string, *cmn.Bck)                                   {}
 func (*StatsTracker) Add(string, int64)                                         {}
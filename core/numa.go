@@ -0,0 +1,28 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+// NumaDone accounts for a single object processed by a per-mountpath worker (or jogger)
+// that is (or is not) pinned to its NUMA node; compare average latencies
+// numa.pinned.ns.total/numa.pinned.n vs numa.unpinned.ns.total/numa.unpinned.n
+// before and after enabling memsys.numa
+func NumaDone(pinned bool, elapsed int64) {
+	if g.tstats == nil {
+		return // (unit tests)
+	}
+	if pinned {
+		g.tstats.AddWith(
+			cos.NamedVal64{Name: NumaPinnedCount, Value: 1},
+			cos.NamedVal64{Name: NumaPinnedLatencyTotal, Value: elapsed},
+		)
+	} else {
+		g.tstats.AddWith(
+			cos.NamedVal64{Name: NumaUnpinnedCount, Value: 1},
+			cos.NamedVal64{Name: NumaUnpinnedLatencyTotal, Value: elapsed},
+		)
+	}
+}
@@ -54,11 +54,13 @@ type (
 		String() string
 		Name() string
 		Cname() string
+		User() string // submitter
 
 		// modifiers
 		Finish()
 		Abort(error) bool
 		AddNotif(n Notif)
+		SetUser(user string)
 
 		// common stats
 		Objs() int64
@@ -92,11 +94,15 @@ type (
 		ID        string    `json:"id"`
 		Kind      string    `json:"kind"`
 		CtlMsg    string    `json:"ctlmsg,omitempty"` // initiating control msg (added v3.26)
+		User      string    `json:"user,omitempty"`   // submitter (AuthN user ID), if known
 
 		// extended error info
 		AbortErr string `json:"abort-err"`
 		Err      string `json:"err"`
 
+		// finished early, with partial results (e.g., upon reaching apc.JobQuota)
+		Partial string `json:"partial,omitempty"`
+
 		// rebalance-only
 		RebID int64 `json:"glob.id,string"`
 
@@ -104,6 +110,15 @@ type (
 		Stats    Stats `json:"stats"`
 		AbortedX bool  `json:"aborted"`
 		IdleX    bool  `json:"is_idle"`
+
+		// throughput history, upon request (see xact.QueryMsg.Timeline)
+		Timeline []Sample `json:"timeline,omitempty"`
+	}
+	// periodic sample of the (cumulative) locally processed counters (see xact/timeline.go)
+	Sample struct {
+		Time  int64 `json:"t,string"` // unix nano
+		Objs  int64 `json:"objs,string"`
+		Bytes int64 `json:"bytes,string"`
 	}
 	AllRunningInOut struct {
 		Kind    string
@@ -132,6 +132,9 @@ cat > $AIS_CONF_FILE <<EOL
 		"error_limit":    2,
 		"io_err_limit":   10,
 		"io_err_time":    "10s",
+		"test_file_size": "1MiB",
+		"probe_count":    3,
+		"probe_interval": "10m",
 		"enabled":        true
 	},
 	"auth": {
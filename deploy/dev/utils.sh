@@ -38,7 +38,7 @@ run_cmd() {
 # 3. when adding/deleting backends, update the 3 (three) functions that follow below:
 
 set_env_backends() {
-  known_backends=( aws gcp azure oci ht )
+  known_backends=( aws gcp azure oci rgw ht )
   if [[ ! -z $TAGS ]]; then
     ## environment var TAGS may contain any/all build tags, including backends
     for b in "${known_backends[@]}"; do
@@ -64,6 +64,7 @@ set_env_backends() {
         azure) ;;
         gcp)   ;;
         oci)   ;;
+        rgw)   ;;
         ht)    ;;
         *)     echo "fatal: unknown backend '$b' in 'AIS_BACKEND_PROVIDERS=${AIS_BACKEND_PROVIDERS}'"; exit 1;;
       esac
@@ -117,6 +118,15 @@ _set_env_backends() {
   if  [[ "${cld_oci}" == "y" ]] ; then
     AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} oci"
   fi
+  echo "Ceph RGW: (y/n) ?"
+  read -r cld_rgw
+  if [[ "$cld_rgw" == "" ]] ; then
+    return
+  fi
+  is_boolean "${cld_rgw}"
+  if  [[ "${cld_rgw}" == "y" ]] ; then
+    AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} rgw"
+  fi
 }
 
 make_backend_conf() {
@@ -127,6 +137,7 @@ make_backend_conf() {
       azure) backend_conf+=('"azure": {}') ;;
       gcp)   backend_conf+=('"gcp":   {}') ;;
       oci)   backend_conf+=('"oci":   {}') ;;
+      rgw)   backend_conf+=("\"rgw\":   {\"endpoint\": \"${RGW_ENDPOINT:-http://localhost:7480}\"}") ;;
       ht)    backend_conf+=('"ht":    {}') ;;
     esac
   done
@@ -204,7 +204,9 @@ func (r *XactBckEncode) afterEncode(lom *core.LOM, err error) {
 		r.LomAdd(lom)
 	} else if err != errSkipped {
 		r.AddErr(err)
-		nlog.Errorln(r.Name(), "failed to ec-encode", lom.Cname(), "err:", err)
+		if lom != nil {
+			nlog.Errorln(r.Name(), "failed to ec-encode", lom.Cname(), "err:", err)
+		}
 	}
 	r.wg.Done()
 }
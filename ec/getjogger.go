@@ -76,7 +76,7 @@ func (c *getJogger) newCtx(req *request) (*restoreCtx, error) {
 	ctx.toDisk = useDisk(0 /*size of the original object is unknown*/, c.parent.config)
 	ctx.lom = lom
 	err = lom.Load(true /*cache it*/, false /*locked*/)
-	if os.IsNotExist(err) {
+	if os.IsNotExist(err) || cmn.IsErrLmetaCorrupted(err) { // (the latter: to be overwritten - see x-verify-checksum)
 		err = nil
 	}
 	return ctx, err
@@ -155,7 +155,7 @@ func (c *getJogger) copyMissingReplicas(ctx *restoreCtx, reader cos.ReadOpenClos
 		return err
 	}
 	smap := core.T.Sowner().Get()
-	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), ctx.meta.Parity+1)
+	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), ctx.meta.Parity+1, ctx.lom.Bprops().EC.SpreadFD)
 	if err != nil {
 		return err
 	}
@@ -647,7 +647,7 @@ func (*getJogger) emptyTargets(ctx *restoreCtx) ([]string, error) {
 	}
 	// Generate the list of targets that should have a slice.
 	smap := core.T.Sowner().Get()
-	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), sliceCnt+1)
+	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), sliceCnt+1, ctx.lom.Bprops().EC.SpreadFD)
 	if err != nil {
 		nlog.Warningln(err)
 		return nil, err
@@ -101,7 +101,7 @@ func (mgr *Manager) OpenStreams(withRefc bool) {
 		client      = transport.NewIntraDataClient()
 		config      = cmn.GCO.Get()
 		compression = config.EC.Compression
-		extraReq    = transport.Extra{Callback: cbReq, Compression: compression, Config: config}
+		extraReq    = transport.Extra{Callback: cbReq, Compression: compression, Config: config, QoS: transport.QoSEC}
 	)
 	reqSbArgs := bundle.Args{
 		Multiplier: config.EC.SbundleMult,
@@ -113,7 +113,7 @@ func (mgr *Manager) OpenStreams(withRefc bool) {
 		Multiplier: config.EC.SbundleMult,
 		Trname:     RespStreamName,
 		Net:        mgr.netResp,
-		Extra:      &transport.Extra{Compression: compression, Config: config},
+		Extra:      &transport.Extra{Compression: compression, Config: config, QoS: transport.QoSEC},
 	}
 
 	mgr.reqBundle.Store(bundle.New(client, reqSbArgs))
@@ -255,28 +255,50 @@ func (mgr *Manager) recvResponse(hdr *transport.ObjHdr, objReader io.Reader, err
 //   - intra - if true, it is internal request and has low priority
 //   - cb - optional callback that is called after the object is encoded
 func (mgr *Manager) EncodeObject(lom *core.LOM, cb onFin) error {
+	req, err := mgr.splitReq(lom)
+	if err != nil {
+		return err
+	}
+	if cb != nil {
+		req.rebuild = true
+		req.Callback = cb
+	}
+	mgr.RestoreBckPutXact(lom.Bck()).encode(req, lom)
+	return nil
+}
+
+// EncodeOnPut encodes a newly written object as per bucket's `ec.encode_mode`:
+//   - async: same as EncodeObject (no callback)
+//   - inline: wait for the object to be encoded (bounded by `timeout.send_file_time`);
+//     return the resulting error, if any
+//   - lazy: schedule encoding `ec.lazy_delay` from now; fall back to async when
+//     too many objects are already waiting
+//
+// The caller must not hold the object's lock.
+func (mgr *Manager) EncodeOnPut(lom *core.LOM) error {
+	req, err := mgr.splitReq(lom)
+	if err != nil {
+		return err
+	}
+	xctn := mgr.RestoreBckPutXact(lom.Bck())
+	return xctn.encodeOnPut(req, lom, &lom.Bprops().EC)
+}
+
+func (*Manager) splitReq(lom *core.LOM) (*request, error) {
 	if !lom.ECEnabled() {
-		return ErrorECDisabled
+		return nil, ErrorECDisabled
 	}
 	cs := fs.Cap()
 	if err := cs.Err(); err != nil {
-		return err
+		return nil, err
 	}
 	spec, _ := fs.CSM.FileSpec(lom.FQN)
 	if spec != nil && !spec.PermToProcess() {
-		return errSkipped
+		return nil, errSkipped
 	}
-
 	req := allocateReq(ActSplit, lom.LIF())
 	req.IsCopy = IsECCopy(lom.Lsize(), &lom.Bprops().EC)
-	if cb != nil {
-		req.rebuild = true
-		req.Callback = cb
-	}
-
-	mgr.RestoreBckPutXact(lom.Bck()).encode(req, lom)
-
-	return nil
+	return req, nil
 }
 
 func (mgr *Manager) CleanupObject(lom *core.LOM) {
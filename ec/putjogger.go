@@ -114,11 +114,14 @@ func (c *putJogger) do(req *request) {
 		if cmn.Rom.FastV(4, cos.SmoduleEC) {
 			nlog.Warningln(err)
 		}
+		if req.Callback != nil {
+			req.Callback(nil, err) // (e.g., bucket destroyed)
+		}
 		return
 	}
 	c.parent.IncPending()
 
-	c._do(req, lom)
+	err = c._do(req, lom)
 
 	if req.Callback != nil {
 		req.Callback(lom, err)
@@ -127,13 +130,13 @@ func (c *putJogger) do(req *request) {
 	c.parent.DecPending()
 }
 
-func (c *putJogger) _do(req *request, lom *core.LOM) {
+func (c *putJogger) _do(req *request, lom *core.LOM) (err error) {
 	if req.Action == ActSplit {
-		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		if err = lom.Load(false /*cache it*/, false /*locked*/); err != nil {
 			if cmn.Rom.FastV(4, cos.SmoduleEC) {
 				nlog.Warningln(err)
 			}
-			return
+			return err
 		}
 		ecConf := lom.Bprops().EC
 		memRequired := lom.Lsize() * int64(ecConf.DataSlices+ecConf.ParitySlices) / int64(ecConf.ParitySlices)
@@ -144,7 +147,7 @@ func (c *putJogger) _do(req *request, lom *core.LOM) {
 	c.parent.stats.updateWaitTime(now.Sub(req.tm))
 	req.tm = now
 
-	if err := c.ec(req, lom); err != nil {
+	if err = c.ec(req, lom); err != nil {
 		err = cmn.NewErrFailedTo(core.T, req.Action, lom.Cname(), err)
 		c.parent.AddErr(err, 0)
 	}
@@ -159,6 +162,7 @@ func (c *putJogger) _do(req *request, lom *core.LOM) {
 			}
 		}
 	}
+	return err
 }
 
 func (c *putJogger) stop() {
@@ -256,7 +260,7 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 	if err != nil {
 		return err
 	}
-	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), reqTargets)
+	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), reqTargets, ctx.lom.Bprops().EC.SpreadFD)
 	if err != nil {
 		return err
 	}
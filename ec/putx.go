@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -33,6 +34,14 @@ type (
 		xactECBase
 		xactReqBase
 		putJoggers map[string]*putJogger // mountpath joggers for PUT/DEL
+		lazy       struct {
+			reqs []lazyReq // FIFO (see apc.ECEncodeLazy)
+			mu   sync.Mutex
+		}
+	}
+	lazyReq struct {
+		req *request
+		due int64 // mono time
 	}
 	// extended x-ec-put statistics
 	ExtECPutStats struct {
@@ -45,6 +54,7 @@ type (
 		DeleteErrCount int64        `json:"ec.delete.err.n,string"`
 		AvgObjTime     cos.Duration `json:"ec.obj.process.ns"`
 		AvgQueueLen    float64      `json:"ec.queue.len.f"`
+		LazyPending    int64        `json:"ec.lazy.pending.n,string"` // backlog: objects waiting to be encoded (lazy)
 		IsIdle         bool         `json:"is_idle"`
 	}
 )
@@ -167,16 +177,21 @@ func (r *XactPut) Run(gowg *sync.WaitGroup) {
 	gowg.Done()
 
 	ticker := time.NewTicker(r.config.Periodic.StatsTime.D())
-	r.mainLoop(ticker)
+	lazyTicker := time.NewTicker(lazyTickTime)
+	r.mainLoop(ticker, lazyTicker)
 	ticker.Stop()
+	lazyTicker.Stop()
+	r.clearLazy()
 	wg.Wait()
 	// not closing stream bundles as they are shared across EC xactions
 	r.Finish()
 }
 
-func (r *XactPut) mainLoop(ticker *time.Ticker) {
+func (r *XactPut) mainLoop(ticker, lazyTicker *time.Ticker) {
 	for {
 		select {
+		case <-lazyTicker.C:
+			r.dispatchLazy()
 		case <-ticker.C:
 			if cmn.Rom.FastV(4, cos.SmoduleEC) {
 				if s := fmt.Sprintf("%v", r.Snap()); s != "" {
@@ -218,12 +233,140 @@ func (r *XactPut) stop() {
 
 // Encode schedules FQN for erasure coding process
 func (r *XactPut) encode(req *request, lom *core.LOM) {
+	if err := r.dispatch(req, lom); err != nil {
+		nlog.Errorf("failed to encode %s: %v", lom, err)
+		freeReq(req)
+	}
+}
+
+// see Manager.EncodeOnPut
+func (r *XactPut) encodeOnPut(req *request, lom *core.LOM, ecConf *cmn.ECConf) error {
+	switch ecConf.EncodeMode {
+	case apc.ECEncodeInline:
+		return r.encodeInline(req, lom, r.config.Timeout.SendFile.D())
+	case apc.ECEncodeLazy:
+		r.encodeLazy(req, lom, ecConf.LazyDelay.D())
+	default:
+		r.encode(req, lom)
+	}
+	return nil
+}
+
+// wait for the object to get encoded - but not past x-ec-put abort or timeout
+// (in the latter case, encoding continues in the background)
+func (r *XactPut) encodeInline(req *request, lom *core.LOM, timeout time.Duration) error {
+	errCh := make(chan error, 1)
+	req.Callback = func(_ *core.LOM, err error) { errCh <- err }
+
+	r.IncPending() // (keeps demand xaction from idling out)
+	defer r.DecPending()
+	if err := r.dispatch(req, lom); err != nil {
+		freeReq(req)
+		return err
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-errCh:
+		return err
+	case err := <-r.ChanAbort():
+		return cmn.NewErrAborted(r.Name(), "inline encode "+lom.Cname(), err)
+	case <-timer.C:
+		return fmt.Errorf("%s: timed out waiting for %s to get encoded (%v)", r, lom.Cname(), timeout)
+	}
+}
+
+func (r *XactPut) dispatch(req *request, lom *core.LOM) error {
 	now := time.Now()
 	req.putTime, req.tm = now, now
-	if err := r.dispatchRequest(req, lom); err != nil {
-		nlog.Errorf("failed to encode %s: %v", lom, err)
+	return r.dispatchRequest(req, lom)
+}
+
+//
+// lazy encoding (see apc.ECEncodeLazy)
+// - requests are kept in memory, in FIFO order; pending requests are discarded when x-ec-put stops
+// (use x-ec-encode to encode all not yet encoded objects in a bucket)
+// - the number of pending requests is limited by lazyMaxPending; beyond that, encoding becomes async
+//
+
+const (
+	lazyTickTime   = time.Second
+	lazyMaxPending = 64 * 1024
+)
+
+func (r *XactPut) encodeLazy(req *request, lom *core.LOM, delay time.Duration) {
+	if !r.ecRequestsEnabled() {
+		nlog.Errorf("failed to encode %s: %v", lom, ErrorECDisabled)
 		freeReq(req)
+		return
+	}
+	r.lazy.mu.Lock()
+	if len(r.lazy.reqs) >= lazyMaxPending {
+		r.lazy.mu.Unlock()
+		if cmn.Rom.FastV(4, cos.SmoduleEC) {
+			nlog.Warningln(r.Name(), "lazy encode: too many pending requests - encoding", lom.Cname(), "now")
+		}
+		r.encode(req, lom)
+		return
+	}
+	r.IncPending() // (keeps demand xaction from idling out)
+	r.lazy.reqs = append(r.lazy.reqs, lazyReq{req: req, due: mono.NanoTime() + delay.Nanoseconds()})
+	r.lazy.mu.Unlock()
+}
+
+func (r *XactPut) lazyPending() (n int) {
+	r.lazy.mu.Lock()
+	n = len(r.lazy.reqs)
+	r.lazy.mu.Unlock()
+	return
+}
+
+func (r *XactPut) dispatchLazy() {
+	var (
+		now = mono.NanoTime()
+		due []lazyReq
+	)
+	r.lazy.mu.Lock()
+	n := 0
+	for n < len(r.lazy.reqs) && r.lazy.reqs[n].due <= now {
+		n++
+	}
+	if n > 0 {
+		due = make([]lazyReq, n)
+		copy(due, r.lazy.reqs[:n])
+		r.lazy.reqs = r.lazy.reqs[n:]
+	}
+	r.lazy.mu.Unlock()
+
+	for _, lr := range due {
+		r.DecPending()
+		lom, err := lr.req.LIF.LOM()
+		if err == nil {
+			if err = lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+				err = r.dispatch(lr.req, lom)
+			}
+			core.FreeLOM(lom)
+		}
+		if err != nil {
+			if cmn.Rom.FastV(4, cos.SmoduleEC) {
+				nlog.Warningln(r.Name(), "lazy encode:", err) // e.g., deleted in the meantime
+			}
+			freeReq(lr.req)
+		}
+	}
+}
+
+func (r *XactPut) clearLazy() {
+	r.lazy.mu.Lock()
+	for _, lr := range r.lazy.reqs {
+		freeReq(lr.req)
+		r.DecPending()
+	}
+	if n := len(r.lazy.reqs); n > 0 {
+		nlog.Warningln(r.Name(), "discarding", n, "pending lazy-encode requests")
 	}
+	r.lazy.reqs = nil
+	r.lazy.mu.Unlock()
 }
 
 // Cleanup deletes all object slices or copies after the main object is removed
@@ -250,6 +393,7 @@ func (r *XactPut) Snap() (snap *core.Snap) {
 		DeleteCount:    st.DelReq,
 		AvgObjTime:     cos.Duration(st.ObjTime),
 		AvgQueueLen:    st.QueueLen,
+		LazyPending:    int64(r.lazyPending()),
 		IsIdle:         r.Pending() == 0,
 	}
 
@@ -0,0 +1,150 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+const ecTestMpath = "/tmp/ec-test-mpath"
+
+var ecTestBck = cmn.Bck{Name: "ec-put", Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+func TestMain(m *testing.M) {
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	config.Timeout.SendFile = cos.Duration(time.Minute)
+	cmn.GCO.CommitUpdate(config)
+
+	hk.TestInit()
+	xreg.Init()
+	cos.InitShortID(0)
+	fs.TestNew(nil)
+	_ = cos.CreateDir(ecTestMpath)
+	_, _ = fs.Add(ecTestMpath, "daeID")
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+
+	props := &cmn.Bprops{EC: cmn.ECConf{Enabled: true, DataSlices: 1, ParitySlices: 1}, BID: 1}
+	bck := meta.CloneBck(&ecTestBck)
+	bck.Props = props
+	_ = mock.NewTarget(mock.NewBaseBownerMock(bck))
+
+	rc := m.Run()
+	os.RemoveAll(ecTestMpath)
+	os.Exit(rc)
+}
+
+// x-ec-put with a single (not running) mountpath jogger
+func newTestPutXact(t *testing.T) (*XactPut, *putJogger) {
+	r := newPutXact(&ecTestBck, nil)
+	r.DemandBase.Init(cos.GenUUID(), apc.ActECPut, "", meta.CloneBck(&ecTestBck), 0)
+	t.Cleanup(func() { r.clearLazy() })
+	j, ok := r.putJoggers[ecTestMpath]
+	tassert.Fatalf(t, ok && len(r.putJoggers) == 1, "expecting a single jogger, got %d", len(r.putJoggers))
+	return r, j
+}
+
+func newTestReq(t *testing.T, objName string) (*request, *core.LOM) {
+	lom := core.AllocLOM(objName)
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	tassert.CheckFatal(t, lom.InitBck(&ecTestBck))
+	return allocateReq(ActSplit, lom.LIF()), lom
+}
+
+// mock jogger: completes n requests with the given error
+func runTestJogger(j *putJogger, n int, err error) {
+	go func() {
+		for range n {
+			req := <-j.putCh
+			if req.Callback != nil {
+				req.Callback(nil, err)
+			}
+			freeReq(req)
+		}
+	}()
+}
+
+func TestEncodeOnPutAsync(t *testing.T) {
+	r, j := newTestPutXact(t)
+	req, lom := newTestReq(t, "async")
+	err := r.encodeOnPut(req, lom, &cmn.ECConf{EncodeMode: apc.ECEncodeAsync})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(j.putCh) == 1, "expecting async request to be dispatched, got %d", len(j.putCh))
+	tassert.Errorf(t, r.Pending() == 0, "async: expecting no pending, got %d", r.Pending())
+}
+
+func TestEncodeOnPutInline(t *testing.T) {
+	r, j := newTestPutXact(t)
+
+	// completes
+	runTestJogger(j, 1, nil)
+	req, lom := newTestReq(t, "inline")
+	tassert.CheckFatal(t, r.encodeOnPut(req, lom, &cmn.ECConf{EncodeMode: apc.ECEncodeInline}))
+
+	// fails
+	errEncode := errors.New("encode failed")
+	runTestJogger(j, 1, errEncode)
+	req, lom = newTestReq(t, "inline-err")
+	err := r.encodeOnPut(req, lom, &cmn.ECConf{EncodeMode: apc.ECEncodeInline})
+	tassert.Errorf(t, errors.Is(err, errEncode), "expecting %v, got %v", errEncode, err)
+
+	// times out (nobody is processing)
+	req, lom = newTestReq(t, "inline-timeout")
+	err = r.encodeInline(req, lom, 10*time.Millisecond)
+	tassert.Errorf(t, err != nil, "expecting timeout")
+	tassert.Errorf(t, r.Pending() == 0, "inline: expecting no pending, got %d", r.Pending())
+
+	// x-ec-put aborts
+	<-j.putCh
+	time.AfterFunc(10*time.Millisecond, func() { r.Abort(errors.New("test abort")) })
+	req, lom = newTestReq(t, "inline-abort")
+	err = r.encodeInline(req, lom, time.Minute)
+	tassert.Errorf(t, cmn.IsErrAborted(err), "expecting aborted, got %v", err)
+}
+
+func TestEncodeOnPutLazy(t *testing.T) {
+	r, j := newTestPutXact(t)
+	ecConf := &cmn.ECConf{EncodeMode: apc.ECEncodeLazy, LazyDelay: cos.Duration(time.Hour)}
+
+	req, lom := newTestReq(t, "lazy")
+	tassert.CheckFatal(t, r.encodeOnPut(req, lom, ecConf))
+	r.dispatchLazy() // not due yet
+	tassert.Errorf(t, r.lazyPending() == 1 && len(j.putCh) == 0, "expecting 1 lazy pending, got (%d, %d)",
+		r.lazyPending(), len(j.putCh))
+	tassert.Errorf(t, r.Pending() == 1, "lazy: expecting pending, got %d", r.Pending())
+
+	// full: fall back to async
+	r.lazy.mu.Lock()
+	saved := r.lazy.reqs
+	r.lazy.reqs = make([]lazyReq, lazyMaxPending)
+	r.lazy.mu.Unlock()
+
+	req, lom = newTestReq(t, "lazy-full")
+	tassert.CheckFatal(t, r.encodeOnPut(req, lom, ecConf))
+	tassert.Errorf(t, len(j.putCh) == 1, "full lazy queue: expecting async dispatch, got %d", len(j.putCh))
+
+	r.lazy.mu.Lock()
+	r.lazy.reqs = saved
+	r.lazy.mu.Unlock()
+
+	// discarded when x-ec-put stops
+	r.clearLazy()
+	tassert.Errorf(t, r.lazyPending() == 0 && r.Pending() == 0, "expecting lazy queue to be cleared, got (%d, %d)",
+		r.lazyPending(), r.Pending())
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
 	"regexp"
 	"strings"
@@ -26,10 +27,19 @@ const (
 	TypeRange   Type = "range"
 	TypeMulti   Type = "multi"
 	TypeBackend Type = "backend"
+	TypeHF      Type = "hf"      // Hugging Face Hub
+	TypeTorrent Type = "torrent" // BitTorrent metainfo or magnet link (web seeds only)
 )
 
 const PrefixJobID = "dnl-"
 
+// job status (see AdminBody.Status)
+const (
+	JobStatusRunning  = "running"
+	JobStatusFinished = "finished"
+	JobStatusAborted  = "aborted"
+)
+
 const DownloadProgressInterval = 10 * time.Second
 
 type (
@@ -51,12 +61,18 @@ type (
 		StartedTime   time.Time `json:"started_time"`
 		FinishedTime  time.Time `json:"finished_time"`
 		FinishedCnt   int       `json:"finished_cnt"`
-		ScheduledCnt  int       `json:"scheduled_cnt"` // tasks being processed or already processed by dispatched
-		SkippedCnt    int       `json:"skipped_cnt"`   // number of tasks skipped
+		ScheduledCnt  int       `json:"scheduled_cnt"`          // tasks being processed or already processed by dispatched
+		SkippedCnt    int       `json:"skipped_cnt"`            // number of tasks skipped
+		FilteredCnt   int       `json:"filtered_cnt,omitempty"` // tasks skipped by size filter upon download (see Filter)
 		ErrorCnt      int       `json:"error_cnt"`
-		Total         int       `json:"total"`          // total number of tasks, negative if unknown
-		AllDispatched bool      `json:"all_dispatched"` // if true, dispatcher has already scheduled all tasks for given job
+		ResumedCnt    int       `json:"resumed_cnt,omitempty"`          // tasks resumed from checkpoint (see checkpoint.go)
+		ResumedBytes  int64     `json:"resumed_bytes,string,omitempty"` // bytes not downloaded again
+		Priority      int       `json:"priority,omitempty"`             // (see Base.Priority)
+		Bandwidth     int64     `json:"bandwidth,string,omitempty"`     // currently allocated bytes per second (see bwsched.go)
+		Total         int       `json:"total"`                          // total number of tasks, negative if unknown
+		AllDispatched bool      `json:"all_dispatched"`                 // if true, dispatcher has already scheduled all tasks for given job
 		Aborted       bool      `json:"aborted"`
+		Paused        bool      `json:"paused,omitempty"` // not issuing new tasks until resumed (see PauseJob)
 	}
 
 	JobInfos []*Job
@@ -66,6 +82,13 @@ type (
 		CurrentTasks  []TaskDlInfo  `json:"current_tasks,omitempty"`
 		FinishedTasks []TaskDlInfo  `json:"finished_tasks,omitempty"`
 		Errs          []TaskErrInfo `json:"download_errors,omitempty"`
+
+		// consolidated progress (see AdminBody.Progress):
+		// - bytes downloaded so far and, when known, total bytes (both over current and finished tasks);
+		// - per-target counters, by target ID
+		DownloadedBytes int64           `json:"downloaded_bytes,string,omitempty"`
+		TotalBytes      int64           `json:"total_bytes,string,omitempty"`
+		Targets         map[string]*Job `json:"targets,omitempty"`
 	}
 
 	Limits struct {
@@ -79,6 +102,14 @@ type (
 		Timeout          string  `json:"timeout"`
 		ProgressInterval string  `json:"progress_interval"`
 		Limits           Limits  `json:"limits"`
+		// relative share of `downloader.max_bandwidth` when competing with other jobs (see bwsched.go)
+		Priority int `json:"priority,omitempty"`
+		// derive destination names from source URLs (range, multi-link, and unnamed single downloads)
+		Naming *NamingRules `json:"naming,omitempty"`
+		// skip unwanted files (by extension, name, and size) prior to creating download tasks
+		Filter *Filter `json:"filter,omitempty"`
+		// upon completion, store integrity manifest in the destination bucket (see manifest.go)
+		Manifest bool `json:"manifest,omitempty"`
 	}
 
 	SingleObj struct {
@@ -90,7 +121,10 @@ type (
 	AdminBody struct {
 		ID         string `json:"id"`
 		Regex      string `json:"regex"`
-		OnlyActive bool   `json:"only_active_tasks"` // Skips detailed info about tasks finished/errored
+		OnlyActive bool   `json:"only_active_tasks"`  // Skips detailed info about tasks finished/errored
+		Spec       bool   `json:"spec,omitempty"`     // GET the original (sanitized) job spec (requires ID)
+		Progress   bool   `json:"progress,omitempty"` // GET consolidated cluster-wide progress, no per-task details (requires ID)
+		Status     string `json:"status,omitempty"`   // list only jobs with this status: JobStatusRunning, et al.
 	}
 
 	TaskDlInfo struct {
@@ -130,11 +164,30 @@ type (
 		Base
 		ObjectsPayload any `json:"objects"`
 	}
+
+	// Hugging Face Hub repository (model, dataset, or space)
+	HFBody struct {
+		Base
+		Repo     string `json:"repo"`            // ORG/NAME
+		RepoType string `json:"repo_type"`       // one of: HFRepoModel (default), HFRepoDataset, HFRepoSpace
+		Revision string `json:"revision"`        // branch, tag, or commit (default "main")
+		Path     string `json:"path"`            // optional subdirectory in the repository
+		Subdir   string `json:"subdir"`          // optional destination virtual directory
+		Token    string `json:"token,omitempty"` // HF access token (gated and private repos); targets' $HF_TOKEN otherwise
+	}
+
+	// BitTorrent (see torrent.go)
+	TorrentBody struct {
+		Base
+		Torrent  string `json:"torrent"`            // .torrent URL or magnet link
+		Metainfo []byte `json:"metainfo,omitempty"` // .torrent content (mutually exclusive with Torrent)
+		Subdir   string `json:"subdir"`             // optional destination virtual directory
+	}
 )
 
 func IsType(a string) bool {
 	b := Type(a)
-	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange
+	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange || b == TypeHF || b == TypeTorrent
 }
 
 /////////
@@ -145,10 +198,16 @@ func (j *Job) Aggregate(rhs *Job) {
 	j.FinishedCnt += rhs.FinishedCnt
 	j.ScheduledCnt += rhs.ScheduledCnt
 	j.SkippedCnt += rhs.SkippedCnt
+	j.FilteredCnt += rhs.FilteredCnt
 	j.ErrorCnt += rhs.ErrorCnt
+	j.ResumedCnt += rhs.ResumedCnt
+	j.ResumedBytes += rhs.ResumedBytes
+	j.Bandwidth += rhs.Bandwidth
+	j.Priority = max(j.Priority, rhs.Priority)
 	j.Total += rhs.Total
 	j.AllDispatched = j.AllDispatched && rhs.AllDispatched
 	j.Aborted = j.Aborted || rhs.Aborted
+	j.Paused = j.Paused || rhs.Paused
 	if j.StartedTime.After(rhs.StartedTime) {
 		j.StartedTime = rhs.StartedTime
 	}
@@ -214,6 +273,8 @@ func (j *Job) String() string {
 		sb.WriteString("aborted")
 	case finished:
 		sb.WriteString("finished")
+	case j.Paused:
+		sb.WriteString(fmt.Sprintf("paused, %d file%s still being downloaded", pending, cos.Plural(pending)))
 	default:
 		sb.WriteString(fmt.Sprintf("%d file%s still being downloaded", pending, cos.Plural(pending)))
 	}
@@ -235,6 +296,91 @@ func (db Body) MarshalJSON() ([]byte, error) {
 	return []byte(s), nil
 }
 
+// secrets are never retained (see Sanitize):
+// - fields (at any depth, case-insensitive) are removed, e.g. HFBody.Token
+// - URL userinfo (user:pass@) is removed
+// - presigned (and similar) query parameters are kept but their values are redacted
+var (
+	secretFields = []string{"token", "password", "secret", "header", "headers", "authorization", "cookie"}
+	secretParams = []string{
+		"x-amz-signature", "x-amz-credential", "x-amz-security-token",
+		"x-goog-signature", "x-goog-credential",
+		"sig", "signature", "token", "access_token",
+	}
+)
+
+const redacted = "REDACTED"
+
+// returns the entire body (including type) with secrets removed
+func (db *Body) Sanitize() (json.RawMessage, error) {
+	var m map[string]any
+	if err := jsoniter.Unmarshal(db.RawMessage, &m); err != nil {
+		return nil, err
+	}
+	raw, err := jsoniter.Marshal(sanitize(m))
+	if err != nil {
+		return nil, err
+	}
+	return Body{Type: db.Type, RawMessage: raw}.MarshalJSON()
+}
+
+func sanitize(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, vv := range v {
+			if cos.StringInSlice(strings.ToLower(k), secretFields) {
+				delete(v, k)
+			} else {
+				v[k] = sanitize(vv)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = sanitize(v[i])
+		}
+	case string:
+		return redactURL(v)
+	}
+	return v
+}
+
+// NOTE: string surgery rather than url.Parse/String to keep the rest of the link
+// (e.g., range template "{0001..1000}") as is
+func redactURL(s string) string {
+	i := strings.Index(s, "://")
+	if i <= 0 {
+		return s
+	}
+	// userinfo
+	authority := s[i+3:]
+	if end := strings.IndexAny(authority, "/?#"); end >= 0 {
+		authority = authority[:end]
+	}
+	if at := strings.LastIndexByte(authority, '@'); at >= 0 {
+		s = s[:i+3] + s[i+3+at+1:]
+	}
+	// query
+	q := strings.IndexByte(s, '?')
+	if q < 0 {
+		return s
+	}
+	query, frag := s[q+1:], ""
+	if h := strings.IndexByte(query, '#'); h >= 0 {
+		query, frag = query[:h], query[h:]
+	}
+	params := strings.Split(query, "&")
+	for j, param := range params {
+		k, _, ok := strings.Cut(param, "=")
+		if !ok {
+			continue
+		}
+		if key, err := url.QueryUnescape(k); err == nil && cos.StringInSlice(strings.ToLower(key), secretParams) {
+			params[j] = k + "=" + redacted
+		}
+	}
+	return s[:q+1] + strings.Join(params, "&") + frag
+}
+
 func (db *Body) UnmarshalJSON(b []byte) error {
 	db.Type = Type(jsoniter.Get(b, "type").ToString())
 	if db.Type == "" {
@@ -282,9 +428,30 @@ func (d *StatusResp) Aggregate(rhs *StatusResp) *StatusResp {
 	d.CurrentTasks = append(d.CurrentTasks, rhs.CurrentTasks...)
 	d.FinishedTasks = append(d.FinishedTasks, rhs.FinishedTasks...)
 	d.Errs = append(d.Errs, rhs.Errs...)
+	d.DownloadedBytes += rhs.DownloadedBytes
+	d.TotalBytes += rhs.TotalBytes
+	if len(rhs.Targets) > 0 {
+		if d.Targets == nil {
+			d.Targets = make(map[string]*Job, len(rhs.Targets))
+		}
+		for tid, job := range rhs.Targets {
+			d.Targets[tid] = job
+		}
+	}
 	return d
 }
 
+// (target) sum up byte counts and remove per-task details
+func (d *StatusResp) consolidate() {
+	for _, tasks := range [][]TaskDlInfo{d.CurrentTasks, d.FinishedTasks} {
+		for i := range tasks {
+			d.DownloadedBytes += tasks[i].Downloaded
+			d.TotalBytes += tasks[i].Total
+		}
+	}
+	d.CurrentTasks, d.FinishedTasks, d.Errs = nil, nil, nil
+}
+
 //////////
 // Base //
 //////////
@@ -304,6 +471,17 @@ func (b *Base) Validate() error {
 	if b.Limits.BytesPerHour < 0 {
 		return fmt.Errorf("'limit.bytes_per_hour' must be non-negative (got: %d)", b.Limits.BytesPerHour)
 	}
+	if b.Priority < 0 || b.Priority > maxPriority {
+		return fmt.Errorf("'priority' must be in range [0, %d] (got: %d)", maxPriority, b.Priority)
+	}
+	if b.Naming != nil {
+		if err := b.Naming.Validate(); err != nil {
+			return err
+		}
+	}
+	if b.Filter != nil {
+		return b.Filter.Validate()
+	}
 	return nil
 }
 
@@ -339,9 +517,14 @@ func (b *AdminBody) Validate(requireID bool) error {
 		if _, err := regexp.CompilePOSIX(b.Regex); err != nil {
 			return err
 		}
-	} else if b.ID == "" && requireID {
+	} else if b.ID == "" && (requireID || b.Spec || b.Progress) {
 		return errors.New("UUID not specified")
 	}
+	switch b.Status {
+	case "", JobStatusRunning, JobStatusFinished, JobStatusAborted:
+	default:
+		return fmt.Errorf("invalid job status %q (expecting one of: %q, %q, %q)", b.Status, JobStatusRunning, JobStatusFinished, JobStatusAborted)
+	}
 	return nil
 }
 
@@ -365,6 +548,13 @@ func (b *SingleBody) Validate() error {
 	if err := b.Base.Validate(); err != nil {
 		return err
 	}
+	if b.ObjName == "" && b.Link != "" && b.Naming != nil {
+		objName, err := b.Naming.ObjName(b.Link)
+		if err != nil {
+			return err
+		}
+		b.ObjName = objName
+	}
 	return b.SingleObj.Validate()
 }
 
@@ -438,9 +628,8 @@ func (b *MultiBody) ExtractPayload() (cos.StrKVs, error) {
 		for _, val := range ty {
 			switch link := val.(type) {
 			case string:
-				objName := path.Base(link)
-				if objName == "." || objName == "/" {
-					err := fmt.Errorf("failed to extract object name from the download %q", link)
+				objName, err := objNameFromLink(b.Naming, link)
+				if err != nil {
 					// TODO: ignore and continue?
 					return nil, err
 				}
@@ -0,0 +1,43 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestStatusProgress(t *testing.T) {
+	var (
+		t1 = &StatusResp{
+			Job:           Job{ID: "job", FinishedCnt: 2, ScheduledCnt: 3, ErrorCnt: 1, Total: 4},
+			CurrentTasks:  []TaskDlInfo{{Name: "c", Downloaded: 10, Total: 100}},
+			FinishedTasks: []TaskDlInfo{{Name: "a", Downloaded: 50, Total: 50}, {Name: "b", Downloaded: 20}},
+			Errs:          []TaskErrInfo{{Name: "d", Err: "not found"}},
+		}
+		t2 = &StatusResp{
+			Job:           Job{ID: "job", FinishedCnt: 1, ScheduledCnt: 1, SkippedCnt: 1, Total: 2},
+			FinishedTasks: []TaskDlInfo{{Name: "e", Downloaded: 5, Total: 5}},
+		}
+	)
+	t1.consolidate()
+	t2.consolidate()
+	tassert.Errorf(t, t1.CurrentTasks == nil && t1.FinishedTasks == nil && t1.Errs == nil, "expecting no per-task details")
+	tassert.Errorf(t, t1.DownloadedBytes == 80 && t1.TotalBytes == 150, "t1: got %d/%d", t1.DownloadedBytes, t1.TotalBytes)
+
+	// (proxy) per-target breakdown
+	j1, j2 := t1.Job, t2.Job
+	t1.Targets, t2.Targets = map[string]*Job{"t1": &j1}, map[string]*Job{"t2": &j2}
+
+	var resp *StatusResp
+	resp = resp.Aggregate(t1)
+	resp = resp.Aggregate(t2)
+	tassert.Errorf(t, resp.FinishedCnt == 3 && resp.ScheduledCnt == 4 && resp.SkippedCnt == 1 && resp.ErrorCnt == 1 && resp.Total == 6,
+		"unexpected aggregated counters: %+v", resp.Job)
+	tassert.Errorf(t, resp.DownloadedBytes == 85 && resp.TotalBytes == 155, "got %d/%d", resp.DownloadedBytes, resp.TotalBytes)
+	tassert.Fatalf(t, len(resp.Targets) == 2, "expecting 2 targets, got %d", len(resp.Targets))
+	tassert.Errorf(t, resp.Targets["t1"].FinishedCnt == 2 && resp.Targets["t2"].SkippedCnt == 1, "unexpected per-target counters")
+}
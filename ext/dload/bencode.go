@@ -0,0 +1,144 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// minimal bencode decoder (BEP 3): integers (int64), byte strings (string), lists ([]any), and dictionaries (map[string]any);
+// in addition, keeps the raw bytes of the top-level "info" dictionary (to compute info-hash)
+
+const bencMaxDepth = 64
+
+type bdecoder struct {
+	b     []byte
+	pos   int
+	depth int
+	info  []byte // raw top-level "info" (see above)
+}
+
+var errBencode = errors.New("invalid bencoding")
+
+func bdecode(b []byte) (v any, info []byte, err error) {
+	d := &bdecoder{b: b}
+	if v, err = d.decode(); err != nil {
+		return nil, nil, err
+	}
+	if d.pos != len(b) {
+		return nil, nil, fmt.Errorf("%w: trailing data at offset %d", errBencode, d.pos)
+	}
+	return v, d.info, nil
+}
+
+func (d *bdecoder) errf(what string) error {
+	return fmt.Errorf("%w: %s at offset %d", errBencode, what, d.pos)
+}
+
+func (d *bdecoder) decode() (any, error) {
+	if d.pos >= len(d.b) {
+		return nil, d.errf("unexpected end")
+	}
+	switch c := d.b[d.pos]; {
+	case c == 'i':
+		d.pos++
+		return d.int('e')
+	case c == 'l', c == 'd':
+		if d.depth++; d.depth > bencMaxDepth {
+			return nil, d.errf("nesting too deep")
+		}
+		d.pos++
+		var (
+			v   any
+			err error
+		)
+		if c == 'l' {
+			v, err = d.list()
+		} else {
+			v, err = d.dict()
+		}
+		d.depth--
+		return v, err
+	case c >= '0' && c <= '9':
+		return d.str()
+	default:
+		return nil, d.errf("unexpected '" + string(c) + "'")
+	}
+}
+
+func (d *bdecoder) int(delim byte) (int64, error) {
+	start := d.pos
+	for d.pos < len(d.b) && d.b[d.pos] != delim {
+		d.pos++
+	}
+	if d.pos >= len(d.b) {
+		return 0, d.errf("unterminated integer")
+	}
+	n, err := strconv.ParseInt(string(d.b[start:d.pos]), 10, 64)
+	if err != nil {
+		return 0, d.errf("invalid integer")
+	}
+	d.pos++ // delim
+	return n, nil
+}
+
+func (d *bdecoder) str() (string, error) {
+	n, err := d.int(':')
+	if err != nil {
+		return "", err
+	}
+	if n < 0 || n > int64(len(d.b)-d.pos) {
+		return "", d.errf("invalid string length")
+	}
+	s := string(d.b[d.pos : d.pos+int(n)])
+	d.pos += int(n)
+	return s, nil
+}
+
+func (d *bdecoder) list() ([]any, error) {
+	l := []any{}
+	for {
+		if d.pos >= len(d.b) {
+			return nil, d.errf("unterminated list")
+		}
+		if d.b[d.pos] == 'e' {
+			d.pos++
+			return l, nil
+		}
+		v, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, v)
+	}
+}
+
+func (d *bdecoder) dict() (map[string]any, error) {
+	m := make(map[string]any, 8)
+	for {
+		if d.pos >= len(d.b) {
+			return nil, d.errf("unterminated dictionary")
+		}
+		if d.b[d.pos] == 'e' {
+			d.pos++
+			return m, nil
+		}
+		key, err := d.str()
+		if err != nil {
+			return nil, err
+		}
+		start := d.pos
+		v, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		if key == "info" && d.depth == 1 {
+			d.info = d.b[start:d.pos]
+		}
+		m[key] = v
+	}
+}
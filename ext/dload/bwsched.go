@@ -0,0 +1,225 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Bandwidth scheduler: shares `downloader.max_bandwidth` (cluster-wide, split evenly between targets)
+// among concurrently running jobs in proportion to their priorities (weights):
+// - only jobs that are currently downloading (have tasks in progress) take part in the allocation,
+//   so that an idle (e.g., paused or waiting) job doesn't hold on to its share;
+// - allocation is recomputed every time a job starts or stops downloading or gets boosted, and
+//   upon changes of `downloader.max_bandwidth` and cluster map (number of targets);
+// - applies in addition to per-job limits (see throttler); boosted jobs are not scheduled
+//   and, therefore, take no share.
+
+const (
+	defaultPriority = 1
+	maxPriority     = 100
+
+	bwMaxSleep = 100 * time.Millisecond
+)
+
+type (
+	bwsched struct {
+		jobs map[string]*bwjob
+		mu   sync.Mutex
+	}
+	bwjob struct {
+		weight  int
+		active  int  // tasks in progress (under bwsched.mu)
+		boosted bool // ditto
+
+		// token bucket, in bytes, that may go into debt
+		mu     sync.Mutex
+		rate   int64 // allocated bytes per second; 0 - unlimited
+		tokens int64
+		last   int64 // mono time of the last refill
+	}
+	bwReader struct {
+		job *bwjob
+		ctx context.Context
+		r   io.ReadCloser
+	}
+)
+
+// interface guard
+var _ meta.Slistener = (*bwsched)(nil)
+
+func (s *bwsched) add(id string, priority int) {
+	if priority <= 0 {
+		priority = defaultPriority
+	}
+	s.mu.Lock()
+	if s.jobs == nil {
+		s.jobs = make(map[string]*bwjob, 4)
+	}
+	s.jobs[id] = &bwjob{weight: priority}
+	s.mu.Unlock()
+}
+
+func (s *bwsched) del(id string) {
+	s.mu.Lock()
+	if _, ok := s.jobs[id]; ok {
+		delete(s.jobs, id)
+		s.realloc()
+	}
+	s.mu.Unlock()
+}
+
+// allocated bytes per second (0: not downloading or unlimited)
+func (s *bwsched) allocated(id string) int64 {
+	s.mu.Lock()
+	job, ok := s.jobs[id]
+	s.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	job.mu.Lock()
+	rate := job.rate
+	job.mu.Unlock()
+	return rate
+}
+
+// task started downloading; returns nil if the job is not scheduled
+func (s *bwsched) begin(id string) *bwjob {
+	s.mu.Lock()
+	job, ok := s.jobs[id]
+	switch {
+	case !ok:
+	case job.boosted:
+		job = nil
+	default:
+		job.active++
+		if job.active == 1 {
+			s.realloc()
+		}
+	}
+	s.mu.Unlock()
+	return job
+}
+
+// boosted job gives up its share (see throttler.boost)
+func (s *bwsched) boost(id string) {
+	s.mu.Lock()
+	if job, ok := s.jobs[id]; ok && !job.boosted {
+		job.boosted = true
+		s.realloc()
+	}
+	s.mu.Unlock()
+}
+
+// (see dispatcher.onConfigChange)
+func (s *bwsched) update() {
+	s.mu.Lock()
+	if len(s.jobs) > 0 {
+		s.realloc()
+	}
+	s.mu.Unlock()
+}
+
+func (*bwsched) String() string { return "dload-bwsched" }
+
+// (the budget is split between targets)
+func (s *bwsched) ListenSmapChanged() { s.update() }
+
+func (s *bwsched) end(job *bwjob) {
+	s.mu.Lock()
+	job.active--
+	if job.active == 0 {
+		s.realloc()
+	}
+	s.mu.Unlock()
+}
+
+// under lock
+func (s *bwsched) realloc() {
+	budget := int64(cmn.GCO.Get().Downloader.MaxBandwidth)
+	if budget > 0 {
+		if cnt := core.T.Sowner().Get().CountActiveTs(); cnt > 1 {
+			budget /= int64(cnt)
+		}
+	}
+	s.alloc(budget)
+}
+
+// proportional share of this target's budget
+func (s *bwsched) alloc(budget int64) {
+	var total int
+	for _, job := range s.jobs {
+		if job.scheduled() {
+			total += job.weight
+		}
+	}
+	for _, job := range s.jobs {
+		var rate int64
+		if budget > 0 && job.scheduled() {
+			rate = max(budget*int64(job.weight)/int64(total), 1)
+		}
+		job.setRate(rate)
+	}
+}
+
+///////////
+// bwjob //
+///////////
+
+// under bwsched.mu
+func (job *bwjob) scheduled() bool { return job.active > 0 && !job.boosted }
+
+func (job *bwjob) setRate(rate int64) {
+	job.mu.Lock()
+	if job.rate != rate {
+		job.rate = rate
+		job.tokens, job.last = 0, mono.NanoTime()
+	}
+	job.mu.Unlock()
+}
+
+// consume n bytes; returns how long to wait for the bucket to get out of debt
+func (job *bwjob) consume(n int) time.Duration {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	if job.rate == 0 {
+		return 0
+	}
+	now := mono.NanoTime()
+	job.tokens = min(job.tokens+job.rate*(now-job.last)/int64(time.Second), job.rate) // burst: 1s
+	job.last = now
+	job.tokens -= int64(n)
+	if job.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-job.tokens * int64(time.Second) / job.rate)
+}
+
+//////////////
+// bwReader //
+//////////////
+
+func (br *bwReader) Read(p []byte) (n int, err error) {
+	n, err = br.r.Read(p)
+	if n == 0 {
+		return n, err
+	}
+	for d := br.job.consume(n); d > 0; d = br.job.consume(0) {
+		if errSleep := sleepCtx(br.ctx, min(d, bwMaxSleep)); errSleep != nil {
+			return n, errSleep
+		}
+	}
+	return n, err
+}
+
+func (br *bwReader) Close() error { return br.r.Close() }
@@ -0,0 +1,56 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBwschedAlloc(t *testing.T) {
+	var s bwsched
+	s.add("low", 0) // default priority
+	s.add("high", 3)
+	s.add("idle", 50)
+
+	s.jobs["low"].active, s.jobs["high"].active = 1, 2
+	s.alloc(400)
+	tassert.Errorf(t, s.allocated("low") == 100, "expected 100, got %d", s.allocated("low"))
+	tassert.Errorf(t, s.allocated("high") == 300, "expected 300, got %d", s.allocated("high"))
+	tassert.Errorf(t, s.allocated("idle") == 0, "expected idle job to get nothing, got %d", s.allocated("idle"))
+
+	// "high" is done: "low" gets it all
+	s.jobs["high"].active = 0
+	s.alloc(400)
+	tassert.Errorf(t, s.allocated("low") == 400, "expected 400, got %d", s.allocated("low"))
+
+	// boosted "high" is downloading again but takes no share
+	s.jobs["high"].active = 1
+	s.jobs["high"].boosted = true
+	s.alloc(400)
+	tassert.Errorf(t, s.allocated("low") == 400, "expected 400, got %d", s.allocated("low"))
+	tassert.Errorf(t, s.allocated("high") == 0, "expected boosted job to get nothing, got %d", s.allocated("high"))
+
+	// unlimited
+	s.alloc(0)
+	tassert.Errorf(t, s.allocated("low") == 0, "expected unlimited, got %d", s.allocated("low"))
+	tassert.Errorf(t, s.jobs["low"].consume(1<<30) == 0, "expected no wait when unlimited")
+}
+
+func TestBwjobConsume(t *testing.T) {
+	job := &bwjob{weight: 1}
+	job.setRate(1000)
+
+	// in debt by 500 bytes => 0.5s
+	d := job.consume(500)
+	tassert.Errorf(t, d > 400*time.Millisecond && d <= 500*time.Millisecond, "unexpected wait %v", d)
+
+	// after waiting, the debt is (mostly) paid off
+	time.Sleep(d)
+	d = job.consume(0)
+	tassert.Errorf(t, d < 50*time.Millisecond, "unexpected wait %v", d)
+}
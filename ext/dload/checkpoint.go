@@ -0,0 +1,221 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Resumable downloads:
+// - large (cpMinSize) HTTP(S) downloads with known size and a validator (strong ETag or Last-Modified)
+//   are tee-d into a partial (work) file;
+// - every cpInterval bytes the partial file is synced and its offset persisted in the download store;
+// - when the same task runs again (retry, re-submitted job, restarted target) the jogger sends
+//   `Range: bytes=<offset>-` with `If-Range: <validator>` and, upon 206, PUTs the concatenation
+//   of the persisted prefix and the remaining bytes;
+// - a 200 (source ignored the range or the content has changed) restarts from byte zero.
+
+const (
+	cpMinSize  = 64 * cos.MiB
+	cpInterval = 16 * cos.MiB
+)
+
+type (
+	checkpoint struct {
+		FQN       string `json:"fqn"`       // partial content
+		Validator string `json:"validator"` // to send with If-Range
+		Offset    int64  `json:"offset"`    // bytes written and synced
+		Size      int64  `json:"size"`      // total
+		Updated   int64  `json:"updated"`   // unix nano
+	}
+	// tees downloaded bytes into the partial file and periodically persists the offset
+	cpReader struct {
+		r    io.ReadCloser
+		fh   *os.File
+		task *singleTask
+		cp   *checkpoint
+		err  error // failed to write: stop checkpointing but keep downloading
+		last int64
+	}
+)
+
+var errBadContentRange = errors.New("invalid Content-Range")
+
+func (task *singleTask) cpKey() string {
+	return strconv.FormatUint(xxhash.Checksum64S(cos.UnsafeB(task.uid()), cos.MLCG32), 16)
+}
+
+// load checkpoint, if exists and still usable
+func (task *singleTask) loadCheckpoint() *checkpoint {
+	cp, err := g.store.getCheckpoint(task.cpKey())
+	if err != nil || cp == nil {
+		return nil
+	}
+	finfo, err := os.Stat(cp.FQN)
+	if err != nil || finfo.Size() < cp.Offset || cp.Offset <= 0 || cp.Offset >= cp.Size {
+		task.delCheckpoint(cp)
+		return nil
+	}
+	return cp
+}
+
+func (task *singleTask) delCheckpoint(cp *checkpoint) {
+	if err := cos.RemoveFile(cp.FQN); err != nil {
+		nlog.Warningln(task.String(), "failed to remove partial download:", err)
+	}
+	g.store.delCheckpoint(task.cpKey())
+}
+
+// new checkpoint for a download that's starting from scratch
+func (task *singleTask) newCheckpoint(lom *core.LOM, resp *http.Response, size int64, r io.ReadCloser) *cpReader {
+	validator := cpValidator(resp)
+	if size < cpMinSize || validator == "" || resp.StatusCode != http.StatusOK {
+		return nil
+	}
+	cp := &checkpoint{
+		FQN:       fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileDload),
+		Validator: validator,
+		Size:      size,
+	}
+	fh, err := cos.CreateFile(cp.FQN)
+	if err != nil {
+		nlog.Warningln(task.String(), "failed to create partial download:", err)
+		return nil
+	}
+	return &cpReader{r: r, fh: fh, task: task, cp: cp}
+}
+
+// resume from checkpoint: (persisted prefix) + (remaining bytes in the response body)
+func (task *singleTask) resume(r io.ReadCloser) (io.ReadCloser, *cpReader, error) {
+	cp := task.cp
+	fh, err := os.OpenFile(cp.FQN, os.O_RDWR, cos.PermRWR)
+	if err != nil {
+		return nil, nil, err
+	}
+	// discard unsynced tail, if any, and continue appending at offset
+	if err := fh.Truncate(cp.Offset); err != nil {
+		cos.Close(fh)
+		return nil, nil, err
+	}
+	if _, err := fh.Seek(cp.Offset, io.SeekStart); err != nil {
+		cos.Close(fh)
+		return nil, nil, err
+	}
+	cpr := &cpReader{r: r, fh: fh, task: task, cp: cp, last: cp.Offset}
+	task.currentSize.Store(cp.Offset)
+	g.store.incResumed(task.jobID(), cp.Offset)
+	return io.NopCloser(io.MultiReader(io.NewSectionReader(fh, 0, cp.Offset), cpr)), cpr, nil
+}
+
+// validate 206 response against checkpoint
+func (cp *checkpoint) validate(resp *http.Response) error {
+	start, _, total, err := parseContentRange(resp.Header.Get(cos.HdrContentRange))
+	if err != nil {
+		return err
+	}
+	if start != cp.Offset || total != cp.Size {
+		return fmt.Errorf("%w: expected range starting at %d (total %d), got %q", errBadContentRange,
+			cp.Offset, cp.Size, resp.Header.Get(cos.HdrContentRange))
+	}
+	if etag := resp.Header.Get(cos.HdrETag); etag != "" && strings.HasPrefix(cp.Validator, `"`) && etag != cp.Validator {
+		return fmt.Errorf("content changed: ETag %s vs %s", etag, cp.Validator)
+	}
+	return nil
+}
+
+// prefer strong ETag; otherwise, Last-Modified
+func cpValidator(resp *http.Response) string {
+	if etag := resp.Header.Get(cos.HdrETag); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return resp.Header.Get(cos.HdrLastModified)
+}
+
+// Content-Range: bytes <start>-<end>/<total>
+// (see also cmn.MakeRangeHdr)
+func parseContentRange(s string) (start, end, total int64, err error) {
+	rng, ok := strings.CutPrefix(s, cos.HdrContentRangeValPrefix)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("%w: %q", errBadContentRange, s)
+	}
+	rng, stot, ok := strings.Cut(rng, "/")
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("%w: %q", errBadContentRange, s)
+	}
+	sstart, send, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("%w: %q", errBadContentRange, s)
+	}
+	if start, err = strconv.ParseInt(sstart, 10, 64); err != nil {
+		return 0, 0, 0, fmt.Errorf("%w: %q", errBadContentRange, s)
+	}
+	if end, err = strconv.ParseInt(send, 10, 64); err != nil || end < start {
+		return 0, 0, 0, fmt.Errorf("%w: %q", errBadContentRange, s)
+	}
+	if total, err = strconv.ParseInt(stot, 10, 64); err != nil || total <= end { // (including "*")
+		return 0, 0, 0, fmt.Errorf("%w: %q", errBadContentRange, s)
+	}
+	return start, end, total, nil
+}
+
+//////////////
+// cpReader //
+//////////////
+
+func (cpr *cpReader) Read(b []byte) (n int, err error) {
+	n, err = cpr.r.Read(b)
+	if n == 0 || cpr.err != nil {
+		return n, err
+	}
+	if _, cpr.err = cpr.fh.Write(b[:n]); cpr.err != nil {
+		nlog.Warningln(cpr.task.String(), "failed to write partial download (no longer resumable):", cpr.err)
+		return n, err
+	}
+	cpr.cp.Offset += int64(n)
+	if cpr.cp.Offset-cpr.last >= cpInterval {
+		cpr.persist()
+	}
+	return n, err
+}
+
+func (cpr *cpReader) Close() error { return cpr.r.Close() }
+
+func (cpr *cpReader) persist() {
+	if cpr.err != nil {
+		return
+	}
+	if cpr.err = cpr.fh.Sync(); cpr.err != nil {
+		nlog.Warningln(cpr.task.String(), "failed to sync partial download:", cpr.err)
+		return
+	}
+	cpr.last = cpr.cp.Offset
+	cpr.cp.Updated = time.Now().UnixNano()
+	g.store.setCheckpoint(cpr.task.cpKey(), cpr.cp)
+}
+
+// success: remove partial content and checkpoint
+// failure: persist the latest offset to resume from
+func (cpr *cpReader) fini(ok bool) {
+	if !ok && cpr.last < cpr.cp.Offset {
+		cpr.persist()
+	}
+	cos.Close(cpr.fh)
+	if ok || (cpr.err != nil && cpr.last == 0) {
+		cpr.task.delCheckpoint(cpr.cp)
+	}
+}
@@ -0,0 +1,81 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		hdr               string
+		start, end, total int64
+		ok                bool
+	}{
+		{hdr: "bytes 0-99/100", start: 0, end: 99, total: 100, ok: true},
+		{hdr: "bytes 67108864-134217727/134217728", start: 64 << 20, end: 128<<20 - 1, total: 128 << 20, ok: true},
+		{hdr: "bytes 10-99/*"},
+		{hdr: "bytes 10-99/99"},
+		{hdr: "bytes 99-10/100"},
+		{hdr: "bytes */100"},
+		{hdr: "0-99/100"},
+		{hdr: ""},
+	}
+	for _, test := range tests {
+		start, end, total, err := parseContentRange(test.hdr)
+		if !test.ok {
+			tassert.Errorf(t, errors.Is(err, errBadContentRange), "%q: expected error, got %v", test.hdr, err)
+			continue
+		}
+		tassert.Errorf(t, err == nil, "%q: unexpected error %v", test.hdr, err)
+		tassert.Errorf(t, start == test.start && end == test.end && total == test.total,
+			"%q: expected (%d, %d, %d), got (%d, %d, %d)", test.hdr, test.start, test.end, test.total, start, end, total)
+	}
+}
+
+func TestCheckpointStore(t *testing.T) {
+	dir := t.TempDir()
+	driver, err := kvdb.NewBuntDB(filepath.Join(dir, "dl.db"))
+	tassert.CheckFatal(t, err)
+	db := newDownloadDB(driver)
+
+	cp, err := db.getCheckpoint("none")
+	tassert.Errorf(t, cp == nil && err == nil, "expected no checkpoint, got (%+v, %v)", cp, err)
+
+	var (
+		now    = time.Now()
+		fresh  = &checkpoint{FQN: filepath.Join(dir, "fresh"), Validator: `"etag"`, Offset: 16, Size: 64, Updated: now.UnixNano()}
+		stale  = &checkpoint{FQN: filepath.Join(dir, "stale"), Offset: 32, Size: 64, Updated: now.Add(-48 * time.Hour).UnixNano()}
+		loaded *checkpoint
+	)
+	for _, cp := range []*checkpoint{fresh, stale} {
+		tassert.CheckFatal(t, os.WriteFile(cp.FQN, make([]byte, cp.Offset), 0o644))
+	}
+	db.setCheckpoint("fresh", fresh)
+	db.setCheckpoint("stale", stale)
+
+	loaded, err = db.getCheckpoint("fresh")
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, loaded != nil && *loaded == *fresh, "expected %+v, got %+v", fresh, loaded)
+
+	db.delOldCheckpoints(now.Add(-24 * time.Hour))
+	loaded, _ = db.getCheckpoint("stale")
+	tassert.Errorf(t, loaded == nil, "expected stale checkpoint to be removed")
+	_, err = os.Stat(stale.FQN)
+	tassert.Errorf(t, os.IsNotExist(err), "expected stale partial content to be removed, got %v", err)
+	loaded, _ = db.getCheckpoint("fresh")
+	tassert.Errorf(t, loaded != nil, "expected fresh checkpoint to remain")
+
+	db.delCheckpoint("fresh")
+	loaded, _ = db.getCheckpoint("fresh")
+	tassert.Errorf(t, loaded == nil, "expected checkpoint to be deleted")
+}
@@ -8,15 +8,19 @@ import (
 	"errors"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
 )
 
 const (
 	downloaderErrors     = "errors"
 	downloaderTasks      = "tasks"
+	downloaderCheckpts   = "checkpoints" // resumable downloads (see checkpoint.go)
+	downloaderManifests  = "manifests"   // integrity manifest entries (see manifest.go)
 	downloaderCollection = "downloads"
 
 	// Number of errors stored in memory. When the number of errors exceeds
@@ -26,6 +30,9 @@ const (
 	// Number of tasks stored in memory. When the number of tasks exceeds
 	// this number, then all errors will be flushed to disk
 	taskInfoCacheSize = 1000
+
+	// ditto, manifest entries
+	manifestCacheSize = 1000
 )
 
 var errJobNotFound = errors.New("job not found")
@@ -34,8 +41,9 @@ type downloaderDB struct {
 	mtx    sync.RWMutex
 	driver kvdb.Driver
 
-	errCache      map[string][]TaskErrInfo // memory cache for errors, see: errCacheSize
-	taskInfoCache map[string][]TaskDlInfo  // memory cache for tasks, see: taskInfoCacheSize
+	errCache      map[string][]TaskErrInfo   // memory cache for errors, see: errCacheSize
+	taskInfoCache map[string][]TaskDlInfo    // memory cache for tasks, see: taskInfoCacheSize
+	mfstCache     map[string][]ManifestEntry // memory cache for manifest entries, see: manifestCacheSize
 }
 
 func newDownloadDB(driver kvdb.Driver) *downloaderDB {
@@ -43,6 +51,7 @@ func newDownloadDB(driver kvdb.Driver) *downloaderDB {
 		driver:        driver,
 		errCache:      make(map[string][]TaskErrInfo, 10),
 		taskInfoCache: make(map[string][]TaskDlInfo, 10),
+		mfstCache:     make(map[string][]ManifestEntry, 4),
 	}
 }
 
@@ -174,6 +183,21 @@ func (db *downloaderDB) flush(id string) error {
 
 		db.taskInfoCache[id] = db.taskInfoCache[id][:0] // clear cache
 	}
+
+	if len(db.mfstCache[id]) > 0 {
+		entries, err := db.manifest(id) // it will also append entries from cache
+		if err != nil {
+			return err
+		}
+
+		key := path.Join(downloaderManifests, id)
+		if err := db.driver.Set(downloaderCollection, key, entries); err != nil {
+			nlog.Errorln(err)
+			return err
+		}
+
+		db.mfstCache[id] = db.mfstCache[id][:0] // clear cache
+	}
 	return nil
 }
 
@@ -183,5 +207,101 @@ func (db *downloaderDB) delete(id string) {
 	db.driver.Delete(downloaderCollection, key)
 	key = path.Join(downloaderTasks, id)
 	db.driver.Delete(downloaderCollection, key)
+	key = path.Join(downloaderManifests, id)
+	db.driver.Delete(downloaderCollection, key)
+	delete(db.mfstCache, id)
 	db.mtx.Unlock()
 }
+
+//
+// manifest entries
+//
+
+func (db *downloaderDB) manifest(id string) (entries []ManifestEntry, err error) {
+	key := path.Join(downloaderManifests, id)
+	if err := db.driver.Get(downloaderCollection, key, &entries); err != nil {
+		if !cos.IsErrNotFound(err) {
+			nlog.Errorln(err)
+			return nil, err
+		}
+		// nothing in DB - return what's cached
+		return db.mfstCache[id], nil
+	}
+	entries = append(entries, db.mfstCache[id]...)
+	return
+}
+
+func (db *downloaderDB) persistManifestEntry(id string, entry *ManifestEntry) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if len(db.mfstCache[id]) < manifestCacheSize { // if possible store entry in cache
+		db.mfstCache[id] = append(db.mfstCache[id], *entry)
+		return nil
+	}
+
+	entries, err := db.manifest(id) // it will also append entries from cache
+	if err != nil {
+		return err
+	}
+	entries = append(entries, *entry)
+
+	key := path.Join(downloaderManifests, id)
+	if err := db.driver.Set(downloaderCollection, key, entries); err != nil {
+		return err
+	}
+	db.mfstCache[id] = db.mfstCache[id][:0] // clear cache
+	return nil
+}
+
+func (db *downloaderDB) getManifest(id string) ([]ManifestEntry, error) {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+	return db.manifest(id)
+}
+
+//
+// checkpoints
+//
+
+func (db *downloaderDB) getCheckpoint(key string) (*checkpoint, error) {
+	cp := &checkpoint{}
+	if err := db.driver.Get(downloaderCollection, path.Join(downloaderCheckpts, key), cp); err != nil {
+		if cos.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return cp, nil
+}
+
+func (db *downloaderDB) setCheckpoint(key string, cp *checkpoint) {
+	if err := db.driver.Set(downloaderCollection, path.Join(downloaderCheckpts, key), cp); err != nil {
+		nlog.Errorln(err)
+	}
+}
+
+func (db *downloaderDB) delCheckpoint(key string) {
+	db.driver.Delete(downloaderCollection, path.Join(downloaderCheckpts, key))
+}
+
+// remove checkpoints (and partial content) not updated since `before`
+func (db *downloaderDB) delOldCheckpoints(before time.Time) {
+	all, err := db.driver.GetAll(downloaderCollection, downloaderCheckpts+"/")
+	if err != nil {
+		if !cos.IsErrNotFound(err) {
+			nlog.Errorln(err)
+		}
+		return
+	}
+	for key, val := range all {
+		cp := &checkpoint{}
+		if err := jsoniter.UnmarshalFromString(val, cp); err == nil && cp.Updated >= before.UnixNano() {
+			continue
+		}
+		if cp.FQN != "" {
+			cos.RemoveFile(cp.FQN)
+		}
+		db.driver.Delete(downloaderCollection, key)
+	}
+}
@@ -5,6 +5,8 @@
 package dload
 
 import (
+	"net/http"
+
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -48,12 +50,16 @@ type (
 	WebResource struct {
 		ObjName string
 		Link    string
+		Header  http.Header
+		Oid     string
 	}
 
 	DstElement struct {
 		ObjName string
 		Version string
 		Link    string
+		Header  http.Header // additional request headers, if any
+		Oid     string      // expected SHA-256 of the content (Hugging Face LFS file), if known
 	}
 
 	DiffResolverResult struct {
@@ -176,6 +182,8 @@ func (dr *DiffResolver) PushDst(v any) {
 		d = &DstElement{
 			ObjName: x.ObjName,
 			Link:    x.Link,
+			Header:  x.Header,
+			Oid:     x.Oid,
 		}
 	default:
 		debug.FailTypeCast(v)
@@ -267,10 +275,15 @@ func (dr *DiffResolver) push(job jobif, d *dispatcher) {
 				dr.PushSrc(lom)
 			}
 			if obj.link != "" {
-				dr.PushDst(&WebResource{
+				wr := &WebResource{
 					ObjName: obj.objName,
 					Link:    obj.link,
-				})
+					Header:  job.header(),
+				}
+				if hj, ok := job.(*hfDlJob); ok {
+					wr.Oid = hj.oid(obj.objName)
+				}
+				dr.PushDst(wr)
 			} else {
 				dr.PushDst(&BackendResource{
 					ObjName: obj.objName,
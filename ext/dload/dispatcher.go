@@ -7,13 +7,13 @@ package dload
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
-	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
@@ -36,19 +36,31 @@ type (
 		joggers     map[string]*jogger     // mpath -> jogger
 		mtx         sync.RWMutex           // Protects map defined below.
 		abortJob    map[string]*cos.StopCh // jobID -> abort job chan
+		jobs        map[string]jobif       // jobID -> running job (see handleBoost, handlePause)
 		workCh      chan jobif
 		stopCh      *cos.StopCh
-		config      *cmn.Config
+		qsize       int // jogger queue capacity (see cmn.DownloaderConf.QueueSize)
+		// signals waiters (see waitFor) upon jogger's task completion or job removal
+		pending struct {
+			cond sync.Cond
+			mu   sync.Mutex
+		}
+		// closed to wake up idle joggers that may have tasks to steal (see jogger.get)
+		steal struct {
+			ch chan struct{}
+			mu sync.Mutex
+		}
 	}
 
 	startupSema struct {
-		started atomic.Bool
+		started cos.StopCh // closed upon startup
 	}
 
 	global struct {
 		tstats stats.Tracker
 		db     kvdb.Driver
 		store  *infoStore
+		bws    bwsched // bandwidth scheduler
 
 		// Downloader selects one of the two clients (below) by the destination URL.
 		// Certification check is disabled for now and does not depend on cluster settings.
@@ -79,15 +91,18 @@ func Init(tstats stats.Tracker, db kvdb.Driver, clientConf *cmn.ClientConf) {
 ////////////////
 
 func newDispatcher(xdl *Xact) *dispatcher {
-	return &dispatcher{
-		xdl:         xdl,
-		startupSema: startupSema{},
-		joggers:     make(map[string]*jogger, 8),
-		workCh:      make(chan jobif),
-		stopCh:      cos.NewStopCh(),
-		abortJob:    make(map[string]*cos.StopCh, 100),
-		config:      cmn.GCO.Get(),
-	}
+	d := &dispatcher{
+		xdl:      xdl,
+		joggers:  make(map[string]*jogger, 8),
+		workCh:   make(chan jobif),
+		stopCh:   cos.NewStopCh(),
+		abortJob: make(map[string]*cos.StopCh, 100),
+		jobs:     make(map[string]jobif, 100),
+		qsize:    cmn.GCO.Get().Downloader.QueueSize,
+	}
+	d.startupSema.started.Init()
+	d.pending.cond.L = &d.pending.mu
+	return d
 }
 
 func (d *dispatcher) run() (err error) {
@@ -103,6 +118,11 @@ func (d *dispatcher) run() (err error) {
 	// allow other goroutines to run
 	d.startupSema.markStarted()
 
+	unsub := cmn.GCO.Subscribe("downloader", d.onConfigChange)
+	defer unsub()
+	core.T.Sowner().Listeners().Reg(&g.bws)
+	defer core.T.Sowner().Listeners().Unreg(&g.bws)
+
 	nlog.Infoln(d.xdl.Name(), "started, cnt:", len(avail))
 mloop:
 	for {
@@ -121,7 +141,9 @@ mloop:
 			// may not saturate the full downloader throughput).
 			d.mtx.Lock()
 			d.abortJob[job.ID()] = cos.NewStopCh()
+			d.jobs[job.ID()] = job
 			d.mtx.Unlock()
+			g.bws.add(job.ID(), job.priority())
 
 			select {
 			case <-d.xdl.IdleTimer():
@@ -157,6 +179,34 @@ func (d *dispatcher) stop() {
 	}
 }
 
+// - timeout: applies to all subsequently started downloads (see singleTask.initialTimeout);
+// - queue size: resizes all jogger queues (see queue.waitSpace);
+// - max bandwidth: reallocates bandwidth shares (see bwsched);
+// - job store: upon restart
+func (d *dispatcher) onConfigChange(oconfig, nconfig *cmn.Config) {
+	oc, nc := &oconfig.Downloader, &nconfig.Downloader
+	if oc.MaxBandwidth != nc.MaxBandwidth {
+		g.bws.update()
+		nlog.Infoln(d.xdl.Name(), "max bandwidth:", oc.MaxBandwidth, "=>", nc.MaxBandwidth)
+	}
+	if oc.Timeout != nc.Timeout {
+		nlog.Infoln(d.xdl.Name(), "timeout:", oc.Timeout, "=>", nc.Timeout)
+	}
+	if oc.JobStore != nc.JobStore {
+		nlog.Warningln(d.xdl.Name(), "job store:", oc.JobStore, "=>", nc.JobStore, "- will take effect upon restart")
+	}
+	if oc.QueueSize != nc.QueueSize {
+		size := nc.QueueSize
+		if size <= 0 {
+			size = queueChSize
+		}
+		for _, j := range d.joggers {
+			j.q.resize(size)
+		}
+		nlog.Infoln(d.xdl.Name(), "queue size:", oc.QueueSize, "=>", nc.QueueSize)
+	}
+}
+
 func (d *dispatcher) addJogger(mpath string) {
 	_, ok := d.joggers[mpath]
 	debug.Assert(!ok)
@@ -171,7 +221,9 @@ func (d *dispatcher) cleanupJob(jobID string) {
 		ch.Close()
 		delete(d.abortJob, jobID)
 	}
+	delete(d.jobs, jobID)
 	d.mtx.Unlock()
+	g.bws.del(jobID)
 }
 
 func (d *dispatcher) finish(job jobif) {
@@ -184,6 +236,10 @@ func (d *dispatcher) finish(job jobif) {
 		nlog.Infof("Job %q finished waiting for all tasks", job.ID())
 	}
 	d.cleanupJob(job.ID())
+	if job.throttler().resume() { // (aborted while paused)
+		g.store.setPaused(job.ID(), false)
+		d.xdl.DecPending()
+	}
 	if verbose {
 		nlog.Infof("Job %q cleaned up", job.ID())
 	}
@@ -242,12 +298,14 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 
 			if result.Action == DiffResolverSkip {
 				g.store.incSkipped(job.ID())
+				releaseObj(job, obj.objName)
 				continue
 			}
 
 			task := &singleTask{xdl: d.xdl, obj: obj, job: job}
 			if result.Action == DiffResolverErr {
 				task.markFailed(result.Err.Error())
+				releaseObj(job, obj.objName)
 				continue
 			}
 
@@ -332,17 +390,50 @@ func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
 
 	// NOTE: Throttle job before making jogger busy - we don't want to clog the
 	//  jogger as other tasks from other jobs can be already ready to download.
+	throt := task.job.throttler()
+	if resumeCh := throt.paused(); resumeCh != nil {
+		select {
+		case <-resumeCh:
+		case <-d.jobAbortedCh(task.job.ID()).Listen():
+			return true, nil
+		case <-d.stopCh.Listen():
+			return false, nil
+		}
+	}
 	select {
-	case <-task.job.throttler().tryAcquire():
+	case <-throt.tryAcquire():
+		break
+	case <-throt.boostCh.Listen():
 		break
 	case <-d.jobAbortedCh(task.job.ID()).Listen():
 		return true, nil
 	}
 
 	// Secondly, try to push the new task into queue.
+	// When the (HRW) jogger is full, route around it if possible - otherwise, block.
+	if jogger.q.full() {
+		jogger.backpressure()
+		if other := d.reroute(jogger, task); other != nil {
+			jogger = other
+		}
+	}
+	for space := jogger.q.waitSpace(); space != nil; space = jogger.q.waitSpace() {
+		select {
+		case <-space:
+		case <-d.jobAbortedCh(task.job.ID()).Listen():
+			task.job.throttler().release()
+			return true, nil
+		case <-d.stopCh.Listen():
+			task.job.throttler().release()
+			return false, nil
+		}
+	}
 	select {
-	// TODO -- FIXME: currently, dispatcher halts if any given jogger is "full" but others available
 	case jogger.putCh(task) <- task:
+		jogger.reportDepth()
+		if jogger.q.backlog() >= stealMinBacklog {
+			d.notifySteal()
+		}
 		return true, nil
 	case <-d.jobAbortedCh(task.job.ID()).Listen():
 		task.job.throttler().release()
@@ -353,6 +444,34 @@ func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
 	}
 }
 
+// Returns another jogger to queue the task whose HRW jogger is full, or nil
+// if there's none. Routing around is safe because the task is written to its HRW
+// mountpath regardless of the executing jogger (see "work stealing" in jogger.go),
+// provided that:
+// - the task is not already queued (tracked) by its HRW jogger;
+// - the other jogger is running and at most half-full (so that it keeps room for its own tasks).
+func (d *dispatcher) reroute(hrw *jogger, task *singleTask) (other *jogger) {
+	if len(d.joggers) < 2 || hrw.taskExists(task) {
+		return nil
+	}
+	minBacklog := math.MaxInt
+	for _, j := range d.joggers {
+		if j == hrw || j.stopped() {
+			continue
+		}
+		if l := j.q.backlog(); l <= j.q.size/2 && l < minBacklog {
+			other, minBacklog = j, l
+		}
+	}
+	if other != nil {
+		hrw.rerouted()
+		if cmn.Rom.FastV(4, cos.SmoduleDload) {
+			nlog.Infof("%s: jogger[%s] is full - routing %s to jogger[%s]", core.T, hrw.mpath, task, other.mpath)
+		}
+	}
+	return other
+}
+
 func (d *dispatcher) adminReq(req *request) (resp any, statusCode int, err error) {
 	if cmn.Rom.FastV(4, cos.SmoduleDload) {
 		nlog.Infof("Admin request (id: %q, action: %q, onlyActive: %t)", req.id, req.action, req.onlyActive)
@@ -368,6 +487,10 @@ func (d *dispatcher) adminReq(req *request) (resp any, statusCode int, err error
 		d.handleAbort(req)
 	case actRemove:
 		d.handleRemove(req)
+	case actBoost:
+		d.handleBoost(req)
+	case actPause, actResume:
+		d.handlePause(req)
 	default:
 		debug.Assertf(false, "%v; %v", req, req.action)
 	}
@@ -401,6 +524,55 @@ func (d *dispatcher) handleAbort(req *request) {
 	req.okRsp(nil)
 }
 
+func (d *dispatcher) handleBoost(req *request) {
+	dljob, err := g.store.checkExists(req)
+	if err != nil {
+		return
+	}
+	d.mtx.RLock()
+	job, ok := d.jobs[req.id]
+	d.mtx.RUnlock()
+	dlj := dljob.clone()
+	if !ok || !dlj.JobRunning() {
+		req.okRsp(nil) // (nothing to do - finished or not started on this target)
+		return
+	}
+	job.throttler().boost()
+	g.bws.boost(job.ID())
+	nlog.Infoln(job.String(), "boosted: lifting connection and bandwidth limits")
+	req.okRsp(nil)
+}
+
+// While paused, the job holds the xaction (see IncPending) to prevent idle timeout.
+// NOTE: holding d.mtx to serialize with job's cleanup - see finish()
+func (d *dispatcher) handlePause(req *request) {
+	dljob, err := g.store.checkExists(req)
+	if err != nil {
+		return
+	}
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	job, ok := d.jobs[req.id]
+	dlj := dljob.clone()
+	if !ok || !dlj.JobRunning() {
+		req.okRsp(nil) // (nothing to do - finished or not started on this target)
+		return
+	}
+	throt := job.throttler()
+	if req.action == actPause {
+		if throt.pause() {
+			d.xdl.IncPending()
+			g.store.setPaused(req.id, true)
+			nlog.Infoln(job.String(), "paused")
+		}
+	} else if throt.resume() {
+		g.store.setPaused(req.id, false)
+		d.xdl.DecPending()
+		nlog.Infoln(job.String(), "resumed")
+	}
+	req.okRsp(nil)
+}
+
 func (d *dispatcher) handleStatus(req *request) {
 	var (
 		finishedTasks []TaskDlInfo
@@ -427,12 +599,16 @@ func (d *dispatcher) handleStatus(req *request) {
 		sort.Sort(TaskErrByName(dlErrors))
 	}
 
-	req.okRsp(&StatusResp{
+	resp := &StatusResp{
 		Job:           dljob.clone(),
 		CurrentTasks:  currentTasks,
 		FinishedTasks: finishedTasks,
 		Errs:          dlErrors,
-	})
+	}
+	if req.progress {
+		resp.consolidate()
+	}
+	req.okRsp(resp)
 }
 
 func (d *dispatcher) activeTasks(reqID string) []TaskDlInfo {
@@ -448,9 +624,9 @@ func (d *dispatcher) activeTasks(reqID string) []TaskDlInfo {
 	return currentTasks
 }
 
-// pending returns `true` if any joggers has pending tasks for a given `reqID`,
+// hasPending returns `true` if any joggers has pending tasks for a given `reqID`,
 // `false` otherwise.
-func (d *dispatcher) pending(jobID string) bool {
+func (d *dispatcher) hasPending(jobID string) bool {
 	for _, j := range d.joggers {
 		if j.pending(jobID) {
 			return true
@@ -461,34 +637,69 @@ func (d *dispatcher) pending(jobID string) bool {
 
 // PRECONDITION: All tasks should be dispatched.
 func (d *dispatcher) waitFor(jobID string) {
-	for ; ; time.Sleep(time.Second) {
-		if !d.pending(jobID) {
-			return
-		}
+	d.pending.mu.Lock()
+	for d.hasPending(jobID) {
+		d.pending.cond.Wait()
+	}
+	d.pending.mu.Unlock()
+}
+
+// called by joggers _after_ changing their state (see hasPending);
+// taking the lock guarantees that a waiter cannot miss the wakeup
+// between checking and waiting
+func (d *dispatcher) notifyPending() {
+	d.pending.mu.Lock()
+	d.pending.cond.Broadcast()
+	d.pending.mu.Unlock()
+	d.notifySteal()
+}
+
+// returns the channel for idle joggers to wait on (see notifySteal)
+func (d *dispatcher) stealCh() <-chan struct{} {
+	d.steal.mu.Lock()
+	if d.steal.ch == nil {
+		d.steal.ch = make(chan struct{})
+	}
+	ch := d.steal.ch
+	d.steal.mu.Unlock()
+	return ch
+}
+
+// wake up idle joggers, if any, to look for tasks to steal: upon enqueueing
+// (growing backlog) and upon completion (fewer thieves)
+func (d *dispatcher) notifySteal() {
+	d.steal.mu.Lock()
+	if d.steal.ch != nil {
+		close(d.steal.ch)
+		d.steal.ch = nil
 	}
+	d.steal.mu.Unlock()
 }
 
 /////////////////
 // startupSema //
 /////////////////
 
-func (ss *startupSema) markStarted() { ss.started.Store(true) }
+func (ss *startupSema) markStarted() { ss.started.Close() }
 
 func (ss *startupSema) waitForStartup() {
 	const (
-		sleep   = 500 * time.Millisecond
 		timeout = 10 * time.Second
 		errmsg  = "FATAL: dispatcher takes too much time to start"
 	)
-	if ss.started.Load() {
+	select {
+	case <-ss.started.Listen():
 		return
+	default:
 	}
-	for total := time.Duration(0); !ss.started.Load(); total += sleep {
-		time.Sleep(sleep)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-ss.started.Listen():
+	case <-timer.C:
 		// should never happen even on slowest machines
-		debug.Assert(total < timeout, errmsg)
-		if total >= timeout && total < timeout+sleep*2 {
-			nlog.Errorln(errmsg)
-		}
+		nlog.Errorln(errmsg)
+		debug.Assert(false, errmsg)
+		<-ss.started.Listen()
 	}
 }
@@ -0,0 +1,118 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Filter selects files to download. Names (destination object names) are always
+// checked prior to creating download tasks; sizes - when known at listing time
+// (backend and Hugging Face downloads) and, otherwise, by the task itself upon
+// receiving Content-Length.
+type Filter struct {
+	// allowed extensions, case-insensitive (e.g. ".jpg", "tar.gz")
+	Exts []string `json:"extensions,omitempty"`
+	// object name regex
+	Regex string `json:"regex,omitempty"`
+	// size range, in bytes; zero max means no limit
+	MinSize int64 `json:"min_size,omitempty"`
+	MaxSize int64 `json:"max_size,omitempty"`
+
+	re *regexp.Regexp
+}
+
+// (tasks that fail with it are counted as filtered, not as errors)
+var errFiltered = errors.New("filtered out")
+
+// enforces max size when the source doesn't report it (no Content-Length)
+type maxSizeReader struct {
+	r        io.ReadCloser
+	n, max   int64
+	exceeded bool
+}
+
+// validates, compiles regex, and normalizes extensions
+func (f *Filter) Validate() (err error) {
+	if f.MinSize < 0 || f.MaxSize < 0 {
+		return fmt.Errorf("invalid 'filter' size range [%d, %d]: expecting non-negative values", f.MinSize, f.MaxSize)
+	}
+	if f.MaxSize > 0 && f.MinSize > f.MaxSize {
+		return fmt.Errorf("invalid 'filter' size range: min_size %d is greater than max_size %d", f.MinSize, f.MaxSize)
+	}
+	if f.Regex != "" {
+		if f.re, err = regexp.Compile(f.Regex); err != nil {
+			return fmt.Errorf("invalid 'filter.regex' %q: %v", f.Regex, err)
+		}
+	}
+	for i, ext := range f.Exts {
+		ext = strings.ToLower(strings.TrimSpace(ext))
+		if ext == "" || ext == "." {
+			return errors.New("'filter.extensions' contains empty extension")
+		}
+		if ext[0] != '.' {
+			ext = "." + ext
+		}
+		f.Exts[i] = ext
+	}
+	return nil
+}
+
+// negative size: unknown; nil filter matches all
+func (f *Filter) Match(name string, size int64) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Exts) > 0 {
+		var (
+			lname = strings.ToLower(name)
+			ok    bool
+		)
+		for _, ext := range f.Exts {
+			if strings.HasSuffix(lname, ext) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if f.re != nil && !f.re.MatchString(name) {
+		return false
+	}
+	return f.MatchSize(size)
+}
+
+func (f *Filter) MatchSize(size int64) bool {
+	if f == nil || size < 0 {
+		return true
+	}
+	return size >= f.MinSize && (f.MaxSize == 0 || size <= f.MaxSize)
+}
+
+///////////////////
+// maxSizeReader //
+///////////////////
+
+func (r *maxSizeReader) Read(p []byte) (n int, err error) {
+	n, err = r.r.Read(p)
+	r.n += int64(n)
+	if r.n > r.max {
+		r.exceeded = true
+		return n, r.err()
+	}
+	return n, err
+}
+
+func (r *maxSizeReader) Close() error { return r.r.Close() }
+
+func (r *maxSizeReader) err() error {
+	return fmt.Errorf("%w: size exceeds %d", errFiltered, r.max)
+}
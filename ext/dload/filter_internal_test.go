@@ -0,0 +1,35 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestMaxSizeReader(t *testing.T) {
+	tests := []struct {
+		size, max int64
+		exceeded  bool
+	}{
+		{size: 0, max: 10},
+		{size: 10, max: 10},
+		{size: 11, max: 10, exceeded: true},
+		{size: 4096, max: 100, exceeded: true},
+	}
+	for _, test := range tests {
+		r := &maxSizeReader{r: io.NopCloser(bytes.NewReader(make([]byte, test.size))), max: test.max}
+		n, err := io.Copy(io.Discard, r)
+		if test.exceeded {
+			tassert.Errorf(t, errors.Is(err, errFiltered) && r.exceeded, "%+v: expected filtered, got %v", test, err)
+		} else {
+			tassert.Errorf(t, err == nil && !r.exceeded && n == test.size, "%+v: unexpected %d, %v", test, n, err)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Hugging Face Hub source:
+//   hf://[models/|datasets/|spaces/]ORG/NAME[@REVISION][/PATH]
+// - files are enumerated via HF Hub tree API (recursively, all pages);
+// - each target downloads its own (HRW) subset, in parallel across mountpath joggers;
+// - already downloaded files (same size/ETag) are skipped, which makes a restarted job resume;
+// - LFS files: the resolve endpoint returns the actual content (not the pointer);
+//   content is verified against the pointer's SHA-256 (`lfs.oid`) while downloading - prior
+//   to committing the object, which then stores the verified oid in its custom metadata;
+//   when resuming, LFS files are skipped only if their recorded oid matches.
+
+const (
+	HFScheme = "hf"
+
+	HFRepoModel   = "model"
+	HFRepoDataset = "dataset"
+	HFRepoSpace   = "space"
+
+	hfDefaultRevision = "main"
+	hfDefaultEndpoint = "https://huggingface.co"
+
+	// environment (target side)
+	hfEnvEndpoint = "HF_ENDPOINT"
+	hfEnvToken    = "HF_TOKEN"
+
+	hfListTimeout = time.Minute
+	hfHdrLink     = "Link" // pagination (RFC 8288)
+
+	hfOidMD = "lfs_oid" // custom metadata: verified SHA-256 of the content
+)
+
+type (
+	hfDlJob struct {
+		sliceDlJob
+		lfs  map[string]string // object name => expected SHA-256 (hex)
+		repo string
+	}
+
+	// (subset of) HF Hub tree API response
+	hfEntry struct {
+		LFS  *hfLFS `json:"lfs,omitempty"`
+		Type string `json:"type"` // "file" | "directory"
+		Path string `json:"path"`
+		Size int64  `json:"size"`
+	}
+	hfLFS struct {
+		Oid  string `json:"oid"` // SHA-256 of the content
+		Size int64  `json:"size"`
+	}
+
+	// computes SHA-256 while reading; fails the read at EOF upon mismatch
+	hfVerifier struct {
+		r     io.ReadCloser
+		ck    *cos.CksumHash
+		cname string
+		oid   string
+		err   error
+		done  bool
+	}
+)
+
+var errLFSCksum = errors.New("LFS checksum mismatch")
+
+// interface guard
+var _ jobif = (*hfDlJob)(nil)
+
+// ParseHFURI parses `hf://` source into the download request body fields.
+func ParseHFURI(uri string, body *HFBody) error {
+	s, ok := strings.CutPrefix(uri, HFScheme+apc.BckProviderSeparator)
+	if !ok {
+		return fmt.Errorf("invalid Hugging Face source %q: expecting %s%s prefix", uri, HFScheme, apc.BckProviderSeparator)
+	}
+	body.RepoType = HFRepoModel
+	for _, ty := range []string{HFRepoModel, HFRepoDataset, HFRepoSpace} {
+		if rest, ok := strings.CutPrefix(s, ty+"s/"); ok {
+			body.RepoType, s = ty, rest
+			break
+		}
+	}
+	parts := strings.SplitN(s, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid Hugging Face source %q: expecting (ORG/NAME) repository", uri)
+	}
+	name := parts[1]
+	if i := strings.IndexByte(name, '@'); i >= 0 {
+		body.Revision = name[i+1:]
+		name = name[:i]
+		if body.Revision == "" || name == "" {
+			return fmt.Errorf("invalid Hugging Face source %q: empty repository name or revision", uri)
+		}
+	}
+	body.Repo = parts[0] + "/" + name
+	if len(parts) == 3 {
+		body.Path = strings.Trim(parts[2], "/")
+	}
+	return nil
+}
+
+////////////
+// HFBody //
+////////////
+
+func (b *HFBody) Validate() error {
+	if err := b.Base.Validate(); err != nil {
+		return err
+	}
+	if b.Repo == "" {
+		return errors.New("missing 'repo' in the request body")
+	}
+	if strings.Count(b.Repo, "/") != 1 {
+		return fmt.Errorf("invalid repository %q: expecting ORG/NAME", b.Repo)
+	}
+	switch b.RepoType {
+	case "":
+		b.RepoType = HFRepoModel
+	case HFRepoModel, HFRepoDataset, HFRepoSpace:
+	default:
+		return fmt.Errorf("invalid repository type %q (expecting one of: %q, %q, %q)",
+			b.RepoType, HFRepoModel, HFRepoDataset, HFRepoSpace)
+	}
+	if b.Revision == "" {
+		b.Revision = hfDefaultRevision
+	}
+	return nil
+}
+
+func (b *HFBody) Describe() string {
+	if b.Description != "" {
+		return b.Description
+	}
+	return fmt.Sprintf("%s -> %s", b.URI(), b.Bck)
+}
+
+// NOTE: never include the token
+func (b *HFBody) String() string {
+	return fmt.Sprintf("bucket: %q, repo: %q (%s), revision: %q, path: %q", b.Bck, b.Repo, b.RepoType, b.Revision, b.Path)
+}
+
+func (b *HFBody) URI() string {
+	s := HFScheme + apc.BckProviderSeparator + b.typePrefix() + b.Repo
+	if b.Revision != "" && b.Revision != hfDefaultRevision {
+		s += "@" + b.Revision
+	}
+	if b.Path != "" {
+		s += "/" + b.Path
+	}
+	return s
+}
+
+func (b *HFBody) typePrefix() string {
+	if b.RepoType == "" || b.RepoType == HFRepoModel {
+		return ""
+	}
+	return b.RepoType + "s/"
+}
+
+func (*HFBody) endpoint() string {
+	if ep := os.Getenv(hfEnvEndpoint); ep != "" {
+		return strings.TrimSuffix(ep, "/")
+	}
+	return hfDefaultEndpoint
+}
+
+func (b *HFBody) token() string {
+	if b.Token != "" {
+		return b.Token
+	}
+	return os.Getenv(hfEnvToken)
+}
+
+// e.g. https://huggingface.co/api/datasets/ORG/NAME/tree/main/PATH?recursive=true
+func (b *HFBody) treeURL() string {
+	u := b.endpoint() + "/api/" + b.RepoType + "s/" + b.Repo + "/tree/" + url.PathEscape(b.Revision)
+	if b.Path != "" {
+		u += "/" + hfEscapePath(b.Path)
+	}
+	return u + "?recursive=true"
+}
+
+// e.g. https://huggingface.co/datasets/ORG/NAME/resolve/main/FILE
+func (b *HFBody) resolveURL(fpath string) string {
+	return b.endpoint() + "/" + b.typePrefix() + b.Repo + "/resolve/" + url.PathEscape(b.Revision) + "/" + hfEscapePath(fpath)
+}
+
+// escape each segment of a repository file path (e.g., "a b/c#1.txt" => "a%20b/c%231.txt")
+func hfEscapePath(fpath string) string {
+	segs := strings.Split(fpath, "/")
+	for i, seg := range segs {
+		segs[i] = url.PathEscape(seg)
+	}
+	return strings.Join(segs, "/")
+}
+
+// list all files in the repository (or its subdirectory); follow pagination via `Link: <...>; rel="next"`
+func (b *HFBody) listFiles() (entries []hfEntry, err error) {
+	next := b.treeURL()
+	for next != "" {
+		var page []hfEntry
+		if next, err = b.listPage(next, &page); err != nil {
+			return nil, err
+		}
+		for _, e := range page {
+			if e.Type == "file" {
+				entries = append(entries, e)
+			}
+		}
+	}
+	return entries, nil
+}
+
+func (b *HFBody) listPage(link string, page *[]hfEntry) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), hfListTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	if token := b.token(); token != "" {
+		req.Header.Set(apc.HdrAuthorization, "Bearer "+token)
+	}
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return "", err
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to list %s: status %d", b.URI(), resp.StatusCode)
+	}
+	if err := jsoniter.NewDecoder(resp.Body).Decode(page); err != nil {
+		return "", fmt.Errorf("failed to list %s: %v", b.URI(), err)
+	}
+	return hfNextPage(resp.Header.Get(hfHdrLink)), nil
+}
+
+// parse RFC 8288 `Link` header, e.g.: <https://huggingface.co/api/...&cursor=xyz>; rel="next"
+func hfNextPage(hdr string) string {
+	for _, link := range strings.Split(hdr, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+		for _, p := range parts[1:] {
+			if strings.TrimSpace(p) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
+
+/////////////
+// hfDlJob //
+/////////////
+
+func newHFDlJob(id string, bck *meta.Bck, payload *HFBody, xdl *Xact) (*hfDlJob, error) {
+	hj := &hfDlJob{repo: payload.URI(), lfs: make(map[string]string)}
+	hj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
+	if token := payload.token(); token != "" {
+		hj.hdr = http.Header{apc.HdrAuthorization: []string{"Bearer " + token}}
+	}
+
+	entries, err := payload.listFiles()
+	if err != nil {
+		return nil, err
+	}
+	objects := make(cos.StrKVs, len(entries))
+	for _, e := range entries {
+		fpath := e.Path
+		if payload.Path != "" {
+			fpath = strings.TrimPrefix(strings.TrimPrefix(fpath, payload.Path), "/")
+		}
+		name := path.Join(payload.Subdir, fpath)
+		if !payload.Filter.Match(name, e.size()) {
+			continue
+		}
+		objects[name] = payload.resolveURL(e.Path)
+		if e.LFS != nil && e.LFS.Oid != "" {
+			hj.lfs[name] = e.LFS.Oid
+		}
+	}
+	if err := hj.sliceDlJob.init(bck, objects); err != nil {
+		return nil, err
+	}
+	// NOTE: diff-resolver expects sorted input
+	sort.Slice(hj.objs, func(i, j int) bool { return hj.objs[i].objName < hj.objs[j].objName })
+	return hj, nil
+}
+
+func (j *hfDlJob) String() string { return "hf-" + j.baseDlJob.String() + "-" + j.repo }
+
+// LFS file: verify content while downloading (see task._dput); nil otherwise
+func (j *hfDlJob) verifier(lom *core.LOM, r io.ReadCloser) *hfVerifier {
+	oid, ok := j.lfs[lom.ObjName]
+	if !ok {
+		return nil
+	}
+	// (persisted only if the PUT succeeds, i.e., verified)
+	lom.SetCustomKey(hfOidMD, oid)
+	return &hfVerifier{r: r, ck: cos.NewCksumHash(cos.ChecksumSHA256), cname: lom.Cname(), oid: oid}
+}
+
+// (see DiffResolver.push)
+func (j *hfDlJob) oid(objName string) string { return j.lfs[objName] }
+
+////////////////
+// hfVerifier //
+////////////////
+
+func (v *hfVerifier) Read(p []byte) (n int, err error) {
+	n, err = v.r.Read(p)
+	v.ck.H.Write(p[:n])
+	if err != io.EOF || v.done {
+		return n, err
+	}
+	v.done = true
+	v.ck.Finalize()
+	if actual := v.ck.Val(); actual != v.oid {
+		v.err = fmt.Errorf("%w: %s (expected %s, got %s)", errLFSCksum, v.cname, v.oid, actual)
+		return n, v.err
+	}
+	return n, err
+}
+
+func (v *hfVerifier) Close() error { return v.r.Close() }
+
+/////////////
+// hfEntry //
+/////////////
+
+// for LFS files, the actual (content) size
+func (e *hfEntry) size() int64 {
+	if e.LFS != nil && e.LFS.Size > 0 {
+		return e.LFS.Size
+	}
+	return e.Size
+}
@@ -0,0 +1,82 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHFListFiles(t *testing.T) {
+	const oid = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	pages := map[string]string{
+		"": `[{"type":"directory","path":"data","size":0},
+			{"type":"file","path":"data/README.md","size":12}]`,
+		"2": `[{"type":"file","path":"data/train.parquet","size":134,
+			"lfs":{"oid":"` + oid + `","size":1048576,"pointerSize":134}}]`,
+	}
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		cursor := r.URL.Query().Get("cursor")
+		if cursor == "" {
+			w.Header().Set(hfHdrLink, "<http://"+r.Host+r.URL.Path+"?recursive=true&cursor=2>; rel=\"next\"")
+		}
+		w.Write([]byte(pages[cursor]))
+	}))
+	defer srv.Close()
+	saved := g.clientH
+	g.clientH = srv.Client()
+	defer func() { g.clientH = saved }()
+	t.Setenv(hfEnvEndpoint, srv.URL)
+
+	b := &HFBody{Repo: "org/name", RepoType: HFRepoDataset, Revision: hfDefaultRevision, Token: "hf_secret"}
+	entries, err := b.listFiles()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(entries) == 2, "expected 2 files, got %d", len(entries))
+	tassert.Errorf(t, auth == "Bearer hf_secret", "wrong authorization %q", auth)
+
+	tassert.Errorf(t, entries[0].LFS == nil && entries[0].size() == 12, "wrong regular file %+v", entries[0])
+	lfs := entries[1]
+	tassert.Errorf(t, lfs.LFS != nil && lfs.LFS.Oid == oid, "expected LFS pointer, got %+v", lfs)
+	tassert.Errorf(t, lfs.size() == 1048576, "expected LFS content size, got %d", lfs.size())
+	tassert.Errorf(t, b.resolveURL(lfs.Path) == srv.URL+"/datasets/org/name/resolve/main/data/train.parquet",
+		"wrong resolve URL %q", b.resolveURL(lfs.Path))
+
+	// special characters in file names (and subdirectory)
+	const fpath = "data/a b/c#1?.txt"
+	tassert.Errorf(t, b.resolveURL(fpath) == srv.URL+"/datasets/org/name/resolve/main/data/a%20b/c%231%3F.txt",
+		"wrong resolve URL %q", b.resolveURL(fpath))
+	b.Path = "data/a b"
+	tassert.Errorf(t, b.treeURL() == srv.URL+"/api/datasets/org/name/tree/main/data/a%20b?recursive=true",
+		"wrong tree URL %q", b.treeURL())
+}
+
+func TestHFVerifier(t *testing.T) {
+	const oid = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08" // sha256("test")
+	for _, content := range []string{"test", "tset"} {
+		v := &hfVerifier{
+			r:     io.NopCloser(iotest.OneByteReader(strings.NewReader(content))),
+			ck:    cos.NewCksumHash(cos.ChecksumSHA256),
+			cname: "ais://hf/" + content,
+			oid:   oid,
+		}
+		b, err := io.ReadAll(v)
+		tassert.Errorf(t, string(b) == content, "expected %q, got %q", content, b)
+		if content == "test" {
+			tassert.Errorf(t, err == nil && v.err == nil, "%s: unexpected error %v", content, err)
+		} else {
+			tassert.Errorf(t, errors.Is(err, errLFSCksum) && v.err == err, "%s: expected checksum mismatch, got %v", content, err)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
@@ -17,44 +18,55 @@ import (
 	"github.com/NVIDIA/aistore/hk"
 )
 
-// TODO: stored only in memory, should be persisted at some point (powercycle)
 type infoStore struct {
 	*downloaderDB
-	dljobs map[string]*dljob
-	sync.RWMutex
+	jobs jobStore   // see jobstore.go
+	mu   sync.Mutex // serializes job modifications: get => modify => put (see update)
 }
 
 func newInfoStore(driver kvdb.Driver) *infoStore {
 	db := newDownloadDB(driver)
 	is := &infoStore{
 		downloaderDB: db,
-		dljobs:       make(map[string]*dljob),
+		jobs:         newJobStore(cmn.GCO.Get()),
 	}
 	hk.Reg("downloader"+hk.NameSuffix, is.housekeep, hk.DayInterval)
 	return is
 }
 
 func (is *infoStore) getJob(id string) (*dljob, error) {
-	is.RLock()
-	defer is.RUnlock()
-
-	if ji, ok := is.dljobs[id]; ok {
-		return ji, nil
+	if job, ok := is.jobs.get(id); ok {
+		return job, nil
 	}
 	return nil, errJobNotFound
 }
 
+// modify the job and store it back (see jobStore NOTE);
+// atomic: concurrent updates of a terminated (persisted) job must not lose each other's writes
+func (is *infoStore) update(id string, cb func(*dljob)) {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+	dljob, err := is.getJob(id)
+	if err != nil {
+		debug.AssertNoErr(err)
+		return
+	}
+	cb(dljob)
+	if !_isRunning(dljob.finishedTime.Load()) {
+		is.jobs.put(dljob)
+	}
+}
+
 func (is *infoStore) getList(req *request) (jobs []*dljob) {
-	is.RLock()
-	for _, job := range is.dljobs {
-		if req.onlyActive && !_isRunning(job.finishedTime.Load()) {
-			continue
-		}
+	status := req.status
+	if req.onlyActive {
+		status = JobStatusRunning
+	}
+	is.jobs.list(status, func(job *dljob) {
 		if req.regex == nil || req.regex.MatchString(job.description) {
 			jobs = append(jobs, job)
 		}
-	}
-	is.RUnlock()
+	})
 	return
 }
 
@@ -63,84 +75,98 @@ func (is *infoStore) setJob(job jobif) (njob *dljob) {
 		id:          job.ID(),
 		xid:         job.XactID(),
 		total:       job.Len(),
+		priority:    job.priority(),
 		description: job.Description(),
 		startedTime: time.Now(),
+		spec:        job.spec(),
 	}
-	is.Lock()
-	is.dljobs[job.ID()] = njob
-	is.Unlock()
+	is.jobs.add(njob)
 	return
 }
 
 func (is *infoStore) incFinished(id string) {
-	dljob, err := is.getJob(id)
-	debug.AssertNoErr(err)
-	dljob.finishedCnt.Inc()
+	is.update(id, func(dljob *dljob) {
+		dljob.finishedCnt.Inc()
+	})
 }
 
 func (is *infoStore) incSkipped(id string) {
-	dljob, err := is.getJob(id)
-	debug.AssertNoErr(err)
-	dljob.skippedCnt.Inc()
-	dljob.finishedCnt.Inc()
+	is.update(id, func(dljob *dljob) {
+		dljob.skippedCnt.Inc()
+		dljob.finishedCnt.Inc()
+	})
+}
+
+func (is *infoStore) incFiltered(id string) {
+	is.update(id, func(dljob *dljob) {
+		dljob.filteredCnt.Inc()
+		dljob.finishedCnt.Inc()
+	})
+}
+
+func (is *infoStore) incResumed(id string, size int64) {
+	is.update(id, func(dljob *dljob) {
+		dljob.resumedCnt.Inc()
+		dljob.resumedBytes.Add(size)
+	})
 }
 
 func (is *infoStore) incScheduled(id string) {
-	dljob, err := is.getJob(id)
-	debug.AssertNoErr(err)
-	dljob.scheduledCnt.Inc()
+	is.update(id, func(dljob *dljob) {
+		dljob.scheduledCnt.Inc()
+	})
 }
 
 func (is *infoStore) incErrorCnt(id string) {
-	dljob, err := is.getJob(id)
-	debug.AssertNoErr(err)
-	dljob.errorCnt.Inc()
+	is.update(id, func(dljob *dljob) {
+		dljob.errorCnt.Inc()
+	})
 }
 
 func (is *infoStore) setAllDispatched(id string, dispatched bool) {
-	dljob, err := is.getJob(id)
-	debug.AssertNoErr(err)
-	dljob.allDispatched.Store(dispatched)
+	is.update(id, func(dljob *dljob) {
+		dljob.allDispatched.Store(dispatched)
+	})
+}
+
+func (is *infoStore) setPaused(id string, paused bool) {
+	is.update(id, func(dljob *dljob) {
+		dljob.paused.Store(paused)
+	})
 }
 
 func (is *infoStore) markFinished(id string) (error, bool /*aborted*/) {
+	is.mu.Lock()
+	defer is.mu.Unlock()
 	dljob, err := is.getJob(id)
 	if err != nil {
 		debug.AssertNoErr(err)
 		return err, false
 	}
 	dljob.finishedTime.Store(time.Now())
-	return dljob.valid(), dljob.aborted.Load()
+	err, aborted := dljob.valid(), dljob.aborted.Load()
+	is.jobs.terminated(dljob)
+	return err, aborted
 }
 
 func (is *infoStore) setAborted(id string) {
-	dljob, err := is.getJob(id)
-	debug.AssertNoErr(err)
-	dljob.aborted.Store(true)
+	is.update(id, func(dljob *dljob) {
+		dljob.aborted.Store(true)
+	})
 	// NOTE: Don't set `FinishedTime` yet as we are not fully done.
 	//       The job now can be removed but there's no guarantee
 	//       that all tasks have been stopped and all resources were freed.
 }
 
 func (is *infoStore) delJob(id string) {
-	delete(is.dljobs, id)
+	is.jobs.del(id)
 	is.downloaderDB.delete(id)
 }
 
 func (is *infoStore) housekeep(int64) time.Duration {
 	const interval = hk.DayInterval
-	var now time.Time
-	is.Lock()
-	for id, dljob := range is.dljobs {
-		if now.IsZero() {
-			now = time.Now()
-		}
-		if now.Sub(dljob.finishedTime.Load()) > interval {
-			is.delJob(id)
-		}
-	}
-	is.Unlock()
-
+	is.jobs.delOlder(time.Now().Add(-interval))
+	is.downloaderDB.delOldCheckpoints(time.Now().Add(-interval))
 	return interval
 }
 
@@ -5,8 +5,10 @@
 package dload
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"path"
 	"strings"
 	"time"
@@ -68,8 +70,24 @@ type (
 		// via tryAcquire and release
 		throttler() *throttler
 
+		// relative bandwidth share (see bwsched.go)
+		priority() int
+
+		// record downloaded objects and store integrity manifest (see manifest.go)
+		withManifest() bool
+
+		// additional request headers (e.g., authorization), nil if none
+		header() http.Header
+
+		// optional (see Filter)
+		filter() *Filter
+
 		// job cleanup
 		cleanup()
+
+		// original (sanitized) job spec (see Body.Sanitize)
+		spec() json.RawMessage
+		setSpec(json.RawMessage)
 	}
 
 	baseDlJob struct {
@@ -80,6 +98,11 @@ type (
 		description string
 		timeout     time.Duration
 		throt       throttler
+		hdr         http.Header
+		jspec       json.RawMessage
+		flt         *Filter // optional
+		prio        int
+		mfst        bool // (see Base.Manifest)
 	}
 
 	sliceDlJob struct {
@@ -96,11 +119,12 @@ type (
 
 	rangeDlJob struct {
 		baseDlJob
-		objs  []dlObj            // objects' metas which are ready to be downloaded
-		pt    cos.ParsedTemplate // range template
-		dir   string             // objects directory(prefix) from request
-		count int                // total number object to download by a target
-		done  bool               // true when iterator is finished, nothing left to read
+		objs   []dlObj            // objects' metas which are ready to be downloaded
+		pt     cos.ParsedTemplate // range template
+		naming *NamingRules       // optional (see NamingRules)
+		dir    string             // objects directory(prefix) from request
+		count  int                // total number object to download by a target
+		done   bool               // true when iterator is finished, nothing left to read
 	}
 
 	backendDlJob struct {
@@ -122,10 +146,16 @@ type (
 		finishedCnt   atomic.Int32
 		scheduledCnt  atomic.Int32
 		skippedCnt    atomic.Int32
+		filteredCnt   atomic.Int32
 		errorCnt      atomic.Int32
+		resumedCnt    atomic.Int32
+		resumedBytes  atomic.Int64
 		total         int
+		priority      int
 		aborted       atomic.Bool
 		allDispatched atomic.Bool
+		paused        atomic.Bool
+		spec          json.RawMessage // (see Body.Sanitize)
 	}
 )
 
@@ -133,13 +163,14 @@ type (
 // baseDlJob //
 ///////////////
 
-func (j *baseDlJob) init(id string, bck *meta.Bck, timeout, desc string, limits Limits, xdl *Xact) {
+func (j *baseDlJob) init(id string, bck *meta.Bck, base *Base, desc string, xdl *Xact) {
 	// TODO: this might be inaccurate if we download 1 or 2 objects because then
 	//  other targets will have limits but will not use them.
+	limits := base.Limits
 	if limits.BytesPerHour > 0 {
 		limits.BytesPerHour /= core.T.Sowner().Get().CountActiveTs()
 	}
-	td, _ := time.ParseDuration(timeout)
+	td, _ := time.ParseDuration(base.Timeout)
 	{
 		j.id = id
 		j.bck = bck
@@ -147,6 +178,9 @@ func (j *baseDlJob) init(id string, bck *meta.Bck, timeout, desc string, limits
 		j.description = desc
 		j.throt.init(limits)
 		j.xdl = xdl
+		j.flt = base.Filter
+		j.prio = base.Priority
+		j.mfst = base.Manifest
 	}
 }
 
@@ -157,6 +191,9 @@ func (j *baseDlJob) Timeout() time.Duration { return j.timeout }
 func (j *baseDlJob) Description() string    { return j.description }
 func (*baseDlJob) Sync() bool               { return false }
 
+func (j *baseDlJob) spec() json.RawMessage        { return j.jspec }
+func (j *baseDlJob) setSpec(spec json.RawMessage) { j.jspec = spec }
+
 func (j *baseDlJob) String() (s string) {
 	s = fmt.Sprintf("dl-job[%s]-%s", j.ID(), j.Bck())
 	if j.Description() == "" {
@@ -189,6 +226,10 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 
 func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
 func (j *baseDlJob) throttler() *throttler { return &j.throt }
+func (j *baseDlJob) header() http.Header   { return j.hdr }
+func (j *baseDlJob) filter() *Filter       { return j.flt }
+func (j *baseDlJob) priority() int         { return j.prio }
+func (j *baseDlJob) withManifest() bool    { return j.mfst }
 
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
@@ -198,6 +239,11 @@ func (j *baseDlJob) cleanup() {
 		nlog.Errorln(j.String()+":", err, aborted)
 	}
 	g.store.flush(j.ID())
+	if j.mfst {
+		if errM := j.storeManifest(aborted); errM != nil {
+			nlog.Errorln(j.String()+": failed to store manifest:", errM)
+		}
+	}
 	nl.OnFinished(j.Notif(), err, aborted)
 }
 
@@ -206,6 +252,11 @@ func (j *baseDlJob) cleanup() {
 //
 
 func (j *sliceDlJob) init(bck *meta.Bck, objects cos.StrKVs) error {
+	for name := range objects {
+		if !j.flt.Match(name, -1) {
+			delete(objects, name)
+		}
+	}
 	objs, err := buildDlObjs(bck, objects)
 	if err != nil {
 		return err
@@ -235,7 +286,7 @@ func newMultiDlJob(id string, bck *meta.Bck, payload *MultiBody, xdl *Xact) (mj
 	var objs cos.StrKVs
 
 	mj = &multiDlJob{}
-	mj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	mj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -250,7 +301,7 @@ func newSingleDlJob(id string, bck *meta.Bck, payload *SingleBody, xdl *Xact) (s
 	var objs cos.StrKVs
 
 	sj = &singleDlJob{}
-	sj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	sj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -273,13 +324,14 @@ func newRangeDlJob(id string, bck *meta.Bck, payload *RangeBody, xdl *Xact) (rj
 	if rj.pt, err = cos.ParseBashTemplate(payload.Template); err != nil {
 		return nil, err
 	}
-	rj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	rj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 
-	if rj.count, err = countObjects(rj.pt, payload.Subdir, rj.bck); err != nil {
+	if rj.count, err = countObjects(rj.pt, payload.Subdir, payload.Naming, rj.flt, rj.bck); err != nil {
 		return nil, err
 	}
 	rj.pt.InitIter()
 	rj.dir = payload.Subdir
+	rj.naming = payload.Naming
 	return
 }
 
@@ -312,7 +364,14 @@ func (j *rangeDlJob) getNextObjs() error {
 			j.done = true
 			break
 		}
-		name := path.Join(j.dir, path.Base(link))
+		objName, err := objNameFromLink(j.naming, link)
+		if err != nil {
+			return err
+		}
+		name := path.Join(j.dir, objName)
+		if !j.flt.Match(name, -1) {
+			continue
+		}
 		obj, err := makeDlObj(smap, sid, j.bck, name, link)
 		if err != nil {
 			if err == errInvalidTarget {
@@ -336,7 +395,7 @@ func newBackendDlJob(id string, bck *meta.Bck, payload *BackendBody, xdl *Xact)
 		return nil, errors.New("bucket download does not support HTTP buckets")
 	}
 	bj = &backendDlJob{}
-	bj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	bj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 	{
 		bj.sync = payload.Sync
 		bj.prefix = payload.Prefix
@@ -387,7 +446,7 @@ func (j *backendDlJob) getNextObjs() error {
 		j.continuationToken = lst.ContinuationToken
 
 		for _, entry := range lst.Entries {
-			if !j.checkObj(entry.Name) {
+			if !j.checkObj(entry.Name) || !j.flt.Match(entry.Name, entry.Size) {
 				continue
 			}
 			obj, err := makeDlObj(smap, sid, j.bck, entry.Name, "")
@@ -419,15 +478,56 @@ func (j *dljob) clone() Job {
 		FinishedCnt:   int(j.finishedCnt.Load()),
 		ScheduledCnt:  int(j.scheduledCnt.Load()),
 		SkippedCnt:    int(j.skippedCnt.Load()),
+		FilteredCnt:   int(j.filteredCnt.Load()),
 		ErrorCnt:      int(j.errorCnt.Load()),
+		ResumedCnt:    int(j.resumedCnt.Load()),
+		ResumedBytes:  j.resumedBytes.Load(),
+		Priority:      j.priority,
+		Bandwidth:     g.bws.allocated(j.id),
 		Total:         j.total,
 		AllDispatched: j.allDispatched.Load(),
 		Aborted:       j.aborted.Load(),
+		Paused:        j.paused.Load(),
 		StartedTime:   j.startedTime,
 		FinishedTime:  j.finishedTime.Load(),
 	}
 }
 
+// (see buntJobStore)
+func newDljobFrom(job *Job) *dljob {
+	j := &dljob{
+		id:          job.ID,
+		xid:         job.XactID,
+		description: job.Description,
+		startedTime: job.StartedTime,
+		total:       job.Total,
+		priority:    job.Priority,
+	}
+	j.finishedTime.Store(job.FinishedTime)
+	j.finishedCnt.Store(int32(job.FinishedCnt))
+	j.scheduledCnt.Store(int32(job.ScheduledCnt))
+	j.skippedCnt.Store(int32(job.SkippedCnt))
+	j.filteredCnt.Store(int32(job.FilteredCnt))
+	j.errorCnt.Store(int32(job.ErrorCnt))
+	j.resumedCnt.Store(int32(job.ResumedCnt))
+	j.resumedBytes.Store(job.ResumedBytes)
+	j.aborted.Store(job.Aborted)
+	j.allDispatched.Store(job.AllDispatched)
+	j.paused.Store(job.Paused)
+	return j
+}
+
+func (j *dljob) status() string {
+	switch {
+	case _isRunning(j.finishedTime.Load()):
+		return JobStatusRunning
+	case j.aborted.Load():
+		return JobStatusAborted
+	default:
+		return JobStatusFinished
+	}
+}
+
 // Used for debugging purposes to ensure integrity of the struct.
 func (j *dljob) valid() (err error) {
 	if j.aborted.Load() {
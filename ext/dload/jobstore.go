@@ -0,0 +1,292 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tidwall/buntdb"
+)
+
+// Download jobs (metadata and counters) are kept in a pluggable job store
+// (compare with downloaderDB that stores per-job tasks and errors):
+// - memory (default): all jobs, running and finished, are kept in memory until removed or housekept;
+// - buntdb: running jobs are kept in memory while finished ones are persisted in a separate
+//   local database indexed by finishing time (see config.Downloader.JobStore)
+//
+// NOTE: get() returns the job itself only while the job is kept in memory; otherwise, it
+// returns a copy, and the caller must put() the modified job back (see infoStore.update)
+
+const (
+	JobStoreMemory = "memory"
+	JobStoreBunt   = "buntdb"
+)
+
+const (
+	jobStoreDBName = ".ais.dljobs.db"
+
+	jobKeyPrefix = "dljob:"
+	jobIdxFin    = "finished"
+	jobIdxStatus = "status" // (see JobStatusFinished, JobStatusAborted)
+)
+
+type (
+	jobStore interface {
+		add(job *dljob)
+		get(id string) (*dljob, bool)
+		put(job *dljob)                      // store modified job (see NOTE above)
+		list(status string, cb func(*dljob)) // all jobs or only those with a given status (see AdminBody.Status)
+		terminated(job *dljob)               // upon job's termination (see markFinished)
+		del(id string)
+		delOlder(cutoff time.Time) int
+	}
+
+	memJobStore struct {
+		dljobs map[string]*dljob
+		mu     sync.RWMutex
+	}
+
+	buntJobStore struct {
+		db     *buntdb.DB
+		active memJobStore
+	}
+
+	// buntdb record
+	jobRec struct {
+		Job
+		Spec     json.RawMessage `json:"spec,omitempty"` // original (sanitized) job spec
+		Status   string          `json:"status"`
+		Finished int64           `json:"finished"` // (unix nano - for indexing)
+	}
+)
+
+// interface guard
+var (
+	_ jobStore = (*memJobStore)(nil)
+	_ jobStore = (*buntJobStore)(nil)
+)
+
+func newJobStore(config *cmn.Config) jobStore {
+	switch config.Downloader.JobStore {
+	case JobStoreBunt:
+		path := filepath.Join(config.ConfigDir, jobStoreDBName)
+		js, err := newBuntJobStore(path)
+		if err == nil {
+			return js
+		}
+		nlog.Errorln("failed to open download job store", path, "err:", err, "- proceeding with in-memory store")
+	default:
+	}
+	return newMemJobStore()
+}
+
+/////////////////
+// memJobStore //
+/////////////////
+
+func newMemJobStore() *memJobStore {
+	return &memJobStore{dljobs: make(map[string]*dljob, 16)}
+}
+
+func (js *memJobStore) add(job *dljob) {
+	js.mu.Lock()
+	js.dljobs[job.id] = job
+	js.mu.Unlock()
+}
+
+func (js *memJobStore) get(id string) (job *dljob, ok bool) {
+	js.mu.RLock()
+	job, ok = js.dljobs[id]
+	js.mu.RUnlock()
+	return
+}
+
+func (*memJobStore) put(*dljob) {} // (get returns the job itself)
+
+func (js *memJobStore) list(status string, cb func(*dljob)) {
+	js.mu.RLock()
+	for _, job := range js.dljobs {
+		if status != "" && job.status() != status {
+			continue
+		}
+		cb(job)
+	}
+	js.mu.RUnlock()
+}
+
+func (*memJobStore) terminated(*dljob) {}
+
+func (js *memJobStore) del(id string) {
+	js.mu.Lock()
+	delete(js.dljobs, id)
+	js.mu.Unlock()
+}
+
+func (js *memJobStore) delOlder(cutoff time.Time) (n int) {
+	js.mu.Lock()
+	for id, job := range js.dljobs {
+		if fin := job.finishedTime.Load(); !_isRunning(fin) && fin.Before(cutoff) {
+			delete(js.dljobs, id)
+			n++
+		}
+	}
+	js.mu.Unlock()
+	return
+}
+
+//////////////////
+// buntJobStore //
+//////////////////
+
+func newBuntJobStore(path string) (*buntJobStore, error) {
+	db, err := buntdb.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	db.SetConfig(buntdb.Config{
+		SyncPolicy:           buntdb.EverySecond,
+		AutoShrinkMinSize:    cos.MiB,
+		AutoShrinkPercentage: 50,
+	})
+	if err := db.CreateIndex(jobIdxFin, jobKeyPrefix+"*", buntdb.IndexJSON(jobIdxFin)); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := db.CreateIndex(jobIdxStatus, jobKeyPrefix+"*", buntdb.IndexJSON(jobIdxStatus)); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &buntJobStore{db: db, active: memJobStore{dljobs: make(map[string]*dljob, 16)}}, nil
+}
+
+func (js *buntJobStore) add(job *dljob) { js.active.add(job) }
+
+func (js *buntJobStore) get(id string) (*dljob, bool) {
+	if job, ok := js.active.get(id); ok {
+		return job, true
+	}
+	var val string
+	err := js.db.View(func(tx *buntdb.Tx) (err error) {
+		val, err = tx.Get(jobKeyPrefix + id)
+		return err
+	})
+	if err != nil {
+		if err != buntdb.ErrNotFound {
+			nlog.Errorln("download job store:", err)
+		}
+		return nil, false
+	}
+	job, err := js.unmarshal(val)
+	return job, err == nil
+}
+
+// terminated jobs with a given status: range the status index rather than scan all jobs
+func (js *buntJobStore) list(status string, cb func(*dljob)) {
+	js.active.list(status, cb)
+	if status == JobStatusRunning {
+		return
+	}
+	iter := func(_, val string) bool {
+		if job, err := js.unmarshal(val); err == nil {
+			cb(job)
+		}
+		return true
+	}
+	js.db.View(func(tx *buntdb.Tx) error {
+		if status == "" {
+			return tx.Ascend(jobIdxFin, iter)
+		}
+		return tx.AscendEqual(jobIdxStatus, `{"`+jobIdxStatus+`":`+strconv.Quote(status)+`}`, iter)
+	})
+}
+
+// terminated jobs are returned as copies - persist modifications
+func (js *buntJobStore) put(job *dljob) {
+	if _, ok := js.active.get(job.id); ok {
+		return
+	}
+	if err := js.persist(job); err != nil {
+		nlog.Errorln("failed to update download job", job.id, "err:", err)
+	}
+}
+
+// move terminated job from memory to db
+func (js *buntJobStore) terminated(job *dljob) {
+	if err := js.persist(job); err != nil {
+		nlog.Errorln("failed to persist download job", job.id, "err:", err)
+		return // (stays in memory)
+	}
+	js.active.del(job.id)
+}
+
+func (js *buntJobStore) persist(job *dljob) error {
+	rec := jobRec{Job: job.clone(), Spec: job.spec, Status: JobStatusFinished}
+	if rec.Aborted {
+		rec.Status = JobStatusAborted
+	}
+	rec.Finished = rec.FinishedTime.UnixNano()
+	b, err := jsoniter.Marshal(&rec)
+	if err != nil {
+		return err
+	}
+	return js.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(jobKeyPrefix+job.id, string(b), nil)
+		return err
+	})
+}
+
+func (js *buntJobStore) del(id string) {
+	js.active.del(id)
+	js.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(jobKeyPrefix + id)
+		return err
+	})
+}
+
+// finished before cutoff: range the index rather than scan all jobs
+func (js *buntJobStore) delOlder(cutoff time.Time) int {
+	var (
+		n     = js.active.delOlder(cutoff)
+		keys  []string
+		pivot = `{"` + jobIdxFin + `":` + strconv.FormatInt(cutoff.UnixNano(), 10) + `}`
+	)
+	js.db.View(func(tx *buntdb.Tx) error {
+		return tx.AscendLessThan(jobIdxFin, pivot, func(key, _ string) bool {
+			keys = append(keys, key)
+			return true
+		})
+	})
+	if len(keys) == 0 {
+		return n
+	}
+	js.db.Update(func(tx *buntdb.Tx) error {
+		for _, key := range keys {
+			if _, err := tx.Delete(key); err == nil {
+				n++
+			}
+		}
+		return nil
+	})
+	return n
+}
+
+func (*buntJobStore) unmarshal(val string) (*dljob, error) {
+	var rec jobRec
+	if err := jsoniter.UnmarshalFromString(val, &rec); err != nil {
+		nlog.Errorln("download job store: failed to unmarshal", val, "err:", err)
+		return nil, err
+	}
+	job := newDljobFrom(&rec.Job)
+	job.spec = rec.Spec
+	return job, nil
+}
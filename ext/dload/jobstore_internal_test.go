@@ -0,0 +1,107 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBuntJobStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), jobStoreDBName)
+	js, err := newBuntJobStore(path)
+	tassert.CheckFatal(t, err)
+
+	old, running := &dljob{id: "old", description: "old job"}, &dljob{id: "running", description: "running job"}
+	js.add(old)
+	js.add(running)
+	old.finishedCnt.Store(10)
+	old.aborted.Store(true)
+	old.finishedTime.Store(time.Now().Add(-time.Hour))
+	old.spec = []byte(`{"type":"range","template":"https://example.com/{0..9}.tar"}`)
+	js.terminated(old)
+
+	// terminated job is moved to db
+	_, inMemory := js.active.get(old.id)
+	tassert.Errorf(t, !inMemory, "expected %q to be moved to db", old.id)
+	job, ok := js.get(old.id)
+	tassert.Fatalf(t, ok, "job %q not found", old.id)
+	tassert.Errorf(t, job.finishedCnt.Load() == 10 && job.aborted.Load() && job.description == old.description,
+		"unexpected job %+v", job.clone())
+	tassert.Errorf(t, string(job.spec) == string(old.spec), "expected spec %s, got %s", old.spec, job.spec)
+
+	// terminated job is a copy: modifications require put()
+	job.paused.Store(true)
+	job, _ = js.get(old.id)
+	tassert.Errorf(t, !job.paused.Load(), "expected a copy")
+	job.paused.Store(true)
+	js.put(job)
+	job, _ = js.get(old.id)
+	tassert.Errorf(t, job.paused.Load(), "expected modification to be persisted")
+
+	var active, all, aborted, finished int
+	js.list(JobStatusRunning, func(*dljob) { active++ })
+	js.list("", func(*dljob) { all++ })
+	js.list(JobStatusAborted, func(*dljob) { aborted++ })
+	js.list(JobStatusFinished, func(*dljob) { finished++ })
+	tassert.Errorf(t, active == 1 && all == 2, "expected 1 active and 2 total, got %d and %d", active, all)
+	tassert.Errorf(t, aborted == 1 && finished == 0, "expected 1 aborted and 0 finished, got %d and %d", aborted, finished)
+
+	// running jobs are never housekept
+	n := js.delOlder(time.Now())
+	tassert.Errorf(t, n == 1, "expected 1 deleted job, got %d", n)
+	_, ok = js.get(old.id)
+	tassert.Errorf(t, !ok, "job %q must be deleted", old.id)
+	_, ok = js.get(running.id)
+	tassert.Errorf(t, ok, "job %q must not be deleted", running.id)
+
+	// persistence
+	tassert.CheckFatal(t, js.db.Close())
+	js, err = newBuntJobStore(path)
+	tassert.CheckFatal(t, err)
+	defer js.db.Close()
+	another := &dljob{id: "another"}
+	another.finishedTime.Store(time.Now())
+	js.add(another)
+	js.terminated(another)
+	tassert.CheckFatal(t, js.db.Close())
+	js, err = newBuntJobStore(path)
+	tassert.CheckFatal(t, err)
+	_, ok = js.get(another.id)
+	tassert.Errorf(t, ok, "job %q not found upon reopening", another.id)
+}
+
+func TestInfoStoreUpdate(t *testing.T) {
+	const n = 64
+	js, err := newBuntJobStore(filepath.Join(t.TempDir(), jobStoreDBName))
+	tassert.CheckFatal(t, err)
+	defer js.db.Close()
+
+	is := &infoStore{jobs: js}
+	job := &dljob{id: "job"}
+	js.add(job)
+	job.finishedTime.Store(time.Now())
+	js.terminated(job)
+
+	// concurrent get => modify => put of a persisted job
+	wg := &sync.WaitGroup{}
+	for range n {
+		wg.Add(1)
+		go func() {
+			is.update(job.id, func(j *dljob) {
+				time.Sleep(time.Millisecond)
+				j.finishedCnt.Inc()
+			})
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	job, _ = js.get(job.id)
+	tassert.Errorf(t, job.finishedCnt.Load() == n, "expected %d, got %d", n, job.finishedCnt.Load())
+}
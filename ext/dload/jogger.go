@@ -8,20 +8,38 @@ import (
 	"sync"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/stats"
 )
 
-const queueChSize = 1000
+const (
+	queueChSize = 1000      // default jogger queue capacity (see cmn.DownloaderConf.QueueSize)
+	queueChMax  = 16 * 1024 // max capacity (see cmn.DownloaderConf.Validate)
+)
+
+// Work stealing: an idle jogger executes tasks queued to a busy one.
+// Stolen tasks are written to their HRW mountpaths exactly as if executed by the owner
+// (see core.T.PutObject), while the owner's queue keeps tracking them (pending, abort, and done).
+// Idle joggers do not poll - they wait to be woken up by the dispatcher (see notifySteal).
+const (
+	stealMinBacklog = 4 // steal only from joggers that have at least so many queued tasks
+	stealMaxThieves = 2 // max number of joggers concurrently executing a given jogger's tasks
+)
 
 type (
 	queueEntry = map[string]struct{}
 
+	// NOTE: the channel is allocated with max capacity, while `size` is the current (runtime
+	// configurable) one - dispatcher waits for the queue to get below `size` (see waitSpace)
 	queue struct {
-		ch chan *singleTask      // for pending downloads
-		m  map[string]queueEntry // jobID -> set of request uid
-		mu sync.RWMutex
+		ch    chan *singleTask      // for pending downloads
+		m     map[string]queueEntry // jobID -> set of request uid
+		space chan struct{}         // closed when the queue gets below capacity
+		mu    sync.RWMutex
+		size  int // capacity
 	}
 
 	// Each jogger corresponds to an mpath. All types of download requests
@@ -35,69 +53,192 @@ type (
 		q           *queue
 		task        *singleTask // currently running download task
 		mtx         sync.Mutex
+		thieves     atomic.Int32 // number of other joggers currently executing this jogger's tasks
+		depth       atomic.Int64 // queue depth last reported to stats (see reportDepth)
 		stopAgent   bool
 	}
 )
 
 func newJogger(d *dispatcher, mpath string) (j *jogger) {
-	j = &jogger{mpath: mpath, parent: d, q: newQueue()}
+	size := d.qsize
+	if size <= 0 {
+		size = queueChSize
+	}
+	j = &jogger{mpath: mpath, parent: d, q: newQueue(size)}
 	j.terminateCh.Init()
 	return
 }
 
 func (j *jogger) jog() {
+	j.parent.startupSema.waitForStartup() // (all joggers added)
 	for {
-		t := j.q.get()
+		t, owner := j.get()
 		if t == nil {
 			break
 		}
+		owner.reportDepth()
+		j.do(t, owner)
+		if owner != j {
+			owner.thieves.Dec()
+			j.parent.notifySteal()
+		}
+	}
 
-		j.mtx.Lock()
-		// Check if the task exists to ensure that the job wasn't removed while
-		// we waited on the queue. We must do it under the jogger's lock to ensure that
-		// there is no race between aborting job and marking it as being handled.
-		if !j.taskExists(t) {
-			t.job.throttler().release()
-			j.mtx.Unlock()
-			continue
+	j.q.cleanup()
+	j.reportDepth()
+	j.parent.notifyPending()
+	j.terminateCh.Close()
+}
+
+// returns the next task to execute and its owner: this jogger, or a busy one (see steal)
+func (j *jogger) get() (*singleTask, *jogger) {
+	if len(j.parent.joggers) < 2 {
+		return j.q.get(), j
+	}
+	for {
+		stealCh := j.parent.stealCh() // (prior to looking - not to miss the wakeup)
+		select {
+		case t, ok := <-j.q.ch:
+			if !ok {
+				return nil, nil
+			}
+			j.q.signalSpace()
+			return t, j
+		default:
+		}
+		if t, owner := j.steal(); t != nil {
+			return t, owner
+		}
+		select {
+		case t, ok := <-j.q.ch:
+			if !ok {
+				return nil, nil
+			}
+			j.q.signalSpace()
+			return t, j
+		case <-stealCh:
 		}
+	}
+}
 
-		if j.stopAgent {
-			// Jogger has been stopped so we must mark task as failed. We do not
-			// `break` here because we want to drain the queue, otherwise some
-			// of the tasks may be in the queue and therefore the finished
-			// counter won't be correct.
-			t.job.throttler().release()
-			t.markFailed(internalErrorMsg)
-			j.mtx.Unlock()
+// steal a task from the jogger with the longest backlog, if any
+// (bounded by stealMinBacklog and stealMaxThieves)
+func (j *jogger) steal() (*singleTask, *jogger) {
+	if j.stopped() {
+		return nil, nil
+	}
+	var (
+		victim  *jogger
+		backlog = stealMinBacklog - 1
+	)
+	for _, other := range j.parent.joggers {
+		if other == j {
 			continue
 		}
+		if l := other.q.backlog(); l > backlog {
+			victim, backlog = other, l
+		}
+	}
+	if victim == nil {
+		return nil, nil
+	}
+	if victim.thieves.Inc() > stealMaxThieves {
+		victim.thieves.Dec()
+		return nil, nil
+	}
+	if t := victim.q.tryGet(); t != nil {
+		if cmn.Rom.FastV(4, cos.SmoduleDload) {
+			nlog.Infof("jogger[%s]: stole %s from jogger[%s] (backlog %d)", j.mpath, t, victim.mpath, backlog)
+		}
+		return t, victim
+	}
+	victim.thieves.Dec()
+	return nil, nil
+}
 
-		j.task = t
-		j.task.init()
+func (j *jogger) do(t *singleTask, owner *jogger) {
+	j.mtx.Lock()
+	// Check if the task exists to ensure that the job wasn't removed while
+	// we waited on the queue. We must do it under the jogger's lock to ensure that
+	// there is no race between aborting job and marking it as being handled.
+	// A stolen task is tracked by its owner's queue - checking the job's abort channel
+	// as well (see handleAbort).
+	if !owner.taskExists(t) || (owner != j && j.parent.checkAbortedJob(t.job)) {
+		t.job.throttler().release()
 		j.mtx.Unlock()
+		return
+	}
 
-		// do
-		lom := core.AllocLOM(t.obj.objName)
-		t.download(lom)
+	if j.stopAgent {
+		// Jogger has been stopped so we must mark task as failed. We do not
+		// `break` here because we want to drain the queue, otherwise some
+		// of the tasks may be in the queue and therefore the finished
+		// counter won't be correct.
+		t.job.throttler().release()
+		t.markFailed(internalErrorMsg)
+		j.mtx.Unlock()
+		return
+	}
 
-		// finish, cleanup
-		core.FreeLOM(lom)
-		t.cancel()
+	j.task = t
+	j.task.init()
+	j.mtx.Unlock()
 
-		t.job.throttler().release()
+	// do
+	lom := core.AllocLOM(t.obj.objName)
+	t.download(lom)
 
-		j.mtx.Lock()
-		j.task.persist()
-		j.task = nil
-		j.mtx.Unlock()
-		if j.q.del(t) {
-			j.parent.xdl.DecPending()
-		}
+	// finish, cleanup
+	core.FreeLOM(lom)
+	t.cancel()
+
+	t.job.throttler().release()
+
+	j.mtx.Lock()
+	j.task.persist()
+	j.task = nil
+	j.mtx.Unlock()
+	if owner.q.del(t) {
+		j.parent.xdl.DecPending()
 	}
+	j.parent.notifyPending()
+}
 
-	j.q.cleanup()
-	j.terminateCh.Close()
+func (j *jogger) stopped() bool {
+	j.mtx.Lock()
+	stopped := j.stopAgent
+	j.mtx.Unlock()
+	return stopped
+}
+
+//
+// stats: queue depth and backpressure (per mountpath)
+//
+
+func (j *jogger) vlabs() map[string]string { return map[string]string{stats.VarlabMountpath: j.mpath} }
+
+// update the (gauge) delta - concurrent callers always converge on the current depth
+func (j *jogger) reportDepth() {
+	if g.tstats == nil { // unit tests
+		return
+	}
+	depth := int64(j.q.backlog())
+	if delta := depth - j.depth.Swap(depth); delta != 0 {
+		g.tstats.AddWith(cos.NamedVal64{Name: stats.DloadQueueDepth, Value: delta, VarLabs: j.vlabs()})
+	}
+}
+
+func (j *jogger) backpressure() {
+	if g.tstats != nil {
+		g.tstats.IncWith(stats.DloadBackpressureCount, j.vlabs())
+	}
+}
+
+// (counted against the full jogger that the task was routed around)
+func (j *jogger) rerouted() {
+	if g.tstats != nil {
+		g.tstats.IncWith(stats.DloadRerouteCount, j.vlabs())
+	}
 }
 
 // stop terminates the jogger and waits for it to finish.
@@ -152,6 +293,7 @@ func (j *jogger) abortJob(id string) {
 	}
 
 	j.mtx.Unlock()
+	j.parent.notifyPending()
 
 	if task != nil && cmn.Rom.FastV(4, cos.SmoduleDload) /*verbose*/ {
 		nlog.Infof("%s: abort-job[%s, mpath=%s], task=%s", core.T.String(), id, j.mpath, j.task.String())
@@ -172,13 +314,45 @@ func (j *jogger) pending(id string) bool {
 	return task != nil || j.q.pending(id)
 }
 
-func newQueue() *queue {
+func newQueue(size int) *queue {
 	return &queue{
-		ch: make(chan *singleTask, queueChSize),
-		m:  make(map[string]queueEntry),
+		ch:   make(chan *singleTask, max(size, queueChMax)),
+		m:    make(map[string]queueEntry),
+		size: size,
 	}
 }
 
+// returns nil if the queue is below capacity; otherwise, the channel to wait on
+func (q *queue) waitSpace() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.stopped() || len(q.ch) < q.size {
+		return nil
+	}
+	if q.space == nil {
+		q.space = make(chan struct{})
+	}
+	return q.space
+}
+
+// wake up dispatchers waiting for the queue to get below capacity
+func (q *queue) signalSpace() {
+	q.mu.Lock()
+	if q.space != nil && (q.stopped() || len(q.ch) < q.size) {
+		close(q.space)
+		q.space = nil
+	}
+	q.mu.Unlock()
+}
+
+// (see cmn.DownloaderConf.QueueSize)
+func (q *queue) resize(size int) {
+	q.mu.Lock()
+	q.size = size
+	q.mu.Unlock()
+	q.signalSpace()
+}
+
 // PRECONDITION: `q.Lock()` must be taken.
 func (q *queue) putCh(t *singleTask) (ok bool, ch chan<- *singleTask) {
 	if q.stopped() || q.exists(t.jobID(), t.uid()) {
@@ -196,6 +370,7 @@ func (q *queue) get() (foundTask *singleTask) {
 	if !ok {
 		return nil
 	}
+	q.signalSpace()
 
 	// NOTE: We do not delete task here but postpone it until the task
 	//  has `Finished` to prevent situation where we put task which is
@@ -203,6 +378,37 @@ func (q *queue) get() (foundTask *singleTask) {
 	return t
 }
 
+// non-blocking get (see jogger.steal)
+func (q *queue) tryGet() (t *singleTask) {
+	q.mu.RLock()
+	if !q.stopped() {
+		select {
+		case t = <-q.ch:
+		default:
+		}
+	}
+	q.mu.RUnlock()
+	if t != nil {
+		q.signalSpace()
+	}
+	return t
+}
+
+func (q *queue) backlog() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.stopped() {
+		return 0
+	}
+	return len(q.ch)
+}
+
+func (q *queue) full() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return !q.stopped() && len(q.ch) >= q.size
+}
+
 func (q *queue) del(t *singleTask) bool {
 	q.mu.Lock()
 	deleted := q.removeFromSet(t.jobID(), t.uid())
@@ -214,6 +420,10 @@ func (q *queue) cleanup() {
 	q.mu.Lock()
 	q.ch = nil
 	q.m = nil
+	if q.space != nil {
+		close(q.space)
+		q.space = nil
+	}
 	q.mu.Unlock()
 }
 
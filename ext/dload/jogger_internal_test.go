@@ -0,0 +1,203 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestJoggerSteal(t *testing.T) {
+	var (
+		d   = &dispatcher{joggers: make(map[string]*jogger, 3)}
+		job = &singleDlJob{}
+	)
+	job.id, job.bck = "job", meta.NewBck("bck", apc.AIS, cmn.NsGlobal)
+	for _, mpath := range []string{"/mp1", "/mp2", "/mp3"} {
+		d.joggers[mpath] = newJogger(d, mpath)
+	}
+	enqueue := func(j *jogger, n int) {
+		for i := range n {
+			task := &singleTask{job: job, obj: dlObj{objName: j.mpath + "/" + strconv.Itoa(i), link: "http://x"}}
+			j.q.putToSet(task.jobID(), task.uid())
+			j.q.ch <- task
+		}
+	}
+	var (
+		thief = d.joggers["/mp1"]
+		busy  = d.joggers["/mp2"]
+		other = d.joggers["/mp3"]
+	)
+
+	// below the backlog threshold: nothing to steal
+	enqueue(other, stealMinBacklog-1)
+	task, owner := thief.steal()
+	tassert.Fatalf(t, task == nil && owner == nil, "expected nothing to steal, got %v", task)
+
+	// steal from the jogger with the longest backlog (up to stealMaxThieves at a time)
+	enqueue(busy, stealMinBacklog+stealMaxThieves+1)
+	for i := range stealMaxThieves {
+		task, owner = thief.steal()
+		tassert.Fatalf(t, task != nil && owner == busy, "%d: expected to steal from %s, got (%v, %v)", i, busy.mpath, task, owner)
+		tassert.Errorf(t, busy.taskExists(task), "stolen task %s must be tracked by its owner", task)
+	}
+	task, _ = thief.steal()
+	tassert.Fatalf(t, task == nil, "expected no more than %d thieves, got %v", stealMaxThieves, task)
+
+	// done executing one
+	busy.thieves.Dec()
+	task, owner = thief.steal()
+	tassert.Fatalf(t, task != nil && owner == busy, "expected to steal from %s, got (%v, %v)", busy.mpath, task, owner)
+
+	// stopped jogger doesn't steal
+	busy.thieves.Store(0)
+	thief.stopAgent = true
+	task, _ = thief.steal()
+	tassert.Fatalf(t, task == nil, "stopped jogger must not steal, got %v", task)
+}
+
+// idle jogger blocks (no polling) until woken up to steal
+func TestJoggerStealWakeup(t *testing.T) {
+	var (
+		d   = &dispatcher{joggers: make(map[string]*jogger, 2)}
+		job = &singleDlJob{}
+	)
+	job.id, job.bck = "job", meta.NewBck("bck", apc.AIS, cmn.NsGlobal)
+	for _, mpath := range []string{"/mp1", "/mp2"} {
+		d.joggers[mpath] = newJogger(d, mpath)
+	}
+	var (
+		thief = d.joggers["/mp1"]
+		busy  = d.joggers["/mp2"]
+		ch    = make(chan *jogger, 1)
+	)
+	go func() {
+		_, owner := thief.get()
+		ch <- owner
+	}()
+	for i := range stealMinBacklog {
+		task := &singleTask{job: job, obj: dlObj{objName: strconv.Itoa(i), link: "http://x"}}
+		busy.q.putToSet(task.jobID(), task.uid())
+		busy.q.ch <- task
+	}
+	d.notifySteal() // (as per dispatchDownload)
+	select {
+	case owner := <-ch:
+		tassert.Fatalf(t, owner == busy, "expected to steal from %s, got %v", busy.mpath, owner)
+	case <-time.After(10 * time.Second):
+		t.Fatal("idle jogger was not woken up")
+	}
+
+	// and terminates when its queue is closed
+	go func() {
+		_, owner := thief.get()
+		ch <- owner
+	}()
+	thief.q.close()
+	select {
+	case owner := <-ch:
+		tassert.Fatalf(t, owner == nil, "expected termination, got %v", owner)
+	case <-time.After(10 * time.Second):
+		t.Fatal("idle jogger did not terminate")
+	}
+}
+
+func TestDispatcherReroute(t *testing.T) {
+	const qsize = 16
+	var (
+		d   = &dispatcher{joggers: make(map[string]*jogger, 3), qsize: qsize}
+		job = &singleDlJob{}
+	)
+	job.id, job.bck = "job", meta.NewBck("bck", apc.AIS, cmn.NsGlobal)
+	for _, mpath := range []string{"/mp1", "/mp2", "/mp3"} {
+		d.joggers[mpath] = newJogger(d, mpath)
+	}
+	newTask := func(name string) *singleTask {
+		return &singleTask{job: job, obj: dlObj{objName: name, link: "http://x"}}
+	}
+	enqueue := func(j *jogger, n int) {
+		for i := range n {
+			task := newTask(j.mpath + "/" + strconv.Itoa(i))
+			j.q.putToSet(task.jobID(), task.uid())
+			j.q.ch <- task
+		}
+	}
+	var (
+		full  = d.joggers["/mp1"]
+		half  = d.joggers["/mp2"]
+		light = d.joggers["/mp3"]
+	)
+	tassert.Fatalf(t, full.q.size == qsize, "expected queue size %d, got %d", qsize, full.q.size)
+
+	enqueue(full, qsize)
+	enqueue(half, qsize/2)
+	enqueue(light, 1)
+	tassert.Fatalf(t, full.q.full() && !half.q.full(), "expected only %s to be full", full.mpath)
+
+	// the least loaded
+	other := d.reroute(full, newTask("new"))
+	tassert.Fatalf(t, other == light, "expected to reroute to %s, got %v", light.mpath, other)
+
+	// not if already queued by its HRW jogger
+	other = d.reroute(full, newTask(full.mpath+"/0"))
+	tassert.Fatalf(t, other == nil, "expected no rerouting of an already queued task, got %v", other)
+
+	// not to stopped joggers, and not to those more than half-full
+	light.stopAgent = true
+	other = d.reroute(full, newTask("new"))
+	tassert.Fatalf(t, other == half, "expected to reroute to %s, got %v", half.mpath, other)
+	enqueue(half, 1)
+	other = d.reroute(full, newTask("new"))
+	tassert.Fatalf(t, other == nil, "expected nowhere to reroute, got %v", other)
+}
+
+func TestQueueResize(t *testing.T) {
+	const qsize = 16
+	var (
+		q   = newQueue(qsize)
+		job = &singleDlJob{}
+		n   int
+	)
+	job.id = "job"
+	fill := func(size int) {
+		for ; n < size; n++ {
+			task := &singleTask{job: job, obj: dlObj{objName: strconv.Itoa(n), link: "http://x"}}
+			q.putToSet(task.jobID(), task.uid())
+			q.ch <- task
+		}
+	}
+	fill(qsize)
+	space := q.waitSpace()
+	tassert.Fatalf(t, space != nil && q.full(), "expected full queue")
+
+	// growing the queue wakes up waiters
+	q.resize(2 * qsize)
+	_, ok := <-space
+	tassert.Errorf(t, !ok, "expected waiters to be woken up")
+	tassert.Errorf(t, q.waitSpace() == nil && !q.full(), "expected space in the queue")
+
+	// shrinking: waiting until below the new capacity
+	fill(2 * qsize)
+	q.resize(qsize)
+	space = q.waitSpace()
+	tassert.Fatalf(t, space != nil, "expected full queue")
+	for range qsize {
+		q.get()
+		select {
+		case <-space:
+			t.Fatalf("woken up at %d queued tasks", len(q.ch))
+		default:
+		}
+	}
+	q.get()
+	_, ok = <-space
+	tassert.Errorf(t, !ok && q.waitSpace() == nil, "expected waiters to be woken up at %d queued tasks", len(q.ch))
+}
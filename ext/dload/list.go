@@ -4,13 +4,17 @@
  */
 package dload
 
-import "regexp"
+import (
+	"errors"
+	"net/http"
+	"regexp"
+)
 
-func ListJobs(regex *regexp.Regexp, onlyActive bool) (any, int, error) {
+func ListJobs(regex *regexp.Regexp, onlyActive bool, status string) (any, int, error) {
 	var (
 		respMap map[string]Job
 		jobs    []*dljob
-		req     = &request{action: actList, regex: regex, onlyActive: onlyActive}
+		req     = &request{action: actList, regex: regex, onlyActive: onlyActive, status: status}
 	)
 	if g.store != nil {
 		jobs = g.store.getList(req)
@@ -28,3 +32,20 @@ ex:
 	rsp := req.response
 	return rsp.value, rsp.statusCode, rsp.err
 }
+
+// the original (sanitized) spec of a given job
+func JobSpec(id string) (any, int, error) {
+	if g.store == nil {
+		return nil, http.StatusNotFound, errors.New("download job " + id + " not found")
+	}
+	req := &request{id: id}
+	dljob, err := g.store.checkExists(req)
+	if err != nil {
+		rsp := req.response
+		return nil, rsp.statusCode, rsp.err
+	}
+	if len(dljob.spec) == 0 {
+		return nil, http.StatusNotFound, errors.New("download job " + id + ": job spec is not available")
+	}
+	return dljob.spec, http.StatusOK, nil
+}
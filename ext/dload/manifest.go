@@ -0,0 +1,168 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Integrity manifest (Base.Manifest):
+// - upon each successful download, the target records object's name, size, checksum, version,
+//   and source (URL or remote bucket);
+// - when the job finishes (including abort), each target stores its part of the manifest
+//   in the destination bucket as ManifestName(jobID, targetID);
+// - together, the parts enumerate all objects downloaded by the job;
+//   objects that were skipped (already present and unchanged) are counted but not listed.
+
+const (
+	ManifestPrefix = "dload-manifests/"
+
+	manifestTimeout = time.Minute // (target => target)
+)
+
+type (
+	Manifest struct {
+		Started  time.Time       `json:"started"`
+		Finished time.Time       `json:"finished"`
+		JobID    string          `json:"job_id"`
+		Target   string          `json:"target"`
+		Bucket   string          `json:"bucket"`
+		Objects  []ManifestEntry `json:"objects"`
+		Skipped  int             `json:"skipped"`            // already present and unchanged
+		Filtered int             `json:"filtered,omitempty"` // out of filter's size range (see Filter)
+		Errors   int             `json:"errors"`
+		Aborted  bool            `json:"aborted,omitempty"`
+	}
+	ManifestEntry struct {
+		Name       string `json:"name"`
+		Size       int64  `json:"size,string"`
+		CksumType  string `json:"checksum_type,omitempty"`
+		CksumValue string `json:"checksum,omitempty"`
+		Version    string `json:"version,omitempty"`
+		Source     string `json:"source"` // URL or remote bucket (e.g., s3://abc/name)
+	}
+)
+
+// object name of the target's part of the manifest
+func ManifestName(jobID, tid string) string {
+	return ManifestPrefix + path.Join(jobID, tid+".json")
+}
+
+// record successfully downloaded object
+func (task *singleTask) addManifestEntry(lom *core.LOM) {
+	entry := &ManifestEntry{
+		Name:    lom.ObjName,
+		Size:    lom.Lsize(),
+		Version: lom.Version(),
+		Source:  task.obj.link,
+	}
+	if task.obj.fromRemote {
+		entry.Source = lom.Cname()
+	}
+	if cksum := lom.Checksum(); cksum != nil && cksum.Type() != cos.ChecksumNone {
+		entry.CksumType, entry.CksumValue = cksum.Type(), cksum.Val()
+	}
+	if err := g.store.persistManifestEntry(task.jobID(), entry); err != nil {
+		nlog.Errorln(task.String(), "failed to record manifest entry:", err)
+	}
+}
+
+// store this target's part of the manifest
+func (j *baseDlJob) storeManifest(aborted bool) error {
+	dljob, err := g.store.getJob(j.ID())
+	if err != nil {
+		return err
+	}
+	entries, err := g.store.getManifest(j.ID())
+	if err != nil {
+		return err
+	}
+	m := &Manifest{
+		JobID:    j.ID(),
+		Target:   core.T.SID(),
+		Bucket:   j.bck.Cname(""),
+		Started:  dljob.startedTime,
+		Finished: time.Now(),
+		Skipped:  int(dljob.skippedCnt.Load()),
+		Filtered: int(dljob.filteredCnt.Load()),
+		Errors:   int(dljob.errorCnt.Load()),
+		Aborted:  aborted,
+		Objects:  entries,
+	}
+	if m.Objects == nil {
+		m.Objects = []ManifestEntry{}
+	}
+	b, err := jsoniter.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return j.putManifest(ManifestName(j.ID(), core.T.SID()), b)
+}
+
+// PUT locally or, if the name maps to another target, target => target
+func (j *baseDlJob) putManifest(objName string, b []byte) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(j.Bck()); err != nil {
+		return err
+	}
+	tsi, local, err := lom.HrwTarget(core.T.Sowner().Get())
+	if err != nil {
+		return err
+	}
+	if local {
+		params := core.AllocPutParams()
+		{
+			params.WorkTag = "dl"
+			params.Reader = io.NopCloser(cos.NewByteHandle(b))
+			params.OWT = cmn.OwtPut
+			params.Atime = time.Now()
+			params.Size = int64(len(b))
+		}
+		err := core.T.PutObject(lom, params)
+		core.FreePutParams(params)
+		return err
+	}
+
+	hdr := make(http.Header, 2)
+	hdr.Set(apc.HdrT2TPutterID, core.T.SID())
+	hdr.Set(cos.HdrContentLength, strconv.Itoa(len(b)))
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(j.bck.Name, objName),
+		Query:  j.bck.NewQuery(),
+		Header: hdr,
+		BodyR:  cos.NewByteHandle(b),
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(manifestTimeout)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	req.ContentLength = int64(len(b))
+	resp, err := core.T.DataClient().Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return err
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("failed to store %s at %s: status %d (%s)", lom.Cname(), tsi, resp.StatusCode, msg)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestManifestStore(t *testing.T) {
+	driver, err := kvdb.NewBuntDB(filepath.Join(t.TempDir(), "dl.db"))
+	tassert.CheckFatal(t, err)
+	db := newDownloadDB(driver)
+
+	const (
+		id = "dnl-abc"
+		n  = 2*manifestCacheSize + 10 // spills over to the DB (twice)
+	)
+	entries, err := db.getManifest(id)
+	tassert.Errorf(t, err == nil && len(entries) == 0, "expected no entries, got (%d, %v)", len(entries), err)
+
+	for i := range n {
+		entry := &ManifestEntry{Name: fmt.Sprintf("obj-%04d", i), Size: int64(i), Source: fmt.Sprintf("https://host/obj-%04d", i)}
+		tassert.CheckFatal(t, db.persistManifestEntry(id, entry))
+	}
+	check := func(tag string) {
+		entries, err := db.getManifest(id)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(entries) == n, "%s: expected %d entries, got %d", tag, n, len(entries))
+		for i := range entries {
+			tassert.Fatalf(t, entries[i].Name == fmt.Sprintf("obj-%04d", i) && entries[i].Size == int64(i),
+				"%s: unexpected entry %d: %+v", tag, i, entries[i])
+		}
+	}
+	check("cached")
+	tassert.CheckFatal(t, db.flush(id))
+	check("flushed")
+
+	db.delete(id)
+	entries, err = db.getManifest(id)
+	tassert.Errorf(t, err == nil && len(entries) == 0, "expected no entries after delete, got (%d, %v)", len(entries), err)
+
+	tassert.Errorf(t, ManifestName(id, "t1") == "dload-manifests/dnl-abc/t1.json", "unexpected %q", ManifestName(id, "t1"))
+}
@@ -0,0 +1,201 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// NamingRules derive destination object names from source URLs - for range and
+// multi-link downloads, and single-object downloads that do not specify the name.
+// Query strings and fragments are always stripped.
+type NamingRules struct {
+	// source URL path prefix => destination prefix (the longest match wins);
+	// prefixes match whole path segments ("/data" matches "/data/x" but not "/database/x");
+	// when matched, the rest of the URL path is kept in its entirety
+	Prefixes map[string]string `json:"prefixes,omitempty"`
+	// number of trailing URL path segments to keep: 0 (default) - base name only, -1 - entire path
+	KeepSegments int `json:"keep_segments,omitempty"`
+	// unsafe characters and "." or ".." segments: NamingReject (default) or NamingReplace (with '_')
+	OnUnsafe string `json:"on_unsafe,omitempty"`
+}
+
+const (
+	NamingReject  = "reject"
+	NamingReplace = "replace"
+)
+
+const namingSafeChars = "-_.~+=,@!()"
+
+func (r *NamingRules) Validate() error {
+	switch r.OnUnsafe {
+	case "", NamingReject, NamingReplace:
+	default:
+		return fmt.Errorf("invalid 'naming.on_unsafe' %q (expecting %q or %q)", r.OnUnsafe, NamingReject, NamingReplace)
+	}
+	if r.KeepSegments < -1 {
+		return fmt.Errorf("invalid 'naming.keep_segments' %d (expecting -1, 0, or positive)", r.KeepSegments)
+	}
+	for src, dst := range r.Prefixes {
+		if strings.Trim(src, "/") == "" {
+			return errors.New("'naming.prefixes' contains empty source prefix")
+		}
+		if dst == "" {
+			continue
+		}
+		if _, err := sanitizeName(dst, false); err != nil {
+			return fmt.Errorf("invalid 'naming.prefixes' destination %q: %v", dst, err)
+		}
+	}
+	return nil
+}
+
+// ObjName returns destination object name for a given source link.
+func (r *NamingRules) ObjName(link string) (string, error) {
+	u, err := url.Parse(cmn.PrependProtocol(link))
+	if err != nil {
+		return "", err
+	}
+	var name string
+	segs, err := r.pathSegments(u)
+	if err == nil {
+		if n, dst, ok := r.matchPrefix(segs); ok {
+			name = dst + strings.Join(segs[n:], "/")
+		} else {
+			name = r.trimSegments(segs)
+		}
+		name, err = sanitizeName(name, r.OnUnsafe == NamingReplace)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot derive object name from %q: %v", link, err)
+	}
+	return name, nil
+}
+
+// split the (escaped) URL path and unescape each segment separately,
+// so that an encoded '/' (%2F) does not become a separator
+func (r *NamingRules) pathSegments(u *url.URL) ([]string, error) {
+	var (
+		parts = strings.Split(u.EscapedPath(), "/")
+		segs  = make([]string, 0, len(parts))
+	)
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		seg, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, err
+		}
+		if strings.IndexByte(seg, '/') >= 0 {
+			if r.OnUnsafe != NamingReplace {
+				return nil, fmt.Errorf("unsafe character %q", '/')
+			}
+			seg = strings.ReplaceAll(seg, "/", "_")
+		}
+		segs = append(segs, seg)
+	}
+	return segs, nil
+}
+
+// returns the number of matched segments of the longest matching source prefix
+func (r *NamingRules) matchPrefix(segs []string) (n int, dst string, ok bool) {
+	for s, d := range r.Prefixes {
+		src := strings.Split(strings.Trim(s, "/"), "/")
+		if len(src) <= n || len(src) > len(segs) {
+			continue
+		}
+		matched := true
+		for i := range src {
+			if src[i] != segs[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			n, dst, ok = len(src), d, true
+		}
+	}
+	if ok && dst != "" && !strings.HasSuffix(dst, "/") {
+		dst += "/"
+	}
+	return n, dst, ok
+}
+
+func (r *NamingRules) trimSegments(segs []string) string {
+	switch n := r.KeepSegments; {
+	case len(segs) == 0:
+		return ""
+	case n == -1:
+		return strings.Join(segs, "/")
+	case n == 0:
+		return segs[len(segs)-1]
+	default:
+		return strings.Join(segs[max(len(segs)-n, 0):], "/")
+	}
+}
+
+// empty segments are dropped; unsafe characters and "." or ".." segments are either rejected or replaced
+func sanitizeName(name string, replace bool) (string, error) {
+	var (
+		segs = strings.Split(name, "/")
+		out  = make([]string, 0, len(segs))
+	)
+	for _, seg := range segs {
+		switch seg {
+		case "":
+			continue
+		case ".", "..":
+			if !replace {
+				return "", fmt.Errorf("unsafe path segment %q", seg)
+			}
+			seg = strings.Repeat("_", len(seg))
+		}
+		for _, c := range seg {
+			if unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune(namingSafeChars, c) {
+				continue
+			}
+			if !replace {
+				return "", fmt.Errorf("unsafe character %q", c)
+			}
+			seg = strings.Map(safeRune, seg)
+			break
+		}
+		out = append(out, seg)
+	}
+	if len(out) == 0 {
+		return "", errors.New("empty name")
+	}
+	return path.Join(out...), nil
+}
+
+func safeRune(c rune) rune {
+	if unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune(namingSafeChars, c) {
+		return c
+	}
+	return '_'
+}
+
+// destination object name: naming rules, if defined, or the link's base name
+func objNameFromLink(r *NamingRules, link string) (string, error) {
+	if r != nil {
+		return r.ObjName(link)
+	}
+	if objName, ok := sharedLinkObjName(link); ok {
+		return objName, nil
+	}
+	objName := path.Base(link)
+	if objName == "." || objName == "/" {
+		return "", fmt.Errorf("failed to extract object name from the download %q", link)
+	}
+	return objName, nil
+}
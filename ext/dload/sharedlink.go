@@ -0,0 +1,181 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Shared-link sources: Google Drive and Dropbox.
+// Share links point to HTML "preview" pages - here we translate them into direct
+// download links and, for Google Drive, pass the virus-scan confirmation page
+// that precedes large files. Rate limiting (429/503) is handled by retrying
+// with backoff (see `retryAfter`).
+
+const (
+	srcGDrive  = "gdrive"
+	srcDropbox = "dropbox"
+
+	gdriveDownload = "https://drive.usercontent.google.com/download"
+
+	maxConfirmPage = 1 << 20 // HTML confirmation page is small
+
+	minRetryAfter = time.Second
+	maxRetryAfter = time.Minute
+)
+
+var (
+	gdriveFileRegex = regexp.MustCompile(`/file/d/([\w-]+)`)
+	gdriveFormRegex = regexp.MustCompile(`(?s)<form[^>]+id="download-form"[^>]+action="([^"]+)"(.*?)</form>`)
+	gdriveHidRegex  = regexp.MustCompile(`<input type="hidden" name="([^"]+)" value="([^"]*)"`)
+	gdriveConfRegex = regexp.MustCompile(`confirm=([\w-]+)`)
+
+	errGDriveQuota = errors.New("Google Drive: download quota exceeded or the file is not shared publicly")
+)
+
+// returns the source type and, for Google Drive, file ID
+func sharedLinkSrc(u *url.URL) (src, id string) {
+	host := strings.ToLower(u.Hostname())
+	switch host {
+	case "drive.google.com", "docs.google.com", "drive.usercontent.google.com":
+		if m := gdriveFileRegex.FindStringSubmatch(u.Path); m != nil {
+			return srcGDrive, m[1]
+		}
+		if id = u.Query().Get("id"); id != "" {
+			return srcGDrive, id
+		}
+	case "www.dropbox.com", "dropbox.com":
+		return srcDropbox, ""
+	}
+	return "", ""
+}
+
+// translate share link into direct download link (noop for all other links)
+func resolveSharedLink(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	switch src, id := sharedLinkSrc(u); src {
+	case srcGDrive:
+		q := url.Values{"id": []string{id}, "export": []string{"download"}}
+		if rk := u.Query().Get("resourcekey"); rk != "" {
+			q.Set("resourcekey", rk)
+		}
+		return gdriveDownload + "?" + q.Encode()
+	case srcDropbox:
+		q := u.Query()
+		q.Del("raw")
+		q.Set("dl", "1")
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+	return link
+}
+
+// default object name when not specified by the user (and no naming rules)
+func sharedLinkObjName(link string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", false
+	}
+	switch src, id := sharedLinkSrc(u); src {
+	case srcGDrive:
+		return id, true // (share link does not contain filename)
+	case srcDropbox:
+		if name := path.Base(u.Path); name != "." && name != "/" {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Google Drive responds with HTML (instead of the content) when a file is too large
+// to be virus-scanned - the page contains a form to confirm the download
+func isConfirmPage(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusOK &&
+		strings.HasPrefix(resp.Header.Get(cos.HdrContentType), "text/html") &&
+		strings.HasPrefix(resp.Request.URL.Host, "drive.")
+}
+
+func gdriveConfirmLink(body io.Reader, link string) (string, error) {
+	b, err := io.ReadAll(io.LimitReader(body, maxConfirmPage))
+	if err != nil {
+		return "", err
+	}
+	page := string(b)
+
+	// current: <form id="download-form" action="..."> with hidden inputs
+	if m := gdriveFormRegex.FindStringSubmatch(page); m != nil {
+		q := url.Values{}
+		for _, in := range gdriveHidRegex.FindAllStringSubmatch(m[2], -1) {
+			q.Set(html.UnescapeString(in[1]), html.UnescapeString(in[2]))
+		}
+		return html.UnescapeString(m[1]) + "?" + q.Encode(), nil
+	}
+	// legacy: "confirm=XXXX" token in the download link
+	if m := gdriveConfRegex.FindStringSubmatch(page); m != nil {
+		u, err := url.Parse(link)
+		if err != nil {
+			return "", err
+		}
+		q := u.Query()
+		q.Set("confirm", m[1])
+		u.RawQuery = q.Encode()
+		return u.String(), nil
+	}
+	return "", errGDriveQuota
+}
+
+// how long to wait before retrying rate-limited request
+func retryAfter(hdr string, attempt int) time.Duration {
+	if hdr != "" {
+		if secs, err := strconv.Atoi(hdr); err == nil {
+			return min(max(time.Duration(secs)*time.Second, minRetryAfter), maxRetryAfter)
+		}
+		if t, err := http.ParseTime(hdr); err == nil {
+			return min(max(time.Until(t), minRetryAfter), maxRetryAfter)
+		}
+	}
+	return min(minRetryAfter<<attempt, maxRetryAfter)
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isRateLimited(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+}
+
+type errRateLimited struct {
+	link       string
+	retryAfter string // "Retry-After" header, if any
+	status     int
+}
+
+func (e *errRateLimited) Error() string {
+	return fmt.Sprintf("rate-limited by %q (status %d)", e.link, e.status)
+}
@@ -0,0 +1,72 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestResolveSharedLink(t *testing.T) {
+	tests := []struct {
+		link, expected, objName string
+	}{
+		{
+			"https://drive.google.com/file/d/1AbC-d_E/view?usp=sharing",
+			"https://drive.usercontent.google.com/download?export=download&id=1AbC-d_E", "1AbC-d_E",
+		},
+		{
+			"https://drive.google.com/open?id=XyZ&resourcekey=0-rk",
+			"https://drive.usercontent.google.com/download?export=download&id=XyZ&resourcekey=0-rk", "XyZ",
+		},
+		{
+			"https://www.dropbox.com/scl/fi/abc/train.tar?rlkey=k&dl=0",
+			"https://www.dropbox.com/scl/fi/abc/train.tar?dl=1&rlkey=k", "train.tar",
+		},
+		{
+			"https://www.dropbox.com/s/abc/data.csv?raw=1",
+			"https://www.dropbox.com/s/abc/data.csv?dl=1", "data.csv",
+		},
+		{"https://example.com/data/file.tar", "https://example.com/data/file.tar", ""},
+	}
+	for _, test := range tests {
+		got := resolveSharedLink(test.link)
+		tassert.Errorf(t, got == test.expected, "%q: expected %q, got %q", test.link, test.expected, got)
+		objName, _ := sharedLinkObjName(test.link)
+		tassert.Errorf(t, objName == test.objName, "%q: expected object name %q, got %q", test.link, test.objName, objName)
+	}
+}
+
+func TestGDriveConfirmLink(t *testing.T) {
+	const link = "https://drive.usercontent.google.com/download?export=download&id=ID"
+
+	form := `<html><body><form id="download-form" action="https://drive.usercontent.google.com/download" method="get">
+<input type="hidden" name="id" value="ID"><input type="hidden" name="export" value="download">
+<input type="hidden" name="confirm" value="t"><input type="hidden" name="uuid" value="u-1"></form></body></html>`
+	got, err := gdriveConfirmLink(strings.NewReader(form), link)
+	tassert.CheckFatal(t, err)
+	expected := "https://drive.usercontent.google.com/download?confirm=t&export=download&id=ID&uuid=u-1"
+	tassert.Errorf(t, got == expected, "expected %q, got %q", expected, got)
+
+	legacy := `<a id="uc-download-link" href="/uc?export=download&amp;confirm=AbC1&amp;id=ID">Download anyway</a>`
+	got, err = gdriveConfirmLink(strings.NewReader(legacy), link)
+	tassert.CheckFatal(t, err)
+	expected = "https://drive.usercontent.google.com/download?confirm=AbC1&export=download&id=ID"
+	tassert.Errorf(t, got == expected, "expected %q, got %q", expected, got)
+
+	_, err = gdriveConfirmLink(strings.NewReader("<html>Quota exceeded</html>"), link)
+	tassert.Errorf(t, err == errGDriveQuota, "expected quota error, got %v", err)
+}
+
+func TestRetryAfter(t *testing.T) {
+	tassert.Errorf(t, retryAfter("5", 0) == 5*time.Second, "expected 5s")
+	tassert.Errorf(t, retryAfter("0", 0) == minRetryAfter, "expected min")
+	tassert.Errorf(t, retryAfter("3600", 0) == maxRetryAfter, "expected max")
+	tassert.Errorf(t, retryAfter("", 2) == 4*time.Second, "expected exponential backoff")
+	tassert.Errorf(t, retryAfter("garbage", 10) == maxRetryAfter, "expected max")
+}
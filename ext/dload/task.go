@@ -43,6 +43,8 @@ type singleTask struct {
 	downloadCtx context.Context    // w/ cancel function
 	getCtx      context.Context    // w/ timeout and size
 	cancel      context.CancelFunc // to cancel in-progress download
+	cp          *checkpoint        // to resume from (see checkpoint.go)
+	bwj         *bwjob             // bandwidth share (see bwsched.go)
 }
 
 // List of HTTP status codes which we shouldn'task retry (just report the job failed).
@@ -73,6 +75,7 @@ func (task *singleTask) download(lom *core.LOM) {
 	}
 	if err != nil && !os.IsNotExist(err) {
 		task.markFailed(internalErrorMsg)
+		releaseObj(task.job, task.obj.objName)
 		return
 	}
 
@@ -82,19 +85,32 @@ func (task *singleTask) download(lom *core.LOM) {
 
 	task.started.Store(time.Now())
 	lom.SetAtimeUnix(task.started.Load().UnixNano())
-	if task.obj.fromRemote {
+	if task.bwj = g.bws.begin(task.jobID()); task.bwj != nil {
+		defer g.bws.end(task.bwj)
+	}
+	switch tj, ok := task.job.(*torrentDlJob); {
+	case ok:
+		err = tj.download(task, lom)
+	case task.obj.fromRemote:
 		err = task.downloadRemote(lom)
-	} else {
+	default:
 		err = task.downloadLocal(lom)
 	}
 	task.ended.Store(time.Now())
 
 	if err != nil {
-		task.markFailed(err.Error())
+		if errors.Is(err, errFiltered) {
+			task.markFiltered(err)
+		} else {
+			task.markFailed(err.Error())
+		}
 		return
 	}
 
 	g.store.incFinished(task.jobID())
+	if task.job.withManifest() {
+		task.addManifestEntry(lom)
+	}
 
 	vlabs := map[string]string{stats.VarlabBucket: lom.Bck().Cname("")}
 	lsize := task.currentSize.Load()
@@ -110,30 +126,75 @@ func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*er
 	defer cancel()
 
 	task.getCtx = ctx
+	task.cp = task.loadCheckpoint()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, task.obj.link, http.NoBody)
+	// share links (Google Drive, Dropbox) => direct download
+	link := resolveSharedLink(task.obj.link)
+	req, err := task.newReq(ctx, link)
 	if err != nil {
 		return true, err
 	}
-
-	// Set "User-Agent" header when doing requests to Google Cloud Storage.
-	// This should increase the number of connections to GCS.
-	if cos.IsGoogleStorageURL(req.URL) {
-		req.Header.Add("User-Agent", gcsUA)
-	}
-
-	resp, err := clientForURL(task.obj.link).Do(req) //nolint:bodyclose // cos.Close
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
 	if err != nil {
 		return false, err
 	}
+	if isConfirmPage(resp) {
+		// Google Drive: confirm download of a file that's too large to be virus-scanned
+		link, err = gdriveConfirmLink(resp.Body, link)
+		cos.Close(resp.Body)
+		if err != nil {
+			return true, err
+		}
+		if req, err = task.newReq(ctx, link); err != nil {
+			return true, err
+		}
+		if resp, err = clientForURL(link).Do(req); err != nil { //nolint:bodyclose // cos.Close
+			return false, err
+		}
+		if isConfirmPage(resp) {
+			cos.Close(resp.Body)
+			return true, errGDriveQuota
+		}
+	}
+	if isRateLimited(resp.StatusCode) {
+		erl := &errRateLimited{link: task.obj.link, retryAfter: resp.Header.Get(cos.HdrRetryAfter), status: resp.StatusCode}
+		cos.Close(resp.Body)
+		return false, erl
+	}
 
 	fatal, err := task._dput(lom, req, resp)
 	cos.Close(resp.Body)
 	return fatal, err
 }
 
+func (task *singleTask) newReq(ctx context.Context, link string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set "User-Agent" header when doing requests to Google Cloud Storage.
+	// This should increase the number of connections to GCS.
+	if cos.IsGoogleStorageURL(req.URL) {
+		req.Header.Add("User-Agent", gcsUA)
+	}
+	for k, v := range task.job.header() {
+		req.Header[k] = v
+	}
+	if task.cp != nil {
+		req.Header.Set(cos.HdrRange, fmt.Sprintf("%s%d-", cos.HdrRangeValPrefix, task.cp.Offset))
+		req.Header.Set(cos.HdrIfRange, task.cp.Validator)
+	}
+	return req, nil
+}
+
 func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Response) (bool /*err is fatal*/, error) {
 	if resp.StatusCode >= http.StatusBadRequest {
+		if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && task.cp != nil {
+			task.delCheckpoint(task.cp) // retry from scratch
+			return false, cmn.NewErrHTTP(req, fmt.Errorf("cannot resume %q at offset %d", task.obj.link, task.cp.Offset),
+				resp.StatusCode)
+		}
 		if resp.StatusCode == http.StatusNotFound {
 			return false, cmn.NewErrHTTP(req, fmt.Errorf("%q does not exist", task.obj.link), http.StatusNotFound)
 		}
@@ -142,10 +203,48 @@ func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Respo
 			resp.StatusCode)
 	}
 
-	r := task.wrapReader(resp.Body)
 	size := attrsFromLink(task.obj.link, resp, lom)
+	resumed := task.cp != nil && resp.StatusCode == http.StatusPartialContent
+	if resumed {
+		if err := task.cp.validate(resp); err != nil {
+			task.delCheckpoint(task.cp) // ditto
+			return false, cmn.NewErrHTTP(req, err, resp.StatusCode)
+		}
+		size = task.cp.Size
+	} else if task.cp != nil {
+		task.delCheckpoint(task.cp) // source ignored the range or content changed: start over
+	}
+	flt := task.job.filter()
+	if size > 0 && !flt.MatchSize(size) {
+		return true, fmt.Errorf("%w: size %d is outside [%d, %d]", errFiltered, size, flt.MinSize, flt.MaxSize)
+	}
 	task.setTotalSize(size)
 
+	var (
+		r   = task.wrapReader(resp.Body)
+		cpr *cpReader
+	)
+	if resumed {
+		var err error
+		if r, cpr, err = task.resume(r); err != nil {
+			task.delCheckpoint(task.cp)
+			return false, cmn.NewErrHTTP(req, err, resp.StatusCode)
+		}
+	} else if cpr = task.newCheckpoint(lom, resp, size, r); cpr != nil {
+		r = cpr
+	}
+	var msr *maxSizeReader
+	if size <= 0 && flt != nil && flt.MaxSize > 0 {
+		msr = &maxSizeReader{r: r, max: flt.MaxSize}
+		r = msr
+	}
+	var hv *hfVerifier
+	if hj, ok := task.job.(*hfDlJob); ok {
+		if hv = hj.verifier(lom, r); hv != nil {
+			r = hv
+		}
+	}
+
 	params := core.AllocPutParams()
 	{
 		params.WorkTag = "dl"
@@ -157,6 +256,18 @@ func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Respo
 	}
 	erp := core.T.PutObject(lom, params)
 	core.FreePutParams(params)
+	if cpr != nil {
+		cpr.fini(erp == nil)
+	}
+	if msr != nil && msr.exceeded {
+		return true, msr.err()
+	}
+	if hv != nil && hv.err != nil {
+		if cpr != nil {
+			task.delCheckpoint(cpr.cp) // (corrupted)
+		}
+		return true, hv.err
+	}
 	if erp != nil {
 		return true, erp
 	}
@@ -181,7 +292,14 @@ func (task *singleTask) downloadLocal(lom *core.LOM) (err error) {
 		if errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
 			return err // canceled or stopped, so just return
 		}
-		if errors.Is(err, context.DeadlineExceeded) {
+		var erl *errRateLimited
+		if errors.As(err, &erl) {
+			d := retryAfter(erl.retryAfter, i)
+			nlog.Warningf("%s [retries: %d/%d]: %v - retrying in %v", task, i, retryCnt, err, d)
+			if err := sleepCtx(task.downloadCtx, d); err != nil {
+				return err
+			}
+		} else if errors.Is(err, context.DeadlineExceeded) {
 			nlog.Warningf("%s [retries: %d/%d]: timeout (%v) - increasing and retrying", task, i, retryCnt, timeout)
 			timeout = time.Duration(float64(timeout) * reqTimeoutFactor)
 		} else if herr := cmn.Err2HTTPErr(err); herr != nil {
@@ -244,7 +362,12 @@ func (task *singleTask) wrapReader(r io.ReadCloser) io.ReadCloser {
 		},
 	}
 	// Wrap around throttler reader (noop if throttling is disabled).
-	r = task.job.throttler().wrapReader(task.getCtx, r)
+	throt := task.job.throttler()
+	r = throt.wrapReader(task.getCtx, r)
+	// Bandwidth share, unless boosted.
+	if task.bwj != nil && !throt.boosted() {
+		r = &bwReader{job: task.bwj, ctx: task.getCtx, r: r}
+	}
 	return r
 }
 
@@ -256,6 +379,14 @@ func (task *singleTask) markFailed(statusMsg string) {
 	g.store.incErrorCnt(task.jobID())
 }
 
+// size filter (see Filter): not an error
+func (task *singleTask) markFiltered(err error) {
+	if cmn.Rom.FastV(4, cos.SmoduleDload) {
+		nlog.Infoln(task.String()+":", err)
+	}
+	g.store.incFiltered(task.jobID())
+}
+
 func (task *singleTask) persist() {
 	if err := g.store.persistTaskInfo(task); err != nil {
 		nlog.Errorln(err)
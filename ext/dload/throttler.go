@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -17,8 +18,13 @@ var errThrottlerStopped = errors.New("throttler has been stopped")
 
 type (
 	throttler struct {
-		sema    *cos.Semaphore
-		emptyCh chan struct{} // Empty, closed channel (set only if `sema == nil`).
+		sema    chan struct{} // connection tokens (nil when the number of connections is not limited)
+		emptyCh chan struct{} // Empty, closed channel (returned when `sema == nil` or boosted).
+		boostCh *cos.StopCh   // closed upon boost (i.e., when all limits are lifted)
+
+		// pause: non-nil while paused, closed upon resume (see pause, resume)
+		resumeCh chan struct{}
+		pauseMu  sync.Mutex
 
 		maxBytesPerMinute int
 		capacityCh        chan int
@@ -39,11 +45,14 @@ type (
 )
 
 func (t *throttler) init(limits Limits) {
+	t.emptyCh = make(chan struct{})
+	close(t.emptyCh)
+	t.boostCh = cos.NewStopCh()
 	if limits.Connections > 0 {
-		t.sema = cos.NewSemaphore(limits.Connections)
-	} else {
-		t.emptyCh = make(chan struct{})
-		close(t.emptyCh)
+		t.sema = make(chan struct{}, limits.Connections)
+		for range limits.Connections {
+			t.sema <- struct{}{}
+		}
 	}
 	if limits.BytesPerHour > 0 {
 		t.initThroughputThrottling(limits.BytesPerHour / 60)
@@ -104,21 +113,71 @@ func (t *throttler) do() {
 }
 
 func (t *throttler) tryAcquire() <-chan struct{} {
-	if t.sema == nil {
+	if t.sema == nil || t.boosted() {
 		return t.emptyCh
 	}
-	return t.sema.TryAcquire()
+	return t.sema
 }
 
+// never blocks: tokens acquired after boost are not tracked
 func (t *throttler) release() {
 	if t.sema == nil {
 		return
 	}
-	t.sema.Release()
+	select {
+	case t.sema <- struct{}{}:
+	default:
+	}
+}
+
+// Lift all limits (connections and bandwidth) for the remaining lifetime of the job.
+// Takes effect immediately, including requests that are currently waiting to be admitted
+// and readers waiting for throughput allowance.
+func (t *throttler) boost() { t.boostCh.Close() }
+
+// Stop admitting new requests until resume (requests that were already admitted
+// run to completion). Returns false if already paused.
+func (t *throttler) pause() bool {
+	t.pauseMu.Lock()
+	defer t.pauseMu.Unlock()
+	if t.resumeCh != nil {
+		return false
+	}
+	t.resumeCh = make(chan struct{})
+	return true
+}
+
+// Returns false if not paused.
+func (t *throttler) resume() bool {
+	t.pauseMu.Lock()
+	defer t.pauseMu.Unlock()
+	if t.resumeCh == nil {
+		return false
+	}
+	close(t.resumeCh)
+	t.resumeCh = nil
+	return true
+}
+
+// returns the channel to wait on when paused, nil otherwise
+func (t *throttler) paused() <-chan struct{} {
+	t.pauseMu.Lock()
+	ch := t.resumeCh
+	t.pauseMu.Unlock()
+	return ch
+}
+
+func (t *throttler) boosted() bool {
+	select {
+	case <-t.boostCh.Listen():
+		return true
+	default:
+		return false
+	}
 }
 
 func (t *throttler) wrapReader(ctx context.Context, r io.ReadCloser) io.ReadCloser {
-	if t.maxBytesPerMinute == 0 {
+	if t.maxBytesPerMinute == 0 || t.boosted() {
 		return r
 	}
 	return &throttledReader{
@@ -145,6 +204,9 @@ func (t *throttler) giveBack(leftoverSize int) {
 }
 
 func (t *throttler) acquireAllowance(ctx context.Context, n int) error {
+	if t.boosted() {
+		return nil
+	}
 	select {
 	case size, ok := <-t.capacityCh:
 		if !ok {
@@ -152,6 +214,8 @@ func (t *throttler) acquireAllowance(ctx context.Context, n int) error {
 		}
 		t.giveBack(size - n)
 		return nil
+	case <-t.boostCh.Listen():
+		return nil
 	case <-ctx.Done():
 		return context.Canceled
 	}
@@ -0,0 +1,87 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestThrottlerBoost(t *testing.T) {
+	var throt throttler
+	throt.init(Limits{Connections: 1, BytesPerHour: 60 * 10}) // 10 bytes per minute
+	defer throt.stop()
+
+	ready := func() bool {
+		select {
+		case <-throt.tryAcquire():
+			return true
+		default:
+			return false
+		}
+	}
+	tassert.Fatalf(t, ready(), "expected the first connection to be admitted")
+	tassert.Errorf(t, !ready(), "expected the second connection to wait")
+
+	// bandwidth: exhaust the allowance, and make sure the next reader waits
+	ctx := context.Background()
+	tassert.CheckFatal(t, throt.acquireAllowance(ctx, 10))
+	waiting := make(chan error, 1)
+	go func() { waiting <- throt.acquireAllowance(ctx, 10) }()
+	select {
+	case err := <-waiting:
+		t.Fatalf("expected the reader to wait for allowance, got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	throt.boost()
+	select {
+	case err := <-waiting:
+		tassert.CheckFatal(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("expected the waiting reader to proceed upon boost")
+	}
+	tassert.Errorf(t, ready() && ready(), "expected all connections to be admitted upon boost")
+
+	// releasing more than acquired must not block
+	for range 3 {
+		throt.release()
+	}
+}
+
+func TestThrottlerPause(t *testing.T) {
+	var throt throttler
+	throt.init(Limits{})
+
+	tassert.Fatalf(t, throt.paused() == nil, "expected not paused")
+	tassert.Fatalf(t, !throt.resume(), "expected resume to be a no-op when not paused")
+
+	tassert.Fatalf(t, throt.pause(), "expected pause")
+	tassert.Fatalf(t, !throt.pause(), "expected repeated pause to be a no-op")
+	resumeCh := throt.paused()
+	tassert.Fatalf(t, resumeCh != nil, "expected paused")
+
+	waiting := make(chan struct{})
+	go func() {
+		<-resumeCh
+		close(waiting)
+	}()
+	select {
+	case <-waiting:
+		t.Fatal("expected to wait while paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	tassert.Fatalf(t, throt.resume(), "expected resume")
+	select {
+	case <-waiting:
+	case <-time.After(time.Second):
+		t.Fatal("expected to proceed upon resume")
+	}
+	tassert.Errorf(t, throt.paused() == nil, "expected not paused after resume")
+}
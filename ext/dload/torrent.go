@@ -0,0 +1,760 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // BitTorrent v1 piece and info hashes are SHA-1 by definition
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// BitTorrent source: .torrent (metainfo) or magnet link, downloaded via WebSeeds (BEP 19)
+// - metainfo is given inline (TorrentBody.Metainfo), by URL, or via magnet link's `xs` (exact source)
+//   parameter; in the latter case, info-hash (`xt=urn:btih:`) must match;
+// - file data is fetched from HTTP(S) web seeds (metainfo `url-list` and magnet `ws`) using range reads;
+//   peer-to-peer transfer (trackers, DHT) is not supported;
+// - each file becomes an object; as with all other downloads, each target downloads its own (HRW) subset;
+// - the target reads whole pieces covering its file, verifies their SHA-1, and stores the file's part of each piece;
+// - objects that already exist and match their web seed files are skipped (see CompareObjects),
+//   which makes a re-submitted job effectively resume;
+// - a piece that spans multiple files is downloaded (and verified) once per target, and then shared
+//   by the respective files (see tpieces);
+// - unlike BitTorrent peers, targets do not exchange pieces: a piece that spans two files assigned
+//   to different targets is read by both.
+
+const (
+	TorrentExt       = ".torrent"
+	MagnetScheme     = "magnet"
+	torrentMaxSize   = 16 * cos.MiB // metainfo
+	torrentTimeout   = time.Minute  // fetching metainfo
+	torrentRetries   = 3            // rounds over all web seeds, per piece
+	torrentMaxPieceL = 64 * cos.MiB
+)
+
+type (
+	torrentMeta struct {
+		name     string
+		infoHash [sha1.Size]byte
+		pieceLen int64
+		pieces   string // concatenated SHA-1 hashes
+		total    int64
+		files    []*torrentFile
+		webseeds []string
+		multi    bool
+	}
+	torrentFile struct {
+		path []string // path in the torrent (excluding name)
+		off  int64    // offset in the torrent's (concatenated) content
+		size int64
+	}
+
+	torrentDlJob struct {
+		sliceDlJob
+		mi     *torrentMeta
+		files  map[string]*torrentFile // by object name
+		pieces tpieces
+	}
+
+	// verified pieces shared by multiple files (of a given job)
+	tpieces struct {
+		m     map[int]*tpiece
+		refs  map[int]int         // piece index => number of files (still) to read it
+		files map[string]struct{} // files yet to be either downloaded or released (see claim)
+		mu    sync.Mutex
+	}
+	tpiece struct {
+		sgl     *memsys.SGL
+		ready   chan struct{}
+		err     error
+		readers int
+	}
+
+	// reads (and verifies) whole pieces that cover a given file
+	pieceReader struct {
+		ctx     context.Context
+		j       *torrentDlJob
+		f       *torrentFile
+		sgl     *memsys.SGL // private (not shared) pieces
+		sp      *tpiece     // current piece, if shared
+		cur     io.Reader   // (remaining) file's part of the current piece
+		timeout time.Duration
+		spi     int // index of the current shared piece
+		next    int
+		last    int
+	}
+)
+
+var (
+	errPieceHash = errors.New("piece hash mismatch")
+
+	// interface guard
+	_ jobif = (*torrentDlJob)(nil)
+)
+
+/////////////////
+// TorrentBody //
+/////////////////
+
+func (b *TorrentBody) Validate() error {
+	if err := b.Base.Validate(); err != nil {
+		return err
+	}
+	switch {
+	case b.Torrent == "" && len(b.Metainfo) == 0:
+		return errors.New("missing 'torrent' (URL or magnet link) or 'metainfo' in the request body")
+	case b.Torrent != "" && len(b.Metainfo) > 0:
+		return errors.New("'torrent' and 'metainfo' are mutually exclusive")
+	case len(b.Metainfo) > torrentMaxSize:
+		return fmt.Errorf("metainfo is too large (%d > %d)", len(b.Metainfo), torrentMaxSize)
+	}
+	return nil
+}
+
+func (b *TorrentBody) Describe() string {
+	if b.Description != "" {
+		return b.Description
+	}
+	src := b.Torrent
+	if src == "" {
+		src = "metainfo"
+	}
+	return fmt.Sprintf("%s -> %s", cos.SHead(src), b.Bck)
+}
+
+func (b *TorrentBody) String() string {
+	return fmt.Sprintf("bucket: %q, torrent: %q, metainfo: %dB, subdir: %q", b.Bck, b.Torrent, len(b.Metainfo), b.Subdir)
+}
+
+// resolve metainfo; add magnet's web seeds, if any
+func (b *TorrentBody) meta() (*torrentMeta, error) {
+	if len(b.Metainfo) > 0 {
+		return parseTorrent(b.Metainfo)
+	}
+	if !strings.HasPrefix(b.Torrent, MagnetScheme+":") {
+		raw, err := fetchTorrent(b.Torrent)
+		if err != nil {
+			return nil, err
+		}
+		return parseTorrent(raw)
+	}
+
+	hash, xs, ws, err := parseMagnet(b.Torrent)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := fetchTorrent(xs)
+	if err != nil {
+		return nil, err
+	}
+	mi, err := parseTorrent(raw)
+	if err != nil {
+		return nil, err
+	}
+	if mi.infoHash != hash {
+		return nil, fmt.Errorf("info-hash mismatch: magnet %x vs metainfo %x (from %s)", hash, mi.infoHash, xs)
+	}
+	for _, u := range ws {
+		if !cos.StringInSlice(u, mi.webseeds) {
+			mi.webseeds = append(mi.webseeds, u)
+		}
+	}
+	return mi, nil
+}
+
+func fetchTorrent(link string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), torrentTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: status %d", link, resp.StatusCode)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, torrentMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > torrentMaxSize {
+		return nil, fmt.Errorf("%s: metainfo is too large (> %d)", link, torrentMaxSize)
+	}
+	return raw, nil
+}
+
+// magnet:?xt=urn:btih:<40 hex | 32 base32>&xs=<metainfo URL>&ws=<web seed>...
+func parseMagnet(link string) (hash [sha1.Size]byte, xs string, ws []string, err error) {
+	query, ok := strings.CutPrefix(link, MagnetScheme+":?")
+	if !ok {
+		return hash, "", nil, fmt.Errorf("invalid magnet link %q", link)
+	}
+	q, err := url.ParseQuery(query)
+	if err != nil {
+		return hash, "", nil, fmt.Errorf("invalid magnet link %q: %v", link, err)
+	}
+	var (
+		xt, _ = strings.CutPrefix(q.Get("xt"), "urn:btih:")
+		b     []byte
+	)
+	switch len(xt) {
+	case 2 * sha1.Size:
+		b, err = hex.DecodeString(xt)
+	case 32:
+		b, err = base32.StdEncoding.DecodeString(strings.ToUpper(xt))
+	default:
+		err = errors.New("expecting 'xt=urn:btih:' with 40-hex or 32-base32 info-hash")
+	}
+	if err != nil {
+		return hash, "", nil, fmt.Errorf("invalid magnet link %q: %v", link, err)
+	}
+	copy(hash[:], b)
+
+	if xs = q.Get("xs"); xs == "" {
+		xs = q.Get("as")
+	}
+	if xs == "" {
+		return hash, "", nil, fmt.Errorf("magnet link %q: metainfo source ('xs' or 'as') is required (fetching metainfo from peers is not supported)", link)
+	}
+	return hash, xs, q["ws"], nil
+}
+
+/////////////////
+// torrentMeta //
+/////////////////
+
+func parseTorrent(raw []byte) (*torrentMeta, error) {
+	v, info, err := bdecode(raw)
+	if err != nil {
+		return nil, err
+	}
+	top, ok := v.(map[string]any)
+	if !ok || info == nil {
+		return nil, errors.New("invalid metainfo: missing 'info' dictionary")
+	}
+	dict, ok := top["info"].(map[string]any)
+	if !ok {
+		return nil, errors.New("invalid metainfo: 'info' is not a dictionary")
+	}
+
+	mi := &torrentMeta{infoHash: sha1.Sum(info)} //nolint:gosec // (see above)
+	if mi.name, ok = dict["name"].(string); !ok || !validTorrentPath(mi.name) {
+		return nil, fmt.Errorf("invalid metainfo: invalid name %q", dict["name"])
+	}
+	if mi.pieceLen, ok = dict["piece length"].(int64); !ok || mi.pieceLen <= 0 || mi.pieceLen > torrentMaxPieceL {
+		return nil, fmt.Errorf("invalid metainfo: invalid piece length %v", dict["piece length"])
+	}
+	if mi.pieces, ok = dict["pieces"].(string); !ok || len(mi.pieces)%sha1.Size != 0 {
+		return nil, errors.New("invalid metainfo: invalid 'pieces'")
+	}
+
+	if length, ok := dict["length"].(int64); ok {
+		if length < 0 {
+			return nil, fmt.Errorf("invalid metainfo: negative length %d", length)
+		}
+		mi.files = []*torrentFile{{size: length}}
+		mi.total = length
+	} else {
+		files, ok := dict["files"].([]any)
+		if !ok || len(files) == 0 {
+			return nil, errors.New("invalid metainfo: expecting either 'length' or 'files'")
+		}
+		mi.multi = true
+		for _, e := range files {
+			f, err := mi.parseFile(e)
+			if err != nil {
+				return nil, err
+			}
+			mi.files = append(mi.files, f)
+		}
+	}
+	if npieces := (mi.total + mi.pieceLen - 1) / mi.pieceLen; int64(len(mi.pieces)/sha1.Size) != npieces {
+		return nil, fmt.Errorf("invalid metainfo: expecting %d pieces, got %d", npieces, len(mi.pieces)/sha1.Size)
+	}
+
+	// web seeds: string or list
+	switch ul := top["url-list"].(type) {
+	case string:
+		mi.webseeds = append(mi.webseeds, ul)
+	case []any:
+		for _, u := range ul {
+			if s, ok := u.(string); ok && s != "" {
+				mi.webseeds = append(mi.webseeds, s)
+			}
+		}
+	}
+	return mi, nil
+}
+
+func (mi *torrentMeta) parseFile(e any) (*torrentFile, error) {
+	m, ok := e.(map[string]any)
+	if !ok {
+		return nil, errors.New("invalid metainfo: invalid 'files' entry")
+	}
+	size, ok := m["length"].(int64)
+	if !ok || size < 0 {
+		return nil, fmt.Errorf("invalid metainfo: invalid file length %v", m["length"])
+	}
+	l, ok := m["path"].([]any)
+	if !ok || len(l) == 0 {
+		return nil, errors.New("invalid metainfo: invalid file path")
+	}
+	f := &torrentFile{off: mi.total, size: size, path: make([]string, 0, len(l))}
+	for _, p := range l {
+		s, ok := p.(string)
+		if !ok || !validTorrentPath(s) {
+			return nil, fmt.Errorf("invalid metainfo: invalid file path %v", l)
+		}
+		f.path = append(f.path, s)
+	}
+	mi.total += size
+	return f, nil
+}
+
+func validTorrentPath(s string) bool {
+	return s != "" && s != "." && s != ".." && !strings.ContainsAny(s, "/\\\x00")
+}
+
+// object name (relative to destination subdirectory)
+func (mi *torrentMeta) objName(f *torrentFile) string {
+	if !mi.multi {
+		return mi.name
+	}
+	return path.Join(mi.name, path.Join(f.path...))
+}
+
+// BEP 19: multi-file: <url>/<name>/<path>; single-file: <url> or, if ends with '/', <url><name>
+func (mi *torrentMeta) fileURL(ws string, f *torrentFile) string {
+	if !mi.multi {
+		if strings.HasSuffix(ws, "/") {
+			return ws + url.PathEscape(mi.name)
+		}
+		return ws
+	}
+	var sb strings.Builder
+	sb.WriteString(strings.TrimSuffix(ws, "/"))
+	sb.WriteString("/")
+	sb.WriteString(url.PathEscape(mi.name))
+	for _, p := range f.path {
+		sb.WriteString("/")
+		sb.WriteString(url.PathEscape(p))
+	}
+	return sb.String()
+}
+
+func (mi *torrentMeta) pieceHash(i int) []byte {
+	return cos.UnsafeB(mi.pieces[i*sha1.Size : (i+1)*sha1.Size])
+}
+
+//////////////////
+// torrentDlJob //
+//////////////////
+
+func newTorrentDlJob(id string, bck *meta.Bck, payload *TorrentBody, xdl *Xact) (*torrentDlJob, error) {
+	mi, err := payload.meta()
+	if err != nil {
+		return nil, err
+	}
+	if len(mi.webseeds) == 0 {
+		return nil, fmt.Errorf("torrent %q has no web seeds (BEP 19 'url-list'); peer-to-peer transfer is not supported", mi.name)
+	}
+	tj := &torrentDlJob{mi: mi, files: make(map[string]*torrentFile, len(mi.files))}
+	tj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
+
+	objects := make(cos.StrKVs, len(mi.files))
+	for _, f := range mi.files {
+		name := path.Join(payload.Subdir, mi.objName(f))
+		if !payload.Filter.Match(name, f.size) {
+			continue
+		}
+		objects[name] = mi.fileURL(mi.webseeds[0], f)
+		tj.files[name] = f
+	}
+	if err := tj.sliceDlJob.init(bck, objects); err != nil {
+		return nil, err
+	}
+	tj.pieces.init(mi, tj.files, tj.objs)
+	// NOTE: diff-resolver expects sorted input
+	sort.Slice(tj.objs, func(i, j int) bool { return tj.objs[i].objName < tj.objs[j].objName })
+	return tj, nil
+}
+
+func (j *torrentDlJob) String() string {
+	return fmt.Sprintf("torrent-%s-%s[%x]", j.baseDlJob.String(), j.mi.name, j.mi.infoHash)
+}
+
+func (j *torrentDlJob) cleanup() {
+	j.pieces.free()
+	j.baseDlJob.cleanup()
+}
+
+func (j *torrentDlJob) download(task *singleTask, lom *core.LOM) error {
+	f, ok := j.files[task.obj.objName]
+	if !ok {
+		return fmt.Errorf("%s: %q not found", j, task.obj.objName)
+	}
+	if f.size > 0 && !j.pieces.claim(task.obj.objName) {
+		return fmt.Errorf("%s: %q is being (or has been) downloaded", j, task.obj.objName)
+	}
+	task.getCtx = task.downloadCtx
+	task.setTotalSize(f.size)
+
+	pr := newPieceReader(task.downloadCtx, j, f, task.initialTimeout())
+	defer pr.free()
+	lom.SetCustomKey(cmn.SourceObjMD, cmn.WebObjMD)
+
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = "dl"
+		params.Reader = task.wrapReader(io.NopCloser(pr))
+		params.OWT = cmn.OwtPut
+		params.Atime = task.started.Load()
+		params.Size = f.size
+		params.Xact = task.xdl
+	}
+	erp := core.T.PutObject(lom, params)
+	core.FreePutParams(params)
+	if erp != nil {
+		return erp
+	}
+	return lom.Load(true /*cache it*/, false /*locked*/)
+}
+
+// (job-agnostic; see dispatcher and singleTask)
+func releaseObj(job jobif, objName string) {
+	if tj, ok := job.(*torrentDlJob); ok {
+		tj.release(objName)
+	}
+}
+
+// object that won't be downloaded (skipped, or failed prior to reading): release its pieces
+func (j *torrentDlJob) release(objName string) {
+	f, ok := j.files[objName]
+	if !ok || f.size == 0 || !j.pieces.claim(objName) {
+		return
+	}
+	for i := int(f.off / j.mi.pieceLen); i <= int((f.off+f.size-1)/j.mi.pieceLen); i++ {
+		j.pieces.done(i)
+	}
+}
+
+// read and verify piece `i` from any web seed (round-robin, up to torrentRetries rounds)
+func (j *torrentDlJob) fetchPiece(ctx context.Context, i int, sgl *memsys.SGL, timeout time.Duration) (err error) {
+	var (
+		mi   = j.mi
+		ps   = int64(i) * mi.pieceLen
+		pe   = min(ps+mi.pieceLen, mi.total)
+		hash = sha1.New() //nolint:gosec // (see above)
+	)
+	for attempt := range torrentRetries * len(mi.webseeds) {
+		ws := mi.webseeds[attempt%len(mi.webseeds)]
+		sgl.Reset()
+		hash.Reset()
+		if err = j.readPiece(ctx, ws, io.MultiWriter(sgl, hash), ps, pe, timeout); err == nil {
+			if bytes.Equal(hash.Sum(nil), mi.pieceHash(i)) {
+				return nil
+			}
+			err = fmt.Errorf("%w: piece %d of %q", errPieceHash, i, mi.name)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// read [ps, pe) of the torrent's content from a given web seed
+func (j *torrentDlJob) readPiece(ctx context.Context, ws string, w io.Writer, ps, pe int64, timeout time.Duration) error {
+	for _, f := range j.mi.files {
+		if f.size == 0 || f.off+f.size <= ps || f.off >= pe {
+			continue
+		}
+		var (
+			lo = max(ps, f.off)
+			hi = min(pe, f.off+f.size)
+		)
+		if err := j.readRange(ctx, j.mi.fileURL(ws, f), w, lo-f.off, hi-lo, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *torrentDlJob) readRange(ctx context.Context, link string, w io.Writer, off, length int64, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return err
+	}
+	for k, v := range j.header() {
+		req.Header[k] = v
+	}
+	req.Header.Set(cos.HdrRange, cmn.MakeRangeHdr(off, length))
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return err
+	}
+	defer cos.Close(resp.Body)
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK: // web seed ignored the range
+		if _, err := io.CopyN(io.Discard, resp.Body, off); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("failed to read %s [%d, %d): status %d", link, off, off+length, resp.StatusCode)
+	}
+	_, err = io.CopyN(w, resp.Body, length)
+	return err
+}
+
+/////////////
+// tpieces //
+/////////////
+
+// count this target's files per piece; only pieces read by more than one file are shared
+func (tp *tpieces) init(mi *torrentMeta, files map[string]*torrentFile, objs []dlObj) {
+	refs := make(map[int]int, 4)
+	tp.files = make(map[string]struct{}, len(objs))
+	for _, obj := range objs {
+		f, ok := files[obj.objName]
+		if !ok || f.size == 0 {
+			continue
+		}
+		tp.files[obj.objName] = struct{}{}
+		for i := int(f.off / mi.pieceLen); i <= int((f.off+f.size-1)/mi.pieceLen); i++ {
+			refs[i]++
+		}
+	}
+	for i, n := range refs {
+		if n < 2 {
+			delete(refs, i)
+		}
+	}
+	tp.refs = refs
+	tp.m = make(map[int]*tpiece, min(len(refs), 64))
+}
+
+// a given file either gets downloaded or released - exactly once
+func (tp *tpieces) claim(objName string) bool {
+	tp.mu.Lock()
+	_, ok := tp.files[objName]
+	delete(tp.files, objName)
+	tp.mu.Unlock()
+	return ok
+}
+
+// returns (nil, false) if piece `i` is not shared; otherwise, the shared piece
+// and whether the caller must load it (see loaded); the caller must put() it back
+func (tp *tpieces) acquire(i int, size int64) (p *tpiece, loader bool) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	if p = tp.m[i]; p != nil {
+		p.readers++
+		return p, false
+	}
+	if tp.refs[i] < 2 {
+		return nil, false
+	}
+	p = &tpiece{sgl: memsys.PageMM().NewSGL(size), ready: make(chan struct{}), readers: 1}
+	tp.m[i] = p
+	return p, true
+}
+
+func (tp *tpieces) loaded(i int, p *tpiece, err error) {
+	tp.mu.Lock()
+	p.err = err
+	if err != nil {
+		delete(tp.m, i) // waiters will retry
+	}
+	tp.mu.Unlock()
+	close(p.ready)
+}
+
+// done reading shared piece `i`
+func (tp *tpieces) put(i int, p *tpiece) {
+	tp.mu.Lock()
+	p.readers--
+	if p.readers == 0 && (tp.m[i] != p || tp.refs[i] <= 0) {
+		tp._free(i, p)
+	}
+	tp.mu.Unlock()
+}
+
+// a given file no longer needs piece `i` (read it or failed)
+func (tp *tpieces) done(i int) {
+	tp.mu.Lock()
+	if n, ok := tp.refs[i]; ok {
+		if n > 1 {
+			tp.refs[i] = n - 1
+		} else {
+			delete(tp.refs, i)
+			if p := tp.m[i]; p != nil && p.readers == 0 {
+				tp._free(i, p)
+			}
+		}
+	}
+	tp.mu.Unlock()
+}
+
+func (tp *tpieces) _free(i int, p *tpiece) {
+	if tp.m[i] == p {
+		delete(tp.m, i)
+	}
+	if p.sgl != nil {
+		p.sgl.Free()
+		p.sgl = nil
+	}
+}
+
+// job cleanup (files that were never downloaded - e.g., skipped or aborted - may leave pieces behind)
+func (tp *tpieces) free() {
+	tp.mu.Lock()
+	for i, p := range tp.m {
+		debug.Assert(p.readers == 0, i, p.readers)
+		tp._free(i, p)
+	}
+	clear(tp.refs)
+	clear(tp.files)
+	tp.mu.Unlock()
+}
+
+/////////////////
+// pieceReader //
+/////////////////
+
+func newPieceReader(ctx context.Context, j *torrentDlJob, f *torrentFile, timeout time.Duration) *pieceReader {
+	pr := &pieceReader{ctx: ctx, j: j, f: f, timeout: timeout}
+	if f.size > 0 {
+		pr.next, pr.last = int(f.off/j.mi.pieceLen), int((f.off+f.size-1)/j.mi.pieceLen)
+	} else {
+		pr.next = 1 // nothing to read
+	}
+	return pr
+}
+
+func (pr *pieceReader) Read(p []byte) (int, error) {
+	for {
+		if pr.cur != nil {
+			n, err := pr.cur.Read(p)
+			if err != io.EOF {
+				return n, err
+			}
+			pr.cur = nil
+			pr.putShared(true /*done*/)
+			if n > 0 {
+				return n, nil
+			}
+		}
+		if pr.next > pr.last {
+			return 0, io.EOF
+		}
+		if err := pr.load(pr.next); err != nil {
+			return 0, err
+		}
+		pr.next++
+	}
+}
+
+func (pr *pieceReader) load(i int) error {
+	var (
+		mi  = pr.j.mi
+		ps  = int64(i) * mi.pieceLen
+		pe  = min(ps+mi.pieceLen, mi.total)
+		sgl *memsys.SGL
+	)
+	for sgl == nil {
+		p, loader := pr.j.pieces.acquire(i, pe-ps)
+		switch {
+		case p == nil: // not shared
+			if pr.sgl == nil {
+				pr.sgl = memsys.PageMM().NewSGL(pe - ps)
+			}
+			if err := pr.j.fetchPiece(pr.ctx, i, pr.sgl, pr.timeout); err != nil {
+				return err
+			}
+			sgl = pr.sgl
+		case loader:
+			err := pr.j.fetchPiece(pr.ctx, i, p.sgl, pr.timeout)
+			pr.j.pieces.loaded(i, p, err)
+			if err != nil {
+				pr.j.pieces.put(i, p)
+				return err
+			}
+			sgl, pr.sp, pr.spi = p.sgl, p, i
+		default:
+			select {
+			case <-p.ready:
+			case <-pr.ctx.Done():
+				pr.j.pieces.put(i, p)
+				return pr.ctx.Err()
+			}
+			if p.err != nil {
+				pr.j.pieces.put(i, p) // and retry
+				continue
+			}
+			sgl, pr.sp, pr.spi = p.sgl, p, i
+		}
+	}
+	r := memsys.NewReader(sgl)
+	lo, hi := max(ps, pr.f.off)-ps, min(pe, pr.f.off+pr.f.size)-ps
+	if _, err := r.Seek(lo, io.SeekStart); err != nil {
+		pr.putShared(false)
+		return err
+	}
+	pr.cur = io.LimitReader(r, hi-lo)
+	return nil
+}
+
+func (pr *pieceReader) putShared(done bool) {
+	if pr.sp == nil {
+		return
+	}
+	pr.j.pieces.put(pr.spi, pr.sp)
+	if done {
+		pr.j.pieces.done(pr.spi)
+	}
+	pr.sp = nil
+}
+
+// upon download (successful or not): release all the pieces that this file won't need anymore
+func (pr *pieceReader) free() {
+	if pr.sp != nil {
+		pr.putShared(false)
+		pr.j.pieces.done(pr.spi)
+		pr.next = max(pr.next, pr.spi+1)
+	}
+	for i := pr.next; i <= pr.last; i++ {
+		pr.j.pieces.done(i)
+	}
+	pr.next = pr.last + 1
+	if pr.sgl != nil {
+		pr.sgl.Free()
+		pr.sgl = nil
+	}
+}
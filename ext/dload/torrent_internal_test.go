@@ -0,0 +1,336 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // (see torrent.go)
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// minimal bencode encoder (test only)
+func bencode(v any) string {
+	switch v := v.(type) {
+	case int:
+		return fmt.Sprintf("i%de", v)
+	case string:
+		return fmt.Sprintf("%d:%s", len(v), v)
+	case []any:
+		var sb strings.Builder
+		sb.WriteByte('l')
+		for _, e := range v {
+			sb.WriteString(bencode(e))
+		}
+		sb.WriteByte('e')
+		return sb.String()
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var sb strings.Builder
+		sb.WriteByte('d')
+		for _, k := range keys {
+			sb.WriteString(bencode(k))
+			sb.WriteString(bencode(v[k]))
+		}
+		sb.WriteByte('e')
+		return sb.String()
+	}
+	panic(v)
+}
+
+func pieceHashes(content []byte, pieceLen int) string {
+	var sb strings.Builder
+	for off := 0; off < len(content); off += pieceLen {
+		sum := sha1.Sum(content[off:min(off+pieceLen, len(content))]) //nolint:gosec // (see torrent.go)
+		sb.Write(sum[:])
+	}
+	return sb.String()
+}
+
+func TestBdecode(t *testing.T) {
+	v, info, err := bdecode([]byte("d3:agei42e4:infod1:ai-1ee4:listl1:x1:yee"))
+	tassert.CheckFatal(t, err)
+	m := v.(map[string]any)
+	tassert.Errorf(t, m["age"] == int64(42), "expected 42, got %v", m["age"])
+	tassert.Errorf(t, string(info) == "d1:ai-1ee", "wrong raw info %q", info)
+	l := m["list"].([]any)
+	tassert.Errorf(t, len(l) == 2 && l[0] == "x" && l[1] == "y", "wrong list %v", l)
+
+	for _, s := range []string{"", "i42", "5:abc", "d3:key", "l", "x", "i4xe", "d1:ai1ee1:z", "di1ei2ee", strings.Repeat("l", 100)} {
+		_, _, err := bdecode([]byte(s))
+		tassert.Errorf(t, errors.Is(err, errBencode), "%q: expected bencoding error, got %v", s, err)
+	}
+}
+
+func TestParseTorrent(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 10) // 100 bytes
+	info := map[string]any{
+		"name":         "data",
+		"piece length": 32,
+		"pieces":       pieceHashes(content, 32),
+		"files": []any{
+			map[string]any{"length": 30, "path": []any{"a.bin"}},
+			map[string]any{"length": 0, "path": []any{"empty"}},
+			map[string]any{"length": 70, "path": []any{"sub", "b.bin"}},
+		},
+	}
+	raw := bencode(map[string]any{"info": info, "url-list": []any{"http://one/", "http://two"}})
+	mi, err := parseTorrent([]byte(raw))
+	tassert.CheckFatal(t, err)
+
+	expected := sha1.Sum([]byte(bencode(info))) //nolint:gosec // (see torrent.go)
+	tassert.Errorf(t, mi.infoHash == expected, "info-hash mismatch: %x vs %x", mi.infoHash, expected)
+	tassert.Errorf(t, mi.total == 100 && len(mi.files) == 3 && mi.multi, "wrong files: total %d, %d files", mi.total, len(mi.files))
+	tassert.Errorf(t, mi.files[2].off == 30, "expected offset 30, got %d", mi.files[2].off)
+	tassert.Errorf(t, mi.objName(mi.files[2]) == "data/sub/b.bin", "wrong object name %q", mi.objName(mi.files[2]))
+	tassert.Errorf(t, mi.fileURL(mi.webseeds[1], mi.files[2]) == "http://two/data/sub/b.bin",
+		"wrong URL %q", mi.fileURL(mi.webseeds[1], mi.files[2]))
+
+	// single file
+	single := map[string]any{"name": "f.bin", "piece length": 64, "pieces": pieceHashes(content, 64), "length": 100}
+	mi, err = parseTorrent([]byte(bencode(map[string]any{"info": single, "url-list": "http://host/dir/"})))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !mi.multi && mi.objName(mi.files[0]) == "f.bin", "wrong single-file metainfo")
+	tassert.Errorf(t, mi.fileURL("http://host/dir/", mi.files[0]) == "http://host/dir/f.bin", "wrong URL")
+	tassert.Errorf(t, mi.fileURL("http://host/x.bin", mi.files[0]) == "http://host/x.bin", "wrong URL")
+
+	// invalid
+	for name, info := range map[string]map[string]any{
+		"traversal":  {"name": "..", "piece length": 64, "pieces": pieceHashes(content, 64), "length": 100},
+		"pieces":     {"name": "f", "piece length": 32, "pieces": pieceHashes(content, 64), "length": 100},
+		"no-length":  {"name": "f", "piece length": 64, "pieces": pieceHashes(content, 64)},
+		"path-slash": {"name": "f", "piece length": 64, "pieces": pieceHashes(content, 64), "files": []any{map[string]any{"length": 100, "path": []any{"a/b"}}}},
+	} {
+		_, err := parseTorrent([]byte(bencode(map[string]any{"info": info})))
+		tassert.Errorf(t, err != nil, "%s: expected error", name)
+	}
+}
+
+func TestParseMagnet(t *testing.T) {
+	const hexHash = "c12fe1c06bba254a9dc9f519b335aa7c1367a88a"
+	hash, xs, ws, err := parseMagnet("magnet:?xt=urn:btih:" + hexHash + "&dn=x&xs=http%3A%2F%2Fhost%2Fx.torrent&ws=http%3A%2F%2Fseed%2F")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, hex.EncodeToString(hash[:]) == hexHash, "wrong hash %x", hash)
+	tassert.Errorf(t, xs == "http://host/x.torrent", "wrong xs %q", xs)
+	tassert.Errorf(t, len(ws) == 1 && ws[0] == "http://seed/", "wrong ws %v", ws)
+
+	// base32
+	hash2, _, _, err := parseMagnet("magnet:?xt=urn:btih:YEX6DQDLXISUVHOJ6UM3GNNKPQJWPKEK&xs=http://host/x.torrent")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, hash2 == hash, "base32 vs hex: %x vs %x", hash2, hash)
+
+	for _, link := range []string{
+		"magnet:?xt=urn:btih:" + hexHash, // no metainfo source
+		"magnet:?xt=urn:btih:abc&xs=http://host/x.torrent",
+		"http://host/x.torrent",
+	} {
+		_, _, _, err := parseMagnet(link)
+		tassert.Errorf(t, err != nil, "%q: expected error", link)
+	}
+}
+
+func TestPieceReader(t *testing.T) {
+	content := make([]byte, 1000)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+	files := map[string][]byte{
+		"/data/a.bin": content[:300],
+		"/data/b.bin": content[300:450],
+		"/data/c.bin": content[450:],
+	}
+	var corrupt bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, ok := files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if corrupt {
+			b = bytes.Repeat([]byte{0}, len(b))
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(b))
+	}))
+	defer srv.Close()
+	saved := g.clientH
+	g.clientH = srv.Client()
+	defer func() { g.clientH = saved }()
+
+	info := map[string]any{
+		"name":         "data",
+		"piece length": 128,
+		"pieces":       pieceHashes(content, 128),
+		"files": []any{
+			map[string]any{"length": 300, "path": []any{"a.bin"}},
+			map[string]any{"length": 150, "path": []any{"b.bin"}},
+			map[string]any{"length": 550, "path": []any{"c.bin"}},
+		},
+	}
+	// first web seed is broken, the second one works
+	mi, err := parseTorrent([]byte(bencode(map[string]any{"info": info, "url-list": []any{srv.URL + "/none", srv.URL}})))
+	tassert.CheckFatal(t, err)
+	j := &torrentDlJob{mi: mi}
+
+	read := func(f *torrentFile) ([]byte, error) {
+		pr := newPieceReader(context.Background(), j, f, time.Minute)
+		defer pr.free()
+		return io.ReadAll(pr)
+	}
+	for _, f := range mi.files {
+		b, err := read(f)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(b, content[f.off:f.off+f.size]), "%v: content mismatch", f.path)
+	}
+
+	corrupt = true
+	_, err = read(mi.files[1])
+	tassert.Errorf(t, errors.Is(err, errPieceHash), "expected piece hash mismatch, got %v", err)
+}
+
+// small files sharing a piece: each piece is fetched (and verified) once
+func TestPieceReaderShared(t *testing.T) {
+	content := make([]byte, 256)
+	for i := range content {
+		content[i] = byte(i * 13)
+	}
+	var (
+		files  = make(map[string][]byte, 8)
+		finfo  = make([]any, 0, 8)
+		nreqs  = make(map[string]int, 8)
+		fsize  = 32
+		mu     sync.Mutex
+		objs   []dlObj
+		tfiles = make(map[string]*torrentFile, 8)
+	)
+	for i := range len(content) / fsize {
+		name := fmt.Sprintf("f%d", i)
+		files["/data/"+name] = content[i*fsize : (i+1)*fsize]
+		finfo = append(finfo, map[string]any{"length": fsize, "path": []any{name}})
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		nreqs[r.URL.Path]++
+		mu.Unlock()
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(files[r.URL.Path]))
+	}))
+	defer srv.Close()
+	saved := g.clientH
+	g.clientH = srv.Client()
+	defer func() { g.clientH = saved }()
+
+	info := map[string]any{"name": "data", "piece length": 128, "pieces": pieceHashes(content, 128), "files": finfo}
+	mi, err := parseTorrent([]byte(bencode(map[string]any{"info": info, "url-list": []any{srv.URL}})))
+	tassert.CheckFatal(t, err)
+	for _, f := range mi.files {
+		name := mi.objName(f)
+		tfiles[name] = f
+		objs = append(objs, dlObj{objName: name})
+	}
+	j := &torrentDlJob{mi: mi, files: tfiles}
+	j.pieces.init(mi, tfiles, objs)
+	defer j.pieces.free()
+
+	var wg sync.WaitGroup
+	for _, f := range mi.files {
+		wg.Add(1)
+		go func(f *torrentFile) {
+			defer wg.Done()
+			pr := newPieceReader(context.Background(), j, f, time.Minute)
+			b, err := io.ReadAll(pr)
+			pr.free()
+			tassert.CheckError(t, err)
+			tassert.Errorf(t, bytes.Equal(b, content[f.off:f.off+f.size]), "%v: content mismatch", f.path)
+		}(f)
+	}
+	wg.Wait()
+
+	for name, n := range nreqs {
+		tassert.Errorf(t, n == 1, "%s: expected a single request, got %d", name, n)
+	}
+	tassert.Errorf(t, len(nreqs) == len(files), "expected %d files requested, got %d", len(files), len(nreqs))
+	j.pieces.mu.Lock()
+	tassert.Errorf(t, len(j.pieces.m) == 0 && len(j.pieces.refs) == 0, "expected all pieces released, got %d (%d)",
+		len(j.pieces.m), len(j.pieces.refs))
+	j.pieces.mu.Unlock()
+}
+
+// files that get skipped (or fail prior to reading) release the pieces they share
+func TestPiecesRelease(t *testing.T) {
+	content := make([]byte, 256)
+	for i := range content {
+		content[i] = byte(i * 11)
+	}
+	var (
+		files  = make(map[string][]byte, 8)
+		finfo  = make([]any, 0, 8)
+		objs   []dlObj
+		tfiles = make(map[string]*torrentFile, 8)
+		fsize  = 32
+	)
+	for i := range len(content) / fsize {
+		name := fmt.Sprintf("f%d", i)
+		files["/data/"+name] = content[i*fsize : (i+1)*fsize]
+		finfo = append(finfo, map[string]any{"length": fsize, "path": []any{name}})
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(files[r.URL.Path]))
+	}))
+	defer srv.Close()
+	saved := g.clientH
+	g.clientH = srv.Client()
+	defer func() { g.clientH = saved }()
+
+	info := map[string]any{"name": "data", "piece length": 128, "pieces": pieceHashes(content, 128), "files": finfo}
+	mi, err := parseTorrent([]byte(bencode(map[string]any{"info": info, "url-list": []any{srv.URL}})))
+	tassert.CheckFatal(t, err)
+	for _, f := range mi.files {
+		name := mi.objName(f)
+		tfiles[name] = f
+		objs = append(objs, dlObj{objName: name})
+	}
+	j := &torrentDlJob{mi: mi, files: tfiles}
+	j.pieces.init(mi, tfiles, objs)
+	defer j.pieces.free()
+
+	// download the first file of each piece; skip all the others
+	for i, f := range mi.files {
+		name := mi.objName(f)
+		if i%4 != 0 {
+			releaseObj(j, name)
+			releaseObj(j, name) // (idempotent)
+			continue
+		}
+		tassert.Fatalf(t, j.pieces.claim(name), "%s: expected to claim", name)
+		pr := newPieceReader(context.Background(), j, f, time.Minute)
+		b, err := io.ReadAll(pr)
+		pr.free()
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(b, content[f.off:f.off+f.size]), "%v: content mismatch", f.path)
+
+		// released (or downloaded) files cannot be claimed again
+		tassert.Errorf(t, !j.pieces.claim(name), "%s: expected already claimed", name)
+	}
+
+	j.pieces.mu.Lock()
+	tassert.Errorf(t, len(j.pieces.m) == 0 && len(j.pieces.refs) == 0 && len(j.pieces.files) == 0,
+		"expected all pieces released, got %d (%d, %d)", len(j.pieces.m), len(j.pieces.refs), len(j.pieces.files))
+	j.pieces.mu.Unlock()
+}
@@ -35,7 +35,7 @@ func clientForURL(u string) *http.Client {
 }
 
 //nolint:gocritic // need a copy of cos.ParsedTemplate
-func countObjects(pt cos.ParsedTemplate, dir string, bck *meta.Bck) (cnt int, err error) {
+func countObjects(pt cos.ParsedTemplate, dir string, naming *NamingRules, flt *Filter, bck *meta.Bck) (cnt int, err error) {
 	var (
 		smap = core.T.Sowner().Get()
 		sid  = core.T.SID()
@@ -43,7 +43,14 @@ func countObjects(pt cos.ParsedTemplate, dir string, bck *meta.Bck) (cnt int, er
 	)
 	pt.InitIter()
 	for link, ok := pt.Next(); ok; link, ok = pt.Next() {
-		name := path.Join(dir, path.Base(link))
+		var objName string
+		if objName, err = objNameFromLink(naming, link); err != nil {
+			return
+		}
+		name := path.Join(dir, objName)
+		if !flt.Match(name, -1) {
+			continue
+		}
 		name, err = NormalizeObjName(name)
 		if err != nil {
 			return
@@ -118,6 +125,21 @@ func NormalizeObjName(objName string) (string, error) {
 }
 
 func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, error) {
+	job, err := parseStartRequest(bck, id, dlb, xdl)
+	if err != nil {
+		return nil, err
+	}
+	// keep the original (sanitized) job spec, to describe and possibly re-submit the job later
+	spec, err := dlb.Sanitize()
+	if err != nil {
+		nlog.Errorln(job.String(), "failed to sanitize job spec:", err)
+	} else {
+		job.setSpec(spec)
+	}
+	return job, nil
+}
+
+func parseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, error) {
 	switch dlb.Type {
 	case TypeBackend:
 		dp := &BackendBody{}
@@ -159,8 +181,28 @@ func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, er
 			return nil, err
 		}
 		return newSingleDlJob(id, bck, dp, xdl)
+	case TypeHF:
+		dp := &HFBody{}
+		err := jsoniter.Unmarshal(dlb.RawMessage, dp)
+		if err != nil {
+			return nil, err
+		}
+		if err := dp.Validate(); err != nil {
+			return nil, err
+		}
+		return newHFDlJob(id, bck, dp, xdl)
+	case TypeTorrent:
+		dp := &TorrentBody{}
+		err := jsoniter.Unmarshal(dlb.RawMessage, dp)
+		if err != nil {
+			return nil, err
+		}
+		if err := dp.Validate(); err != nil {
+			return nil, err
+		}
+		return newTorrentDlJob(id, bck, dp, xdl)
 	default:
-		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend)")
+		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend, hf, torrent)")
 	}
 }
 
@@ -230,13 +272,16 @@ func parseGoogleCksumHeader(hdr []string) cos.StrKVs {
 	return cksums
 }
 
-func headLink(link string) (resp *http.Response, err error) {
+func headLink(link string, hdr http.Header) (resp *http.Response, err error) {
 	var (
 		req         *http.Request
 		ctx, cancel = context.WithTimeout(context.Background(), headReqTimeout)
 	)
 	req, err = http.NewRequestWithContext(ctx, http.MethodHead, link, http.NoBody)
 	if err == nil {
+		for k, v := range hdr {
+			req.Header[k] = v
+		}
 		resp, err = clientForURL(link).Do(req)
 	}
 	cancel()
@@ -246,13 +291,18 @@ func headLink(link string) (resp *http.Response, err error) {
 // Use all available metadata including {size, version, ETag, MD5, CRC}
 // to compare local object with its remote counterpart (source).
 func CompareObjects(lom *core.LOM, dst *DstElement) (bool /*equal*/, error) {
+	// trust content that was verified upon download (see hfVerifier)
+	if dst.Oid != "" {
+		oid, ok := lom.GetCustomKey(hfOidMD)
+		return ok && oid == dst.Oid, nil
+	}
 	if dst.Link == "" {
 		res := lom.CheckRemoteMD(true /*rlocked*/, false /*sync*/, nil /*origReq*/) // TODO: use job.Sync()
 		return res.Eq, res.Err
 		// TODO: make use of res.ObjAttrs
 	}
 
-	resp, err := headLink(dst.Link) //nolint:bodyclose // cos.Close
+	resp, err := headLink(dst.Link, dst.Header) //nolint:bodyclose // cos.Close
 	if err != nil {
 		return false, err
 	}
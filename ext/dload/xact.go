@@ -37,7 +37,10 @@ import (
 //   * Download    - to download a new object from a URL
 //   * Abort       - to abort a previously requested download (currently queued or currently downloading)
 //   * Status      - to request the status of a previously requested download
-// The Download, Abort and Status requests are encapsulated into an internal
+//   * Boost       - to lift throttling limits (connections, bandwidth) of a running download
+//   * Pause       - to stop dispatching new tasks of a running download (until Resume)
+//   * Resume      - to resume dispatching tasks of a paused download
+// The Download, Abort, Boost, Pause, Resume, and Status requests are encapsulated into an internal
 // request object, added to a dispatcher's request queue and then are dispatched by dispatcher
 // to the correct jogger. The remaining operations are private to the Downloader and
 // are used only internally. Dispatcher is implemented as goroutine listening for
@@ -109,6 +112,9 @@ const (
 	actRemove = "REMOVE"
 	actAbort  = "ABORT"
 	actStatus = "STATUS"
+	actBoost  = "BOOST"
+	actPause  = "PAUSE"
+	actResume = "RESUME"
 	actList   = "LIST"
 )
 
@@ -142,7 +148,9 @@ type (
 		id         string         // id of the job task
 		regex      *regexp.Regexp // regex of descriptions to return if id is empty
 		response   *response      // where the outcome of the request is written
+		status     string         // list jobs with a given status (see AdminBody.Status)
 		onlyActive bool           // request status of only active tasks
+		progress   bool           // consolidated progress only (no per-task details)
 	}
 
 	progressReader struct {
@@ -246,6 +254,33 @@ func (xld *Xact) RemoveJob(id string) (resp any, statusCode int, err error) {
 	return
 }
 
+// lift throttling limits of a running job (see throttler.boost)
+func (xld *Xact) BoostJob(id string) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actBoost, id: id}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
+// stop dispatching new tasks of a running job while keeping its state and progress
+// (tasks that are already queued or downloading run to completion)
+func (xld *Xact) PauseJob(id string) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actPause, id: id}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
+func (xld *Xact) ResumeJob(id string) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actResume, id: id}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
 func (xld *Xact) JobStatus(id string, onlyActive bool) (resp any, statusCode int, err error) {
 	xld.IncPending()
 	req := &request{action: actStatus, id: id, onlyActive: onlyActive}
@@ -254,6 +289,15 @@ func (xld *Xact) JobStatus(id string, onlyActive bool) (resp any, statusCode int
 	return
 }
 
+// consolidated progress: counters and byte counts (see StatusResp)
+func (xld *Xact) JobProgress(id string) (resp any, statusCode int, err error) {
+	xld.IncPending()
+	req := &request{action: actStatus, id: id, progress: true}
+	resp, statusCode, err = xld.dispatcher.adminReq(req)
+	xld.DecPending()
+	return
+}
+
 func (xld *Xact) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	xld.ToSnap(snap)
@@ -23,6 +23,17 @@ const (
 
 var algorithms = []string{algDefault, Alphanumeric, MD5, Shuffle, Content, None}
 
+// record-level deduplication: what to do with records that have identical sorting keys
+// (applies after sorting; among duplicates, "first" and "last" are determined by record name)
+const (
+	DedupNone      = ""           // default (no deduplication)
+	DedupKeepFirst = "keep-first" // keep one record (the one with the smallest name) and drop the rest
+	DedupKeepLast  = "keep-last"  // keep one record (the one with the largest name) and drop the rest
+	DedupDropAll   = "drop-all"   // drop all records that have duplicates
+)
+
+var dedupPolicies = []string{DedupNone, DedupKeepFirst, DedupKeepLast, DedupDropAll}
+
 type Algorithm struct {
 	// one of the `algorithms` above
 	Kind string `json:"kind"`
@@ -41,6 +52,9 @@ type Algorithm struct {
 	// ditto: Content only
 	// `shard.contentKeyTypes` enum values: {"int", "string", "float" }
 	ContentKeyType string `json:"content_key_type"`
+
+	// one of the `dedupPolicies` above; not supported with Shuffle and None
+	Dedup string `json:"dedup,omitempty"`
 }
 
 // RequestSpec defines the user specification for requests to the endpoint /v1/sort.
@@ -74,6 +88,11 @@ type RequestSpec struct {
 	// Default: calcMaxLimit()
 	CreateConcMaxLimit int `json:"create_concurrency_max_limit" yaml:"create_concurrency_max_limit"`
 
+	// Extract metadata only (no content), sort, and report the predicted output shards
+	// and per-target requirements - see Metrics.Estimate - without creating any shards.
+	// Default: false
+	Estimate bool `json:"estimate" yaml:"estimate"`
+
 	// debug
 	DsorterType string `json:"dsorter_type"`
 	DryRun      bool   `json:"dry_run"` // Default: false
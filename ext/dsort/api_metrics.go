@@ -82,6 +82,8 @@ type (
 		SentStats *TimeStats `json:"sent_stats,omitempty"`
 		// RecvStats - time statistics about records receivied from another target
 		RecvStats *TimeStats `json:"recv_stats,omitempty"`
+		// DedupCnt - number of records dropped as duplicates (see Algorithm.Dedup)
+		DedupCnt int64 `json:"dedup_count,string,omitempty"`
 	}
 
 	// ShardCreation contains metrics for third and last phase of Dsort.
@@ -104,6 +106,31 @@ type (
 	}
 )
 
+// estimate-only run: predicted output and per-target requirements
+type (
+	// Estimate is computed by the target that ends up with all the sorted records
+	// (from record metadata only - no content gets extracted and no shards get created)
+	Estimate struct {
+		Targets       map[string]*TargetEstimate `json:"targets"`              // by target ID
+		KeySample     []string                   `json:"key_sample,omitempty"` // evenly spaced sample of sorted keys
+		RecordCnt     int64                      `json:"record_count,string"`
+		RecordSize    int64                      `json:"record_size,string"`     // total (uncompressed) size of all records
+		RecordMemSize int64                      `json:"record_mem_size,string"` // memory to sort all records' metadata (at a single target)
+		ShardCnt      int64                      `json:"shard_count,string"`
+		MinShardSize  int64                      `json:"min_shard_size,string"`
+		MaxShardSize  int64                      `json:"max_shard_size,string"`
+		AvgShardSize  int64                      `json:"avg_shard_size,string"`
+	}
+	TargetEstimate struct {
+		// extraction: records to keep in memory or, when exceeding max_mem_usage, spill to disk
+		ExtractedCnt  int64 `json:"extracted_count,string"`
+		ExtractedSize int64 `json:"extracted_size,string"`
+		// creation: output shards to be stored by this target
+		ShardCnt  int64 `json:"shard_count,string"`
+		ShardSize int64 `json:"shard_size,string"`
+	}
+)
+
 // main stats-and-status types
 type (
 	// Metrics is general struct which contains all stats about Dsort run.
@@ -112,6 +139,9 @@ type (
 		Sorting    *MetaSorting     `json:"meta_sorting,omitempty"`
 		Creation   *ShardCreation   `json:"shard_creation,omitempty"`
 
+		// estimate-only run (see RequestSpec.Estimate); reported by a single target
+		Estimate *Estimate `json:"estimate,omitempty"`
+
 		// job description
 		Description string `json:"description,omitempty"`
 
@@ -118,12 +118,23 @@ func (m *Manager) start() (err error) {
 		shardSize := int64(float64(m.Pars.OutputShardSize) / ratio)
 		nlog.Infof("%s: [dsort] %s started phase 3: ratio=%f, shard size (%d, %d)",
 			core.T, m.ManagerUUID, ratio, shardSize, m.Pars.OutputShardSize)
-		if err := m.phase3(shardSize); err != nil {
+		if m.Pars.Estimate {
+			if err := m.estimate(shardSize, ratio); err != nil {
+				return err
+			}
+		} else if err := m.phase3(shardSize); err != nil {
 			nlog.Errorf("%s: [dsort] %s phase3 err: %v", core.T, m.ManagerUUID, err)
 			return err
 		}
 	}
 
+	// estimate only: no shards to create
+	if m.Pars.Estimate {
+		m.Metrics.Creation.begin()
+		m.Metrics.Creation.finish()
+		return nil
+	}
+
 	// Wait for signal to start shard creations. This will happen when manager
 	// notice that the specification for shards to be created locally was received.
 	select {
@@ -195,7 +206,7 @@ func (m *Manager) extractLocalShards() (err error) {
 	m.dsorter.postExtraction()
 	m.Metrics.Extraction.finish()
 	m.extractionPhase.adjuster.stop()
-	if err == nil {
+	if err == nil && !m.Pars.Estimate {
 		m.incrementRef(int64(m.recm.Records.TotalObjectCount()))
 	}
 	return
@@ -548,6 +559,13 @@ func (m *Manager) participateInRecordDistribution(targetOrder meta.Nodes) (curre
 	}
 
 	err = sortRecords(m.recm.Records, m.Pars.Algorithm)
+	if err == nil && m.Pars.Algorithm.Dedup != DedupNone {
+		var n int
+		n, err = dedupRecords(m.recm.Records, m.Pars.Algorithm)
+		metrics.mu.Lock()
+		metrics.DedupCnt += int64(n)
+		metrics.mu.Unlock()
+	}
 	m.dsorter.postRecordDistribution()
 	return true, err
 }
@@ -983,6 +1001,9 @@ func (es *extractShard) _do(lom *core.LOM) error {
 		}
 		shardRW = shard.RWs[ext]
 		debug.Assert(shardRW != nil, ext)
+		if m.Pars.Estimate {
+			shardRW = shard.MetaRW(ext)
+		}
 	}
 
 	phaseInfo := &m.extractionPhase
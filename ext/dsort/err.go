@@ -18,10 +18,13 @@ const (
 	fmtErrNegOutputSize  = "output shard size must be >= 0 (got %d)"
 	fmtErrOrderURL       = "failed to parse ekm file ('ekm_file') URL %q: %v"
 	fmtErrSeed           = "invalid seed %q (expecting integer value)"
+	fmtErrInvalidDedup   = "invalid dedup policy %q (expecting one of: %+v)"
 )
 
 var (
 	errAlgExt            = errors.New("algorithm: invalid extension")
+	errDedupNoKeys       = errors.New("algorithm: deduplication requires sorting by key")
+	errEstimateDryRun    = errors.New("estimate and dry-run are mutually exclusive")
 	errNegConcLimit      = errors.New("negative concurrency limit")
 	errMissingOutputSize = errors.New("output shard size must be set (cannot be 0 and cannot be omitted)")
 	errMissingSrcBucket  = errors.New("missing source bucket")
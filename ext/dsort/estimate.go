@@ -0,0 +1,128 @@
+// Package dsort provides distributed massively parallel resharding for very large datasets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+)
+
+// Estimate-only run (RequestSpec.Estimate):
+// - extraction phase reads record metadata (names, sizes, and keys) but not the content;
+// - sorting phase runs as usual;
+// - the final target generates output shards - same as phase 3 - but instead of distributing
+//   them reports the predicted shard count, sizes, and per-target requirements (Metrics.Estimate).
+
+const estimateKeySample = 10
+
+func (m *Manager) estimate(maxSize int64, ratio float64) error {
+	var (
+		shards []*shard.Shard
+		err    error
+	)
+	if m.Pars.EKMFileURL != "" {
+		shards, err = m.generateShardsWithOrderingFile(maxSize)
+	} else {
+		shards, err = m.generateShardsWithTemplate(maxSize)
+	}
+	if err != nil {
+		return err
+	}
+	bck := meta.CloneBck(&m.Pars.OutputBck)
+	if err := bck.Init(core.T.Bowner()); err != nil {
+		return err
+	}
+	// output shards are expected to compress as well as the input (when resharding into the same format)
+	if m.Pars.OutputExtension != m.Pars.InputExtension {
+		ratio = 1
+	}
+
+	est := newEstimate(m.smap)
+	for _, s := range shards {
+		si, err := m.smap.HrwName2T(bck.MakeUname(s.Name))
+		if err != nil {
+			return err
+		}
+		est.addShard(si.ID(), int64(float64(s.Size)*ratio))
+	}
+	est.finalize()
+	records := m.recm.Records
+	est.addRecords(records.All())
+	est.RecordMemSize = int64(records.RecordMemorySize()) * int64(records.Len())
+	records.Drain()
+
+	m.Metrics.lock()
+	m.Metrics.Estimate = est
+	m.Metrics.unlock()
+
+	nlog.Infof("%s: [dsort] %s estimate: %d records => %d shards (avg size %d)", core.T, m.ManagerUUID,
+		est.RecordCnt, est.ShardCnt, est.AvgShardSize)
+	return nil
+}
+
+//////////////
+// Estimate //
+//////////////
+
+func newEstimate(smap *meta.Smap) *Estimate {
+	est := &Estimate{Targets: make(map[string]*TargetEstimate, smap.CountActiveTs())}
+	for tid, tsi := range smap.Tmap {
+		if !smap.InMaintOrDecomm(tsi) {
+			est.Targets[tid] = &TargetEstimate{}
+		}
+	}
+	return est
+}
+
+func (est *Estimate) addShard(tid string, size int64) {
+	if te, ok := est.Targets[tid]; ok {
+		te.ShardCnt++
+		te.ShardSize += size
+	}
+	if est.ShardCnt == 0 || size < est.MinShardSize {
+		est.MinShardSize = size
+	}
+	est.MaxShardSize = max(est.MaxShardSize, size)
+	est.ShardCnt++
+}
+
+func (est *Estimate) finalize() {
+	if est.ShardCnt == 0 {
+		return
+	}
+	var total int64
+	for _, te := range est.Targets {
+		total += te.ShardSize
+	}
+	est.AvgShardSize = total / est.ShardCnt
+}
+
+// records are sorted; extraction-wise, each record belongs to the target that extracted it
+func (est *Estimate) addRecords(records []*shard.Record) {
+	for _, r := range records {
+		size := r.TotalSize()
+		if te, ok := est.Targets[r.DaemonID]; ok {
+			te.ExtractedCnt++
+			te.ExtractedSize += size
+		}
+		est.RecordCnt++
+		est.RecordSize += size
+	}
+	n := len(records)
+	if n == 0 || records[0].Key == nil {
+		return
+	}
+	step := max(n/estimateKeySample, 1)
+	for i := 0; i < n && len(est.KeySample) < estimateKeySample; i += step {
+		est.KeySample = append(est.KeySample, fmt.Sprint(records[i].Key))
+	}
+	if last := fmt.Sprint(records[n-1].Key); est.KeySample[len(est.KeySample)-1] != last {
+		est.KeySample[len(est.KeySample)-1] = last // always include the last (max) key
+	}
+}
@@ -0,0 +1,65 @@
+// Package dsort provides distributed massively parallel resharding for very large datasets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Estimate", func() {
+	newEst := func(tids ...string) *Estimate {
+		est := &Estimate{Targets: make(map[string]*TargetEstimate, len(tids))}
+		for _, tid := range tids {
+			est.Targets[tid] = &TargetEstimate{}
+		}
+		return est
+	}
+
+	It("should compute shard distribution", func() {
+		est := newEst("t1", "t2")
+		est.addShard("t1", 100)
+		est.addShard("t2", 300)
+		est.addShard("t1", 200)
+		est.finalize()
+
+		Expect(est.ShardCnt).To(Equal(int64(3)))
+		Expect(est.MinShardSize).To(Equal(int64(100)))
+		Expect(est.MaxShardSize).To(Equal(int64(300)))
+		Expect(est.AvgShardSize).To(Equal(int64(200)))
+		Expect(*est.Targets["t1"]).To(Equal(TargetEstimate{ShardCnt: 2, ShardSize: 300}))
+		Expect(*est.Targets["t2"]).To(Equal(TargetEstimate{ShardCnt: 1, ShardSize: 300}))
+	})
+
+	It("should account records and sample keys", func() {
+		var (
+			est     = newEst("t1", "t2")
+			records = make([]*shard.Record, 0, 100)
+		)
+		for i := range 100 {
+			tid := "t1"
+			if i%4 == 0 {
+				tid = "t2"
+			}
+			records = append(records, &shard.Record{
+				Key:      fmt.Sprintf("key-%03d", i),
+				DaemonID: tid,
+				Objects:  []*shard.RecordObj{{Size: 10}, {Size: 5}},
+			})
+		}
+		est.addRecords(records)
+
+		Expect(est.RecordCnt).To(Equal(int64(100)))
+		Expect(est.RecordSize).To(Equal(int64(1500)))
+		Expect(*est.Targets["t1"]).To(Equal(TargetEstimate{ExtractedCnt: 75, ExtractedSize: 1125}))
+		Expect(*est.Targets["t2"]).To(Equal(TargetEstimate{ExtractedCnt: 25, ExtractedSize: 375}))
+		Expect(est.KeySample).To(HaveLen(estimateKeySample))
+		Expect(est.KeySample[0]).To(Equal("key-000"))
+		Expect(est.KeySample[estimateKeySample-1]).To(Equal("key-099"))
+	})
+})
@@ -470,6 +470,9 @@ func tinitHandler(w http.ResponseWriter, r *http.Request) {
 		debug.AssertNoErr(rns.Err)
 		xctn := rns.Entry.Get()
 		debug.Assert(xctn.ID() == managerUUID, xctn.ID()+" vs "+managerUUID)
+		if pars.User != "" {
+			xctn.SetUser(pars.User)
+		}
 
 		m.xctn = xctn.(*xaction)
 	}
@@ -475,6 +475,12 @@ func (m *Manager) setRW() (err error) {
 	}
 
 	m.recm = shard.NewRecordManager(m.Pars.InputBck, m.shardRW, ke, m.onDupRecs)
+	if m.Pars.Estimate {
+		m.recm.SetMetaOnly()
+		if m.shardRW != nil {
+			m.shardRW = shard.MetaRW(m.Pars.InputExtension)
+		}
+	}
 	return nil
 }
 
@@ -388,6 +388,21 @@ var _ = Describe("RequestSpec", func() {
 			Expect(check).To(BeTrue())
 		})
 
+		It("should fail due to estimate combined with dry-run", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111}-suffix"),
+				OutputFormat:    "prefix-{0010..0111}-suffix",
+				OutputShardSize: "10KB",
+				Algorithm:       Algorithm{Kind: None},
+				Estimate:        true,
+				DryRun:          true,
+			}
+			_, err := rs.parse()
+			Expect(err).To(Equal(errEstimateDryRun))
+		})
+
 		It("should fail due to invalid mem usage specification", func() {
 			rs := RequestSpec{
 				InputBck:        cmn.Bck{Name: "test"},
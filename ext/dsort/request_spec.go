@@ -52,6 +52,8 @@ type parsedReqSpec struct {
 	ExtractConcMaxLimit int                   `json:"extract_concurrency_max_limit"`
 	CreateConcMaxLimit  int                   `json:"create_concurrency_max_limit"`
 	SbundleMult         int                   `json:"bundle_multiplier"`
+	Estimate            bool                  `json:"estimate"`
+	User                string                `json:"user,omitempty"` // submitter (set by proxy)
 
 	// debug
 	DsorterType string `json:"dsorter_type"`
@@ -60,6 +62,9 @@ type parsedReqSpec struct {
 	cmn.DsortConf
 }
 
+// job submitter (see xact Snap.User)
+func (parsc *ParsedReq) SetUser(user string) { parsc.pars.User = user }
+
 /////////////////
 // RequestSpec //
 /////////////////
@@ -210,6 +215,10 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	pars.CreateConcMaxLimit = rs.CreateConcMaxLimit
 	pars.DsorterType = rs.DsorterType
 	pars.DryRun = rs.DryRun
+	pars.Estimate = rs.Estimate
+	if pars.Estimate && pars.DryRun {
+		return nil, errEstimateDryRun
+	}
 
 	// `cfg` here contains inherited (aka global) part of the dsort config -
 	// apply this request's rs.Config values to override or assign defaults
@@ -262,6 +271,12 @@ func parseAlgorithm(alg Algorithm) (*Algorithm, error) {
 	} else {
 		alg.ContentKeyType = shard.ContentKeyString
 	}
+	if !cos.StringInSlice(alg.Dedup, dedupPolicies) {
+		return nil, fmt.Errorf(fmtErrInvalidDedup, alg.Dedup, dedupPolicies[1:])
+	}
+	if alg.Dedup != DedupNone && (alg.Kind == Shuffle || alg.Kind == None) {
+		return nil, fmt.Errorf("%w (%q)", errDedupNoKeys, alg.Kind)
+	}
 
 	return &alg, nil
 }
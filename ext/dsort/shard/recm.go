@@ -73,6 +73,7 @@ type (
 			mu      sync.Mutex
 			records []*Records // records received from other targets which are waiting to be merged
 		}
+		metaOnly bool // extract record metadata, skip content (see SetMetaOnly)
 	}
 )
 
@@ -118,6 +119,16 @@ func (recm *RecordManager) RecordWithBuffer(args *extractRecordArgs) (size int64
 
 	r, ske, needRead := recm.keyExtractor.PrepareExtractor(args.recordName, args.r, ext)
 	switch {
+	case recm.metaOnly:
+		debug.Assert(args.w == nil) // (see MetaRW)
+		mdSize, size = int64(len(args.metadata)), r.Size()
+		storeType = OffsetStoreType // (never loaded)
+		contentPath, _ = recm.encodeRecordName(storeType, args.shardName, args.recordName)
+		if needRead {
+			if _, err := io.CopyBuffer(io.Discard, r, args.buf); err != nil {
+				return 0, errors.WithStack(err)
+			}
+		}
 	case args.extractMethod.Has(ExtractToMem):
 		mdSize = int64(len(args.metadata))
 		storeType = SGLStoreType
@@ -199,6 +210,10 @@ func (recm *RecordManager) RecordWithBuffer(args *extractRecordArgs) (size int64
 	return size, nil
 }
 
+// metadata-only extraction: records carry names, keys, and sizes but no content
+// (the content is read only when the sorting key requires it)
+func (recm *RecordManager) SetMetaOnly() { recm.metaOnly = true }
+
 func (recm *RecordManager) EnqueueRecords(records *Records) {
 	recm.enqueued.mu.Lock()
 	recm.enqueued.records = append(recm.enqueued.records, records)
@@ -261,6 +261,53 @@ func (r *Records) Less(i, j int, keyType string) (bool, error) {
 	return false, nil
 }
 
+// Dedup removes records with duplicate keys - the records must be already sorted by key.
+// For each group of (two or more) records with equal keys, `keep` selects the one to keep,
+// or returns nil to drop the entire group.
+func (r *Records) Dedup(keyType string, keep func(dups []*Record) *Record) (removed int, err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	out := r.arr[:0] // (in place)
+	for i := 0; i < len(r.arr); {
+		j := i + 1
+		for ; j < len(r.arr); j++ {
+			var lt, gt bool
+			if lt, err = r.Less(i, j, keyType); err != nil {
+				return 0, err
+			}
+			if gt, err = r.Less(j, i, keyType); err != nil {
+				return 0, err
+			}
+			if lt || gt {
+				break
+			}
+		}
+		if j == i+1 {
+			out = append(out, r.arr[i])
+			i = j
+			continue
+		}
+		dups := r.arr[i:j]
+		rec := keep(dups)
+		for _, d := range dups {
+			if d == rec {
+				continue
+			}
+			delete(r.m, d.Name)
+			r.totalObjectCount -= len(d.Objects)
+			removed++
+		}
+		if rec != nil {
+			out = append(out, rec)
+		}
+		i = j
+	}
+	clear(r.arr[len(out):])
+	r.arr = out
+	return removed, nil
+}
+
 func (r *Records) TotalObjectCount() int {
 	return r.totalObjectCount
 }
@@ -32,6 +32,16 @@ var (
 	}
 )
 
+// RW to extract record metadata only (see RecordManager.SetMetaOnly):
+// compressed tarballs are read directly, without writing intermediate (work) tarballs
+func MetaRW(ext string) RW {
+	rw, ok := RWs[ext]
+	if ok && ext != archive.ExtTar && ext != archive.ExtZip {
+		return &tarRW{ext}
+	}
+	return rw
+}
+
 func IsCompressed(ext string) bool {
 	rw, ok := RWs[ext]
 	debug.Assert(ok, ext)
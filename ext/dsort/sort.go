@@ -45,6 +45,37 @@ func (s *alphaByKey) Less(i, j int) bool {
 	return less
 }
 
+// drops records with duplicate keys (precondition: sorted) according to alg.Dedup policy
+func dedupRecords(r *shard.Records, alg *Algorithm) (int, error) {
+	var keep func(dups []*shard.Record) *shard.Record
+	switch alg.Dedup {
+	case DedupKeepFirst:
+		keep = func(dups []*shard.Record) *shard.Record {
+			rec := dups[0]
+			for _, d := range dups[1:] {
+				if d.Name < rec.Name {
+					rec = d
+				}
+			}
+			return rec
+		}
+	case DedupKeepLast:
+		keep = func(dups []*shard.Record) *shard.Record {
+			rec := dups[0]
+			for _, d := range dups[1:] {
+				if d.Name > rec.Name {
+					rec = d
+				}
+			}
+			return rec
+		}
+	default:
+		debug.Assert(alg.Dedup == DedupDropAll, alg.Dedup)
+		keep = func([]*shard.Record) *shard.Record { return nil }
+	}
+	return r.Dedup(alg.ContentKeyType, keep)
+}
+
 // sorts records by each Record.Key in the order determined by the `alg` algorithm.
 func sortRecords(r *shard.Records, alg *Algorithm) (err error) {
 	switch alg.Kind {
@@ -94,4 +94,52 @@ var _ = Describe("SortRecords", func() {
 		err := sortRecords(fm, &Algorithm{Decreasing: true, ContentKeyType: shard.ContentKeyString})
 		Expect(err).To(HaveOccurred())
 	})
+
+	Describe("dedup", func() {
+		// names: "a", "b", ...; keys: as specified
+		withNames := func(keys ...any) *shard.Records {
+			records := shard.NewRecords(len(keys))
+			for i, key := range keys {
+				records.Insert(&shard.Record{Key: key, Name: string(rune('a' + i))})
+			}
+			return records
+		}
+		names := func(r *shard.Records) (out []string) {
+			for _, rec := range r.All() {
+				out = append(out, rec.Name)
+			}
+			return out
+		}
+		alg := &Algorithm{ContentKeyType: shard.ContentKeyInt}
+
+		It("should keep first", func() {
+			fm := withNames(int64(3), int64(1), int64(3), int64(2), int64(1))
+			Expect(sortRecords(fm, alg)).To(Succeed())
+			alg.Dedup = DedupKeepFirst
+			n, err := dedupRecords(fm, alg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(2))
+			Expect(names(fm)).To(Equal([]string{"b", "d", "a"}))
+		})
+
+		It("should keep last", func() {
+			fm := withNames(int64(3), int64(1), int64(3), int64(2), int64(1))
+			Expect(sortRecords(fm, alg)).To(Succeed())
+			alg.Dedup = DedupKeepLast
+			n, err := dedupRecords(fm, alg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(2))
+			Expect(names(fm)).To(Equal([]string{"e", "d", "c"}))
+		})
+
+		It("should drop all duplicates", func() {
+			fm := withNames(int64(3), int64(1), int64(3), int64(2), int64(1))
+			Expect(sortRecords(fm, alg)).To(Succeed())
+			alg.Dedup = DedupDropAll
+			n, err := dedupRecords(fm, alg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(4))
+			Expect(names(fm)).To(Equal([]string{"d"}))
+		})
+	})
 })
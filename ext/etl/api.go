@@ -126,6 +126,20 @@ type (
 		CPU      float64 `json:"cpu"`
 		Mem      int64   `json:"mem"`
 	}
+
+	// per-target transformation counters of a given ETL instance
+	StatsByTarget []*Stats
+	Stats         struct {
+		TargetID string     `json:"target_id"`
+		Inline   XformStats `json:"inline"`  // on-the-fly, as part of GET
+		Offline  XformStats `json:"offline"` // bucket-to-bucket and multi-object
+	}
+	XformStats struct {
+		Objs    int64 `json:"objs"`
+		Size    int64 `json:"size"`       // inline: source objects; offline: transformed output
+		Errs    int64 `json:"errs"`       // failed transformations
+		Latency int64 `json:"latency_ns"` // total cumulative; divide by Objs to get the average
+	}
 )
 
 var (
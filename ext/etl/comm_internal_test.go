@@ -6,6 +6,7 @@ package etl
 
 import (
 	cryptorand "crypto/rand"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -17,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
@@ -38,6 +40,7 @@ var _ = Describe("CommunicatorTest", func() {
 	var (
 		tmpDir            string
 		comm              Communicator
+		lom               *core.LOM
 		transformerServer *httptest.Server
 		targetServer      *httptest.Server
 		proxyServer       *httptest.Server
@@ -74,7 +77,7 @@ var _ = Describe("CommunicatorTest", func() {
 		// cluster.InitLomLocker(tMock)
 
 		// Create an object.
-		lom := &core.LOM{ObjName: objName}
+		lom = &core.LOM{ObjName: objName}
 		err = lom.InitBck(clusterBck.Bucket())
 		Expect(err).NotTo(HaveOccurred())
 		err = createRandomFile(lom.FQN, dataSize)
@@ -90,7 +93,7 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(err).NotTo(HaveOccurred())
 		}))
 		targetServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			err := comm.InlineTransform(w, r, lom)
+			err := comm.InlineTransform(w, r, lom, 0 /*timeout*/)
 			Expect(err).NotTo(HaveOccurred())
 		}))
 		proxyServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -139,6 +142,50 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(b).To(Equal(transformData))
 		})
 	}
+
+	for _, commType := range []string{Hpush, Hrev} {
+		It("should return ErrUnavail when transformer is down "+commType, func() {
+			down := httptest.NewServer(http.NotFoundHandler())
+			down.Close()
+			boot := &etlBootstrapper{
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: commType}},
+				pod:  &corev1.Pod{},
+				uri:  down.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			}
+			comm = newCommunicator(nil, boot)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			err := comm.InlineTransform(w, r, lom, time.Second)
+			Expect(err).To(HaveOccurred())
+			Expect(IsErrUnavail(err)).To(BeTrue())
+			Expect(w.Body.Len()).To(BeZero())
+		})
+	}
+
+	It("should count inline and offline transformations", func() {
+		boot := &etlBootstrapper{
+			msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush}},
+			pod:  &corev1.Pod{},
+			xctn: mock.NewXact(apc.ActETLInline),
+		}
+		c := newCommunicator(nil, boot)
+		started := mono.NanoTime()
+		c.ObjDone(true /*inline*/, 100, started, nil)
+		c.ObjDone(true /*inline*/, 0, started, errors.New("transform failed"))
+		c.ObjDone(false /*inline*/, 200, started, nil)
+		c.ObjDone(false /*inline*/, 300, started, nil)
+
+		xs := c.Stats()
+		Expect(xs.Inline.Objs).To(Equal(int64(1)))
+		Expect(xs.Inline.Size).To(Equal(int64(100)))
+		Expect(xs.Inline.Errs).To(Equal(int64(1)))
+		Expect(xs.Offline.Objs).To(Equal(int64(2)))
+		Expect(xs.Offline.Size).To(Equal(int64(500)))
+		Expect(xs.Offline.Errs).To(BeZero())
+		Expect(xs.Offline.Latency).To(BeNumerically(">", 0))
+	})
 })
 
 // Creates a file with random content.
@@ -6,6 +6,7 @@ package etl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,12 +17,15 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
 )
 
 type (
@@ -29,6 +33,10 @@ type (
 		ObjCount() int64
 		InBytes() int64
 		OutBytes() int64
+
+		// per-ETL-instance transformation counters (see also: stats.ETL* metrics)
+		ObjDone(inline bool, size, started int64, err error)
+		Stats() *Stats
 	}
 
 	// Communicator is responsible for managing communications with local ETL container.
@@ -46,7 +54,9 @@ type (
 		// InlineTransform uses one of the two ETL container endpoints:
 		//  - Method "PUT", Path "/"
 		//  - Method "GET", Path "/bucket/object"
-		InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error
+		// Returns ErrUnavail if the transformer didn't respond (nothing written to `w`);
+		// non-zero timeout applies to (push) and (reverse proxy) communications.
+		InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM, timeout time.Duration) error
 
 		// OfflineTransform is driven by `OfflineDP` to provide offline transformation, as it were
 		// Implementations include:
@@ -64,6 +74,12 @@ type (
 	baseComm struct {
 		listener meta.Slistener
 		boot     *etlBootstrapper
+		xstats   struct {
+			inline, offline xcounters
+		}
+	}
+	xcounters struct {
+		objs, size, errs, lat atomic.Int64
 	}
 	pushComm struct {
 		baseComm
@@ -82,6 +98,13 @@ type (
 		w       io.Writer
 		writeCb func(int)
 	}
+
+	// transformer did not respond: connection refused, timed out, etc.
+	ErrUnavail struct {
+		err error
+	}
+
+	rpErrKey struct{} // (reverse proxy) per-request error handling
 )
 
 // interface guard
@@ -127,6 +150,13 @@ func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicato
 					req.Header.Set("User-Agent", "")
 				}
 			},
+			ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+				if perr, ok := req.Context().Value(rpErrKey{}).(*error); ok {
+					*perr = err // (the caller decides)
+					return
+				}
+				w.WriteHeader(http.StatusBadGateway)
+			},
 		}
 		rp.rp = revProxy
 		return rp
@@ -151,8 +181,51 @@ func (c *baseComm) ObjCount() int64 { return c.boot.xctn.Objs() }
 func (c *baseComm) InBytes() int64  { return c.boot.xctn.InBytes() }
 func (c *baseComm) OutBytes() int64 { return c.boot.xctn.OutBytes() }
 
+func (c *baseComm) ObjDone(inline bool, size, started int64, err error) {
+	var (
+		xc      = &c.xstats.offline
+		metrics = offlineMetrics
+		elapsed = mono.SinceNano(started)
+	)
+	if inline {
+		xc, metrics = &c.xstats.inline, inlineMetrics
+	}
+	if err != nil {
+		xc.errs.Inc()
+	} else {
+		xc.objs.Inc()
+		xc.size.Add(size)
+		xc.lat.Add(elapsed)
+	}
+	if tstats == nil {
+		return
+	}
+	vlabs := map[string]string{stats.VarlabETL: c.Name()}
+	if err != nil {
+		tstats.IncWith(metrics[3], vlabs)
+		return
+	}
+	tstats.AddWith(
+		cos.NamedVal64{Name: metrics[0], Value: 1, VarLabs: vlabs},
+		cos.NamedVal64{Name: metrics[1], Value: size, VarLabs: vlabs},
+		cos.NamedVal64{Name: metrics[2], Value: elapsed, VarLabs: vlabs},
+	)
+}
+
+func (c *baseComm) Stats() *Stats {
+	return &Stats{
+		TargetID: core.T.SID(),
+		Inline:   c.xstats.inline.get(),
+		Offline:  c.xstats.offline.get(),
+	}
+}
+
 func (c *baseComm) Stop() { c.boot.xctn.Finish() }
 
+func (xc *xcounters) get() XformStats {
+	return XformStats{Objs: xc.objs.Load(), Size: xc.size.Load(), Errs: xc.errs.Load(), Latency: xc.lat.Load()}
+}
+
 func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	if err := c.boot.xctn.AbortErr(); err != nil {
 		return nil, err
@@ -281,6 +354,9 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 	// Do it
 	//
 	resp, err = core.T.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+	if err != nil {
+		err = &ErrUnavail{err}
+	}
 
 finish:
 	if err != nil {
@@ -307,8 +383,8 @@ finish:
 	return cos.NewReaderWithArgs(args), 0, nil
 }
 
-func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom *core.LOM) error {
-	r, err := pc.doRequest(lom, 0 /*timeout*/)
+func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom *core.LOM, timeout time.Duration) error {
+	r, err := pc.doRequest(lom, timeout)
 	if err != nil {
 		return err
 	}
@@ -341,7 +417,8 @@ func (pc *pushComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (r co
 // redirectComm: implements Hpull
 //////////////////
 
-func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error {
+// NOTE: client follows the redirect - timeout does not apply
+func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM, _ time.Duration) error {
 	if err := rc.boot.xctn.AbortErr(); err != nil {
 		return err
 	}
@@ -391,7 +468,7 @@ func (rc *redirectComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (
 // revProxyComm: implements Hrev
 //////////////////
 
-func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error {
+func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM, timeout time.Duration) error {
 	size, err := lomLoad(lom)
 	if err != nil {
 		return err
@@ -403,8 +480,21 @@ func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 
 	r.URL.Path, _ = url.PathUnescape(path) // `Path` must be unescaped otherwise it will be escaped again.
 	r.URL.RawPath = path                   // `RawPath` should be escaped version of `Path`.
-	rp.rp.ServeHTTP(w, r)
 
+	var (
+		errRP error
+		ctx   = context.WithValue(r.Context(), rpErrKey{}, &errRP)
+	)
+	if timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	rp.rp.ServeHTTP(w, r.WithContext(ctx))
+
+	if errRP != nil {
+		return &ErrUnavail{errRP}
+	}
 	return nil
 }
 
@@ -433,6 +523,18 @@ func (cw *cbWriter) Write(b []byte) (n int, err error) {
 	return
 }
 
+////////////////
+// ErrUnavail //
+////////////////
+
+func (e *ErrUnavail) Error() string { return "transformer unavailable: " + e.err.Error() }
+func (e *ErrUnavail) Unwrap() error { return e.err }
+
+func IsErrUnavail(err error) bool {
+	var e *ErrUnavail
+	return errors.As(err, &e)
+}
+
 //
 // utils
 //
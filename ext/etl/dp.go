@@ -11,6 +11,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 )
@@ -43,9 +44,10 @@ func NewOfflineDP(msg *apc.TCBMsg, config *cmn.Config) (*OfflineDP, error) {
 // TODO -- FIXME: comm.OfflineTransform to support latestVer and sync
 func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCloser, cos.OAH, error) {
 	var (
-		r      cos.ReadCloseSizer // note: +sizer
-		err    error
-		action = "read [" + dp.tcbmsg.Transform.Name + "]-transformed " + lom.Cname()
+		r       cos.ReadCloseSizer // note: +sizer
+		err     error
+		action  = "read [" + dp.tcbmsg.Transform.Name + "]-transformed " + lom.Cname()
+		started = mono.NanoTime()
 	)
 	debug.Assert(!latestVer && !sync, "NIY") // TODO -- FIXME
 	call := func() (int, error) {
@@ -66,8 +68,10 @@ func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCl
 		nlog.Infoln(action, err)
 	}
 	if err != nil {
+		dp.comm.ObjDone(false /*inline*/, 0, started, err)
 		return nil, nil, err
 	}
+	dp.comm.ObjDone(false /*inline*/, max(r.Size(), 0), started, nil)
 	lom.SetAtimeUnix(time.Now().UnixNano())
 	oah := &cmn.ObjAttrs{
 		Size:  r.Size(),
@@ -11,6 +11,7 @@ import (
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/stats"
 )
 
 type (
@@ -23,6 +24,13 @@ type (
 var (
 	reg       *registry
 	reqSecret string
+	tstats    stats.Tracker
+)
+
+// (count, size, latency, errors) - see baseComm.ObjDone
+var (
+	inlineMetrics  = [4]string{stats.ETLInlineCount, stats.ETLInlineSize, stats.ETLInlineLatencyTotal, stats.ErrETLInlineCount}
+	offlineMetrics = [4]string{stats.ETLOfflineCount, stats.ETLOfflineSize, stats.ETLOfflineLatencyTotal, stats.ErrETLOfflineCount}
 )
 
 func init() {
@@ -30,6 +38,8 @@ func init() {
 	reqSecret = cos.CryptoRandS(10)
 }
 
+func Tinit(t stats.Tracker) { tstats = t }
+
 func (r *registry) add(name string, c Communicator) (err error) {
 	r.mtx.Lock()
 	if _, ok := r.m[name]; ok {
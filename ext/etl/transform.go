@@ -401,6 +401,15 @@ func PodMetrics(etlName string) (*CPUMemUsed, error) {
 	return nil, err
 }
 
+// GetStats returns this target's transformation counters of the named ETL instance
+func GetStats(etlName string) (*Stats, error) {
+	c, err := GetCommunicator(etlName)
+	if err != nil {
+		return nil, err
+	}
+	return c.Stats(), nil
+}
+
 // Pod conditions include enumerated lifecycle states, such as `PodScheduled`,
 // `ContainersReady`, `Initialized`, `Ready`
 // (see https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle).
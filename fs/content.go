@@ -38,6 +38,7 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	ObjMDType    = "md" // custom metadata that does not fit object's xattr (see core/lsidecar.go)
 )
 
 type (
@@ -177,6 +178,7 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	ObjMDContentResolver    struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -251,3 +253,14 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// (moves and gets evicted together with its object)
+func (*ObjMDContentResolver) PermToMove() bool    { return false }
+func (*ObjMDContentResolver) PermToEvict() bool   { return false }
+func (*ObjMDContentResolver) PermToProcess() bool { return false }
+
+func (*ObjMDContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*ObjMDContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
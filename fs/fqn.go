@@ -35,6 +35,9 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileDload        = "dload"          // partially downloaded content (resumable download)
+	WorkfileRepair       = "repair"         // restore corrupted object (see x-verify-checksum)
+	WorkfilePatch        = "patch"          // PATCH (ranged write) object
 )
 
 type ParsedFQN struct {
@@ -203,6 +206,8 @@ func CleanPathErr(err error) {
 			what = "'ec slice'"
 		case ECMetaType:
 			what = "'ec metadata'"
+		case ObjMDType:
+			what = "'object metadata'"
 		default:
 			what = fmt.Sprintf("'%s'(?)", parsed.ContentType)
 		}
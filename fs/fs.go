@@ -24,7 +24,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/ios"
+	"github.com/NVIDIA/aistore/sys"
 	"github.com/OneOfOne/xxhash"
+	"github.com/karrick/godirwalk"
 )
 
 const bidUnknownTTL = 2 * time.Minute // comment below; TODO: unify and move to config along w/ lom cache
@@ -171,6 +173,29 @@ func (mi *Mountpath) IsAvail() bool {
 	return ok
 }
 
+// NUMA node local to the mountpath's disks; when unknown (or the disks disagree),
+// mountpaths are spread across nodes by their path digests
+// (returns nil when the host is not NUMA)
+func (mi *Mountpath) NumaNode() *sys.NumaNode {
+	nodes := sys.NumaNodes()
+	if len(nodes) < 2 {
+		return nil
+	}
+	id := -1
+	for _, disk := range mi.Disks {
+		nid := sys.DiskNumaNode(disk)
+		if nid < 0 || (id >= 0 && nid != id) {
+			id = -1
+			break
+		}
+		id = nid
+	}
+	if node := sys.NumaNodeByID(id); node != nil {
+		return node
+	}
+	return &nodes[mi.PathDigest%uint64(len(nodes))]
+}
+
 func (mi *Mountpath) CreateMissingBckDirs(bck *cmn.Bck) (err error) {
 	for contentType := range CSM.m {
 		dir := mi.MakePathCT(bck, contentType)
@@ -1153,6 +1178,106 @@ func OnDiskSize(bck *cmn.Bck, prefix string) (size uint64) {
 	return
 }
 
+// number and total size of the bucket's objects on all available mountpaths;
+// optional `keep` selects objects to count (e.g., main replicas at their HRW locations)
+// (walks the respective directories - see also ais/tgtbusage.go for caching)
+func ObjFiles(bck *cmn.Bck, keep func(mi *Mountpath, objName string) bool) (num, size uint64, err error) {
+	var (
+		avail = GetAvail()
+		wg    = &sync.WaitGroup{}
+		mu    sync.Mutex
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *Mountpath) {
+			var (
+				n, sz uint64
+				e     error
+				dir   = mi.MakePathCT(bck, ObjectType)
+			)
+			if keep == nil {
+				n, sz, e = ios.DirFiles(dir)
+			} else {
+				n, sz, e = mi.objFiles(dir, keep)
+			}
+			mu.Lock()
+			if e != nil && !os.IsNotExist(e) {
+				err = e
+			}
+			num += n
+			size += sz
+			mu.Unlock()
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	return num, size, err
+}
+
+func (mi *Mountpath) objFiles(dir string, keep func(*Mountpath, string) bool) (num, size uint64, err error) {
+	cb := func(fqn string, de *godirwalk.Dirent) error {
+		if !de.IsRegular() {
+			return nil
+		}
+		objName := strings.TrimPrefix(fqn[len(dir):], "/")
+		if !keep(mi, objName) {
+			return nil
+		}
+		finfo, err := os.Lstat(fqn)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil // (removed in the meantime)
+			}
+			return err
+		}
+		num++
+		size += uint64(finfo.Size())
+		return nil
+	}
+	err = godirwalk.Walk(dir, &godirwalk.Options{Callback: cb, Unsorted: true})
+	return num, size, err
+}
+
+// used bytes per content type, for the given buckets, on all available mountpaths
+// (walks the respective directories - not intended to be called often; see ais/tgtcusage.go)
+func ContentUsage(bcks []cmn.Bck) apc.ContentUsage {
+	var (
+		avail = GetAvail()
+		usage = make(apc.ContentUsage, len(avail))
+		wg    = &sync.WaitGroup{}
+		mu    sync.Mutex
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *Mountpath) {
+			cts := mi.contentUsage(bcks)
+			mu.Lock()
+			usage[mi.Path] = cts
+			mu.Unlock()
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	return usage
+}
+
+func (mi *Mountpath) contentUsage(bcks []cmn.Bck) map[string]uint64 {
+	cts := make(map[string]uint64, len(CSM.m))
+	for i := range bcks {
+		for ct := range CSM.m {
+			size, err := ios.DirSizeOnDisk(mi.MakePathCT(&bcks[i], ct), false /*withNonDirPrefix*/)
+			if err != nil {
+				if !os.IsNotExist(err) && cmn.Rom.FastV(4, cos.SmoduleFS) {
+					nlog.Warningln("failed to calculate content size:", err, "["+mi.String(), bcks[i].String(), ct+"]")
+				}
+				continue
+			}
+			cts[ct] += size
+		}
+	}
+	return cts
+}
+
 // via (`apc.WhatDiskStats`, target_stats)
 func DiskStats(allds cos.AllDiskStats, tcdf *Tcdf, config *cmn.Config, refreshCap bool) {
 	// iops and bw
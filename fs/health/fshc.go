@@ -27,10 +27,13 @@ import (
 // - the mountpath appears to be unavailable, or
 // - configured error limit is exceeded
 // the mountpath is disabled - effectively, removed from the operation henceforth.
+//
+// Unless opted out (fshc.probe_count = -1), a disabled mountpath is then put on probation:
+// FSHC periodically re-tests it and re-enables it after so many consecutive clean probes.
 
 // constants and tunables
 const (
-	tmpSize     = cos.MiB // write: temp file size
+	tmpSize     = cos.MiB // write: temp file size (default, see fshc.test_file_size)
 	maxNumFiles = 100     // read:  upto so many existing files
 )
 
@@ -45,11 +48,13 @@ const (
 )
 
 type (
-	disabler interface {
+	mpather interface {
 		DisableMpath(mi *fs.Mountpath) error // impl. ais/tgtfshc.go
+		EnableMpath(mi *fs.Mountpath) error  // ditto
 	}
 	FSHC struct {
-		t disabler
+		t      mpather
+		stopCh cos.StopCh // terminates probation (all mountpaths)
 	}
 )
 
@@ -63,7 +68,24 @@ type (
 // per mountpath: recent-or-running
 var all sync.Map // [mpath => ror]
 
-func NewFSHC(t disabler) (f *FSHC) { return &FSHC{t: t} }
+// mountpaths on probation
+var probation sync.Map // [mpath => *cos.StopCh]
+
+func NewFSHC(t mpather) (f *FSHC) {
+	f = &FSHC{t: t}
+	f.stopCh.Init()
+	return f
+}
+
+// terminate all probations (upon shutdown)
+func (f *FSHC) Stop() { f.stopCh.Close() }
+
+// terminate probation of a given mountpath (e.g., upon user enabling or detaching it)
+func (*FSHC) StopProbation(mpath string) {
+	if v, ok := probation.LoadAndDelete(mpath); ok {
+		v.(*cos.StopCh).Close()
+	}
+}
 
 func (*FSHC) IsErr(err error) bool {
 	return cmn.IsErrGetCap(err) || cmn.IsErrMpathCheck(err) || cos.IsIOError(err)
@@ -108,6 +130,7 @@ func (f *FSHC) run(mi *fs.Mountpath, fqn string) {
 		cfg        = cmn.GCO.Get().FSHC
 		maxerrs    = cfg.HardErrs
 		numFiles   = cfg.TestFileCount
+		fsize      = testFileSize(&cfg)
 	)
 	// 1. fstat
 	err := cos.Stat(mi.Path)
@@ -135,7 +158,7 @@ func (f *FSHC) run(mi *fs.Mountpath, fqn string) {
 
 	// 4. read/write tests
 	for i := range 2 {
-		rerrs, werrs := _rw(mi, fqn, numFiles, tmpSize)
+		rerrs, werrs := _rw(mi, fqn, numFiles, fsize)
 
 		if rerrs == 0 && werrs == 0 {
 			if i == 0 {
@@ -146,7 +169,7 @@ func (f *FSHC) run(mi *fs.Mountpath, fqn string) {
 			return
 		}
 		serr = fmt.Sprintf("(read %d, write %d (max-errors %d, write-size %s%s))",
-			rerrs, werrs, maxerrs, cos.ToSizeIEC(tmpSize, 0), pass)
+			rerrs, werrs, maxerrs, cos.ToSizeIEC(int64(fsize), 0), pass)
 
 		if rerrs+werrs < maxerrs {
 			nlog.Errorln("Warning: detected read/write errors", mi.String(), serr)
@@ -176,7 +199,91 @@ func (f *FSHC) _disable(mi *fs.Mountpath) {
 	} else {
 		nlog.Infoln(mi.String(), "now disabled")
 		mi.SetFlags(fs.FlagDisabledByFSHC)
+		if cmn.GCO.Get().FSHC.ProbeCount >= 0 {
+			sch := cos.NewStopCh()
+			if _, loaded := probation.LoadOrStore(mi.Path, sch); !loaded {
+				go f.probation(mi, sch)
+			}
+		}
+	}
+}
+
+func testFileSize(cfg *cmn.FSHCConf) int {
+	if cfg.TestFileSize == 0 {
+		return tmpSize
+	}
+	return int(cfg.TestFileSize)
+}
+
+// periodically re-test mountpath disabled by FSHC; re-enable after so many consecutive clean probes
+// (terminates when the mountpath is re-enabled or detached by other means, when opted out, or upon shutdown)
+func (f *FSHC) probation(mi *fs.Mountpath, sch *cos.StopCh) {
+	defer probation.CompareAndDelete(mi.Path, sch)
+
+	var (
+		clean int
+		timer = time.NewTimer(time.Hour)
+	)
+	defer timer.Stop()
+	for {
+		cfg := cmn.GCO.Get().FSHC
+		if cfg.ProbeCount < 0 {
+			nlog.Infoln(mi.String(), "probation: opted out")
+			return
+		}
+		timer.Reset(max(cfg.ProbeInterval.D(), minTimeBetweenRuns))
+		select {
+		case <-timer.C:
+		case <-sch.Listen():
+			nlog.Infoln(mi.String(), "probation: stopped")
+			return
+		case <-f.stopCh.Listen():
+			return
+		}
+
+		if !isDisabledByFSHC(mi) {
+			nlog.Infoln(mi.String(), "probation: no longer disabled by FSHC, nothing to do")
+			return
+		}
+		cfg = cmn.GCO.Get().FSHC
+		if err := probe(mi, &cfg); err != nil {
+			clean = 0
+			nlog.Warningln(mi.String(), "probation: failed probe:", err)
+			continue
+		}
+		clean++
+		nlog.Infoln(mi.String(), "probation: clean probe", clean, "of", cfg.ProbeCount)
+		if clean < cfg.ProbeCount {
+			continue
+		}
+		if err := f.t.EnableMpath(mi); err != nil {
+			nlog.Errorln(mi.String(), "probation: failed to re-enable:", err)
+			clean = 0
+			continue
+		}
+		nlog.Infoln(mi.String(), "re-enabled after", clean, "consecutive clean probes")
+		return
+	}
+}
+
+func isDisabledByFSHC(mi *fs.Mountpath) bool {
+	_, disabled := fs.Get()
+	d, ok := disabled[mi.Path]
+	return ok && d == mi && mi.IsAnySet(fs.FlagDisabledByFSHC)
+}
+
+// a single probe: same checks as in `run` (above) with zero tolerance for errors
+func probe(mi *fs.Mountpath, cfg *cmn.FSHCConf) error {
+	if err := cos.Stat(mi.Path); err != nil {
+		return err
+	}
+	if err := mi.CheckFS(); err != nil {
+		return err
+	}
+	if rerrs, werrs := _rw(mi, "", cfg.TestFileCount, testFileSize(cfg)); rerrs > 0 || werrs > 0 {
+		return fmt.Errorf("read errors %d, write errors %d", rerrs, werrs)
 	}
+	return nil
 }
 
 // the core testing function: reads existing and writes temporary files on mountpath
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -133,3 +134,61 @@ func TestFSCheckerTryWriteFile(t *testing.T) {
 	err := _write(mpath, cos.KiB)
 	tassert.CheckFatal(t, err)
 }
+
+func TestFSCheckerProbe(t *testing.T) {
+	setupTests(t)
+
+	cfg := cmn.GCO.Get().FSHC
+	cfg.TestFileSize = 4 * cos.KiB
+	avail, disabled := fs.Get()
+
+	mi, ok := avail[fsCheckerTmpDir+"/1"]
+	tassert.Fatalf(t, ok, "expecting available mountpath")
+	tassert.CheckFatal(t, probe(mi, &cfg))
+
+	mi, ok = disabled[fsCheckerTmpDir+"/4"]
+	tassert.Fatalf(t, ok, "expecting disabled mountpath")
+	tassert.CheckFatal(t, probe(mi, &cfg)) // disabled but healthy
+	tassert.Errorf(t, !isDisabledByFSHC(mi), "%s is disabled but not by FSHC", mi)
+
+	mi = avail[fsCheckerTmpDir+"/3"] // deleted (see initMountpaths)
+	err := probe(mi, &cfg)
+	tassert.Errorf(t, err != nil, "expecting probe to fail on deleted mountpath %s", mi)
+}
+
+type mpatherMock struct {
+	disabled, enabled int
+}
+
+func (m *mpatherMock) DisableMpath(*fs.Mountpath) error { m.disabled++; return nil }
+func (m *mpatherMock) EnableMpath(*fs.Mountpath) error  { m.enabled++; return nil }
+
+func TestFSCheckerStopProbation(t *testing.T) {
+	setupTests(t)
+
+	var (
+		m      = &mpatherMock{}
+		f      = NewFSHC(m)
+		mpaths = []string{fsCheckerTmpDir + "/1", fsCheckerTmpDir + "/2"}
+	)
+	avail, _ := fs.Get()
+	for _, mpath := range mpaths {
+		mi := avail[mpath]
+		f._disable(mi)
+		_, ok := probation.Load(mpath)
+		tassert.Fatalf(t, ok, "%s: expecting probation", mi)
+	}
+	tassert.Errorf(t, m.disabled == 2, "expecting 2 disabled, got %d", m.disabled)
+
+	// user (re)enables or detaches
+	f.StopProbation(mpaths[0])
+	_, ok := probation.Load(mpaths[0])
+	tassert.Errorf(t, !ok, "%s: expecting probation to stop", mpaths[0])
+
+	// shutdown
+	f.Stop()
+	time.Sleep(100 * time.Millisecond)
+	_, ok = probation.Load(mpaths[1])
+	tassert.Errorf(t, !ok, "%s: expecting probation to terminate upon shutdown", mpaths[1])
+	tassert.Errorf(t, m.enabled == 0, "not expecting re-enable, got %d", m.enabled)
+}
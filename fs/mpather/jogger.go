@@ -75,6 +75,7 @@ type (
 		stopCh    cos.StopCh
 		bufs      [][]byte
 		num       int64
+		numa      numaPin // (parallel visits run in other goroutines and remain unpinned)
 	}
 
 	joggerSyncGroup struct {
@@ -203,9 +204,12 @@ func (j *jogger) run() (err error) {
 		goto ex
 	}
 
+	j.numa.pin(j.mi, j.config, j.syncGroup == nil)
+	defer j.numa.release()
+
 	if j.opts.Slab != nil {
 		if j.opts.Parallel <= 1 {
-			j.bufs = [][]byte{j.opts.Slab.Alloc()}
+			j.bufs = [][]byte{j.numa.alloc(j.opts.Slab)}
 		} else {
 			j.bufs = make([][]byte, j.opts.Parallel)
 			for i := range j.opts.Parallel {
@@ -410,7 +414,10 @@ func (j *jogger) visitObj(lom *core.LOM, buf []byte) (err error) {
 		return nil
 	}
 visit:
-	return j.opts.VisitObj(lom, buf)
+	started := j.numa.begin()
+	err = j.opts.VisitObj(lom, buf)
+	j.numa.done(started)
+	return err
 }
 
 func (j *jogger) visitCT(ct *core.CT, buf []byte) error { return j.opts.VisitCT(ct, buf) }
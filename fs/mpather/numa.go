@@ -0,0 +1,69 @@
+// Package mpather provides per-mountpath concepts.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package mpather
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/sys"
+)
+
+// NUMA placement of per-mountpath workers and joggers (see cmn.MemsysConf.NUMA):
+// pin the (running) goroutine to the mountpath's NUMA node and allocate its buffers there;
+// on multi-node hosts, account for pinned and unpinned work alike - to compare
+
+type numaPin struct {
+	unpin  func()
+	pinned bool
+	active bool
+}
+
+func (np *numaPin) pin(mi *fs.Mountpath, config *cmn.Config, canPin bool) {
+	if !sys.IsNUMA() {
+		return
+	}
+	np.active = true
+	if !config.Memsys.NUMA || !canPin {
+		return
+	}
+	node := mi.NumaNode()
+	unpin, err := sys.NumaPin(node)
+	if err != nil {
+		nlog.Warningln(mi.String(), "failed to pin to NUMA node", node.ID, "err:", err)
+		return
+	}
+	np.unpin, np.pinned = unpin, true
+}
+
+func (np *numaPin) release() {
+	if np.unpin != nil {
+		np.unpin()
+		np.unpin = nil
+	}
+}
+
+func (np *numaPin) alloc(slab *memsys.Slab) []byte {
+	if np.pinned {
+		return slab.AllocLocal()
+	}
+	return slab.Alloc()
+}
+
+func (np *numaPin) begin() int64 {
+	if np.active {
+		return mono.NanoTime()
+	}
+	return 0
+}
+
+func (np *numaPin) done(started int64) {
+	if np.active {
+		core.NumaDone(np.pinned, mono.SinceNano(started))
+	}
+}
@@ -35,6 +35,7 @@ type (
 		mi     *fs.Mountpath
 		workCh chan core.LIF
 		stopCh cos.StopCh
+		numa   numaPin
 	}
 )
 
@@ -95,8 +96,10 @@ func newWorker(opts *WorkerGroupOpts, mi *fs.Mountpath) (w *worker) {
 
 func (w *worker) work() error {
 	var buf []byte
+	w.numa.pin(w.mi, cmn.GCO.Get(), true)
+	defer w.numa.release()
 	if w.opts.Slab != nil {
-		buf = w.opts.Slab.Alloc()
+		buf = w.numa.alloc(w.opts.Slab)
 		defer w.opts.Slab.Free(buf)
 	}
 	for {
@@ -107,7 +110,9 @@ func (w *worker) work() error {
 				break
 			}
 			if err = lom.Load(false /*cache it*/, false); err == nil {
+				started := w.numa.begin()
 				w.opts.Callback(lom, buf)
+				w.numa.done(started)
 			} else {
 				core.FreeLOM(lom)
 			}
@@ -137,8 +137,14 @@ func Walk(opts *WalkOpts) error {
 		Unsorted:      !opts.Sorted,
 		ScratchBuffer: scratch,
 	}
+	parallel := opts.Mi.walkParallelism() // (see walkpar.go)
 	for _, fqn := range fqns {
-		err1 := godirwalk.Walk(fqn, gOpts)
+		var err1 error
+		if parallel > 1 {
+			err1 = pwalkRoot(opts, fqn, ew, parallel)
+		} else {
+			err1 = godirwalk.Walk(fqn, gOpts)
+		}
 		if err1 == nil || os.IsNotExist(err1) {
 			continue
 		}
@@ -10,6 +10,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -193,3 +195,64 @@ func TestWalkBckSkipDir(t *testing.T) {
 	}
 	tassert.Fatalf(t, expectedTotal == len(fqns), "expected %d objects, got %d", expectedTotal, len(fqns))
 }
+
+func TestWalkParallel(t *testing.T) {
+	var (
+		bck = cmn.Bck{Name: "name", Provider: apc.AIS}
+		rnd = cos.NowRand()
+	)
+	fs.TestNew(mock.NewIOS())
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+
+	mpath := t.TempDir()
+	mi, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	// top-level files and subtrees of varying depth
+	dir := mi.MakePathCT(&bck, fs.ObjectType)
+	for i := range 20 {
+		sub := filepath.Join(dir, "d"+strconv.Itoa(i))
+		for depth := range rnd.IntN(4) + 1 {
+			sub = filepath.Join(sub, "s"+strconv.Itoa(depth))
+			tassert.CheckFatal(t, cos.CreateDir(sub))
+			for j := range rnd.IntN(10) {
+				tassert.CheckFatal(t, os.WriteFile(filepath.Join(sub, "f"+strconv.Itoa(j)), nil, cos.PermRWR))
+			}
+		}
+		tassert.CheckFatal(t, os.WriteFile(filepath.Join(dir, "f"+strconv.Itoa(i)), nil, cos.PermRWR))
+	}
+
+	walk := func(parallel int, skipDir string) []string {
+		config := cmn.GCO.BeginUpdate()
+		config.Disk.WalkParallel = parallel
+		cmn.GCO.CommitUpdate(config)
+
+		fqns := make([]string, 0, 256)
+		err := fs.Walk(&fs.WalkOpts{
+			Mi:  mi,
+			Bck: bck,
+			CTs: []string{fs.ObjectType},
+			Callback: func(fqn string, de fs.DirEntry) error {
+				if de.IsDir() && strings.HasSuffix(fqn, skipDir) {
+					return filepath.SkipDir
+				}
+				fqns = append(fqns, fqn)
+				return nil
+			},
+			Sorted: true,
+		})
+		tassert.CheckFatal(t, err)
+		return fqns
+	}
+	defer walk(0, "")
+
+	for _, skipDir := range []string{"", "/s1", "/d7"} {
+		expected := walk(0, skipDir)
+		for _, parallel := range []int{2, 4, 32} {
+			fqns := walk(parallel, skipDir)
+			tassert.Fatalf(t, reflect.DeepEqual(fqns, expected),
+				"parallel(%d) walk (skip %q): expected %d entries in the same order as sequential, got %d",
+				parallel, skipDir, len(expected), len(fqns))
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/karrick/godirwalk"
+)
+
+// Parallel walk: top-level directory subtrees of a given root are traversed by
+// a bounded pool of workers (see cmn.DiskConf.WalkParallelism) - while user callback
+// is still invoked sequentially, and in the same (pre-)order as single-threaded walk.
+// In particular:
+// - with `Sorted` option the resulting order is lexicographical, same as godirwalk;
+// - filepath.SkipDir returned by the callback skips the directory (or, when returned
+//   for a file, the remaining entries in the containing directory), same as godirwalk;
+// - workers run ahead of the callback by at most mpathQueueSize entries each.
+
+type (
+	pwalk struct {
+		opts     *WalkOpts
+		ew       *errCallbackWrapper
+		stopCh   chan struct{}
+		wg       sync.WaitGroup
+		parallel int
+	}
+	pwalkSub struct { // top-level subtree
+		root  string
+		entCh chan pwalkEnt
+		err   error // valid once entCh is closed
+		skip  atomic.Bool
+	}
+	pwalkEnt struct {
+		fqn string
+		de  *godirwalk.Dirent
+	}
+)
+
+var errPwalkStopped = errors.New("parallel walk stopped")
+
+// number of parallel walkers for a given mountpath
+func (mi *Mountpath) walkParallelism() int {
+	if mi == nil {
+		return 1
+	}
+	config := cmn.GCO.Get()
+	return config.Disk.WalkParallelism(mi.Label)
+}
+
+// compare w/ godirwalk.Walk
+func pwalkRoot(opts *WalkOpts, root string, ew *errCallbackWrapper, parallel int) error {
+	de, err := godirwalk.NewDirent(root)
+	if err != nil {
+		return err
+	}
+	pw := &pwalk{opts: opts, ew: ew, parallel: parallel}
+	skip, err := pw.call(root, de)
+	if err != nil || skip != "" || !de.IsDir() {
+		return err
+	}
+
+	scratch, slab := memsys.PageMM().AllocSize(memsys.DefaultBufSize)
+	children, err := godirwalk.ReadDirents(root, scratch)
+	slab.Free(scratch)
+	if err != nil {
+		if ew.PathErrToAction(root, err) == godirwalk.SkipNode {
+			err = nil
+		}
+		return err
+	}
+	if opts.Sorted {
+		sort.Sort(children)
+	}
+
+	subs := make([]*pwalkSub, 0, len(children))
+	for _, child := range children {
+		if child.IsDir() {
+			sub := &pwalkSub{root: filepath.Join(root, child.Name()), entCh: make(chan pwalkEnt, mpathQueueSize)}
+			subs = append(subs, sub)
+		}
+	}
+	pw.stopCh = make(chan struct{})
+	pw.start(subs)
+	err = pw.emit(root, children, subs)
+	close(pw.stopCh)
+	pw.wg.Wait()
+	return err
+}
+
+func (pw *pwalk) start(subs []*pwalkSub) {
+	if len(subs) == 0 {
+		return
+	}
+	var (
+		workCh = make(chan *pwalkSub)
+		n      = min(pw.parallel, len(subs))
+	)
+	pw.wg.Add(n + 1)
+	for range n {
+		go pw.work(workCh)
+	}
+	// dispatch in order, so that the subtree being emitted is always in progress
+	go func() {
+		defer pw.wg.Done()
+		defer close(workCh)
+		for _, sub := range subs {
+			select {
+			case workCh <- sub:
+			case <-pw.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (pw *pwalk) work(workCh <-chan *pwalkSub) {
+	scratch, slab := memsys.PageMM().AllocSize(memsys.DefaultBufSize)
+	gOpts := &godirwalk.Options{
+		ErrorCallback: pw.ew.PathErrToAction,
+		Unsorted:      !pw.opts.Sorted,
+		ScratchBuffer: scratch,
+	}
+	for sub := range workCh {
+		gOpts.Callback = func(fqn string, de *godirwalk.Dirent) error {
+			if sub.skip.Load() {
+				return errPwalkStopped
+			}
+			select {
+			case sub.entCh <- pwalkEnt{fqn, de}:
+				return nil
+			case <-pw.stopCh:
+				return errPwalkStopped
+			}
+		}
+		sub.err = godirwalk.Walk(sub.root, gOpts)
+		close(sub.entCh)
+	}
+	slab.Free(scratch)
+	pw.wg.Done()
+}
+
+// invoke user callback for all root's children and their subtrees, in order
+func (pw *pwalk) emit(root string, children godirwalk.Dirents, subs []*pwalkSub) error {
+	var i int
+	for _, child := range children {
+		if !child.IsDir() {
+			fqn := filepath.Join(root, child.Name())
+			skip, err := pw.call(fqn, child)
+			if err != nil || skip != "" { // (the latter: skipping remaining root's entries)
+				return err
+			}
+			continue
+		}
+		sub := subs[i]
+		i++
+		if err := pw.emitSub(sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pw *pwalk) emitSub(sub *pwalkSub) (err error) {
+	var skip string
+	for ent := range sub.entCh {
+		if err != nil {
+			continue // drain
+		}
+		if skip != "" {
+			if strings.HasPrefix(ent.fqn, skip) {
+				continue
+			}
+			skip = "" // depth-first: all entries under `skip` are contiguous
+		}
+		skip, err = pw.call(ent.fqn, ent.de)
+		if err != nil || skip == sub.root+cos.PathSeparator {
+			sub.skip.Store(true) // stop walking this subtree
+		}
+	}
+	if err == nil && sub.err != nil && sub.err != errPwalkStopped {
+		err = sub.err
+	}
+	return err
+}
+
+// user callback; returns the (directory) prefix to skip, if any
+// (compare w/ godirwalk walk() handling SkipDir, SkipThis, and ErrorCallback)
+func (pw *pwalk) call(fqn string, de *godirwalk.Dirent) (string, error) {
+	err := pw.opts.Callback(fqn, de)
+	switch {
+	case err == nil:
+		return "", nil
+	case err == filepath.SkipDir:
+		if de.IsDir() {
+			return fqn + cos.PathSeparator, nil
+		}
+		return filepath.Dir(fqn) + cos.PathSeparator, nil
+	case err == godirwalk.SkipThis || pw.ew.PathErrToAction(fqn, err) == godirwalk.SkipNode:
+		if de.IsDir() {
+			return fqn + cos.PathSeparator, nil
+		}
+		return "", nil
+	default:
+		return "", err
+	}
+}
@@ -39,6 +39,22 @@ func DirSizeOnDisk(originalDirPath string, withNonDirPrefix bool) (size uint64,
 	return
 }
 
+func DirFiles(dirPath string) (num, size uint64, err error) {
+	err = godirwalk.Walk(dirPath, &godirwalk.Options{Callback: func(osPathname string, entry *godirwalk.Dirent) error {
+		if !entry.IsRegular() {
+			return nil
+		}
+		stat, err := os.Lstat(osPathname)
+		if err != nil {
+			return err
+		}
+		num++
+		size += uint64(stat.Size())
+		return nil
+	}})
+	return
+}
+
 func GetFSStats(path string) (blocks, bavail uint64, bsize int64, err error) {
 	var fsStats unix.Statfs_t
 	fsStats, err = getFSStats(path)
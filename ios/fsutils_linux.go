@@ -41,13 +41,31 @@ func DirSizeOnDisk(dirPath string, withNonDirPrefix bool) (size uint64, err erro
 		return size, err
 	}
 
-	size, err = dirSizeOnDiskFD(fd, prefix, 0)
+	size, err = dirSizeOnDiskFD(fd, prefix, 0, nil)
 	return size + uint64(stat.Size), err
 }
 
+// DirFiles returns the number and total size of regular files in a given directory,
+// including its subdirectories.
+func DirFiles(dirPath string) (num, size uint64, err error) {
+	fd, err := syscall.Open(dirPath, dirOpenMode, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer syscall.Close(fd)
+
+	var files dirFiles
+	_, err = dirSizeOnDiskFD(fd, "", 0, &files)
+	return files.num, files.size, err
+}
+
+type dirFiles struct {
+	num, size uint64
+}
+
 // dirSizeOnDiskFD calculates directory size on disk based on the opened
-// file descriptor to said directory.
-func dirSizeOnDiskFD(fd int, prefix string, stackSize int) (size uint64, err error) {
+// file descriptor to said directory; optionally, counts regular files.
+func dirSizeOnDiskFD(fd int, prefix string, stackSize int, files *dirFiles) (size uint64, err error) {
 	if stackSize >= maxStackSize {
 		return size, fmt.Errorf("DirSizeOnDisk stack overflow, exceeded maximum size of %d nested directories", maxStackSize)
 	}
@@ -98,6 +116,10 @@ func dirSizeOnDiskFD(fd int, prefix string, stackSize int) (size uint64, err err
 				return size, errno
 			}
 			size += uint64(stat.Size)
+			if files != nil && sde.Type == syscall.DT_REG {
+				files.num++
+				files.size += uint64(stat.Size)
+			}
 
 			if sde.Type == syscall.DT_DIR {
 				fd, _, errno := syscall.Syscall6(syscall.SYS_OPENAT, uintptr(fd), uintptr(unsafe.Pointer(&sde.Name[0])), uintptr(dirOpenMode), uintptr(0), 0, 0)
@@ -108,7 +130,7 @@ func dirSizeOnDiskFD(fd int, prefix string, stackSize int) (size uint64, err err
 					}
 					return size, errno
 				}
-				n, err := dirSizeOnDiskFD(int(fd), "", stackSize+1)
+				n, err := dirSizeOnDiskFD(int(fd), "", stackSize+1, files)
 				_ = syscall.Close(int(fd))
 				if err != nil {
 					return size, err
@@ -54,4 +54,20 @@ var _ = Describe("fsutils", func() {
 			})
 		})
 	})
+
+	Describe("DirFiles", func() {
+		It("should count regular files and their sizes", func() {
+			rootDir, files := tools.PrepareDirTree(GinkgoTB(), tools.DirTreeDesc{
+				InitDir:  "",
+				Dirs:     5,
+				Files:    3,
+				FileSize: 1024,
+				Depth:    2,
+			})
+			num, size, err := ios.DirFiles(rootDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(num).To(BeEquivalentTo(len(files)))
+			Expect(size).To(BeEquivalentTo(len(files) * 1024))
+		})
+	})
 })
@@ -39,6 +39,19 @@ func (s *Slab) Alloc() (buf []byte) {
 	return
 }
 
+// AllocLocal bypasses the pool to allocate a new buffer and touch its pages
+// from the calling thread. With a NUMA-pinned caller, the kernel's first-touch
+// policy then places the buffer on the caller's node (best effort - the Go
+// runtime may hand out previously touched memory). Freed as usual.
+func (s *Slab) AllocLocal() (buf []byte) {
+	buf = make([]byte, s.Size())
+	for i := 0; i < len(buf); i += PageSize {
+		buf[i] = 0xff
+	}
+	s.hitsInc()
+	return buf
+}
+
 func (s *Slab) Free(buf []byte) {
 	s.muput.Lock()
 	debug.Assert(int64(cap(buf)) == s.Size())
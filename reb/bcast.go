@@ -99,7 +99,7 @@ func (reb *Reb) rxReady(tsi *meta.Snode, rargs *rebArgs) bool /*ready*/ {
 	var (
 		curwt time.Duration
 		sleep = cmn.Rom.CplaneOperation() * 2
-		maxwt = rargs.config.Rebalance.DestRetryTime.D() + rargs.config.Rebalance.DestRetryTime.D()/2
+		maxwt = reb.destRetryTime() + reb.destRetryTime()/2
 		xreb  = rargs.xreb
 	)
 	for curwt < maxwt {
@@ -134,7 +134,7 @@ func (reb *Reb) waitAcksExtended(tsi *meta.Snode, rargs *rebArgs) (ok bool) {
 		curwt      time.Duration
 		status     *Status
 		sleep      = rargs.config.Timeout.CplaneOperation.D()
-		maxwt      = rargs.config.Rebalance.DestRetryTime.D()
+		maxwt      = reb.destRetryTime()
 		sleepRetry = cmn.KeepaliveRetryDuration(rargs.config)
 		xreb       = rargs.xreb
 	)
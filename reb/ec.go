@@ -209,7 +209,7 @@ func (reb *Reb) findEmptyTarget(md *ec.Metadata, ct *core.CT, sender string) (*m
 		sliceCnt     = md.Data + md.Parity + 2
 		smap         = reb.smap.Load()
 		uname        = ct.UnamePtr()
-		hrwList, err = smap.HrwTargetList(uname, sliceCnt)
+		hrwList, err = smap.HrwTargetList(uname, sliceCnt, ct.Bck().Props.EC.SpreadFD)
 	)
 	if err != nil {
 		return nil, err
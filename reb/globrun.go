@@ -76,6 +76,8 @@ type (
 		rebID atomic.Int64
 		// quiescence
 		lastrx atomic.Int64 // mono time
+		// config.Rebalance.DestRetryTime (nanoseconds) - updated at runtime (see onConfigChange)
+		destRetry atomic.Int64
 		// this state
 		mu sync.Mutex
 	}
@@ -151,9 +153,26 @@ func New(config *cmn.Config) *Reb {
 	}
 	reb.dm = bundle.NewDM(trname, reb.recvObj, cmn.OwtRebalance, dmExtra) // (compare with dm.Renew below)
 
+	reb.destRetry.Store(int64(config.Rebalance.DestRetryTime))
+	cmn.GCO.Subscribe("rebalance", reb.onConfigChange)
 	return reb
 }
 
+// - dest_retry_time: applies immediately, including waits of the currently running rebalance;
+// - compression and bundle_multiplier: upon the next rebalance (streams are per run)
+func (reb *Reb) onConfigChange(oconfig, nconfig *cmn.Config) {
+	oc, nc := &oconfig.Rebalance, &nconfig.Rebalance
+	if oc.DestRetryTime != nc.DestRetryTime {
+		reb.destRetry.Store(int64(nc.DestRetryTime))
+		nlog.Infoln("rebalance: dest_retry_time:", oc.DestRetryTime, "=>", nc.DestRetryTime)
+	}
+	if oc.Compression != nc.Compression || oc.SbundleMult != nc.SbundleMult {
+		nlog.Infoln("rebalance: compression and/or bundle_multiplier changed - will take effect upon the next rebalance")
+	}
+}
+
+func (reb *Reb) destRetryTime() time.Duration { return time.Duration(reb.destRetry.Load()) }
+
 func (reb *Reb) regRecv() error {
 	if err := reb.dm.RegRecv(); err != nil {
 		return err
@@ -539,12 +558,12 @@ func (reb *Reb) rebWaitAck(rargs *rebArgs) (errCnt int) {
 	var (
 		cnt   int
 		sleep = rargs.config.Timeout.CplaneOperation.D()
-		maxwt = rargs.config.Rebalance.DestRetryTime.D()
+		maxwt = reb.destRetryTime()
 		xreb  = rargs.xreb
 		smap  = rargs.smap
 	)
 	maxwt += time.Duration(int64(time.Minute) * int64(rargs.smap.CountTargets()/10))
-	maxwt = min(maxwt, rargs.config.Rebalance.DestRetryTime.D()*2)
+	maxwt = min(maxwt, reb.destRetryTime()*2)
 	reb.changeStage(rebStageWaitAck)
 
 	for {
@@ -86,7 +86,7 @@ func (reb *Reb) _waitForSmap() (smap *meta.Smap, err error) {
 	var (
 		config = cmn.GCO.Get()
 		sleep  = cmn.Rom.CplaneOperation()
-		maxwt  = config.Rebalance.DestRetryTime.D()
+		maxwt  = reb.destRetryTime()
 		curwt  time.Duration
 	)
 	maxwt = min(maxwt, config.Timeout.SendFile.D()/3)
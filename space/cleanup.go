@@ -246,9 +246,20 @@ func (j *clnJ) String() string {
 	if j.ini.Args.Force {
 		sb.WriteString("-with-force")
 	}
+	if j.dryRun() {
+		sb.WriteString("-dry-run")
+	}
 	return sb.String()
 }
 
+// dry-run: count (and list) what would be removed - remove nothing
+func (j *clnJ) dryRun() bool { return j.ini.Args.Flags&xact.XclnDryRun == xact.XclnDryRun }
+
+func (j *clnJ) wouldRm(what, fqn string, size int64) {
+	j.ini.Xaction.ObjsAdd(1, size)
+	nlog.Infoln(j.String(), "would remove", what, fqn, "size", size)
+}
+
 func (j *clnJ) stop() { j.stopCh <- struct{}{} }
 
 func (j *clnJ) run(providers []string) {
@@ -346,6 +357,9 @@ func (j *clnJ) jogBcks(bcks []cmn.Bck) (size int64, rerr error) {
 }
 
 func (j *clnJ) removeDeleted() (err error) {
+	if j.dryRun() {
+		return nil // 'deleted' is already gone from the user's perspective (not counted)
+	}
 	err = j.mi.RemoveDeleted(j.String())
 	if err != nil {
 		j.ini.Xaction.AddErr(err)
@@ -369,7 +383,7 @@ func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.ObjMDType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
@@ -431,6 +445,16 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 			return
 		}
 		j.oldWork = append(j.oldWork, fqn)
+	case fs.ObjMDType:
+		// custom metadata sidecars: remove those that outlived their objects
+		ct, err := core.NewCTFromFQN(fqn, core.T.Bowner())
+		if err != nil {
+			j.oldWork = append(j.oldWork, fqn)
+			return
+		}
+		if cos.Stat(ct.Clone(fs.ObjectType).FQN()) != nil {
+			j.oldWork = append(j.oldWork, fqn)
+		}
 	default:
 		debug.Assert(false, "Unsupported content type: ", parsedFQN.ContentType)
 	}
@@ -439,7 +463,6 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 // [TODO]
 // - add stats error counters (stats.ErrLmetaCorruptedCount, ...)
 // - revisit rm-ed byte counting
-// - dry-run (feature) with all to-be removed listed
 func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	if err := lom.InitFQN(fqn, &j.bck); err != nil {
 		nlog.Errorln(j.String(), "unexpected object fqn", fqn, err)
@@ -448,7 +471,7 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	}
 	// handle load err
 	if errLoad := lom.Load(false /*cache it*/, false /*locked*/); errLoad != nil {
-		_, atimefs, _, err := lom.Fstat(true /*get-atime*/)
+		size, atimefs, _, err := lom.Fstat(true /*get-atime*/)
 		if err != nil {
 			if !os.IsNotExist(err) {
 				err = os.NewSyscallError("stat", err)
@@ -461,6 +484,12 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		if atimefs+int64(j.config.LRU.DontEvictTime) > j.now {
 			return
 		}
+		if j.dryRun() {
+			if cmn.IsErrLmetaCorrupted(errLoad) || cmn.IsErrLmetaNotFound(errLoad) {
+				j.wouldRm("no-MD or MD-corrupted", lom.FQN, size)
+			}
+			return
+		}
 		if cmn.IsErrLmetaCorrupted(errLoad) {
 			if err := lom.RemoveMain(); err != nil {
 				nlog.Errorf("%s: failed to rm MD-corrupted %s: %v (nested: %v)", j, lom, errLoad, err)
@@ -491,19 +520,21 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		if lom.HasCopies() {
 			j.rmExtraCopies(lom)
 		}
-		if lom.Lsize() == 0 {
-			if j.ini.Args.Flags&xact.XrmZeroSize == xact.XrmZeroSize {
-				// remove in place
-				if ecode, err := core.T.DeleteObject(lom, false /*evict*/); err != nil {
-					nlog.Errorln("failed to remove zero size", lom.Cname(), "err: [", err, ecode, "]")
+		if lom.Lsize() == 0 && j.ini.Args.Flags&xact.XrmZeroSize == xact.XrmZeroSize {
+			if j.dryRun() {
+				j.wouldRm("zero size", lom.FQN, 0)
+				return
+			}
+			// remove in place
+			if ecode, err := core.T.DeleteObject(lom, false /*evict*/); err != nil {
+				nlog.Errorln("failed to remove zero size", lom.Cname(), "err: [", err, ecode, "]")
+			} else {
+				if lom.Bck().IsRemote() {
+					nlog.Warningln("removed zero size", lom.Cname(), "(both cluster and remote)")
 				} else {
-					if lom.Bck().IsRemote() {
-						nlog.Warningln("removed zero size", lom.Cname(), "(both cluster and remote)")
-					} else {
-						nlog.Warningln("removed zero size", lom.Cname())
-					}
-					j.ini.StatsT.Inc(stats.CleanupStoreCount)
+					nlog.Warningln("removed zero size", lom.Cname())
 				}
+				j.ini.StatsT.Inc(stats.CleanupStoreCount)
 			}
 		}
 		return
@@ -526,6 +557,10 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 }
 
 func (j *clnJ) rmExtraCopies(lom *core.LOM) {
+	if j.dryRun() {
+		j.dryExtraCopies(lom)
+		return
+	}
 	if !lom.TryLock(true) {
 		return // must be busy
 	}
@@ -552,6 +587,20 @@ func (j *clnJ) rmExtraCopies(lom *core.LOM) {
 	}
 }
 
+// (compare with lom.DelExtraCopies)
+func (j *clnJ) dryExtraCopies(lom *core.LOM) {
+	copies := lom.GetCopies()
+	for _, mi := range fs.GetAvail() {
+		copyFQN := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+		if _, ok := copies[copyFQN]; ok {
+			continue
+		}
+		if finfo, err := os.Stat(copyFQN); err == nil {
+			j.wouldRm("extra copy", copyFQN, finfo.Size())
+		}
+	}
+}
+
 func (j *clnJ) walk(fqn string, de fs.DirEntry) error {
 	if de.IsDir() {
 		j._rmEmptyDir(fqn)
@@ -576,6 +625,9 @@ func (j *clnJ) walk(fqn string, de fs.DirEntry) error {
 }
 
 func (j *clnJ) _rmEmptyDir(fqn string) {
+	if j.dryRun() {
+		return
+	}
 	base := filepath.Base(fqn)
 
 	if fs.LikelyCT(base) {
@@ -621,6 +673,10 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 	if cmn.Rom.FastV(4, cos.SmoduleSpace) {
 		nlog.Infof("%s: num-old %d, misplaced (%d, ec=%d)", j, len(j.oldWork), len(j.misplaced.loms), len(j.misplaced.ec))
 	}
+	if j.dryRun() {
+		j.dryLeftovers()
+		return 0, nil
+	}
 
 	// 1. rm older work
 	for _, workfqn := range j.oldWork {
@@ -694,6 +750,35 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 	return
 }
 
+func (j *clnJ) dryLeftovers() {
+	for _, workfqn := range j.oldWork {
+		if finfo, err := os.Stat(workfqn); err == nil {
+			j.wouldRm("old work", workfqn, finfo.Size())
+		}
+	}
+	j.oldWork = j.oldWork[:0]
+
+	if len(j.misplaced.loms) > 0 && j.p.rmMisplaced() {
+		for _, mlom := range j.misplaced.loms {
+			lom := core.AllocLOM(mlom.ObjName)
+			if lom.InitBck(&j.bck) != nil || lom.FromFS() != nil {
+				j.wouldRm("misplaced", mlom.FQN, mlom.Lsize(true /*not loaded*/))
+			} else if _, ok := lom.GetCopies()[mlom.FQN]; !ok {
+				j.wouldRm("misplaced", mlom.FQN, mlom.Lsize(true /*not loaded*/))
+			}
+			core.FreeLOM(lom)
+		}
+	}
+	j.misplaced.loms = j.misplaced.loms[:0]
+
+	for _, ct := range j.misplaced.ec {
+		if cos.Stat(fs.CSM.Gen(ct, fs.ECMetaType, "")) != nil {
+			j.wouldRm("misplaced EC", ct.FQN(), ct.Lsize())
+		}
+	}
+	j.misplaced.ec = j.misplaced.ec[:0]
+}
+
 func (j *clnJ) yieldTerm() error {
 	xcln := j.ini.Xaction
 	select {
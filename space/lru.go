@@ -15,6 +15,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -94,6 +95,7 @@ type (
 		mi      *fs.Mountpath
 		config  *cmn.Config
 		// runtime
+		confChanged atomic.Bool // (see cmn.GCO.Subscribe)
 		throttle    bool
 		allowDelObj bool
 	}
@@ -164,6 +166,19 @@ func RunLRU(ini *IniLRU) {
 	}
 	providers := apc.Providers.ToSlice()
 
+	// runtime changes of the LRU and space config take effect immediately
+	// (rather than upon the next capCheckThresh)
+	onChange := func(_, _ *cmn.Config) {
+		for _, j := range joggers {
+			j.confChanged.Store(true)
+		}
+	}
+	unsubLRU, unsubSpace := cmn.GCO.Subscribe("lru", onChange), cmn.GCO.Subscribe("space", onChange)
+	defer func() {
+		unsubLRU()
+		unsubSpace()
+	}()
+
 	for _, j := range joggers {
 		parent.wg.Add(1)
 		j.joggers = joggers
@@ -395,7 +410,7 @@ func (j *lruJ) postRemove(prev, size int64) (capCheck int64, err error) {
 	if err = j.yieldTerm(); err != nil {
 		return
 	}
-	if capCheck < capCheckThresh {
+	if capCheck < capCheckThresh && !j.confChanged.CAS(true, false) {
 		return
 	}
 	// init, recompute, and throttle - once per capCheckThresh
@@ -284,6 +284,40 @@ var _ = Describe("space evict/cleanup tests", func() {
 				Expect(len(files)).To(Equal(0))
 			})
 		})
+
+		Describe("cleanup dry-run", func() {
+			var ini *space.IniCln
+			BeforeEach(func() {
+				ini = newInitStoreCln()
+			})
+			It("should count and not remove", func() {
+				const size = 3 * cos.KiB
+				var (
+					noMD = path.Join(filesPath, "no-md")
+					zero = path.Join(filesPath, "zero-size")
+				)
+				saveRandomFiles(filesPath, 2)
+				Expect(os.WriteFile(noMD, make([]byte, size), cos.PermRWR)).NotTo(HaveOccurred())
+				saveRandomFile(zero, 0)
+
+				ini.Args.Flags = xact.XclnDryRun | xact.XrmZeroSize
+				space.RunCleanup(ini)
+
+				snap := ini.Xaction.Snap()
+				Expect(snap.Stats.Objs).To(Equal(int64(2)))
+				Expect(snap.Stats.Bytes).To(Equal(int64(size)))
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(4))
+
+				// and now for real
+				ini = newInitStoreCln()
+				ini.Args.Flags = xact.XrmZeroSize
+				space.RunCleanup(ini)
+
+				Expect(noMD).NotTo(BeAnExistingFile())
+			})
+		})
 	})
 })
 
@@ -389,7 +423,7 @@ func getRandomFileName(fileCounter int) string {
 }
 
 func saveRandomFile(filename string, size int64) {
-	buff := make([]byte, size)
+	buff := make([]byte, max(size, 1))
 	_, err := cos.SaveReader(filename, rand.Reader, buff, cos.ChecksumNone, size)
 	Expect(err).NotTo(HaveOccurred())
 	lom := &core.LOM{}
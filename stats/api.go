@@ -49,12 +49,14 @@ const (
 	VarlabXactKind  = "xkind"
 	VarlabXactID    = "xid"
 	VarlabMountpath = "mountpath"
+	VarlabETL       = "etl"
 )
 
 var (
 	BckVarlabs     = []string{VarlabBucket}
 	BckXactVarlabs = []string{VarlabBucket, VarlabXactKind, VarlabXactID}
 	MpathVarlabs   = []string{VarlabMountpath}
+	ETLVarlabs     = []string{VarlabETL}
 )
 
 type (
@@ -66,6 +68,7 @@ type (
 		PromHandler() http.Handler
 
 		Inc(metric string)
+		SetGauge(metric string, val int64)
 		IncWith(metric string, vlabs map[string]string)
 		IncBck(name string, bck *cmn.Bck)
 
@@ -115,6 +118,14 @@ type (
 		Reserved3   int64          `json:"reserved3,omitempty"`
 		Reserved4   int64          `json:"reserved4,omitempty"`
 	}
+
+	// cluster-wide alerts (apc.WhatNodeAlerts)
+	Alerts struct {
+		Snode *meta.Snode        `json:"snode"`
+		Err   string             `json:"err,omitempty"` // failed to reach the node
+		Flags cos.NodeStateFlags `json:"flags"`
+	}
+	ClusterAlerts map[string]*Alerts // by node ID
 )
 
 // [backward compatibility]: includes v3.22 cdf* structures
@@ -147,6 +158,9 @@ type (
 	}
 )
 
+// red alert (cos.NodeStateFlags.IsRed) or failure to respond
+func (a *Alerts) IsRed() bool { return a.Err != "" || a.Flags.IsRed() }
+
 func IsErrMetric(name string) bool {
 	return strings.HasPrefix(name, errPrefix) // e.g., "err.get.n"
 }
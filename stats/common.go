@@ -149,12 +149,21 @@ type (
 		sorted    []string    // sorted names
 		mem       sys.MemStat
 		next      int64 // mono.Nano
+		errRate   errRate
 		startedUp atomic.Bool
 	}
+	// error-rate spike (see `checkErrRate` and config.Periodic.ErrRate*)
+	errRate struct {
+		nerr, nops int64 // previous totals
+		last       int64 // mono time of the last spike
+	}
 )
 
 var ignoreIdle = [...]string{"kalive", Uptime, "disk."}
 
+// basic request counters, each with its (errPrefix + name) error counterpart
+var reqCountNames = [...]string{GetCount, PutCount, HeadCount, AppendCount, DeleteCount, RenameCount, ListCount}
+
 ////////////
 // runner //
 ////////////
@@ -321,6 +330,9 @@ func (r *runner) regCommon(snode *meta.Snode) {
 func (r *runner) Inc(name string)            { r.core.add(name, 1) }
 func (r *runner) Add(name string, val int64) { r.core.add(name, val) }
 
+// (KindGauge only; compare with Add)
+func (r *runner) SetGauge(name string, val int64) { r.core.set(name, val) }
+
 // (prometheus with variable labels)
 func (r *runner) AddWith(nvs ...cos.NamedVal64) {
 	for _, nv := range nvs {
@@ -492,6 +504,9 @@ waitStartup:
 				// clear
 				r.ClrFlag(NodeAlerts, cos.KeepAliveErrors)
 			}
+
+			// 5. error-rate alert
+			r.checkErrRate(now, &config.Periodic)
 		case <-r.stopCh:
 			r.ticker.Stop()
 			return nil
@@ -633,6 +648,60 @@ func (r *runner) checkNgr(now, lastNgr int64, goMaxProcs int) int64 {
 	return lastNgr
 }
 
+// compute the percentage of failed requests since the previous call
+// (see `reqCountNames`)
+func (r *runner) checkErrRate(now int64, config *cmn.PeriodConf) {
+	var nerr, nops int64
+	for _, name := range reqCountNames {
+		nops += r.Get(name)
+		nerr += r.Get(errPrefix + name)
+	}
+	derr, dops := nerr-r.errRate.nerr, nops-r.errRate.nops
+	switch r.errRate.check(now, nerr, nops, r.nodeStateFlags().IsSet(cos.HighErrorRate), config) {
+	case errRateRaise:
+		r.SetFlag(NodeAlerts, cos.HighErrorRate)
+		nlog.Warningln("High error rate:", derr, "errors vs", dops, "successful requests")
+	case errRateClear:
+		r.ClrFlag(NodeAlerts, cos.HighErrorRate)
+		nlog.Infoln("Error rate is now back to normal")
+	}
+}
+
+/////////////
+// errRate //
+/////////////
+
+const (
+	errRateNop = iota
+	errRateRaise
+	errRateClear
+)
+
+// given current totals, decide whether to raise or clear the alert
+func (e *errRate) check(now, nerr, nops int64, alerted bool, config *cmn.PeriodConf) int {
+	derr, dops := nerr-e.nerr, nops-e.nops
+	e.nerr, e.nops = nerr, nops
+	if derr < 0 || dops < 0 {
+		return errRateNop // (reset stats)
+	}
+	var (
+		high    = int64(cos.NonZero(config.ErrRateHigh, cmn.ErrRateHighDflt))
+		minErrs = cos.NonZero(config.ErrRateMinErrs, cmn.ErrRateMinErrsDflt)
+		clrTime = cos.NonZero(config.ErrRateClearTime.D(), cmn.ErrRateClearTimeDflt)
+	)
+	if derr >= minErrs && derr*100 >= (derr+dops)*high {
+		e.last = now
+		if !alerted {
+			return errRateRaise
+		}
+		return errRateNop
+	}
+	if alerted && time.Duration(now-e.last) > clrTime {
+		return errRateClear
+	}
+	return errRateNop
+}
+
 func (r *runner) Stop(err error) {
 	nlog.Infoln("Stopping", r.Name(), "err:", err)
 	r.stopCh <- struct{}{}
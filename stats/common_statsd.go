@@ -113,6 +113,12 @@ func (s *coreStats) add(name string, val int64) {
 	}
 }
 
+func (s *coreStats) set(name string, val int64) {
+	v, ok := s.Tracker[name]
+	debug.Assertf(ok && v.kind == KindGauge, "invalid gauge %q", name)
+	ratomic.StoreInt64(&v.Value, val)
+}
+
 func (s *coreStats) updateUptime(d time.Duration) {
 	v := s.Tracker[Uptime]
 	ratomic.StoreInt64(&v.Value, d.Nanoseconds())
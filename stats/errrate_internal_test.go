@@ -0,0 +1,81 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestErrRateDefaults(t *testing.T) {
+	var (
+		e        errRate
+		config   cmn.PeriodConf
+		nerr     int64
+		nops     int64
+		now      int64
+		interval = int64(10 * time.Second)
+	)
+	step := func(derr, dops int64, alerted bool) int {
+		nerr += derr
+		nops += dops
+		now += interval
+		return e.check(now, nerr, nops, alerted, &config)
+	}
+
+	// 5% (default) but fewer than 100 errors
+	tassert.Errorf(t, step(99, 1, false) == errRateNop, "expected no alert below min errors")
+	// 100 errors, less than 5%
+	tassert.Errorf(t, step(100, 2000, false) == errRateNop, "expected no alert below 5%%")
+	// 100 errors, 5%
+	tassert.Errorf(t, step(100, 1900, false) == errRateRaise, "expected alert")
+	tassert.Errorf(t, step(1000, 0, true) == errRateNop, "expected no change (already raised)")
+
+	// back to normal but not long enough
+	for range int(cmn.ErrRateClearTimeDflt) / int(interval) {
+		tassert.Errorf(t, step(0, 1000, true) == errRateNop, "expected alert to persist")
+	}
+	tassert.Errorf(t, step(0, 1000, true) == errRateClear, "expected alert to clear")
+	tassert.Errorf(t, step(0, 1000, false) == errRateNop, "expected no change (already cleared)")
+
+	// stats reset
+	nerr, nops = 0, 0
+	tassert.Errorf(t, step(0, 0, false) == errRateNop, "expected no alert upon reset")
+	tassert.Errorf(t, e.nerr == 0 && e.nops == 0, "expected totals to follow the reset, got %d, %d", e.nerr, e.nops)
+}
+
+func TestErrRateConfig(t *testing.T) {
+	var (
+		e      errRate
+		config = cmn.PeriodConf{
+			StatsTime:     cos.Duration(10 * time.Second),
+			RetrySyncTime: cos.Duration(time.Second),
+			NotifTime:     cos.Duration(30 * time.Second),
+
+			ErrRateHigh:      50,
+			ErrRateMinErrs:   10,
+			ErrRateClearTime: cos.Duration(time.Minute),
+		}
+	)
+	tassert.CheckFatal(t, config.Validate())
+
+	tassert.Errorf(t, e.check(1, 9, 0, false, &config) == errRateNop, "expected no alert below (configured) min errors")
+	tassert.Errorf(t, e.check(2, 19, 20, false, &config) == errRateNop, "expected no alert below 50%%")
+	tassert.Errorf(t, e.check(3, 29, 30, false, &config) == errRateRaise, "expected alert at 50%%")
+
+	now := int64(3)
+	tassert.Errorf(t, e.check(now+int64(time.Minute), 29, 100, true, &config) == errRateNop, "expected alert to persist")
+	tassert.Errorf(t, e.check(now+int64(time.Minute)+1, 29, 200, true, &config) == errRateClear, "expected alert to clear")
+
+	for _, bad := range []cmn.PeriodConf{{ErrRateHigh: 101}, {ErrRateHigh: -1}, {ErrRateMinErrs: -1}, {ErrRateClearTime: -1}} {
+		bad.StatsTime, bad.RetrySyncTime, bad.NotifTime = config.StatsTime, config.RetrySyncTime, config.NotifTime
+		tassert.Errorf(t, bad.Validate() != nil, "expected validation error for %+v", bad)
+	}
+}
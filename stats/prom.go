@@ -94,6 +94,16 @@ func (s *coreStats) add(name string, val int64) {
 	v.iadd.add(v, val)
 }
 
+func (s *coreStats) set(name string, val int64) {
+	v, ok := s.Tracker[name]
+	debug.Assertf(ok && v.kind == KindGauge, "invalid gauge %q", name)
+
+	ratomic.StoreInt64(&v.Value, val)
+	vprom, ok := v.iadd.(gauge)
+	debug.Assert(ok, name)
+	vprom.Set(float64(val))
+}
+
 func (s *coreStats) addWith(nv cos.NamedVal64) {
 	v, ok := s.Tracker[nv.Name]
 	debug.Assertf(ok, "invalid metric name %q", nv.Name)
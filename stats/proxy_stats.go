@@ -14,9 +14,21 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 )
 
-const numProxyStats = 24 // approx. initial
+const numProxyStats = 32 // approx. initial
+
+// proxy-only: API request admission (see ais/prxadm.go and config.Proxy.Admission)
+const (
+	AdmListInflight = "adm.lst.inflight" // KindGauge
+	AdmListQueued   = "adm.lst.queued"   // ditto
+	AdmListShed     = "adm.lst.shed.n"   // KindCounter
+
+	AdmObjInflight = "adm.obj.inflight"
+	AdmObjQueued   = "adm.obj.queued"
+	AdmObjShed     = "adm.obj.shed.n"
+)
 
 // NOTE: currently, proxy's stats == common and hardcoded
 
@@ -43,6 +55,7 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	r.core.init(numProxyStats)
 
 	r.regCommon(p.Snode()) // common metrics
+	r.regAdm(p.Snode())
 
 	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
@@ -56,6 +69,25 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	return &r.runner.startedUp
 }
 
+func (r *Prunner) regAdm(snode *meta.Snode) {
+	for _, class := range []struct {
+		inflight, queued, shed, what string
+	}{
+		{AdmListInflight, AdmListQueued, AdmListShed, "list-objects and other bucket GET"},
+		{AdmObjInflight, AdmObjQueued, AdmObjShed, "object"},
+	} {
+		r.reg(snode, class.inflight, KindGauge,
+			&Extra{Help: "number of admitted " + class.what + " requests currently executing (see proxy.admission config)"},
+		)
+		r.reg(snode, class.queued, KindGauge,
+			&Extra{Help: "number of " + class.what + " requests waiting to be admitted (queue depth)"},
+		)
+		r.reg(snode, class.shed, KindCounter,
+			&Extra{Help: "total number of " + class.what + " requests rejected with 503 (queue full or queue timeout)"},
+		)
+	}
+}
+
 //
 // statsLogger interface impl
 //
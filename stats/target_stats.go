@@ -47,6 +47,14 @@ const (
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
 
+	// hedged reads (see cmn.HedgeConf)
+	HedgeCount    = "hedge.n"     // number of hedged (alternative source) cold GETs
+	HedgeWinCount = "hedge.win.n" // number of times the alternative source responded first
+
+	// read-ahead (see cmn.ReadAheadConf)
+	ReadAheadCount    = "read_ahead.n"     // number of objects prefetched upon detecting sequential access
+	ReadAheadHitCount = "read_ahead.hit.n" // number of GETs served from read-ahead
+
 	// errors
 	ErrPutCksumCount = errPrefix + "put.cksum.n"
 
@@ -77,7 +85,21 @@ const (
 	DsortExtractShardSize    = "dsort.extract.shard.size" // uncompressed
 
 	// Downloader
-	DloadSize = "dl.size"
+	DloadSize              = "dl.size"
+	DloadQueueDepth        = "dl.queue.depth" // KindGauge (per mountpath)
+	DloadBackpressureCount = "dl.backpressure.n"
+	DloadRerouteCount      = "dl.reroute.n"
+
+	// ETL (per ETL instance - see VarlabETL)
+	ETLInlineCount         = "etl.inline.n"
+	ETLInlineSize          = "etl.inline.size"
+	ETLInlineLatencyTotal  = "etl.inline.ns.total"
+	ETLInlineFallbackCount = "etl.inline.fallback.n"
+	ETLOfflineCount        = "etl.offline.n"
+	ETLOfflineSize         = "etl.offline.size"
+	ETLOfflineLatencyTotal = "etl.offline.ns.total"
+	ErrETLInlineCount      = errPrefix + ETLInlineCount
+	ErrETLOfflineCount     = errPrefix + ETLOfflineCount
 
 	// KindThroughput
 	GetThroughput = "get.bps" // bytes per second
@@ -95,6 +117,11 @@ const (
 	LcacheErrCount       = core.LcacheErrCount
 	LcacheFlushColdCount = core.LcacheFlushColdCount
 
+	NumaPinnedCount          = core.NumaPinnedCount
+	NumaPinnedLatencyTotal   = core.NumaPinnedLatencyTotal
+	NumaUnpinnedCount        = core.NumaUnpinnedCount
+	NumaUnpinnedLatencyTotal = core.NumaUnpinnedLatencyTotal
+
 	// variable label used for prometheus disk metrics
 	diskMetricLabel = "disk"
 )
@@ -280,6 +307,33 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckVarlabs,
 		},
 	)
+	// hedged reads
+	r.reg(snode, HedgeCount, KindCounter,
+		&Extra{
+			Help:    "number of hedged cold GETs, i.e., cold GETs that were raced against an alternative source",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, HedgeWinCount, KindCounter,
+		&Extra{
+			Help:    "number of hedged cold GETs where the alternative source responded first",
+			VarLabs: BckVarlabs,
+		},
+	)
+	// read-ahead
+	r.reg(snode, ReadAheadCount, KindCounter,
+		&Extra{
+			Help:    "number of objects prefetched from remote backend upon detecting sequential access",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ReadAheadHitCount, KindCounter,
+		&Extra{
+			Help:    "number of GETs of the objects prefetched by read-ahead",
+			VarLabs: BckVarlabs,
+		},
+	)
+
 	r.reg(snode, RemoteDeletedDelCount, KindCounter,
 		&Extra{
 			Help:    "number of out-of-band deletes (by a 3rd party remote DELETE(object) from outside this cluster)",
@@ -429,6 +483,80 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckVarlabs,
 		},
 	)
+	r.reg(snode, DloadQueueDepth, KindGauge,
+		&Extra{
+			Help:    "downloader: number of tasks currently queued to the mountpath (jogger)",
+			VarLabs: MpathVarlabs,
+		},
+	)
+	r.reg(snode, DloadBackpressureCount, KindCounter,
+		&Extra{
+			Help:    "downloader: number of times a task could not be queued to its mountpath (jogger) because the queue was full",
+			VarLabs: MpathVarlabs,
+		},
+	)
+	r.reg(snode, DloadRerouteCount, KindCounter,
+		&Extra{
+			Help:    "downloader: number of tasks routed around a full mountpath (jogger) queue and executed by another jogger",
+			VarLabs: MpathVarlabs,
+		},
+	)
+
+	// ETL
+	r.reg(snode, ETLInlineCount, KindCounter,
+		&Extra{
+			Help:    "ETL: number of objects transformed inline (on the fly, as part of GET)",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ETLInlineSize, KindSize,
+		&Extra{
+			Help:    "ETL: total cumulative size (bytes) of source objects transformed inline",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ETLInlineLatencyTotal, KindTotal,
+		&Extra{
+			Help:    "ETL: total cumulative time (nanoseconds) of inline transformations",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ErrETLInlineCount, KindCounter,
+		&Extra{
+			Help:    "ETL: number of failed inline transformations",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ETLInlineFallbackCount, KindCounter,
+		&Extra{
+			Help:    "ETL: number of GETs that served the original object when the transformer was unavailable (bucket property etl.on_error)",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ETLOfflineCount, KindCounter,
+		&Extra{
+			Help:    "ETL: number of objects transformed offline (bucket-to-bucket and multi-object)",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ETLOfflineSize, KindSize,
+		&Extra{
+			Help:    "ETL: total cumulative size (bytes) of objects produced by offline transformations",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ETLOfflineLatencyTotal, KindTotal,
+		&Extra{
+			Help:    "ETL: total cumulative time (nanoseconds) to receive offline-transformed objects (time to first byte)",
+			VarLabs: ETLVarlabs,
+		},
+	)
+	r.reg(snode, ErrETLOfflineCount, KindCounter,
+		&Extra{
+			Help:    "ETL: number of failed offline transformations",
+			VarLabs: ETLVarlabs,
+		},
+	)
 
 	// dsort
 	r.reg(snode, DsortCreationReqCount, KindCounter,
@@ -478,6 +606,26 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "number of times a LOM from cache was written to stable storage (core, internal)",
 		},
 	)
+	r.reg(snode, NumaPinnedCount, KindCounter,
+		&Extra{
+			Help: "NUMA: number of objects processed by per-mountpath workers and joggers pinned to their NUMA node",
+		},
+	)
+	r.reg(snode, NumaPinnedLatencyTotal, KindTotal,
+		&Extra{
+			Help: "NUMA: total cumulative time (nanoseconds) to process objects by pinned workers and joggers",
+		},
+	)
+	r.reg(snode, NumaUnpinnedCount, KindCounter,
+		&Extra{
+			Help: "NUMA: number of objects processed by unpinned per-mountpath workers and joggers (multi-node hosts only)",
+		},
+	)
+	r.reg(snode, NumaUnpinnedLatencyTotal, KindTotal,
+		&Extra{
+			Help: "NUMA: total cumulative time (nanoseconds) to process objects by unpinned workers and joggers",
+		},
+	)
 }
 
 func (r *Trunner) RegDiskMetrics(snode *meta.Snode, disk string) {
@@ -644,7 +792,10 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 		clr |= cos.NodeRestarted
 	}
 
-	// 7. separately, memory and CPU alerts
+	// 7. mountpaths (degraded or none)
+	set, clr = r._mpaths(set, clr)
+
+	// 8. separately, memory and CPU alerts
 	r._memload(r.t.PageMM(), set, clr)
 }
 
@@ -706,6 +857,22 @@ func (r *Trunner) _cap(config *cmn.Config, now int64, verbose bool) (set, clr co
 	return set, clr
 }
 
+// degraded (some mountpaths disabled) or no mountpaths at all
+func (r *Trunner) _mpaths(set, clr cos.NodeStateFlags) (cos.NodeStateFlags, cos.NodeStateFlags) {
+	avail, disabled := fs.Get()
+	switch {
+	case len(avail) == 0:
+		set |= cos.NoMountpaths
+		clr |= cos.DegradedMountpath
+	case len(disabled) > 0:
+		set |= cos.DegradedMountpath
+		clr |= cos.NoMountpaths
+	default:
+		clr |= cos.NoMountpaths | cos.DegradedMountpath
+	}
+	return set, clr
+}
+
 func (r *Trunner) logCapacity(now int64) {
 	fast := fs.NoneShared(len(r.Tcdf.Mountpaths))
 	unique := fast // and vice versa
@@ -0,0 +1,71 @@
+// Package sys provides methods to read system information
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package sys
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// NUMA topology and thread pinning (Linux only; elsewhere, NumaNodes() returns nil)
+
+type NumaNode struct {
+	CPUs []int
+	ID   int
+}
+
+var numa struct {
+	nodes []NumaNode
+	once  sync.Once
+}
+
+// returns NUMA nodes sorted by ID, or nil when the topology is not available
+func NumaNodes() []NumaNode {
+	numa.once.Do(func() { numa.nodes = readNumaNodes() })
+	return numa.nodes
+}
+
+// more than one NUMA node with CPUs
+func IsNUMA() bool { return len(NumaNodes()) > 1 }
+
+func NumaNodeByID(id int) *NumaNode {
+	nodes := NumaNodes()
+	for i := range nodes {
+		if nodes[i].ID == id {
+			return &nodes[i]
+		}
+	}
+	return nil
+}
+
+// parses Linux cpulist format, e.g. "0-3,8,10-11"
+func ParseCPUList(s string) ([]int, error) {
+	var cpus []int
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		lo, hi, isRange := strings.Cut(part, "-")
+		first, err := strconv.Atoi(lo)
+		if err != nil || first < 0 {
+			return nil, fmt.Errorf("invalid cpulist %q", s)
+		}
+		last := first
+		if isRange {
+			if last, err = strconv.Atoi(hi); err != nil || last < first {
+				return nil, fmt.Errorf("invalid cpulist %q", s)
+			}
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
@@ -0,0 +1,15 @@
+// Package sys provides methods to read system information
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package sys
+
+import "errors"
+
+func readNumaNodes() []NumaNode { return nil }
+
+func DiskNumaNode(string) int { return -1 }
+
+func NumaPin(*NumaNode) (func(), error) {
+	return nil, errors.New("NUMA pinning is not supported")
+}
@@ -0,0 +1,88 @@
+// Package sys provides methods to read system information
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package sys
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	numaNodesPath = "/sys/devices/system/node/"
+	blockDevPath  = "/sys/class/block/"
+)
+
+func readNumaNodes() (nodes []NumaNode) {
+	dirents, err := os.ReadDir(numaNodesPath)
+	if err != nil {
+		return nil
+	}
+	for _, de := range dirents {
+		name := de.Name()
+		if !strings.HasPrefix(name, "node") {
+			continue
+		}
+		id, err := strconv.Atoi(name[len("node"):])
+		if err != nil {
+			continue
+		}
+		line, err := cos.ReadOneLine(filepath.Join(numaNodesPath, name, "cpulist"))
+		if err != nil {
+			continue
+		}
+		cpus, err := ParseCPUList(line)
+		if err != nil || len(cpus) == 0 { // (memory-only node)
+			continue
+		}
+		nodes = append(nodes, NumaNode{ID: id, CPUs: cpus})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+// NUMA node of the block device's controller, or -1 if unknown
+// (partitions and NVMe namespaces resolve via their parent device)
+func DiskNumaNode(disk string) int {
+	for _, sub := range []string{"device/numa_node", "device/device/numa_node", "../device/numa_node"} {
+		if id, err := cos.ReadOneInt64(filepath.Join(blockDevPath, disk, sub)); err == nil && id >= 0 {
+			return int(id)
+		}
+	}
+	return -1
+}
+
+// NumaPin locks the calling goroutine to its current OS thread and restricts
+// the thread to the CPUs of the given NUMA node. The returned unpin restores
+// the original affinity and unlocks the thread.
+func NumaPin(node *NumaNode) (unpin func(), err error) {
+	var prev, set unix.CPUSet
+	runtime.LockOSThread()
+	if err = unix.SchedGetaffinity(0, &prev); err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+	for _, cpu := range node.CPUs {
+		set.Set(cpu)
+	}
+	if err = unix.SchedSetaffinity(0, &set); err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+	unpin = func() {
+		// failing to restore, keep the thread locked - the runtime will terminate it
+		// when the goroutine exits
+		if unix.SchedSetaffinity(0, &prev) == nil {
+			runtime.UnlockOSThread()
+		}
+	}
+	return unpin, nil
+}
@@ -113,3 +113,20 @@ func TestProc(t *testing.T) {
 	tassert.Errorf(t, newStats.CPU.Percent > 0.0, "Process must use some CPU. Usage: %g", stats.CPU.Percent)
 	t.Logf("Process CPU usage: %6.2f%%", newStats.CPU.Percent)
 }
+
+func TestParseCPUList(t *testing.T) {
+	cpus, err := sys.ParseCPUList("0-3,8,10-11\n")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(cpus) == 7 && cpus[0] == 0 && cpus[3] == 3 && cpus[4] == 8 && cpus[6] == 11, "unexpected %v", cpus)
+
+	cpus, err = sys.ParseCPUList("")
+	tassert.Errorf(t, err == nil && len(cpus) == 0, "unexpected %v, %v", cpus, err)
+
+	for _, bad := range []string{"a", "3-1", "1,,2", "-1"} {
+		_, err := sys.ParseCPUList(bad)
+		tassert.Errorf(t, err != nil, "expected error for %q", bad)
+	}
+	for _, node := range sys.NumaNodes() {
+		t.Logf("NUMA node %d: %d CPUs", node.ID, len(node.CPUs))
+	}
+}
@@ -0,0 +1,258 @@
+// Package tools provides common tools and utilities for all unit and integration tests
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/tools/readers"
+	"golang.org/x/sync/errgroup"
+)
+
+// Deterministic synthetic bucket populator:
+// - the same PopulateArgs always produce the same object names, sizes, and content;
+// - the resulting manifest (object name => size and checksum) can be saved, loaded,
+//   and used to validate the bucket after rebalance, EC recovery, restore, etc.
+
+// size distributions
+const (
+	DistFixed   = "fixed"   // all objects have MaxSize
+	DistUniform = "uniform" // uniform in [MinSize, MaxSize]
+	DistSkewed  = "skewed"  // log-uniform in [MinSize, MaxSize]: many small, few large
+)
+
+const maxValidateErrs = 16 // max number of validation errors to report
+
+type (
+	PopulateArgs struct {
+		Bck       cmn.Bck
+		Prefix    string // object name prefix (virtual directory)
+		Dist      string // DistFixed, etc.; default: DistUniform
+		CksumType string // default: xxhash
+		Seed      uint64
+		MinSize   int64
+		MaxSize   int64
+		Count     int
+		Workers   int // default: 16
+	}
+	ManifestEntry struct {
+		Size  int64  `json:"size,string"`
+		Cksum string `json:"cksum"`
+		Seed  int64  `json:"seed,string"` // content seed (see readers.NewRandSeeded)
+	}
+	Manifest struct {
+		Bck       cmn.Bck                  `json:"bck"`
+		CksumType string                   `json:"cksum_type"`
+		Objects   map[string]ManifestEntry `json:"objects"`
+		Seed      uint64                   `json:"seed,string"`
+	}
+)
+
+//////////////////
+// PopulateArgs //
+//////////////////
+
+func (args *PopulateArgs) validate() error {
+	if args.Count <= 0 {
+		return fmt.Errorf("invalid object count %d", args.Count)
+	}
+	if args.MaxSize <= 0 || args.MinSize < 0 || args.MinSize > args.MaxSize {
+		return fmt.Errorf("invalid size range [%d, %d]", args.MinSize, args.MaxSize)
+	}
+	switch args.Dist {
+	case "":
+		args.Dist = DistUniform
+	case DistFixed, DistUniform, DistSkewed:
+	default:
+		return fmt.Errorf("invalid size distribution %q", args.Dist)
+	}
+	if args.CksumType == "" || args.CksumType == cos.ChecksumNone {
+		args.CksumType = cos.ChecksumXXHash
+	}
+	if args.Workers <= 0 {
+		args.Workers = 16
+	}
+	return nil
+}
+
+func (args *PopulateArgs) size(rnd *rand.Rand) int64 {
+	switch args.Dist {
+	case DistFixed:
+		return args.MaxSize
+	case DistSkewed:
+		lo := float64(max(args.MinSize, 1))
+		return min(int64(lo*math.Pow(float64(args.MaxSize)/lo, rnd.Float64())), args.MaxSize)
+	default:
+		return args.MinSize + rnd.Int64N(args.MaxSize-args.MinSize+1)
+	}
+}
+
+// GenManifest computes object names, sizes, content seeds, and checksums without
+// writing anything (same args => same manifest)
+func GenManifest(args *PopulateArgs) (*Manifest, error) {
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	var (
+		m = &Manifest{
+			Bck:       args.Bck,
+			CksumType: args.CksumType,
+			Seed:      args.Seed,
+			Objects:   make(map[string]ManifestEntry, args.Count),
+		}
+		rnd   = rand.New(cos.NewRandSource(args.Seed))
+		width = len(fmt.Sprint(args.Count - 1))
+	)
+	for i := range args.Count {
+		var (
+			name = path.Join(args.Prefix, fmt.Sprintf("obj-%0*d", width, i))
+			e    = ManifestEntry{Size: args.size(rnd), Seed: rnd.Int64()}
+		)
+		r, err := readers.NewRandSeeded(e.Seed, e.Size, args.CksumType)
+		if err != nil {
+			return nil, err
+		}
+		e.Cksum = r.Cksum().Value()
+		m.Objects[name] = e
+	}
+	return m, nil
+}
+
+// Populate writes objects as per GenManifest and returns the manifest
+func Populate(bp api.BaseParams, args *PopulateArgs) (*Manifest, error) {
+	m, err := GenManifest(args)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		group errgroup.Group
+		names = m.Names()
+	)
+	group.SetLimit(args.Workers)
+	for _, name := range names {
+		e := m.Objects[name]
+		group.Go(func() error {
+			r, err := readers.NewRandSeeded(e.Seed, e.Size, m.CksumType)
+			if err != nil {
+				return err
+			}
+			_, err = api.PutObject(&api.PutArgs{
+				BaseParams: bp,
+				Bck:        m.Bck,
+				ObjName:    name,
+				Cksum:      r.Cksum(),
+				Reader:     r,
+				Size:       uint64(e.Size),
+				SkipVC:     true,
+			})
+			return err
+		})
+	}
+	return m, group.Wait()
+}
+
+//////////////
+// Manifest //
+//////////////
+
+func (m *Manifest) Names() []string {
+	names := make([]string, 0, len(m.Objects))
+	for name := range m.Objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *Manifest) Save(fqn string) error { return jsp.Save(fqn, m, jsp.Plain(), nil) }
+
+func LoadManifest(fqn string) (*Manifest, error) {
+	m := &Manifest{}
+	if _, err := jsp.Load(fqn, m, jsp.Plain()); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate reads all manifest objects from the cluster and checks their sizes and checksums;
+// returns an error describing (up to maxValidateErrs) missing or corrupted objects
+func (m *Manifest) Validate(bp api.BaseParams, workers int) error {
+	var (
+		group errgroup.Group
+		errCh = make(chan error, len(m.Objects))
+	)
+	group.SetLimit(max(workers, 1))
+	for _, name := range m.Names() {
+		e := m.Objects[name]
+		group.Go(func() error {
+			if err := m.validateObj(bp, name, &e); err != nil {
+				errCh <- err
+			}
+			return nil
+		})
+	}
+	group.Wait()
+	close(errCh)
+
+	errs := make([]string, 0, maxValidateErrs)
+	var n int
+	for err := range errCh {
+		if n < maxValidateErrs {
+			errs = append(errs, err.Error())
+		}
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	sort.Strings(errs)
+	return fmt.Errorf("%s: %d (out of %d) objects failed validation:\n%s",
+		m.Bck.Cname(""), n, len(m.Objects), strings.Join(errs, "\n"))
+}
+
+func (m *Manifest) validateObj(bp api.BaseParams, name string, e *ManifestEntry) error {
+	cksum := cos.NewCksumHash(m.CksumType)
+	oah, err := api.GetObject(bp, m.Bck, name, &api.GetArgs{Writer: cksum.H})
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	if size := oah.Size(); size != e.Size {
+		return fmt.Errorf("%s: size %d != %d", name, size, e.Size)
+	}
+	cksum.Finalize()
+	if cksum.Value() != e.Cksum {
+		return fmt.Errorf("%s: %s checksum %s != %s", name, m.CksumType, cksum.Value(), e.Cksum)
+	}
+	return nil
+}
+
+// (compare with validateObj)
+func (m *Manifest) Check(name string, r io.Reader) error {
+	e, ok := m.Objects[name]
+	if !ok {
+		return errors.New(name + ": not in the manifest")
+	}
+	cksum := cos.NewCksumHash(m.CksumType)
+	size, err := io.Copy(cksum.H, r)
+	if err != nil {
+		return err
+	}
+	cksum.Finalize()
+	if size != e.Size || cksum.Value() != e.Cksum {
+		return fmt.Errorf("%s: (size %d, %s) != (size %d, %s)", name, size, cksum.Value(), e.Size, e.Cksum)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Package tools provides common tools and utilities for all unit and integration tests
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tools_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/readers"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestGenManifest(t *testing.T) {
+	for _, dist := range []string{tools.DistFixed, tools.DistUniform, tools.DistSkewed} {
+		t.Run(dist, func(t *testing.T) {
+			args := tools.PopulateArgs{
+				Bck:     cmn.Bck{Name: "populate", Provider: apc.AIS},
+				Prefix:  "dir",
+				Dist:    dist,
+				Seed:    1234,
+				MinSize: cos.KiB,
+				MaxSize: 64 * cos.KiB,
+				Count:   100,
+			}
+			m1, err := tools.GenManifest(&args)
+			tassert.CheckFatal(t, err)
+			m2, err := tools.GenManifest(&args)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, reflect.DeepEqual(m1, m2), "expecting deterministic manifest")
+			tassert.Fatalf(t, len(m1.Objects) == args.Count, "expected %d objects, got %d", args.Count, len(m1.Objects))
+
+			for name, e := range m1.Objects {
+				tassert.Errorf(t, strings.HasPrefix(name, "dir/obj-"), "unexpected name %q", name)
+				tassert.Errorf(t, e.Size >= args.MinSize && e.Size <= args.MaxSize, "%s: size %d out of range", name, e.Size)
+				if dist == tools.DistFixed {
+					tassert.Errorf(t, e.Size == args.MaxSize, "%s: expected fixed size, got %d", name, e.Size)
+				}
+				// content is reproducible from the manifest alone
+				r, err := readers.NewRandSeeded(e.Seed, e.Size, cos.ChecksumNone)
+				tassert.CheckFatal(t, err)
+				tassert.CheckError(t, m1.Check(name, r))
+			}
+
+			args.Seed++
+			m3, err := tools.GenManifest(&args)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, !reflect.DeepEqual(m1.Objects, m3.Objects), "expecting different seeds to produce different manifests")
+		})
+	}
+}
+
+func TestManifestSaveLoad(t *testing.T) {
+	args := tools.PopulateArgs{Bck: cmn.Bck{Name: "populate", Provider: apc.AIS}, Seed: 7, MaxSize: cos.KiB, Count: 10}
+	m, err := tools.GenManifest(&args)
+	tassert.CheckFatal(t, err)
+
+	fqn := filepath.Join(t.TempDir(), "manifest.json")
+	tassert.CheckFatal(t, m.Save(fqn))
+	loaded, err := tools.LoadManifest(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, reflect.DeepEqual(m, loaded), "manifest changed after save/load")
+}
+
+func TestPopulateValidate(t *testing.T) {
+	var (
+		objs sync.Map // URL path => content
+		srv  = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				b, err := io.ReadAll(r.Body)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				objs.Store(r.URL.Path, b)
+			case http.MethodGet:
+				v, ok := objs.Load(r.URL.Path)
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				b := v.([]byte)
+				w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
+				w.Write(b)
+			}
+		}))
+	)
+	defer srv.Close()
+
+	bp := api.BaseParams{Client: http.DefaultClient, URL: srv.URL}
+	args := tools.PopulateArgs{
+		Bck:     cmn.Bck{Name: "populate", Provider: apc.AIS},
+		Dist:    tools.DistSkewed,
+		Seed:    42,
+		MinSize: 1,
+		MaxSize: 256 * cos.KiB,
+		Count:   50,
+		Workers: 4,
+	}
+	m, err := tools.Populate(bp, &args)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, m.Validate(bp, 4))
+
+	// corrupt one object, remove another
+	names := m.Names()
+	objs.Range(func(k, v any) bool {
+		path := k.(string)
+		switch {
+		case strings.HasSuffix(path, names[0]):
+			b := v.([]byte)
+			b[0] ^= 0xff
+		case strings.HasSuffix(path, names[1]):
+			objs.Delete(k)
+		}
+		return true
+	})
+	err = m.Validate(bp, 4)
+	tassert.Fatalf(t, err != nil, "expected validation to fail")
+	tassert.Errorf(t, strings.Contains(err.Error(), "2 (out of 50)"), "unexpected error: %v", err)
+}
@@ -94,10 +94,12 @@ var (
 ////////////////
 
 func NewRand(size int64, cksumType string) (Reader, error) {
-	var (
-		cksum *cos.Cksum
-		seed  = mono.NanoTime()
-	)
+	return NewRandSeeded(mono.NanoTime(), size, cksumType)
+}
+
+// same as above with a given seed: same seed and size always produce the same content
+func NewRandSeeded(seed, size int64, cksumType string) (Reader, error) {
+	var cksum *cos.Cksum
 	rand1 := newSeededReader(uint64(seed))
 	if cksumType != cos.ChecksumNone {
 		rr := &rrLimited{rand1, size, 0}
@@ -31,6 +31,7 @@ import (
 const (
 	opcFin = iota + math.MaxUint16 - 16
 	opcIdleTick
+	OpcBatch // many small objects packed into a single transport object (see batch.go)
 )
 
 func ReservedOpcode(opc int) bool { return opc >= opcFin }
@@ -57,6 +58,7 @@ type (
 		SizePDU      int32         // NOTE: 0(zero): no PDUs; must be below maxSizePDU; unknown size _requires_ PDUs
 		MaxHdrSize   int32         // overrides config.Transport.MaxHeaderSize
 		ChanBurst    int           // overrides config.Transport.Burst
+		QoS          int           // QoS class (QoSUser, QoSRebalance, etc. enum); by default, determined by xaction kind
 	}
 
 	// receive-side session stats indexed by session ID (see recv.go for "uid")
@@ -99,6 +99,7 @@ type (
 		numCur   int64        // gets reset to zero upon each timeout
 		sizeCur  int64        // ditto
 		chanFull atomic.Int64
+		qos      int // QoS class
 	}
 )
 
@@ -127,6 +128,7 @@ func newBase(client Client, dstURL, dstID string, extra *Extra) (s *streamBase)
 		s.xctn = extra.Xact
 		sid = "-" + extra.Xact.ID()
 	}
+	s.qos = qosClassOf(extra)
 	// NOTE: PDU-based traffic - a MUST-have for "unsized" transmissions
 	if extra.UsePDU() {
 		if extra.SizePDU > maxSizePDU {
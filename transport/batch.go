@@ -0,0 +1,135 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Small-object batching: to amortize per-object overhead, a sender packs multiple small objects
+// into a single transport object with `OpcBatch` opcode. Each packed object is serialized
+// exactly like a standalone one: protocol header, object header, and data:
+//
+// | proto-hdr (16) | obj-hdr (hlen) | data (obj size) | proto-hdr | obj-hdr | data | ...
+//
+// On the receive side, `RecvBatch` unpacks the objects and delivers them one by one
+// via the regular `RecvObj` callback. See also: bundle.DataMover.
+
+type (
+	Batch struct {
+		sgl  *memsys.SGL
+		hbuf []byte
+		objs []*Obj // packed objects, to invoke their respective completion callbacks
+	}
+	// counts bytes consumed by the receiver
+	batchReader struct {
+		r   io.Reader
+		off int64
+	}
+)
+
+// maxHdrSize: zero defaults to config.Transport.MaxHeaderSize (compare w/ Extra.MaxHdrSize)
+func NewBatch(sgl *memsys.SGL, config *cmn.Config, maxHdrSize int32) *Batch {
+	return &Batch{sgl: sgl, hbuf: make([]byte, _sizeHdr(config, int64(maxHdrSize)))}
+}
+
+// Add packs the object; data must be exactly `obj.Size()` bytes
+func (b *Batch) Add(obj *Obj, data []byte) {
+	debug.Assert(int64(len(data)) == obj.Size(), len(data), " vs ", obj.Size())
+	off := insObjHeader(b.hbuf, &obj.Hdr, false /*usePDU*/)
+	b.sgl.Write(b.hbuf[:off])
+	b.sgl.Write(data)
+	b.objs = append(b.objs, obj)
+}
+
+func (b *Batch) Num() int    { return len(b.objs) }
+func (b *Batch) Size() int64 { return b.sgl.Len() }
+
+// Obj returns transport object to send the batch; its completion callback
+// calls back all packed objects and frees the batch
+func (b *Batch) Obj() (*Obj, cos.ReadOpenCloser) {
+	obj := AllocSend()
+	obj.Hdr.Opcode = OpcBatch
+	obj.Hdr.ObjAttrs.Size = b.sgl.Len()
+	obj.Callback = b.cmpl
+	return obj, memsys.NewReader(b.sgl)
+}
+
+func (b *Batch) cmpl(_ *ObjHdr, _ io.ReadCloser, _ any, err error) {
+	b.Cleanup(err)
+}
+
+// Cleanup calls back all packed objects (with a given error, if any) and frees the batch
+func (b *Batch) Cleanup(err error) {
+	for _, obj := range b.objs {
+		if obj.Callback != nil {
+			obj.Callback(&obj.Hdr, nil, obj.CmplArg, err)
+		}
+		freeSend(obj)
+	}
+	b.objs = nil
+	b.sgl.Free()
+}
+
+// RecvBatch unpacks `OpcBatch` object and delivers packed objects, one at a time, via `recv`;
+// data that a given `recv` does not read is skipped
+func RecvBatch(hdr *ObjHdr, reader io.Reader, recv RecvObj) error {
+	debug.Assert(hdr.Opcode == OpcBatch)
+	var (
+		br   = &batchReader{r: reader}
+		size = hdr.ObjSize()
+	)
+	hbuf, _ := g.mm.AllocSize(cmn.DfltTransportHeader)
+	defer func() { g.mm.Free(hbuf) }()
+	for br.off < size {
+		if _, err := io.ReadFull(br, hbuf[:sizeProtoHdr]); err != nil {
+			return fmt.Errorf("batch from %s: failed to read proto header: %w", hdr.SID, err)
+		}
+		hlen, _, err := extProtoHdr(hbuf, "batch")
+		if err != nil {
+			return err
+		}
+		if hlen > cap(hbuf) {
+			if hlen > cmn.MaxTransportHeader {
+				return fmt.Errorf("batch from %s: header length %d exceeds maximum %d", hdr.SID, hlen, cmn.MaxTransportHeader)
+			}
+			g.mm.Free(hbuf)
+			hbuf, _ = g.mm.AllocSize(int64(hlen))
+		}
+		if _, err := io.ReadFull(br, hbuf[:hlen]); err != nil {
+			return fmt.Errorf("batch from %s: failed to read obj header: %w", hdr.SID, err)
+		}
+		obj := allocRecv()
+		obj.body, obj.hdr, obj.loghdr = br, ExtObjHeader(hbuf, hlen), "batch"
+
+		var (
+			start   = br.off
+			objSize = obj.Size()
+		)
+		if err := recv(&obj.hdr, obj, nil); err != nil {
+			return err
+		}
+		// (obj may have been already freed by the callback)
+		if rem := objSize - (br.off - start); rem > 0 {
+			if _, err := io.CopyN(io.Discard, br, rem); err != nil {
+				return fmt.Errorf("batch from %s: failed to skip %d bytes: %w", hdr.SID, rem, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (br *batchReader) Read(b []byte) (n int, err error) {
+	n, err = br.r.Read(b)
+	br.off += int64(n)
+	return
+}
@@ -0,0 +1,75 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBatch(t *testing.T) {
+	const num = 100
+	g.mm = memsys.PageMM()
+	var (
+		config  = &cmn.Config{}
+		batch   = NewBatch(g.mm.NewSGL(0), config, 0)
+		objs    = make(map[string][]byte, num)
+		numCmpl int
+	)
+	for i := range num {
+		var (
+			obj  = AllocSend()
+			name = fmt.Sprintf("obj-%03d", i)
+			data = bytes.Repeat([]byte{byte(i)}, 1+i*37)
+		)
+		obj.Hdr = ObjHdr{Bck: cmn.Bck{Name: "bck", Provider: apc.AIS}, ObjName: name, SID: "t1", Opaque: []byte(name)}
+		obj.Hdr.ObjAttrs.Size = int64(len(data))
+		obj.Hdr.ObjAttrs.SetCustomKey("k", name)
+		obj.Callback = func(_ *ObjHdr, _ io.ReadCloser, _ any, err error) {
+			tassert.CheckError(t, err)
+			numCmpl++
+		}
+		batch.Add(obj, data)
+		objs[name] = data
+	}
+	tassert.Fatalf(t, batch.Num() == num, "expected %d, got %d", num, batch.Num())
+
+	obj, roc := batch.Obj()
+	tassert.Fatalf(t, obj.Hdr.Opcode == OpcBatch && obj.Size() == batch.Size(), "invalid batch %+v", obj.Hdr)
+
+	var received int
+	recv := func(hdr *ObjHdr, r io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		expected, ok := objs[hdr.ObjName]
+		tassert.Fatalf(t, ok, "unexpected %q", hdr.ObjName)
+		tassert.Errorf(t, string(hdr.Opaque) == hdr.ObjName && hdr.SID == "t1", "invalid header %+v", hdr)
+		if v, _ := hdr.ObjAttrs.GetCustomKey("k"); v != hdr.ObjName {
+			t.Errorf("%s: invalid custom metadata %q", hdr.ObjName, v)
+		}
+		received++
+		if received%10 == 0 {
+			return nil // not reading: must be skipped
+		}
+		data, err := io.ReadAll(r)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(data, expected), "%s: data mismatch", hdr.ObjName)
+		return nil
+	}
+	err := RecvBatch(&obj.Hdr, io.LimitReader(roc, obj.Size()), recv)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, received == num, "expected %d received, got %d", num, received)
+
+	batch.Cleanup(nil)
+	freeSend(obj)
+	tassert.Errorf(t, numCmpl == num, "expected %d callbacks, got %d", num, numCmpl)
+}
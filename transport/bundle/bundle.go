@@ -154,7 +154,7 @@ func (sb *Streams) Close(gracefully bool) {
 // when (nodes == nil) transmit via all established streams in a bundle
 // otherwise, restrict to the specified subset (nodes)
 func (sb *Streams) Send(obj *transport.Obj, roc cos.ReadOpenCloser, nodes ...*meta.Snode) (err error) {
-	debug.Assert(!transport.ReservedOpcode(obj.Hdr.Opcode))
+	debug.Assert(!transport.ReservedOpcode(obj.Hdr.Opcode) || obj.Hdr.Opcode == transport.OpcBatch)
 	streams := sb.get()
 	// validate
 	switch {
@@ -0,0 +1,133 @@
+// Package bundle provides multi-streaming transport with the functionality
+// to dynamically (un)register receive endpoints, establish long-lived flows, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package bundle
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+// Small-object batching (config.Transport.BatchSmall):
+// objects of up to the configured size are read into memory and packed, per destination,
+// into a single transport object (see transport.Batch). A batch gets sent when it grows
+// to dmBatchMaxSize (or dmBatchMaxNum objects), or after dmBatchLinger - whatever comes first.
+// To preserve per-destination ordering, sending any other object to a given target
+// first sends the pending batch, if any.
+// Receive side is always batch-aware, independently of the local configuration.
+
+const (
+	dmBatchMaxSize = cos.MiB
+	dmBatchMaxNum  = 512
+	dmBatchLinger  = 50 * time.Millisecond
+)
+
+type (
+	dmBatch struct {
+		*transport.Batch
+		tsi   *meta.Snode
+		timer *time.Timer
+	}
+	dmBatches struct {
+		pending map[string]*dmBatch // by destination target ID
+		small   int64               // max size of a batched object; zero: no batching
+		mu      sync.Mutex
+	}
+)
+
+func (dm *DataMover) batchable(obj *transport.Obj, tsi *meta.Snode) bool {
+	size := obj.Size()
+	return dm.batch.small > 0 && tsi != nil && obj.Hdr.Opcode == 0 && size > 0 && size <= dm.batch.small
+}
+
+func (dm *DataMover) sendBatched(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode) error {
+	var (
+		mm        = core.T.PageMM()
+		size      = obj.Size()
+		buf, slab = mm.AllocSize(size)
+	)
+	data := buf[:size]
+	_, err := io.ReadFull(roc, data)
+	cos.Close(roc)
+	if err != nil {
+		slab.Free(buf)
+		if obj.Callback != nil {
+			obj.Callback(&obj.Hdr, roc, obj.CmplArg, err)
+		}
+		return err
+	}
+
+	obj.Hdr.SID = core.T.SID()
+	tid := tsi.ID()
+	dm.batch.mu.Lock()
+	b, ok := dm.batch.pending[tid]
+	if !ok {
+		b = &dmBatch{
+			Batch: transport.NewBatch(mm.NewSGL(dmBatchMaxSize), dm.config, dm.maxHdrSize),
+			tsi:   tsi,
+		}
+		b.timer = time.AfterFunc(dmBatchLinger, func() { dm.flushBatch(tid) })
+		dm.batch.pending[tid] = b
+	}
+	b.Add(obj, data)
+	full := b.Size() >= dmBatchMaxSize || b.Num() >= dmBatchMaxNum
+	if full {
+		delete(dm.batch.pending, tid)
+	}
+	dm.batch.mu.Unlock()
+
+	slab.Free(buf)
+	dm.xctn.OutObjsAdd(1, size)
+	if full {
+		return dm.sendBatch(b)
+	}
+	return nil
+}
+
+func (dm *DataMover) sendBatch(b *dmBatch) error {
+	b.timer.Stop()
+	obj, roc := b.Obj()
+	return dm.data.streams.Send(obj, roc, b.tsi) // (on error, calls back packed objects)
+}
+
+func (dm *DataMover) flushBatch(tid string) {
+	if dm.batch.small == 0 {
+		return
+	}
+	dm.batch.mu.Lock()
+	b, ok := dm.batch.pending[tid]
+	if ok {
+		delete(dm.batch.pending, tid)
+	}
+	dm.batch.mu.Unlock()
+	if ok {
+		dm.sendBatch(b)
+	}
+}
+
+// send (or, when aborting, discard) all pending batches
+func (dm *DataMover) flushAll(err error) {
+	if dm.batch.small == 0 {
+		return
+	}
+	dm.batch.mu.Lock()
+	pending := dm.batch.pending
+	dm.batch.pending = make(map[string]*dmBatch, len(pending))
+	dm.batch.mu.Unlock()
+	for _, b := range pending {
+		if err == nil {
+			dm.sendBatch(b)
+		} else {
+			b.timer.Stop()
+			b.Cleanup(err)
+		}
+	}
+}
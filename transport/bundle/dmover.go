@@ -48,6 +48,7 @@ type (
 			opened atomic.Bool
 			laterx atomic.Bool
 		}
+		batch      dmBatches // small-object batching
 		sizePDU    int32
 		maxHdrSize int32
 	}
@@ -74,6 +75,10 @@ func NewDM(trname string, recvCB transport.RecvObj, owt cmn.OWT, extra Extra) *D
 	dm.multiplier = extra.Multiplier
 	dm.sizePDU, dm.maxHdrSize = extra.SizePDU, extra.MaxHdrSize
 	dm.stage.regout.Store(true)
+	if small := int64(extra.Config.Transport.BatchSmall); small > 0 {
+		dm.batch.small = small
+		dm.batch.pending = make(map[string]*dmBatch, 4)
+	}
 
 	if extra.Compression == "" {
 		extra.Compression = apc.CompressNever
@@ -114,7 +119,8 @@ func (dm *DataMover) Renew(trname string, recvCB transport.RecvObj, owt cmn.OWT,
 		extra.Compression = apc.CompressNever
 	}
 	debug.Assert(owt == dm.owt)
-	if dm.multiplier == extra.Multiplier && dm.compression == extra.Compression && dm.sizePDU == extra.SizePDU && dm.maxHdrSize == extra.MaxHdrSize {
+	if dm.multiplier == extra.Multiplier && dm.compression == extra.Compression && dm.sizePDU == extra.SizePDU &&
+		dm.maxHdrSize == extra.MaxHdrSize && dm.batch.small == int64(extra.Config.Transport.BatchSmall) {
 		return nil
 	}
 	nlog.Infoln("renew DM", dm.String(), "=> [", extra.Compression, extra.Multiplier, "]")
@@ -243,6 +249,8 @@ func (dm *DataMover) Close(err error) {
 	if err == nil && dm.xctn != nil && dm.xctn.IsAborted() {
 		err = dm.xctn.AbortErr()
 	}
+	dm.flushAll(err)
+
 	// nil: close gracefully via `fin`, otherwise abort
 	if err == nil {
 		dm.data.streams.Close(true)
@@ -260,6 +268,7 @@ func (dm *DataMover) Close(err error) {
 }
 
 func (dm *DataMover) Abort() {
+	dm.flushAll(cmn.NewErrAborted(dm.String(), "dm-abort", nil))
 	dm.data.streams.Abort()
 	if dm.useACKs() {
 		dm.ack.streams.Abort()
@@ -267,6 +276,12 @@ func (dm *DataMover) Abort() {
 }
 
 func (dm *DataMover) Send(obj *transport.Obj, roc cos.ReadOpenCloser, tsi *meta.Snode) (err error) {
+	if dm.batchable(obj, tsi) {
+		return dm.sendBatched(obj, roc, tsi)
+	}
+	if tsi != nil {
+		dm.flushBatch(tsi.ID()) // (ordering)
+	}
 	err = dm.data.streams.Send(obj, roc, tsi)
 	if err == nil && !transport.ReservedOpcode(obj.Hdr.Opcode) {
 		dm.xctn.OutObjsAdd(1, obj.Size())
@@ -279,6 +294,7 @@ func (dm *DataMover) ACK(hdr *transport.ObjHdr, cb transport.ObjSentCB, tsi *met
 }
 
 func (dm *DataMover) Bcast(obj *transport.Obj, roc cos.ReadOpenCloser) error {
+	dm.flushAll(nil) // (ordering)
 	return dm.data.streams.Send(obj, roc)
 }
 
@@ -298,6 +314,13 @@ func (dm *DataMover) quicb(time.Duration /*total*/) core.QuiRes {
 }
 
 func (dm *DataMover) wrapRecvData(hdr *transport.ObjHdr, reader io.Reader, err error) error {
+	if hdr.Opcode == transport.OpcBatch && err == nil {
+		// unpack and deliver one object at a time (see dmbatch.go)
+		dm.stage.laterx.Store(true)
+		err = transport.RecvBatch(hdr, reader, dm.wrapRecvData)
+		transport.DrainAndFreeReader(reader)
+		return err
+	}
 	if hdr.Bck.Name != "" && hdr.ObjName != "" && hdr.ObjAttrs.Size >= 0 {
 		dm.xctn.InObjsAdd(1, hdr.ObjAttrs.Size)
 	}
@@ -0,0 +1,134 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// QoS: weighted sharing of the (configured) send bandwidth by competing classes of streams
+// - each stream belongs to exactly one class (see Extra.QoS and qosClassOf below)
+// - a class is "active" if it has transmitted within the last qosActiveWindow
+// - when two or more classes are active, each is rate-limited to its weighted share of
+//   config.Transport.QoS.Bandwidth (token bucket, per class)
+// - a class that runs alone is not throttled
+// - configuration is dynamic
+
+// QoS classes (see also cmn.TransportQoSConf)
+const (
+	QoSUser = iota // default
+	QoSRebalance
+	QoSEC
+	numQoS
+)
+
+const (
+	qosActiveWindow = 2 * time.Second
+	qosBurst        = 100 * time.Millisecond // max tokens (bytes) that can be accumulated, in time units
+	qosMinBurst     = 64 * cos.KiB
+	qosMaxSleep     = time.Second
+)
+
+type (
+	qosClass struct {
+		lastActive atomic.Int64 // mono time
+		tokens     float64      // bytes
+		last       int64        // mono time of the last refill
+		mu         sync.Mutex
+	}
+	qosCtl struct {
+		classes [numQoS]qosClass
+	}
+)
+
+var qos qosCtl
+
+var QoSNames = [numQoS]string{"user", "rebalance", "ec"}
+
+// default class by xaction kind
+func qosClassOf(extra *Extra) int {
+	if extra.QoS != QoSUser || extra.Xact == nil {
+		return extra.QoS
+	}
+	switch extra.Xact.Kind() {
+	case apc.ActRebalance:
+		return QoSRebalance
+	case apc.ActECEncode, apc.ActECGet, apc.ActECPut, apc.ActECRespond:
+		return QoSEC
+	default:
+		return QoSUser
+	}
+}
+
+func qosWeight(conf *cmn.TransportQoSConf, class int) int {
+	var w int
+	switch class {
+	case QoSRebalance:
+		w = conf.Rebalance
+	case QoSEC:
+		w = conf.EC
+	default:
+		w = conf.User
+	}
+	return cos.NonZero(w, cmn.DfltQoSWeight)
+}
+
+// returns the class's current rate (bytes/s), or zero when not throttled
+func (q *qosCtl) rate(conf *cmn.TransportQoSConf, class int, now int64) float64 {
+	var (
+		total, nactive int
+		window         = qosActiveWindow.Nanoseconds()
+	)
+	for i := range q.classes {
+		if i == class || now-q.classes[i].lastActive.Load() < window {
+			total += qosWeight(conf, i)
+			nactive++
+		}
+	}
+	if nactive < 2 {
+		return 0
+	}
+	return float64(conf.Bandwidth) * float64(qosWeight(conf, class)) / float64(total)
+}
+
+// account for `n` bytes sent by a stream of a given class;
+// when over its share, sleep for as long as it takes to pay back the deficit
+func (q *qosCtl) throttle(class, n int) {
+	conf := &cmn.GCO.Get().Transport.QoS
+	if conf.Bandwidth <= 0 || n <= 0 {
+		return
+	}
+	var (
+		c   = &q.classes[class]
+		now = mono.NanoTime()
+	)
+	c.lastActive.Store(now)
+	rate := q.rate(conf, class, now)
+	if rate == 0 {
+		return
+	}
+	c.mu.Lock()
+	if c.last != 0 {
+		burst := max(rate*qosBurst.Seconds(), qosMinBurst)
+		c.tokens = min(c.tokens+rate*time.Duration(now-c.last).Seconds(), burst)
+	}
+	c.last = now
+	c.tokens -= float64(n)
+	deficit := -c.tokens
+	c.mu.Unlock()
+
+	if deficit > 0 {
+		d := time.Duration(deficit / rate * float64(time.Second))
+		time.Sleep(min(d, qosMaxSleep))
+	}
+}
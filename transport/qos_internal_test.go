@@ -0,0 +1,47 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestQoSRate(t *testing.T) {
+	var (
+		q    qosCtl
+		conf = cmn.TransportQoSConf{Bandwidth: 100 * cos.MiB, Rebalance: 30, User: 70}
+		now  = mono.NanoTime()
+	)
+	// alone: not throttled
+	tassert.Errorf(t, q.rate(&conf, QoSRebalance, now) == 0, "expecting no throttling when running alone")
+
+	// competing with user traffic: 30%
+	q.classes[QoSRebalance].lastActive.Store(now)
+	q.classes[QoSUser].lastActive.Store(now)
+	rate := q.rate(&conf, QoSRebalance, now)
+	tassert.Errorf(t, rate == float64(30*cos.MiB), "expecting 30%% of the bandwidth, got %.0f", rate)
+	rate = q.rate(&conf, QoSUser, now)
+	tassert.Errorf(t, rate == float64(70*cos.MiB), "expecting 70%% of the bandwidth, got %.0f", rate)
+
+	// EC joins (default weight)
+	q.classes[QoSEC].lastActive.Store(now)
+	rate = q.rate(&conf, QoSRebalance, now)
+	exp := float64(100*cos.MiB) * 30 / float64(30+70+cmn.DfltQoSWeight)
+	tassert.Errorf(t, rate == exp, "expecting %.0f, got %.0f", exp, rate)
+
+	// user traffic goes quiet
+	later := now + qosActiveWindow.Nanoseconds() + int64(time.Millisecond)
+	q.classes[QoSEC].lastActive.Store(later)
+	rate = q.rate(&conf, QoSRebalance, later)
+	exp = float64(100*cos.MiB) * 30 / float64(30+cmn.DfltQoSWeight)
+	tassert.Errorf(t, rate == exp, "expecting %.0f, got %.0f", exp, rate)
+}
@@ -274,6 +274,7 @@ func (s *Stream) sendData(b []byte) (n int, err error) {
 		objSize = obj.Size()
 	)
 	n, err = obj.Reader.Read(b)
+	qos.throttle(s.qos, n)
 	s.sendoff.off += int64(n)
 	if err != nil {
 		if err == io.EOF {
@@ -291,6 +292,7 @@ func (s *Stream) sendData(b []byte) (n int, err error) {
 
 func (s *Stream) sendPDU(b []byte) (n int) {
 	n = s.pdu.read(b)
+	qos.throttle(s.qos, n)
 	return
 }
 
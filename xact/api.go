@@ -52,8 +52,13 @@ const (
 // ArgsMsg.Flags
 const (
 	XrmZeroSize = 1 << iota // usage: x-cleanup (apc.ActStoreCleanup) to remove zero size objects
+	XrvSample               // usage: x-verify-rebalance (apc.ActRebVerify) to check a (1/RebVerifySampleRate) sample
+	XcvRepair               // usage: x-verify-checksum (apc.ActVerifyCksum) to repair corrupted objects
+	XclnDryRun              // usage: x-cleanup (apc.ActStoreCleanup) to count (and log) what would be removed - remove nothing
 )
 
+const RebVerifySampleRate = 100
+
 type (
 	// either xaction ID or Kind must be specified
 	// is getting passed via ActMsg.Value w/ MorphMarshal extraction
@@ -67,6 +72,8 @@ type (
 		Flags       uint32        `json:"flags,omitempty"` // enum (XrmZeroSize, ...) bitwise
 		Force       bool          // force
 		OnlyRunning bool          // only for running xactions
+		User        string        // submitter (AuthN user ID)
+		Timeline    bool          // include throughput history (see QueryMsg)
 	}
 
 	// simplified JSON-tagged version of the above
@@ -77,6 +84,8 @@ type (
 		Kind        string    `json:"kind"`
 		DaemonID    string    `json:"node,omitempty"`
 		Buckets     []cmn.Bck `json:"buckets,omitempty"`
+		User        string    `json:"user,omitempty"`
+		Timeline    bool      `json:"timeline,omitempty"` // include throughput history (see timeline.go)
 	}
 
 	// primarily: `api.QueryXactionSnaps`
@@ -121,6 +130,7 @@ var Table = map[string]Descriptor{
 	// bucket-less xactions that will typically have a 'cluster' scope (with resilver being a notable exception)
 	apc.ActElection:  {DisplayName: "elect-primary", Scope: ScopeG, Startable: false},
 	apc.ActRebalance: {Scope: ScopeG, Startable: true, Metasync: true, Rebalance: true},
+	apc.ActRebVerify: {Scope: ScopeG, Startable: true, ConflictRebRes: true, ExtendedStats: true},
 
 	apc.ActETLInline: {Scope: ScopeG, Startable: false, AbortRebRes: true},
 
@@ -198,6 +208,13 @@ var Table = map[string]Descriptor{
 		Startable:   false,
 		RefreshCap:  true,
 	},
+	apc.ActTierObjects: {
+		DisplayName: "tier-objects",
+		Scope:       ScopeB,
+		Access:      apc.AceObjDELETE,
+		Startable:   false,
+		RefreshCap:  true,
+	},
 	apc.ActDeleteObjects: {
 		DisplayName: "delete-objects",
 		Scope:       ScopeB,
@@ -261,6 +278,12 @@ var Table = map[string]Descriptor{
 
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Idles: true},
 
+	// AIS-native bucket inventory: one CSV part per target (placement-dependent, hence ConflictRebRes)
+	apc.ActMakeInventory: {Access: apc.AceObjLIST | apc.AcePUT, Scope: ScopeB, Startable: true, ConflictRebRes: true},
+
+	// on-demand integrity audit: recompute and compare checksums (see CksumVerifyReport)
+	apc.ActVerifyCksum: {Access: apc.AceGET | apc.AcePUT, Scope: ScopeB, Startable: true, ConflictRebRes: true, ExtendedStats: true},
+
 	// cache management, internal usage
 	apc.ActLoadLomCache:   {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true},
 	apc.ActInvalListCache: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false},
@@ -451,6 +474,9 @@ func (msg *QueryMsg) String() (s string) {
 	if msg.OnlyRunning != nil && *msg.OnlyRunning {
 		s += "-only-running"
 	}
+	if msg.User != "" {
+		s += "-user[" + msg.User + "]"
+	}
 	return
 }
 
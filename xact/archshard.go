@@ -0,0 +1,14 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+// Multi-object archive (apc.ActArchive) extended stats (see `Snap.Ext`):
+// per-shard record counts and sizes, reported by the target that assembles the shards
+// (when splitting is requested via apc.ArchiveMsg.MaxShardSize and/or MaxShardRecs)
+type ArchShard struct {
+	Name string `json:"name"`
+	Recs int64  `json:"recs,string"`
+	Size int64  `json:"size,string"`
+}
@@ -37,6 +37,7 @@ type (
 		kind   string
 		_nam   string
 		ctlmsg string // via InitBase, SetCtlMsg
+		user   ratomic.Pointer[string]
 		err    cos.Errs
 		stats  struct {
 			objs     atomic.Int64 // locally processed
@@ -46,8 +47,9 @@ type (
 			inobjs   atomic.Int64 // receive
 			inbytes  atomic.Int64
 		}
-		sutime atomic.Int64
-		eutime atomic.Int64
+		timeline timeline // (see timeline.go)
+		sutime   atomic.Int64
+		eutime   atomic.Int64
 	}
 	Marked struct {
 		Xact        core.Xact
@@ -321,7 +323,9 @@ func (xctn *Base) Finish() {
 	if !xctn.eutime.CAS(0, 1) {
 		return
 	}
-	xctn.eutime.Store(time.Now().UnixNano())
+	now := time.Now()
+	xctn.eutime.Store(now.UnixNano())
+	xctn.addSample(now, true /*final*/)
 	if aborted = xctn.IsAborted(); aborted {
 		if perr := xctn.abort.err.Load(); perr != nil {
 			err = *perr
@@ -387,6 +391,7 @@ func (xctn *Base) ToSnap(snap *core.Snap) {
 	snap.ID = xctn.ID()
 	snap.Kind = xctn.Kind()
 	snap.CtlMsg = xctn.ctlmsg
+	snap.User = xctn.User()
 	snap.StartTime = xctn.StartTime()
 	snap.EndTime = xctn.EndTime()
 	if err := xctn.AbortErr(); err != nil {
@@ -413,6 +418,16 @@ func (xctn *Base) ToStats(stats *core.Stats) {
 
 func (xctn *Base) SetCtlMsg(s string) { xctn.ctlmsg = s } // see InitBase
 
+// submitter: authenticated user that started this xaction (see apc.ActMsg.User)
+func (xctn *Base) SetUser(user string) { xctn.user.Store(&user) }
+
+func (xctn *Base) User() string {
+	if u := xctn.user.Load(); u != nil {
+		return *u
+	}
+	return ""
+}
+
 //
 // RebID helpers
 //
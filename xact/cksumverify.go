@@ -0,0 +1,27 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+// max number of corrupted objects to include in the report
+const CksumVerifyMaxExamples = 16
+
+// x-verify-checksum (target) start args
+type CksumVerifyArgs struct {
+	Prefix string
+	Repair bool
+}
+
+// On-demand integrity audit report: x-verify-checksum (apc.ActVerifyCksum) extended stats
+// (see `Snap.Ext`). Corrupted objects that cannot be repaired are reported but never removed.
+type CksumVerifyReport struct {
+	Tid       string   `json:"tid"`
+	Prefix    string   `json:"prefix,omitempty"`
+	Repair    bool     `json:"repair"`
+	Checked   int64    `json:"checked,string"`
+	NoCksum   int64    `json:"no_cksum,string"`    // objects without stored checksum (nothing to compare against)
+	Corrupted int64    `json:"corrupted,string"`   // content or metadata checksum mismatch
+	Repaired  int64    `json:"repaired,string"`    // restored from mirror, EC, or remote backend
+	Examples  []string `json:"examples,omitempty"` // up to CksumVerifyMaxExamples corrupted objects
+}
@@ -0,0 +1,46 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+)
+
+// max number of offending objects to include in the report
+const RebVerifyMaxExamples = 16
+
+// Post-rebalance verification report: x-verify-rebalance (apc.ActRebVerify) extended stats
+// (see `Snap.Ext`), one per target. Upon completion, each target also persists its report
+// (checksummed and signed jsp, see SaveRebVerify), so that the latest report survives
+// both xaction registry cleanup and node restarts.
+type RebVerifyReport struct {
+	ID          string   `json:"id"` // xaction ID
+	Tid         string   `json:"tid"`
+	SmapVersion int64    `json:"smap_version,string"`
+	Sampled     bool     `json:"sampled"`
+	Checked     int64    `json:"checked,string"`         // objects and EC metafiles
+	Misplaced   int64    `json:"misplaced,string"`       // wrong target (HRW)
+	MisplacedMi int64    `json:"misplaced_mpath,string"` // wrong mountpath (HRW)
+	BadCopies   int64    `json:"bad_copies,string"`      // n-way mirror: number of copies differs from configured
+	BadEC       int64    `json:"bad_ec,string"`          // EC: missing, duplicate, or unknown-target slices
+	Examples    []string `json:"examples,omitempty"`     // up to RebVerifyMaxExamples offending objects
+}
+
+func (r *RebVerifyReport) Failed() int64 { return r.Misplaced + r.MisplacedMi + r.BadCopies + r.BadEC }
+
+func SaveRebVerify(configDir string, rep *RebVerifyReport) error {
+	return jsp.Save(filepath.Join(configDir, fname.RebVerify), rep, jsp.CksumSign(cmn.MetaverRebVerify), nil)
+}
+
+// load the latest persisted report (fails on checksum mismatch, i.e., corruption or tampering)
+func LoadRebVerify(configDir string) (*RebVerifyReport, error) {
+	rep := &RebVerifyReport{}
+	_, err := jsp.Load(filepath.Join(configDir, fname.RebVerify), rep, jsp.CksumSign(cmn.MetaverRebVerify))
+	return rep, err
+}
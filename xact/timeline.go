@@ -0,0 +1,100 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Throughput timeline: periodic samples of xaction's (locally processed) counters
+// - sampled by the registry every TimelineIval while the xaction is running,
+//   plus the final sample upon finishing;
+// - xactions that finish within the first interval have no timeline;
+// - bounded: when full, every other sample is dropped and the sampling interval doubles,
+//   so that the entire history of a long job is retained at a coarser resolution;
+// - stays with the xaction (job record) until the latter gets removed from the registry
+//   (see hk.OldAgeX), and is returned upon request (see QueryMsg.Timeline).
+
+const (
+	TimelineIval = 10 * time.Second // hk timer: sample running xactions
+	timelineCap  = 256              // max samples per xaction
+)
+
+type timeline struct {
+	samples []core.Sample
+	mu      sync.Mutex
+	stride  int // record every stride-th tick
+	ticks   int
+}
+
+// called periodically (see xreg)
+func (xctn *Base) Sample(now time.Time) {
+	if xctn.Finished() {
+		return
+	}
+	xctn.addSample(now, false)
+}
+
+// returns a copy
+func (xctn *Base) Timeline() (samples []core.Sample) {
+	tl := &xctn.timeline
+	tl.mu.Lock()
+	if len(tl.samples) > 0 {
+		samples = make([]core.Sample, len(tl.samples))
+		copy(samples, tl.samples)
+	}
+	tl.mu.Unlock()
+	return samples
+}
+
+func (xctn *Base) addSample(now time.Time, final bool) {
+	tl := &xctn.timeline
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	if final {
+		if len(tl.samples) == 0 {
+			return // (see above)
+		}
+	} else {
+		if tl.stride == 0 {
+			tl.stride = 1
+			// the very first sample: start time and zero counters
+			tl.samples = append(tl.samples, core.Sample{Time: xctn.StartTime().UnixNano()})
+		}
+		if tl.ticks++; tl.ticks < tl.stride {
+			return
+		}
+		tl.ticks = 0
+	}
+	if len(tl.samples) == timelineCap {
+		tl.compact()
+	}
+	tl.samples = append(tl.samples, core.Sample{Time: now.UnixNano(), Objs: xctn.Objs(), Bytes: xctn.Bytes()})
+}
+
+// keep every other sample (including the first one); counters are cumulative,
+// so throughput between any two remaining samples is still accurate
+func (tl *timeline) compact() {
+	n := 0
+	for i := 0; i < len(tl.samples); i += 2 {
+		tl.samples[n] = tl.samples[i]
+		n++
+	}
+	clear(tl.samples[n:])
+	tl.samples = tl.samples[:n]
+	tl.stride *= 2
+}
+
+// throughput between two samples: bytes per second and objects per second
+func Throughput(prev, cur *core.Sample) (bps, ops float64) {
+	elapsed := time.Duration(cur.Time - prev.Time).Seconds()
+	if elapsed <= 0 {
+		return 0, 0
+	}
+	return float64(cur.Bytes-prev.Bytes) / elapsed, float64(cur.Objs-prev.Objs) / elapsed
+}
@@ -118,6 +118,14 @@ func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
 
+func RenewMakeInventory(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActMakeInventory, bck, Args{UUID: uuid})
+}
+
+func RenewVerifyCksum(uuid string, bck *meta.Bck, args *xact.CksumVerifyArgs) RenewRes {
+	return RenewBucketXact(apc.ActVerifyCksum, bck, Args{UUID: uuid, Custom: args})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
@@ -22,6 +22,11 @@ func RenewRebalance(id int64, ctlmsg string) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewRebVerify(id string, flags uint32) RenewRes {
+	e := dreg.nonbckXacts[apc.ActRebVerify].New(Args{UUID: id, Custom: flags}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewResilver(id string) core.Xact {
 	e := dreg.nonbckXacts[apc.ActResilver].New(Args{UUID: id}, nil)
 	rns := dreg.renew(e, nil)
@@ -65,7 +65,9 @@ type (
 		OnlyRunning *bool
 		ID          string
 		Kind        string
+		User        string // submitter
 		Buckets     []*meta.Bck
+		Timeline    bool // include throughput history
 	}
 )
 
@@ -85,6 +87,7 @@ type (
 		scope  []int       // one of { ScopeG, ScopeB, ... } enum
 		kind   string      // all of a kind
 		newreb bool        // (rebalance is starting) vs (dtor.AbortRebRes)
+		modify bool        // only those that write into (any of) the bcks
 	}
 
 	entries struct {
@@ -102,6 +105,11 @@ type (
 		nonbckXacts map[string]Renewable
 		finDelta    atomic.Int64
 	}
+	// throughput timeline (implemented by xact.Base)
+	sampler interface {
+		Sample(now time.Time)
+		Timeline() []core.Sample
+	}
 )
 
 // default global registry that keeps track of all running xactions
@@ -135,6 +143,7 @@ func newRegistry() (r *registry) {
 func RegWithHK() {
 	hk.Reg("x-old"+hk.NameSuffix, dreg.hkDelOld, 0)
 	hk.Reg("x-prune-active"+hk.NameSuffix, dreg.hkPruneActive, 0)
+	hk.Reg("x-timeline"+hk.NameSuffix, dreg.hkTimeline, xact.TimelineIval)
 }
 
 func GetXact(uuid string) (core.Xact, error) { return dreg.getXact(uuid) }
@@ -230,6 +239,12 @@ func AbortAllBuckets(err error, bcks ...*meta.Bck) {
 	dreg.abort(&abortArgs{bcks: bcks, err: err})
 }
 
+// AbortModifying aborts xactions that write into any of the provided (e.g., frozen) bcks:
+// jobs that have it as their destination, and bucket jobs that require modify access.
+func AbortModifying(err error, bcks ...*meta.Bck) {
+	dreg.abort(&abortArgs{bcks: bcks, err: err, modify: true})
+}
+
 // AbortAll waits until abort of all xactions is finished
 // Every abort is done asynchronously
 func AbortAll(err error, scope ...int) {
@@ -264,6 +279,23 @@ func DoAbort(flt Flt, err error) {
 }
 
 func GetSnap(flt Flt) ([]*core.Snap, error) {
+	snaps, err := getSnap(flt)
+	if err == nil && flt.Timeline {
+		addTimeline(snaps)
+	}
+	if err != nil || flt.User == "" {
+		return snaps, err
+	}
+	filtered := snaps[:0]
+	for _, snap := range snaps {
+		if snap.User == flt.User {
+			filtered = append(filtered, snap)
+		}
+	}
+	return filtered, nil
+}
+
+func getSnap(flt Flt) ([]*core.Snap, error) {
 	var onlyRunning bool
 	if flt.OnlyRunning != nil {
 		onlyRunning = *flt.OnlyRunning
@@ -351,6 +383,13 @@ func (args *abortArgs) do(entry Renewable) bool {
 	case len(args.bcks) > 0:
 		debug.Assertf(args.scope == nil, "scope %v", args.scope)
 		for _, bck := range args.bcks {
+			if args.modify {
+				if modifies(xctn, bck) {
+					abort = true
+					break
+				}
+				continue
+			}
 			if xctn.Bck() != nil && bck.Equal(xctn.Bck(), true /*sameID*/, true /*same backend*/) {
 				abort = true
 				break
@@ -372,6 +411,18 @@ func (args *abortArgs) do(entry Renewable) bool {
 	return true
 }
 
+func modifies(xctn core.Xact, bck *meta.Bck) bool {
+	// destination, if any (x-tcb, x-tco, x-archive, dsort, et al.)
+	if snap := xctn.Snap(); snap != nil && !snap.DstBck.IsEmpty() {
+		return bck.Bucket().Equal(&snap.DstBck)
+	}
+	if xctn.Bck() == nil || !bck.Equal(xctn.Bck(), false /*sameID*/, true /*same backend*/) {
+		return false
+	}
+	_, dtor, err := xact.GetDescriptor(xctn.Kind())
+	return err == nil && dtor.Access&apc.AccessModify != 0
+}
+
 func (r *registry) matchingXactsStats(match func(xctn core.Xact) bool) []*core.Snap {
 	matchingEntries := make([]Renewable, 0, 20)
 	r.entries.forEach(func(entry Renewable) bool {
@@ -412,6 +463,36 @@ func (r *registry) hkPruneActive(int64) time.Duration {
 	return hk.PruneActiveIval
 }
 
+// sample running xactions' throughput (see xact/timeline.go)
+func (r *registry) hkTimeline(int64) time.Duration {
+	now := time.Now()
+	e := &r.entries
+	e.mtx.RLock()
+	for _, entry := range e.active {
+		xctn := entry.Get()
+		if xctn.Kind() == apc.ActList || !xctn.Running() {
+			continue
+		}
+		if s, ok := xctn.(sampler); ok {
+			s.Sample(now)
+		}
+	}
+	e.mtx.RUnlock()
+	return xact.TimelineIval
+}
+
+func addTimeline(snaps []*core.Snap) {
+	for _, snap := range snaps {
+		xctn, err := dreg.getXact(snap.ID)
+		if err != nil || xctn == nil {
+			continue
+		}
+		if s, ok := xctn.(sampler); ok {
+			snap.Timeline = s.Timeline()
+		}
+	}
+}
+
 func (r *registry) hkDelOld(int64) time.Duration {
 	var (
 		toRemove  []string
@@ -841,6 +922,10 @@ func (flt *Flt) String() string {
 
 func (flt Flt) Matches(xctn core.Xact) (yes bool) {
 	debug.Assert(xact.IsValidKind(xctn.Kind()), xctn.String())
+	// submitted by?
+	if flt.User != "" && xctn.User() != flt.User {
+		return false
+	}
 	// running?
 	if flt.OnlyRunning != nil {
 		if *flt.OnlyRunning != xctn.Running() {
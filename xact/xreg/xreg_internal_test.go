@@ -0,0 +1,72 @@
+// Package xreg provides registry and (renew, find) functions for AIS eXtended Actions (xactions).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xreg
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+type testXact struct {
+	xact.Base
+	dst *meta.Bck
+}
+
+func (*testXact) Run(*sync.WaitGroup) {}
+
+func (r *testXact) Snap() *core.Snap {
+	snap := &core.Snap{}
+	r.ToSnap(snap)
+	if r.dst != nil {
+		snap.DstBck = r.dst.Clone()
+	}
+	return snap
+}
+
+func newTestXact(kind string, bck, dst *meta.Bck) *testXact {
+	r := &testXact{dst: dst}
+	r.InitBase(cos.GenUUID(), kind, "", bck)
+	return r
+}
+
+func TestModifies(t *testing.T) {
+	cos.InitShortID(0)
+	var (
+		frozen = meta.NewBck("frozen", apc.AIS, cmn.NsGlobal)
+		other  = meta.NewBck("other", apc.AIS, cmn.NsGlobal)
+	)
+	tests := []struct {
+		xctn *testXact
+		abrt bool
+	}{
+		{newTestXact(apc.ActCopyBck, frozen, frozen), true},      // copying into
+		{newTestXact(apc.ActCopyBck, other, other), false},       // copying from (x-tcb: Bck() is the destination)
+		{newTestXact(apc.ActArchive, frozen, other), false},      // archiving from
+		{newTestXact(apc.ActArchive, other, frozen), true},       // archiving into
+		{newTestXact(apc.ActDsort, other, frozen), true},         // dsort output
+		{newTestXact(apc.ActDeleteObjects, frozen, nil), true},   // modify access
+		{newTestXact(apc.ActPromote, frozen, nil), true},         // ditto
+		{newTestXact(apc.ActDeleteObjects, other, nil), false},   // another bucket
+		{newTestXact(apc.ActSummaryBck, frozen, nil), false},     // read-only
+		{newTestXact(apc.ActRebalance, nil, nil), false},         // bucket-less
+		{newTestXact(apc.ActETLObjects, other, frozen), true},    // transforming into
+		{newTestXact(apc.ActCopyObjects, frozen, frozen), true},  // within the same bucket
+		{newTestXact(apc.ActCopyObjects, frozen, other), false},  // from
+		{newTestXact(apc.ActETLObjects, frozen, nil), true},      // (no destination)
+		{newTestXact(apc.ActPrefetchObjects, frozen, nil), true}, // (writes in-cluster)
+		{newTestXact(apc.ActEvictObjects, frozen, nil), true},    // (deletes in-cluster)
+	}
+	for i, test := range tests {
+		tassert.Errorf(t, modifies(test.xctn, frozen) == test.abrt, "%d: %s: expecting abort=%t", i, test.xctn.Kind(), test.abrt)
+	}
+}
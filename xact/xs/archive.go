@@ -13,6 +13,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -51,11 +53,20 @@ type (
 		wfh     cos.LomWriter // -> workFQN
 		cksum   cos.CksumHashSize
 		cnt     atomic.Int32 // num archived
+		quota   *jquota      // apc.JobQuota, if requested
 		// tar only
 		appendPos int64 // append to existing
 		tarFormat tar.Format
 		// finishing
-		refc atomic.Int32
+		refc atomic.Int32 // designated target: number of senders yet to report completion
+		hold atomic.Int32 // other targets: release upon local completion and, if split, the designated target's
+
+		// splitting into multiple shards (see apc.ArchiveMsg.MaxShardSize et al.)
+		split struct {
+			mu   sync.Mutex
+			idx  int   // current shard
+			recs int64 // in the current shard
+		}
 	}
 	archtask struct {
 		wi   *archwi
@@ -78,6 +89,11 @@ type (
 			m map[string]*archwi
 			sync.RWMutex
 		}
+		shards struct {
+			done []xact.ArchShard // multi-shard output: finalized so far
+			mu   sync.Mutex
+		}
+		quota xquota
 	}
 )
 
@@ -140,7 +156,7 @@ func (r *XactArch) Begin(msg *cmn.ArchiveBckMsg, archlom *core.LOM) (err error)
 		r.AddErr(err, 4, cos.SmoduleXs)
 		return err
 	}
-	debug.Assert(archlom.Cname() == msg.Cname()) // relying on it
+	debug.Assert(archlom.Cname() == msg.ToBck.Cname(shardName(msg, 0))) // relying on it
 
 	wi := &archwi{r: r, msg: msg, archlom: archlom, tarFormat: tar.FormatUnknown}
 	wi.fqn = fs.CSM.Gen(wi.archlom, fs.WorkfileType, fs.WorkfileCreateArch)
@@ -153,6 +169,12 @@ func (r *XactArch) Begin(msg *cmn.ArchiveBckMsg, archlom *core.LOM) (err error)
 	}
 	nat := smap.CountActiveTs()
 	wi.refc.Store(int32(nat - 1))
+	wi.hold.Store(1)
+	if msg.Split() {
+		// keep receiving (finalized shards) until the designated target is done (see _recv)
+		wi.hold.Store(2)
+	}
+	wi.quota = newJquota(&msg.JobQuota, nat)
 
 	wi.tsi, err = smap.HrwName2T(msg.ToBck.MakeUname(msg.ArchName))
 	if err != nil {
@@ -259,6 +281,7 @@ func (r *XactArch) Do(msg *cmn.ArchiveBckMsg) {
 		r.cleanup()
 		return
 	}
+	lrit.quota = wi.quota
 
 	// dynamic ctlmsg // TODO: ref
 	{
@@ -280,6 +303,15 @@ func (r *XactArch) Do(msg *cmn.ArchiveBckMsg) {
 		if msg.AppendIfExists {
 			sb.WriteString(", append-iff")
 		}
+		if msg.MaxShardSize > 0 {
+			sb.WriteString(", max-shard-size=")
+			sb.WriteString(cos.ToSizeIEC(msg.MaxShardSize, 0))
+		}
+		if msg.MaxShardRecs > 0 {
+			sb.WriteString(", max-shard-recs=")
+			sb.WriteString(strconv.FormatInt(msg.MaxShardRecs, 10))
+		}
+		msg.JobQuota.Str(&sb)
 
 		r.Base.SetCtlMsg(sb.String())
 	}
@@ -358,6 +390,9 @@ func (r *XactArch) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) e
 }
 
 func (r *XactArch) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
+	if hdr.Opcode == opcodeShard {
+		return r.recvShard(hdr, objReader)
+	}
 	r.pending.RLock()
 	wi, ok := r.pending.m[cos.UnsafeS(hdr.Opaque)] // txnUUID
 	r.pending.RUnlock()
@@ -372,7 +407,21 @@ func (r *XactArch) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
 		}
 		return err
 	}
-	debug.Assert(wi.tsi.ID() == core.T.SID() && wi.msg.TxnUUID == cos.UnsafeS(hdr.Opaque))
+	debug.Assert(wi.msg.TxnUUID == cos.UnsafeS(hdr.Opaque))
+
+	// multi-shard output: the designated target has placed all its shards
+	if wi.tsi.ID() != core.T.SID() {
+		debug.Assert(wi.msg.Split() && hdr.SID == wi.tsi.ID(), hdr.SID, " vs ", wi.tsi.ID())
+		switch hdr.Opcode {
+		case opcodeDone:
+		case opcodeAbrt:
+			r.AddErr(fmt.Errorf("%s: designated %s failed: %s", r.Name(), wi.tsi.StringEx(), hdr.ObjName), 4, cos.SmoduleXs)
+		default:
+			return fmt.Errorf("%s: unexpected opcode %d from %s", r.Name(), hdr.Opcode, meta.Tname(hdr.SID))
+		}
+		r.unhold(wi)
+		return nil
+	}
 
 	// NOTE: best-effort via ref-counting
 	if hdr.Opcode == opcodeDone {
@@ -382,10 +431,7 @@ func (r *XactArch) _recv(hdr *transport.ObjHdr, objReader io.Reader) error {
 	}
 
 	debug.Assert(hdr.Opcode == 0)
-	err := wi.writer.Write(wi.nameInArch(hdr.ObjName), &hdr.ObjAttrs, objReader)
-	if err == nil {
-		wi.cnt.Inc()
-	} else {
+	if err := wi.write(wi.nameInArch(hdr.ObjName), &hdr.ObjAttrs, objReader); err != nil {
 		r.AddErr(err, 5, cos.SmoduleXs)
 	}
 	return nil
@@ -405,6 +451,10 @@ func (r *XactArch) finalize(wi *archwi) {
 	r.pending.Unlock()
 
 	ecode, err := r._fini(wi)
+	if wi.msg.Split() {
+		// all shards placed (or sent, in order) - notify the rest of the targets
+		r.sendTerm(wi.msg.TxnUUID, nil /*all*/, err)
+	}
 	r.DecPending()
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		var s string
@@ -453,14 +503,88 @@ func (r *XactArch) _fini(wi *archwi) (ecode int, err error) {
 	}
 	debug.Assert(wi.wfh == nil)
 
-	wi.archlom.SetSize(size)
-	ecode, err = core.T.FinalizeObj(wi.archlom, wi.fqn, r, cmn.OwtArchive)
+	ecode, err = wi.place(size)
 	core.FreeLOM(wi.archlom)
-	r.ObjsAdd(1, size-wi.appendPos)
-
 	return
 }
 
+// non-designated target: release the work item when done sending and, if split,
+// when the designated target is done placing shards (see archwi.hold)
+func (r *XactArch) unhold(wi *archwi) {
+	if wi.hold.Dec() > 0 {
+		return
+	}
+	r.pending.Lock()
+	delete(r.pending.m, wi.msg.TxnUUID)
+	r.wiCnt.Dec()
+	r.pending.Unlock()
+	r.DecPending()
+
+	core.FreeLOM(wi.archlom)
+}
+
+func (r *XactArch) addShard(shard xact.ArchShard) {
+	r.shards.mu.Lock()
+	r.shards.done = append(r.shards.done, shard)
+	r.shards.mu.Unlock()
+}
+
+// multi-shard output: a shard that does not HRW-map to this target goes to its owner
+// (compare w/ XactTCB._recv)
+func (r *XactArch) sendShard(wi *archwi, tsi *meta.Snode, shard xact.ArchShard) error {
+	fqn := wi.fqn
+	fh, err := cos.NewFileHandle(fqn)
+	if err != nil {
+		return err
+	}
+	wi.fqn = "" // (the callback below removes it)
+	o := transport.AllocSend()
+	hdr := &o.Hdr
+	{
+		hdr.Bck = wi.msg.ToBck
+		hdr.ObjName = wi.archlom.ObjName
+		hdr.ObjAttrs.CopyFrom(wi.archlom.ObjAttrs(), false /*skip cksum*/)
+		hdr.Opcode = opcodeShard
+	}
+	o.Callback = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+		if err != nil {
+			r.AddErr(err, 5, cos.SmoduleXs)
+		} else {
+			r.addShard(shard)
+		}
+		cos.RemoveFile(fqn)
+	}
+	return r.p.dm.Send(o, fh, tsi)
+}
+
+func (r *XactArch) recvShard(hdr *transport.ObjHdr, objReader io.Reader) error {
+	lom := core.AllocLOM(hdr.ObjName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&hdr.Bck); err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return err
+	}
+	lom.CopyAttrs(&hdr.ObjAttrs, true /*skip cksum*/)
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfileCreateArch
+		params.Reader = io.NopCloser(objReader)
+		params.Cksum = hdr.ObjAttrs.Cksum
+		params.Xact = r
+		params.Size = hdr.ObjAttrs.Size
+		params.OWT = cmn.OwtArchive
+		params.Atime = time.Now()
+	}
+	err := core.T.PutObject(lom, params)
+	core.FreePutParams(params)
+	if err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+		return err
+	}
+	r.ObjsAdd(1, hdr.ObjAttrs.Size)
+	return nil
+}
+
 func (r *XactArch) Name() (s string) {
 	s = r.streamingX.Name()
 	if src, dst := r.FromTo(); src != nil {
@@ -492,6 +616,15 @@ func (r *XactArch) Snap() (snap *core.Snap) {
 	if f, t := r.FromTo(); f != nil {
 		snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
 	}
+
+	r.quota.toSnap(snap)
+
+	// per-shard counts (multi-shard output only)
+	r.shards.mu.Lock()
+	if len(r.shards.done) > 0 {
+		snap.Ext = slices.Clone(r.shards.done)
+	}
+	r.shards.mu.Unlock()
 	return
 }
 
@@ -512,17 +645,12 @@ func (j *jogger) run() {
 			}
 
 			lrit.wait()
+			wi.r.quota.check(wi.quota)
 			if core.T.SID() == wi.tsi.ID() {
 				go wi.r.finalize(wi) // async finalize this shard
 			} else {
 				wi.r.sendTerm(wi.msg.TxnUUID, wi.tsi, nil)
-				wi.r.pending.Lock()
-				delete(wi.r.pending.m, wi.msg.TxnUUID)
-				wi.r.wiCnt.Dec()
-				wi.r.pending.Unlock()
-				wi.r.DecPending()
-
-				core.FreeLOM(wi.archlom)
+				wi.r.unhold(wi)
 			}
 		case <-j.stopCh.Listen():
 			return
@@ -612,6 +740,7 @@ func (wi *archwi) do(lom *core.LOM, lrit *lrit) {
 	}
 	if core.T.SID() != wi.tsi.ID() {
 		wi.r.doSend(lom, wi, fh)
+		wi.quota.add(lom.Lsize())
 		return
 	}
 	// see Begin
@@ -621,13 +750,100 @@ func (wi *archwi) do(lom *core.LOM, lrit *lrit) {
 		wi.r.Abort(err)
 		return
 	}
-	err = wi.writer.Write(wi.nameInArch(lom.ObjName), lom, fh /*reader*/)
+	err = wi.write(wi.nameInArch(lom.ObjName), lom, fh /*reader*/)
 	cos.Close(fh)
-	if err == nil {
-		wi.cnt.Inc()
-	} else {
+	if err != nil {
 		wi.r.AddErr(err, 5, cos.SmoduleXs)
+		return
+	}
+	wi.quota.add(lom.Lsize())
+}
+
+func (wi *archwi) write(nameInArch string, oah cos.OAH, reader io.Reader) (err error) {
+	if !wi.msg.Split() {
+		if err = wi.writer.Write(nameInArch, oah, reader); err == nil {
+			wi.cnt.Inc()
+		}
+		return err
+	}
+
+	wi.split.mu.Lock()
+	if wi.split.recs > 0 && wi.full(oah.Lsize()) {
+		err = wi.nextShard()
+	}
+	if err == nil {
+		if err = wi.writer.Write(nameInArch, oah, reader); err == nil {
+			wi.cnt.Inc()
+			wi.split.recs++
+		}
+	}
+	wi.split.mu.Unlock()
+	return err
+}
+
+// whether adding the next (size) object would exceed the limits
+func (wi *archwi) full(size int64) bool {
+	msg := wi.msg
+	if msg.MaxShardRecs > 0 && wi.split.recs >= msg.MaxShardRecs {
+		return true
+	}
+	return msg.MaxShardSize > 0 && wi.cksum.Size+size > msg.MaxShardSize
+}
+
+// finalize the current shard and start the next one (under split.mu)
+func (wi *archwi) nextShard() error {
+	wi.writer.Fini()
+	size, err := wi.finalize()
+	if err != nil {
+		return err
 	}
+	if _, err := wi.place(size); err != nil {
+		return err
+	}
+	core.FreeLOM(wi.archlom)
+
+	wi.split.idx++
+	wi.archlom = core.AllocLOM(shardName(wi.msg, wi.split.idx))
+	if err := wi.archlom.InitBck(&wi.msg.ToBck); err != nil {
+		return err
+	}
+	wi.fqn = fs.CSM.Gen(wi.archlom, fs.WorkfileType, fs.WorkfileCreateArch)
+	wi.cksum.Init(wi.archlom.CksumType())
+	if wi.wfh, err = wi.archlom.CreateWork(wi.fqn); err != nil {
+		return err
+	}
+	wi.writer = archive.NewWriter(wi.msg.Mime, wi.wfh, &wi.cksum, &archive.Opts{Serialize: true})
+	wi.split.recs = 0
+	return nil
+}
+
+// store finalized (current) shard locally or send it to its HRW target
+func (wi *archwi) place(size int64) (ecode int, err error) {
+	var (
+		r     = wi.r
+		tsi   = wi.tsi
+		shard = xact.ArchShard{Name: wi.archlom.ObjName, Recs: wi.split.recs, Size: size}
+	)
+	wi.archlom.SetSize(size)
+	if wi.msg.Split() {
+		smap := core.T.Sowner().Get()
+		if tsi, err = smap.HrwName2T(wi.msg.ToBck.MakeUname(wi.archlom.ObjName)); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+	// NOTE: a shard counts as done only once placed (ie., finalized locally or sent)
+	if tsi.ID() != core.T.SID() && r.p.dm != nil {
+		err = r.sendShard(wi, tsi, shard)
+		return 0, err
+	}
+	ecode, err = core.T.FinalizeObj(wi.archlom, wi.fqn, r, cmn.OwtArchive)
+	if err == nil {
+		r.ObjsAdd(1, size-wi.appendPos)
+		if wi.msg.Split() {
+			r.addShard(shard)
+		}
+	}
+	return ecode, err
 }
 
 func (wi *archwi) quiesce() core.QuiRes {
@@ -640,6 +856,13 @@ func (wi *archwi) quiesce() core.QuiRes {
 	})
 }
 
+func shardName(msg *cmn.ArchiveBckMsg, idx int) string {
+	if !msg.Split() {
+		return msg.ArchName
+	}
+	return archive.ShardName(msg.ArchName, idx)
+}
+
 func (wi *archwi) nameInArch(objName string) string {
 	if wi.msg.BaseNameOnly {
 		objName = filepath.Base(objName)
@@ -0,0 +1,47 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// non-designated target must keep receiving until both local completion and,
+// when splitting into shards, the designated target's "done"
+func TestArchUnhold(t *testing.T) {
+	hk.TestInit()
+	for _, split := range []bool{false, true} {
+		r := &XactArch{}
+		r.pending.m = make(map[string]*archwi)
+		r.DemandBase.Init(cos.GenUUID(), apc.ActArchive, "", nil, 0)
+
+		wi := &archwi{r: r, msg: &cmn.ArchiveBckMsg{}, archlom: core.AllocLOM("arch.tar")}
+		wi.msg.TxnUUID = "txn"
+		wi.hold.Store(1)
+		if split {
+			wi.hold.Store(2)
+		}
+		r.pending.m[wi.msg.TxnUUID] = wi
+		r.wiCnt.Inc()
+		r.IncPending()
+
+		r.unhold(wi) // local completion
+		if split {
+			tassert.Fatalf(t, r.wiCnt.Load() == 1 && len(r.pending.m) == 1, "released prior to designated target's done")
+			r.unhold(wi)
+		}
+		tassert.Fatalf(t, r.wiCnt.Load() == 0 && len(r.pending.m) == 0 && r.Pending() == 0,
+			"split=%t: expecting released, got cnt=%d, pending=%d", split, r.wiCnt.Load(), r.Pending())
+		r.DemandBase.Stop()
+	}
+}
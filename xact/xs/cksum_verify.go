@@ -0,0 +1,280 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-verify-checksum: on-demand integrity audit of a given bucket[/prefix]
+// - walks local objects (main replicas), recomputes their content checksums, and compares
+//   against stored metadata;
+// - optionally, repairs corrupted objects from (in that order):
+//   remote backend, local mirror, EC slices;
+// - unrecoverable objects are reported but never removed.
+// The resulting report is returned via `Snap.Ext` - see xact.CksumVerifyReport
+
+type (
+	cvFactory struct {
+		xreg.RenewBase
+		xctn *XactCksumVerify
+	}
+	XactCksumVerify struct {
+		args *xact.CksumVerifyArgs
+		xact.BckJog
+		examples struct {
+			names []string
+			mu    sync.Mutex
+		}
+		checked   atomic.Int64
+		noCksum   atomic.Int64
+		corrupted atomic.Int64
+		repaired  atomic.Int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactCksumVerify)(nil)
+	_ xreg.Renewable = (*cvFactory)(nil)
+)
+
+///////////////
+// cvFactory //
+///////////////
+
+func (*cvFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &cvFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *cvFactory) Start() error {
+	args, ok := p.Args.Custom.(*xact.CksumVerifyArgs)
+	debug.Assert(ok)
+	p.xctn = newCksumVerify(p.UUID(), p.Bck, args)
+	return nil
+}
+
+func (*cvFactory) Kind() string     { return apc.ActVerifyCksum }
+func (p *cvFactory) Get() core.Xact { return p.xctn }
+
+func (*cvFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////////////
+// XactCksumVerify //
+/////////////////////
+
+func newCksumVerify(id string, bck *meta.Bck, args *xact.CksumVerifyArgs) (r *XactCksumVerify) {
+	r = &XactCksumVerify{args: args}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Prefix:   args.Prefix,
+		// (no DoLoad: loading here in visitObj to count corrupted metadata)
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	var ctlmsg string
+	if args.Repair {
+		ctlmsg = "repair"
+	}
+	r.BckJog.Init(id, apc.ActVerifyCksum, ctlmsg, bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactCksumVerify) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "prefix:", r.args.Prefix)
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+	rep := r.report()
+	nlog.Infoln(r.Name(), "checked:", rep.Checked, "corrupted:", rep.Corrupted, "repaired:", rep.Repaired)
+}
+
+func (r *XactCksumVerify) visitObj(lom *core.LOM, _ []byte) error {
+	err := lom.Load(false /*cache it*/, false /*locked*/)
+	switch {
+	case err == nil:
+		if lom.IsCopy() {
+			return nil
+		}
+	case cmn.IsErrObjNought(err):
+		return nil // removed in the meantime
+	case cmn.IsErrLmetaCorrupted(err):
+		r.checked.Inc()
+		r.corrupt(lom, err)
+		return nil
+	default:
+		return err
+	}
+
+	r.checked.Inc()
+	r.ObjsAdd(1, lom.Lsize())
+	if lom.CksumType() == cos.ChecksumNone || lom.Checksum().IsEmpty() {
+		r.noCksum.Inc()
+		return nil
+	}
+
+	lom.Lock(false)
+	err = lom.ValidateMetaChecksum()
+	if err == nil {
+		err = lom.ValidateContentChecksum()
+	}
+	lom.Unlock(false)
+
+	switch {
+	case err == nil:
+	case cos.IsErrBadCksum(err):
+		r.corrupt(lom, err)
+	case cmn.IsErrObjNought(err):
+	default:
+		return err
+	}
+	return nil
+}
+
+func (r *XactCksumVerify) corrupt(lom *core.LOM, err error) {
+	r.corrupted.Inc()
+	nlog.Warningln(r.Name(), err)
+	if !r.args.Repair {
+		r.example(lom.Cname())
+		return
+	}
+	if how, err := r.repair(lom); err != nil {
+		r.example(lom.Cname() + ": failed to repair: " + err.Error())
+	} else {
+		r.repaired.Inc()
+		r.example(lom.Cname() + ": repaired from " + how)
+	}
+}
+
+// compare with (target) GET-path recovery (ais/tgtobj.go)
+// - corrupted object stays in place until repaired: all sources below write
+// into a workfile and then rename it over the original
+func (*XactCksumVerify) repair(lom *core.LOM) (string, error) {
+	var (
+		remote  = lom.Bck().IsRemote()
+		mirror  = lom.HasCopies()
+		ecEnbld = lom.ECEnabled()
+	)
+	if !remote && !mirror && !ecEnbld {
+		return "", cmn.NewErrFailedTo(core.T, "repair", lom.Cname(), errors.New("no redundancy"))
+	}
+
+	if remote {
+		if _, err := core.T.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+			return "", err
+		}
+		return "remote", nil
+	}
+	if mirror {
+		err := repairFromMirror(lom)
+		if err == nil {
+			return "mirror", nil
+		}
+		if !ecEnbld {
+			return "", err
+		}
+		nlog.Warningln(err, "- trying EC")
+	}
+	if err := ec.ECM.Recover(lom); err != nil {
+		return "", err
+	}
+	lom.Uncache()
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return "", err
+	}
+	return "EC", nil
+}
+
+// copy the first good mirror copy into a workfile and rename it over the (corrupted) main replica
+func repairFromMirror(lom *core.LOM) error {
+	var (
+		cksum     = lom.Checksum()
+		workFQN   = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileRepair)
+		buf, slab = core.T.PageMM().Alloc()
+	)
+	defer slab.Free(buf)
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	for copyFQN := range lom.GetCopies() {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		_, cksumH, err := cos.CopyFile(copyFQN, workFQN, buf, cksum.Ty())
+		if err != nil {
+			continue
+		}
+		if !cksumH.Equal(cksum) {
+			nlog.Warningln(lom.Cname(), "copy", copyFQN, "is also corrupted")
+			if err := cos.RemoveFile(workFQN); err != nil {
+				nlog.Errorln("nested error:", err)
+			}
+			continue
+		}
+		if err := lom.RenameFinalize(workFQN); err != nil {
+			if nerr := cos.RemoveFile(workFQN); nerr != nil {
+				nlog.Errorln("nested error:", nerr)
+			}
+			return err
+		}
+		return lom.Persist() // (rename has replaced xattr-stored metadata as well)
+	}
+	return cmn.NewErrFailedTo(core.T, "restore", lom.Cname(), errors.New("no good copies"))
+}
+
+func (r *XactCksumVerify) example(s string) {
+	r.examples.mu.Lock()
+	if len(r.examples.names) < xact.CksumVerifyMaxExamples {
+		r.examples.names = append(r.examples.names, s)
+	}
+	r.examples.mu.Unlock()
+}
+
+func (r *XactCksumVerify) report() *xact.CksumVerifyReport {
+	rep := &xact.CksumVerifyReport{
+		Tid:       core.T.SID(),
+		Prefix:    r.args.Prefix,
+		Repair:    r.args.Repair,
+		Checked:   r.checked.Load(),
+		NoCksum:   r.noCksum.Load(),
+		Corrupted: r.corrupted.Load(),
+		Repaired:  r.repaired.Load(),
+	}
+	r.examples.mu.Lock()
+	rep.Examples = append(rep.Examples, r.examples.names...)
+	r.examples.mu.Unlock()
+	return rep
+}
+
+func (r *XactCksumVerify) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	snap.Ext = r.report()
+	return
+}
@@ -0,0 +1,148 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const cvTestData = "good content of a mirrored object"
+
+// two mountpaths; returns (mirrored) object that has its main replica corrupted
+func cvSetup(t *testing.T) *core.LOM {
+	var (
+		root = t.TempDir()
+		bck  = meta.NewBck("cksum-verify", apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+			Cksum:  cmn.CksumConf{Type: cos.ChecksumXXHash},
+			Mirror: cmn.MirrorConf{Enabled: true, Copies: 2},
+			BID:    0xc5,
+		})
+	)
+	fs.TestNew(nil)
+	t.Cleanup(func() { fs.TestNew(nil) })
+	for _, name := range []string{"mp1", "mp2"} {
+		mpath := filepath.Join(root, name)
+		tassert.CheckFatal(t, cos.CreateDir(mpath))
+		_, err := fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+	}
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	core.T = mock.NewTarget(mock.NewBaseBownerMock(bck))
+	errs := fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	tassert.Fatalf(t, len(errs) == 0, "failed to create %s: %v", bck, errs)
+
+	lom := core.AllocLOM("obj")
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, []byte(cvTestData), cos.PermRWR))
+
+	// mirror
+	var copyFQN string
+	for path, mi := range fs.GetAvail() {
+		if path != lom.Mountpath().Path {
+			copyFQN = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+		}
+	}
+	lom.Lock(true)
+	lom.SetSize(int64(len(cvTestData)))
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	_, err := lom.ComputeSetCksum()
+	if err == nil {
+		err = lom.Persist()
+	}
+	if err == nil {
+		var clone *core.LOM
+		if clone, err = lom.Copy2FQN(copyFQN, nil); err == nil {
+			core.FreeLOM(clone)
+		}
+	}
+	lom.Unlock(true)
+	tassert.CheckFatal(t, err)
+
+	cvCorrupt(t, lom.FQN)
+	lom.Uncache()
+	tassert.CheckFatal(t, lom.Load(false, false))
+	tassert.Fatalf(t, lom.HasCopies(), "expecting %s to have copies", lom)
+	err = cvValidate(lom)
+	tassert.Fatalf(t, cos.IsErrBadCksum(err), "expecting bad checksum, got %v", err)
+	return lom
+}
+
+// flip content in place (keeping the size and metadata)
+func cvCorrupt(t *testing.T, fqn string) {
+	fh, err := os.OpenFile(fqn, os.O_WRONLY, 0)
+	tassert.CheckFatal(t, err)
+	_, err = fh.WriteAt([]byte("BAD"), 0)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, fh.Close())
+}
+
+func cvValidate(lom *core.LOM) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	return lom.ValidateContentChecksum()
+}
+
+func cvNoWorkfiles(t *testing.T, lom *core.LOM) {
+	wdir := lom.Mountpath().MakePathCT(lom.Bucket(), fs.WorkfileType)
+	entries, _ := os.ReadDir(wdir)
+	tassert.Errorf(t, len(entries) == 0, "expecting no workfiles, got %d", len(entries))
+}
+
+func TestCksumVerifyRepairMirror(t *testing.T) {
+	lom := cvSetup(t)
+	tassert.CheckFatal(t, repairFromMirror(lom))
+
+	data, err := os.ReadFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(data) == cvTestData, "expecting restored content, got %q", data)
+
+	lom.Uncache()
+	tassert.CheckFatal(t, lom.Load(false, false))
+	tassert.Errorf(t, lom.NumCopies() == 2, "expecting 2 copies, got %d", lom.NumCopies())
+	tassert.CheckFatal(t, cvValidate(lom))
+	cvNoWorkfiles(t, lom)
+}
+
+// when there's nothing to repair from, the (corrupted) object must stay in place
+func TestCksumVerifyRepairFails(t *testing.T) {
+	lom := cvSetup(t)
+	lom.Lock(false)
+	for fqn := range lom.GetCopies() {
+		if fqn != lom.FQN {
+			cvCorrupt(t, fqn)
+		}
+	}
+	lom.Unlock(false)
+
+	err := repairFromMirror(lom)
+	tassert.Fatalf(t, err != nil, "expecting repair to fail")
+
+	lom.Uncache()
+	tassert.CheckFatal(t, lom.Load(false, false))
+	tassert.Errorf(t, lom.Lsize() == int64(len(cvTestData)), "corrupted object must remain in place")
+	err = cvValidate(lom)
+	tassert.Errorf(t, cos.IsErrBadCksum(err), "expecting bad checksum, got %v", err)
+	cvNoWorkfiles(t, lom)
+
+	_, err = (&XactCksumVerify{}).repair(lom)
+	tassert.Errorf(t, err != nil, "expecting repair to fail")
+	_, err = os.Stat(lom.FQN)
+	tassert.CheckError(t, err)
+}
@@ -6,8 +6,10 @@
 package xs
 
 import (
-	"strings"
+	"fmt"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -23,22 +25,25 @@ type (
 	evdFactory struct {
 		xreg.RenewBase
 		xctn *evictDelete
-		msg  *apc.ListRange
+		msg  *apc.EvdMsg
 		kind string
 	}
 	evictDelete struct {
 		lrit
 		xact.Base
 		config *cmn.Config
+		msg    *apc.EvdMsg
+		cutoff int64 // (when selecting by access time: msg.OlderThan)
 	}
 )
 
 //
 // evict/delete; utilizes mult-object lr-iterator
+// tier (apc.ActTierObjects): same as evict but first make sure the remote backend has the object
 //
 
 func (p *evdFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
-	msg := args.Custom.(*apc.ListRange)
+	msg := args.Custom.(*apc.EvdMsg)
 	debug.Assert(!msg.IsList() || !msg.HasTemplate())
 	np := &evdFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, kind: p.kind, msg: msg}
 	return np
@@ -56,16 +61,18 @@ func (*evdFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
 	return xreg.WprKeepAndStartNew, nil
 }
 
-func newEvictDelete(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.ListRange) (ed *evictDelete, err error) {
-	ed = &evictDelete{config: cmn.GCO.Get()}
-	if err = ed.lrit.init(ed, msg, bck, lrpWorkersDflt); err != nil {
+func newEvictDelete(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.EvdMsg) (ed *evictDelete, err error) {
+	ed = &evictDelete{config: cmn.GCO.Get(), msg: msg}
+	if err = ed.lrit.init(ed, &msg.ListRange, bck, lrpWorkersDflt); err != nil {
 		return nil, err
 	}
-
-	var sb strings.Builder
-	sb.Grow(80)
-	msg.Str(&sb, ed.lrp == lrpPrefix)
-	ed.InitBase(xargs.UUID, kind, sb.String() /*ctlmsg*/, bck)
+	if msg.OlderThan > 0 {
+		ed.cutoff = time.Now().UnixNano() - msg.OlderThan.D().Nanoseconds()
+	}
+	// evicting, offloading, selecting (by age or size), and dry-run counting all apply
+	// to in-cluster objects only - no need to list remote bucket
+	ed.lrit.local = kind != apc.ActDeleteObjects || msg.HasFilter() || msg.DryRun
+	ed.InitBase(xargs.UUID, kind, msg.Str(ed.lrp == lrpPrefix) /*ctlmsg*/, bck)
 
 	return ed, nil
 }
@@ -81,7 +88,26 @@ func (r *evictDelete) Run(wg *sync.WaitGroup) {
 }
 
 func (r *evictDelete) do(lom *core.LOM, lrit *lrit) {
-	ecode, err := core.T.DeleteObject(lom, r.Kind() == apc.ActEvictObjects)
+	if r.msg.HasFilter() && !r.selected(lom) {
+		return
+	}
+	if r.msg.DryRun {
+		// count what would be removed from this target (see also: `selected` - loads when filtering)
+		if lom.Load(false /*cache it*/, false /*locked*/) == nil {
+			r.ObjsAdd(1, lom.Lsize(true))
+		}
+		return
+	}
+	if r.Kind() == apc.ActTierObjects {
+		if ecode, err := r.offload(lom); err != nil {
+			// (not present in the cluster - nothing to do)
+			if ecode != 0 || (!cos.IsNotExist(err, 0) && !cmn.IsErrObjNought(err)) {
+				r.AddErr(err, 5, cos.SmoduleXs)
+			}
+			return
+		}
+	}
+	ecode, err := core.T.DeleteObject(lom, r.Kind() != apc.ActDeleteObjects)
 	if err == nil { // done
 		r.ObjsAdd(1, lom.Lsize(true))
 		return
@@ -96,6 +122,42 @@ eret:
 	r.AddErr(err, 5, cos.SmoduleXs)
 }
 
+// select in-cluster objects by access time and size (compare with space cleanup and LRU)
+func (r *evictDelete) selected(lom *core.LOM) bool {
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return false // not present (not "cached") or failed to load - either way, skip
+	}
+	if r.msg.MinSize > 0 && lom.Lsize() < r.msg.MinSize {
+		return false
+	}
+	return r.cutoff == 0 || lom.AtimeUnix() < r.cutoff
+}
+
+// upload in-cluster object to the remote backend unless the latter already has it;
+// never overwrite remote object that differs - fail instead (and keep in-cluster copy)
+func (*evictDelete) offload(lom *core.LOM) (int, error) {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return 0, err
+	}
+	oa, ecode, err := core.T.HeadCold(lom, nil /*origReq*/)
+	switch {
+	case err == nil:
+		if errEq := lom.CheckEq(oa); errEq != nil {
+			return 0, fmt.Errorf("tier %s: remote object differs [%v]", lom.Cname(), errEq)
+		}
+		return 0, nil
+	case ecode != http.StatusNotFound && !cos.IsNotExist(err, ecode):
+		return ecode, err
+	}
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		return 0, err
+	}
+	return core.T.Backend(lom.Bck()).PutObj(fh, lom, nil /*origReq*/)
+}
+
 func (r *evictDelete) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
@@ -0,0 +1,221 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+type (
+	// remote backend (HEAD and PUT) and object removal
+	evdTarget struct {
+		*mock.TargetMock
+		remote  map[string]*cmn.ObjAttrs // remote objects
+		puts    []string
+		deletes []string
+		listed  int // remote bucket listings
+		mu      sync.Mutex
+	}
+	evdBackend struct {
+		core.Backend // (not implemented)
+		t            *evdTarget
+	}
+)
+
+func (t *evdTarget) HeadCold(lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if oa, ok := t.remote[lom.ObjName]; ok {
+		return oa, 0, nil
+	}
+	return nil, http.StatusNotFound, cos.NewErrNotFound(nil, lom.Cname())
+}
+
+func (t *evdTarget) Backend(*meta.Bck) core.Backend { return &evdBackend{t: t} }
+
+func (t *evdTarget) DeleteObject(lom *core.LOM, _ bool) (int, error) {
+	t.mu.Lock()
+	t.deletes = append(t.deletes, lom.ObjName)
+	t.mu.Unlock()
+	return 0, nil
+}
+
+func (b *evdBackend) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	cos.Close(r)
+	b.t.mu.Lock()
+	b.t.puts = append(b.t.puts, lom.ObjName)
+	b.t.remote[lom.ObjName] = lom.ObjAttrs()
+	b.t.mu.Unlock()
+	return 0, nil
+}
+
+func (b *evdBackend) ListObjects(_ *meta.Bck, _ *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	b.t.mu.Lock()
+	b.t.listed++
+	lst.Entries = lst.Entries[:0]
+	for name := range b.t.remote {
+		lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: name})
+	}
+	b.t.mu.Unlock()
+	return 0, nil
+}
+
+// remote bucket (compare with rvSetup)
+func evdSetup(t *testing.T) (*evdTarget, *meta.Bck) {
+	var (
+		root = t.TempDir()
+		bck  = meta.NewBck("evd", apc.AWS, cmn.NsGlobal, &cmn.Bprops{
+			Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash},
+			BID:   0xa2,
+		})
+	)
+	fs.TestNew(nil)
+	t.Cleanup(func() { fs.TestNew(nil) })
+	for _, name := range []string{"mp1", "mp2"} {
+		mpath := filepath.Join(root, name)
+		tassert.CheckFatal(t, cos.CreateDir(mpath))
+		_, err := fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+	}
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	tmock := mock.NewTarget(mock.NewBaseBownerMock(bck))
+	tmock.SO = &rvSowner{rvSmap(rvLocal)}
+	et := &evdTarget{TargetMock: tmock, remote: make(map[string]*cmn.ObjAttrs)}
+	core.T = et
+	errs := fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	tassert.Fatalf(t, len(errs) == 0, "failed to create %s: %v", bck, errs)
+	return et, bck
+}
+
+func evdNew(t *testing.T, kind string, bck *meta.Bck, msg *apc.EvdMsg) *evictDelete {
+	cos.InitShortID(0)
+	r := &evictDelete{config: cmn.GCO.Get(), msg: msg}
+	r.lrit.parent, r.lrit.lrp, r.lrit.bck = r, lrpList, bck
+	if msg.OlderThan > 0 {
+		r.cutoff = time.Now().UnixNano() - msg.OlderThan.D().Nanoseconds()
+	}
+	r.InitBase(cos.GenUUID(), kind, "", bck)
+	t.Cleanup(func() { r.Finish() })
+	return r
+}
+
+// put object and set its access time
+func evdPut(t *testing.T, bck *meta.Bck, objName string, atime time.Time) *core.LOM {
+	lom, _ := rvPut(t, bck, objName, 1)
+	lom.Lock(true)
+	lom.SetAtimeUnix(atime.UnixNano())
+	tassert.CheckFatal(t, lom.Persist())
+	lom.Unlock(true)
+	lom.Uncache()
+	tassert.CheckFatal(t, os.Chtimes(lom.FQN, atime, atime))
+	return lom
+}
+
+// offload (tier) selected objects to remote backend, and evict
+func TestTierObjects(t *testing.T) {
+	var (
+		et, bck = evdSetup(t)
+		old     = time.Now().Add(-48 * time.Hour)
+		msg     = &apc.EvdMsg{OlderThan: cos.Duration(time.Hour), MinSize: 10}
+		r       = evdNew(t, apc.ActTierObjects, bck, msg)
+	)
+	var (
+		oldBig  = evdPut(t, bck, "tier-old-big-object", old)
+		recent  = evdPut(t, bck, "tier-new-big-object", time.Now())
+		small   = evdPut(t, bck, "tier-sm", old)
+		same    = evdPut(t, bck, "tier-old-same-object", old)
+		differs = evdPut(t, bck, "tier-old-diff-object", old)
+	)
+	et.remote[same.ObjName] = same.ObjAttrs()
+	et.remote[differs.ObjName] = &cmn.ObjAttrs{Size: 1, Cksum: cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef")}
+
+	for _, lom := range []*core.LOM{oldBig, recent, small, same, differs} {
+		r.do(rvLoad(t, lom.FQN, bck), &r.lrit)
+	}
+
+	// uploaded only when not present remotely; evicted only when remote has it
+	tassert.Errorf(t, slices.Equal(et.puts, []string{oldBig.ObjName}), "unexpected uploads: %v", et.puts)
+	slices.Sort(et.deletes)
+	tassert.Errorf(t, slices.Equal(et.deletes, []string{oldBig.ObjName, same.ObjName}), "unexpected evictions: %v", et.deletes)
+
+	// never overwriting remote object that differs
+	tassert.Errorf(t, r.ErrCnt() == 1, "expected 1 error (remote differs), got %d (%v)", r.ErrCnt(), r.Err())
+	tassert.Errorf(t, et.remote[differs.ObjName].Size == 1, "remote object must not be overwritten")
+	tassert.Errorf(t, r.Objs() == 2, "expected 2 objects, got %d", r.Objs())
+
+	// dry-run: counting what would be offloaded and evicted
+	et.puts, et.deletes = nil, nil
+	dry := evdNew(t, apc.ActTierObjects, bck, &apc.EvdMsg{OlderThan: msg.OlderThan, MinSize: msg.MinSize, DryRun: true})
+	for _, lom := range []*core.LOM{oldBig, recent, small} {
+		dry.do(rvLoad(t, lom.FQN, bck), &dry.lrit)
+	}
+	tassert.Errorf(t, dry.Objs() == 1 && len(et.puts) == 0 && len(et.deletes) == 0,
+		"dry-run: expected 1 object and no changes, got %d (%v, %v)", dry.Objs(), et.puts, et.deletes)
+}
+
+// evict by prefix and age: visiting in-cluster objects without listing remote bucket
+func TestEvictPrefixLocal(t *testing.T) {
+	var (
+		et, bck = evdSetup(t)
+		old     = time.Now().Add(-48 * time.Hour)
+		smap    = core.T.Sowner().Get()
+	)
+	evdPut(t, bck, "logs-a", old)
+	evdPut(t, bck, "logs-b", old)
+	evdPut(t, bck, "logs-recent", time.Now())
+	evdPut(t, bck, "other-c", old)
+	et.remote["logs-remote-only"] = &cmn.ObjAttrs{Size: 1}
+
+	tests := []struct {
+		name    string
+		kind    string
+		msg     *apc.EvdMsg
+		listed  bool
+		deletes []string
+		objs    int64
+	}{
+		{"evict-by-age", apc.ActEvictObjects, &apc.EvdMsg{OlderThan: cos.Duration(time.Hour)}, false, []string{"logs-a", "logs-b"}, 2},
+		{"evict", apc.ActEvictObjects, &apc.EvdMsg{}, false, []string{"logs-a", "logs-b", "logs-recent"}, 3},
+		{"delete-dry-run", apc.ActDeleteObjects, &apc.EvdMsg{DryRun: true}, false, nil, 3},
+		{"delete", apc.ActDeleteObjects, &apc.EvdMsg{}, true, []string{"logs-remote-only"}, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			et.deletes, et.listed = nil, 0
+			test.msg.Template = "logs-"
+			r, err := newEvictDelete(&xreg.Args{UUID: cos.GenUUID()}, test.kind, bck, test.msg)
+			tassert.CheckFatal(t, err)
+			t.Cleanup(func() { r.Finish() })
+			r.lrit.workers = nil // run synchronously
+			tassert.Fatalf(t, r.lrit.lrp == lrpPrefix, "expected prefix, got %d", r.lrit.lrp)
+
+			tassert.CheckFatal(t, r.lrit._prefix(r, smap))
+
+			slices.Sort(et.deletes)
+			tassert.Errorf(t, slices.Equal(et.deletes, test.deletes), "unexpected deletions: %v", et.deletes)
+			tassert.Errorf(t, (et.listed > 0) == test.listed, "remote listing: expected %t, got %d", test.listed, et.listed)
+			tassert.Errorf(t, r.Objs() == test.objs, "expected %d objects, got %d", test.objs, r.Objs())
+		})
+	}
+}
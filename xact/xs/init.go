@@ -29,10 +29,12 @@ func Treg(coi COI) {
 
 	xreg.RegNonBckXact(&resFactory{})
 	xreg.RegNonBckXact(&rebFactory{})
+	xreg.RegNonBckXact(&rvFactory{})
 	xreg.RegNonBckXact(&etlFactory{})
 
 	xreg.RegBckXact(&bmvFactory{})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActEvictObjects})
+	xreg.RegBckXact(&evdFactory{kind: apc.ActTierObjects})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActDeleteObjects})
 	xreg.RegBckXact(&prfFactory{})
 
@@ -40,6 +42,8 @@ func Treg(coi COI) {
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&invFactory{})
+	xreg.RegBckXact(&cvFactory{})
 
 	gcoi = coi
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
@@ -0,0 +1,232 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"encoding/csv"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-make-inventory: AIS-native bucket inventory (see cmn/inventory.go)
+// - walks local objects of a given bucket and writes them as CSV records (cmn.NativeInvHeader);
+// - stores the result in the same bucket as a single inventory part that HRW-maps to this target;
+// - keeps only the `inventory.keep` most recent local parts (including the new one), if configured
+// (periodic inventories are started by the primary - see ais/prxinv.go)
+
+const invMaxParts = 1 << 16 // (see invPartLOM)
+
+type (
+	invFactory struct {
+		xreg.RenewBase
+		xctn *XactInventory
+	}
+	XactInventory struct {
+		smap *meta.Smap
+		sgl  *memsys.SGL
+		w    *csv.Writer
+		old  map[string]*invOld // previously generated inventory parts stored by this target
+		part string
+		xact.BckJog
+		mu sync.Mutex
+	}
+	invOld struct {
+		parts []string
+		atime int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactInventory)(nil)
+	_ xreg.Renewable = (*invFactory)(nil)
+)
+
+////////////////
+// invFactory //
+////////////////
+
+func (*invFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &invFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *invFactory) Start() error {
+	p.xctn = newInventory(p.UUID(), p.Bck)
+	return nil
+}
+
+func (*invFactory) Kind() string     { return apc.ActMakeInventory }
+func (p *invFactory) Get() core.Xact { return p.xctn }
+
+func (*invFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+///////////////////
+// XactInventory //
+///////////////////
+
+func newInventory(id string, bck *meta.Bck) (r *XactInventory) {
+	r = &XactInventory{
+		smap: core.T.Sowner().Get(),
+		sgl:  core.T.PageMM().NewSGL(0),
+		old:  make(map[string]*invOld, 4),
+	}
+	r.w = csv.NewWriter(r.sgl)
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.Load,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(id, apc.ActMakeInventory, "" /*ctlmsg*/, bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactInventory) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.w.Write(cmn.NativeInvHeader)
+
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err == nil {
+		err = r.write()
+	}
+	if err == nil {
+		r.cleanup()
+	} else {
+		r.AddErr(err)
+	}
+	r.sgl.Free()
+	r.Finish()
+}
+
+func (r *XactInventory) visitObj(lom *core.LOM, _ []byte) error {
+	if cmn.IsNativeInvObj(lom.ObjName) {
+		if id := cmn.NativeInvID(lom.ObjName); id != "" {
+			r.mu.Lock()
+			old, ok := r.old[id]
+			if !ok {
+				old = &invOld{}
+				r.old[id] = old
+			}
+			old.parts = append(old.parts, lom.ObjName)
+			old.atime = max(old.atime, lom.AtimeUnix())
+			r.mu.Unlock()
+		}
+		return nil
+	}
+	rec := cmn.NativeInvRecord(lom.ObjName, lom.Lsize(), lom.Checksum(), lom.Version(), lom.AtimeUnix())
+	r.mu.Lock()
+	err := r.w.Write(rec)
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	r.ObjsAdd(1, lom.Lsize())
+	return nil
+}
+
+func (r *XactInventory) write() error {
+	r.w.Flush()
+	if err := r.w.Error(); err != nil {
+		return err
+	}
+	lom, err := r.invPartLOM()
+	if err != nil {
+		return err
+	}
+	defer core.FreeLOM(lom)
+
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfilePut
+		params.Reader = memsys.NewReader(r.sgl)
+		params.Atime = lom.Atime()
+		params.Xact = r
+		params.Size = r.sgl.Size()
+		params.OWT = cmn.OwtPut
+	}
+	err = core.T.PutObject(lom, params)
+	core.FreePutParams(params)
+	if err == nil {
+		r.part = lom.ObjName
+		nlog.Infoln(r.Name(), "=>", lom.Cname())
+	}
+	return err
+}
+
+// remove older inventory parts stored by this target beyond `inventory.keep`
+func (r *XactInventory) cleanup() {
+	keep := r.Bck().Props.Inventory.Keep
+	if keep <= 0 || len(r.old) < keep {
+		return
+	}
+	olds := make([]*invOld, 0, len(r.old))
+	for _, old := range r.old {
+		olds = append(olds, old)
+	}
+	sort.Slice(olds, func(i, j int) bool { return olds[i].atime > olds[j].atime })
+	for _, old := range olds[keep-1:] { // (keep-1) + the one just written
+		for _, objName := range old.parts {
+			lom := core.AllocLOM(objName)
+			if err := lom.InitBck(r.Bck().Bucket()); err == nil {
+				if _, err := core.T.DeleteObject(lom, false /*evict*/); err != nil && !cos.IsNotExist(err, 0) {
+					nlog.Errorln(r.Name(), "failed to remove old inventory part", lom.Cname(), "err:", err)
+				}
+			}
+			core.FreeLOM(lom)
+		}
+	}
+}
+
+// inventory parts are regular objects - select the first part name that HRW-maps to this target
+func (r *XactInventory) invPartLOM() (*core.LOM, error) {
+	bck := r.Bck().Bucket()
+	for n := range invMaxParts {
+		lom := core.AllocLOM(cmn.NativeInvPartName(r.ID(), n))
+		if err := lom.InitBck(bck); err != nil {
+			core.FreeLOM(lom)
+			return nil, err
+		}
+		_, local, err := lom.HrwTarget(r.smap)
+		if err != nil {
+			core.FreeLOM(lom)
+			return nil, err
+		}
+		if local {
+			return lom, nil
+		}
+		core.FreeLOM(lom)
+	}
+	return nil, errors.New(r.Name() + ": failed to select inventory part name")
+}
+
+func (r *XactInventory) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	if r.part != "" && r.Finished() {
+		snap.Ext = r.part
+	}
+	return
+}
@@ -6,7 +6,8 @@
 package xs
 
 import (
-	"sync"
+	"context"
+	"fmt"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -52,8 +53,10 @@ type (
 	// a strict subset of core.Xact, includes only the methods
 	// lrit needs for itself
 	lrxact interface {
+		Name() string
 		IsAborted() bool
 		Finished() bool
+		Abort(error) bool
 	}
 
 	// running concurrency
@@ -72,12 +75,14 @@ type (
 		bck    *meta.Bck
 		pt     *cos.ParsedTemplate
 		prefix string
-		lrp    int // { lrpList, ... } enum
+		lrp    int     // { lrpList, ... } enum
+		quota  *jquota // stop iterating upon reaching (optional)
+		local  bool    // prefix: visit in-cluster objects only (remote bucket is not listed)
 
 		// running concurrency
 		workCh  chan lrpair
 		workers []*lrworker
-		wg      sync.WaitGroup
+		wpool   *cos.WorkerPool
 	}
 )
 
@@ -158,6 +163,9 @@ func (r *lrit) init(xctn lrxact, msg *apc.ListRange, bck *meta.Bck, numWorkers i
 
 	// work channel capacity: up to 4 pending work items per
 	r.workCh = make(chan lrpair, min(numWorkers<<2, 512))
+	// fail fast: a failed (or panicked) worker stops the others and unblocks
+	// the producer (see do); wait() then aborts the parent xaction
+	r.wpool = cos.NewWorkerPool(context.Background(), numWorkers, true /*fail fast*/)
 	return nil
 }
 
@@ -192,8 +200,7 @@ pref:
 
 func (r *lrit) run(wi lrwi, smap *meta.Smap) (err error) {
 	for _, worker := range r.workers {
-		r.wg.Add(1)
-		go worker.run()
+		r.wpool.Go(worker.run)
 	}
 	switch r.lrp {
 	case lrpList:
@@ -211,10 +218,17 @@ func (r *lrit) wait() {
 		return
 	}
 	close(r.workCh)
-	r.wg.Wait()
+	err := r.wpool.Wait()
+	for lrpair := range r.workCh { // not processed
+		core.FreeLOM(lrpair.lom)
+	}
+	if err != nil {
+		nlog.Errorln(r.parent.Name(), "list-range workers:", err)
+		r.parent.Abort(err)
+	}
 }
 
-func (r *lrit) done() bool { return r.parent.IsAborted() || r.parent.Finished() }
+func (r *lrit) done() bool { return r.parent.IsAborted() || r.parent.Finished() || r.quota.done() }
 
 func (r *lrit) _list(wi lrwi, smap *meta.Smap) error {
 	r.lrp = lrpList
@@ -264,7 +278,7 @@ func (r *lrit) _prefix(wi lrwi, smap *meta.Smap) error {
 		lst     *cmn.LsoRes
 		lsmsg   = &apc.LsoMsg{Prefix: r.prefix, Props: apc.GetPropsStatus}
 		npg     = newNpgCtx(r.bck, lsmsg, noopCb, nil /*core.LsoInvCtx bucket inventory*/)
-		bremote = r.bck.IsRemote()
+		bremote = r.bck.IsRemote() && !r.local
 	)
 	lsmsg.SetFlag(apc.LsNoDirs)
 
@@ -343,17 +357,32 @@ func (r *lrit) do(lom *core.LOM, wi lrwi, smap *meta.Smap) (bool /*this lom done
 		wi.do(lom, r)
 		return true, nil
 	}
-	r.workCh <- lrpair{lom, wi} // lom eventually freed below
-	return false, nil
+	return false, r.push(lom, wi)
+}
+
+func (r *lrit) push(lom *core.LOM, wi lrwi) error {
+	select {
+	case r.workCh <- lrpair{lom, wi}: // lom eventually freed below
+		return nil
+	case <-r.wpool.Ctx().Done():
+		return fmt.Errorf("%s: list-range workers failed: %w", r.parent.Name(), r.wpool.Ctx().Err())
+	}
 }
 
 //////////////
 // lrworker //
 //////////////
 
-func (worker *lrworker) run() {
+func (worker *lrworker) run(ctx context.Context) error {
 	for {
-		lrpair, ok := <-worker.lrit.workCh
+		var (
+			lrpair lrpair
+			ok     bool
+		)
+		select {
+		case lrpair, ok = <-worker.lrit.workCh:
+		case <-ctx.Done():
+		}
 		if !ok {
 			break
 		}
@@ -363,5 +392,5 @@ func (worker *lrworker) run() {
 			break
 		}
 	}
-	worker.lrit.wg.Done()
+	return nil
 }
@@ -0,0 +1,66 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type (
+	lritMock struct {
+		aborted atomic.Bool
+	}
+	lrwiPanic struct{}
+)
+
+func (*lritMock) Name() string        { return "mock-lrit" }
+func (x *lritMock) IsAborted() bool   { return x.aborted.Load() }
+func (*lritMock) Finished() bool      { return false }
+func (x *lritMock) Abort(error) bool  { return x.aborted.CompareAndSwap(false, true) }
+func (lrwiPanic) do(*core.LOM, *lrit) { panic("boom") }
+
+// panicking worker must neither block the producer nor go unnoticed
+func TestLritWorkerPanic(t *testing.T) {
+	const numWorkers = 2
+	var (
+		parent = &lritMock{}
+		r      = &lrit{parent: parent, workCh: make(chan lrpair, 1)}
+	)
+	r.wpool = cos.NewWorkerPool(context.Background(), numWorkers, true /*fail fast*/)
+	for range numWorkers {
+		r.workers = append(r.workers, &lrworker{r})
+		r.wpool.Go(r.workers[len(r.workers)-1].run)
+	}
+
+	var (
+		err  error
+		done = make(chan struct{})
+	)
+	go func() {
+		for range 100 {
+			if err = r.push(core.AllocLOM("o"), lrwiPanic{}); err != nil {
+				break
+			}
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("producer blocked")
+	}
+	tassert.Fatalf(t, err != nil, "expected producer to fail")
+
+	r.wait()
+	tassert.Errorf(t, parent.IsAborted(), "expected parent xaction to be aborted")
+}
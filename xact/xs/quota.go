@@ -0,0 +1,103 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"strconv"
+	"strings"
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// apc.JobQuota: this target's share of the job-wide caps
+// - objects and bytes are counted upon (successful) processing;
+// - checked prior to processing the next object, and so may overshoot by
+//   the number of concurrent workers
+
+const partialQuota = "quota reached"
+
+type (
+	jquota struct {
+		maxObjs  int64
+		maxBytes int64
+		objs     atomic.Int64
+		bytes    atomic.Int64
+		reached  atomic.Bool
+	}
+	// xaction that runs multiple work items (jobs), each with its own quota
+	xquota struct {
+		ratomic.Pointer[jquota] // the first one reached
+	}
+)
+
+// returns nil when no quota is requested
+func newJquota(q *apc.JobQuota, nat int) *jquota {
+	if !q.IsSet() {
+		return nil
+	}
+	nat = max(nat, 1)
+	return &jquota{maxObjs: _share(q.MaxObjects, nat), maxBytes: _share(q.MaxBytes, nat)}
+}
+
+// round up (except when not set)
+func _share(n int64, nat int) int64 { return (n + int64(nat) - 1) / int64(nat) }
+
+// nil-safe
+func (q *jquota) done() bool { return q != nil && q.reached.Load() }
+
+func (q *jquota) add(size int64) {
+	if q == nil {
+		return
+	}
+	objs, bytes := q.objs.Inc(), q.bytes.Add(size)
+	if (q.maxObjs > 0 && objs >= q.maxObjs) || (q.maxBytes > 0 && bytes >= q.maxBytes) {
+		q.reached.Store(true)
+	}
+}
+
+func (q *jquota) toSnap(snap *core.Snap) {
+	if !q.done() {
+		return
+	}
+	var sb strings.Builder
+	sb.Grow(64)
+	sb.WriteString(partialQuota)
+	sb.WriteString(": processed ")
+	sb.WriteString(strconv.FormatInt(q.objs.Load(), 10))
+	sb.WriteString(" object(s), ")
+	sb.WriteString(cos.ToSizeIEC(q.bytes.Load(), 2))
+	sb.WriteString(" (target's share:")
+	if q.maxObjs > 0 {
+		sb.WriteString(" max-objects=")
+		sb.WriteString(strconv.FormatInt(q.maxObjs, 10))
+	}
+	if q.maxBytes > 0 {
+		sb.WriteString(" max-bytes=")
+		sb.WriteString(cos.ToSizeIEC(q.maxBytes, 0))
+	}
+	sb.WriteByte(')')
+	snap.Partial = sb.String()
+}
+
+////////////
+// xquota //
+////////////
+
+func (x *xquota) check(q *jquota) {
+	if q.done() {
+		x.CompareAndSwap(nil, q)
+	}
+}
+
+func (x *xquota) toSnap(snap *core.Snap) {
+	if q := x.Load(); q != nil {
+		q.toSnap(snap)
+	}
+}
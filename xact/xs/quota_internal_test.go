@@ -0,0 +1,53 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestJobQuota(t *testing.T) {
+	tassert.Fatalf(t, newJquota(&apc.JobQuota{}, 3) == nil, "expecting no quota")
+	var q *jquota
+	q.add(1) // nil-safe
+	tassert.Fatalf(t, !q.done(), "nil quota must never be reached")
+
+	// objects: 10 across 3 targets => 4 per target
+	q = newJquota(&apc.JobQuota{MaxObjects: 10}, 3)
+	tassert.Fatalf(t, q.maxObjs == 4 && q.maxBytes == 0, "unexpected share %+v", q)
+	for range 3 {
+		q.add(cos.MiB)
+	}
+	tassert.Fatalf(t, !q.done(), "not expecting quota reached")
+	q.add(cos.MiB)
+	tassert.Fatalf(t, q.done(), "expecting quota reached")
+
+	// bytes
+	q = newJquota(&apc.JobQuota{MaxBytes: cos.GiB}, 2)
+	q.add(cos.GiB / 4)
+	tassert.Fatalf(t, !q.done(), "not expecting quota reached")
+	q.add(cos.GiB / 4)
+	tassert.Fatalf(t, q.done(), "expecting quota reached")
+
+	// snap
+	var (
+		x    xquota
+		snap core.Snap
+	)
+	x.toSnap(&snap)
+	tassert.Fatalf(t, snap.Partial == "", "expecting empty partial, got %q", snap.Partial)
+	x.check(newJquota(&apc.JobQuota{MaxObjects: 1}, 1)) // not reached
+	x.check(q)
+	x.toSnap(&snap)
+	tassert.Fatalf(t, strings.HasPrefix(snap.Partial, partialQuota) && strings.Contains(snap.Partial, "max-bytes=512MiB"),
+		"unexpected partial %q", snap.Partial)
+}
@@ -0,0 +1,245 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-verify-rebalance: post-rebalance verification pass that walks all local buckets
+// (all objects or a sample) and checks:
+// - object placement: HRW target and HRW mountpath
+// - n-way mirroring: number of copies
+// - erasure coding: slice distribution as per EC metafiles
+// The resulting report is returned via `Snap.Ext` and, upon completion, persisted
+// (see xact.RebVerifyReport)
+
+type (
+	rvFactory struct {
+		xreg.RenewBase
+		xctn *XactRebVerify
+	}
+	XactRebVerify struct {
+		smap *meta.Smap
+		xact.BckJog
+		examples struct {
+			names []string
+			mu    sync.Mutex
+		}
+		checked     atomic.Int64
+		misplaced   atomic.Int64
+		misplacedMi atomic.Int64
+		badCopies   atomic.Int64
+		badEC       atomic.Int64
+		sampled     bool
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactRebVerify)(nil)
+	_ xreg.Renewable = (*rvFactory)(nil)
+)
+
+///////////////
+// rvFactory //
+///////////////
+
+func (*rvFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &rvFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *rvFactory) Start() error {
+	flags, ok := p.Args.Custom.(uint32)
+	debug.Assert(ok)
+	p.xctn = newRebVerify(p.UUID(), flags)
+	return nil
+}
+
+func (*rvFactory) Kind() string     { return apc.ActRebVerify }
+func (p *rvFactory) Get() core.Xact { return p.xctn }
+
+func (*rvFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+///////////////////
+// XactRebVerify //
+///////////////////
+
+func newRebVerify(id string, flags uint32) (r *XactRebVerify) {
+	r = &XactRebVerify{
+		smap:    core.T.Sowner().Get(),
+		sampled: flags&xact.XrvSample != 0,
+	}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType, fs.ECMetaType},
+		VisitObj: r.visitObj,
+		VisitCT:  r.visitCT,
+		DoLoad:   mpather.Load,
+	}
+	var ctlmsg string
+	if r.sampled {
+		ctlmsg = "sampled"
+	}
+	// (empty bucket: all buckets)
+	r.BckJog.Init(id, apc.ActRebVerify, ctlmsg, nil /*bck*/, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactRebVerify) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "smap", r.smap.StringEx())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+	rep := r.report()
+	nlog.Infoln(r.Name(), "checked:", rep.Checked, "failed:", rep.Failed())
+	if err := xact.SaveRebVerify(cmn.GCO.Get().ConfigDir, rep); err != nil {
+		nlog.Errorln(r.Name(), "failed to persist verification report:", err)
+	}
+}
+
+func (r *XactRebVerify) skip(digest uint64) bool {
+	return r.sampled && digest%xact.RebVerifySampleRate != 0
+}
+
+func (r *XactRebVerify) visitObj(lom *core.LOM, _ []byte) error {
+	if r.skip(lom.Digest()) {
+		return nil
+	}
+	// mirror copies are accounted for (once) with their respective main replicas
+	if lom.IsCopy() {
+		return nil
+	}
+	r.checked.Inc()
+	r.ObjsAdd(1, lom.Lsize())
+
+	// EC: full replicas and slices are placed by the EC itself (see visitCT)
+	if lom.ECEnabled() {
+		return nil
+	}
+	tsi, local, err := lom.HrwTarget(r.smap)
+	if err != nil {
+		return err
+	}
+	if !local {
+		r.misplaced.Inc()
+		r.example(lom.Cname() + " => " + tsi.StringEx())
+		return nil
+	}
+	if !lom.IsHRW() && !lom.IsCopy() {
+		r.misplacedMi.Inc()
+		r.example(lom.Cname() + " @ " + lom.Mountpath().String())
+		return nil
+	}
+	if mirror := lom.MirrorConf(); mirror.Enabled {
+		if n := numCopies(lom); n != int(mirror.Copies) {
+			r.badCopies.Inc()
+			r.example(fmt.Sprintf("%s: wrong number of copies (%d, expecting %d)", lom.Cname(), n, mirror.Copies))
+		}
+	}
+	return nil
+}
+
+// number of distinct (by FQN) replicas that are actually present, including the main one
+func numCopies(lom *core.LOM) int {
+	lom.Lock(false)
+	copies := lom.GetCopies()
+	lom.Unlock(false)
+	n := 1
+	for fqn := range copies {
+		if fqn == lom.FQN {
+			continue
+		}
+		if err := cos.Stat(fqn); err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *XactRebVerify) visitCT(ct *core.CT, _ []byte) error {
+	if ct.ContentType() != fs.ECMetaType || r.skip(ct.Digest()) {
+		return nil
+	}
+	r.checked.Inc()
+	md, err := ec.LoadMetadata(ct.FQN())
+	if err != nil {
+		r.badEC.Inc()
+		r.example(ct.Cname() + ": " + err.Error())
+		return nil
+	}
+	expected := md.Data + md.Parity + 1
+	if md.IsCopy {
+		expected = md.Parity + 1
+	}
+	if _, ok := md.Daemons[core.T.SID()]; !ok || len(md.Daemons) != expected {
+		r.badEC.Inc()
+		r.example(ct.Cname() + ": unexpected slice distribution")
+		return nil
+	}
+	for tid := range md.Daemons {
+		if r.smap.GetTarget(tid) == nil {
+			r.badEC.Inc()
+			r.example(ct.Cname() + ": slice on unknown target " + meta.Tname(tid))
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *XactRebVerify) example(s string) {
+	r.examples.mu.Lock()
+	if len(r.examples.names) < xact.RebVerifyMaxExamples {
+		r.examples.names = append(r.examples.names, s)
+	}
+	r.examples.mu.Unlock()
+}
+
+func (r *XactRebVerify) report() *xact.RebVerifyReport {
+	rep := &xact.RebVerifyReport{
+		ID:          r.ID(),
+		Tid:         core.T.SID(),
+		SmapVersion: r.smap.Version,
+		Sampled:     r.sampled,
+		Checked:     r.checked.Load(),
+		Misplaced:   r.misplaced.Load(),
+		MisplacedMi: r.misplacedMi.Load(),
+		BadCopies:   r.badCopies.Load(),
+		BadEC:       r.badEC.Load(),
+	}
+	r.examples.mu.Lock()
+	rep.Examples = append(rep.Examples, r.examples.names...)
+	r.examples.mu.Unlock()
+	return rep
+}
+
+func (r *XactRebVerify) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	snap.Ext = r.report()
+	return
+}
@@ -0,0 +1,227 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+const rvLocal = "mock-id" // (see mock.TargetMock.SID)
+
+type (
+	rvSowner    struct{ smap *meta.Smap }
+	rvListeners struct{}
+)
+
+func (o *rvSowner) Get() *meta.Smap             { return o.smap }
+func (*rvSowner) Listeners() meta.SmapListeners { return rvListeners{} }
+func (rvListeners) Reg(meta.Slistener)          {}
+func (rvListeners) Unreg(meta.Slistener)        {}
+
+func rvSmap(tids ...string) *meta.Smap {
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, len(tids)), Version: 1}
+	for _, tid := range tids {
+		tsi := &meta.Snode{}
+		tsi.Init(tid, apc.Target)
+		smap.Tmap[tid] = tsi
+	}
+	return smap
+}
+
+// two mountpaths, n-way mirrored bucket
+func rvSetup(t *testing.T, smap *meta.Smap) (*XactRebVerify, *meta.Bck) {
+	var (
+		root = t.TempDir()
+		bck  = meta.NewBck("reb-verify", apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+			Cksum:  cmn.CksumConf{Type: cos.ChecksumXXHash},
+			Mirror: cmn.MirrorConf{Enabled: true, Copies: 2},
+			BID:    0xa1,
+		})
+	)
+	fs.TestNew(nil)
+	t.Cleanup(func() { fs.TestNew(nil) })
+	for _, name := range []string{"mp1", "mp2"} {
+		mpath := filepath.Join(root, name)
+		tassert.CheckFatal(t, cos.CreateDir(mpath))
+		_, err := fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+	}
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ECMetaType, &fs.ECMetaContentResolver{}, true)
+	tmock := mock.NewTarget(mock.NewBaseBownerMock(bck))
+	tmock.SO = &rvSowner{smap}
+	core.T = tmock
+	errs := fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	tassert.Fatalf(t, len(errs) == 0, "failed to create %s: %v", bck, errs)
+	return &XactRebVerify{smap: smap}, bck
+}
+
+// create object at its HRW location with (mirrorCopies - 1) additional copies
+func rvPut(t *testing.T, bck *meta.Bck, objName string, mirrorCopies int) (lom *core.LOM, copyFQN string) {
+	lom = core.AllocLOM(objName)
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, []byte(objName), cos.PermRWR))
+	for path, mi := range fs.GetAvail() {
+		if path != lom.Mountpath().Path {
+			copyFQN = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+		}
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	lom.SetSize(int64(len(objName)))
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	_, err := lom.ComputeSetCksum()
+	if err == nil {
+		err = lom.Persist()
+	}
+	if err == nil && mirrorCopies > 1 {
+		var clone *core.LOM
+		if clone, err = lom.Copy2FQN(copyFQN, nil); err == nil {
+			core.FreeLOM(clone)
+		}
+	}
+	tassert.CheckFatal(t, err)
+	return lom, copyFQN
+}
+
+func rvLoad(t *testing.T, fqn string, bck *meta.Bck) *core.LOM {
+	lom := core.AllocLOM("")
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	tassert.CheckFatal(t, lom.InitFQN(fqn, bck.Bucket()))
+	tassert.CheckFatal(t, lom.Load(false, false))
+	return lom
+}
+
+func TestRebVerifyPlacement(t *testing.T) {
+	smap := rvSmap(rvLocal, "t2")
+	r, bck := rvSetup(t, smap)
+
+	// find one local and one remote (as per HRW) object names
+	var local, remote string
+	for i := 0; local == "" || remote == ""; i++ {
+		name := "obj-" + strconv.Itoa(i)
+		tsi, err := smap.HrwName2T(bck.MakeUname(name))
+		tassert.CheckFatal(t, err)
+		if tsi.ID() == rvLocal {
+			local = name
+		} else {
+			remote = name
+		}
+	}
+
+	lom, _ := rvPut(t, bck, local, 2)
+	tassert.CheckFatal(t, r.visitObj(lom, nil))
+	rep := r.report()
+	tassert.Fatalf(t, rep.Checked == 1 && rep.Failed() == 0, "expecting no issues, got %+v", rep)
+
+	lom, _ = rvPut(t, bck, remote, 2)
+	tassert.CheckFatal(t, r.visitObj(lom, nil))
+	rep = r.report()
+	tassert.Fatalf(t, rep.Misplaced == 1 && len(rep.Examples) == 1, "expecting misplaced, got %+v", rep)
+
+	// wrong mountpath: move the main replica (a non-mirrored object) away from its HRW mountpath
+	r, bck = rvSetup(t, rvSmap(rvLocal))
+	bck.Props.Mirror.Enabled = false
+	lom, otherFQN := rvPut(t, bck, "misplaced-mpath", 1)
+	tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(otherFQN), cos.PermRWXRX))
+	tassert.CheckFatal(t, os.Rename(lom.FQN, otherFQN))
+	lom = rvLoad(t, otherFQN, bck)
+	tassert.CheckFatal(t, r.visitObj(lom, nil))
+	rep = r.report()
+	tassert.Fatalf(t, rep.MisplacedMi == 1 && rep.Failed() == 1, "expecting misplaced mountpath, got %+v", rep)
+}
+
+func TestRebVerifyCopies(t *testing.T) {
+	r, bck := rvSetup(t, rvSmap(rvLocal))
+	lom, copyFQN := rvPut(t, bck, "mirrored", 2)
+
+	// the main replica and its copy: checked (and counted) once
+	tassert.CheckFatal(t, r.visitObj(lom, nil))
+	tassert.CheckFatal(t, r.visitObj(rvLoad(t, copyFQN, bck), nil))
+	rep := r.report()
+	tassert.Fatalf(t, rep.Checked == 1 && rep.Failed() == 0, "expecting 1 checked, no issues, got %+v", rep)
+
+	// the copy is listed in metadata but is missing
+	tassert.CheckFatal(t, os.Remove(copyFQN))
+	lom = rvLoad(t, lom.FQN, bck)
+	tassert.CheckFatal(t, r.visitObj(lom, nil))
+	rep = r.report()
+	tassert.Fatalf(t, rep.Checked == 2 && rep.BadCopies == 1, "expecting bad copies, got %+v", rep)
+}
+
+func TestRebVerifyEC(t *testing.T) {
+	smap := rvSmap(rvLocal, "t2", "t3")
+	r, bck := rvSetup(t, smap)
+	lom, _ := rvPut(t, bck, "encoded", 1)
+	ct := core.NewCTFromLOM(lom, fs.ECMetaType)
+
+	tests := []struct {
+		daemons cos.MapStrUint16
+		isCopy  bool
+		bad     bool
+	}{
+		{daemons: cos.MapStrUint16{rvLocal: 0, "t2": 1, "t3": 2}},
+		{daemons: cos.MapStrUint16{rvLocal: 0, "t2": 1}, bad: true},          // missing slice
+		{daemons: cos.MapStrUint16{"t2": 1, "t3": 2, "t4": 0}, bad: true},    // not including self
+		{daemons: cos.MapStrUint16{rvLocal: 0, "t2": 1, "t4": 2}, bad: true}, // unknown target
+		{daemons: cos.MapStrUint16{rvLocal: 0, "t2": 0}, isCopy: true},       // replicated
+		{daemons: cos.MapStrUint16{rvLocal: 0, "t2": 0, "t3": 0}, isCopy: true, bad: true},
+	}
+	var bad int64
+	for i, test := range tests {
+		md := ec.NewMetadata()
+		md.Data, md.Parity, md.IsCopy, md.Daemons = 1, 1, test.isCopy, test.daemons
+		tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(ct.FQN()), cos.PermRWXRX))
+		tassert.CheckFatal(t, os.WriteFile(ct.FQN(), md.NewPack(), cos.PermRWR))
+		tassert.CheckFatal(t, r.visitCT(ct, nil))
+		if test.bad {
+			bad++
+		}
+		rep := r.report()
+		tassert.Fatalf(t, rep.BadEC == bad, "%d: %v: expecting %d bad, got %+v", i, test.daemons, bad, rep)
+	}
+
+	// corrupted metafile
+	tassert.CheckFatal(t, os.WriteFile(ct.FQN(), []byte("garbage"), cos.PermRWR))
+	tassert.CheckFatal(t, r.visitCT(ct, nil))
+	rep := r.report()
+	tassert.Errorf(t, rep.BadEC == bad+1, "expecting corrupted metafile to count, got %+v", rep)
+}
+
+func TestRebVerifyPersist(t *testing.T) {
+	dir := t.TempDir()
+	rep := &xact.RebVerifyReport{ID: "xid", Tid: rvLocal, Checked: 10, BadCopies: 1, Examples: []string{"obj"}}
+	tassert.CheckFatal(t, xact.SaveRebVerify(dir, rep))
+	loaded, err := xact.LoadRebVerify(dir)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, loaded.ID == rep.ID && loaded.Checked == 10 && loaded.Failed() == 1, "got %+v", loaded)
+
+	// tampered with
+	fqn := filepath.Join(dir, ".ais.rebverify")
+	b, err := os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	b[len(b)-2] ^= 0xff
+	tassert.CheckFatal(t, os.WriteFile(fqn, b, cos.PermRWR))
+	_, err = xact.LoadRebVerify(dir)
+	tassert.Errorf(t, err != nil, "expecting checksum error")
+}
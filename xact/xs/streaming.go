@@ -29,6 +29,7 @@ import (
 const (
 	opcodeDone = iota + 27182
 	opcodeAbrt
+	opcodeShard // x-archive: finalized shard => its (HRW) owner
 )
 
 const (
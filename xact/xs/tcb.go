@@ -8,6 +8,7 @@ package xs
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -45,9 +46,19 @@ type (
 		rxlast atomic.Int64 // finishing
 		xact.BckJog
 		prune    prune
+		quota    *jquota // apc.JobQuota, if requested
 		nam, str string
 		wg       sync.WaitGroup // starting up
 		refc     atomic.Int32   // finishing
+		snapshot struct {
+			vers    map[string]string // object name => version and checksum when the copy started
+			mu      sync.Mutex
+			skipped atomic.Int64 // created or modified after the copy started
+		}
+	}
+	// (see Snap.Ext)
+	tcbSnapshotStats struct {
+		Skipped int64 `json:"snapshot.skipped.n,string"`
 	}
 )
 
@@ -187,6 +198,8 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 		r.str = r.Base.String() + "<=" + args.BckFrom.Cname(msg.Prefix)
 	}
 
+	r.quota = newJquota(&msg.JobQuota, smap.CountActiveTs())
+
 	if msg.Sync {
 		debug.Assert(msg.Prepend == "", msg.Prepend) // validated (cli, P)
 		{
@@ -213,6 +226,14 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 
 	r.wg.Done()
 
+	// snapshot-consistent copy: objects that were created or overwritten after this point
+	// are skipped (and counted)
+	if r.p.args.Msg.Snapshot {
+		if err := r.snapStart(); err != nil {
+			r.Abort(err)
+		}
+	}
+
 	r.BckJog.Run()
 	if r.p.args.Msg.Sync {
 		r.prune.run() // the 2nd jgroup
@@ -238,6 +259,12 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 	if r.p.args.Msg.Sync {
 		r.prune.wait()
 	}
+	if n := r.snapshot.skipped.Load(); n > 0 {
+		nlog.Infoln(r.Name(), "snapshot: skipped", n, "object(s) modified after the copy started")
+	}
+	if r.quota.done() {
+		nlog.Infoln(r.Name(), partialQuota, "- finishing with partial results")
+	}
 	r.Finish()
 }
 
@@ -270,7 +297,24 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 	var (
 		args   = r.p.args // TCBArgs
 		toName = args.Msg.ToName(lom.ObjName)
+		size   int64
 	)
+	if r.quota.done() {
+		return cmn.NewErrAborted(r.Name(), partialQuota, nil) // stop this jogger (not the xaction)
+	}
+	if args.Msg.Snapshot {
+		// NOTE: hold the read lock for the duration of the copy (and note that the copy
+		// takes its own - nested - read lock); same-name destination is never the case (validated)
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return nil // removed in the meantime
+		}
+		if r.snapChanged(lom) {
+			r.snapshot.skipped.Inc()
+			return nil
+		}
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(r.Base.Name()+":", lom.Cname(), "=>", args.BckTo.Cname(toName))
 	}
@@ -291,10 +335,11 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 			coiParams.ObjnameTo = lom.ObjName
 		}
 	}
-	_, err = gcoi.CopyObject(lom, r.dm, coiParams)
+	size, err = gcoi.CopyObject(lom, r.dm, coiParams)
 	FreeCOI(coiParams)
 	switch {
 	case err == nil:
+		r.quota.add(size)
 		if args.Msg.Sync {
 			r.prune.filter.Insert(cos.UnsafeB(lom.Uname()))
 		}
@@ -308,6 +353,61 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 	return
 }
 
+// record versions and checksums of the (local) source objects
+func (r *XactTCB) snapStart() error {
+	var (
+		args = r.p.args
+		opts = &mpather.JgroupOpts{
+			CTs:      []string{fs.ObjectType},
+			VisitObj: r.snapRecord,
+			Prefix:   args.Msg.Prefix,
+			DoLoad:   mpather.Load,
+			Throttle: true,
+		}
+	)
+	opts.Bck.Copy(args.BckFrom.Bucket())
+	r.snapshot.vers = make(map[string]string, 1024)
+	jg := mpather.NewJoggerGroup(opts, r.Config, nil)
+	jg.Run()
+	select {
+	case errCause := <-r.ChanAbort():
+		jg.Stop()
+		return errCause
+	case <-jg.ListenFinished():
+		return jg.Stop()
+	}
+}
+
+func (r *XactTCB) snapRecord(lom *core.LOM, _ []byte) error {
+	v := snapVer(lom)
+	r.snapshot.mu.Lock()
+	r.snapshot.vers[lom.ObjName] = v
+	r.snapshot.mu.Unlock()
+	return nil
+}
+
+// (under read lock) created or overwritten since the copy started
+func (r *XactTCB) snapChanged(lom *core.LOM) bool {
+	r.snapshot.mu.Lock()
+	v, ok := r.snapshot.vers[lom.ObjName]
+	r.snapshot.mu.Unlock()
+	return !ok || v != snapVer(lom)
+}
+
+func snapVer(lom *core.LOM) string {
+	var (
+		cksum = lom.Checksum()
+		v     = lom.Version()
+	)
+	if !cksum.IsEmpty() {
+		v += "/" + cksum.Val()
+	}
+	if v == "" {
+		v = strconv.FormatInt(lom.Lsize(), 10) // (no version, no checksum)
+	}
+	return v
+}
+
 // NOTE: strict(est) error handling: abort on any of the errors below
 func (r *XactTCB) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
 	if err != nil && !cos.IsEOF(err) {
@@ -376,5 +476,9 @@ func (r *XactTCB) Snap() (snap *core.Snap) {
 	snap.IdleX = r.IsIdle()
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+	if r.p.args.Msg.Snapshot {
+		snap.Ext = &tcbSnapshotStats{Skipped: r.snapshot.skipped.Load()}
+	}
+	r.quota.toSnap(snap)
 	return
 }
@@ -0,0 +1,46 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+func TestTCBSnapshot(t *testing.T) {
+	_, bck := rvSetup(t, rvSmap(rvLocal))
+	bck.Props.Mirror.Enabled = false
+
+	existing, _ := rvPut(t, bck, "existing", 1)
+	overwritten, _ := rvPut(t, bck, "overwritten", 1)
+
+	r := &XactTCB{p: &tcbFactory{args: &xreg.TCBArgs{BckFrom: bck, Msg: &apc.TCBMsg{}}}}
+	r.Config = cmn.GCO.Get()
+	tassert.CheckFatal(t, r.snapStart())
+	tassert.Fatalf(t, len(r.snapshot.vers) == 2, "expecting 2 recorded, got %d", len(r.snapshot.vers))
+
+	// same mtime (or older) - different content
+	overwritten.Lock(true)
+	overwritten.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef"))
+	overwritten.SetAtimeUnix(overwritten.AtimeUnix())
+	tassert.CheckFatal(t, overwritten.Persist())
+	overwritten.Unlock(true)
+	created, _ := rvPut(t, bck, "created", 1)
+
+	for _, test := range []struct {
+		lom     *core.LOM
+		changed bool
+	}{{existing, false}, {overwritten, true}, {created, true}} {
+		lom := rvLoad(t, test.lom.FQN, bck)
+		tassert.Errorf(t, r.snapChanged(lom) == test.changed, "%s: expecting changed=%t", lom.ObjName, test.changed)
+	}
+}
@@ -43,12 +43,14 @@ type (
 		args     *xreg.TCObjsArgs
 		workCh   chan *cmn.TCOMsg
 		chanFull atomic.Int64
+		quota    xquota
 		streamingX
 		owt cmn.OWT
 	}
 	tcowi struct {
-		r   *XactTCObjs
-		msg *cmn.TCOMsg
+		r     *XactTCObjs
+		msg   *cmn.TCOMsg
+		quota *jquota // apc.JobQuota, if requested
 		// finishing
 		refc atomic.Int32
 	}
@@ -132,11 +134,13 @@ func (r *XactTCObjs) Snap() (snap *core.Snap) {
 	snap.IdleX = r.IsIdle()
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+	r.quota.toSnap(snap)
 	return
 }
 
 func (r *XactTCObjs) Begin(msg *cmn.TCOMsg) {
 	wi := &tcowi{r: r, msg: msg}
+	wi.quota = newJquota(&msg.JobQuota, core.T.Sowner().Get().CountActiveTs())
 	r.pending.mtx.Lock()
 
 	r.pending.m[msg.TxnUUID] = wi
@@ -179,6 +183,7 @@ func (r *XactTCObjs) Run(wg *sync.WaitGroup) {
 			// run
 			var wg *sync.WaitGroup
 			if err = lrit.init(r, &msg.ListRange, r.Bck(), lrpWorkersDflt); err == nil {
+				lrit.quota = wi.quota
 				// dynamic ctlmsg
 				{
 					var sb strings.Builder
@@ -204,6 +209,7 @@ func (r *XactTCObjs) Run(wg *sync.WaitGroup) {
 			}
 
 			lrit.wait()
+			r.quota.check(wi.quota)
 
 			if r.IsAborted() || err != nil {
 				goto fin
@@ -322,6 +328,9 @@ func (r *XactTCObjs) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *core.
 ///////////
 
 func (wi *tcowi) do(lom *core.LOM, lrit *lrit) {
+	if wi.quota.done() {
+		return // (queued prior to reaching)
+	}
 	var (
 		objNameTo = wi.msg.ToName(lom.ObjName)
 		buf, slab = core.T.PageMM().Alloc()
@@ -348,7 +357,7 @@ func (wi *tcowi) do(lom *core.LOM, lrit *lrit) {
 			coiParams.ObjnameTo = lom.ObjName
 		}
 	}
-	_, err := gcoi.CopyObject(lom, wi.r.p.dm, coiParams)
+	size, err := gcoi.CopyObject(lom, wi.r.p.dm, coiParams)
 	FreeCOI(coiParams)
 	slab.Free(buf)
 
@@ -356,7 +365,10 @@ func (wi *tcowi) do(lom *core.LOM, lrit *lrit) {
 		if !cos.IsNotExist(err, 0) || lrit.lrp == lrpList {
 			wi.r.AddErr(err, 5, cos.SmoduleXs)
 		}
-	} else if cmn.Rom.FastV(5, cos.SmoduleXs) {
+		return
+	}
+	wi.quota.add(size)
+	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(wi.r.Name()+":", lom.Cname(), "=>", wi.r.args.BckTo.Cname(objNameTo))
 	}
 }
@@ -333,3 +333,29 @@ func TestBeid(t *testing.T) {
 		fmt.Printf("Warning: failed to reproduce %d time%s out of %d\n", cnt, cos.Plural(cnt), num)
 	}
 }
+
+func TestXactionTimeline(t *testing.T) {
+	var (
+		xctn = &xact.Base{}
+		now  = time.Now()
+	)
+	xctn.InitBase(cos.GenUUID(), apc.ActLRU, "", nil)
+	tassert.Errorf(t, len(xctn.Timeline()) == 0, "expected empty timeline")
+
+	const n = 1000 // more than fits
+	for i := 1; i <= n; i++ {
+		xctn.ObjsAdd(1, cos.KiB)
+		xctn.Sample(now.Add(time.Duration(i) * xact.TimelineIval))
+	}
+	xctn.Finish()
+
+	tl := xctn.Timeline()
+	tassert.Fatalf(t, len(tl) > 2 && len(tl) <= 256+1, "unexpected number of samples: %d", len(tl))
+	tassert.Errorf(t, tl[0].Time == xctn.StartTime().UnixNano() && tl[0].Objs == 0, "first sample must be the start time")
+	tassert.Errorf(t, tl[len(tl)-1].Objs == n, "final sample: expected %d objects, got %d", n, tl[len(tl)-1].Objs)
+	for i := 2; i < len(tl)-1; i++ { // (the final sample is taken in real time)
+		tassert.Fatalf(t, tl[i].Time > tl[i-1].Time && tl[i].Objs >= tl[i-1].Objs, "samples out of order at %d", i)
+	}
+	bps, ops := xact.Throughput(&tl[1], &tl[2])
+	tassert.Errorf(t, ops > 0 && bps == ops*cos.KiB, "unexpected throughput (%f, %f)", bps, ops)
+}